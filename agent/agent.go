@@ -9,8 +9,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +32,22 @@ const (
 	// type that an agent will queue before rejecting messages.
 	MaxMessageBufferSize = 10
 
+	// assetCacheGCInterval specifies the interval at which the agent evicts
+	// runtime assets from its cache, if the cache is limited.
+	assetCacheGCInterval = time.Hour
+
+	// bufferReplayBatchSize specifies the maximum number of buffered events
+	// replayed at once.
+	bufferReplayBatchSize = 100
+
+	// shutdownTimeout specifies the maximum time the agent waits for the checks
+	// in progress to complete when it stops.
+	shutdownTimeout = 30 * time.Second
+
+	// reconnectMaxJitter specifies the maximum random delay before the agent
+	// attempts to reconnect to a backend after losing its connection.
+	reconnectMaxJitter = 5 * time.Second
+
 	// TCPSocketReadDeadline specifies the maximum time the TCP socket will wait
 	// to receive data.
 	TCPSocketReadDeadline = 500 * time.Millisecond
@@ -42,6 +60,14 @@ const (
 	DefaultBackendURL = "ws://127.0.0.1:8081"
 	// DefaultEnvironment specifies the default environment
 	DefaultEnvironment = "default"
+	// DefaultEventsBufferDisable specifies if the events buffer is disabled
+	DefaultEventsBufferDisable = false
+	// DefaultEventsBufferMaxAge specifies the default maximum age, in seconds,
+	// of the buffered events
+	DefaultEventsBufferMaxAge = 86400
+	// DefaultEventsBufferMaxSize specifies the default maximum size, in bytes,
+	// of the events buffer
+	DefaultEventsBufferMaxSize = 100 * 1024 * 1024
 	// DefaultKeepaliveInterval specifies the default keepalive interval
 	DefaultKeepaliveInterval = 20
 	// DefaultKeepaliveTimeout specifies the default keepalive timeout
@@ -72,17 +98,36 @@ type Config struct {
 	AgentID string
 	// API contains the Sensu client HTTP API configuration
 	API *APIConfig
+	// AssetCacheMaxAge is the time, in seconds, after which the unused runtime
+	// assets are evicted from the cache. Default: 0 (disabled)
+	AssetCacheMaxAge int
+	// AssetCacheMaxSize is the size, in bytes, beyond which the least recently
+	// used runtime assets are evicted from the cache. Default: 0 (unlimited)
+	AssetCacheMaxSize int64
+	// AssetMirror is the base URL runtime assets are downloaded from instead of
+	// their own URL, e.g. in air-gapped environments
+	AssetMirror string
 	// BackendURLs is a list of URLs for the Sensu Backend. Default:
 	// ws://127.0.0.1:8081
 	BackendURLs []string
 	// CacheDir path where cached data is stored
 	CacheDir string
+	// CloudDiscovery indicates whether the metadata of the cloud instance the
+	// agent is running on is discovered and added to its entity
+	CloudDiscovery bool
 	// Deregister indicates whether the entity is ephemeral
 	Deregister bool
 	// DeregistrationHandler specifies a single deregistration handler
 	DeregistrationHandler string
 	// Environment sets the Agent's RBAC environment identifier
 	Environment string
+	// EventsBuffer contains the configuration of the disk-backed buffer of the
+	// events sent while the backend is unreachable
+	EventsBuffer *EventsBufferConfig
+	// EventsCompressionThreshold is the size, in bytes, beyond which events are
+	// compressed with gzip before being sent to the backend. Default: 0
+	// (disabled)
+	EventsCompressionThreshold int64
 	// ExtendedAttributes contains any custom attributes passed to the agent on
 	// start
 	ExtendedAttributes []byte
@@ -92,6 +137,17 @@ type Config struct {
 	// KeepaliveTimeout is the time after which a sensu-agent is considered dead
 	// back the backend.
 	KeepaliveTimeout uint32
+	// KeepaliveWarningTimeout is the time after which the backend creates a
+	// warning keepalive event for the agent. Default: KeepaliveTimeout
+	KeepaliveWarningTimeout uint32
+	// KeepaliveCriticalTimeout is the time after which the backend creates a
+	// critical keepalive event for the agent. Default: 0 (disabled)
+	KeepaliveCriticalTimeout uint32
+	// KeepaliveHandlers are the handlers of the keepalive events of the agent
+	KeepaliveHandlers []string
+	// MaxConcurrentChecks is the maximum number of checks executed at once by
+	// the agent. Default: 0 (unlimited)
+	MaxConcurrentChecks int
 	// Organization sets the Agent's RBAC organization identifier
 	Organization string
 	// Password sets Agent's password
@@ -110,6 +166,13 @@ type Config struct {
 	User string
 }
 
+// EventsBufferConfig contains the events buffer configuration
+type EventsBufferConfig struct {
+	Disable bool
+	MaxAge  int
+	MaxSize int64
+}
+
 // StatsdServerConfig contains the statsd server configuration
 type StatsdServerConfig struct {
 	Host          string
@@ -134,9 +197,14 @@ func FixtureConfig() *Config {
 			Host: DefaultAPIHost,
 			Port: DefaultAPIPort,
 		},
-		BackendURLs:       []string{},
-		CacheDir:          path.SystemCacheDir("sensu-agent"),
-		Environment:       DefaultEnvironment,
+		BackendURLs: []string{},
+		CacheDir:    path.SystemCacheDir("sensu-agent"),
+		Environment: DefaultEnvironment,
+		EventsBuffer: &EventsBufferConfig{
+			Disable: DefaultEventsBufferDisable,
+			MaxAge:  DefaultEventsBufferMaxAge,
+			MaxSize: DefaultEventsBufferMaxSize,
+		},
 		KeepaliveInterval: DefaultKeepaliveInterval,
 		KeepaliveTimeout:  DefaultKeepaliveTimeout,
 		Organization:      DefaultOrganization,
@@ -161,6 +229,7 @@ func FixtureConfig() *Config {
 func NewConfig() *Config {
 	c := &Config{
 		API:          &APIConfig{},
+		EventsBuffer: &EventsBufferConfig{},
 		Socket:       &SocketConfig{},
 		StatsdServer: &StatsdServerConfig{},
 	}
@@ -183,11 +252,16 @@ type Agent struct {
 	api             *http.Server
 	assetManager    *assetmanager.Manager
 	backendSelector BackendSelector
+	buffer          *eventBuffer
 	cancel          context.CancelFunc
 	config          *Config
 	conn            transport.Transport
 	context         context.Context
+	draining        chan struct{}
 	entity          *types.Entity
+	executions      *sync.WaitGroup
+	executionsMu    *sync.Mutex
+	executionSlots  chan struct{}
 	handler         *handler.MessageHandler
 	header          http.Header
 	inProgress      map[string]*types.CheckConfig
@@ -208,6 +282,9 @@ func NewAgent(config *Config) *Agent {
 		cancel:          cancel,
 		context:         ctx,
 		config:          config,
+		draining:        make(chan struct{}),
+		executions:      &sync.WaitGroup{},
+		executionsMu:    &sync.Mutex{},
 		handler:         handler.NewMessageHandler(),
 		inProgress:      make(map[string]*types.CheckConfig),
 		inProgressMu:    &sync.Mutex{},
@@ -217,9 +294,14 @@ func NewAgent(config *Config) *Agent {
 		wg:              &sync.WaitGroup{},
 	}
 
+	if config.MaxConcurrentChecks > 0 {
+		agent.executionSlots = make(chan struct{}, config.MaxConcurrentChecks)
+	}
+
 	agent.statsdServer = NewStatsdServer(agent)
 	agent.handler.AddHandler(types.CheckRequestType, agent.handleCheck)
 	agent.assetManager = assetmanager.New(config.CacheDir, agent.getAgentEntity())
+	agent.assetManager.SetMirror(config.AssetMirror)
 
 	return agent
 }
@@ -239,30 +321,7 @@ func (a *Agent) receiveMessages(out chan *transport.Message) {
 					logger.Debug(err)
 				}
 
-				// Now, we must attempt to reconnect to the backend, with exponential
-				// backoff
-				backoff := retry.ExponentialBackoff{
-					InitialDelayInterval: 500 * time.Millisecond,
-					MaxDelayInterval:     10 * time.Second,
-					MaxRetryAttempts:     0, // Unlimited attempts
-					Multiplier:           1.5,
-				}
-				if err := backoff.Retry(func(retry int) (bool, error) {
-					//if retry != 0 {
-					//	logger.Debugf("reconnection attempt #%d", retry)
-					//}
-
-					if err = a.conn.Reconnect(a.backendSelector.Select(), a.config.TLS, a.header); err != nil {
-						logger.WithError(err).Error("reconnection attempt failed")
-						return false, nil
-					}
-
-					// At this point, the attempt was successful
-					logger.Info("successfully reconnected")
-					return true, nil
-				}); err != nil {
-					logger.WithError(err).Fatal("could not reconnect to transport")
-				}
+				a.reconnect()
 			}
 
 		}
@@ -270,6 +329,60 @@ func (a *Agent) receiveMessages(out chan *transport.Message) {
 	}
 }
 
+// connect connects the agent to one of its backends, trying each of them in
+// turn until one is reachable.
+func (a *Agent) connect() (transport.Transport, error) {
+	var err error
+	for i := 0; i < len(a.config.BackendURLs) || i == 0; i++ {
+		backendURL := a.backendSelector.Select()
+		var conn transport.Transport
+		if conn, err = transport.Connect(backendURL, a.config.TLS, a.header); err == nil {
+			return conn, nil
+		}
+		logger.WithError(err).WithField("backend", backendURL).Error("could not connect to backend")
+	}
+	return nil, err
+}
+
+// reconnect reconnects the agent to one of its backends, with exponential
+// backoff, then sends a keepalive so the backend resumes the session and the
+// subscriptions of the agent right away.
+func (a *Agent) reconnect() {
+	// Wait for a random delay before the first attempt, so the agents of a
+	// backend that went down do not all reconnect at the same time
+	select {
+	case <-time.After(time.Duration(rand.Int63n(int64(reconnectMaxJitter)))):
+	case <-a.stopping:
+		return
+	}
+
+	backoff := retry.ExponentialBackoff{
+		InitialDelayInterval: 500 * time.Millisecond,
+		MaxDelayInterval:     10 * time.Second,
+		MaxRetryAttempts:     0, // Unlimited attempts
+		Multiplier:           1.5,
+	}
+	if err := backoff.Retry(func(retry int) (bool, error) {
+		// Each attempt goes to the next backend, so the agent fails over to
+		// another backend if its backend is down
+		backendURL := a.backendSelector.Select()
+		if err := a.conn.Reconnect(backendURL, a.config.TLS, a.header); err != nil {
+			logger.WithError(err).WithField("backend", backendURL).Error("reconnection attempt failed")
+			return false, nil
+		}
+
+		// At this point, the attempt was successful
+		logger.WithField("backend", backendURL).Info("successfully reconnected")
+		return true, nil
+	}); err != nil {
+		logger.WithError(err).Fatal("could not reconnect to transport")
+	}
+
+	if err := a.sendKeepalive(); err != nil {
+		logger.WithError(err).Error("error sending keepalive")
+	}
+}
+
 func (a *Agent) receivePump() {
 	logger.Info("connected - starting receivePump")
 
@@ -302,6 +415,17 @@ func (a *Agent) sendMessage(msgType string, payload []byte) {
 		"type":    msgType,
 		"payload": string(payload),
 	}).Debug("sending message")
+	// Large events are compressed, the transport decompressing them on the
+	// backend
+	if msgType == transport.MessageTypeEvent && a.config.EventsCompressionThreshold > 0 && int64(len(payload)) > a.config.EventsCompressionThreshold {
+		compressed, err := transport.CompressPayload(payload)
+		if err != nil {
+			logger.WithError(err).Warning("error compressing event, sending it uncompressed")
+		} else {
+			payload = compressed
+		}
+	}
+
 	// blocks until message can be enqueued.
 	// TODO(greg): ring buffer?
 	msg := &transport.Message{
@@ -316,6 +440,7 @@ func (a *Agent) sendPump() {
 	// to prevent a race condition between it and something else trying
 	// to close the transport (which actually causes a write to the websocket
 	// connection.)
+	defer a.wg.Done()
 	defer func() {
 		if err := a.conn.Close(); err != nil {
 			logger.Debug(err)
@@ -329,10 +454,91 @@ func (a *Agent) sendPump() {
 	for {
 		select {
 		case msg := <-a.sendq:
-			if err := a.conn.Send(msg); err != nil {
-				logger.WithError(err).Warning("transport send error")
-			}
+			a.send(msg)
+		case <-ticker.C:
+			a.replayBufferedEvents()
 		case <-a.stopping:
+			// Flush the messages queued before the agent stopped, such as its
+			// deregistration, and the buffered events before closing the
+			// transport.
+			for {
+				select {
+				case msg := <-a.sendq:
+					a.send(msg)
+				default:
+					a.flushBufferedEvents()
+					return
+				}
+			}
+		}
+	}
+}
+
+// send sends the given message to the backend. Events which can't be sent are
+// stored in the events buffer, to be replayed once the backend is reachable
+// again. Events queue behind the buffered ones until the buffer is empty, so
+// the backend receives them in order.
+func (a *Agent) send(msg *transport.Message) {
+	// The transport recycles the messages it sends, even unsuccessfully
+	msgType, payload := msg.Type, msg.Payload
+
+	if a.buffer == nil || msgType != transport.MessageTypeEvent {
+		if err := a.conn.Send(msg); err != nil {
+			logger.WithError(err).Warning("transport send error")
+		}
+		return
+	}
+
+	if a.buffer.Len() == 0 && !a.conn.Closed() {
+		err := a.conn.Send(msg)
+		if err == nil {
+			return
+		}
+		logger.WithError(err).Warning("transport send error, buffering event")
+	}
+
+	if err := a.buffer.Push(&transport.Message{Type: msgType, Payload: payload}); err != nil {
+		logger.WithError(err).Error("error buffering event")
+	}
+}
+
+// replayBufferedEvents sends a batch of the buffered events to the backend,
+// oldest first, until the buffer is empty or the backend is unreachable.
+func (a *Agent) replayBufferedEvents() {
+	if a.buffer == nil {
+		return
+	}
+
+	// Replay the buffer in batches so the other messages, like keepalives,
+	// are not held back by a large backlog
+	for i := 0; i < bufferReplayBatchSize && !a.conn.Closed(); i++ {
+		msg, err := a.buffer.Peek()
+		if err != nil {
+			logger.WithError(err).Error("error reading buffered event")
+			return
+		}
+		if msg == nil {
+			return
+		}
+		if err := a.conn.Send(msg); err != nil {
+			logger.WithError(err).Warning("transport send error, buffered event will be retried")
+			return
+		}
+		a.buffer.Pop()
+	}
+}
+
+// flushBufferedEvents replays the buffered events until the buffer is empty or
+// the backend does not accept them anymore.
+func (a *Agent) flushBufferedEvents() {
+	if a.buffer == nil {
+		return
+	}
+
+	for n := a.buffer.Len(); n > 0; n = a.buffer.Len() {
+		a.replayBufferedEvents()
+		if a.buffer.Len() == n {
+			logger.WithField("events", n).Warning("could not flush the buffered events, they will be sent on restart")
 			return
 		}
 	}
@@ -359,6 +565,23 @@ func (a *Agent) sendKeepalive() error {
 	return nil
 }
 
+// sendDeregistration queues a deregistration message so the backend
+// deregisters the entity of the agent.
+func (a *Agent) sendDeregistration() error {
+	logger.Info("sending deregistration")
+	deregistration := &types.Event{
+		Entity:    a.getAgentEntity(),
+		Timestamp: time.Now().Unix(),
+	}
+	msgBytes, err := json.Marshal(deregistration)
+	if err != nil {
+		return err
+	}
+
+	a.sendMessage(transport.MessageTypeDeregistration, msgBytes)
+	return nil
+}
+
 func (a *Agent) buildTransportHeaderMap() http.Header {
 	header := http.Header{}
 	header.Set(transport.HeaderKeyAgentID, a.config.AgentID)
@@ -389,7 +612,24 @@ func (a *Agent) Run() error {
 		a.StartStatsd()
 	}
 
-	conn, err := transport.Connect(a.backendSelector.Select(), a.config.TLS, a.header)
+	// Buffer the events on disk while the backend is unreachable, if enabled
+	if a.config.EventsBuffer != nil && !a.config.EventsBuffer.Disable {
+		dir := filepath.Join(a.config.CacheDir, "events")
+		maxAge := time.Duration(a.config.EventsBuffer.MaxAge) * time.Second
+		buffer, err := newEventBuffer(dir, a.config.EventsBuffer.MaxSize, maxAge)
+		if err != nil {
+			logger.WithError(err).Error("error creating the events buffer, events will not be buffered")
+		} else {
+			a.buffer = buffer
+		}
+	}
+
+	// Add the metadata of the cloud instance to the entity before registering
+	if a.config.CloudDiscovery {
+		a.discoverCloud()
+	}
+
+	conn, err := a.connect()
 	if err != nil {
 		return err
 	}
@@ -397,10 +637,15 @@ func (a *Agent) Run() error {
 	a.conn = conn
 
 	// These are in separate goroutines so that they can, theoretically, be executing
-	// concurrently.
+	// concurrently. Stop() waits for the sendPump to flush the queued messages.
+	a.wg.Add(1)
 	go a.sendPump()
 	go a.receivePump()
 
+	if a.config.AssetCacheMaxSize > 0 || a.config.AssetCacheMaxAge > 0 {
+		go a.collectAssetGarbage()
+	}
+
 	// Send an immediate keepalive once we've connected.
 	if err := a.sendKeepalive(); err != nil {
 		logger.WithError(err).Error("error sending keepalive")
@@ -461,6 +706,26 @@ func (a *Agent) StartAPI() {
 	}()
 }
 
+// collectAssetGarbage periodically evicts runtime assets from the cache of the
+// agent, according to its limits, until the agent is stopped.
+func (a *Agent) collectAssetGarbage() {
+	ticker := time.NewTicker(assetCacheGCInterval)
+	defer ticker.Stop()
+
+	maxAge := time.Duration(a.config.AssetCacheMaxAge) * time.Second
+	for {
+		if err := assetmanager.CollectGarbage(a.config.CacheDir, a.config.AssetCacheMaxSize, maxAge); err != nil {
+			logger.WithError(err).Error("error evicting runtime assets from the cache")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-a.stopping:
+			return
+		}
+	}
+}
+
 // StartSocketListeners starts the agent's TCP and UDP socket listeners.
 func (a *Agent) StartSocketListeners() {
 	if _, _, err := a.createListenSockets(); err != nil {
@@ -468,21 +733,104 @@ func (a *Agent) StartSocketListeners() {
 	}
 }
 
-// Stop shuts down the agent. It will block until all listening goroutines
-// have returned.
+// Stop shuts down the agent gracefully. It stops executing new checks, waits
+// for the checks in progress, then sends their results, a last keepalive or
+// the deregistration of the agent and the buffered events to the backend. It
+// will block until all listening goroutines have returned.
 func (a *Agent) Stop() {
+	// Stop executing new checks and let the checks in progress complete, so
+	// their results are sent before the connection is closed
+	a.executionsMu.Lock()
+	close(a.draining)
+	a.executionsMu.Unlock()
+	a.waitForExecutions()
+
+	// Send a last keepalive, so the backend has the whole keepalive timeout of
+	// the agent to see it come back, e.g. while it is upgraded, or the
+	// deregistration of the agent
+	if a.conn != nil {
+		if a.config.Deregister {
+			if err := a.sendDeregistration(); err != nil {
+				logger.WithError(err).Error("error sending deregistration")
+			}
+		} else if err := a.sendKeepalive(); err != nil {
+			logger.WithError(err).Error("error sending keepalive")
+		}
+	}
+
 	a.cancel()
 	close(a.stopping)
 	a.wg.Wait()
 }
 
+// waitForExecutions waits for the checks in progress to complete, up to the
+// shutdown timeout.
+func (a *Agent) waitForExecutions() {
+	done := make(chan struct{})
+	go func() {
+		a.executions.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		logger.Warning("checks still in progress after the shutdown timeout, their results will not be sent")
+	}
+}
+
+// Reload applies the backend URLs, subscriptions, custom attributes, keepalive
+// handlers and redacted fields of the given configuration to the running
+// agent, without closing its connection to the backend or interrupting the
+// checks in progress. The agent sends a keepalive right away, so the backend
+// updates its subscriptions, and connects to the new backend URLs on its next
+// reconnection. Changing the other settings requires restarting the agent.
+func (a *Agent) Reload(config *Config) {
+	cfg := *a.config
+	cfg.BackendURLs = config.BackendURLs
+	cfg.ExtendedAttributes = config.ExtendedAttributes
+	cfg.KeepaliveHandlers = config.KeepaliveHandlers
+	cfg.Redact = config.Redact
+	cfg.Subscriptions = config.Subscriptions
+	a.config = &cfg
+
+	a.backendSelector = &RandomBackendSelector{Backends: cfg.BackendURLs}
+
+	if a.header != nil {
+		header := http.Header{}
+		for key := range a.header {
+			header.Set(key, a.header.Get(key))
+		}
+		header.Set(transport.HeaderKeySubscriptions, strings.Join(cfg.Subscriptions, ","))
+		a.header = header
+	}
+
+	// The system information of the entity does not depend on the
+	// configuration, so it is not discovered again
+	entity := a.newAgentEntity()
+	entity.System = a.getAgentEntity().System
+	a.entity = entity
+
+	logger.WithFields(logrus.Fields{
+		"backends":      cfg.BackendURLs,
+		"subscriptions": cfg.Subscriptions,
+	}).Info("agent configuration reloaded")
+
+	if a.conn != nil {
+		if err := a.sendKeepalive(); err != nil {
+			logger.WithError(err).Error("error sending keepalive")
+		}
+	}
+}
+
 // StartStatsd starts up a StatsD listener on the agent, logs an error for any
 // failures.
 func (a *Agent) StartStatsd() {
 	logger.Info("starting statsd server on address: ", a.statsdServer.MetricsAddr)
 
 	go func() {
-		if err := a.statsdServer.Run(a.context); err != nil {
+		sf := statsdSocketFactory(a.statsdServer.MetricsAddr)
+		if err := a.statsdServer.RunWithCustomSocket(a.context, sf); err != nil {
 			logger.WithError(err).Errorf("error with statsd server on address: %s, statsd listener will not run", a.statsdServer.MetricsAddr)
 		}
 	}()
@@ -55,6 +55,49 @@ func TestSendLoop(t *testing.T) {
 	<-done
 }
 
+func TestDeregistrationOnStop(t *testing.T) {
+	done := make(chan struct{})
+	server := transport.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := server.Serve(w, r)
+		assert.NoError(t, err)
+
+		for {
+			msg, err := conn.Receive()
+			if err != nil {
+				return
+			}
+			if msg.Type != transport.MessageTypeDeregistration {
+				continue
+			}
+
+			event := &types.Event{}
+			assert.NoError(t, json.Unmarshal(msg.Payload, event))
+			assert.NotNil(t, event.Entity)
+			assert.True(t, event.Entity.Deregister)
+			close(done)
+			return
+		}
+	}))
+	defer ts.Close()
+
+	wsURL := strings.Replace(ts.URL, "http", "ws", 1)
+
+	cfg := FixtureConfig()
+	cfg.BackendURLs = []string{wsURL}
+	cfg.API.Port = 0
+	cfg.Socket.Port = 0
+	cfg.Deregister = true
+	ta := NewAgent(cfg)
+	err := ta.Run()
+	assert.NoError(t, err)
+	if err != nil {
+		assert.FailNow(t, "agent failed to run")
+	}
+	ta.Stop()
+	<-done
+}
+
 func TestReceiveLoop(t *testing.T) {
 	testMessage := &testMessageType{"message"}
 
@@ -151,3 +194,72 @@ func TestKeepaliveLoggingRedaction(t *testing.T) {
 	defer ta.Stop()
 	<-done
 }
+
+func TestRunFailsOverToAnotherBackend(t *testing.T) {
+	done := make(chan struct{})
+	server := transport.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := server.Serve(w, r)
+		assert.NoError(t, err)
+
+		msg, err := conn.Receive()
+		assert.NoError(t, err)
+		assert.Equal(t, "keepalive", msg.Type)
+		close(done)
+	}))
+	defer ts.Close()
+
+	// The other backend is down
+	down := httptest.NewServer(http.NotFoundHandler())
+	downURL := strings.Replace(down.URL, "http", "ws", 1)
+	down.Close()
+
+	cfg := FixtureConfig()
+	cfg.BackendURLs = []string{downURL, strings.Replace(ts.URL, "http", "ws", 1)}
+	cfg.API.Port = 0
+	cfg.Socket.Port = 0
+	ta := NewAgent(cfg)
+	if err := ta.Run(); err != nil {
+		assert.FailNow(t, "agent failed to run", err.Error())
+	}
+	defer ta.Stop()
+	<-done
+}
+
+func TestKeepaliveOnStop(t *testing.T) {
+	done := make(chan struct{})
+	server := transport.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := server.Serve(w, r)
+		assert.NoError(t, err)
+
+		// The first keepalive is sent when the agent starts, the second one
+		// when it stops
+		keepalives := 0
+		for {
+			msg, err := conn.Receive()
+			if err != nil {
+				return
+			}
+			if msg.Type == transport.MessageTypeKeepalive {
+				keepalives++
+			}
+			if keepalives == 2 {
+				close(done)
+				return
+			}
+		}
+	}))
+	defer ts.Close()
+
+	cfg := FixtureConfig()
+	cfg.BackendURLs = []string{strings.Replace(ts.URL, "http", "ws", 1)}
+	cfg.API.Port = 0
+	cfg.Socket.Port = 0
+	ta := NewAgent(cfg)
+	if err := ta.Run(); err != nil {
+		assert.FailNow(t, "agent failed to run", err.Error())
+	}
+	ta.Stop()
+	<-done
+}
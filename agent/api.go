@@ -35,6 +35,7 @@ func newServer(a *Agent) *http.Server {
 func registerRoutes(a *Agent, r *mux.Router) {
 	r.HandleFunc("/events", addEvent(a)).Methods(http.MethodPost)
 	r.HandleFunc("/healthz", healthz(a.conn)).Methods(http.MethodGet)
+	r.HandleFunc("/brew", brew).Methods(http.MethodGet)
 }
 
 // healthz returns an OK status if the agent is up and connected to a backend.
@@ -50,6 +51,13 @@ func healthz(conn transport.Transport) http.HandlerFunc {
 	}
 }
 
+// brew refuses to brew coffee, as the Sensu 1.x client API does, which makes
+// for a liveness check of the agent API alone.
+func brew(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusTeapot)
+	_, _ = fmt.Fprint(w, "I'm a teapot")
+}
+
 // addEvent accepts an event and send it to the backend over the event channel
 func addEvent(a *Agent) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -105,3 +105,18 @@ func TestHealthz(t *testing.T) {
 		})
 	}
 }
+
+func TestBrew(t *testing.T) {
+	agent := NewAgent(FixtureConfig())
+
+	r, err := http.NewRequest("GET", "/brew", nil)
+	assert.NoError(t, err)
+
+	router := mux.NewRouter()
+	registerRoutes(agent, router)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, "I'm a teapot", w.Body.String())
+}
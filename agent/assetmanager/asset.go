@@ -7,7 +7,9 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"time"
 
@@ -34,8 +36,9 @@ const (
 
 // A RuntimeAsset refers to an asset that is currently in use by the agent.
 type RuntimeAsset struct {
-	path  string
-	asset *types.Asset
+	path   string
+	asset  *types.Asset
+	mirror string
 }
 
 // NewRuntimeAsset given asset and pathPrefix return new managed asset
@@ -95,6 +98,13 @@ func (d *RuntimeAsset) markAsInstalled() error {
 	return file.Close()
 }
 
+// Update the modification time of the .installed file, which records when the
+// asset was last used.
+func (d *RuntimeAsset) markAsUsed() error {
+	now := time.Now()
+	return os.Chtimes(filepath.Join(d.path, ".installed"), now, now)
+}
+
 // Avoid competing installation of assets
 func (d *RuntimeAsset) awaitLock() (*lockfile.Lockfile, error) {
 	lockfile, err := lockfile.New(filepath.Join(d.path, ".lock"))
@@ -137,7 +147,11 @@ func (d *RuntimeAsset) awaitLock() (*lockfile.Lockfile, error) {
 func (d *RuntimeAsset) fetch() (*http.Response, error) {
 	// GET asset w/ timeout
 	netClient := &http.Client{Timeout: fetchTimeout}
-	r, err := netClient.Get(d.asset.URL)
+	assetURL, err := d.url()
+	if err != nil {
+		return nil, err
+	}
+	r, err := netClient.Get(assetURL)
 	if err != nil {
 		return r, fmt.Errorf("error fetching asset: %s", err.Error())
 	}
@@ -145,6 +159,27 @@ func (d *RuntimeAsset) fetch() (*http.Response, error) {
 	return r, err
 }
 
+// url returns the URL to download the asset from: its own URL, or the same
+// path under the mirror if any.
+func (d *RuntimeAsset) url() (string, error) {
+	if d.mirror == "" {
+		return d.asset.URL, nil
+	}
+
+	mirror, err := url.Parse(d.mirror)
+	if err != nil {
+		return "", fmt.Errorf("invalid asset mirror: %s", err)
+	}
+	assetURL, err := url.Parse(d.asset.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid asset URL: %s", err)
+	}
+
+	mirror.Path = path.Join("/", mirror.Path, assetURL.Path)
+	mirror.RawQuery = assetURL.RawQuery
+	return mirror.String(), nil
+}
+
 // binDir creates the asset's bin directory and returns the path
 func (d *RuntimeAsset) binDir() (string, error) {
 	// Ensure that cache directory exists before we attempt to write the contents
@@ -221,9 +256,13 @@ func (d *RuntimeAsset) install() (err error) {
 	}
 	defer lockfile.Unlock()
 
-	// Check that asset hasn't already been installed
+	// Check that asset hasn't already been installed, in which case its use is
+	// recorded so the cache garbage collector keeps it
 	if cached, err := d.isInstalled(); cached || err != nil {
-		return err
+		if err != nil {
+			return err
+		}
+		return d.markAsUsed()
 	}
 
 	logger.WithFields(logrus.Fields{
@@ -196,3 +196,74 @@ func TestIsCachedDirIsDirectory(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, cached)
 }
+
+func TestMirrorURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mirror   string
+		url      string
+		expected string
+	}{
+		{
+			name:     "no mirror",
+			url:      "https://example.com/assets/ruby24.tar?v=1",
+			expected: "https://example.com/assets/ruby24.tar?v=1",
+		},
+		{
+			name:     "mirror host",
+			mirror:   "http://mirror.local",
+			url:      "https://example.com/assets/ruby24.tar?v=1",
+			expected: "http://mirror.local/assets/ruby24.tar?v=1",
+		},
+		{
+			name:     "mirror with path",
+			mirror:   "http://mirror.local/sensu/",
+			url:      "https://example.com/assets/ruby24.tar",
+			expected: "http://mirror.local/sensu/assets/ruby24.tar",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runtimeAsset := &RuntimeAsset{
+				asset:  &types.Asset{Name: "ruby24", URL: tc.url},
+				mirror: tc.mirror,
+			}
+			url, err := runtimeAsset.url()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, url)
+		})
+	}
+}
+
+func TestInstallFromMirror(t *testing.T) {
+	server, test := newTest(t)
+	defer server.Close()
+	defer test.Dispose(t)
+
+	test.responseBody = readFixture("rubby-on-rails.tar")
+	test.asset.Sha512 = stringToSHA512(test.responseBody)
+	test.asset.URL = "http://unreachable.invalid/myfile"
+	test.runtimeAsset.mirror = server.URL
+
+	require.NoError(t, test.runtimeAsset.install())
+}
+
+func TestInstallRecordsUse(t *testing.T) {
+	server, test := newTest(t)
+	defer server.Close()
+	defer test.Dispose(t)
+
+	test.responseBody = readFixture("rubby-on-rails.tar")
+	test.asset.Sha512 = stringToSHA512(test.responseBody)
+	require.NoError(t, test.runtimeAsset.install())
+
+	installed := filepath.Join(test.runtimeAsset.path, ".installed")
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(installed, past, past))
+
+	require.NoError(t, test.runtimeAsset.install())
+	info, err := os.Stat(installed)
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().After(past))
+}
@@ -10,11 +10,16 @@ type AssetFactory struct {
 
 	// CacheDir is the directory where assets are stored
 	CacheDir string
+	// Mirror is the base URL assets are downloaded from instead of their own
+	// URL, if set
+	Mirror string
 }
 
 // NewAsset returns a new RuntimeAsset given an asset
 func (factory AssetFactory) NewAsset(asset *types.Asset) *RuntimeAsset {
-	return NewRuntimeAsset(asset, factory.CacheDir)
+	runtimeAsset := NewRuntimeAsset(asset, factory.CacheDir)
+	runtimeAsset.mirror = factory.Mirror
+	return runtimeAsset
 }
 
 // NewAssetSet returns a new RuntimeAsset given an asset
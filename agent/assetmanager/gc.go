@@ -0,0 +1,154 @@
+package assetmanager
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/nightlyone/lockfile"
+	"github.com/sirupsen/logrus"
+)
+
+// cachedAsset is an asset installed in the cache directory.
+type cachedAsset struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+// CollectGarbage evicts the installed assets of the given cache directory
+// which were not used for longer than maxAge, then the least recently used
+// ones while the total size of the cache exceeds maxSize bytes. Either limit is
+// ignored if zero. Assets being installed are never evicted, and evicted
+// assets are installed again the next time they are needed.
+func CollectGarbage(cacheDir string, maxSize int64, maxAge time.Duration) error {
+	if maxSize <= 0 && maxAge <= 0 {
+		return nil
+	}
+
+	assets, err := cachedAssets(cacheDir)
+	if err != nil {
+		return err
+	}
+
+	// Least recently used first
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].lastUsed.Before(assets[j].lastUsed)
+	})
+
+	var total int64
+	for _, asset := range assets {
+		total += asset.size
+	}
+
+	now := time.Now()
+	for _, asset := range assets {
+		expired := maxAge > 0 && now.Sub(asset.lastUsed) > maxAge
+		oversized := maxSize > 0 && total > maxSize
+		if !expired && !oversized {
+			continue
+		}
+
+		evicted, err := evictAsset(asset.path)
+		if err != nil {
+			logger.WithError(err).WithField("path", asset.path).Error("error evicting asset")
+			continue
+		}
+		if evicted {
+			total -= asset.size
+			logger.WithFields(logrus.Fields{
+				"path":      asset.path,
+				"last_used": asset.lastUsed,
+			}).Info("evicted asset from cache")
+		}
+	}
+
+	return nil
+}
+
+// cachedAssets returns the installed assets of the given cache directory,
+// which are stored in directories named after their SHA-512 checksum.
+func cachedAssets(cacheDir string) ([]cachedAsset, error) {
+	files, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	assets := []cachedAsset{}
+	for _, file := range files {
+		if !file.IsDir() || !isChecksum(file.Name()) {
+			continue
+		}
+
+		assetPath := filepath.Join(cacheDir, file.Name())
+		installed, err := os.Stat(filepath.Join(assetPath, ".installed"))
+		if err != nil {
+			// Not installed yet, or being installed
+			continue
+		}
+
+		size, err := dirSize(assetPath)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, cachedAsset{
+			path:     assetPath,
+			size:     size,
+			lastUsed: installed.ModTime(),
+		})
+	}
+
+	return assets, nil
+}
+
+// evictAsset removes the installed asset at the given path, unless it is
+// locked by an installation, and returns whether it was removed.
+func evictAsset(assetPath string) (bool, error) {
+	lock, err := lockfile.New(filepath.Join(assetPath, ".lock"))
+	if err != nil {
+		return false, err
+	}
+	if err := lock.TryLock(); err != nil {
+		return false, nil
+	}
+	defer func() {
+		_ = lock.Unlock()
+	}()
+
+	// Remove the .installed file first, so the asset is installed again
+	// should its removal be interrupted
+	if err := os.Remove(filepath.Join(assetPath, ".installed")); err != nil {
+		return false, err
+	}
+	return true, os.RemoveAll(assetPath)
+}
+
+// isChecksum returns whether the given name is a SHA-512 checksum.
+func isChecksum(name string) bool {
+	if len(name) != 128 {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
+}
+
+// dirSize returns the total size of the files under the given directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
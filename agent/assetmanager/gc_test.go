@@ -0,0 +1,67 @@
+package assetmanager
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// installFakeAsset creates an installed asset of the given size in the cache
+// directory, last used at the given time, and returns its path.
+func installFakeAsset(t *testing.T, cacheDir string, id string, size int, lastUsed time.Time) string {
+	assetPath := filepath.Join(cacheDir, strings.Repeat(id, 128))
+	require.NoError(t, os.MkdirAll(filepath.Join(assetPath, "bin"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(assetPath, "bin", "cmd"), make([]byte, size), 0700))
+	installed := filepath.Join(assetPath, ".installed")
+	require.NoError(t, ioutil.WriteFile(installed, nil, 0600))
+	require.NoError(t, os.Chtimes(installed, lastUsed, lastUsed))
+	return assetPath
+}
+
+func assertExists(t *testing.T, path string) {
+	_, err := os.Stat(path)
+	assert.NoError(t, err)
+}
+
+func assertNotExists(t *testing.T, path string) {
+	_, err := os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "%s exists", path)
+}
+
+func TestCollectGarbage(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "sensu-assets-gc")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	now := time.Now()
+	expired := installFakeAsset(t, cacheDir, "a", 10, now.Add(-48*time.Hour))
+	leastRecent := installFakeAsset(t, cacheDir, "b", 100, now.Add(-2*time.Hour))
+	mostRecent := installFakeAsset(t, cacheDir, "c", 100, now.Add(-time.Hour))
+
+	// Neither installed assets nor other files are collected
+	pending := filepath.Join(cacheDir, strings.Repeat("d", 128))
+	require.NoError(t, os.MkdirAll(pending, 0700))
+	other := filepath.Join(cacheDir, "events")
+	require.NoError(t, os.MkdirAll(other, 0700))
+
+	// No limits
+	require.NoError(t, CollectGarbage(cacheDir, 0, 0))
+	assertExists(t, expired)
+
+	require.NoError(t, CollectGarbage(cacheDir, 150, 24*time.Hour))
+	assertNotExists(t, expired)
+	assertNotExists(t, leastRecent)
+	assertExists(t, mostRecent)
+	assertExists(t, pending)
+	assertExists(t, other)
+}
+
+func TestCollectGarbageMissingCacheDir(t *testing.T) {
+	assert.NoError(t, CollectGarbage(filepath.Join(os.TempDir(), "sensu-assets-gc-missing"), 1, time.Hour))
+}
@@ -56,6 +56,13 @@ func (mngrPtr *Manager) SetCacheDir(baseDir string) {
 	mngrPtr.store.Clear()
 }
 
+// SetMirror sets the base URL assets are downloaded from, in place of the
+// scheme and host of their own URL, e.g. for air-gapped environments.
+func (mngrPtr *Manager) SetMirror(mirror string) {
+	mngrPtr.factory.Mirror = mirror
+	mngrPtr.store.Clear()
+}
+
 // Reset clears all knownAssets and env from state, this forces the agent to
 // recompute the next time a check is run.
 //
@@ -2,6 +2,7 @@ package assetmanager
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"strings"
 )
@@ -38,6 +39,27 @@ func (setPtr *RuntimeAssetSet) Env() []string {
 	return setPtr.env
 }
 
+// Scripts - returns the contents of the JavaScript files provided by the
+// assets in their lib directory
+func (setPtr *RuntimeAssetSet) Scripts() ([]string, error) {
+	scripts := []string{}
+	for _, asset := range setPtr.assets {
+		paths, err := filepath.Glob(filepath.Join(asset.path, "lib", "*.js"))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			script, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			scripts = append(scripts, string(script))
+		}
+	}
+
+	return scripts, nil
+}
+
 // Injects PATH, LD_LIBRARY_PATH, & CPATH into given ENV representation
 func (setPtr *RuntimeAssetSet) computeEnv(baseEnv []string) {
 	assetPaths := setPtr.paths()
@@ -103,3 +103,19 @@ func TestManagerInstall(t *testing.T) {
 
 	require.NoError(t, test.assetSet.InstallAll())
 }
+
+func TestScripts(t *testing.T) {
+	server, test := newAssetTest(t)
+	defer server.Close()
+	defer test.Dispose(t)
+
+	test.asset.path = filepath.Join(test.cacheDir, "scripts")
+	libDir := filepath.Join(test.asset.path, "lib")
+	require.NoError(t, os.MkdirAll(libDir, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(libDir, "a.js"), []byte("var a = 1;"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(libDir, "b.so"), []byte("binary"), 0644))
+
+	scripts, err := test.assetSet.Scripts()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"var a = 1;"}, scripts)
+}
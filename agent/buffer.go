@@ -0,0 +1,204 @@
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+)
+
+// bufferEntrySuffix is the extension of the files of the buffered messages.
+// Files being written have an additional temporary extension, so partial
+// writes are never replayed.
+const bufferEntrySuffix = ".msg"
+
+// eventBuffer is a bounded queue of messages, persisted on disk so they
+// survive backend outages and agent restarts. Each message is stored in its
+// own file, named after the time it was buffered at so that the files sort in
+// queue order.
+type eventBuffer struct {
+	dir     string
+	maxSize int64
+	maxAge  time.Duration
+
+	mu      sync.Mutex
+	entries []bufferEntry
+	size    int64
+	seq     uint64
+}
+
+// bufferEntry is a message stored in the buffer.
+type bufferEntry struct {
+	name      string
+	size      int64
+	timestamp time.Time
+}
+
+// newEventBuffer returns an event buffer storing its messages in dir, which
+// is created if needed. Messages left in dir, for instance by a previous run
+// of the agent, are queued first. The buffer discards its oldest messages
+// once their total size exceeds maxSize bytes, or once they are older than
+// maxAge, unless those are zero.
+func newEventBuffer(dir string, maxSize int64, maxAge time.Duration) (*eventBuffer, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &eventBuffer{
+		dir:     dir,
+		maxSize: maxSize,
+		maxAge:  maxAge,
+	}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), bufferEntrySuffix) {
+			continue
+		}
+		timestamp, err := parseBufferEntryTime(file.Name())
+		if err != nil {
+			logger.WithError(err).Warning("ignoring invalid buffered message")
+			continue
+		}
+		b.entries = append(b.entries, bufferEntry{
+			name:      file.Name(),
+			size:      file.Size(),
+			timestamp: timestamp,
+		})
+		b.size += file.Size()
+	}
+	sort.Slice(b.entries, func(i, j int) bool {
+		return b.entries[i].name < b.entries[j].name
+	})
+
+	b.prune(time.Now())
+
+	return b, nil
+}
+
+// parseBufferEntryTime returns the time a message was buffered at, given the
+// name of its file.
+func parseBufferEntryTime(name string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(strings.SplitN(name, "-", 2)[0], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid buffered message name %q", name)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// Len returns the number of buffered messages.
+func (b *eventBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.entries)
+}
+
+// Push appends the given message to the buffer, then discards the oldest
+// messages if the buffer exceeds its limits.
+func (b *eventBuffer) Push(msg *transport.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.seq++
+	entry := bufferEntry{
+		name:      fmt.Sprintf("%019d-%010d%s", now.UnixNano(), b.seq, bufferEntrySuffix),
+		size:      int64(len(data)),
+		timestamp: now,
+	}
+
+	path := filepath.Join(b.dir, entry.name)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+
+	b.entries = append(b.entries, entry)
+	b.size += entry.size
+	b.prune(now)
+
+	return nil
+}
+
+// Peek returns the oldest buffered message, without removing it, or nil if
+// the buffer is empty. Expired and unreadable messages are discarded.
+func (b *eventBuffer) Peek() (*transport.Message, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.prune(time.Now())
+	for len(b.entries) > 0 {
+		data, err := ioutil.ReadFile(filepath.Join(b.dir, b.entries[0].name))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		var msg transport.Message
+		if err == nil {
+			if err = json.Unmarshal(data, &msg); err == nil {
+				return &msg, nil
+			}
+		}
+
+		logger.WithError(err).Warning("discarding invalid buffered message")
+		b.remove()
+	}
+
+	return nil, nil
+}
+
+// Pop removes the oldest buffered message.
+func (b *eventBuffer) Pop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) > 0 {
+		b.remove()
+	}
+}
+
+// prune discards the oldest messages while the buffer exceeds its limits.
+// The caller must hold the lock of the buffer.
+func (b *eventBuffer) prune(now time.Time) {
+	discarded := 0
+	for len(b.entries) > 0 {
+		expired := b.maxAge > 0 && now.Sub(b.entries[0].timestamp) > b.maxAge
+		oversized := b.maxSize > 0 && b.size > b.maxSize
+		if !expired && !oversized {
+			break
+		}
+		b.remove()
+		discarded++
+	}
+
+	if discarded > 0 {
+		logger.WithField("discarded", discarded).Warning("discarded buffered messages over the buffer limits")
+	}
+}
+
+// remove deletes the oldest buffered message. The caller must hold the lock
+// of the buffer.
+func (b *eventBuffer) remove() {
+	entry := b.entries[0]
+	if err := os.Remove(filepath.Join(b.dir, entry.name)); err != nil && !os.IsNotExist(err) {
+		logger.WithError(err).Error("error removing buffered message")
+	}
+	b.entries = b.entries[1:]
+	b.size -= entry.size
+}
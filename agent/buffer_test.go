@@ -0,0 +1,152 @@
+package agent
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/mocktransport"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func tempBufferDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "sensu-agent-buffer")
+	require.NoError(t, err)
+	return dir
+}
+
+func TestEventBufferQueue(t *testing.T) {
+	dir := tempBufferDir(t)
+	defer os.RemoveAll(dir)
+
+	b, err := newEventBuffer(dir, 0, 0)
+	require.NoError(t, err)
+
+	msg, err := b.Peek()
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	require.NoError(t, b.Push(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("first")}))
+	require.NoError(t, b.Push(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("second")}))
+	assert.Equal(t, 2, b.Len())
+
+	// The buffered messages survive a restart of the agent
+	b, err = newEventBuffer(dir, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, b.Len())
+
+	msg, err = b.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, transport.MessageTypeEvent, msg.Type)
+	assert.Equal(t, "first", string(msg.Payload))
+	b.Pop()
+
+	msg, err = b.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(msg.Payload))
+	b.Pop()
+
+	assert.Equal(t, 0, b.Len())
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestEventBufferMaxSize(t *testing.T) {
+	dir := tempBufferDir(t)
+	defer os.RemoveAll(dir)
+
+	msg := &transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("event")}
+	b, err := newEventBuffer(dir, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, b.Push(msg))
+	entrySize := b.size
+
+	// Only two messages fit in the buffer
+	b, err = newEventBuffer(dir, 2*entrySize, 0)
+	require.NoError(t, err)
+	require.NoError(t, b.Push(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("event")}))
+	require.NoError(t, b.Push(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("event")}))
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, 2*entrySize, b.size)
+}
+
+func TestEventBufferMaxAge(t *testing.T) {
+	dir := tempBufferDir(t)
+	defer os.RemoveAll(dir)
+
+	b, err := newEventBuffer(dir, 0, time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, b.Push(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("event")}))
+	time.Sleep(10 * time.Millisecond)
+
+	msg, err := b.Peek()
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+	assert.Equal(t, 0, b.Len())
+}
+
+func TestSendBuffersEvents(t *testing.T) {
+	dir := tempBufferDir(t)
+	defer os.RemoveAll(dir)
+
+	buffer, err := newEventBuffer(dir, 0, 0)
+	require.NoError(t, err)
+
+	conn := &mocktransport.MockTransport{}
+	ta := NewAgent(FixtureConfig())
+	ta.buffer = buffer
+	ta.conn = conn
+
+	// The backend is unreachable
+	conn.On("Closed").Return(true).Times(2)
+	ta.send(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("first")})
+	ta.send(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("second")})
+	ta.replayBufferedEvents()
+	assert.Equal(t, 2, buffer.Len())
+
+	// Keepalives are not buffered
+	keepalive := &transport.Message{Type: transport.MessageTypeKeepalive}
+	conn.On("Send", keepalive).Return(transport.ClosedError{Message: "closed"}).Once()
+	ta.send(keepalive)
+	assert.Equal(t, 2, buffer.Len())
+
+	// The backend is reachable again, the buffered events are replayed in order
+	var sent []string
+	conn.On("Closed").Return(false)
+	conn.On("Send", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		sent = append(sent, string(args.Get(0).(*transport.Message).Payload))
+	})
+	ta.send(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("third")})
+	ta.replayBufferedEvents()
+	assert.Equal(t, []string{"first", "second", "third"}, sent)
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func TestFlushBufferedEvents(t *testing.T) {
+	dir := tempBufferDir(t)
+	defer os.RemoveAll(dir)
+
+	buffer, err := newEventBuffer(dir, 0, 0)
+	require.NoError(t, err)
+	for i := 0; i < bufferReplayBatchSize+1; i++ {
+		require.NoError(t, buffer.Push(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("event")}))
+	}
+
+	conn := &mocktransport.MockTransport{}
+	ta := NewAgent(FixtureConfig())
+	ta.buffer = buffer
+	ta.conn = conn
+
+	// The buffered events are all flushed, beyond a single replay batch, until
+	// the backend does not accept them anymore
+	conn.On("Closed").Return(false)
+	conn.On("Send", mock.Anything).Return(nil).Times(bufferReplayBatchSize)
+	conn.On("Send", mock.Anything).Return(transport.ClosedError{Message: "closed"})
+	ta.flushBufferedEvents()
+	assert.Equal(t, 1, buffer.Len())
+}
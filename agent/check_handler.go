@@ -1,20 +1,26 @@
 package agent
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"time"
+	"unicode/utf8"
 
 	"github.com/sensu/sensu-go/agent/transformers"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/dynamic"
+	"github.com/sirupsen/logrus"
 )
 
-// TODO(greg): At some point, we're going to need max parallelism.
 func (a *Agent) handleCheck(payload []byte) error {
 	request := &types.CheckRequest{}
 	if err := json.Unmarshal(payload, request); err != nil {
@@ -23,26 +29,50 @@ func (a *Agent) handleCheck(payload []byte) error {
 		return errors.New("given check configuration appears invalid")
 	}
 
-	// only schedule check execution if its not already in progress
+	// only schedule check execution if its not already in progress, marking it
+	// as in progress right away so concurrent requests of the check are skipped
 	// ** check hooks are part of a checks execution
 	a.inProgressMu.Lock()
 	_, in := a.inProgress[request.Config.Name]
-	a.inProgressMu.Unlock()
 	if !in {
-		logger.Info("scheduling check execution: ", request.Config.Name)
+		a.inProgress[request.Config.Name] = request.Config
+	}
+	a.inProgressMu.Unlock()
+	if in {
+		return fmt.Errorf("check execution still in progress: %s", request.Config.Name)
+	}
 
-		if ok := a.prepareCheck(request.Config); !ok {
-			// An error occured during the preparation of the check and the error has
-			// been sent back to the server. At this point we should not execute the
-			// check and wait for the next check request
-			return nil
-		}
+	logger.Info("scheduling check execution: ", request.Config.Name)
 
-		go a.executeCheck(request)
-	} else {
-		return fmt.Errorf("check execution still in progress: %s", request.Config.Name)
+	if ok := a.prepareCheck(request.Config); !ok {
+		// An error occured during the preparation of the check and the error has
+		// been sent back to the server. At this point we should not execute the
+		// check and wait for the next check request
+		a.inProgressMu.Lock()
+		delete(a.inProgress, request.Config.Name)
+		a.inProgressMu.Unlock()
+		return nil
 	}
 
+	// Checks are not executed anymore once the agent is stopping, and the
+	// checks in progress are waited for
+	a.executionsMu.Lock()
+	defer a.executionsMu.Unlock()
+	select {
+	case <-a.draining:
+		a.inProgressMu.Lock()
+		delete(a.inProgress, request.Config.Name)
+		a.inProgressMu.Unlock()
+		return fmt.Errorf("agent is stopping, check not executed: %s", request.Config.Name)
+	default:
+	}
+	a.executions.Add(1)
+
+	go func() {
+		defer a.executions.Done()
+		a.executeCheck(request)
+	}()
+
 	return nil
 }
 
@@ -56,6 +86,28 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 		a.inProgressMu.Unlock()
 	}()
 
+	// Spread the executions of the subscribed agents over the splay of the
+	// check, each agent waiting the same offset on every execution
+	if offset := splayOffset(a.config.AgentID, request.Config); offset > 0 {
+		timer := time.NewTimer(offset)
+		select {
+		case <-timer.C:
+		case <-a.draining:
+			timer.Stop()
+			return
+		}
+	}
+
+	// Wait for an execution slot if the concurrent check executions are limited
+	if a.executionSlots != nil {
+		select {
+		case a.executionSlots <- struct{}{}:
+			defer func() { <-a.executionSlots }()
+		case <-a.draining:
+			return
+		}
+	}
+
 	checkConfig := request.Config
 	checkAssets := request.Assets
 	checkHooks := request.Hooks
@@ -73,10 +125,13 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 	assets := a.assetManager.RegisterSet(checkAssets)
 
 	// Inject the dependenices into PATH, LD_LIBRARY_PATH & CPATH so that they are
-	// availabe when when the command is executed.
+	// availabe when when the command is executed, along with the secrets of the
+	// check.
+	env := append(assets.Env(), check.EnvVars...)
 	ex := &command.Execution{
-		Env:          append(assets.Env(), check.EnvVars...),
+		Env:          append(env, request.Secrets...),
 		Command:      checkConfig.Command,
+		Args:         checkConfig.CommandArgs,
 		Timeout:      int(checkConfig.Timeout),
 		InProgress:   a.inProgress,
 		InProgressMu: a.inProgressMu,
@@ -91,6 +146,8 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 			return
 		}
 		ex.Input = string(input)
+	} else if checkConfig.StdinPayload != "" {
+		ex.Input = checkConfig.StdinPayload
 	}
 
 	// Ensure that all the dependencies are installed.
@@ -99,20 +156,10 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 		return
 	}
 
-	if _, err := command.ExecuteCommand(context.Background(), ex); err != nil {
-		event.Check.Output = err.Error()
-	} else {
-		event.Check.Output = ex.Output
-	}
-
-	event.Check.Duration = ex.Duration
-	event.Check.Status = uint32(ex.Status)
-
-	event.Entity = a.getAgentEntity()
-	event.Timestamp = time.Now().Unix()
-
-	if len(checkHooks) != 0 {
-		event.Check.Hooks = a.ExecuteHooks(request, ex.Status)
+	// The metrics of the checks with a Prometheus URL are scraped from its
+	// endpoint instead of the output of a command
+	if checkConfig.PrometheusURL != "" && check.OutputMetricFormat == "" {
+		check.OutputMetricFormat = types.PrometheusOutputMetricFormat
 	}
 
 	// Instantiate metrics in the event if the check is attempting to extract metrics
@@ -120,14 +167,37 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 		event.Metrics = &types.Metrics{}
 	}
 
-	if check.OutputMetricFormat != "" {
-		event.Metrics.Points = extractMetrics(event)
+	if checkConfig.PrometheusURL != "" {
+		start := time.Now()
+		body, err := scrapePrometheus(checkConfig.PrometheusURL, checkConfig.Timeout)
+		if err != nil {
+			event.Check.Output = err.Error()
+			event.Check.Status = 2
+		} else {
+			event.Metrics.Points, event.Metrics.ParseErrors = extractMetrics(bytes.NewReader(body), event)
+			event.Check.Output = fmt.Sprintf("%d metric points scraped from %s", len(event.Metrics.Points), checkConfig.PrometheusURL)
+		}
+		event.Check.Duration = time.Since(start).Seconds()
+	} else {
+		executeCommand(ex, event, request.Secrets)
+	}
+
+	event.Entity = a.getAgentEntity()
+	event.Timestamp = time.Now().Unix()
+
+	if len(checkHooks) != 0 {
+		event.Check.Hooks = a.ExecuteHooks(request, event, int(event.Check.Status))
 	}
 
 	if len(check.OutputMetricHandlers) != 0 {
 		event.Metrics.Handlers = check.OutputMetricHandlers
 	}
 
+	// The output is discarded or truncated once its metrics were extracted
+	if check.DiscardOutput {
+		event.Check.Output = ""
+	}
+
 	msg, err := json.Marshal(event)
 	if err != nil {
 		logger.WithError(err).Error("error marshaling check result")
@@ -137,6 +207,97 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 	a.sendMessage(transport.MessageTypeEvent, msg)
 }
 
+// executeCommand executes the command of the check of the given event, and
+// records its result in the event. The output of the command is streamed to
+// the transformer extracting its metrics, if any, and only the part of it kept
+// in the event is buffered. The secrets of the check never leave the agent,
+// even through its output or metrics.
+func executeCommand(ex *command.Execution, event *types.Event, secrets []string) {
+	check := event.Check
+
+	// Enough of the output is kept to redact the secrets the output kept is
+	// truncated in the middle of
+	longestSecret := 0
+	for _, value := range types.EnvVarsToMap(secrets) {
+		if len(value) > longestSecret {
+			longestSecret = len(value)
+		}
+	}
+	if check.MaxOutputSize > 0 {
+		ex.MaxOutputSize = check.MaxOutputSize + int64(longestSecret)
+	}
+
+	var points []*types.MetricPoint
+	var parseErrors uint32
+	var extracted chan struct{}
+	var pw *io.PipeWriter
+	if check.OutputMetricFormat != "" {
+		var pr *io.PipeReader
+		pr, pw = io.Pipe()
+		ex.OutputWriter = pw
+		extracted = make(chan struct{})
+		go func() {
+			defer close(extracted)
+			points, parseErrors = extractMetrics(pr, event)
+			// The command blocks until the whole output is read
+			_, _ = io.Copy(ioutil.Discard, pr)
+		}()
+	}
+
+	_, err := command.ExecuteCommand(context.Background(), ex)
+	if pw != nil {
+		_ = pw.Close()
+		<-extracted
+	}
+
+	var output string
+	if err != nil {
+		output = types.RedactSecrets(err.Error(), secrets)
+	} else {
+		output = types.RedactSecrets(ex.Output, secrets)
+		if ex.MaxOutputSize > 0 && int64(len(ex.Output)) >= ex.MaxOutputSize {
+			// The output may end with the beginning of a secret, which
+			// cannot be redacted
+			output = truncateOutput(output, int64(len(output)-longestSecret))
+		}
+	}
+	if check.MaxOutputSize > 0 {
+		output = truncateOutput(output, check.MaxOutputSize)
+	}
+
+	event.Check.Output = output
+	event.Check.Duration = ex.Duration
+	event.Check.Status = uint32(ex.Status)
+
+	// The metrics of the commands that timed out or could not be started are
+	// discarded, as their output used to be
+	if check.OutputMetricFormat != "" {
+		if err != nil || (ex.Output == command.TimeoutOutput && ex.Status == command.TimeoutExitStatus) {
+			points, parseErrors = nil, 0
+		}
+		redactMetrics(points, secrets)
+		event.Metrics.Points, event.Metrics.ParseErrors = points, parseErrors
+	}
+}
+
+// redactMetrics redacts the given secrets from the names and tags of the given
+// metric points.
+func redactMetrics(points []*types.MetricPoint, secrets []string) {
+	if len(secrets) == 0 {
+		return
+	}
+	for _, point := range points {
+		point.Name = types.RedactSecrets(point.Name, secrets)
+		for i, tag := range point.Tags {
+			tag = &types.MetricTag{
+				Name:  types.RedactSecrets(tag.Name, secrets),
+				Value: types.RedactSecrets(tag.Value, secrets),
+			}
+			point.Tags[i] = tag
+		}
+	}
+}
+
 // prepareCheck prepares a check before its execution by validating the
 // configuration and performing token substitution. A boolean value is returned,
 // indicathing whether the check should be executed or not
@@ -194,28 +355,65 @@ func (a *Agent) sendFailure(event *types.Event, err error) {
 	}
 }
 
-func extractMetrics(event *types.Event) []*types.MetricPoint {
-	var transformer Transformer
-	var err error
-	switch event.Check.OutputMetricFormat {
-	case types.GraphiteOutputMetricFormat:
-		transformer, err = transformers.ParseGraphite(event.Check.Output)
-	case types.InfluxDBOutputMetricFormat:
-		transformer, err = transformers.ParseInflux(event.Check.Output)
-	case types.NagiosOutputMetricFormat:
-		transformer, err = transformers.ParseNagios(event)
-	case types.OpenTSDBOutputMetricFormat:
-		transformer, err = transformers.ParseOpenTSDB(event.Check.Output)
+// extractMetrics extracts the metrics from the check output, read from r, and
+// returns them along with the number of metrics that were dropped because they
+// could not be parsed. The output is parsed line by line so large outputs are
+// not copied.
+func extractMetrics(r io.Reader, event *types.Event) ([]*types.MetricPoint, uint32) {
+	transformer, dropped, err := transformers.ParseReader(r, event)
+	if err != nil {
+		logger.WithError(err).WithField("format", event.Check.OutputMetricFormat).Error("unable to extract metric from check output")
+		return nil, 0
+	}
+	if dropped > 0 {
+		logger.WithFields(logrus.Fields{
+			"check":   event.Check.Name,
+			"format":  event.Check.OutputMetricFormat,
+			"dropped": dropped,
+		}).Warn("dropped metrics that could not be parsed from check output")
 	}
 
-	if err != nil {
-		logger.WithError(err).Error("unable to extract metric from check output")
-		return nil
+	points := transformer.Transform()
+	addMetricTags(points, event.Check.OutputMetricTags)
+
+	return points, uint32(dropped)
+}
+
+// addMetricTags appends the given tags to the tags of every metric point. The
+// tags of a point are copied first since transformers may share them between
+// points
+func addMetricTags(points []*types.MetricPoint, tags []*types.MetricTag) {
+	if len(tags) == 0 {
+		return
 	}
-	if transformer == nil {
-		logger.WithField("format", event.Check.OutputMetricFormat).Error("output metric format is not supported")
-		return nil
+	for _, point := range points {
+		pointTags := make([]*types.MetricTag, 0, len(point.Tags)+len(tags))
+		pointTags = append(pointTags, point.Tags...)
+		point.Tags = append(pointTags, tags...)
 	}
+}
 
-	return transformer.Transform()
+// truncateOutput truncates the given output to at most size bytes, without
+// splitting its last character
+func truncateOutput(output string, size int64) string {
+	if int64(len(output)) <= size {
+		return output
+	}
+	n := int(size)
+	for n > 0 && !utf8.RuneStart(output[n]) {
+		n--
+	}
+	return output[:n]
+}
+
+// splayOffset returns how long the given agent delays the executions of the
+// given check, within its splay. The offset is derived from the agent and the
+// check, so the series of results of each agent stay evenly spaced.
+func splayOffset(agentID string, check *types.CheckConfig) time.Duration {
+	if check.Splay == 0 {
+		return 0
+	}
+	sum := md5.Sum([]byte(agentID + "/" + check.Name))
+	splay := uint64(time.Duration(check.Splay) * time.Second / time.Millisecond)
+	return time.Duration(binary.LittleEndian.Uint64(sum[:])%splay) * time.Millisecond
 }
@@ -31,3 +31,25 @@ func TestEnvVars(t *testing.T) {
 	assert.NotZero(t, event.Timestamp)
 	assert.Equal(t, event.Check.Output, "BAR\n")
 }
+
+func TestSecrets(t *testing.T) {
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = "echo password: $PASSWORD, length: ${#PASSWORD}"
+	request := &types.CheckRequest{
+		Config:  checkConfig,
+		Secrets: []string{"PASSWORD=hunter2"},
+		Issued:  time.Now().Unix(),
+	}
+
+	config := FixtureConfig()
+	agent := NewAgent(config)
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	agent.executeCheck(request)
+	msg := <-ch
+	event := &types.Event{}
+	assert.NoError(t, json.Unmarshal(msg.Payload, event))
+	assert.Equal(t, "password: REDACTED, length: 7\n", event.Check.Output)
+	assert.NotContains(t, string(msg.Payload), "hunter2")
+}
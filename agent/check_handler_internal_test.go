@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -50,6 +53,117 @@ func TestHandleCheck(t *testing.T) {
 	assert.NoError(agent.handleCheck(payload))
 }
 
+func TestHandleCheckStillRunning(t *testing.T) {
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "sleep"), "1")
+	request := &types.CheckRequest{Config: checkConfig, Issued: time.Now().Unix()}
+	payload, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	agent := NewAgent(FixtureConfig())
+	agent.sendq = make(chan *transport.Message, 5)
+
+	// The check is skipped while its first execution is still running
+	assert.NoError(t, agent.handleCheck(payload))
+	assert.Error(t, agent.handleCheck(payload))
+}
+
+func TestStopWaitsForChecks(t *testing.T) {
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "sleep"), "1")
+	request := &types.CheckRequest{Config: checkConfig, Issued: time.Now().Unix()}
+	payload, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	agent := NewAgent(FixtureConfig())
+	ch := make(chan *transport.Message, 5)
+	agent.sendq = ch
+
+	require.NoError(t, agent.handleCheck(payload))
+	agent.Stop()
+
+	// The result of the check in progress was sent before the agent stopped
+	select {
+	case msg := <-ch:
+		assert.Equal(t, transport.MessageTypeEvent, msg.Type)
+	default:
+		assert.FailNow(t, "check in progress was not waited for")
+	}
+
+	// No check is executed once the agent is stopping
+	checkConfig.Name = "other"
+	payload, err = json.Marshal(request)
+	require.NoError(t, err)
+	assert.Error(t, agent.handleCheck(payload))
+}
+
+func TestExecuteCheckMaxConcurrentChecks(t *testing.T) {
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "true"))
+	request := &types.CheckRequest{Config: checkConfig, Issued: time.Now().Unix()}
+
+	config := FixtureConfig()
+	config.MaxConcurrentChecks = 1
+	agent := NewAgent(config)
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	// Another check occupies the only execution slot
+	agent.executionSlots <- struct{}{}
+	go agent.executeCheck(request)
+
+	select {
+	case <-ch:
+		assert.FailNow(t, "check executed beyond the concurrent checks limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	<-agent.executionSlots
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "check not executed once an execution slot was freed")
+	}
+}
+
+func TestExecuteCheckPrometheus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("# TYPE up gauge\nup 1\nrequests_total{code=\"200\"} 42\n"))
+	}))
+	defer server.Close()
+
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = ""
+	checkConfig.PrometheusURL = server.URL + "/metrics"
+	request := &types.CheckRequest{Config: checkConfig, Issued: time.Now().Unix()}
+
+	agent := NewAgent(FixtureConfig())
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	agent.executeCheck(request)
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Equal(t, uint32(0), event.Check.Status)
+	assert.Equal(t, types.PrometheusOutputMetricFormat, event.Check.OutputMetricFormat)
+	require.True(t, event.HasMetrics())
+	require.Len(t, event.Metrics.Points, 2)
+	assert.Equal(t, "up", event.Metrics.Points[0].Name)
+	assert.Equal(t, "requests_total", event.Metrics.Points[1].Name)
+	assert.Equal(t, float64(42), event.Metrics.Points[1].Value)
+
+	// The check fails if the endpoint can't be scraped
+	server.Close()
+	agent.executeCheck(request)
+
+	event = &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Equal(t, uint32(2), event.Check.Status)
+	assert.Contains(t, event.Check.Output, "error scraping prometheus metrics")
+	assert.Empty(t, event.Metrics.Points)
+}
+
 func TestExecuteCheck(t *testing.T) {
 	assert := assert.New(t)
 
@@ -145,6 +259,130 @@ func TestExecuteCheck(t *testing.T) {
 	assert.Equal(int64(987654321), metric1.Timestamp)
 }
 
+func TestExecuteCheckOutputSize(t *testing.T) {
+	metrics := "metric.foo 1 123456789\nmetric.bar 2 987654321"
+	f, err := ioutil.TempFile("", "metric")
+	require.NoError(t, err)
+	_, err = fmt.Fprintln(f, metrics)
+	require.NoError(t, err)
+	f.Close()
+	defer os.Remove(f.Name())
+
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "cat"), f.Name())
+	checkConfig.OutputMetricFormat = types.GraphiteOutputMetricFormat
+	checkConfig.MaxOutputSize = 10
+	request := &types.CheckRequest{Config: checkConfig, Issued: time.Now().Unix()}
+
+	agent := NewAgent(FixtureConfig())
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	// The metrics are extracted from the whole output before it is truncated
+	agent.executeCheck(request)
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Equal(t, "metric.foo", event.Check.Output)
+	require.True(t, event.HasMetrics())
+	assert.Len(t, event.Metrics.Points, 2)
+
+	checkConfig.DiscardOutput = true
+	agent.executeCheck(request)
+
+	event = &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Empty(t, event.Check.Output)
+	require.True(t, event.HasMetrics())
+	assert.Len(t, event.Metrics.Points, 2)
+}
+
+func TestExecuteCheckOutputSizeSecrets(t *testing.T) {
+	metrics := "metric.s3cr3t 1 123456789\nmetric.bar 2 987654321"
+	f, err := ioutil.TempFile("", "metric")
+	require.NoError(t, err)
+	_, err = fmt.Fprintln(f, metrics)
+	require.NoError(t, err)
+	f.Close()
+	defer os.Remove(f.Name())
+
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "cat"), f.Name())
+	checkConfig.OutputMetricFormat = types.GraphiteOutputMetricFormat
+	checkConfig.MaxOutputSize = 10
+	request := &types.CheckRequest{
+		Config:  checkConfig,
+		Issued:  time.Now().Unix(),
+		Secrets: []string{"PASSWORD=s3cr3t"},
+	}
+
+	agent := NewAgent(FixtureConfig())
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	// The output is truncated in the middle of the secret, which is still
+	// redacted, from the metrics too
+	agent.executeCheck(request)
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Equal(t, "metric.RED", event.Check.Output)
+	require.True(t, event.HasMetrics())
+	require.Len(t, event.Metrics.Points, 2)
+	assert.Equal(t, "metric.REDACTED", event.Metrics.Points[0].Name)
+}
+
+func TestExecuteCheckCompression(t *testing.T) {
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "true"))
+	request := &types.CheckRequest{Config: checkConfig, Issued: time.Now().Unix()}
+
+	config := FixtureConfig()
+	config.EventsCompressionThreshold = 1
+	agent := NewAgent(config)
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	agent.executeCheck(request)
+
+	// The compressed event is decompressed when decoded by the backend
+	msg := <-ch
+	assert.Error(t, json.Unmarshal(msg.Payload, &types.Event{}))
+	msgType, payload, err := transport.Decode(transport.Encode(msg.Type, msg.Payload))
+	require.NoError(t, err)
+	assert.Equal(t, transport.MessageTypeEvent, msgType)
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal(payload, event))
+	assert.Equal(t, "check", event.Check.Name)
+}
+
+func TestTruncateOutput(t *testing.T) {
+	assert.Equal(t, "foo", truncateOutput("foo", 10))
+	assert.Equal(t, "foo", truncateOutput("foo", 3))
+	assert.Equal(t, "fo", truncateOutput("foo", 2))
+
+	// Multi-byte characters are not split
+	assert.Equal(t, "caf", truncateOutput("café", 4))
+	assert.Equal(t, "café", truncateOutput("café", 5))
+}
+
+func TestSplayOffset(t *testing.T) {
+	check := types.FixtureCheckConfig("check")
+	assert.Equal(t, time.Duration(0), splayOffset("agent1", check))
+
+	check.Splay = 30
+	offset := splayOffset("agent1", check)
+	assert.True(t, offset >= 0 && offset < 30*time.Second)
+	assert.Equal(t, offset, splayOffset("agent1", check))
+
+	// Agents are spread across the splay
+	offsets := map[time.Duration]struct{}{}
+	for i := 0; i < 10; i++ {
+		offsets[splayOffset(fmt.Sprintf("agent%d", i), check)] = struct{}{}
+	}
+	assert.True(t, len(offsets) > 1)
+}
+
 func TestHandleTokenSubstitution(t *testing.T) {
 	assert := assert.New(t)
 
@@ -211,6 +449,27 @@ func TestHandleTokenSubstitutionNoKey(t *testing.T) {
 	assert.Contains(event.Check.Output, "has no entry for key")
 }
 
+func TestPrepareCheckOutputMetricTags(t *testing.T) {
+	assert := assert.New(t)
+
+	config := FixtureConfig()
+	config.AgentID = "TestPrepareCheckOutputMetricTags"
+	config.ExtendedAttributes = []byte(`{"team":"devops"}`)
+	agent := NewAgent(config)
+
+	check := types.FixtureCheckConfig("check")
+	check.OutputMetricTags = []*types.MetricTag{
+		{Name: "entity", Value: "{{ .ID }}"},
+		{Name: "team", Value: "{{ .Team }}"},
+	}
+
+	assert.True(agent.prepareCheck(check))
+	assert.Equal([]*types.MetricTag{
+		{Name: "entity", Value: "TestPrepareCheckOutputMetricTags"},
+		{Name: "team", Value: "devops"},
+	}, check.OutputMetricTags)
+}
+
 func TestPrepareCheck(t *testing.T) {
 	assert := assert.New(t)
 
@@ -256,10 +515,11 @@ func TestExtractMetrics(t *testing.T) {
 			metricFormat: types.GraphiteOutputMetricFormat,
 			expectedMetrics: []*types.MetricPoint{
 				{
-					Name:      "metric.value",
-					Value:     1,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "metric.value",
+					Value:          1,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 			},
 		},
@@ -284,10 +544,11 @@ func TestExtractMetrics(t *testing.T) {
 			metricFormat: types.NagiosOutputMetricFormat,
 			expectedMetrics: []*types.MetricPoint{
 				{
-					Name:      "percent_packet_loss",
-					Value:     0,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "percent_packet_loss",
+					Value:          0,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 			},
 		},
@@ -301,12 +562,56 @@ func TestExtractMetrics(t *testing.T) {
 			metricFormat:    types.NagiosOutputMetricFormat,
 			expectedMetrics: nil,
 		},
+		{
+			name: "valid auto extraction",
+			event: &types.Event{
+				Check: &types.Check{
+					Output: "sys.cpu.user 1356998400 42.5 host=webserver01",
+				},
+			},
+			metricFormat: types.AutoOutputMetricFormat,
+			expectedMetrics: []*types.MetricPoint{
+				{
+					Name:           "sys.cpu.user",
+					Value:          42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Tags: []*types.MetricTag{
+						{Name: "host", Value: "webserver01"},
+					},
+				},
+			},
+		},
+		{
+			name: "valid extraction with output metric tags",
+			event: &types.Event{
+				Check: &types.Check{
+					Output: "sys.cpu.user 1356998400 42.5 host=webserver01",
+					OutputMetricTags: []*types.MetricTag{
+						{Name: "team", Value: "devops"},
+					},
+				},
+			},
+			metricFormat: types.OpenTSDBOutputMetricFormat,
+			expectedMetrics: []*types.MetricPoint{
+				{
+					Name:           "sys.cpu.user",
+					Value:          42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Tags: []*types.MetricTag{
+						{Name: "host", Value: "webserver01"},
+						{Name: "team", Value: "devops"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.event.Check.OutputMetricFormat = tc.metricFormat
-			metrics := extractMetrics(tc.event)
+			metrics, _ := extractMetrics(strings.NewReader(tc.event.Check.Output), tc.event)
 			assert.Equal(tc.expectedMetrics, metrics)
 		})
 	}
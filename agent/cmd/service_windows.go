@@ -0,0 +1,165 @@
+// +build windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/sensu/sensu-go/agent"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+const (
+	serviceName        = "SensuAgent"
+	serviceDisplayName = "Sensu Agent"
+	serviceDescription = "The monitoring agent for Sensu (https://sensu.io)"
+)
+
+func init() {
+	rootCmd.AddCommand(newServiceCommand())
+}
+
+func newServiceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "service",
+		Short: "manage the sensu agent windows service",
+	}
+
+	cmd.AddCommand(newServiceInstallCommand())
+	cmd.AddCommand(newServiceUninstallCommand())
+	cmd.AddCommand(newAgentCommand("run", "run the sensu agent as a windows service", runService))
+
+	return cmd
+}
+
+func newServiceInstallCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "install",
+		Short:         "install the sensu agent windows service",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exe, err := os.Executable()
+			if err != nil {
+				return err
+			}
+
+			// The service runs the agent with the given config file, or the
+			// default one
+			serviceArgs := []string{"service", "run"}
+			if configFile, _ := cmd.Flags().GetString(flagConfigFile); configFile != "" {
+				configFile, err = filepath.Abs(configFile)
+				if err != nil {
+					return err
+				}
+				serviceArgs = append(serviceArgs, "--"+flagConfigFile, configFile)
+			}
+
+			m, err := mgr.Connect()
+			if err != nil {
+				return err
+			}
+			defer m.Disconnect()
+
+			if s, err := m.OpenService(serviceName); err == nil {
+				s.Close()
+				return fmt.Errorf("service %s is already installed", serviceName)
+			}
+
+			s, err := m.CreateService(serviceName, exe, mgr.Config{
+				DisplayName: serviceDisplayName,
+				Description: serviceDescription,
+				StartType:   mgr.StartAutomatic,
+			}, serviceArgs...)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			fmt.Printf("Installed the %s service\n", serviceName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagConfigFile, "c", "", "path to the sensu-agent config file used by the service")
+
+	return cmd
+}
+
+func newServiceUninstallCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "uninstall",
+		Short:         "uninstall the sensu agent windows service",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := mgr.Connect()
+			if err != nil {
+				return err
+			}
+			defer m.Disconnect()
+
+			s, err := m.OpenService(serviceName)
+			if err != nil {
+				return fmt.Errorf("service %s is not installed", serviceName)
+			}
+			defer s.Close()
+
+			// Stop the service if it is running, so it is deleted right away
+			_, _ = s.Control(svc.Stop)
+
+			if err := s.Delete(); err != nil {
+				return err
+			}
+
+			fmt.Printf("Uninstalled the %s service\n", serviceName)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// runService runs an agent with the given configuration as a Windows service,
+// until the service control manager stops it.
+func runService(cfg *agent.Config) error {
+	return svc.Run(serviceName, &serviceHandler{cfg: cfg})
+}
+
+// serviceHandler handles the requests of the service control manager to the
+// agent service.
+type serviceHandler struct {
+	cfg *agent.Config
+}
+
+// Execute starts the agent and stops it once the service is stopped or the
+// computer shuts down.
+func (h *serviceHandler) Execute(args []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status) (bool, uint32) {
+	status <- svc.Status{State: svc.StartPending}
+
+	sensuAgent, err := startAgent(h.cfg)
+	if err != nil {
+		logger.WithError(err).Error("error starting the agent service")
+		return true, 1
+	}
+
+	status <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+
+	for req := range requests {
+		switch req.Cmd {
+		case svc.Interrogate:
+			status <- req.CurrentStatus
+		case svc.Stop, svc.Shutdown:
+			logger.Info("service stop requested")
+			status <- svc.Status{State: svc.StopPending}
+			sensuAgent.Stop()
+			return false, 0
+		}
+	}
+
+	return false, 0
+}
@@ -11,6 +11,7 @@ import (
 	"syscall"
 
 	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/sensu/sensu-go/util/path"
 	"github.com/sensu/sensu-go/util/url"
@@ -33,15 +34,30 @@ const (
 	flagAgentID               = "id"
 	flagAPIHost               = "api-host"
 	flagAPIPort               = "api-port"
+	flagAssetCacheMaxAge      = "asset-cache-max-age"
+	flagAssetCacheMaxSize     = "asset-cache-max-size"
+	flagAssetMirror           = "asset-mirror"
 	flagBackendURL            = "backend-url"
 	flagCacheDir              = "cache-dir"
+	flagCertFile              = "cert-file"
 	flagConfigFile            = "config-file"
 	flagDeregister            = "deregister"
+	flagDisableCloudDiscovery = "disable-cloud-discovery"
 	flagDeregistrationHandler = "deregistration-handler"
 	flagEnvironment           = "environment"
+	flagEventsBufferDisable   = "events-buffer-disable"
+	flagEventsBufferMaxAge    = "events-buffer-max-age"
+	flagEventsBufferMaxSize   = "events-buffer-max-size"
+	flagEventsCompression     = "events-compression-threshold"
 	flagExtendedAttributes    = "custom-attributes"
+	flagInsecureSkipTLSVerify = "insecure-skip-tls-verify"
 	flagKeepaliveInterval     = "keepalive-interval"
 	flagKeepaliveTimeout      = "keepalive-timeout"
+	flagKeepaliveWarning      = "keepalive-warning-timeout"
+	flagKeepaliveCritical     = "keepalive-critical-timeout"
+	flagKeepaliveHandlers     = "keepalive-handlers"
+	flagKeyFile               = "key-file"
+	flagMaxConcurrentChecks   = "max-concurrent-checks"
 	flagOrganization          = "organization"
 	flagPassword              = "password"
 	flagRedact                = "redact"
@@ -53,6 +69,7 @@ const (
 	flagStatsdMetricsHost     = "statsd-metrics-host"
 	flagStatsdMetricsPort     = "statsd-metrics-port"
 	flagSubscriptions         = "subscriptions"
+	flagTrustedCAFile         = "trusted-ca-file"
 	flagUser                  = "user"
 	flagDisableAPI            = "disable-api"
 	flagDisableSockets        = "disable-sockets"
@@ -97,12 +114,186 @@ func splitAndTrim(s string) []string {
 	return r
 }
 
+// setLogLevel sets the logging level of the agent to the configured one.
+func setLogLevel() error {
+	level, err := logrus.ParseLevel(viper.GetString(flagLogLevel))
+	if err != nil {
+		return err
+	}
+	logrus.SetLevel(level)
+	return nil
+}
+
+// newAgentConfig returns the agent configuration given by the flags, the
+// environment and the config file.
+func newAgentConfig() (*agent.Config, error) {
+	cfg := agent.NewConfig()
+	cfg.API.Host = viper.GetString(flagAPIHost)
+	cfg.API.Port = viper.GetInt(flagAPIPort)
+	cfg.AssetCacheMaxAge = viper.GetInt(flagAssetCacheMaxAge)
+	cfg.AssetCacheMaxSize = viper.GetInt64(flagAssetCacheMaxSize)
+	cfg.AssetMirror = viper.GetString(flagAssetMirror)
+	cfg.CacheDir = viper.GetString(flagCacheDir)
+	cfg.CloudDiscovery = !viper.GetBool(flagDisableCloudDiscovery)
+	cfg.Deregister = viper.GetBool(flagDeregister)
+	cfg.DeregistrationHandler = viper.GetString(flagDeregistrationHandler)
+	cfg.Environment = viper.GetString(flagEnvironment)
+	cfg.EventsBuffer.Disable = viper.GetBool(flagEventsBufferDisable)
+	cfg.EventsBuffer.MaxAge = viper.GetInt(flagEventsBufferMaxAge)
+	cfg.EventsBuffer.MaxSize = viper.GetInt64(flagEventsBufferMaxSize)
+	cfg.EventsCompressionThreshold = viper.GetInt64(flagEventsCompression)
+	cfg.ExtendedAttributes = []byte(viper.GetString(flagExtendedAttributes))
+	cfg.KeepaliveInterval = viper.GetInt(flagKeepaliveInterval)
+	cfg.KeepaliveTimeout = uint32(viper.GetInt(flagKeepaliveTimeout))
+	cfg.KeepaliveWarningTimeout = uint32(viper.GetInt(flagKeepaliveWarning))
+	cfg.KeepaliveCriticalTimeout = uint32(viper.GetInt(flagKeepaliveCritical))
+	cfg.KeepaliveHandlers = viper.GetStringSlice(flagKeepaliveHandlers)
+	cfg.MaxConcurrentChecks = viper.GetInt(flagMaxConcurrentChecks)
+	cfg.Organization = viper.GetString(flagOrganization)
+	cfg.Password = viper.GetString(flagPassword)
+	cfg.Socket.Host = viper.GetString(flagSocketHost)
+	cfg.Socket.Port = viper.GetInt(flagSocketPort)
+	cfg.StatsdServer.Disable = viper.GetBool(flagStatsdDisable)
+	cfg.StatsdServer.FlushInterval = viper.GetInt(flagStatsdFlushInterval)
+	cfg.StatsdServer.Host = viper.GetString(flagStatsdMetricsHost)
+	cfg.StatsdServer.Port = viper.GetInt(flagStatsdMetricsPort)
+	cfg.StatsdServer.Handlers = viper.GetStringSlice(flagStatsdEventHandlers)
+	cfg.User = viper.GetString(flagUser)
+
+	// Connect to the backend over TLS, authenticating with a client
+	// certificate if one is given
+	certFile := viper.GetString(flagCertFile)
+	keyFile := viper.GetString(flagKeyFile)
+	trustedCAFile := viper.GetString(flagTrustedCAFile)
+	insecureSkipTLSVerify := viper.GetBool(flagInsecureSkipTLSVerify)
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("the %s and %s flags must be used together", flagCertFile, flagKeyFile)
+	}
+	if certFile != "" || trustedCAFile != "" || insecureSkipTLSVerify {
+		cfg.TLS = &types.TLSOptions{
+			CertFile:           certFile,
+			KeyFile:            keyFile,
+			TrustedCAFile:      trustedCAFile,
+			InsecureSkipVerify: insecureSkipTLSVerify,
+		}
+	}
+
+	agentID := viper.GetString(flagAgentID)
+	if agentID != "" {
+		cfg.AgentID = agentID
+	}
+
+	for _, backendURL := range viper.GetStringSlice(flagBackendURL) {
+		newURL, err := url.AppendPortIfMissing(backendURL, DefaultBackendPort)
+		if err != nil {
+			return nil, err
+		}
+		cfg.BackendURLs = append(cfg.BackendURLs, newURL)
+	}
+
+	// Get a single or a list of redact fields
+	redact := viper.GetString(flagRedact)
+	if redact != "" {
+		cfg.Redact = splitAndTrim(redact)
+	} else {
+		cfg.Redact = viper.GetStringSlice(flagRedact)
+	}
+
+	// Get a single or a list of subscriptions
+	subscriptions := viper.GetString(flagSubscriptions)
+	if subscriptions != "" {
+		cfg.Subscriptions = splitAndTrim(subscriptions)
+	} else {
+		cfg.Subscriptions = viper.GetStringSlice(flagSubscriptions)
+	}
+
+	return cfg, nil
+}
+
+// startAgent starts an agent with the given configuration, along with its API
+// and socket listeners unless they are disabled.
+func startAgent(cfg *agent.Config) (*agent.Agent, error) {
+	sensuAgent := agent.NewAgent(cfg)
+	if err := sensuAgent.Run(); err != nil {
+		return nil, err
+	}
+
+	if !viper.GetBool(flagDisableAPI) {
+		sensuAgent.StartAPI()
+	}
+
+	if !viper.GetBool(flagDisableSockets) {
+		sensuAgent.StartSocketListeners()
+	}
+
+	return sensuAgent, nil
+}
+
+// runAgent runs an agent with the given configuration until the process
+// receives an interrupt or termination signal. The configuration of the agent
+// is reloaded when the process receives a hangup signal.
+func runAgent(cfg *agent.Config) error {
+	sensuAgent, err := startAgent(cfg)
+	if err != nil {
+		return err
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		for sig := range sigs {
+			logger.Info("signal received: ", sig)
+			if sig == syscall.SIGHUP {
+				if err := reloadAgent(sensuAgent); err != nil {
+					logger.WithError(err).Error("error reloading the agent configuration")
+				}
+				continue
+			}
+			sensuAgent.Stop()
+			return
+		}
+	}()
+
+	wg.Wait()
+	return nil
+}
+
+// reloadAgent reads the config file again and applies it to the given agent.
+// The flags used to start the agent take precedence over the config file.
+func reloadAgent(sensuAgent *agent.Agent) error {
+	if err := viper.ReadInConfig(); err != nil {
+		return err
+	}
+	if err := setLogLevel(); err != nil {
+		return err
+	}
+
+	cfg, err := newAgentConfig()
+	if err != nil {
+		return err
+	}
+	sensuAgent.Reload(cfg)
+
+	return nil
+}
+
 func newStartCommand() *cobra.Command {
+	return newAgentCommand("start", "start the sensu agent", runAgent)
+}
+
+// newAgentCommand returns a command configuring an agent with its flags and
+// config file, and running it with the given function.
+func newAgentCommand(use, short string, run func(*agent.Config) error) *cobra.Command {
 	var setupErr error
 
 	cmd := &cobra.Command{
-		Use:           "start",
-		Short:         "start the sensu agent",
+		Use:           use,
+		Short:         short,
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -112,90 +303,16 @@ func newStartCommand() *cobra.Command {
 			if setupErr != nil {
 				return setupErr
 			}
-			level, err := logrus.ParseLevel(viper.GetString(flagLogLevel))
-			if err != nil {
+			if err := setLogLevel(); err != nil {
 				return err
 			}
-			logrus.SetLevel(level)
-
-			cfg := agent.NewConfig()
-			cfg.API.Host = viper.GetString(flagAPIHost)
-			cfg.API.Port = viper.GetInt(flagAPIPort)
-			cfg.CacheDir = viper.GetString(flagCacheDir)
-			cfg.Deregister = viper.GetBool(flagDeregister)
-			cfg.DeregistrationHandler = viper.GetString(flagDeregistrationHandler)
-			cfg.Environment = viper.GetString(flagEnvironment)
-			cfg.ExtendedAttributes = []byte(viper.GetString(flagExtendedAttributes))
-			cfg.KeepaliveInterval = viper.GetInt(flagKeepaliveInterval)
-			cfg.KeepaliveTimeout = uint32(viper.GetInt(flagKeepaliveTimeout))
-			cfg.Organization = viper.GetString(flagOrganization)
-			cfg.Password = viper.GetString(flagPassword)
-			cfg.Socket.Host = viper.GetString(flagSocketHost)
-			cfg.Socket.Port = viper.GetInt(flagSocketPort)
-			cfg.StatsdServer.Disable = viper.GetBool(flagStatsdDisable)
-			cfg.StatsdServer.FlushInterval = viper.GetInt(flagStatsdFlushInterval)
-			cfg.StatsdServer.Host = viper.GetString(flagStatsdMetricsHost)
-			cfg.StatsdServer.Port = viper.GetInt(flagStatsdMetricsPort)
-			cfg.StatsdServer.Handlers = viper.GetStringSlice(flagStatsdEventHandlers)
-			cfg.User = viper.GetString(flagUser)
-
-			agentID := viper.GetString(flagAgentID)
-			if agentID != "" {
-				cfg.AgentID = agentID
-			}
 
-			for _, backendURL := range viper.GetStringSlice(flagBackendURL) {
-				newURL, err := url.AppendPortIfMissing(backendURL, DefaultBackendPort)
-				if err != nil {
-					return err
-				}
-				cfg.BackendURLs = append(cfg.BackendURLs, newURL)
-			}
-
-			// Get a single or a list of redact fields
-			redact := viper.GetString(flagRedact)
-			if redact != "" {
-				cfg.Redact = splitAndTrim(redact)
-			} else {
-				cfg.Redact = viper.GetStringSlice(flagRedact)
-			}
-
-			// Get a single or a list of subscriptions
-			subscriptions := viper.GetString(flagSubscriptions)
-			if subscriptions != "" {
-				cfg.Subscriptions = splitAndTrim(subscriptions)
-			} else {
-				cfg.Subscriptions = viper.GetStringSlice(flagSubscriptions)
-			}
-
-			sensuAgent := agent.NewAgent(cfg)
-			if err := sensuAgent.Run(); err != nil {
+			cfg, err := newAgentConfig()
+			if err != nil {
 				return err
 			}
 
-			if !viper.GetBool(flagDisableAPI) {
-				sensuAgent.StartAPI()
-			}
-
-			if !viper.GetBool(flagDisableSockets) {
-				sensuAgent.StartSocketListeners()
-			}
-
-			sigs := make(chan os.Signal, 1)
-			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-
-			var wg sync.WaitGroup
-			wg.Add(1)
-
-			go func() {
-				defer wg.Done()
-				sig := <-sigs
-				logger.Info("signal received: ", sig)
-				sensuAgent.Stop()
-			}()
-
-			wg.Wait()
-			return nil
+			return run(cfg)
 		},
 	}
 
@@ -223,12 +340,26 @@ func newStartCommand() *cobra.Command {
 	viper.SetDefault(flagAPIHost, agent.DefaultAPIHost)
 	viper.SetDefault(flagAPIPort, agent.DefaultAPIPort)
 	viper.SetDefault(flagBackendURL, []string{agent.DefaultBackendURL})
+	viper.SetDefault(flagAssetCacheMaxAge, 0)
+	viper.SetDefault(flagAssetCacheMaxSize, 0)
+	viper.SetDefault(flagAssetMirror, "")
 	viper.SetDefault(flagCacheDir, path.SystemCacheDir("sensu-agent"))
+	viper.SetDefault(flagCertFile, "")
 	viper.SetDefault(flagDeregister, false)
 	viper.SetDefault(flagDeregistrationHandler, "")
 	viper.SetDefault(flagEnvironment, agent.DefaultEnvironment)
+	viper.SetDefault(flagEventsBufferDisable, agent.DefaultEventsBufferDisable)
+	viper.SetDefault(flagEventsBufferMaxAge, agent.DefaultEventsBufferMaxAge)
+	viper.SetDefault(flagEventsBufferMaxSize, agent.DefaultEventsBufferMaxSize)
+	viper.SetDefault(flagEventsCompression, 0)
+	viper.SetDefault(flagInsecureSkipTLSVerify, false)
 	viper.SetDefault(flagKeepaliveInterval, agent.DefaultKeepaliveInterval)
 	viper.SetDefault(flagKeepaliveTimeout, agent.DefaultKeepaliveTimeout)
+	viper.SetDefault(flagKeepaliveWarning, 0)
+	viper.SetDefault(flagKeepaliveCritical, 0)
+	viper.SetDefault(flagKeepaliveHandlers, []string{})
+	viper.SetDefault(flagKeyFile, "")
+	viper.SetDefault(flagMaxConcurrentChecks, 0)
 	viper.SetDefault(flagOrganization, agent.DefaultOrganization)
 	viper.SetDefault(flagPassword, agent.DefaultPassword)
 	viper.SetDefault(flagRedact, dynamic.DefaultRedactFields)
@@ -240,9 +371,11 @@ func newStartCommand() *cobra.Command {
 	viper.SetDefault(flagStatsdMetricsPort, agent.DefaultStatsdMetricsPort)
 	viper.SetDefault(flagStatsdEventHandlers, []string{})
 	viper.SetDefault(flagSubscriptions, []string{})
+	viper.SetDefault(flagTrustedCAFile, "")
 	viper.SetDefault(flagUser, agent.DefaultUser)
 	viper.SetDefault(flagDisableAPI, false)
 	viper.SetDefault(flagDisableSockets, false)
+	viper.SetDefault(flagDisableCloudDiscovery, false)
 	viper.SetDefault(flagLogLevel, "warn")
 
 	// Merge in config flag set so that it appears in command usage
@@ -256,10 +389,21 @@ func newStartCommand() *cobra.Command {
 	cmd.Flags().Int(flagSocketPort, viper.GetInt(flagSocketPort), "port the Sensu client socket listens on")
 	cmd.Flags().String(flagAgentID, viper.GetString(flagAgentID), "agent ID (defaults to hostname)")
 	cmd.Flags().String(flagAPIHost, viper.GetString(flagAPIHost), "address to bind the Sensu client HTTP API to")
+	cmd.Flags().Int(flagAssetCacheMaxAge, viper.GetInt(flagAssetCacheMaxAge), "number of seconds after which unused runtime assets are evicted from the cache (disabled if 0)")
+	cmd.Flags().Int64(flagAssetCacheMaxSize, viper.GetInt64(flagAssetCacheMaxSize), "maximum size in bytes of the runtime assets cache, beyond which the least recently used assets are evicted (unlimited if 0)")
+	cmd.Flags().String(flagAssetMirror, viper.GetString(flagAssetMirror), "base URL to download runtime assets from, in place of the scheme and host of their URL")
 	cmd.Flags().String(flagCacheDir, viper.GetString(flagCacheDir), "path to store cached data")
+	cmd.Flags().String(flagCertFile, viper.GetString(flagCertFile), "TLS client certificate used to authenticate the agent with the backend")
 	cmd.Flags().String(flagDeregistrationHandler, viper.GetString(flagDeregistrationHandler), "deregistration handler that should process the entity deregistration event.")
 	cmd.Flags().String(flagEnvironment, viper.GetString(flagEnvironment), "agent environment")
+	cmd.Flags().Bool(flagEventsBufferDisable, viper.GetBool(flagEventsBufferDisable), "disables the disk buffer of the events sent while the backend is unreachable")
+	cmd.Flags().Int(flagEventsBufferMaxAge, viper.GetInt(flagEventsBufferMaxAge), "number of seconds after which buffered events are discarded")
+	cmd.Flags().Int64(flagEventsBufferMaxSize, viper.GetInt64(flagEventsBufferMaxSize), "maximum size in bytes of the events buffer, beyond which the oldest events are discarded")
+	cmd.Flags().Int64(flagEventsCompression, viper.GetInt64(flagEventsCompression), "size in bytes beyond which events are compressed with gzip before being sent to the backend (disabled if 0)")
 	cmd.Flags().String(flagExtendedAttributes, viper.GetString(flagExtendedAttributes), "custom attributes to include in the agent entity")
+	cmd.Flags().Bool(flagInsecureSkipTLSVerify, viper.GetBool(flagInsecureSkipTLSVerify), "skip TLS verification of the backend certificate (not recommended!)")
+	cmd.Flags().String(flagKeyFile, viper.GetString(flagKeyFile), "TLS client certificate key")
+	cmd.Flags().Int(flagMaxConcurrentChecks, viper.GetInt(flagMaxConcurrentChecks), "maximum number of checks executed at once by the agent (unlimited if 0)")
 	cmd.Flags().String(flagOrganization, viper.GetString(flagOrganization), "agent organization")
 	cmd.Flags().String(flagPassword, viper.GetString(flagPassword), "agent password")
 	cmd.Flags().String(flagRedact, viper.GetString(flagRedact), "comma-delimited customized list of fields to redact")
@@ -270,11 +414,16 @@ func newStartCommand() *cobra.Command {
 	cmd.Flags().String(flagStatsdMetricsHost, viper.GetString(flagStatsdMetricsHost), "address used for the statsd metrics server")
 	cmd.Flags().Int(flagStatsdMetricsPort, viper.GetInt(flagStatsdMetricsPort), "port used for the statsd metrics server")
 	cmd.Flags().String(flagSubscriptions, viper.GetString(flagSubscriptions), "comma-delimited list of agent subscriptions")
+	cmd.Flags().String(flagTrustedCAFile, viper.GetString(flagTrustedCAFile), "TLS certificate authority used to verify the backend certificate")
 	cmd.Flags().String(flagUser, viper.GetString(flagUser), "agent user")
 	cmd.Flags().StringSlice(flagBackendURL, viper.GetStringSlice(flagBackendURL), "ws/wss URL of Sensu backend server (to specify multiple backends use this flag multiple times)")
 	cmd.Flags().Uint32(flagKeepaliveTimeout, uint32(viper.GetInt(flagKeepaliveTimeout)), "number of seconds until agent is considered dead by backend")
+	cmd.Flags().Uint32(flagKeepaliveWarning, uint32(viper.GetInt(flagKeepaliveWarning)), "number of seconds until backend creates a warning keepalive event (defaults to keepalive-timeout)")
+	cmd.Flags().Uint32(flagKeepaliveCritical, uint32(viper.GetInt(flagKeepaliveCritical)), "number of seconds until backend creates a critical keepalive event (disabled if 0)")
+	cmd.Flags().StringSlice(flagKeepaliveHandlers, viper.GetStringSlice(flagKeepaliveHandlers), "comma-delimited list of handlers for the keepalive events of the agent")
 	cmd.Flags().Bool(flagDisableAPI, viper.GetBool(flagDisableAPI), "disable the Agent HTTP API")
 	cmd.Flags().Bool(flagDisableSockets, viper.GetBool(flagDisableSockets), "disable the Agent TCP and UDP event sockets")
+	cmd.Flags().Bool(flagDisableCloudDiscovery, viper.GetBool(flagDisableCloudDiscovery), "disable the discovery of the EC2, GCE or Azure instance metadata of the agent entity")
 	cmd.Flags().String(flagLogLevel, viper.GetString(flagLogLevel), "logging level [panic, fatal, error, warn, info, debug]")
 
 	if err := viper.ReadInConfig(); err != nil && configFile != "" {
@@ -11,37 +11,7 @@ import (
 
 func (a *Agent) getAgentEntity() *types.Entity {
 	if a.entity == nil {
-		e := &types.Entity{
-			Class:            types.EntityAgentClass,
-			Deregister:       a.config.Deregister,
-			Environment:      a.config.Environment,
-			ID:               a.config.AgentID,
-			KeepaliveTimeout: a.config.KeepaliveTimeout,
-			LastSeen:         time.Now().Unix(),
-			Organization:     a.config.Organization,
-			Redact:           a.config.Redact,
-			Subscriptions:    a.config.Subscriptions,
-			User:             a.config.User,
-		}
-
-		if a.config.DeregistrationHandler != "" {
-			e.Deregistration = types.Deregistration{
-				Handler: a.config.DeregistrationHandler,
-			}
-		}
-
-		// Set any extended attributes in the entity
-		var attrMap map[string]interface{}
-		err := json.Unmarshal(a.config.ExtendedAttributes, &attrMap)
-		if err != nil {
-			logger.WithError(err)
-		}
-		for k, v := range attrMap {
-			err = dynamic.SetField(e, k, v)
-			if err != nil {
-				logger.WithError(err)
-			}
-		}
+		e := a.newAgentEntity()
 
 		s, err := system.Info()
 		if err == nil {
@@ -54,6 +24,58 @@ func (a *Agent) getAgentEntity() *types.Entity {
 	return a.entity
 }
 
+// newAgentEntity returns the entity of the agent described by its
+// configuration, without its system information.
+func (a *Agent) newAgentEntity() *types.Entity {
+	e := &types.Entity{
+		Class:                    types.EntityAgentClass,
+		Deregister:               a.config.Deregister,
+		Environment:              a.config.Environment,
+		ID:                       a.config.AgentID,
+		KeepaliveCriticalTimeout: a.config.KeepaliveCriticalTimeout,
+		KeepaliveHandlers:        a.config.KeepaliveHandlers,
+		KeepaliveTimeout:         a.config.KeepaliveTimeout,
+		KeepaliveWarningTimeout:  a.config.KeepaliveWarningTimeout,
+		LastSeen:                 time.Now().Unix(),
+		Organization:             a.config.Organization,
+		Redact:                   a.config.Redact,
+		Subscriptions:            a.config.Subscriptions,
+		User:                     a.config.User,
+	}
+
+	if a.config.DeregistrationHandler != "" {
+		e.Deregistration = types.Deregistration{
+			Handler: a.config.DeregistrationHandler,
+		}
+	}
+
+	// Set any extended attributes in the entity
+	var attrMap map[string]interface{}
+	err := json.Unmarshal(a.config.ExtendedAttributes, &attrMap)
+	if err != nil {
+		logger.WithError(err)
+	}
+	for k, v := range attrMap {
+		err = dynamic.SetField(e, k, v)
+		if err != nil {
+			logger.WithError(err)
+		}
+	}
+
+	return e
+}
+
+// discoverCloud adds the metadata of the cloud instance the agent is running
+// on, if any, to the system of its entity.
+func (a *Agent) discoverCloud() {
+	cloud, err := system.CloudInfo()
+	if err != nil {
+		logger.WithError(err).Info("no cloud instance metadata discovered")
+		return
+	}
+	a.getAgentEntity().System.Cloud = cloud
+}
+
 // getEntities receives an event and verifies if we have a proxy entity, so it
 // can be added as the source, and ensures that the event uses the agent's
 // entity
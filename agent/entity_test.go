@@ -3,6 +3,7 @@ package agent
 import (
 	"testing"
 
+	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,7 +51,7 @@ func TestGetAgentEntity(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			entity := tc.agent.getAgentEntity()
 			assert.Equal(tc.expectedAgentID, entity.ID)
-			assert.Equal(tc.extendedAttributes, entity.ExtendedAttributes)
+			assert.Equal(tc.extendedAttributes, entity.GetExtendedAttributes())
 		})
 	}
 }
@@ -97,3 +98,28 @@ func TestGetEntities(t *testing.T) {
 		})
 	}
 }
+
+func TestReload(t *testing.T) {
+	cfg := NewConfig()
+	cfg.AgentID = "foo"
+	cfg.BackendURLs = []string{"ws://127.0.0.1:8081"}
+	cfg.Subscriptions = []string{"linux"}
+	agent := NewAgent(cfg)
+	agent.header = agent.buildTransportHeaderMap()
+	agent.getAgentEntity().System.Cloud = &types.Cloud{Provider: "ec2"}
+
+	newCfg := NewConfig()
+	newCfg.AgentID = "bar"
+	newCfg.BackendURLs = []string{"ws://127.0.0.2:8081"}
+	newCfg.ExtendedAttributes = []byte(`{"team":"ops"}`)
+	newCfg.Subscriptions = []string{"linux", "webserver"}
+	agent.Reload(newCfg)
+
+	entity := agent.getAgentEntity()
+	assert.Equal(t, "foo", entity.ID)
+	assert.Equal(t, []string{"linux", "webserver"}, entity.Subscriptions)
+	assert.Equal(t, "ops", entity.ExtendedAttributes.GetString("team"))
+	assert.Equal(t, "ec2", entity.System.Cloud.GetProvider())
+	assert.Equal(t, "linux,webserver", agent.header.Get(transport.HeaderKeySubscriptions))
+	assert.Equal(t, "ws://127.0.0.2:8081", agent.backendSelector.Select())
+}
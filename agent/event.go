@@ -6,6 +6,7 @@ import (
 
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/v1"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
 )
 
 // prepareEvent accepts a partial or complete event and tries to add any missing
@@ -67,12 +68,19 @@ func translateToEvent(a *Agent, result v1.CheckResult, event *types.Event) error
 		return fmt.Errorf("a check output must be provided")
 	}
 
+	// The source of a 1.x check result designates the proxy client it is
+	// about, and takes precedence over its client
+	entityID := result.Client
+	if result.Source != "" {
+		entityID = result.Source
+	}
+
 	agentEntity := a.getAgentEntity()
-	if result.Client == "" || result.Client == agentEntity.ID {
+	if entityID == "" || entityID == agentEntity.ID {
 		event.Entity = agentEntity
 	} else {
 		event.Entity = &types.Entity{
-			ID:    result.Client,
+			ID:    entityID,
 			Class: types.EntityProxyClass,
 		}
 	}
@@ -87,6 +95,12 @@ func translateToEvent(a *Agent, result v1.CheckResult, event *types.Event) error
 		Executed:      result.Executed,
 		Duration:      result.Duration,
 		Output:        result.Output,
+		Handlers:      result.Handlers,
+		Ttl:           result.TTL,
+		Timeout:       result.Timeout,
+	}
+	if result.Handler != "" && !utilstrings.InArray(result.Handler, check.Handlers) {
+		check.Handlers = append(check.Handlers, result.Handler)
 	}
 	check.SetExtendedAttributes(result.GetExtendedAttributes())
 
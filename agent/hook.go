@@ -13,8 +13,9 @@ import (
 )
 
 // ExecuteHooks executes all hooks contained in a check request based on
-// the check status code of the check request
-func (a *Agent) ExecuteHooks(request *types.CheckRequest, status int) []*types.Hook {
+// the check status code of the check request. Hooks accepting JSON via stdin
+// receive the event of the check execution, to capture its context.
+func (a *Agent) ExecuteHooks(request *types.CheckRequest, event *types.Event, status int) []*types.Hook {
 	executedHooks := []*types.Hook{}
 	for _, hookList := range request.Config.CheckHooks {
 		// find the hookList with the corresponding type
@@ -32,8 +33,9 @@ func (a *Agent) ExecuteHooks(request *types.CheckRequest, status int) []*types.H
 				// code and severity (ex. 0, ok)
 				in := hookInList(hookConfig.Name, executedHooks)
 				if !in {
-					hook := a.executeHook(hookConfig, request.Config.Name)
-					executedHooks = append(executedHooks, hook)
+					if hook := a.executeHook(hookConfig, event); hook != nil {
+						executedHooks = append(executedHooks, hook)
+					}
 				}
 			}
 		}
@@ -41,12 +43,8 @@ func (a *Agent) ExecuteHooks(request *types.CheckRequest, status int) []*types.H
 	return executedHooks
 }
 
-func (a *Agent) executeHook(hookConfig *types.HookConfig, check string) *types.Hook {
-	// Instantiate Event and Hook
-	event := &types.Event{
-		Check: &types.Check{},
-	}
-
+func (a *Agent) executeHook(hookConfig *types.HookConfig, event *types.Event) *types.Hook {
+	// Instantiate Hook
 	hook := &types.Hook{
 		HookConfig: *hookConfig,
 		Executed:   time.Now().Unix(),
@@ -55,10 +53,11 @@ func (a *Agent) executeHook(hookConfig *types.HookConfig, check string) *types.H
 	// Instantiate the execution command
 	ex := &command.Execution{
 		Command:      hookConfig.Command,
+		Args:         hookConfig.CommandArgs,
 		Timeout:      int(hookConfig.Timeout),
 		InProgress:   a.inProgress,
 		InProgressMu: a.inProgressMu,
-		Name:         check,
+		Name:         event.Check.Name,
 	}
 
 	// If stdin is true, add JSON event data to command execution.
@@ -69,6 +68,8 @@ func (a *Agent) executeHook(hookConfig *types.HookConfig, check string) *types.H
 			return nil
 		}
 		ex.Input = string(input)
+	} else if hookConfig.StdinPayload != "" {
+		ex.Input = hookConfig.StdinPayload
 	}
 
 	if _, err := command.ExecuteCommand(context.Background(), ex); err != nil {
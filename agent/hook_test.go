@@ -1,6 +1,7 @@
 package agent
 
 import (
+	"encoding/json"
 	"path/filepath"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExecuteHook(t *testing.T) {
@@ -23,8 +25,9 @@ func TestExecuteHook(t *testing.T) {
 
 	truePath := testutil.CommandPath(filepath.Join(toolsDir, "true"))
 	hookConfig.Command = truePath
+	event := types.FixtureEvent("entity", "check")
 
-	hook := agent.executeHook(hookConfig, "check")
+	hook := agent.executeHook(hookConfig, event)
 
 	assert.NotZero(hook.Executed)
 	assert.Equal(hook.Status, int32(0))
@@ -32,13 +35,66 @@ func TestExecuteHook(t *testing.T) {
 
 	hookConfig.Command = "printf hello"
 
-	hook = agent.executeHook(hookConfig, "check")
+	hook = agent.executeHook(hookConfig, event)
 
 	assert.NotZero(hook.Executed)
 	assert.Equal(hook.Status, int32(0))
 	assert.Equal(hook.Output, "hello")
 }
 
+func TestExecuteHookCommandArgs(t *testing.T) {
+	hookConfig := types.FixtureHookConfig("hook")
+	hookConfig.Command = ""
+	hookConfig.CommandArgs = []string{"printf", "%s", "it's\na 'quoted' \"value\""}
+
+	config := FixtureConfig()
+	agent := NewAgent(config)
+	agent.sendq = make(chan *transport.Message, 1)
+	event := types.FixtureEvent("entity", "check")
+
+	// The arguments are given as is, without a shell
+	hook := agent.executeHook(hookConfig, event)
+	assert.Equal(t, int32(0), hook.Status)
+	assert.Equal(t, "it's\na 'quoted' \"value\"", hook.Output)
+
+	// The payload is written to the standard input of the command
+	hookConfig.CommandArgs = []string{"cat"}
+	hookConfig.StdinPayload = "payload"
+	hook = agent.executeHook(hookConfig, event)
+	assert.Equal(t, int32(0), hook.Status)
+	assert.Equal(t, "payload", hook.Output)
+}
+
+func TestExecuteHooksStdin(t *testing.T) {
+	hookConfig := types.FixtureHookConfig("hook")
+	hookConfig.Stdin = true
+	hookConfig.Command = "cat"
+
+	config := FixtureConfig()
+	agent := NewAgent(config)
+	agent.sendq = make(chan *transport.Message, 1)
+
+	request := types.FixtureCheckRequest("check")
+	request.Config.CheckHooks = []types.HookList{{Type: "critical", Hooks: []string{"hook"}}}
+	request.Hooks = []types.HookConfig{*hookConfig}
+	event := types.FixtureEvent("entity", "check")
+	event.Check.Status = 2
+	event.Check.Output = "CRITICAL: disk full"
+
+	hooks := agent.ExecuteHooks(request, event, 2)
+	require.Len(t, hooks, 1)
+
+	// The hook receives the event of the check execution
+	var received types.Event
+	require.NoError(t, json.Unmarshal([]byte(hooks[0].Output), &received))
+	assert.Equal(t, "check", received.Check.Name)
+	assert.Equal(t, "CRITICAL: disk full", received.Check.Output)
+	assert.Equal(t, "entity", received.Entity.ID)
+
+	// Hooks of other statuses are not executed
+	assert.Empty(t, agent.ExecuteHooks(request, event, 0))
+}
+
 func TestPrepareHook(t *testing.T) {
 	assert := assert.New(t)
 
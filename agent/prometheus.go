@@ -0,0 +1,47 @@
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// defaultPrometheusScrapeTimeout is the timeout of the scrapes of the
+// Prometheus metrics endpoints of the checks without a timeout
+const defaultPrometheusScrapeTimeout = 10 * time.Second
+
+// prometheusAcceptHeader asks Prometheus metrics endpoints for the text
+// exposition format
+const prometheusAcceptHeader = "text/plain;version=0.0.4;q=1,*/*;q=0.1"
+
+// scrapePrometheus returns the metrics exposed by the Prometheus metrics
+// endpoint at the given URL, within the given timeout in seconds.
+func scrapePrometheus(url string, timeout uint32) ([]byte, error) {
+	client := &http.Client{Timeout: defaultPrometheusScrapeTimeout}
+	if timeout > 0 {
+		client.Timeout = time.Duration(timeout) * time.Second
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", prometheusAcceptHeader)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error scraping prometheus metrics: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error scraping prometheus metrics: %s returned status %d", url, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error scraping prometheus metrics: %s", err)
+	}
+	return body, nil
+}
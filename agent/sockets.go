@@ -211,35 +211,37 @@ func (a *Agent) handleUDPMessages(c net.PacketConn) {
 				}
 				return
 			}
-			// If the message is a ping, return without notifying sender.
+			// If the message is a ping, ignore it without notifying sender.
 			if match := pingRe.Match(buf[:bytesRead]); match {
-				return
+				continue
 			}
 
 			// Check the message for valid JSON. Valid JSON payloads are passed to the
 			// message sender with the addition of the agent's entity if it is not
-			// included in the message. Any JSON errors are logged, and we return.
+			// included in the message. Any JSON errors are logged, and the message
+			// is dropped.
 			var event types.Event
 			var result v1.CheckResult
 			if err = json.Unmarshal(buf[:bytesRead], &result); err != nil {
 				logger.WithError(err).Error("UDP Invalid event data")
-				return
+				continue
 			}
 
 			if err = translateToEvent(a, result, &event); err != nil {
 				logger.WithError(err).Error("1.x returns \"invalid\"")
-				return
+				continue
 			}
 
 			// Prepare the event by mutating it as required so it passes validation
 			if err = prepareEvent(a, &event); err != nil {
 				logger.WithError(err).Error("invalid event")
-				return
+				continue
 			}
 
 			payload, err := json.Marshal(event)
 			if err != nil {
-				return
+				logger.WithError(err).Error("could not marshal json payload")
+				continue
 			}
 			a.sendMessage(transport.MessageTypeEvent, payload)
 		}
@@ -222,3 +222,74 @@ func TestReceivePingTCP(t *testing.T) {
 	assert.Equal("pong", string(readData[:numBytes]))
 	ta.Stop()
 }
+
+func TestHandleUDPMessagesAfterInvalidMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := FixtureConfig()
+	// Assign a random port to the socket to avoid overlaps
+	cfg.Socket.Port = 0
+	ta := NewAgent(cfg)
+
+	_, addr, err := ta.createListenSockets()
+	require.NoError(t, err)
+
+	udpClient, err := net.Dial("udp", addr)
+	require.NoError(t, err)
+
+	payload := v1.CheckResult{
+		Name:   "app_01",
+		Output: "could not connect to something",
+	}
+	bytes, _ := json.Marshal(payload)
+
+	// The listener must keep reading once it dropped invalid messages
+	_, err = udpClient.Write([]byte(" ping "))
+	require.NoError(t, err)
+	_, err = udpClient.Write([]byte("{not json"))
+	require.NoError(t, err)
+	_, err = udpClient.Write(bytes)
+	require.NoError(t, err)
+	require.NoError(t, udpClient.Close())
+
+	msg := <-ta.sendq
+	assert.Equal("event", msg.Type)
+
+	var event types.Event
+	require.NoError(t, json.Unmarshal(msg.Payload, &event))
+	assert.Equal("app_01", event.Check.Name)
+	ta.Stop()
+}
+
+func TestHandleTCPMessagesLegacyAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := FixtureConfig()
+	// Assign a random port to the socket to avoid overlaps
+	cfg.Socket.Port = 0
+	ta := NewAgent(cfg)
+
+	addr, _, err := ta.createListenSockets()
+	require.NoError(t, err)
+
+	tcpClient, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+
+	payload := []byte(`{"name":"app_01","output":"disk full","status":2,"source":"db01","handler":"email","handlers":["slack"],"ttl":60,"timeout":10}`)
+	_, err = tcpClient.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, tcpClient.Close())
+
+	msg := <-ta.sendq
+	assert.Equal("event", msg.Type)
+
+	var event types.Event
+	require.NoError(t, json.Unmarshal(msg.Payload, &event))
+	assert.Equal(cfg.AgentID, event.Entity.ID)
+	assert.Equal("db01", event.Check.ProxyEntityID)
+	assert.Equal(uint32(2), event.Check.Status)
+	assert.Equal([]string{"slack", "email"}, event.Check.Handlers)
+	assert.Equal(int64(60), event.Check.Ttl)
+	assert.Equal(uint32(10), event.Check.Timeout)
+	ta.Stop()
+}
@@ -5,10 +5,14 @@
 package agent
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/atlassian/gostatsd"
@@ -70,6 +74,181 @@ func NewServer() *statsd.Server {
 	}
 }
 
+// statsdSocketFactory returns a statsd socket factory listening on both UDP
+// and TCP on the given address. All the readers of the statsd server share the
+// same socket.
+func statsdSocketFactory(addr string) statsd.SocketFactory {
+	conn, err := newStatsdConn(addr)
+	return func() (net.PacketConn, error) {
+		if err != nil {
+			return nil, err
+		}
+		return conn, nil
+	}
+}
+
+// statsdPacket is a statsd datagram, or a line sent over TCP.
+type statsdPacket struct {
+	data []byte
+	addr net.Addr
+}
+
+// statsdConn is a net.PacketConn which reads both the datagrams of a UDP
+// socket and the lines of the connections of a TCP listener, so metrics can be
+// sent to the statsd server over either protocol.
+type statsdConn struct {
+	udp       net.PacketConn
+	tcp       net.Listener
+	packets   chan statsdPacket
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// newStatsdConn listens on the given address with both UDP and TCP.
+func newStatsdConn(addr string) (*statsdConn, error) {
+	udp, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	tcp, err := net.Listen("tcp", addr)
+	if err != nil {
+		_ = udp.Close()
+		return nil, err
+	}
+
+	c := &statsdConn{
+		udp:     udp,
+		tcp:     tcp,
+		packets: make(chan statsdPacket, 100),
+		closed:  make(chan struct{}),
+	}
+	go c.readUDP()
+	go c.acceptTCP()
+	return c, nil
+}
+
+func (c *statsdConn) readUDP() {
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := c.udp.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-c.closed:
+				return
+			default:
+			}
+			logger.WithError(err).Error("error reading statsd UDP socket")
+			continue
+		}
+		data := make([]byte, n)
+		copy(data, buf[:n])
+		if !c.send(statsdPacket{data: data, addr: addr}) {
+			return
+		}
+	}
+}
+
+func (c *statsdConn) acceptTCP() {
+	for {
+		conn, err := c.tcp.Accept()
+		if err != nil {
+			select {
+			case <-c.closed:
+				return
+			default:
+			}
+			logger.WithError(err).Error("error accepting statsd TCP connection")
+			continue
+		}
+		go c.readTCP(conn)
+	}
+}
+
+// readTCP reads the metrics of a TCP connection, one per line, until the
+// connection or the statsdConn is closed.
+func (c *statsdConn) readTCP(conn net.Conn) {
+	defer conn.Close()
+
+	// The statsd server only knows about UDP addresses
+	addr := &net.UDPAddr{}
+	if tcpAddr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		addr.IP = tcpAddr.IP
+		addr.Port = tcpAddr.Port
+	}
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		data := make([]byte, len(line))
+		copy(data, line)
+		if !c.send(statsdPacket{data: data, addr: addr}) {
+			return
+		}
+	}
+}
+
+// send queues the given packet, unless the statsdConn is closed.
+func (c *statsdConn) send(p statsdPacket) bool {
+	select {
+	case c.packets <- p:
+		return true
+	case <-c.closed:
+		return false
+	}
+}
+
+// ReadFrom implements net.PacketConn.
+func (c *statsdConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	select {
+	case p := <-c.packets:
+		return copy(b, p.data), p.addr, nil
+	case <-c.closed:
+		return 0, nil, errors.New("statsd connection closed")
+	}
+}
+
+// WriteTo implements net.PacketConn.
+func (c *statsdConn) WriteTo(b []byte, addr net.Addr) (int, error) {
+	return c.udp.WriteTo(b, addr)
+}
+
+// Close implements net.PacketConn, closing both the UDP socket and the TCP
+// listener.
+func (c *statsdConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		err = c.udp.Close()
+		if tcpErr := c.tcp.Close(); err == nil {
+			err = tcpErr
+		}
+	})
+	return err
+}
+
+// LocalAddr implements net.PacketConn.
+func (c *statsdConn) LocalAddr() net.Addr {
+	return c.udp.LocalAddr()
+}
+
+// SetDeadline implements net.PacketConn.
+func (c *statsdConn) SetDeadline(t time.Time) error {
+	return c.udp.SetDeadline(t)
+}
+
+// SetReadDeadline implements net.PacketConn.
+func (c *statsdConn) SetReadDeadline(t time.Time) error {
+	return c.udp.SetReadDeadline(t)
+}
+
+// SetWriteDeadline implements net.PacketConn.
+func (c *statsdConn) SetWriteDeadline(t time.Time) error {
+	return c.udp.SetWriteDeadline(t)
+}
+
 // BackendName is the name of this statsd backend.
 const BackendName = "sensu-statsd"
 
@@ -161,23 +340,23 @@ func (c Client) sendMetrics(points []*types.MetricPoint) (retErr error) {
 	return nil
 }
 
+// composeMetricTags returns the metric tags of the given statsd tags, given
+// as "name:value" or as "name" for tags without value.
 func composeMetricTags(tagsKey string) []*types.MetricTag {
 	tagsKeys := strings.Split(tagsKey, ",")
 	var tags []*types.MetricTag
-	var name, value string
 	for _, tag := range tagsKeys {
-		tagsValues := strings.Split(tag, ":")
-		if len(tagsValues) > 1 {
-			name = tagsValues[0]
-			value = tagsValues[1]
+		if tag == "" {
+			continue
 		}
-		if tag != "" {
-			t := &types.MetricTag{
-				Name:  name,
-				Value: value,
-			}
-			tags = append(tags, t)
+		t := &types.MetricTag{
+			Name: tag,
+		}
+		if tagsValues := strings.SplitN(tag, ":", 2); len(tagsValues) > 1 {
+			t.Name = tagsValues[0]
+			t.Value = tagsValues[1]
 		}
+		tags = append(tags, t)
 	}
 	return tags
 }
@@ -56,6 +56,21 @@ func TestComposeMetricTags(t *testing.T) {
 				{Name: "aggregator_id", Value: "5"},
 			},
 		},
+		{
+			name:    "Tags without value",
+			tagsKey: "canary,env:prod",
+			metricTag: []*types.MetricTag{
+				{Name: "canary"},
+				{Name: "env", Value: "prod"},
+			},
+		},
+		{
+			name:    "Value with colons",
+			tagsKey: "url:http://localhost:8080",
+			metricTag: []*types.MetricTag{
+				{Name: "url", Value: "http://localhost:8080"},
+			},
+		},
 		{
 			name:      "Empty tagsKey",
 			tagsKey:   "",
@@ -185,6 +200,39 @@ func TestReceiveMetrics(t *testing.T) {
 	}
 }
 
+func TestReceiveMetricsTCP(t *testing.T) {
+	cfg := FixtureConfig()
+	cfg.StatsdServer.FlushInterval = 1
+	cfg.StatsdServer.Port = DefaultStatsdMetricsPort + 1
+	ta := NewAgent(cfg)
+	defer ta.Stop()
+
+	go ta.StartStatsd()
+	// Give the server a second to start up
+	time.Sleep(time.Second * 1)
+
+	tcpClient, err := net.Dial("tcp", ta.statsdServer.MetricsAddr)
+	require.NoError(t, err)
+
+	_, err = tcpClient.Write([]byte("foo:1|c|#env:prod\nbar:2|g\n"))
+	require.NoError(t, err)
+	require.NoError(t, tcpClient.Close())
+
+	msg := <-ta.sendq
+	assert.Equal(t, "event", msg.Type)
+
+	var event types.Event
+	require.NoError(t, json.Unmarshal(msg.Payload, &event))
+	require.NotNil(t, event.Metrics)
+
+	names := map[string]bool{}
+	for _, point := range event.Metrics.Points {
+		names[point.Name] = true
+	}
+	assert.True(t, names["foo.value"])
+	assert.True(t, names["bar.value"])
+}
+
 func FixtureCounter(now int64) gostatsd.Counter {
 	return gostatsd.Counter{
 		PerSecond: 2,
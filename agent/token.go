@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/dynamic"
 )
 
 // TokenSubstitution evaluates the input template, that possibly contains
@@ -66,19 +69,53 @@ func TokenSubstitution(data, input interface{}) ([]byte, error) {
 // funcMap defines the available custom functions in templates
 func funcMap() template.FuncMap {
 	return template.FuncMap{
+		"attr":    attrFunc,
 		"default": defaultFunc,
 	}
 }
 
+// attrFunc returns the value at the given dot-separated path of the provided
+// data, e.g. "aws.region", or nil if there is none, so it can be piped to the
+// default function. The extended attributes of a synthesized entity have
+// capitalized keys, so each key of the path is looked up as given, then
+// capitalized. The extended attributes of a resource are looked up directly.
+func attrFunc(path string, data interface{}) interface{} {
+	if getter, ok := data.(dynamic.AttrGetter); ok {
+		value, _ := types.Attributes(getter.GetExtendedAttributes()).Get(path)
+		return value
+	}
+
+	value := data
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if v, ok := m[key]; ok {
+			value = v
+		} else if v, ok := m[strings.Title(key)]; ok {
+			value = v
+		} else {
+			return nil
+		}
+	}
+	return value
+}
+
 // defaultFunc receives v, a slice of interfaces, which length range between one
 // and two arguments, depending on whether the token has a corresponding field.
 // The first argument always represents the default value, while the optional
 // second argument represent the value of the token if it was properly
-// substitued, in which case we should return that value instead of the default
+// substitued, in which case we should return that value instead of the default.
+// A missing field may also be passed as a nil second argument, in which case
+// the default value is returned as well
 func defaultFunc(v ...interface{}) interface{} {
 	if len(v) == 1 {
 		return v[0]
 	} else if len(v) == 2 {
+		if v[1] == nil {
+			return v[0]
+		}
 		return v[1]
 	}
 	return nil
@@ -86,13 +86,34 @@ func TestTokenSubstitution(t *testing.T) {
 			expectedCommand: "",
 			expectedError:   true,
 		},
+		{
+			name:            "extended attribute path",
+			data:            map[string]interface{}{"ID": "foo", "Aws": map[string]interface{}{"Region": "us-west-2"}},
+			input:           types.CheckConfig{Command: `{{ attr "aws.region" . }}`},
+			expectedCommand: "us-west-2",
+			expectedError:   false,
+		},
+		{
+			name:            "default value for missing extended attribute path",
+			data:            map[string]interface{}{"ID": "foo"},
+			input:           types.CheckConfig{Command: `{{ attr "aws.region" . | default "us-east-1" }}`},
+			expectedCommand: "us-east-1",
+			expectedError:   false,
+		},
+		{
+			name:            "extended attribute path of a resource",
+			data:            &types.Entity{ExtendedAttributes: []byte(`{"aws":{"region":"us-west-2"}}`)},
+			input:           types.CheckConfig{Command: `{{ attr "aws.region" . }}`},
+			expectedCommand: "us-west-2",
+			expectedError:   false,
+		},
 		{
 			name: "multiple tokens and valid json",
 			data: types.FixtureEntity("entity"),
 			input: types.CheckConfig{Command: `{{ .ID }}; {{ "hello" }}; {{ .Class }}`,
 				ProxyRequests: &types.ProxyRequests{EntityAttributes: []string{`entity.Class == \"proxy\"`}},
 			},
-			expectedCommand: "entity; hello; host",
+			expectedCommand: "entity; hello; agent",
 			expectedError:   false,
 		},
 	}
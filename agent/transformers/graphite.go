@@ -2,12 +2,20 @@ package transformers
 
 import (
 	"errors"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 )
 
+func init() {
+	Register(types.GraphiteOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parseGraphite(r, tolerant)
+	})
+}
+
 // GraphiteList contains a list of Graphite values
 type GraphiteList []Graphite
 
@@ -16,6 +24,7 @@ type Graphite struct {
 	Path      string
 	Value     float64
 	Timestamp int64
+	TagSet    []*types.MetricTag
 }
 
 // Transform transforms a metric in graphite plain text format to Sensu Metric
@@ -24,10 +33,11 @@ func (g GraphiteList) Transform() []*types.MetricPoint {
 	var points []*types.MetricPoint
 	for _, graphite := range g {
 		mp := &types.MetricPoint{
-			Name:      graphite.Path,
-			Value:     graphite.Value,
-			Timestamp: graphite.Timestamp,
-			Tags:      []*types.MetricTag{},
+			Name:           graphite.Path,
+			Value:          graphite.Value,
+			Timestamp:      graphite.Timestamp,
+			TimestampNanos: graphite.Timestamp * int64(time.Second),
+			Tags:           append([]*types.MetricTag{}, graphite.TagSet...),
 		}
 		points = append(points, mp)
 	}
@@ -36,31 +46,68 @@ func (g GraphiteList) Transform() []*types.MetricPoint {
 
 // ParseGraphite parses a graphite plain text string into a Graphite struct
 func ParseGraphite(metric string) (GraphiteList, error) {
+	graphites, _, err := parseGraphite(strings.NewReader(metric), false)
+	return graphites, err
+}
+
+// parseGraphite parses graphite plain text, line by line, into a Graphite
+// struct. In tolerant mode, the lines that can't be parsed are skipped and
+// counted
+func parseGraphite(r io.Reader, tolerant bool) (GraphiteList, int, error) {
 	var graphites GraphiteList
-	metric = strings.TrimSpace(metric)
-	lines := strings.Split(metric, "\n")
-	for _, line := range lines {
-		g := Graphite{}
-		args := strings.Split(line, " ")
-		if len(args) != 3 {
-			return GraphiteList{}, errors.New("graphite plain text format requires exactly 3 arguments")
+	dropped, err := parseLines(r, tolerant, func(line string) error {
+		g, err := parseGraphiteLine(line)
+		if err != nil {
+			return err
 		}
+		graphites = append(graphites, g)
+		return nil
+	})
+	if err != nil {
+		return GraphiteList{}, 0, err
+	}
 
-		g.Path = args[0]
+	return graphites, dropped, nil
+}
 
-		f, err := strconv.ParseFloat(args[1], 64)
-		if err != nil {
-			return GraphiteList{}, errors.New("metric value is invalid, second argument must be a float")
-		}
-		g.Value = f
+// parseGraphiteLine parses a single line of graphite plain text
+func parseGraphiteLine(line string) (Graphite, error) {
+	g := Graphite{}
+	args := strings.Split(line, " ")
+	if len(args) != 3 {
+		return g, errors.New("graphite plain text format requires exactly 3 arguments")
+	}
 
-		i, err := strconv.ParseInt(args[2], 10, 64)
-		if err != nil {
-			return GraphiteList{}, errors.New("metric timestamp is invalid, third argument must be an int")
+	// Graphite 1.1 tags are appended to the path using the
+	// metric.path;tag1=value1;tag2=value2 syntax
+	pathTags := strings.Split(args[0], ";")
+	g.Path = pathTags[0]
+	if g.Path == "" {
+		return g, errors.New("metric path is invalid, first argument must not be empty")
+	}
+	for _, tagSet := range pathTags[1:] {
+		ts := strings.SplitN(tagSet, "=", 2)
+		if len(ts) != 2 || ts[0] == "" || ts[1] == "" {
+			return g, errors.New("metric tag set is invalid, must contain a tag=value pair")
 		}
-		g.Timestamp = i
-		graphites = append(graphites, g)
+		tag := &types.MetricTag{
+			Name:  ts[0],
+			Value: ts[1],
+		}
+		g.TagSet = append(g.TagSet, tag)
+	}
+
+	f, err := strconv.ParseFloat(args[1], 64)
+	if err != nil {
+		return g, errors.New("metric value is invalid, second argument must be a float")
+	}
+	g.Value = f
+
+	i, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return g, errors.New("metric timestamp is invalid, third argument must be an int")
 	}
+	g.Timestamp = i
 
-	return graphites, nil
+	return g, nil
 }
@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -73,6 +74,36 @@ func TestParseGraphite(t *testing.T) {
 			expectedFormat: GraphiteList{},
 			expectedErr:    true,
 		},
+		{
+			metric: "metric.value;dc=us-east-1;role=web 1 123456789",
+			expectedFormat: GraphiteList{
+				{
+					Path:      "metric.value",
+					Value:     1,
+					Timestamp: 123456789,
+					TagSet: []*types.MetricTag{
+						{Name: "dc", Value: "us-east-1"},
+						{Name: "role", Value: "web"},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			metric:         "metric.value;dc 1 123456789",
+			expectedFormat: GraphiteList{},
+			expectedErr:    true,
+		},
+		{
+			metric:         "metric.value;=us-east-1 1 123456789",
+			expectedFormat: GraphiteList{},
+			expectedErr:    true,
+		},
+		{
+			metric:         ";dc=us-east-1 1 123456789",
+			expectedFormat: GraphiteList{},
+			expectedErr:    true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -105,10 +136,11 @@ func TestTransformGraphite(t *testing.T) {
 			},
 			expectedFormat: []*types.MetricPoint{
 				{
-					Name:      "metric.value",
-					Value:     1,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "metric.value",
+					Value:          1,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 			},
 		},
@@ -150,10 +182,11 @@ func TestTransformGraphite(t *testing.T) {
 					Tags:      []*types.MetricTag{},
 				},
 				{
-					Name:      "metric.value",
-					Value:     1,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "metric.value",
+					Value:          1,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 			},
 		},
@@ -196,10 +229,11 @@ func TestParseAndTransformGraphite(t *testing.T) {
 			metric: "metric.value 1 123456789",
 			expectedFormat: []*types.MetricPoint{
 				{
-					Name:      "metric.value",
-					Value:     1,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "metric.value",
+					Value:          1,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 			},
 			expectedErr: false,
@@ -220,10 +254,11 @@ func TestParseAndTransformGraphite(t *testing.T) {
 			metric: "metric.value 1 123456789\nmetric.value 0 0",
 			expectedFormat: []*types.MetricPoint{
 				{
-					Name:      "metric.value",
-					Value:     1,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "metric.value",
+					Value:          1,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 				{
 					Name:      "metric.value",
@@ -250,6 +285,21 @@ func TestParseAndTransformGraphite(t *testing.T) {
 			metric:      "metric.value 1 noon",
 			expectedErr: true,
 		},
+		{
+			metric: "metric.value;dc=us-east-1 1 123456789",
+			expectedFormat: []*types.MetricPoint{
+				{
+					Name:           "metric.value",
+					Value:          1,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags: []*types.MetricTag{
+						{Name: "dc", Value: "us-east-1"},
+					},
+				},
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,21 +2,30 @@ package transformers
 
 import (
 	"errors"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 )
 
+func init() {
+	Register(types.InfluxDBOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parseInflux(r, tolerant)
+	})
+}
+
 // InfluxList contains a list of Influx values
 type InfluxList []Influx
 
 // Influx contains values of influx db line output metric format
 type Influx struct {
-	Measurement string
-	TagSet      []*types.MetricTag
-	FieldSet    []*Field
-	Timestamp   int64
+	Measurement    string
+	TagSet         []*types.MetricTag
+	FieldSet       []*Field
+	Timestamp      int64
+	TimestampNanos int64
 }
 
 // Transform transforms a metric in influx db line protocol to Sensu Metric
@@ -26,10 +35,11 @@ func (i InfluxList) Transform() []*types.MetricPoint {
 	for _, influx := range i {
 		for _, fieldSet := range influx.FieldSet {
 			mp := &types.MetricPoint{
-				Name:      influx.Measurement + "." + fieldSet.Key,
-				Value:     fieldSet.Value,
-				Timestamp: influx.Timestamp,
-				Tags:      influx.TagSet,
+				Name:           influx.Measurement + "." + fieldSet.Key,
+				Value:          fieldSet.Value,
+				Timestamp:      influx.Timestamp,
+				TimestampNanos: influx.TimestampNanos,
+				Tags:           influx.TagSet,
 			}
 			points = append(points, mp)
 		}
@@ -39,68 +49,131 @@ func (i InfluxList) Transform() []*types.MetricPoint {
 
 // ParseInflux parses an influx db line protocol string into an Influx struct
 func ParseInflux(metric string) (InfluxList, error) {
+	influxList, _, err := parseInflux(strings.NewReader(metric), false)
+	return influxList, err
+}
+
+// parseInflux parses influx db line protocol, line by line, into an Influx
+// struct. In tolerant mode, the lines that can't be parsed are skipped and
+// counted
+func parseInflux(r io.Reader, tolerant bool) (InfluxList, int, error) {
 	var influxList InfluxList
-	metric = strings.TrimSpace(metric)
-	lines := strings.Split(metric, "\n")
-	for _, line := range lines {
-		i := Influx{}
-		args := strings.Split(line, " ")
-		if len(args) != 3 {
-			return InfluxList{}, errors.New("influxdb line format requires exactly 3 arguments")
+	dropped, err := parseLines(r, tolerant, func(line string) error {
+		i, err := parseInfluxLine(line)
+		if err != nil {
+			return err
 		}
+		influxList = append(influxList, i)
+		return nil
+	})
+	if err != nil {
+		return InfluxList{}, 0, err
+	}
 
-		measurementTag := strings.Split(args[0], ",")
-		i.Measurement = measurementTag[0]
-		tagList := []*types.MetricTag{}
-		if len(measurementTag) == 1 {
-			i.TagSet = tagList
-		} else {
-			for i, tagSet := range measurementTag {
-				if i != 0 {
-					ts := strings.Split(tagSet, "=")
-					if len(ts) != 2 {
-						return InfluxList{}, errors.New("metric tag set is invalid, must contain a key=value pair")
-					}
-					tag := &types.MetricTag{
-						Name:  ts[0],
-						Value: ts[1],
-					}
-					tagList = append(tagList, tag)
-				}
-			}
-			i.TagSet = tagList
+	return influxList, dropped, nil
+}
+
+// parseInfluxLine parses a single line of influx db line protocol
+func parseInfluxLine(line string) (Influx, error) {
+	i := Influx{}
+	args := strings.Split(line, " ")
+	if len(args) != 3 {
+		return i, errors.New("influxdb line format requires exactly 3 arguments")
+	}
+
+	measurementTag := strings.Split(args[0], ",")
+	i.Measurement = measurementTag[0]
+	tagList := []*types.MetricTag{}
+	for _, tagSet := range measurementTag[1:] {
+		ts := strings.Split(tagSet, "=")
+		if len(ts) != 2 {
+			return i, errors.New("metric tag set is invalid, must contain a key=value pair")
+		}
+		tag := &types.MetricTag{
+			Name:  ts[0],
+			Value: ts[1],
 		}
+		tagList = append(tagList, tag)
+	}
+	i.TagSet = tagList
 
-		fieldSets := strings.Split(args[1], ",")
-		fieldList := []*Field{}
-		for _, fieldSet := range fieldSets {
-			fs := strings.Split(fieldSet, "=")
-			if len(fs) != 2 {
-				return InfluxList{}, errors.New("metric field set is invalid, must contain a key=value pair")
-			}
-			f, err := strconv.ParseFloat(fs[1], 64)
-			if err != nil {
-				return InfluxList{}, errors.New("metric field value is invalid, must be a float")
-			}
-			field := &Field{
-				Key:   fs[0],
-				Value: f,
-			}
-			fieldList = append(fieldList, field)
+	fieldSets := strings.Split(args[1], ",")
+	fieldList := []*Field{}
+	for _, fieldSet := range fieldSets {
+		fs := strings.Split(fieldSet, "=")
+		if len(fs) != 2 {
+			return i, errors.New("metric field set is invalid, must contain a key=value pair")
+		}
+		f, err := parseInfluxFieldValue(fs[1])
+		if err != nil {
+			return i, err
 		}
-		i.FieldSet = fieldList
+		field := &Field{
+			Key:   fs[0],
+			Value: f,
+		}
+		fieldList = append(fieldList, field)
+	}
+	i.FieldSet = fieldList
+
+	timestamp := args[2]
+	if len(timestamp) > 10 {
+		timestamp = timestamp[:10]
+	}
+	t, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return i, errors.New("metric timestamp is invalid, third argument must be an int")
+	}
+	i.Timestamp = t
 
-		timestamp := args[2]
-		if len(timestamp) > 10 {
-			timestamp = timestamp[:10]
+	// Keep the sub-second precision of the timestamp, which is expected in
+	// nanoseconds but may also be provided in microseconds or milliseconds
+	nanos := args[2]
+	if len(nanos) > 10 {
+		if len(nanos) < 19 {
+			nanos += strings.Repeat("0", 19-len(nanos))
 		}
-		t, err := strconv.ParseInt(timestamp, 10, 64)
+		n, err := strconv.ParseInt(nanos, 10, 64)
 		if err != nil {
-			return InfluxList{}, errors.New("metric timestamp is invalid, third argument must be an int")
+			return i, errors.New("metric timestamp is invalid, third argument must be an int")
 		}
-		i.Timestamp = t
-		influxList = append(influxList, i)
+		i.TimestampNanos = n
+	} else {
+		i.TimestampNanos = t * int64(time.Second)
+	}
+
+	return i, nil
+}
+
+// parseInfluxFieldValue parses the value of a field set, which can either be a
+// float, an integer (e.g. 12i), an unsigned integer (e.g. 12u) or a boolean
+func parseInfluxFieldValue(value string) (float64, error) {
+	switch value {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, nil
+	case "f", "F", "false", "False", "FALSE":
+		return 0, nil
 	}
 
-	return influxList, nil
+	if strings.HasSuffix(value, "i") {
+		i, err := strconv.ParseInt(strings.TrimSuffix(value, "i"), 10, 64)
+		if err != nil {
+			return 0, errors.New("metric field value is invalid, must be an integer")
+		}
+		return float64(i), nil
+	}
+
+	if strings.HasSuffix(value, "u") {
+		u, err := strconv.ParseUint(strings.TrimSuffix(value, "u"), 10, 64)
+		if err != nil {
+			return 0, errors.New("metric field value is invalid, must be an unsigned integer")
+		}
+		return float64(u), nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, errors.New("metric field value is invalid, must be a float")
+	}
+	return f, nil
 }
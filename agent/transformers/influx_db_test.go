@@ -40,7 +40,8 @@ func TestParseInflux(t *testing.T) {
 							Value: 30,
 						},
 					},
-					Timestamp: 1465839830,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 				},
 			},
 			expectedErr: false,
@@ -70,7 +71,8 @@ func TestParseInflux(t *testing.T) {
 							Value: 30,
 						},
 					},
-					Timestamp: 1465839830,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 				},
 				{
 					Measurement: "weather",
@@ -81,7 +83,8 @@ func TestParseInflux(t *testing.T) {
 							Value: 82,
 						},
 					},
-					Timestamp: 1465839830,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 				},
 			},
 			expectedErr: false,
@@ -98,7 +101,26 @@ func TestParseInflux(t *testing.T) {
 							Value: 82,
 						},
 					},
-					Timestamp: 1465839830,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			metric: "weather temperature=82 1465839830100400",
+			expectedFormat: InfluxList{
+				{
+					Measurement: "weather",
+					TagSet:      []*types.MetricTag{},
+					FieldSet: []*Field{
+						{
+							Key:   "temperature",
+							Value: 82,
+						},
+					},
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400000,
 				},
 			},
 			expectedErr: false,
@@ -169,14 +191,16 @@ func TestTransformInflux(t *testing.T) {
 							Value: 30,
 						},
 					},
-					Timestamp: 1465839830,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 				},
 			},
 			expectedFormat: []*types.MetricPoint{
 				{
-					Name:      "weather.temperature",
-					Value:     82,
-					Timestamp: 1465839830,
+					Name:           "weather.temperature",
+					Value:          82,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 					Tags: []*types.MetricTag{
 						{
 							Name:  "location",
@@ -189,9 +213,10 @@ func TestTransformInflux(t *testing.T) {
 					},
 				},
 				{
-					Name:      "weather.humidity",
-					Value:     30,
-					Timestamp: 1465839830,
+					Name:           "weather.humidity",
+					Value:          30,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 					Tags: []*types.MetricTag{
 						{
 							Name:  "location",
@@ -238,9 +263,10 @@ func TestParseAndTransformInflux(t *testing.T) {
 			metric: "weather,location=us-midwest,season=summer temperature=82,humidity=30 1465839830100400200",
 			expectedFormat: []*types.MetricPoint{
 				{
-					Name:      "weather.temperature",
-					Value:     82,
-					Timestamp: 1465839830,
+					Name:           "weather.temperature",
+					Value:          82,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 					Tags: []*types.MetricTag{
 						{
 							Name:  "location",
@@ -253,9 +279,10 @@ func TestParseAndTransformInflux(t *testing.T) {
 					},
 				},
 				{
-					Name:      "weather.humidity",
-					Value:     30,
-					Timestamp: 1465839830,
+					Name:           "weather.humidity",
+					Value:          30,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 					Tags: []*types.MetricTag{
 						{
 							Name:  "location",
@@ -274,9 +301,10 @@ func TestParseAndTransformInflux(t *testing.T) {
 			metric: "weather,location=us-midwest,season=summer temperature=82 1465839830100400200\nweather,location=us-midwest,season=summer humidity=30 1465839830100400200",
 			expectedFormat: []*types.MetricPoint{
 				{
-					Name:      "weather.temperature",
-					Value:     82,
-					Timestamp: 1465839830,
+					Name:           "weather.temperature",
+					Value:          82,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 					Tags: []*types.MetricTag{
 						{
 							Name:  "location",
@@ -289,9 +317,10 @@ func TestParseAndTransformInflux(t *testing.T) {
 					},
 				},
 				{
-					Name:      "weather.humidity",
-					Value:     30,
-					Timestamp: 1465839830,
+					Name:           "weather.humidity",
+					Value:          30,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
 					Tags: []*types.MetricTag{
 						{
 							Name:  "location",
@@ -334,6 +363,41 @@ func TestParseAndTransformInflux(t *testing.T) {
 			metric:      "foo bar baz",
 			expectedErr: true,
 		},
+		{
+			metric: "disk free=42i,total=100u,healthy=t 1465839830100400200",
+			expectedFormat: []*types.MetricPoint{
+				{
+					Name:           "disk.free",
+					Value:          42,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
+					Tags:           []*types.MetricTag{},
+				},
+				{
+					Name:           "disk.total",
+					Value:          100,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
+					Tags:           []*types.MetricTag{},
+				},
+				{
+					Name:           "disk.healthy",
+					Value:          1,
+					Timestamp:      1465839830,
+					TimestampNanos: 1465839830100400200,
+					Tags:           []*types.MetricTag{},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			metric:      "disk free=42x 1465839830100400200",
+			expectedErr: true,
+		},
+		{
+			metric:      "disk free=-42u 1465839830100400200",
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
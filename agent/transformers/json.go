@@ -0,0 +1,245 @@
+package transformers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+func init() {
+	Register(types.JSONOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parseJSON(r, event.Check.OutputMetricMapping, event.Check.Executed, tolerant)
+	})
+}
+
+// The fields used when the check doesn't provide a metric mapping
+const (
+	defaultJSONNameField      = "name"
+	defaultJSONValueField     = "value"
+	defaultJSONTimestampField = "timestamp"
+)
+
+// JSONList contains a list of JSON metrics
+type JSONList []JSON
+
+// JSON contains the values of a metric extracted from a JSON object
+type JSON struct {
+	Name           string
+	Value          float64
+	TagSet         []*types.MetricTag
+	Timestamp      int64
+	TimestampNanos int64
+}
+
+// Transform transforms metrics extracted from JSON objects to Sensu Metric
+// Format
+func (j JSONList) Transform() []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, metric := range j {
+		mp := &types.MetricPoint{
+			Name:           metric.Name,
+			Value:          metric.Value,
+			Timestamp:      metric.Timestamp,
+			TimestampNanos: metric.TimestampNanos,
+			Tags:           append([]*types.MetricTag{}, metric.TagSet...),
+		}
+		points = append(points, mp)
+	}
+	return points
+}
+
+// ParseJSON parses a JSON array of objects into a list of JSON metrics, using
+// the metric mapping of the check to find the fields of each metric
+func ParseJSON(event *types.Event) (JSONList, error) {
+	if !event.HasCheck() {
+		return nil, errors.New("event must contain a check to parse and extract metrics")
+	}
+
+	jsonList, _, err := parseJSON(strings.NewReader(event.Check.Output), event.Check.OutputMetricMapping, event.Check.Executed, false)
+	return jsonList, err
+}
+
+// parseJSON decodes the objects of a JSON array one at a time and extracts a
+// metric from each of them. The timestamp is used for the objects without a
+// timestamp field. In tolerant mode, the objects that can't be mapped to a
+// metric are skipped and counted, but the array must be valid JSON
+func parseJSON(r io.Reader, mapping *types.MetricMapping, timestamp int64, tolerant bool) (JSONList, int, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	if t, err := dec.Token(); err != nil {
+		return nil, 0, fmt.Errorf("invalid json metrics: %s", err)
+	} else if delim, ok := t.(json.Delim); !ok || delim != '[' {
+		return nil, 0, errors.New("invalid json metrics, an array of objects is required")
+	}
+
+	jsonList := JSONList{}
+	var dropped int
+	for dec.More() {
+		var object map[string]interface{}
+		if err := dec.Decode(&object); err != nil {
+			return nil, 0, fmt.Errorf("invalid json metric: %s", err)
+		}
+
+		j, err := parseJSONObject(object, mapping, timestamp)
+		if err != nil {
+			if !tolerant {
+				return nil, 0, err
+			}
+			dropped++
+			continue
+		}
+		jsonList = append(jsonList, j)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, 0, fmt.Errorf("invalid json metrics: %s", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, 0, errors.New("invalid json metrics, unexpected data after the array")
+	}
+
+	return jsonList, dropped, nil
+}
+
+// parseJSONObject extracts a metric from a JSON object
+func parseJSONObject(object map[string]interface{}, mapping *types.MetricMapping, timestamp int64) (JSON, error) {
+	nameField, valueField, timestampField := defaultJSONNameField, defaultJSONValueField, defaultJSONTimestampField
+	var tagFields []string
+	if mapping != nil {
+		if mapping.NameField != "" {
+			nameField = mapping.NameField
+		}
+		if mapping.ValueField != "" {
+			valueField = mapping.ValueField
+		}
+		if mapping.TimestampField != "" {
+			timestampField = mapping.TimestampField
+		}
+		tagFields = mapping.TagFields
+	}
+
+	name, ok := lookupJSONField(object, nameField)
+	if !ok {
+		return JSON{}, fmt.Errorf("invalid json metric, the %q name field is missing", nameField)
+	}
+	j := JSON{
+		Name:           formatJSONValue(name),
+		TagSet:         []*types.MetricTag{},
+		Timestamp:      timestamp,
+		TimestampNanos: timestamp * int64(time.Second),
+	}
+	if j.Name == "" {
+		return JSON{}, fmt.Errorf("invalid json metric, the %q name field is empty", nameField)
+	}
+
+	value, ok := lookupJSONField(object, valueField)
+	if !ok {
+		return JSON{}, fmt.Errorf("invalid json metric, the %q value field is missing", valueField)
+	}
+	var err error
+	if j.Value, err = parseJSONValue(value); err != nil {
+		return JSON{}, err
+	}
+
+	if ts, ok := lookupJSONField(object, timestampField); ok {
+		if j.Timestamp, j.TimestampNanos, err = parseJSONTimestamp(ts); err != nil {
+			return JSON{}, err
+		}
+	}
+
+	for _, field := range tagFields {
+		if value, ok := lookupJSONField(object, field); ok {
+			j.TagSet = append(j.TagSet, &types.MetricTag{
+				Name:  field,
+				Value: formatJSONValue(value),
+			})
+		}
+	}
+
+	return j, nil
+}
+
+// lookupJSONField returns the value of a field, where the fields of nested
+// objects are separated by dots (e.g. disk.free)
+func lookupJSONField(object map[string]interface{}, field string) (interface{}, bool) {
+	if value, ok := object[field]; ok {
+		return value, value != nil
+	}
+
+	parts := strings.SplitN(field, ".", 2)
+	if len(parts) != 2 {
+		return nil, false
+	}
+	nested, ok := object[parts[0]].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	return lookupJSONField(nested, parts[1])
+}
+
+// parseJSONValue parses a metric value, which can either be a number, a numeric
+// string or a boolean
+func parseJSONValue(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid json metric value, must be a number: %s", v)
+		}
+		return f, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("invalid json metric value, must be a number: %v", v)
+	}
+}
+
+// parseJSONTimestamp parses a metric timestamp, which can either be a unix
+// timestamp in seconds or milliseconds, or a RFC 3339 string, and returns it
+// with second and nanosecond resolutions
+func parseJSONTimestamp(value interface{}) (int64, int64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		i, err := strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid json metric timestamp, must be an integer: %s", v)
+		}
+		if len(v.String()) == 13 {
+			return i / 1000, i * int64(time.Millisecond), nil
+		}
+		return i, i * int64(time.Second), nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid json metric timestamp, must be a RFC 3339 date: %s", v)
+		}
+		return t.Unix(), t.UnixNano(), nil
+	default:
+		return 0, 0, fmt.Errorf("invalid json metric timestamp: %v", v)
+	}
+}
+
+// formatJSONValue returns the string representation of a JSON value
+func formatJSONValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
@@ -0,0 +1,177 @@
+package transformers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSON(t *testing.T) {
+	testCases := []struct {
+		name    string
+		output  string
+		mapping *types.MetricMapping
+		want    JSONList
+		wantErr bool
+	}{
+		{
+			name:   "default fields",
+			output: `[{"name": "disk.free", "value": 42, "timestamp": 1356998400}]`,
+			want: JSONList{
+				{
+					Name:           "disk.free",
+					Value:          42,
+					TagSet:         []*types.MetricTag{},
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+				},
+			},
+		},
+		{
+			name:   "missing timestamp",
+			output: `[{"name": "disk.free", "value": "42.5"}]`,
+			want: JSONList{
+				{
+					Name:           "disk.free",
+					Value:          42.5,
+					TagSet:         []*types.MetricTag{},
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+				},
+			},
+		},
+		{
+			name: "mapped fields",
+			output: `[
+				{"metric": "disk.free", "data": {"bytes": 42}, "time": "2013-01-01T00:00:00.5Z", "host": "webserver01", "mount": "/", "healthy": true},
+				{"metric": "disk.used", "data": {"bytes": 58}, "time": 1356998400500, "host": "webserver01"}
+			]`,
+			mapping: &types.MetricMapping{
+				NameField:      "metric",
+				ValueField:     "data.bytes",
+				TimestampField: "time",
+				TagFields:      []string{"host", "mount", "healthy"},
+			},
+			want: JSONList{
+				{
+					Name:  "disk.free",
+					Value: 42,
+					TagSet: []*types.MetricTag{
+						{Name: "host", Value: "webserver01"},
+						{Name: "mount", Value: "/"},
+						{Name: "healthy", Value: "true"},
+					},
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400500 * int64(time.Millisecond),
+				},
+				{
+					Name:  "disk.used",
+					Value: 58,
+					TagSet: []*types.MetricTag{
+						{Name: "host", Value: "webserver01"},
+					},
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400500 * int64(time.Millisecond),
+				},
+			},
+		},
+		{
+			name:   "empty array",
+			output: `[]`,
+			want:   JSONList{},
+		},
+		{
+			name:    "not an array",
+			output:  `{"name": "disk.free", "value": 42}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			output:  `[{"name": "disk.free", "value": 42}`,
+			wantErr: true,
+		},
+		{
+			name:    "trailing data",
+			output:  `[{"name": "disk.free", "value": 42}] foo`,
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			output:  `[{"value": 42}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			output:  `[{"name": "disk.free", "value": "foo"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid timestamp",
+			output:  `[{"name": "disk.free", "value": 42, "timestamp": "yesterday"}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := &types.Event{
+				Check: &types.Check{
+					Executed:            123456789,
+					Output:              tc.output,
+					OutputMetricMapping: tc.mapping,
+				},
+			}
+			got, err := ParseJSON(event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseAndTransformJSON(t *testing.T) {
+	event := &types.Event{
+		Check: &types.Check{
+			Output: `[{"name": "disk.free", "value": 42, "timestamp": 1356998400, "host": "webserver01"}]`,
+			OutputMetricMapping: &types.MetricMapping{
+				TagFields: []string{"host"},
+			},
+		},
+	}
+	transformer, err := ParseJSON(event)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.MetricPoint{
+		{
+			Name:           "disk.free",
+			Value:          42,
+			Timestamp:      1356998400,
+			TimestampNanos: 1356998400 * int64(time.Second),
+			Tags: []*types.MetricTag{
+				{Name: "host", Value: "webserver01"},
+			},
+		},
+	}, transformer.Transform())
+}
+
+func TestParseJSONTolerant(t *testing.T) {
+	output := `[{"name": "disk.free", "value": 42}, {"value": 42}, {"name": "disk.used", "value": 58}]`
+
+	_, _, err := parseJSON(strings.NewReader(output), nil, 12345, false)
+	assert.Error(t, err)
+
+	metrics, dropped, err := parseJSON(strings.NewReader(output), nil, 12345, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	assert.Len(t, metrics, 2)
+
+	// The array itself must be valid
+	_, _, err = parseJSON(strings.NewReader(`[{"name": "disk.free"`), nil, 12345, true)
+	assert.Error(t, err)
+}
@@ -3,13 +3,22 @@ package transformers
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 )
 
+func init() {
+	Register(types.NagiosOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parseNagios(r, event.Check.Executed, tolerant)
+	})
+}
+
 // NagiosList contains a list of Nagios metrics
 type NagiosList []Nagios
 
@@ -18,17 +27,27 @@ type Nagios struct {
 	Label     string
 	Value     float64
 	Timestamp int64
+	TagSet    []*types.MetricTag
 }
 
+// nagiosThresholds contains the names of the optional fields following the
+// value of a perfdata metric, in the order defined by the Nagios plugin API:
+// 'label'=value[UOM];[warn];[crit];[min];[max]
+var nagiosThresholds = []string{"warn", "crit", "min", "max"}
+
+// nonNumericRe matches all the non-numeric characters of a perfdata value
+var nonNumericRe = regexp.MustCompile(`[^-\d\.]`)
+
 // Transform transforms a metric in Nagio perfdata format to Sensu Metric Format
 func (n NagiosList) Transform() []*types.MetricPoint {
 	var points []*types.MetricPoint
 	for _, nagios := range n {
 		mp := &types.MetricPoint{
-			Name:      nagios.Label,
-			Value:     nagios.Value,
-			Timestamp: nagios.Timestamp,
-			Tags:      []*types.MetricTag{},
+			Name:           nagios.Label,
+			Value:          nagios.Value,
+			Timestamp:      nagios.Timestamp,
+			TimestampNanos: nagios.Timestamp * int64(time.Second),
+			Tags:           append([]*types.MetricTag{}, nagios.TagSet...),
 		}
 		points = append(points, mp)
 	}
@@ -37,16 +56,30 @@ func (n NagiosList) Transform() []*types.MetricPoint {
 
 // ParseNagios parses a Nagios perfdata string into a slice of Nagios struct
 func ParseNagios(event *types.Event) (NagiosList, error) {
-	nagiosList := NagiosList{}
-
 	if !event.HasCheck() {
 		return nil, errors.New("event must contain a check to parse and extract metrics")
 	}
 
+	nagiosList, _, err := parseNagios(strings.NewReader(event.Check.Output), event.Check.Executed, false)
+	return nagiosList, err
+}
+
+// parseNagios parses a Nagios perfdata string into a slice of Nagios struct.
+// Unlike the line based formats, the whole output is read since the perfdata
+// can only be located once the plugin output is complete. In tolerant mode,
+// the perfdata metrics that can't be parsed are skipped and counted
+func parseNagios(r io.Reader, timestamp int64, tolerant bool) (NagiosList, int, error) {
+	nagiosList := NagiosList{}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Ensure we have some perfdata metrics and not only human-readable text
-	output := strings.Split(event.Check.Output, "|")
+	output := strings.Split(string(b), "|")
 	if len(output) != 2 {
-		return nil, errors.New("nagios perfdata format requires at least one performance data metric")
+		return nil, 0, errors.New("nagios perfdata format requires at least one performance data metric")
 	}
 
 	// Fetch the perfdata and remove leading & trailing whitespaces
@@ -56,35 +89,67 @@ func ParseNagios(event *types.Event) (NagiosList, error) {
 	metrics := strings.Split(perfdata, " ")
 
 	// Create a Nagios metric for each perfdata metrics
+	var dropped int
 	for _, metric := range metrics {
-		// Clear everything after ';' and split the label and the value
-		parts := strings.Split(metric, ";")
-		parts = strings.Split(parts[0], "=")
-		if len(parts) != 2 {
-			return nil, fmt.Errorf("invalid nagios perfdata metric: %s", metric)
+		n, err := parseNagiosMetric(metric, timestamp)
+		if err != nil {
+			if !tolerant {
+				return nil, 0, err
+			}
+			dropped++
+			continue
 		}
 
-		// Make sure we don't have any whitespace in our label
-		label := strings.Replace(parts[0], " ", "_", -1)
+		// Add this metric to our list
+		nagiosList = append(nagiosList, n)
+	}
 
-		// Remove all non-numeric characters from the value
-		re := regexp.MustCompile(`[^-\d\.]`)
-		strValue := re.ReplaceAllString(parts[1], "")
+	return nagiosList, dropped, nil
+}
 
-		// Parse the value as a float64
-		value, err := strconv.ParseFloat(strValue, 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid nagios perfdata metric value: %s", parts[1])
-		}
+// parseNagiosMetric parses a single perfdata metric
+func parseNagiosMetric(metric string, timestamp int64) (Nagios, error) {
+	// Separate the label and value from the thresholds, then split the label
+	// and the value
+	fields := strings.Split(metric, ";")
+	parts := strings.Split(fields[0], "=")
+	if len(parts) != 2 {
+		return Nagios{}, fmt.Errorf("invalid nagios perfdata metric: %s", metric)
+	}
 
-		// Add this metric to our list
-		n := Nagios{
-			Label:     label,
-			Value:     value,
-			Timestamp: event.Check.Executed,
+	// Make sure we don't have any whitespace in our label
+	label := strings.Replace(parts[0], " ", "_", -1)
+
+	// Remove all non-numeric characters from the value
+	strValue := nonNumericRe.ReplaceAllString(parts[1], "")
+
+	// Parse the value as a float64
+	value, err := strconv.ParseFloat(strValue, 64)
+	if err != nil {
+		return Nagios{}, fmt.Errorf("invalid nagios perfdata metric value: %s", parts[1])
+	}
+
+	// Add the warning & critical thresholds and the min & max values as tags,
+	// skipping the ones that are not provided
+	var tags []*types.MetricTag
+	for i, threshold := range fields[1:] {
+		if i >= len(nagiosThresholds) {
+			break
 		}
-		nagiosList = append(nagiosList, n)
+		threshold = strings.TrimRight(strings.TrimSpace(threshold), ",")
+		if threshold == "" {
+			continue
+		}
+		tags = append(tags, &types.MetricTag{
+			Name:  nagiosThresholds[i],
+			Value: threshold,
+		})
 	}
 
-	return nagiosList, nil
+	return Nagios{
+		Label:     label,
+		Value:     value,
+		Timestamp: timestamp,
+		TagSet:    tags,
+	}, nil
 }
@@ -2,7 +2,9 @@ package transformers
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -81,6 +83,36 @@ func TestParseNagios(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "perfdata metric with thresholds",
+			event: &types.Event{
+				Check: &types.Check{
+					Executed: 12345,
+					Output:   "OK - load average: 0.52, 0.58, 0.59 | load1=0.52;5;10;0 load5=0.58;;;0;",
+				},
+			},
+			want: NagiosList{
+				Nagios{
+					Label:     "load1",
+					Value:     0.52,
+					Timestamp: 12345,
+					TagSet: []*types.MetricTag{
+						{Name: "warn", Value: "5"},
+						{Name: "crit", Value: "10"},
+						{Name: "min", Value: "0"},
+					},
+				},
+				Nagios{
+					Label:     "load5",
+					Value:     0.58,
+					Timestamp: 12345,
+					TagSet: []*types.MetricTag{
+						{Name: "min", Value: "0"},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid perfdata format",
 			event: &types.Event{
@@ -133,10 +165,11 @@ func TestTransformNagios(t *testing.T) {
 			},
 			want: []*types.MetricPoint{
 				{
-					Name:      "percent_packet_loss",
-					Value:     0,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "percent_packet_loss",
+					Value:          0,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
 				},
 			},
 		},
@@ -167,10 +200,35 @@ func TestParseAndTransformNagios(t *testing.T) {
 			},
 			want: []*types.MetricPoint{
 				{
-					Name:      "percent_packet_loss",
-					Value:     0,
-					Timestamp: 123456789,
-					Tags:      []*types.MetricTag{},
+					Name:           "percent_packet_loss",
+					Value:          0,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags:           []*types.MetricTag{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "thresholds as tags",
+			event: &types.Event{
+				Check: &types.Check{
+					Executed: 123456789,
+					Output:   "DISK OK | /=2643MB;5948;5958;0;5968",
+				},
+			},
+			want: []*types.MetricPoint{
+				{
+					Name:           "/",
+					Value:          2643,
+					Timestamp:      123456789,
+					TimestampNanos: 123456789 * int64(time.Second),
+					Tags: []*types.MetricTag{
+						{Name: "warn", Value: "5948"},
+						{Name: "crit", Value: "5958"},
+						{Name: "min", Value: "0"},
+						{Name: "max", Value: "5968"},
+					},
 				},
 			},
 			wantErr: false,
@@ -192,3 +250,15 @@ func TestParseAndTransformNagios(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNagiosTolerant(t *testing.T) {
+	output := "PING ok - Packet loss = 0% | percent_packet_loss=0 rta"
+
+	_, _, err := parseNagios(strings.NewReader(output), 12345, false)
+	assert.Error(t, err)
+
+	metrics, dropped, err := parseNagios(strings.NewReader(output), 12345, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, NagiosList{{Label: "percent_packet_loss", Value: 0, Timestamp: 12345}}, metrics)
+}
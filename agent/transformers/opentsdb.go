@@ -2,21 +2,30 @@ package transformers
 
 import (
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 )
 
+func init() {
+	Register(types.OpenTSDBOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parseOpenTSDB(r, tolerant)
+	})
+}
+
 // OpenTSDBList contains a list of OpenTSDB metrics
 type OpenTSDBList []OpenTSDB
 
 // OpenTSDB contains values of an OpenTSDB metric
 type OpenTSDB struct {
-	Name      string
-	Value     float64
-	TagSet    []*types.MetricTag
-	Timestamp int64
+	Name           string
+	Value          float64
+	TagSet         []*types.MetricTag
+	Timestamp      int64
+	TimestampNanos int64
 }
 
 // Transform transforms metrics in OpenTSDB format to Sensu Metric Format
@@ -24,10 +33,11 @@ func (o OpenTSDBList) Transform() []*types.MetricPoint {
 	var points []*types.MetricPoint
 	for _, metric := range o {
 		mp := &types.MetricPoint{
-			Name:      metric.Name,
-			Value:     metric.Value,
-			Timestamp: metric.Timestamp,
-			Tags:      metric.TagSet,
+			Name:           metric.Name,
+			Value:          metric.Value,
+			Timestamp:      metric.Timestamp,
+			TimestampNanos: metric.TimestampNanos,
+			Tags:           metric.TagSet,
 		}
 		points = append(points, mp)
 	}
@@ -36,66 +46,89 @@ func (o OpenTSDBList) Transform() []*types.MetricPoint {
 
 // ParseOpenTSDB parses OpenTSDB metrics into a list of OpenTSDB structs
 func ParseOpenTSDB(output string) (OpenTSDBList, error) {
+	openTSDBList, _, err := parseOpenTSDB(strings.NewReader(output), false)
+	return openTSDBList, err
+}
+
+// parseOpenTSDB parses OpenTSDB metrics, line by line, into a list of OpenTSDB
+// structs. In tolerant mode, the metrics that can't be parsed are skipped and
+// counted
+func parseOpenTSDB(r io.Reader, tolerant bool) (OpenTSDBList, int, error) {
 	openTSDBList := OpenTSDBList{}
 
-	// Split each line of the output into its own metric
-	output = strings.TrimSpace(output)
-	metrics := strings.Split(output, "\n")
+	// Each line of the output is its own metric
+	dropped, err := parseLines(r, tolerant, func(metric string) error {
+		o, err := parseOpenTSDBLine(metric)
+		if err != nil {
+			return err
+		}
 
-	for _, metric := range metrics {
-		parts := strings.Split(metric, " ")
+		// Add this metric to our list
+		openTSDBList = append(openTSDBList, o)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
 
-		// Ensure we have all the required components. A single metric requires a
-		// name, timestamp, value and at least one tag.
-		if len(parts) < 4 {
-			return nil, fmt.Errorf("invalid opentsdb metric, at least 4 arguments are required: %s", metric)
-		}
+	return openTSDBList, dropped, nil
+}
 
-		name := parts[0]
+// parseOpenTSDBLine parses a single OpenTSDB metric
+func parseOpenTSDBLine(metric string) (OpenTSDB, error) {
+	parts := strings.Split(metric, " ")
 
-		// Convert the timestamp to a unix timestamp with second resolution
-		timestamp, err := strconv.ParseInt(parts[1], 10, 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid opentsdb metric timestamp, must be an integer: %s", parts[1])
-		}
-		if len(parts[1]) == 13 {
-			timestamp = timestamp / 1000
-		}
+	// Ensure we have all the required components. A single metric requires a
+	// name, timestamp, value and at least one tag.
+	if len(parts) < 4 {
+		return OpenTSDB{}, fmt.Errorf("invalid opentsdb metric, at least 4 arguments are required: %s", metric)
+	}
 
-		// Parse the value as a float64
-		value, err := strconv.ParseFloat(parts[2], 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid opentsdb metric value, must be an integer or a floating point value: %s", parts[1])
-		}
+	name := parts[0]
 
-		// Create a OpenTSDB metric with what we have so far
-		o := OpenTSDB{
-			Name:      name,
-			TagSet:    []*types.MetricTag{},
-			Timestamp: timestamp,
-			Value:     value,
-		}
+	// Convert the timestamp to a unix timestamp with second resolution, while
+	// preserving the millisecond precision in the nanosecond timestamp
+	timestamp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return OpenTSDB{}, fmt.Errorf("invalid opentsdb metric timestamp, must be an integer: %s", parts[1])
+	}
+	timestampNanos := timestamp * int64(time.Second)
+	if len(parts[1]) == 13 {
+		timestampNanos = timestamp * int64(time.Millisecond)
+		timestamp = timestamp / 1000
+	}
 
-		// Extract the tag(s)
-		for i := 3; i < len(parts); i++ {
-			t := strings.Split(parts[i], "=")
+	// Parse the value as a float64
+	value, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return OpenTSDB{}, fmt.Errorf("invalid opentsdb metric value, must be an integer or a floating point value: %s", parts[2])
+	}
 
-			if len(t) != 2 {
-				return nil, fmt.Errorf("invalid opentsdb metric tag: %s", parts[i])
-			}
+	// Create a OpenTSDB metric with what we have so far
+	o := OpenTSDB{
+		Name:           name,
+		TagSet:         []*types.MetricTag{},
+		Timestamp:      timestamp,
+		TimestampNanos: timestampNanos,
+		Value:          value,
+	}
 
-			tag := &types.MetricTag{
-				Name:  t[0],
-				Value: t[1],
-			}
+	// Extract the tag(s)
+	for i := 3; i < len(parts); i++ {
+		t := strings.Split(parts[i], "=")
 
-			// Add this tag to our metric
-			o.TagSet = append(o.TagSet, tag)
+		if len(t) != 2 {
+			return OpenTSDB{}, fmt.Errorf("invalid opentsdb metric tag: %s", parts[i])
 		}
 
-		// Add this metric to our list
-		openTSDBList = append(openTSDBList, o)
+		tag := &types.MetricTag{
+			Name:  t[0],
+			Value: t[1],
+		}
+
+		// Add this tag to our metric
+		o.TagSet = append(o.TagSet, tag)
 	}
 
-	return openTSDBList, nil
+	return o, nil
 }
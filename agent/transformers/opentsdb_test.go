@@ -2,7 +2,9 @@ package transformers
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -27,8 +29,9 @@ func TestParseOpenTSDB(t *testing.T) {
 							Value: "webserver01",
 						},
 					},
-					Timestamp: 1356998400,
-					Value:     42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Value:          42.5,
 				},
 			},
 			wantErr: false,
@@ -49,8 +52,9 @@ func TestParseOpenTSDB(t *testing.T) {
 							Value: "0",
 						},
 					},
-					Timestamp: 1356998400,
-					Value:     42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Value:          42.5,
 				},
 			},
 			wantErr: false,
@@ -67,8 +71,9 @@ func TestParseOpenTSDB(t *testing.T) {
 							Value: "webserver01",
 						},
 					},
-					Timestamp: 1356998400,
-					Value:     42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Value:          42.5,
 				},
 			},
 			wantErr: false,
@@ -89,8 +94,28 @@ func TestParseOpenTSDB(t *testing.T) {
 							Value: "0",
 						},
 					},
-					Timestamp: 1356998400,
-					Value:     42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Value:          42.5,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "sub-second millisecond precision",
+			output: "sys.cpu.user 1356998400123 42.5 host=webserver01",
+			want: OpenTSDBList{
+				OpenTSDB{
+					Name: "sys.cpu.user",
+					TagSet: []*types.MetricTag{
+						&types.MetricTag{
+							Name:  "host",
+							Value: "webserver01",
+						},
+					},
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400123 * int64(time.Millisecond),
+					Value:          42.5,
 				},
 			},
 			wantErr: false,
@@ -151,15 +176,17 @@ func TestTransformOpenTSDB(t *testing.T) {
 							Value: "webserver01",
 						},
 					},
-					Timestamp: 1356998400,
-					Value:     42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
+					Value:          42.5,
 				},
 			},
 			want: []*types.MetricPoint{
 				{
-					Name:      "sys.cpu.user",
-					Value:     42.5,
-					Timestamp: 1356998400,
+					Name:           "sys.cpu.user",
+					Value:          42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
 					Tags: []*types.MetricTag{
 						&types.MetricTag{
 							Name:  "host",
@@ -191,9 +218,10 @@ func TestParseAndTransformOpenTSDB(t *testing.T) {
 			output: "sys.cpu.user 1356998400 42.5 host=webserver01",
 			want: []*types.MetricPoint{
 				{
-					Name:      "sys.cpu.user",
-					Value:     42.5,
-					Timestamp: 1356998400,
+					Name:           "sys.cpu.user",
+					Value:          42.5,
+					Timestamp:      1356998400,
+					TimestampNanos: 1356998400 * int64(time.Second),
 					Tags: []*types.MetricTag{
 						&types.MetricTag{
 							Name:  "host",
@@ -227,3 +255,16 @@ func TestParseAndTransformOpenTSDB(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOpenTSDBTolerant(t *testing.T) {
+	output := "sys.cpu.user 1356998400 42.5 host=webserver01\nsys.cpu.user 1356998400 foo host=webserver01\nsys.cpu.nice 1356998400 3 host=webserver01"
+
+	_, _, err := parseOpenTSDB(strings.NewReader(output), false)
+	assert.Error(t, err)
+
+	metrics, dropped, err := parseOpenTSDB(strings.NewReader(output), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	assert.Len(t, metrics, 2)
+	assert.Equal(t, "sys.cpu.nice", metrics[1].Name)
+}
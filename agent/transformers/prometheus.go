@@ -0,0 +1,122 @@
+package transformers
+
+import (
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/sensu/sensu-go/types"
+)
+
+func init() {
+	Register(types.PrometheusOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parsePrometheus(r, event.Check.Executed, tolerant)
+	})
+}
+
+// PrometheusList contains a list of Prometheus samples
+type PrometheusList []Prometheus
+
+// Prometheus contains the values of a sample of the Prometheus text exposition
+// format
+type Prometheus struct {
+	Name           string
+	Value          float64
+	TimestampNanos int64
+	TagSet         []*types.MetricTag
+}
+
+// Transform transforms the samples in Prometheus text exposition format to
+// Sensu Metric Format
+func (p PrometheusList) Transform() []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, prometheus := range p {
+		mp := &types.MetricPoint{
+			Name:           prometheus.Name,
+			Value:          prometheus.Value,
+			Timestamp:      prometheus.TimestampNanos / int64(time.Second),
+			TimestampNanos: prometheus.TimestampNanos,
+			Tags:           append([]*types.MetricTag{}, prometheus.TagSet...),
+		}
+		points = append(points, mp)
+	}
+	return points
+}
+
+// ParsePrometheus parses samples in Prometheus text exposition format, using
+// the given timestamp, in seconds, for the samples without one
+func ParsePrometheus(metrics string, timestamp int64) (PrometheusList, error) {
+	samples, _, err := parsePrometheus(strings.NewReader(metrics), timestamp, false)
+	return samples, err
+}
+
+// parsePrometheus parses the Prometheus text exposition format, line by line.
+// Each sample is parsed on its own, so the samples of histograms and summaries
+// keep their name, e.g. http_request_duration_seconds_bucket, and their le or
+// quantile label as a tag. Comments and blank lines are ignored, as well as
+// the samples whose value is not a number or infinite, which can't be
+// represented as metric points. In tolerant mode, the lines that can't be
+// parsed are skipped and counted.
+func parsePrometheus(r io.Reader, timestamp int64, tolerant bool) (PrometheusList, int, error) {
+	samples := PrometheusList{}
+	dropped, err := parseLines(r, tolerant, func(line string) error {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			return nil
+		}
+		sample, err := parsePrometheusLine(line, timestamp)
+		if err != nil {
+			return err
+		}
+		if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+			return nil
+		}
+		samples = append(samples, sample)
+		return nil
+	})
+	if err != nil {
+		return PrometheusList{}, 0, err
+	}
+
+	return samples, dropped, nil
+}
+
+// parsePrometheusLine parses a single sample of the Prometheus text exposition
+// format
+func parsePrometheusLine(line string, timestamp int64) (Prometheus, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(line + "\n"))
+	if err != nil {
+		return Prometheus{}, err
+	}
+
+	// A sample without a type is parsed as a single untyped metric
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			p := Prometheus{
+				Name:           name,
+				Value:          metric.GetUntyped().GetValue(),
+				TimestampNanos: timestamp * int64(time.Second),
+			}
+			if metric.TimestampMs != nil {
+				p.TimestampNanos = metric.GetTimestampMs() * int64(time.Millisecond)
+			}
+			for _, label := range metric.GetLabel() {
+				p.TagSet = append(p.TagSet, &types.MetricTag{
+					Name:  label.GetName(),
+					Value: label.GetValue(),
+				})
+			}
+			sort.Slice(p.TagSet, func(i, j int) bool {
+				return p.TagSet[i].Name < p.TagSet[j].Name
+			})
+			return p, nil
+		}
+	}
+
+	return Prometheus{}, errors.New("prometheus sample is invalid")
+}
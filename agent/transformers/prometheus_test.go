@@ -0,0 +1,122 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrometheus(t *testing.T) {
+	testCases := []struct {
+		name           string
+		metrics        string
+		expectedFormat PrometheusList
+		expectedErr    bool
+	}{
+		{
+			name: "samples",
+			metrics: `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{method="post",code="400"}    3
+`,
+			expectedFormat: PrometheusList{
+				{
+					Name:           "http_requests_total",
+					Value:          1027,
+					TimestampNanos: 1395066363 * int64(time.Second),
+					TagSet: []*types.MetricTag{
+						{Name: "code", Value: "200"},
+						{Name: "method", Value: "post"},
+					},
+				},
+				{
+					Name:           "http_requests_total",
+					Value:          3,
+					TimestampNanos: 123456789 * int64(time.Second),
+					TagSet: []*types.MetricTag{
+						{Name: "code", Value: "400"},
+						{Name: "method", Value: "post"},
+					},
+				},
+			},
+		},
+		{
+			name: "histogram",
+			metrics: `# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{le="0.05"} 24054
+http_request_duration_seconds_bucket{le="+Inf"} 144320
+http_request_duration_seconds_sum 53423
+`,
+			expectedFormat: PrometheusList{
+				{
+					Name:           "http_request_duration_seconds_bucket",
+					Value:          24054,
+					TimestampNanos: 123456789 * int64(time.Second),
+					TagSet:         []*types.MetricTag{{Name: "le", Value: "0.05"}},
+				},
+				{
+					Name:           "http_request_duration_seconds_bucket",
+					Value:          144320,
+					TimestampNanos: 123456789 * int64(time.Second),
+					TagSet:         []*types.MetricTag{{Name: "le", Value: "+Inf"}},
+				},
+				{
+					Name:           "http_request_duration_seconds_sum",
+					Value:          53423,
+					TimestampNanos: 123456789 * int64(time.Second),
+				},
+			},
+		},
+		{
+			name:           "not a number",
+			metrics:        `rpc_duration_seconds{quantile="0.5"} NaN`,
+			expectedFormat: PrometheusList{},
+		},
+		{
+			name:           "empty",
+			metrics:        "",
+			expectedFormat: PrometheusList{},
+		},
+		{
+			name:           "invalid",
+			metrics:        "metric.value 1 123456789",
+			expectedFormat: PrometheusList{},
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples, err := ParsePrometheus(tc.metrics, 123456789)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedFormat, samples)
+		})
+	}
+}
+
+func TestParsePrometheusTolerant(t *testing.T) {
+	event := types.FixtureEvent("entity", "check")
+	event.Check.OutputMetricFormat = types.PrometheusOutputMetricFormat
+	event.Check.OutputMetricTolerant = true
+	event.Check.Output = "up 1\nnot valid\ntemperature_celsius{room=\"a\"} 21.5"
+
+	transformer, dropped, err := Parse(event)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+
+	points := transformer.Transform()
+	require.Len(t, points, 2)
+	assert.Equal(t, "up", points[0].Name)
+	assert.Equal(t, 1.0, points[0].Value)
+	assert.Equal(t, event.Check.Executed, points[0].Timestamp)
+	assert.Equal(t, "temperature_celsius", points[1].Name)
+	assert.Equal(t, []*types.MetricTag{{Name: "room", Value: "a"}}, points[1].Tags)
+}
@@ -0,0 +1,160 @@
+package transformers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// ParseFunc parses the check output of an event, read from r, into a
+// Transformer. In tolerant mode, the metrics that can't be parsed are skipped
+// and their number is returned instead of an error.
+type ParseFunc func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error)
+
+var (
+	registry   = make(map[string]ParseFunc)
+	registryMu sync.RWMutex
+)
+
+// Register makes a transformer available under the given output metric format
+// name. Registering the same name twice replaces the previous transformer.
+func Register(name string, parse ParseFunc) {
+	if parse == nil {
+		panic("transformers: Register parse function is nil")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = parse
+}
+
+// Lookup returns the transformer registered under the given name, if any
+func Lookup(name string) (ParseFunc, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	parse, ok := registry[name]
+	return parse, ok
+}
+
+// Registered returns the sorted names of all the registered transformers
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// detectSampleSize is the maximum size of the check output inspected to detect
+// its output metric format
+const detectSampleSize = 64 * 1024
+
+// Parse parses the check output of the event with the transformer matching the
+// output metric format of its check, and returns the number of metrics dropped
+// if the check is tolerant. When the format is "auto", the format is detected
+// from the check output.
+func Parse(event *types.Event) (Transformer, int, error) {
+	if !event.HasCheck() {
+		return nil, 0, errors.New("event must contain a check to parse and extract metrics")
+	}
+	return ParseReader(strings.NewReader(event.Check.Output), event)
+}
+
+// ParseReader is like Parse but reads the check output from r, which allows
+// large outputs to be parsed line by line. When the format is "auto", only the
+// beginning of the output is inspected to detect the format.
+func ParseReader(r io.Reader, event *types.Event) (Transformer, int, error) {
+	if !event.HasCheck() {
+		return nil, 0, errors.New("event must contain a check to parse and extract metrics")
+	}
+
+	format := event.Check.OutputMetricFormat
+	if format == types.AutoOutputMetricFormat {
+		sample, eof, err := readSample(r)
+		if err != nil {
+			return nil, 0, err
+		}
+		if format, err = detect(sample, eof, event); err != nil {
+			return nil, 0, err
+		}
+		r = io.MultiReader(bytes.NewReader(sample), r)
+	}
+
+	parse, ok := Lookup(format)
+	if !ok {
+		return nil, 0, fmt.Errorf("output metric format is not supported: %q", format)
+	}
+	return parse(r, event, event.Check.OutputMetricTolerant)
+}
+
+// Detect inspects the check output of the event and returns the first
+// registered format, in alphabetical order, able to parse all of it. If none
+// can and the check is tolerant, the format extracting the most metrics is
+// returned instead.
+func Detect(event *types.Event) (string, error) {
+	if !event.HasCheck() {
+		return "", errors.New("event must contain a check to parse and extract metrics")
+	}
+	return detect([]byte(event.Check.Output), true, event)
+}
+
+// detect returns the output metric format of the sample. Unless the sample is
+// the complete output, its last line, which might be incomplete, is ignored.
+func detect(sample []byte, eof bool, event *types.Event) (string, error) {
+	if !eof {
+		if i := bytes.LastIndexByte(sample, '\n'); i >= 0 {
+			sample = sample[:i]
+		}
+	}
+
+	var best string
+	var bestPoints int
+	for _, name := range Registered() {
+		parse, ok := Lookup(name)
+		if !ok {
+			continue
+		}
+		transformer, dropped, err := parse(bytes.NewReader(sample), event, true)
+		if err != nil {
+			continue
+		}
+		points := len(transformer.Transform())
+		if points == 0 {
+			continue
+		}
+		if dropped == 0 {
+			return name, nil
+		}
+		if points > bestPoints {
+			best, bestPoints = name, points
+		}
+	}
+
+	if best != "" && event.Check.OutputMetricTolerant {
+		return best, nil
+	}
+	return "", errors.New("unable to detect the output metric format of the check output")
+}
+
+// readSample reads the beginning of the check output, up to detectSampleSize
+// bytes, and indicates if the whole output was read
+func readSample(r io.Reader) ([]byte, bool, error) {
+	sample := make([]byte, detectSampleSize)
+	n, err := io.ReadFull(r, sample)
+	switch err {
+	case nil:
+		return sample, false, nil
+	case io.EOF, io.ErrUnexpectedEOF:
+		return sample[:n], true, nil
+	default:
+		return nil, false, err
+	}
+}
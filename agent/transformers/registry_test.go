@@ -0,0 +1,175 @@
+package transformers
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistered(t *testing.T) {
+	assert.Equal(t, []string{
+		types.GraphiteOutputMetricFormat,
+		types.InfluxDBOutputMetricFormat,
+		types.JSONOutputMetricFormat,
+		types.NagiosOutputMetricFormat,
+		types.OpenTSDBOutputMetricFormat,
+		types.PrometheusOutputMetricFormat,
+		types.StatsdOutputMetricFormat,
+	}, Registered())
+}
+
+func TestDetect(t *testing.T) {
+	testCases := []struct {
+		name           string
+		output         string
+		tolerant       bool
+		expectedFormat string
+		expectedErr    bool
+	}{
+		{
+			name:           "graphite",
+			output:         "metric.value 1 123456789",
+			expectedFormat: types.GraphiteOutputMetricFormat,
+		},
+		{
+			name:           "influxdb",
+			output:         "weather,location=us-midwest temperature=82 1465839830100400200",
+			expectedFormat: types.InfluxDBOutputMetricFormat,
+		},
+		{
+			name:           "json",
+			output:         `[{"name": "disk.free", "value": 42}]`,
+			expectedFormat: types.JSONOutputMetricFormat,
+		},
+		{
+			name:           "nagios",
+			output:         "PING ok - Packet loss = 0% | percent_packet_loss=0",
+			expectedFormat: types.NagiosOutputMetricFormat,
+		},
+		{
+			name:           "opentsdb",
+			output:         "sys.cpu.user 1356998400 42.5 host=webserver01",
+			expectedFormat: types.OpenTSDBOutputMetricFormat,
+		},
+		{
+			name:           "statsd",
+			output:         "page.views:1|c|#env:prod",
+			expectedFormat: types.StatsdOutputMetricFormat,
+		},
+		{
+			name:        "unknown",
+			output:      "everything is fine",
+			expectedErr: true,
+		},
+		{
+			name:        "partially valid",
+			output:      "sys.cpu.user 1356998400 42.5 host=webserver01\ngarbage",
+			expectedErr: true,
+		},
+		{
+			name:           "partially valid and tolerant",
+			output:         "sys.cpu.user 1356998400 42.5 host=webserver01\ngarbage",
+			tolerant:       true,
+			expectedFormat: types.OpenTSDBOutputMetricFormat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := &types.Event{Check: &types.Check{
+				Output:               tc.output,
+				OutputMetricTolerant: tc.tolerant,
+			}}
+			format, err := Detect(event)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFormat, format)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	event := &types.Event{Check: &types.Check{
+		Output:             "metric.value 1 123456789",
+		OutputMetricFormat: types.GraphiteOutputMetricFormat,
+	}}
+	transformer, dropped, err := Parse(event)
+	require.NoError(t, err)
+	assert.IsType(t, GraphiteList{}, transformer)
+	assert.Equal(t, 0, dropped)
+
+	event.Check.OutputMetricFormat = types.AutoOutputMetricFormat
+	transformer, _, err = Parse(event)
+	require.NoError(t, err)
+	assert.IsType(t, GraphiteList{}, transformer)
+
+	event.Check.OutputMetricFormat = "not_a_format"
+	_, _, err = Parse(event)
+	assert.Error(t, err)
+
+	_, _, err = Parse(&types.Event{})
+	assert.Error(t, err)
+}
+
+func TestParseTolerant(t *testing.T) {
+	event := &types.Event{Check: &types.Check{
+		Output:             "metric.value 1 123456789\nmetric.value one 123456789\n\nmetric.value 2 123456790",
+		OutputMetricFormat: types.GraphiteOutputMetricFormat,
+	}}
+	_, _, err := Parse(event)
+	assert.Error(t, err)
+
+	event.Check.OutputMetricTolerant = true
+	transformer, dropped, err := Parse(event)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	assert.Len(t, transformer.Transform(), 2)
+}
+
+func TestParseReader(t *testing.T) {
+	// The output is larger than the sample used to detect its format
+	var output bytes.Buffer
+	for output.Len() <= detectSampleSize {
+		output.WriteString("sys.cpu.user 1356998400 42.5 host=webserver01\n")
+	}
+	lines := strings.Count(output.String(), "\n")
+
+	event := &types.Event{Check: &types.Check{
+		OutputMetricFormat: types.AutoOutputMetricFormat,
+	}}
+	transformer, dropped, err := ParseReader(&output, event)
+	require.NoError(t, err)
+	assert.Equal(t, 0, dropped)
+	assert.IsType(t, OpenTSDBList{}, transformer)
+	assert.Len(t, transformer.Transform(), lines)
+
+	_, _, err = ParseReader(strings.NewReader(""), &types.Event{})
+	assert.Error(t, err)
+}
+
+func TestRegister(t *testing.T) {
+	Register("test_format", func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return GraphiteList{}, 0, nil
+	})
+	defer func() {
+		registryMu.Lock()
+		delete(registry, "test_format")
+		registryMu.Unlock()
+	}()
+
+	parse, ok := Lookup("test_format")
+	require.True(t, ok)
+	transformer, _, err := parse(strings.NewReader(""), &types.Event{}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, GraphiteList{}, transformer)
+
+	assert.Panics(t, func() { Register("nil_format", nil) })
+}
@@ -0,0 +1,257 @@
+package transformers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+func init() {
+	Register(types.StatsdOutputMetricFormat, func(r io.Reader, event *types.Event, tolerant bool) (Transformer, int, error) {
+		return parseStatsd(r, event.Check.Executed, tolerant)
+	})
+}
+
+const (
+	statsdCounter   = "c"
+	statsdGauge     = "g"
+	statsdTimer     = "ms"
+	statsdHistogram = "h"
+	statsdSet       = "s"
+)
+
+// StatsdList contains a list of StatsD metrics, aggregated by name, type and
+// tags
+type StatsdList []*Statsd
+
+// Statsd contains the values of a StatsD metric aggregated within a batch
+type Statsd struct {
+	Name      string
+	Type      string
+	TagSet    []*types.MetricTag
+	Timestamp int64
+
+	// Value is the sum of a counter, the last value of a gauge or the number
+	// of unique values of a set
+	Value float64
+
+	// Samples are the values of a timer and Count its number of samples,
+	// adjusted by their sample rate
+	Samples []float64
+	Count   float64
+
+	uniques map[string]struct{}
+}
+
+// statsdLine contains the values of a single line of StatsD wire format
+type statsdLine struct {
+	name   string
+	value  string
+	typ    string
+	rate   float64
+	tagSet []*types.MetricTag
+}
+
+// Transform transforms the aggregated StatsD metrics to Sensu Metric Format
+func (s StatsdList) Transform() []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, statsd := range s {
+		for _, field := range statsd.fields() {
+			mp := &types.MetricPoint{
+				Name:           statsd.Name + "." + field.Key,
+				Value:          field.Value,
+				Timestamp:      statsd.Timestamp,
+				TimestampNanos: statsd.Timestamp * int64(time.Second),
+				Tags:           append([]*types.MetricTag{}, statsd.TagSet...),
+			}
+			points = append(points, mp)
+		}
+	}
+	return points
+}
+
+// fields returns the aggregated values of the metric, using the same names as
+// the agent StatsD server
+func (s *Statsd) fields() []*Field {
+	if s.Type != statsdTimer {
+		return []*Field{{Key: "value", Value: s.Value}}
+	}
+
+	samples := append([]float64{}, s.Samples...)
+	sort.Float64s(samples)
+
+	var sum float64
+	for _, sample := range samples {
+		sum += sample
+	}
+
+	median := samples[len(samples)/2]
+	if len(samples)%2 == 0 {
+		median = (samples[len(samples)/2-1] + median) / 2
+	}
+
+	return []*Field{
+		{Key: "min", Value: samples[0]},
+		{Key: "max", Value: samples[len(samples)-1]},
+		{Key: "count", Value: s.Count},
+		{Key: "mean", Value: sum / float64(len(samples))},
+		{Key: "median", Value: median},
+		{Key: "sum", Value: sum},
+	}
+}
+
+// ParseStatsd parses the StatsD wire format of the check output into a list of
+// aggregated StatsD metrics
+func ParseStatsd(event *types.Event) (StatsdList, error) {
+	if !event.HasCheck() {
+		return nil, errors.New("event must contain a check to parse and extract metrics")
+	}
+
+	statsdList, _, err := parseStatsd(strings.NewReader(event.Check.Output), event.Check.Executed, false)
+	return statsdList, err
+}
+
+// parseStatsd parses the StatsD wire format, line by line, into a list of
+// aggregated StatsD metrics. In tolerant mode, the lines that can't be parsed
+// are skipped and counted
+func parseStatsd(r io.Reader, timestamp int64, tolerant bool) (StatsdList, int, error) {
+	statsdList := StatsdList{}
+	index := make(map[string]*Statsd)
+
+	dropped, err := parseLines(r, tolerant, func(line string) error {
+		l, err := parseStatsdLine(line)
+		if err != nil {
+			return err
+		}
+
+		// Aggregate the lines sharing the same name, type and tags
+		key := statsdKey(l)
+		s, ok := index[key]
+		if !ok {
+			s = &Statsd{
+				Name:      l.name,
+				Type:      l.typ,
+				TagSet:    l.tagSet,
+				Timestamp: timestamp,
+			}
+		}
+		if err := s.add(l, ok); err != nil {
+			return err
+		}
+		if !ok {
+			index[key] = s
+			statsdList = append(statsdList, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return statsdList, dropped, nil
+}
+
+// add aggregates the value of a line into the metric
+func (s *Statsd) add(l statsdLine, exists bool) error {
+	if s.Type == statsdSet {
+		if s.uniques == nil {
+			s.uniques = make(map[string]struct{})
+		}
+		s.uniques[l.value] = struct{}{}
+		s.Value = float64(len(s.uniques))
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(l.value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid statsd metric value, must be a number: %s", l.value)
+	}
+
+	switch s.Type {
+	case statsdCounter:
+		s.Value += value / l.rate
+	case statsdGauge:
+		// A signed value modifies the previous value of the gauge
+		if exists && (strings.HasPrefix(l.value, "+") || strings.HasPrefix(l.value, "-")) {
+			s.Value += value
+		} else {
+			s.Value = value
+		}
+	case statsdTimer:
+		s.Samples = append(s.Samples, value)
+		s.Count += 1 / l.rate
+	}
+	return nil
+}
+
+// parseStatsdLine parses a single line of StatsD wire format, in the
+// name:value|type|@rate|#tag1:value1,tag2 form
+func parseStatsdLine(line string) (statsdLine, error) {
+	l := statsdLine{rate: 1}
+
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) < 2 {
+		return l, fmt.Errorf("invalid statsd metric, a value and a type are required: %s", line)
+	}
+
+	i := strings.LastIndex(parts[0], ":")
+	if i < 1 || i == len(parts[0])-1 {
+		return l, fmt.Errorf("invalid statsd metric, must be in the name:value form: %s", parts[0])
+	}
+	l.name, l.value = parts[0][:i], parts[0][i+1:]
+
+	switch parts[1] {
+	case statsdCounter, statsdGauge, statsdTimer, statsdSet:
+		l.typ = parts[1]
+	case statsdHistogram:
+		// Histograms are aggregated like timers
+		l.typ = statsdTimer
+	default:
+		return l, fmt.Errorf("invalid statsd metric type: %s", parts[1])
+	}
+
+	for _, part := range parts[2:] {
+		switch {
+		case strings.HasPrefix(part, "@"):
+			rate, err := strconv.ParseFloat(part[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return l, fmt.Errorf("invalid statsd metric sample rate: %s", part)
+			}
+			l.rate = rate
+		case strings.HasPrefix(part, "#"):
+			// DogStatsD tags extension
+			for _, tag := range strings.Split(part[1:], ",") {
+				if tag == "" {
+					continue
+				}
+				t := strings.SplitN(tag, ":", 2)
+				metricTag := &types.MetricTag{Name: t[0]}
+				if len(t) == 2 {
+					metricTag.Value = t[1]
+				}
+				l.tagSet = append(l.tagSet, metricTag)
+			}
+		default:
+			return l, fmt.Errorf("invalid statsd metric field: %s", part)
+		}
+	}
+
+	return l, nil
+}
+
+// statsdKey returns the aggregation key of a line, which does not depend on the
+// order of its tags
+func statsdKey(l statsdLine) string {
+	tags := make([]string, 0, len(l.tagSet))
+	for _, tag := range l.tagSet {
+		tags = append(tags, tag.Name+":"+tag.Value)
+	}
+	sort.Strings(tags)
+	return l.name + "|" + l.typ + "|" + strings.Join(tags, ",")
+}
@@ -0,0 +1,186 @@
+package transformers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatsdLine(t *testing.T) {
+	testCases := []struct {
+		name    string
+		line    string
+		want    statsdLine
+		wantErr bool
+	}{
+		{
+			name: "counter",
+			line: "page.views:1|c",
+			want: statsdLine{name: "page.views", value: "1", typ: "c", rate: 1},
+		},
+		{
+			name: "counter with sample rate",
+			line: "page.views:1|c|@0.1",
+			want: statsdLine{name: "page.views", value: "1", typ: "c", rate: 0.1},
+		},
+		{
+			name: "histogram",
+			line: "request.time:320|h",
+			want: statsdLine{name: "request.time", value: "320", typ: "ms", rate: 1},
+		},
+		{
+			name: "dogstatsd tags",
+			line: "page.views:1|c|@0.5|#env:prod,canary",
+			want: statsdLine{
+				name:  "page.views",
+				value: "1",
+				typ:   "c",
+				rate:  0.5,
+				tagSet: []*types.MetricTag{
+					{Name: "env", Value: "prod"},
+					{Name: "canary"},
+				},
+			},
+		},
+		{
+			name:    "missing type",
+			line:    "page.views:1",
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			line:    "page.views|c",
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			line:    "page.views:1|x",
+			wantErr: true,
+		},
+		{
+			name:    "invalid sample rate",
+			line:    "page.views:1|c|@2",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			line:    "page.views:1|c|foo",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseStatsdLine(tc.line)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseAndTransformStatsd(t *testing.T) {
+	point := func(name string, value float64, tags ...*types.MetricTag) *types.MetricPoint {
+		return &types.MetricPoint{
+			Name:           name,
+			Value:          value,
+			Timestamp:      123456789,
+			TimestampNanos: 123456789 * int64(time.Second),
+			Tags:           append([]*types.MetricTag{}, tags...),
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		output  string
+		want    []*types.MetricPoint
+		wantErr bool
+	}{
+		{
+			name:   "counters are summed",
+			output: "page.views:1|c\npage.views:2|c\npage.views:1|c|@0.5",
+			want:   []*types.MetricPoint{point("page.views.value", 5)},
+		},
+		{
+			name:   "gauges keep the last value",
+			output: "queue.size:10|g\nqueue.size:4|g\nqueue.size:+3|g\nqueue.size:-2|g",
+			want:   []*types.MetricPoint{point("queue.size.value", 5)},
+		},
+		{
+			name:   "sets count unique values",
+			output: "users:alice|s\nusers:bob|s\nusers:alice|s",
+			want:   []*types.MetricPoint{point("users.value", 2)},
+		},
+		{
+			name:   "timers are aggregated",
+			output: "request.time:300|ms\nrequest.time:100|ms\nrequest.time:200|ms|@0.5\nrequest.time:400|h",
+			want: []*types.MetricPoint{
+				point("request.time.min", 100),
+				point("request.time.max", 400),
+				point("request.time.count", 5),
+				point("request.time.mean", 250),
+				point("request.time.median", 250),
+				point("request.time.sum", 1000),
+			},
+		},
+		{
+			name:   "tags in any order are aggregated",
+			output: "page.views:1|c|#env:prod,region:us\npage.views:1|c|#region:us,env:prod\npage.views:1|c|#env:dev",
+			want: []*types.MetricPoint{
+				point("page.views.value", 2, &types.MetricTag{Name: "env", Value: "prod"}, &types.MetricTag{Name: "region", Value: "us"}),
+				point("page.views.value", 1, &types.MetricTag{Name: "env", Value: "dev"}),
+			},
+		},
+		{
+			name:   "different types are not aggregated",
+			output: "requests:1|c\nrequests:3|g",
+			want: []*types.MetricPoint{
+				point("requests.value", 1),
+				point("requests.value", 3),
+			},
+		},
+		{
+			name:    "invalid value",
+			output:  "page.views:foo|c",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := &types.Event{
+				Check: &types.Check{
+					Executed: 123456789,
+					Output:   tc.output,
+				},
+			}
+			transformer, err := ParseStatsd(event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, transformer.Transform())
+		})
+	}
+}
+
+func TestParseStatsdTolerant(t *testing.T) {
+	output := "page.views:1|c\ngarbage\npage.views:2|c"
+
+	_, _, err := parseStatsd(strings.NewReader(output), 12345, false)
+	assert.Error(t, err)
+
+	metrics, dropped, err := parseStatsd(strings.NewReader(output), 12345, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	require.Len(t, metrics, 1)
+	assert.Equal(t, 3.0, metrics[0].Value)
+}
@@ -1,7 +1,94 @@
 package transformers
 
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// A Transformer handles transforming Sensu metrics to other output metric formats
+type Transformer interface {
+	// Transform transforms a metric in a different output metric format to Sensu Metric
+	// Format
+	Transform() []*types.MetricPoint
+}
+
 // Field is a key value pair representing a metric
 type Field struct {
 	Key   string
 	Value float64
 }
+
+// maxLineSize is the maximum size of a single line of check output
+const maxLineSize = 1024 * 1024
+
+// parseLines reads the output line by line and calls parse with each line, so
+// only one line is held in memory at a time. Like the whole output, the
+// leading and trailing whitespaces of the output are ignored. In tolerant mode,
+// the blank lines are ignored and the lines that can't be parsed are skipped
+// and counted, otherwise the first parsing error is returned.
+func parseLines(r io.Reader, tolerant bool, parse func(line string) error) (int, error) {
+	var dropped int
+	handle := func(line string) error {
+		if tolerant && strings.TrimSpace(line) == "" {
+			return nil
+		}
+		if err := parse(line); err != nil {
+			if !tolerant {
+				return err
+			}
+			dropped++
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+
+	// The last non-blank line is only handled once the next one is read, so its
+	// trailing whitespaces can be trimmed if it's the last line of the output
+	var last *string
+	var blanks int
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			// Leading blank lines are trimmed
+			if last != nil {
+				blanks++
+			}
+			continue
+		}
+
+		if last == nil {
+			line = strings.TrimLeftFunc(line, unicode.IsSpace)
+		} else {
+			if err := handle(*last); err != nil {
+				return 0, err
+			}
+			for ; blanks > 0; blanks-- {
+				if err := handle(""); err != nil {
+					return 0, err
+				}
+			}
+		}
+		last = &line
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	if last == nil {
+		// The output is empty
+		if err := handle(""); err != nil {
+			return 0, err
+		}
+		return dropped, nil
+	}
+	if err := handle(strings.TrimRightFunc(*last, unicode.IsSpace)); err != nil {
+		return 0, err
+	}
+	return dropped, nil
+}
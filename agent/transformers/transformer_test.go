@@ -0,0 +1,91 @@
+package transformers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLines(t *testing.T) {
+	testCases := []struct {
+		name        string
+		output      string
+		tolerant    bool
+		wantLines   []string
+		wantDropped int
+		wantErr     bool
+	}{
+		{
+			name:      "single line",
+			output:    "metric 1",
+			wantLines: []string{"metric 1"},
+		},
+		{
+			name:      "leading and trailing whitespaces are trimmed",
+			output:    "\n\n  metric 1 \nmetric 2  \n\n",
+			wantLines: []string{"metric 1 ", "metric 2"},
+		},
+		{
+			name:      "windows line endings",
+			output:    "metric 1\r\nmetric 2\r\n",
+			wantLines: []string{"metric 1", "metric 2"},
+		},
+		{
+			name:    "blank line between metrics",
+			output:  "metric 1\n\nmetric 2",
+			wantErr: true,
+		},
+		{
+			name:      "blank line between metrics and tolerant",
+			output:    "metric 1\n\nmetric 2",
+			tolerant:  true,
+			wantLines: []string{"metric 1", "metric 2"},
+		},
+		{
+			name:    "empty output",
+			output:  "",
+			wantErr: true,
+		},
+		{
+			name:     "empty output and tolerant",
+			output:   "",
+			tolerant: true,
+		},
+		{
+			name:        "invalid line and tolerant",
+			output:      "metric 1\ngarbage\nmetric 2",
+			tolerant:    true,
+			wantLines:   []string{"metric 1", "metric 2"},
+			wantDropped: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var lines []string
+			dropped, err := parseLines(strings.NewReader(tc.output), tc.tolerant, func(line string) error {
+				if !strings.HasPrefix(line, "metric") {
+					return errors.New("invalid line")
+				}
+				lines = append(lines, line)
+				return nil
+			})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantLines, lines)
+			assert.Equal(t, tc.wantDropped, dropped)
+		})
+	}
+}
+
+func TestParseLinesTooLong(t *testing.T) {
+	output := "metric " + strings.Repeat("1", maxLineSize)
+	_, err := parseLines(strings.NewReader(output), true, func(string) error { return nil })
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package agentd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -54,6 +55,13 @@ type Config struct {
 	Bus   messaging.MessageBus
 	Store store.Store
 	TLS   *types.TLSOptions
+
+	// Agent authentication with client certificates, which are verified
+	// against the trusted CA file and the CRL file, if any. Agents without a
+	// certificate use basic authentication unless RequireAgentCert is true.
+	AgentAuthTrustedCAFile string
+	AgentAuthCRLFile       string
+	RequireAgentCert       bool
 }
 
 // Option is a functional option.
@@ -72,13 +80,24 @@ func New(c Config, opts ...Option) (*Agentd, error) {
 		wg:       &sync.WaitGroup{},
 		errChan:  make(chan error, 1),
 	}
-	handler := middlewares.BasicAuthentication(http.HandlerFunc(a.webSocketHandler), a.store)
 	a.httpServer = &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", a.Host, a.Port),
-		Handler:      handler,
+		Handler:      a.authenticate(http.HandlerFunc(a.webSocketHandler)),
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
 	}
+	if c.AgentAuthTrustedCAFile != "" {
+		if a.tls == nil {
+			return nil, errors.New("agent authentication with certificates requires tls")
+		}
+		tlsConfig, err := agentTLSConfig(a.tls, c.AgentAuthTrustedCAFile, c.AgentAuthCRLFile, c.RequireAgentCert)
+		if err != nil {
+			return nil, err
+		}
+		a.httpServer.TLSConfig = tlsConfig
+	} else if c.AgentAuthCRLFile != "" || c.RequireAgentCert {
+		return nil, errors.New("agent authentication with certificates requires a trusted CA file")
+	}
 	for _, o := range opts {
 		if err := o(a); err != nil {
 			return nil, err
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/sensu/sensu-go/backend/keepalived"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/handler"
@@ -21,6 +23,7 @@ var json = jsoniter.ConfigDefault
 type SessionStore interface {
 	store.EntityStore
 	store.EnvironmentStore
+	store.EventStore
 }
 
 // A Session is a server-side connection between a Sensu backend server and
@@ -39,13 +42,16 @@ type Session struct {
 	checkChannel chan interface{}
 	bus          messaging.MessageBus
 
-	subscriptions chan messaging.Subscription
+	// subscriptions are the bus subscriptions of the session, by topic
+	subscriptions   map[string]messaging.Subscription
+	subscriptionsMu *sync.Mutex
 }
 
 func newSessionHandler(s *Session) *handler.MessageHandler {
 	handler := handler.NewMessageHandler()
 	handler.AddHandler(transport.MessageTypeKeepalive, s.handleKeepalive)
 	handler.AddHandler(transport.MessageTypeEvent, s.handleEvent)
+	handler.AddHandler(transport.MessageTypeDeregistration, s.handleDeregistration)
 
 	return handler
 }
@@ -79,15 +85,16 @@ func NewSession(cfg SessionConfig, conn transport.Transport, bus messaging.Messa
 	}).Info("agent connected")
 
 	s := &Session{
-		conn:          conn,
-		cfg:           cfg,
-		stopping:      make(chan struct{}, 1),
-		wg:            &sync.WaitGroup{},
-		sendq:         make(chan *transport.Message, 10),
-		checkChannel:  make(chan interface{}, 100),
-		store:         store,
-		bus:           bus,
-		subscriptions: make(chan messaging.Subscription, len(cfg.Subscriptions)),
+		conn:            conn,
+		cfg:             cfg,
+		stopping:        make(chan struct{}, 1),
+		wg:              &sync.WaitGroup{},
+		sendq:           make(chan *transport.Message, 10),
+		checkChannel:    make(chan interface{}, 100),
+		store:           store,
+		bus:             bus,
+		subscriptions:   make(map[string]messaging.Subscription),
+		subscriptionsMu: &sync.Mutex{},
 	}
 	s.handler = newSessionHandler(s)
 	return s, nil
@@ -204,31 +211,59 @@ func (s *Session) Start() (err error) {
 	go s.recvPump()
 	go s.subPump()
 
-	org, env := s.cfg.Organization, s.cfg.Environment
-	agentID := fmt.Sprintf("%s:%s:%s", org, env, s.cfg.AgentID)
-
 	defer func() {
 		if err != nil {
 			s.Stop()
 		}
 	}()
 
-	for _, sub := range s.cfg.Subscriptions {
+	return s.setSubscriptions(s.cfg.Subscriptions)
+}
+
+// setSubscriptions subscribes the session to the topics of the given
+// subscriptions, and unsubscribes it from the topics of the subscriptions it
+// no longer has, so the subscriptions of an agent can change without
+// reconnecting.
+func (s *Session) setSubscriptions(subscriptions []string) error {
+	s.subscriptionsMu.Lock()
+	defer s.subscriptionsMu.Unlock()
+
+	org, env := s.cfg.Organization, s.cfg.Environment
+	agentID := fmt.Sprintf("%s:%s:%s", org, env, s.cfg.AgentID)
+
+	topics := make(map[string]struct{}, len(subscriptions))
+	for _, sub := range subscriptions {
 		// Ignore empty subscriptions
 		if sub == "" {
 			continue
 		}
 
 		topic := messaging.SubscriptionTopic(org, env, sub)
+		topics[topic] = struct{}{}
+		if _, ok := s.subscriptions[topic]; ok {
+			continue
+		}
+
 		logger.WithField("topic", topic).Debug("subscribing to topic")
 		subscription, err := s.bus.Subscribe(topic, agentID, s)
 		if err != nil {
 			logger.WithError(err).Error("error starting subscription")
 			return err
 		}
-		s.subscriptions <- subscription
+		s.subscriptions[topic] = subscription
+	}
+
+	for topic, subscription := range s.subscriptions {
+		if _, ok := topics[topic]; ok {
+			continue
+		}
+
+		logger.WithField("topic", topic).Debug("unsubscribing from topic")
+		if err := subscription.Cancel(); err != nil {
+			logger.WithError(err).Error("unable to unsubscribe from message bus")
+		}
+		delete(s.subscriptions, topic)
 	}
-	close(s.subscriptions)
 
 	return nil
 }
@@ -239,11 +274,14 @@ func (s *Session) Stop() {
 	close(s.stopping)
 	s.wg.Wait()
 
-	for sub := range s.subscriptions {
+	s.subscriptionsMu.Lock()
+	for topic, sub := range s.subscriptions {
 		if err := sub.Cancel(); err != nil {
 			logger.WithError(err).Error("unable to unsubscribe from message bus")
 		}
+		delete(s.subscriptions, topic)
 	}
+	s.subscriptionsMu.Unlock()
 	close(s.checkChannel)
 }
 
@@ -265,9 +303,46 @@ func (s *Session) handleKeepalive(payload []byte) error {
 
 	keepalive.Entity.Subscriptions = addEntitySubscription(keepalive.Entity.ID, keepalive.Entity.Subscriptions)
 
+	// Follow the subscriptions of the agent, which change when its
+	// configuration is reloaded
+	if keepalive.Entity.ID == s.cfg.AgentID {
+		if err := s.setSubscriptions(keepalive.Entity.Subscriptions); err != nil {
+			return err
+		}
+	}
+
 	return s.bus.Publish(messaging.TopicKeepalive, keepalive)
 }
 
+// handleDeregistration deregisters the entity of the agent, which is shutting
+// down, if the entity is set to be deregistered.
+func (s *Session) handleDeregistration(payload []byte) error {
+	deregistration := &types.Event{}
+	if err := json.Unmarshal(payload, deregistration); err != nil {
+		return err
+	}
+
+	entity := deregistration.Entity
+	if entity == nil {
+		return errors.New("deregistration does not contain an entity")
+	}
+
+	// Agents can only deregister their own entity
+	if entity.ID != s.cfg.AgentID || entity.Organization != s.cfg.Organization || entity.Environment != s.cfg.Environment {
+		return errors.New("deregistration entity does not match the agent")
+	}
+
+	if !entity.Deregister {
+		return errors.New("deregistration entity is not set to be deregistered")
+	}
+
+	deregisterer := &keepalived.Deregistration{
+		Store:      s.store,
+		MessageBus: s.bus,
+	}
+	return deregisterer.Deregister(entity)
+}
+
 func (s *Session) handleEvent(payload []byte) error {
 	// Decode the payload to an event
 	event := &types.Event{}
@@ -291,5 +366,9 @@ func (s *Session) handleEvent(payload []byte) error {
 	// Add the entity subscription to the subscriptions of this entity
 	event.Entity.Subscriptions = addEntitySubscription(event.Entity.ID, event.Entity.Subscriptions)
 
+	// Identify the message the event originates from, to trace it through the
+	// pipeline
+	event.RequestID = uuid.New().String()
+
 	return s.bus.Publish(messaging.TopicEventRaw, event)
 }
@@ -3,6 +3,7 @@ package agentd
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"testing"
 
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -111,3 +112,115 @@ func TestBadSessionConfig(t *testing.T) {
 	assert.Nil(t, session)
 	assert.Error(t, err)
 }
+
+func TestSessionDeregistration(t *testing.T) {
+	deregisteredEntity := func(id string, deregister bool) *types.Entity {
+		entity := types.FixtureEntity(id)
+		entity.Organization = "org"
+		entity.Environment = "env"
+		entity.Deregister = deregister
+		return entity
+	}
+
+	tt := []struct {
+		name         string
+		entity       *types.Entity
+		expectedErr  bool
+		deregistered bool
+	}{
+		{
+			name:         "Ephemeral Agent Entity",
+			entity:       deregisteredEntity("testing", true),
+			deregistered: true,
+		},
+		{
+			name:        "Non Ephemeral Agent Entity",
+			entity:      deregisteredEntity("testing", false),
+			expectedErr: true,
+		},
+		{
+			name:        "Another Entity",
+			entity:      deregisteredEntity("other", true),
+			expectedErr: true,
+		},
+		{
+			name:        "No Entity",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+				RingGetter: &mockring.Getter{},
+			})
+			require.NoError(t, err)
+			require.NoError(t, bus.Start())
+
+			st := &mockstore.MockStore{}
+			st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+			st.On("DeleteEntity", mock.Anything, mock.Anything).Return(nil)
+			st.On("GetEventsByEntity", mock.Anything, "testing").Return([]*types.Event{}, nil)
+
+			cfg := SessionConfig{
+				AgentID:      "testing",
+				Organization: "org",
+				Environment:  "env",
+			}
+			session, err := NewSession(cfg, &testTransport{}, bus, st)
+			require.NoError(t, err)
+
+			payload, err := json.Marshal(&types.Event{Entity: tc.entity})
+			require.NoError(t, err)
+
+			err = session.handleDeregistration(payload)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.deregistered {
+				st.AssertCalled(t, "DeleteEntity", mock.Anything, mock.Anything)
+			} else {
+				st.AssertNotCalled(t, "DeleteEntity", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestSessionKeepaliveSubscriptions(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+
+	cfg := SessionConfig{
+		AgentID:       "testing",
+		Organization:  "org",
+		Environment:   "env",
+		Subscriptions: addEntitySubscription("testing", []string{"linux"}),
+	}
+	session, err := NewSession(cfg, &testTransport{}, bus, st)
+	require.NoError(t, err)
+	require.NoError(t, session.setSubscriptions(cfg.Subscriptions))
+
+	entity := types.FixtureEntity("testing")
+	entity.Subscriptions = []string{"webserver"}
+	payload, err := json.Marshal(&types.Event{Entity: entity, Timestamp: 1})
+	require.NoError(t, err)
+	require.NoError(t, session.handleKeepalive(payload))
+
+	topics := []string{}
+	for topic := range session.subscriptions {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	assert.Equal(t, []string{
+		messaging.SubscriptionTopic("org", "env", "entity:testing"),
+		messaging.SubscriptionTopic("org", "env", "webserver"),
+	}, topics)
+}
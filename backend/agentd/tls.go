@@ -0,0 +1,159 @@
+package agentd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+)
+
+// agentTLSConfig returns the TLS configuration of agentd, which verifies the
+// certificates of agents against the given trusted CA file and, if any, the
+// given CRL file. Agents without a certificate are only rejected if
+// requireCert is true.
+func agentTLSConfig(opts *types.TLSOptions, caFile, crlFile string, requireCert bool) (*tls.Config, error) {
+	tlsConfig, err := opts.ToTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading agent auth CA cert: %s", err)
+	}
+	tlsConfig.ClientCAs = x509.NewCertPool()
+	if !tlsConfig.ClientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificate found in agent auth CA cert %q", caFile)
+	}
+
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if requireCert {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	if crlFile != "" {
+		crl, err := loadCRL(crlFile, caPEM)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.VerifyPeerCertificate = verifyNotRevoked(crl)
+	}
+
+	return tlsConfig, nil
+}
+
+// loadCRL reads the given CRL file and verifies it was signed by one of the
+// certificates of the given PEM encoded CA.
+func loadCRL(crlFile string, caPEM []byte) (*pkix.CertificateList, error) {
+	crlBytes, err := ioutil.ReadFile(crlFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading agent auth CRL: %s", err)
+	}
+	crl, err := x509.ParseCRL(crlBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing agent auth CRL: %s", err)
+	}
+
+	cas, err := parseCertificates(caPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing agent auth CA cert: %s", err)
+	}
+	for _, ca := range cas {
+		if ca.CheckCRLSignature(crl) == nil {
+			return crl, nil
+		}
+	}
+	return nil, errors.New("agent auth CRL is not signed by the agent auth CA")
+}
+
+// verifyNotRevoked returns a peer certificate verification function rejecting
+// the certificates revoked by the given CRL.
+func verifyNotRevoked(crl *pkix.CertificateList) func([][]byte, [][]*x509.Certificate) error {
+	return func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		for _, chain := range verifiedChains {
+			for _, cert := range chain {
+				for _, revoked := range crl.TBSCertList.RevokedCertificates {
+					if cert.SerialNumber.Cmp(revoked.SerialNumber) == 0 {
+						return fmt.Errorf("certificate %q was revoked", cert.Subject.CommonName)
+					}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// authenticate is HTTP middleware authenticating agents with their client
+// certificate, whose common name or one of its DNS names must be the agent ID,
+// or with basic authentication if they did not present a certificate.
+func (a *Agentd) authenticate(next http.Handler) http.Handler {
+	basicAuth := middlewares.BasicAuthentication(next, a.store)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			basicAuth.ServeHTTP(w, r)
+			return
+		}
+
+		cert := r.TLS.PeerCertificates[0]
+		agentID := r.Header.Get(transport.HeaderKeyAgentID)
+		if !certificateNames(cert, agentID) {
+			logger.WithField(
+				"agent", agentID,
+			).WithField(
+				"certificate", cert.Subject.CommonName,
+			).Error("agent certificate does not match the agent ID")
+			http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		claims, _ := jwt.NewClaims(agentID)
+		ctx := jwt.SetClaimsIntoContext(r, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// certificateNames returns whether the common name or one of the DNS names of
+// the given certificate is the given name.
+func certificateNames(cert *x509.Certificate, name string) bool {
+	if name == "" {
+		return false
+	}
+	if cert.Subject.CommonName == name {
+		return true
+	}
+	for _, dnsName := range cert.DNSNames {
+		if dnsName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCertificates returns the certificates of the given PEM encoded data.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
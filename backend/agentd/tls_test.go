@@ -0,0 +1,220 @@
+package agentd
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA is a certificate authority issuing agent certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sensu-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// issue returns a client certificate of the given serial number and names.
+func (ca *testCA) issue(t *testing.T, serial int64, cn string, dnsNames ...string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// writeFiles writes the PEM encoded certificate of the CA, and its CRL
+// revoking the given serial numbers, in the given directory.
+func (ca *testCA) writeFiles(t *testing.T, dir string, revoked ...int64) (string, string) {
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})
+	require.NoError(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+	var revokedCerts []pkix.RevokedCertificate
+	for _, serial := range revoked {
+		revokedCerts = append(revokedCerts, pkix.RevokedCertificate{
+			SerialNumber:   big.NewInt(serial),
+			RevocationTime: time.Now(),
+		})
+	}
+	crl, err := ca.cert.CreateCRL(rand.Reader, ca.key, revokedCerts, time.Now(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	crlFile := filepath.Join(dir, "crl.pem")
+	crlPEM := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl})
+	require.NoError(t, ioutil.WriteFile(crlFile, crlPEM, 0600))
+
+	return caFile, crlFile
+}
+
+func TestAgentAuthentication(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-agentd-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t)
+	caFile, crlFile := ca.writeFiles(t, dir, 3)
+
+	testCases := []struct {
+		name        string
+		requireCert bool
+		certs       []tls.Certificate
+		agentID     string
+		user        string
+		wantStatus  int
+		wantErr     bool
+	}{
+		{
+			name:       "common name",
+			certs:      []tls.Certificate{ca.issue(t, 2, "agent1")},
+			agentID:    "agent1",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "dns name",
+			certs:      []tls.Certificate{ca.issue(t, 2, "sensu-agent", "agent1")},
+			agentID:    "agent1",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "another agent",
+			certs:      []tls.Certificate{ca.issue(t, 2, "agent2")},
+			agentID:    "agent1",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:    "revoked certificate",
+			certs:   []tls.Certificate{ca.issue(t, 3, "agent1")},
+			agentID: "agent1",
+			wantErr: true,
+		},
+		{
+			name:    "untrusted certificate",
+			certs:   []tls.Certificate{newTestCA(t).issue(t, 2, "agent1")},
+			agentID: "agent1",
+			wantErr: true,
+		},
+		{
+			name:       "basic authentication",
+			agentID:    "agent1",
+			user:       "agent",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid basic authentication",
+			agentID:    "agent1",
+			user:       "foo",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "required certificate",
+			requireCert: true,
+			agentID:     "agent1",
+			user:        "agent",
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			store.On("AuthenticateUser", mock.Anything, "agent", "P@ssw0rd!").Return(&types.User{}, nil)
+			store.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything).Return((*types.User)(nil), errors.New("unauthorized"))
+			a := &Agentd{store: store}
+
+			tlsConfig, err := agentTLSConfig(&types.TLSOptions{}, caFile, crlFile, tc.requireCert)
+			require.NoError(t, err)
+
+			server := httptest.NewUnstartedServer(a.authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+			server.TLS = tlsConfig
+			server.StartTLS()
+			defer server.Close()
+
+			client := server.Client()
+			client.Transport.(*http.Transport).TLSClientConfig.Certificates = tc.certs
+
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set(transport.HeaderKeyAgentID, tc.agentID)
+			if tc.user != "" {
+				req.SetBasicAuth(tc.user, "P@ssw0rd!")
+			}
+
+			resp, err := client.Do(req)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestAgentTLSConfigCRLIssuer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-agentd-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	caFile, _ := newTestCA(t).writeFiles(t, dir)
+	otherDir := filepath.Join(dir, "other")
+	require.NoError(t, os.Mkdir(otherDir, 0700))
+	_, crlFile := newTestCA(t).writeFiles(t, otherDir)
+
+	_, err = agentTLSConfig(&types.TLSOptions{}, caFile, crlFile, false)
+	assert.Error(t, err)
+}
+
+func TestNewAgentdAuthRequiresTLS(t *testing.T) {
+	_, err := New(Config{AgentAuthTrustedCAFile: "ca.pem"})
+	assert.Error(t, err)
+
+	_, err = New(Config{TLS: &types.TLSOptions{}, RequireAgentCert: true})
+	assert.Error(t, err)
+}
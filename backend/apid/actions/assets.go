@@ -95,6 +95,11 @@ func (a AssetController) Create(ctx context.Context, newAsset types.Asset) error
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := a.Store.UpdateAsset(ctx, &newAsset); err != nil {
 		return NewError(InternalErr, err)
@@ -130,6 +135,11 @@ func (a AssetController) Update(ctx context.Context, given types.Asset) error {
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist Changes
 	if serr := a.Store.UpdateAsset(ctx, asset); serr != nil {
 		return NewError(InternalErr, serr)
@@ -154,6 +164,11 @@ func (a AssetController) CreateOrReplace(ctx context.Context, asset types.Asset)
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist Changes
 	if serr := a.Store.UpdateAsset(ctx, &asset); serr != nil {
 		return NewError(InternalErr, serr)
@@ -0,0 +1,44 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// AuditController exposes actions in which a viewer can perform on the audit
+// log.
+type AuditController struct {
+	Store  store.AuditStore
+	Policy authorization.AuditPolicy
+}
+
+// NewAuditController returns new AuditController
+func NewAuditController(store store.AuditStore) AuditController {
+	return AuditController{
+		Store:  store,
+		Policy: authorization.Audit,
+	}
+}
+
+// Query returns the entries of the audit log available to the viewer, oldest
+// first.
+func (a AuditController) Query(ctx context.Context) ([]*types.AuditEntry, error) {
+	results, err := a.Store.GetAuditEntries(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	// Filter out those resources the viewer does not have access to view.
+	abilities := a.Policy.WithContext(ctx)
+	for i := 0; i < len(results); i++ {
+		if !abilities.CanRead(results[i]) {
+			results = append(results[:i], results[i+1:]...)
+			i--
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,115 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewAuditController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	actions := NewAuditController(store)
+
+	assert.NotNil(actions)
+	assert.Equal(store, actions.Store)
+	assert.NotNil(actions.Policy)
+}
+
+func TestAuditQuery(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeAudit, types.RulePermRead),
+		),
+	)
+
+	otherEnvEntry := types.FixtureAuditEntry("c")
+	otherEnvEntry.Environment = "dev"
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		storeErr     error
+		storeRecords []*types.AuditEntry
+		expectedLen  int
+		expectedErr  error
+	}{
+		{
+			name:         "No Entries",
+			ctx:          defaultCtx,
+			storeRecords: []*types.AuditEntry{},
+			expectedLen:  0,
+		},
+		{
+			name: "With Entries",
+			ctx:  defaultCtx,
+			storeRecords: []*types.AuditEntry{
+				types.FixtureAuditEntry("a"),
+				types.FixtureAuditEntry("b"),
+			},
+			expectedLen: 2,
+		},
+		{
+			name: "With Only Create Access",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeAudit, types.RulePermCreate),
+			)),
+			storeRecords: []*types.AuditEntry{
+				types.FixtureAuditEntry("a"),
+			},
+			expectedLen: 0,
+		},
+		{
+			name: "With Entries Of Other Environments",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				*types.FixtureRule("default", "default"),
+			)),
+			storeRecords: []*types.AuditEntry{
+				types.FixtureAuditEntry("a"),
+				otherEnvEntry,
+			},
+			expectedLen: 1,
+		},
+		{
+			name:         "Store Failure",
+			ctx:          defaultCtx,
+			storeRecords: []*types.AuditEntry{},
+			storeErr:     errors.New("nope"),
+			expectedErr:  NewErrorf(InternalErr),
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewAuditController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.On("GetAuditEntries", mock.Anything).Return(tc.storeRecords, tc.storeErr)
+
+			// Exec Query
+			results, err := actions.Query(tc.ctx)
+
+			// Assert
+			if tc.expectedErr != nil {
+				assert.Error(err)
+				actualErr, ok := err.(Error)
+				assert.True(ok)
+				assert.Equal(tc.expectedErr.(Error).Code, actualErr.Code)
+				return
+			}
+			assert.NoError(err)
+			assert.Len(results, tc.expectedLen)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// CheckResultController exposes actions in which external systems, like cron
+// jobs or serverless functions, submit check results for proxy entities
+// without running an agent.
+type CheckResultController struct {
+	Store        store.Store
+	Bus          messaging.MessageBus
+	Policy       authorization.EventPolicy
+	EntityPolicy authorization.EntityPolicy
+}
+
+// CheckResultOptions controls how a check result is submitted.
+type CheckResultOptions struct {
+	// AutoRegister creates the proxy entity of the result when it does not
+	// exist, instead of rejecting the result.
+	AutoRegister bool
+}
+
+// NewCheckResultController returns new CheckResultController
+func NewCheckResultController(store store.Store, bus messaging.MessageBus) CheckResultController {
+	return CheckResultController{
+		Store:        store,
+		Bus:          bus,
+		Policy:       authorization.Events,
+		EntityPolicy: authorization.Entities,
+	}
+}
+
+// Submit publishes the given check result to the event pipeline, as an event
+// of the proxy entity designated by the proxy_entity_id of the check. The
+// result replaces the previous one of the entity and check, if any.
+func (a CheckResultController) Submit(ctx context.Context, check types.Check, opts CheckResultOptions) (*types.Event, error) {
+	if check.ProxyEntityID == "" {
+		return nil, NewErrorf(InvalidArgument, "check result must specify its proxy_entity_id")
+	}
+
+	// Results belong to the organization and environment of the request
+	check.Organization = types.ContextOrganization(ctx)
+	check.Environment = types.ContextEnvironment(ctx)
+	now := time.Now().Unix()
+	if check.Executed == 0 {
+		check.Executed = now
+	}
+	if check.Issued == 0 {
+		check.Issued = check.Executed
+	}
+	if err := check.Validate(); err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	// Verify permissions before registering the entity
+	policy := a.Policy.WithContext(ctx)
+	placeholder := &types.Event{Entity: &types.Entity{Organization: check.Organization, Environment: check.Environment}}
+	if !(policy.CanCreate(placeholder) && policy.CanUpdate(placeholder)) {
+		return nil, NewErrorf(PermissionDenied, "create/update")
+	}
+
+	entity, err := a.findOrRegisterEntity(ctx, check, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	event := types.Event{
+		Timestamp: now,
+		Entity:    entity,
+		Check:     &check,
+	}
+	events := EventController{Store: a.Store, Policy: a.Policy, Bus: a.Bus}
+	if err := events.CreateOrReplace(ctx, event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// findOrRegisterEntity returns the proxy entity of the given check result,
+// registering it if it does not exist and the options allow it.
+func (a CheckResultController) findOrRegisterEntity(ctx context.Context, check types.Check, opts CheckResultOptions) (*types.Entity, error) {
+	entity, err := a.Store.GetEntityByID(ctx, check.ProxyEntityID)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	if entity != nil {
+		return entity, nil
+	}
+
+	if !opts.AutoRegister {
+		return nil, NewErrorf(NotFound, "entity %q does not exist", check.ProxyEntityID)
+	}
+	entity = &types.Entity{
+		ID:            check.ProxyEntityID,
+		Class:         types.EntityProxyClass,
+		Organization:  check.Organization,
+		Environment:   check.Environment,
+		Subscriptions: []string{types.GetEntitySubscription(check.ProxyEntityID)},
+	}
+
+	// Verify viewer can register the entity
+	abilities := a.EntityPolicy.WithContext(ctx)
+	if !abilities.CanCreate(entity) {
+		return nil, NewErrorf(PermissionDenied, "create entity")
+	}
+	if err := entity.Validate(); err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+	if err := a.Store.UpdateEntity(ctx, entity); err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	return entity, nil
+}
@@ -0,0 +1,168 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockbus"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckResultSubmit(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeEvent,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermCreate),
+		),
+	)
+	noEntityPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeEvent,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermCreate),
+		),
+	)
+
+	newCheck := func(proxyEntityID string) types.Check {
+		check := types.FixtureCheck("backup")
+		check.ProxyEntityID = proxyEntityID
+		check.Executed = 0
+		check.Issued = 0
+		return *check
+	}
+	badCheck := newCheck("db01")
+	badCheck.Interval = 0
+
+	testCases := []struct {
+		name             string
+		ctx              context.Context
+		check            types.Check
+		opts             CheckResultOptions
+		entity           *types.Entity
+		fetchErr         error
+		expectedErr      bool
+		expectedErrCode  ErrCode
+		expectedRegister bool
+	}{
+		{
+			name:   "Existing Entity",
+			ctx:    defaultCtx,
+			check:  newCheck("db01"),
+			entity: types.FixtureEntity("db01"),
+		},
+		{
+			name:             "Registered Entity",
+			ctx:              defaultCtx,
+			check:            newCheck("db01"),
+			opts:             CheckResultOptions{AutoRegister: true},
+			expectedRegister: true,
+		},
+		{
+			name:            "Unregistered Entity",
+			ctx:             defaultCtx,
+			check:           newCheck("db01"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "No Entity Permission",
+			ctx:             noEntityPermsCtx,
+			check:           newCheck("db01"),
+			opts:            CheckResultOptions{AutoRegister: true},
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			check:           newCheck("db01"),
+			entity:          types.FixtureEntity("db01"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "No Proxy Entity",
+			ctx:             defaultCtx,
+			check:           newCheck(""),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			check:           badCheck,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			check:           newCheck("db01"),
+			fetchErr:        errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		bus := &mockbus.MockBus{}
+		actions := NewCheckResultController(store, bus)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.On("GetEntityByID", mock.Anything, "db01").Return(tc.entity, tc.fetchErr)
+			store.On("UpdateEntity", mock.Anything, mock.Anything).Return(nil)
+			bus.On("Publish", messaging.TopicEventRaw, mock.Anything).Return(nil)
+
+			// Exec Query
+			event, err := actions.Submit(tc.ctx, tc.check, tc.opts)
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if ok {
+					assert.Equal(tc.expectedErrCode, inferErr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Given was not of type 'Error'")
+				}
+				bus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(err)
+			assert.Equal("db01", event.Entity.ID)
+			assert.NotZero(event.Timestamp)
+			assert.NotZero(event.Check.Executed)
+			bus.AssertCalled(t, "Publish", messaging.TopicEventRaw, mock.Anything)
+			if tc.expectedRegister {
+				store.AssertCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+				assert.Equal(types.EntityProxyClass, event.Entity.Class)
+				assert.Equal("default", event.Entity.Organization)
+			} else {
+				store.AssertNotCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -31,6 +31,10 @@ var checkConfigUpdateFields = []string{
 	"ProxyRequests",
 	"OutputMetricFormat",
 	"OutputMetricHandlers",
+	"OutputMetricTolerant",
+	"OutputMetricTags",
+	"OutputMetricMapping",
+	"Severities",
 }
 
 var (
@@ -39,13 +43,13 @@ var (
 
 // CheckController exposes actions which a viewer can perform.
 type CheckController struct {
-	store      store.CheckConfigStore
+	store      store.Store
 	policy     authorization.CheckPolicy
 	checkQueue types.Queue
 }
 
 // NewCheckController returns new CheckController
-func NewCheckController(store store.CheckConfigStore, getter types.QueueGetter) CheckController {
+func NewCheckController(store store.Store, getter types.QueueGetter) CheckController {
 	return CheckController{
 		store:      store,
 		policy:     authorization.Checks,
@@ -115,6 +119,11 @@ func (a CheckController) Create(ctx context.Context, newCheck types.CheckConfig)
 		return NewErrorf(PermissionDenied)
 	}
 
+	// Stop there on dry runs, once the references are validated
+	if isDryRun(ctx) {
+		return validateReferences(ctx, a.store, &newCheck, nil)
+	}
+
 	// Persist
 	if err := a.store.UpdateCheckConfig(ctx, &newCheck); err != nil {
 		return NewError(InternalErr, err)
@@ -139,6 +148,11 @@ func (a CheckController) CreateOrReplace(ctx context.Context, newCheck types.Che
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs, once the references are validated
+	if isDryRun(ctx) {
+		return validateReferences(ctx, a.store, &newCheck, nil)
+	}
+
 	// Persist
 	if err := a.store.UpdateCheckConfig(ctx, &newCheck); err != nil {
 		return NewError(InternalErr, err)
@@ -174,6 +188,11 @@ func (a CheckController) Update(ctx context.Context, given types.CheckConfig) er
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs, once the references are validated
+	if isDryRun(ctx) {
+		return validateReferences(ctx, a.store, check, nil)
+	}
+
 	// Persist Changes
 	if serr := a.store.UpdateCheckConfig(ctx, check); serr != nil {
 		return NewError(InternalErr, serr)
@@ -0,0 +1,183 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+type dryRunKey struct{}
+
+var (
+	// builtinFilters are the filters executed by the pipeline without being
+	// stored.
+	builtinFilters = []string{"is_incident", "has_metrics", "not_silenced", "not_flapping", "fatigue_check"}
+
+	// builtinMutators are the mutators executed by the pipeline without being
+	// stored.
+	builtinMutators = []string{"json", "pretty_json", "only_check_output"}
+)
+
+// ContextWithDryRun returns a context making the actions creating or updating
+// resources dry runs: the resources are validated, along with their references
+// to other resources, but not persisted.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// isDryRun returns true if the given context is one of a dry run.
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// referrer is a resource which may refer to other resources of its
+// environment.
+type referrer interface {
+	types.Resource
+	types.MultitenantResource
+}
+
+// reference designates a resource referred to by name by another resource.
+type reference struct {
+	kind string
+	name string
+}
+
+func (r reference) String() string {
+	return fmt.Sprintf("%s %q", r.kind, r.name)
+}
+
+// references returns the resources the given resource refers to.
+func references(resource types.Resource) []reference {
+	refs := []reference{}
+	add := func(kind string, names ...string) {
+		for _, name := range names {
+			refs = append(refs, reference{kind: kind, name: name})
+		}
+	}
+
+	switch r := resource.(type) {
+	case *types.CheckConfig:
+		add("handler", r.Handlers...)
+		add("handler", r.OutputMetricHandlers...)
+		add("asset", r.RuntimeAssets...)
+		for _, hooks := range r.CheckHooks {
+			add("hook", hooks.Hooks...)
+		}
+	case *types.EventFilter:
+		add("asset", r.RuntimeAssets...)
+	case *types.Handler:
+		if r.Mutator != "" && !utilstrings.InArray(r.Mutator, builtinMutators) {
+			add("mutator", r.Mutator)
+		}
+		for _, filter := range r.Filters {
+			if !utilstrings.InArray(filter, builtinFilters) {
+				add("filter", filter)
+			}
+		}
+		add("handler", r.Handlers...)
+		add("asset", r.RuntimeAssets...)
+	case *types.Mutator:
+		add("asset", r.RuntimeAssets...)
+	}
+	return refs
+}
+
+// validateReferences verifies the resources the given resource refers to
+// exist in its environment, either in the store or among the given pending
+// resources, which are about to be stored along with it. Handlers, filters and
+// mutators may also be provided by extensions.
+func validateReferences(ctx context.Context, s store.Store, resource referrer, pending []types.Resource) error {
+	ctx = addOrgEnvToContext(ctx, resource)
+	missing := []string{}
+	for _, ref := range references(resource) {
+		if isPending(ref, resource, pending) {
+			continue
+		}
+		found, err := findReference(ctx, s, ref)
+		if err != nil {
+			return NewError(InternalErr, err)
+		}
+		if !found {
+			missing = append(missing, ref.String())
+		}
+	}
+
+	if len(missing) > 0 {
+		return NewErrorf(InvalidArgument, "%s refers to missing %s", resource.URIPath(), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// findReference returns true if the given referenced resource is stored in the
+// environment of the given context.
+func findReference(ctx context.Context, s store.Store, ref reference) (bool, error) {
+	var found bool
+	var err error
+	switch ref.kind {
+	case "asset":
+		var asset *types.Asset
+		asset, err = s.GetAssetByName(ctx, ref.name)
+		found = asset != nil
+	case "filter":
+		var filter *types.EventFilter
+		filter, err = s.GetEventFilterByName(ctx, ref.name)
+		found = filter != nil
+	case "handler":
+		var handler *types.Handler
+		handler, err = s.GetHandlerByName(ctx, ref.name)
+		found = handler != nil
+	case "hook":
+		var hook *types.HookConfig
+		hook, err = s.GetHookConfigByName(ctx, ref.name)
+		found = hook != nil
+	case "mutator":
+		var mutator *types.Mutator
+		mutator, err = s.GetMutatorByName(ctx, ref.name)
+		found = mutator != nil
+	}
+	if err != nil || found || ref.kind == "asset" || ref.kind == "hook" {
+		return found, err
+	}
+
+	// Handlers, filters and mutators may be provided by extensions
+	if _, err := s.GetExtension(ctx, ref.name); err != nil {
+		if err == store.ErrNoExtension {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isPending returns true if the given referenced resource is among the given
+// pending resources, in the environment of the resource referring to it.
+func isPending(ref reference, from referrer, pending []types.Resource) bool {
+	for _, resource := range pending {
+		var kind, name, org, env string
+		switch r := resource.(type) {
+		case *types.Asset:
+			kind, name, org, env = "asset", r.Name, r.Organization, from.GetEnvironment()
+		case *types.EventFilter:
+			kind, name, org, env = "filter", r.Name, r.Organization, r.Environment
+		case *types.Handler:
+			kind, name, org, env = "handler", r.Name, r.Organization, r.Environment
+		case *types.HookConfig:
+			kind, name, org, env = "hook", r.Name, r.Organization, r.Environment
+		case *types.Mutator:
+			kind, name, org, env = "mutator", r.Name, r.Organization, r.Environment
+		default:
+			continue
+		}
+		if kind == ref.kind && name == ref.name &&
+			org == from.GetOrganization() && env == from.GetEnvironment() {
+			return true
+		}
+	}
+	return false
+}
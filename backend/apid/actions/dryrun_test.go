@@ -0,0 +1,94 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWithDryRun(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, isDryRun(ctx))
+	assert.True(t, isDryRun(ContextWithDryRun(ctx)))
+}
+
+func TestValidateReferences(t *testing.T) {
+	handler := types.FixtureHandler("handler")
+	handler.Mutator = "mutator"
+	handler.Filters = []string{"is_incident", "filter", "extension"}
+	handler.Handlers = []string{"other"}
+
+	testCases := []struct {
+		name        string
+		pending     []types.Resource
+		mutator     *types.Mutator
+		wantMissing string
+	}{
+		{
+			name:        "missing mutator",
+			wantMissing: `/handlers/handler refers to missing mutator "mutator"`,
+		},
+		{
+			name:    "stored mutator",
+			mutator: types.FixtureMutator("mutator"),
+		},
+		{
+			name:    "pending mutator",
+			pending: []types.Resource{types.FixtureMutator("mutator")},
+		},
+		{
+			name: "pending mutator of another environment",
+			pending: []types.Resource{&types.Mutator{
+				Name:         "mutator",
+				Command:      "cat",
+				Organization: "default",
+				Environment:  "dev",
+			}},
+			wantMissing: `/handlers/handler refers to missing mutator "mutator"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := &mockstore.MockStore{}
+			st.On("GetMutatorByName", mock.Anything, "mutator").Return(tc.mutator, nil)
+			st.On("GetEventFilterByName", mock.Anything, "filter").Return(types.FixtureEventFilter("filter"), nil)
+			st.On("GetEventFilterByName", mock.Anything, "extension").Return((*types.EventFilter)(nil), nil)
+			st.On("GetHandlerByName", mock.Anything, "other").Return(types.FixtureHandler("other"), nil)
+			st.On("GetExtension", mock.Anything, "extension").Return(&types.Extension{URL: "http://127.0.0.1"}, nil)
+			st.On("GetExtension", mock.Anything, "mutator").Return((*types.Extension)(nil), store.ErrNoExtension)
+
+			err := validateReferences(context.Background(), st, handler, tc.pending)
+			if tc.wantMissing == "" {
+				assert.NoError(t, err)
+				return
+			}
+			actionErr, ok := err.(Error)
+			require.True(t, ok, "error is an action error")
+			assert.Equal(t, InvalidArgument, actionErr.Code)
+			assert.Equal(t, tc.wantMissing, actionErr.Message)
+		})
+	}
+}
+
+func TestHandlerCreateDryRun(t *testing.T) {
+	ctx := ContextWithDryRun(testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeHandler, types.RulePermCreate),
+		),
+	))
+
+	st := &mockstore.MockStore{}
+	st.On("GetHandlerByName", mock.Anything, "foo").Return((*types.Handler)(nil), nil)
+	ctl := NewHandlerController(st)
+
+	require.NoError(t, ctl.Create(ctx, *types.FixtureHandler("foo")))
+	st.AssertNotCalled(t, "UpdateHandler", mock.Anything, mock.Anything)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -17,13 +19,15 @@ var entityUpdateFields = []string{
 type EntityController struct {
 	Store  store.EntityStore
 	Policy authorization.EntityPolicy
+	Bus    messaging.MessageBus
 }
 
 // NewEntityController returns new EntityController
-func NewEntityController(store store.EntityStore) EntityController {
+func NewEntityController(store store.EntityStore, bus messaging.MessageBus) EntityController {
 	return EntityController{
 		Store:  store,
 		Policy: authorization.Entities,
+		Bus:    bus,
 	}
 }
 
@@ -49,6 +53,14 @@ func (c EntityController) Destroy(ctx context.Context, id string) error {
 		return NewError(InternalErr, err)
 	}
 
+	// Notify the deregistration handler of the entity, if any
+	if c.Bus != nil && result.Deregistration.Handler != "" {
+		event := keepalived.NewDeregistrationEvent(result)
+		if err := c.Bus.Publish(messaging.TopicEvent, event); err != nil {
+			return NewError(InternalErr, err)
+		}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockbus"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/types"
@@ -16,7 +18,7 @@ func TestNewEntityController(t *testing.T) {
 	assert := assert.New(t)
 
 	store := &mockstore.MockStore{}
-	actions := NewEntityController(store)
+	actions := NewEntityController(store, nil)
 
 	assert.NotNil(actions)
 	assert.Equal(store, actions.Store)
@@ -92,7 +94,7 @@ func TestEntityDestroy(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -123,6 +125,33 @@ func TestEntityDestroy(t *testing.T) {
 	}
 }
 
+func TestEntityDestroyDeregistration(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermDelete),
+		),
+	)
+
+	entity := types.FixtureEntity("entity1")
+	entity.Deregistration.Handler = "cmdb"
+
+	store := &mockstore.MockStore{}
+	store.On("GetEntityByID", mock.Anything, "entity1").Return(entity, nil)
+	store.On("DeleteEntityByID", mock.Anything, "entity1").Return(nil)
+
+	bus := &mockbus.MockBus{}
+	bus.On("Publish", messaging.TopicEvent, mock.Anything).Return(nil)
+
+	actions := NewEntityController(store, bus)
+	assert.NoError(t, actions.Destroy(ctx, "entity1"))
+
+	bus.AssertNumberOfCalls(t, "Publish", 1)
+	event := bus.Calls[0].Arguments.Get(1).(*types.Event)
+	assert.Equal(t, "deregistration", event.Check.Name)
+	assert.Equal(t, []string{"cmdb"}, event.Check.Handlers)
+}
+
 func TestEntityFind(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithOrgEnv("default", "default"),
@@ -176,7 +205,7 @@ func TestEntityFind(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -259,7 +288,7 @@ func TestEntityQuery(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -357,7 +386,7 @@ func TestEntityUpdate(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -463,7 +492,7 @@ func TestEntityCreate(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -559,7 +588,7 @@ func TestEntityCreateOrReplace(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
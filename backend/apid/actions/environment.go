@@ -10,6 +10,8 @@ import (
 
 var envUpdateFields = []string{
 	"Description",
+	"EventRetention",
+	"Redact",
 }
 
 // EnvironmentController allows querying Environments in bulk or by name.
@@ -1,6 +1,11 @@
 package actions
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
 
 //
 // Following defines error type w/ error codes. Helpful for
@@ -38,16 +43,22 @@ const (
 	// Unauthenticated used when viewer is not authenticated but action requires
 	// viewer to be authenticated.
 	Unauthenticated
+
+	// PreconditionFailed means that the resource does not satisfy the
+	// preconditions of the operation. Eg. if the resource was modified since
+	// the viewer last read it.
+	PreconditionFailed
 )
 
 // Default error messages if not message is provided.
 var standardErrorMessages = map[ErrCode]string{
-	InternalErr:      "internal error occurred",
-	InvalidArgument:  "invalid argument(s) received",
-	NotFound:         "not found",
-	AlreadyExistsErr: "resource already exists",
-	PermissionDenied: "unauthorized to perform action",
-	Unauthenticated:  "unauthenticated",
+	InternalErr:        "internal error occurred",
+	InvalidArgument:    "invalid argument(s) received",
+	NotFound:           "not found",
+	AlreadyExistsErr:   "resource already exists",
+	PermissionDenied:   "unauthorized to perform action",
+	Unauthenticated:    "unauthenticated",
+	PreconditionFailed: "precondition failed",
 }
 
 // Error describes an issue that ocurred while performing the action.
@@ -57,6 +68,9 @@ type Error struct {
 	// Message is a developer / operator friendly message briefly describing what
 	// occurred.
 	Message string
+	// Details are the validation errors of the fields of the resource given by
+	// the user, when invalid.
+	Details types.FieldErrors
 }
 
 // Error method implements error interface
@@ -66,7 +80,16 @@ func (err Error) Error() string {
 
 // NewError returns a new Error given existing error and code.
 func NewError(code ErrCode, err error) Error {
-	return Error{Code: code, Message: err.Error()}
+	// Continue tokens are given by clients, regardless of the store operation
+	if err == store.ErrInvalidContinueToken {
+		code = InvalidArgument
+	}
+	// Preconditions are given by clients too, see store.ContextWithPrecondition
+	if err == store.ErrPreconditionFailed {
+		code = PreconditionFailed
+	}
+	details, _ := err.(types.FieldErrors)
+	return Error{Code: code, Message: err.Error(), Details: details}
 }
 
 // NewErrorf returns a new Error given message and code.
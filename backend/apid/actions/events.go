@@ -135,7 +135,8 @@ func (a EventController) Create(ctx context.Context, event types.Event) error {
 		}
 	}
 
-	// Publish to event pipeline
+	// Publish to event pipeline, tracing the event back to the request
+	event.RequestID = types.ContextRequestID(ctx)
 	if err := a.Bus.Publish(messaging.TopicEventRaw, &event); err != nil {
 		return NewError(InternalErr, err)
 	}
@@ -158,7 +159,8 @@ func (a EventController) CreateOrReplace(ctx context.Context, event types.Event)
 		return NewError(InvalidArgument, err)
 	}
 
-	// Publish to event pipeline
+	// Publish to event pipeline, tracing the event back to the request
+	event.RequestID = types.ContextRequestID(ctx)
 	if err := a.Bus.Publish(messaging.TopicEventRaw, &event); err != nil {
 		return NewError(InternalErr, err)
 	}
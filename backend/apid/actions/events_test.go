@@ -5,12 +5,14 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/testing/mockbus"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewEventController(t *testing.T) {
@@ -517,3 +519,27 @@ func TestEventCreateOrReplace(t *testing.T) {
 		})
 	}
 }
+
+func TestEventCreateOrReplaceRequestID(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeEvent,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+		),
+	)
+	ctx = context.WithValue(ctx, types.RequestIDKey, "abc")
+
+	bus := &mockbus.MockBus{}
+	var published *types.Event
+	bus.On("Publish", messaging.TopicEventRaw, mock.Anything).Run(func(args mock.Arguments) {
+		published = args.Get(1).(*types.Event)
+	}).Return(nil)
+	actions := NewEventController(&mockstore.MockStore{}, bus)
+
+	require.NoError(t, actions.CreateOrReplace(ctx, *types.FixtureEvent("entity1", "check1")))
+	require.NotNil(t, published)
+	assert.Equal(t, "abc", published.RequestID)
+}
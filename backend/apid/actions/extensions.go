@@ -93,7 +93,7 @@ func (e ExtensionController) Register(ctx context.Context, extension types.Exten
 // Deregister deletes the extension from the registry.
 func (e ExtensionController) Deregister(ctx context.Context, name string) error {
 	if err := e.Store.DeregisterExtension(ctx, name); err != nil {
-		return NewError(InternalErr, nil)
+		return NewError(InternalErr, err)
 	}
 	return nil
 }
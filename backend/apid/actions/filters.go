@@ -54,6 +54,11 @@ func (c EventFilterController) Create(ctx context.Context, filter types.EventFil
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := c.Store.UpdateEventFilter(ctx, &filter); err != nil {
 		return NewError(InternalErr, err)
@@ -81,6 +86,11 @@ func (c EventFilterController) CreateOrReplace(ctx context.Context, filter types
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := c.Store.UpdateEventFilter(ctx, &filter); err != nil {
 		return NewError(InternalErr, err)
@@ -121,6 +131,11 @@ func (c EventFilterController) Update(ctx context.Context, delta types.EventFilt
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := c.Store.UpdateEventFilter(ctx, filter); err != nil {
 		return NewError(InternalErr, err)
@@ -3,10 +3,12 @@ package actions
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
 )
 
 var updateFields = []string{
@@ -17,16 +19,20 @@ var updateFields = []string{
 	"Command",
 	"Handlers",
 	"Socket",
+	"Slack",
+	"PagerDuty",
+	"Email",
+	"RuntimeAssets",
 }
 
 // HandlerController exposes actions available for handlers
 type HandlerController struct {
-	Store  store.HandlerStore
+	Store  store.Store
 	Policy authorization.HandlerPolicy
 }
 
 // NewHandlerController creates a new HandlerController backed by store.
-func NewHandlerController(store store.HandlerStore) HandlerController {
+func NewHandlerController(store store.Store) HandlerController {
 	return HandlerController{
 		Store:  store,
 		Policy: authorization.Handlers,
@@ -63,6 +69,15 @@ func (c HandlerController) Create(ctx context.Context, handler types.Handler) er
 		return NewError(InvalidArgument, errors.New("use the extensions API for this handler type"))
 	}
 
+	if err := c.validateHandlerSet(ctx, &handler); err != nil {
+		return err
+	}
+
+	// Stop there on dry runs, once the references are validated
+	if isDryRun(ctx) {
+		return validateReferences(ctx, c.Store, &handler, nil)
+	}
+
 	// Persist
 	if err := c.Store.UpdateHandler(ctx, &handler); err != nil {
 		return NewError(InternalErr, err)
@@ -94,6 +109,15 @@ func (c HandlerController) CreateOrReplace(ctx context.Context, handler types.Ha
 		return NewError(InvalidArgument, errors.New("use the extensions API for this handler type"))
 	}
 
+	if err := c.validateHandlerSet(ctx, &handler); err != nil {
+		return err
+	}
+
+	// Stop there on dry runs, once the references are validated
+	if isDryRun(ctx) {
+		return validateReferences(ctx, c.Store, &handler, nil)
+	}
+
 	// Persist
 	if err := c.Store.UpdateHandler(ctx, &handler); err != nil {
 		return NewError(InternalErr, err)
@@ -192,6 +216,15 @@ func (c HandlerController) Update(ctx context.Context, newHandler types.Handler)
 		return NewError(InvalidArgument, err)
 	}
 
+	if err := c.validateHandlerSet(ctx, handler); err != nil {
+		return err
+	}
+
+	// Stop there on dry runs, once the references are validated
+	if isDryRun(ctx) {
+		return validateReferences(ctx, c.Store, handler, nil)
+	}
+
 	// Persist Changes
 	if serr := c.Store.UpdateHandler(ctx, handler); serr != nil {
 		return NewError(InternalErr, serr)
@@ -199,3 +232,39 @@ func (c HandlerController) Update(ctx context.Context, newHandler types.Handler)
 
 	return nil
 }
+
+// validateHandlerSet verifies the given handler set does not include itself
+// through the handler sets it includes, and does not nest them deeper than the
+// pipeline expands them, as those handlers would never be executed. Handlers
+// which are not stored yet are ignored.
+func (c HandlerController) validateHandlerSet(ctx context.Context, handler *types.Handler) error {
+	var validate func(set *types.Handler, sets []string) error
+	validate = func(set *types.Handler, sets []string) error {
+		if len(sets) >= types.HandlerSetMaxDepth {
+			return NewErrorf(InvalidArgument, "handler sets cannot be nested deeper than %d: %s",
+				types.HandlerSetMaxDepth-1, strings.Join(sets, " > "))
+		}
+		for _, name := range set.Handlers {
+			if utilstrings.InArray(name, sets) {
+				return NewErrorf(InvalidArgument, "handler set %s includes itself: %s > %s",
+					name, strings.Join(sets, " > "), name)
+			}
+			included, err := c.Store.GetHandlerByName(ctx, name)
+			if err != nil {
+				return NewError(InternalErr, err)
+			}
+			if included == nil || included.Type != types.HandlerSetType {
+				continue
+			}
+			if err := validate(included, append(append([]string{}, sets...), name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if handler.Type != types.HandlerSetType {
+		return nil
+	}
+	return validate(handler, []string{handler.Name})
+}
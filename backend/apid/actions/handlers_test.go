@@ -212,6 +212,69 @@ func TestHandlerCreateOrReplace(t *testing.T) {
 	}
 }
 
+func TestHandlerCreateOrReplaceSet(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeHandler,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+		),
+	)
+
+	tests := []struct {
+		name        string
+		argument    *types.Handler
+		expectedErr bool
+	}{
+		{
+			name:     "Nested Sets",
+			argument: types.FixtureSetHandler("set1", "handler1", "set2"),
+		},
+		{
+			name:        "Cycle",
+			argument:    types.FixtureSetHandler("set1", "set3"),
+			expectedErr: true,
+		},
+		{
+			name:        "Too Deep",
+			argument:    types.FixtureSetHandler("set1", "set4"),
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewHandlerController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetHandlerByName", mock.Anything, "handler1").Return(types.FixtureHandler("handler1"), nil)
+			store.On("GetHandlerByName", mock.Anything, "set2").Return(types.FixtureSetHandler("set2", "handler1", "unknown"), nil)
+			store.On("GetHandlerByName", mock.Anything, "set3").Return(types.FixtureSetHandler("set3", "set1"), nil)
+			store.On("GetHandlerByName", mock.Anything, "set4").Return(types.FixtureSetHandler("set4", "set2"), nil)
+			store.On("GetHandlerByName", mock.Anything, "unknown").Return((*types.Handler)(nil), nil)
+			store.On("UpdateHandler", mock.Anything, mock.Anything).Return(nil)
+
+			err := ctl.CreateOrReplace(ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(InvalidArgument, cerr.Code)
+				} else {
+					assert.FailNow("Not of type 'Error'")
+				}
+				store.AssertNotCalled(t, "UpdateHandler", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
 func TestHandlerDestroy(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithOrgEnv("default", "default"),
@@ -90,6 +90,11 @@ func (a HookController) Create(ctx context.Context, newHook types.HookConfig) er
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := a.Store.UpdateHookConfig(ctx, &newHook); err != nil {
 		return NewError(InternalErr, err)
@@ -114,6 +119,11 @@ func (a HookController) CreateOrReplace(ctx context.Context, newHook types.HookC
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := a.Store.UpdateHookConfig(ctx, &newHook); err != nil {
 		return NewError(InternalErr, err)
@@ -149,6 +159,11 @@ func (a HookController) Update(ctx context.Context, given types.HookConfig) erro
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist Changes
 	if serr := a.Store.UpdateHookConfig(ctx, hook); serr != nil {
 		return NewError(InternalErr, serr)
@@ -12,6 +12,8 @@ var mutatorUpdateFields = []string{
 	"Command",
 	"Timeout",
 	"EnvVars",
+	"RuntimeAssets",
+	"Secrets",
 }
 
 // MutatorController allows querying mutators in bulk or by name.
@@ -54,6 +56,11 @@ func (c MutatorController) Create(ctx context.Context, mut types.Mutator) error
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := c.Store.UpdateMutator(ctx, &mut); err != nil {
 		return NewError(InternalErr, err)
@@ -81,6 +88,11 @@ func (c MutatorController) CreateOrReplace(ctx context.Context, mut types.Mutato
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := c.Store.UpdateMutator(ctx, &mut); err != nil {
 		return NewError(InternalErr, err)
@@ -121,6 +133,11 @@ func (c MutatorController) Update(ctx context.Context, delta types.Mutator) erro
 		return NewError(InvalidArgument, err)
 	}
 
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
 	// Persist
 	if err := c.Store.UpdateMutator(ctx, mut); err != nil {
 		return NewError(InternalErr, err)
@@ -0,0 +1,135 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// NamespaceController manages the namespaces, which are the environments of
+// the organizations addressed by a single name.
+type NamespaceController struct {
+	Organizations OrganizationsController
+	Environments  EnvironmentController
+}
+
+// NewNamespaceController creates a new NamespaceController backed by the
+// organizations and environments stores.
+func NewNamespaceController(orgs store.OrganizationStore, envs store.EnvironmentStore) NamespaceController {
+	return NamespaceController{
+		Organizations: NewOrganizationsController(orgs),
+		Environments:  NewEnvironmentController(envs),
+	}
+}
+
+// Query returns the namespaces available to the viewer, which are the
+// environments it can read in the organizations it can read.
+func (c NamespaceController) Query(ctx context.Context) ([]*types.Namespace, error) {
+	orgs, err := c.Organizations.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*types.Namespace{}
+	for _, org := range orgs {
+		envs, err := c.Environments.Query(ctx, org.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, env := range envs {
+			results = append(results, newNamespace(env))
+		}
+	}
+
+	return results, nil
+}
+
+// Find returns the namespace of the given organization and environment if
+// available to the viewer.
+func (c NamespaceController) Find(ctx context.Context, org, env string) (*types.Namespace, error) {
+	result, err := c.Environments.Find(ctx, org, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNamespace(result), nil
+}
+
+// Create creates a new namespace, creating its organization if it does not
+// exist yet. It returns an error if the namespace already exists.
+func (c NamespaceController) Create(ctx context.Context, ns types.Namespace) error {
+	// Validate
+	if err := ns.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	orgCreated, err := c.ensureOrganization(ctx, ns.GetOrganization())
+	if err != nil {
+		return err
+	}
+
+	env := namespaceEnvironment(ns)
+
+	// The default environment is created along with its organization
+	if orgCreated && env.Name == "default" {
+		return c.Environments.CreateOrReplace(ctx, env)
+	}
+
+	return c.Environments.Create(ctx, env)
+}
+
+// CreateOrReplace creates or replaces a namespace, creating its organization
+// if it does not exist yet.
+func (c NamespaceController) CreateOrReplace(ctx context.Context, ns types.Namespace) error {
+	// Validate
+	if err := ns.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	if _, err := c.ensureOrganization(ctx, ns.GetOrganization()); err != nil {
+		return err
+	}
+
+	return c.Environments.CreateOrReplace(ctx, namespaceEnvironment(ns))
+}
+
+// ensureOrganization creates the given organization if it does not exist, and
+// returns whether it was created.
+func (c NamespaceController) ensureOrganization(ctx context.Context, name string) (bool, error) {
+	org, err := c.Organizations.Store.GetOrganizationByName(ctx, name)
+	if err != nil {
+		return false, NewError(InternalErr, err)
+	} else if org != nil {
+		return false, nil
+	}
+
+	if err := c.Organizations.Create(ctx, types.Organization{Name: name}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Destroy destroys the namespace of the given organization and environment.
+// Its organization is kept.
+func (c NamespaceController) Destroy(ctx context.Context, org, env string) error {
+	return c.Environments.Destroy(ctx, org, env)
+}
+
+// newNamespace returns the namespace of the given environment
+func newNamespace(env *types.Environment) *types.Namespace {
+	ns := types.NewNamespace(env.Organization, env.Name)
+	ns.Description = env.Description
+	ns.Redact = env.Redact
+	return ns
+}
+
+// namespaceEnvironment returns the environment of the given namespace
+func namespaceEnvironment(ns types.Namespace) types.Environment {
+	return types.Environment{
+		Name:         ns.GetEnvironment(),
+		Organization: ns.GetOrganization(),
+		Description:  ns.Description,
+		Redact:       ns.Redact,
+	}
+}
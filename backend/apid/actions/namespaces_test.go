@@ -0,0 +1,178 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceQuery(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeOrganization, types.RulePermRead),
+		types.FixtureRuleWithPerms(types.RuleTypeEnvironment, types.RulePermRead),
+	))
+
+	store := &mockstore.MockStore{}
+	ctl := NewNamespaceController(store, store)
+
+	acmeProd := types.FixtureEnvironment("production")
+	acmeProd.Organization = "acme"
+	store.On("GetOrganizations", ctx).Return([]*types.Organization{
+		types.FixtureOrganization("default"),
+		types.FixtureOrganization("acme"),
+	}, nil)
+	store.On("GetEnvironments", ctx, "default").Return([]*types.Environment{
+		types.FixtureEnvironment("default"),
+	}, nil)
+	store.On("GetEnvironments", ctx, "acme").Return([]*types.Environment{acmeProd}, nil)
+
+	results, err := ctl.Query(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "default/default", results[0].Name)
+	assert.Equal(t, "acme/production", results[1].Name)
+}
+
+func TestNamespaceFind(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEnvironment, types.RulePermRead),
+	))
+
+	store := &mockstore.MockStore{}
+	ctl := NewNamespaceController(store, store)
+
+	env := types.FixtureEnvironment("production")
+	env.Description = "Production"
+	store.On("GetEnvironment", ctx, "default", "production").Return(env, nil)
+	store.On("GetEnvironment", ctx, "default", "staging").Return((*types.Environment)(nil), nil)
+
+	result, err := ctl.Find(ctx, "default", "production")
+	require.NoError(t, err)
+	assert.Equal(t, "default/production", result.Name)
+	assert.Equal(t, "Production", result.Description)
+
+	_, err = ctl.Find(ctx, "default", "staging")
+	require.Error(t, err)
+	assert.Equal(t, NotFound, err.(Error).Code)
+}
+
+func TestNamespaceCreate(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeOrganization, types.RulePermCreate),
+		types.FixtureRuleWithPerms(types.RuleTypeEnvironment, types.RulePermCreate, types.RulePermUpdate),
+	))
+
+	tests := []struct {
+		name            string
+		namespace       string
+		org             *types.Organization
+		env             *types.Environment
+		expectOrg       bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:      "Existing Organization",
+			namespace: "default/production",
+			org:       types.FixtureOrganization("default"),
+		},
+		{
+			name:      "New Organization",
+			namespace: "acme/production",
+			expectOrg: true,
+		},
+		{
+			name:      "New Organization Default Environment",
+			namespace: "acme/default",
+			expectOrg: true,
+		},
+		{
+			name:            "Already Exists",
+			namespace:       "default/production",
+			org:             types.FixtureOrganization("default"),
+			env:             types.FixtureEnvironment("production"),
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "Invalid",
+			namespace:       "production",
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewNamespaceController(store, store)
+
+			store.On("GetOrganizationByName", mock.Anything, mock.Anything).Return(test.org, nil)
+			store.On("CreateOrganization", mock.Anything, mock.Anything).Return(nil)
+			store.On("GetEnvironment", mock.Anything, mock.Anything, mock.Anything).Return(test.env, nil)
+			store.On("UpdateEnvironment", mock.Anything, mock.Anything).Return(nil)
+
+			err := ctl.Create(ctx, types.Namespace{Name: test.namespace})
+			if test.expectedErrCode != 0 {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErrCode, err.(Error).Code)
+				return
+			}
+			require.NoError(t, err)
+
+			if test.expectOrg {
+				store.AssertCalled(t, "CreateOrganization", mock.Anything, mock.Anything)
+			} else {
+				store.AssertNotCalled(t, "CreateOrganization", mock.Anything, mock.Anything)
+			}
+			store.AssertCalled(t, "UpdateEnvironment", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestNamespaceDestroy(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEnvironment, types.RulePermDelete),
+	))
+
+	store := &mockstore.MockStore{}
+	ctl := NewNamespaceController(store, store)
+
+	env := types.FixtureEnvironment("production")
+	store.On("GetEnvironment", ctx, "default", "production").Return(env, nil)
+	store.On("DeleteEnvironment", ctx, env).Return(nil)
+
+	require.NoError(t, ctl.Destroy(ctx, "default", "production"))
+	store.AssertCalled(t, "DeleteEnvironment", ctx, env)
+}
+
+func TestNamespaceCreateOrReplace(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeOrganization, types.RulePermCreate),
+		types.FixtureRuleWithPerms(types.RuleTypeEnvironment, types.RulePermCreate, types.RulePermUpdate),
+	))
+
+	store := &mockstore.MockStore{}
+	ctl := NewNamespaceController(store, store)
+
+	store.On("GetOrganizationByName", mock.Anything, "acme").Return((*types.Organization)(nil), nil)
+	store.On("CreateOrganization", mock.Anything, mock.Anything).Return(nil)
+	store.On("GetEnvironment", mock.Anything, "acme", "production").Return(types.FixtureEnvironment("production"), nil)
+	store.On("UpdateEnvironment", mock.Anything, mock.Anything).Return(nil)
+
+	ns := types.NewNamespace("acme", "production")
+	ns.Description = "Production"
+	require.NoError(t, ctl.CreateOrReplace(ctx, *ns))
+	store.AssertCalled(t, "CreateOrganization", mock.Anything, mock.Anything)
+	store.AssertCalled(t, "UpdateEnvironment", mock.Anything, &types.Environment{
+		Name:         "production",
+		Organization: "acme",
+		Description:  "Production",
+	})
+
+	err := ctl.CreateOrReplace(ctx, types.Namespace{Name: "acme"})
+	require.Error(t, err)
+	assert.Equal(t, InvalidArgument, err.(Error).Code)
+}
@@ -0,0 +1,99 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// PipelineErrorController exposes actions in which a viewer can inspect and
+// discard the events whose handlers ultimately failed in the pipeline.
+type PipelineErrorController struct {
+	Store  store.ErrorStore
+	Policy authorization.EventPolicy
+}
+
+// NewPipelineErrorController returns new PipelineErrorController
+func NewPipelineErrorController(store store.ErrorStore) PipelineErrorController {
+	return PipelineErrorController{
+		Store:  store,
+		Policy: authorization.Events,
+	}
+}
+
+// Query returns resources available to the viewer filter by given params.
+func (a PipelineErrorController) Query(ctx context.Context, entity, check string) ([]*types.Error, error) {
+	var results []*types.Error
+
+	// Fetch from store
+	var serr error
+	if entity != "" && check != "" {
+		results, serr = a.Store.GetErrorsByEntityCheck(ctx, entity, check)
+	} else if entity != "" {
+		results, serr = a.Store.GetErrorsByEntity(ctx, entity)
+	} else {
+		results, serr = a.Store.GetErrors(ctx)
+	}
+
+	if serr != nil {
+		return nil, NewError(InternalErr, serr)
+	}
+
+	// Filter out those resources the viewer does not have access to view.
+	abilities := a.Policy.WithContext(ctx)
+	for i := 0; i < len(results); i++ {
+		if !abilities.CanRead(&results[i].Event) {
+			results = append(results[:i], results[i+1:]...)
+			i--
+		}
+	}
+
+	return results, nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+func (a PipelineErrorController) Find(ctx context.Context, entity, check, timestamp string) (*types.Error, error) {
+	if entity == "" || check == "" || timestamp == "" {
+		return nil, NewErrorf(InvalidArgument, "Find() requires an entity, a check and a timestamp")
+	}
+
+	result, err := a.Store.GetError(ctx, entity, check, timestamp)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	// Verify user has permission to view
+	abilities := a.Policy.WithContext(ctx)
+	if result != nil && abilities.CanRead(&result.Event) {
+		return result, nil
+	}
+
+	return nil, NewErrorf(NotFound)
+}
+
+// Destroy destroys the error indicated by the supplied entity, check and
+// timestamp.
+func (a PipelineErrorController) Destroy(ctx context.Context, entity, check, timestamp string) error {
+	if entity == "" || check == "" || timestamp == "" {
+		return NewErrorf(InvalidArgument, "Destroy() requires an entity, a check and a timestamp")
+	}
+
+	result, err := a.Store.GetError(ctx, entity, check, timestamp)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Verify user has permission to delete
+	abilities := a.Policy.WithContext(ctx)
+	if result != nil && abilities.CanDelete() {
+		if err := a.Store.DeleteError(ctx, entity, check, timestamp); err != nil {
+			return NewError(InternalErr, err)
+		}
+		return nil
+	}
+
+	return NewErrorf(NotFound)
+}
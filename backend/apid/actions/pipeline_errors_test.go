@@ -0,0 +1,218 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPipelineErrorQuery(t *testing.T) {
+	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+	))
+
+	testCases := []struct {
+		name        string
+		ctx         context.Context
+		entity      string
+		check       string
+		expectedLen int
+		storeErr    error
+		expectedErr bool
+	}{
+		{
+			name:        "No Params",
+			ctx:         defaultCtx,
+			expectedLen: 2,
+		},
+		{
+			name: "No Params With Only Create Access",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermCreate),
+			)),
+			expectedLen: 0,
+		},
+		{
+			name:        "Entity Param",
+			ctx:         defaultCtx,
+			entity:      "entity1",
+			expectedLen: 2,
+		},
+		{
+			name:        "Entity Check Params",
+			ctx:         defaultCtx,
+			entity:      "entity1",
+			check:       "check1",
+			expectedLen: 2,
+		},
+		{
+			name:        "Store Failure",
+			ctx:         defaultCtx,
+			storeErr:    errors.New("dunno"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewPipelineErrorController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			perrs := []*types.Error{
+				types.FixtureError("handler1", "handler1: boom"),
+				types.FixtureError("handler2", "handler2: boom"),
+			}
+			store.On("GetErrors", tc.ctx).Return(perrs, tc.storeErr)
+			store.On("GetErrorsByEntity", tc.ctx, mock.Anything).Return(perrs, tc.storeErr)
+			store.On("GetErrorsByEntityCheck", tc.ctx, mock.Anything, mock.Anything).Return(perrs, tc.storeErr)
+
+			results, err := actions.Query(tc.ctx, tc.entity, tc.check)
+			if tc.expectedErr {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Len(results, tc.expectedLen)
+		})
+	}
+}
+
+func TestPipelineErrorFind(t *testing.T) {
+	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+	))
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		perr            *types.Error
+		timestamp       string
+		expected        bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:            "No Timestamp",
+			ctx:             defaultCtx,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Not Found",
+			ctx:             defaultCtx,
+			timestamp:       "1520000000",
+			expectedErrCode: NotFound,
+		},
+		{
+			name:      "Found",
+			ctx:       defaultCtx,
+			perr:      types.FixtureError("handler1", "handler1: boom"),
+			timestamp: "1520000000",
+			expected:  true,
+		},
+		{
+			name: "No Read Permission",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermCreate),
+			)),
+			perr:            types.FixtureError("handler1", "handler1: boom"),
+			timestamp:       "1520000000",
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewPipelineErrorController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetError", tc.ctx, "entity1", "check1", tc.timestamp).Return(tc.perr, nil)
+
+			result, err := actions.Find(tc.ctx, "entity1", "check1", tc.timestamp)
+			if !tc.expected {
+				inferErr, ok := err.(Error)
+				if assert.True(ok) {
+					assert.Equal(tc.expectedErrCode, inferErr.Code)
+				}
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tc.perr, result)
+		})
+	}
+}
+
+func TestPipelineErrorDestroy(t *testing.T) {
+	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermDelete),
+	))
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		perr            *types.Error
+		deleteErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name: "Deleted",
+			ctx:  defaultCtx,
+			perr: types.FixtureError("handler1", "handler1: boom"),
+		},
+		{
+			name:            "Not Found",
+			ctx:             defaultCtx,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name: "No Delete Permission",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+			)),
+			perr:            types.FixtureError("handler1", "handler1: boom"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Failure",
+			ctx:             defaultCtx,
+			perr:            types.FixtureError("handler1", "handler1: boom"),
+			deleteErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewPipelineErrorController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetError", tc.ctx, "entity1", "check1", "1520000000").Return(tc.perr, nil)
+			store.On("DeleteError", tc.ctx, "entity1", "check1", "1520000000").Return(tc.deleteErr)
+
+			err := actions.Destroy(tc.ctx, "entity1", "check1", "1520000000")
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if assert.True(ok) {
+					assert.Equal(tc.expectedErrCode, inferErr.Code)
+				}
+				return
+			}
+			assert.NoError(err)
+			store.AssertCalled(t, "DeleteError", tc.ctx, "entity1", "check1", "1520000000")
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ResourceController exposes actions importing and exporting the checks,
+// hooks, filters, mutators, handlers and assets of an environment at once.
+type ResourceController struct {
+	Store store.Store
+}
+
+// NewResourceController returns new ResourceController
+func NewResourceController(store store.Store) ResourceController {
+	return ResourceController{Store: store}
+}
+
+// Import creates or replaces the given resources, atomically: if any of them
+// is invalid or can not be modified by the viewer, none is. Resources without
+// organization or environment are imported in those of the viewer. When dry
+// run, the resources are only validated, along with their references to
+// other resources.
+func (a ResourceController) Import(ctx context.Context, resources []types.Resource, dryRun bool) error {
+	org, env := types.ContextOrganization(ctx), types.ContextEnvironment(ctx)
+	for i, resource := range resources {
+		if err := a.authorizeImport(ctx, resource, org, env); err != nil {
+			if actionErr, ok := err.(Error); ok {
+				actionErr.Message = fmt.Sprintf("resource %d (%s): %s", i, resource.URIPath(), actionErr.Message)
+				return actionErr
+			}
+			return err
+		}
+		if err := resource.Validate(); err != nil {
+			actionErr := NewError(InvalidArgument, err)
+			actionErr.Message = fmt.Sprintf("resource %d (%s): %s", i, resource.URIPath(), actionErr.Message)
+			return actionErr
+		}
+	}
+
+	if dryRun {
+		// References may be satisfied by the other resources imported
+		for i, resource := range resources {
+			r, ok := resource.(referrer)
+			if !ok {
+				continue
+			}
+			if err := validateReferences(ctx, a.Store, r, resources); err != nil {
+				if actionErr, ok := err.(Error); ok {
+					actionErr.Message = fmt.Sprintf("resource %d: %s", i, actionErr.Message)
+					return actionErr
+				}
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Persist
+	if err := a.Store.UpdateResources(ctx, resources); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// authorizeImport defaults the organization and environment of the given
+// resource to the given ones, and verifies the viewer can create and update it.
+func (a ResourceController) authorizeImport(ctx context.Context, resource types.Resource, org, env string) error {
+	var allowed bool
+	switch r := resource.(type) {
+	case *types.Asset:
+		setDefault(&r.Organization, org)
+		abilities := authorization.Assets.WithContext(addOrgEnvToContext(ctx, r))
+		allowed = abilities.CanCreate() && abilities.CanUpdate()
+	case *types.CheckConfig:
+		setDefault(&r.Organization, org)
+		setDefault(&r.Environment, env)
+		abilities := authorization.Checks.WithContext(addOrgEnvToContext(ctx, r))
+		allowed = abilities.CanCreate(r) && abilities.CanUpdate(r)
+	case *types.EventFilter:
+		setDefault(&r.Organization, org)
+		setDefault(&r.Environment, env)
+		abilities := authorization.Filters.WithContext(addOrgEnvToContext(ctx, r))
+		allowed = abilities.CanCreate(r) && abilities.CanUpdate(r)
+	case *types.Handler:
+		setDefault(&r.Organization, org)
+		setDefault(&r.Environment, env)
+		if r.Type == types.HandlerGRPCType {
+			return NewError(InvalidArgument, errors.New("use the extensions API for this handler type"))
+		}
+		abilities := authorization.Handlers.WithContext(addOrgEnvToContext(ctx, r))
+		allowed = abilities.CanCreate(r) && abilities.CanUpdate(r)
+	case *types.HookConfig:
+		setDefault(&r.Organization, org)
+		setDefault(&r.Environment, env)
+		abilities := authorization.Hooks.WithContext(addOrgEnvToContext(ctx, r))
+		allowed = abilities.CanCreate(r) && abilities.CanUpdate(r)
+	case *types.Mutator:
+		setDefault(&r.Organization, org)
+		setDefault(&r.Environment, env)
+		abilities := authorization.Mutators.WithContext(addOrgEnvToContext(ctx, r))
+		allowed = abilities.CanCreate(r) && abilities.CanUpdate(r)
+	default:
+		return NewErrorf(InvalidArgument, "resources of type %T can not be imported", resource)
+	}
+
+	if !allowed {
+		return NewErrorf(PermissionDenied, "create/update")
+	}
+	return nil
+}
+
+func setDefault(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+// Export returns the checks, hooks, filters, mutators, handlers and assets
+// available to the viewer, in an order suitable for importing them.
+func (a ResourceController) Export(ctx context.Context) ([]types.Resource, error) {
+	resources := []types.Resource{}
+
+	assets, err := a.Store.GetAssets(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	assetAbilities := authorization.Assets.WithContext(ctx)
+	for _, asset := range assets {
+		if assetAbilities.CanRead(asset) {
+			resources = append(resources, asset)
+		}
+	}
+
+	hooks, err := a.Store.GetHookConfigs(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	hookAbilities := authorization.Hooks.WithContext(ctx)
+	for _, hook := range hooks {
+		if hookAbilities.CanRead(hook) {
+			resources = append(resources, hook)
+		}
+	}
+
+	checks, err := a.Store.GetCheckConfigs(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	checkAbilities := authorization.Checks.WithContext(ctx)
+	for _, check := range checks {
+		if checkAbilities.CanRead(check) {
+			resources = append(resources, check)
+		}
+	}
+
+	filters, err := a.Store.GetEventFilters(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	filterAbilities := authorization.Filters.WithContext(ctx)
+	for _, filter := range filters {
+		if filterAbilities.CanRead(filter) {
+			resources = append(resources, filter)
+		}
+	}
+
+	mutators, err := a.Store.GetMutators(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	mutatorAbilities := authorization.Mutators.WithContext(ctx)
+	for _, mutator := range mutators {
+		if mutatorAbilities.CanRead(mutator) {
+			resources = append(resources, mutator)
+		}
+	}
+
+	handlers, err := a.Store.GetHandlers(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	handlerAbilities := authorization.Handlers.WithContext(ctx)
+	for _, handler := range handlers {
+		// Handlers of extensions are registered with the extensions API
+		if handler.Type != types.HandlerGRPCType && handlerAbilities.CanRead(handler) {
+			resources = append(resources, handler)
+		}
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,180 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sensustore "github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceImport(t *testing.T) {
+	fullCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(*types.FixtureRule("*", "*")),
+	)
+	checksOnlyCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermCreate, types.RulePermUpdate),
+		),
+	)
+
+	badCheck := types.FixtureCheckConfig("check")
+	badCheck.Interval = 0
+	grpcHandler := types.FixtureHandler("grpc")
+	grpcHandler.Type = types.HandlerGRPCType
+	mutatedHandler := types.FixtureHandler("handler")
+	mutatedHandler.Mutator = "mutator"
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		resources       []types.Resource
+		dryRun          bool
+		storeErr        error
+		expectStored    bool
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:         "Imported",
+			ctx:          fullCtx,
+			resources:    []types.Resource{types.FixtureCheckConfig("check"), types.FixtureHandler("handler")},
+			expectStored: true,
+		},
+		{
+			name:      "Dry Run",
+			ctx:       fullCtx,
+			resources: []types.Resource{types.FixtureCheckConfig("check"), types.FixtureHandler("handler")},
+			dryRun:    true,
+		},
+		{
+			name:      "Dry Run With Imported Reference",
+			ctx:       fullCtx,
+			resources: []types.Resource{mutatedHandler, types.FixtureMutator("mutator")},
+			dryRun:    true,
+		},
+		{
+			name:            "Dry Run With Missing Reference",
+			ctx:             fullCtx,
+			resources:       []types.Resource{mutatedHandler},
+			dryRun:          true,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Invalid Resource",
+			ctx:             fullCtx,
+			resources:       []types.Resource{types.FixtureHandler("handler"), badCheck},
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Unsupported Resource",
+			ctx:             fullCtx,
+			resources:       []types.Resource{types.FixtureEntity("entity")},
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Extension Handler",
+			ctx:             fullCtx,
+			resources:       []types.Resource{grpcHandler},
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "No Permission",
+			ctx:             checksOnlyCtx,
+			resources:       []types.Resource{types.FixtureCheckConfig("check"), types.FixtureHandler("handler")},
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Store Err",
+			ctx:             fullCtx,
+			resources:       []types.Resource{types.FixtureCheckConfig("check")},
+			storeErr:        errors.New("txn failed"),
+			expectStored:    true,
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			actions := NewResourceController(store)
+			store.On("UpdateResources", mock.Anything, tt.resources).Return(tt.storeErr)
+			store.On("GetAssetByName", mock.Anything, "ruby-2-4-2").Return(types.FixtureAsset("ruby-2-4-2"), nil)
+			store.On("GetHookConfigByName", mock.Anything, "hook1").Return(types.FixtureHookConfig("hook1"), nil)
+			store.On("GetMutatorByName", mock.Anything, "mutator").Return((*types.Mutator)(nil), nil)
+			store.On("GetExtension", mock.Anything, "mutator").Return((*types.Extension)(nil), sensustore.ErrNoExtension)
+
+			err := actions.Import(tt.ctx, tt.resources, tt.dryRun)
+			if tt.expectedErr {
+				inferErr, ok := err.(Error)
+				require.True(t, ok, "error is an action error")
+				assert.Equal(t, tt.expectedErrCode, inferErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.expectStored {
+				store.AssertCalled(t, "UpdateResources", mock.Anything, tt.resources)
+			} else {
+				store.AssertNotCalled(t, "UpdateResources", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestResourceImportDefaultsNamespace(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("acme", "dev"),
+		testutil.ContextWithRules(*types.FixtureRule("*", "*")),
+	)
+	check := types.FixtureCheckConfig("check")
+	check.Organization, check.Environment = "", ""
+	asset := types.FixtureAsset("asset")
+	asset.Organization = ""
+
+	store := &mockstore.MockStore{}
+	store.On("UpdateResources", mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, NewResourceController(store).Import(ctx, []types.Resource{check, asset}, false))
+	assert.Equal(t, "acme", check.Organization)
+	assert.Equal(t, "dev", check.Environment)
+	assert.Equal(t, "acme", asset.Organization)
+}
+
+func TestResourceExport(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead),
+			types.FixtureRuleWithPerms(types.RuleTypeHandler, types.RulePermRead),
+		),
+	)
+	check := types.FixtureCheckConfig("check")
+	handler := types.FixtureHandler("handler")
+	grpcHandler := types.FixtureHandler("grpc")
+	grpcHandler.Type = types.HandlerGRPCType
+
+	store := &mockstore.MockStore{}
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{types.FixtureAsset("asset")}, nil)
+	store.On("GetHookConfigs", mock.Anything).Return([]*types.HookConfig{}, nil)
+	store.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{check}, nil)
+	store.On("GetEventFilters", mock.Anything).Return([]*types.EventFilter{types.FixtureEventFilter("filter")}, nil)
+	store.On("GetMutators", mock.Anything).Return([]*types.Mutator{}, nil)
+	store.On("GetHandlers", mock.Anything).Return([]*types.Handler{handler, grpcHandler}, nil)
+
+	resources, err := NewResourceController(store).Export(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Resource{check, handler}, resources)
+}
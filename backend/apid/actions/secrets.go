@@ -0,0 +1,227 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var secretUpdateFields = []string{
+	"Provider",
+	"ID",
+}
+
+// SecretController allows querying secrets in bulk or by name.
+type SecretController struct {
+	Store  store.SecretStore
+	Policy authorization.SecretPolicy
+}
+
+// NewSecretController creates a new SecretController backed by store.
+func NewSecretController(store store.SecretStore) SecretController {
+	return SecretController{
+		Store:  store,
+		Policy: authorization.Secrets,
+	}
+}
+
+// Create creates a new Secret resource.
+// It returns non-nil error if the new secret is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c SecretController) Create(ctx context.Context, sec types.Secret) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &sec)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if s, err := c.Store.GetSecretByName(ctx, sec.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if s != nil {
+		return NewErrorf(AlreadyExistsErr, sec.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&sec); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	// Validate
+	if err := sec.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateSecret(ctx, &sec); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// CreateOrReplace creates or replaces a Secret resource.
+// It returns non-nil error if the secret is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c SecretController) CreateOrReplace(ctx context.Context, sec types.Secret) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &sec)
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if !(policy.CanCreate(&sec) && policy.CanUpdate(&sec)) {
+		return NewErrorf(PermissionDenied, "create/update")
+	}
+
+	// Validate
+	if err := sec.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateSecret(ctx, &sec); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Update updates a secret.
+// It returns non-nil error if the new secret is invalid, create permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c SecretController) Update(ctx context.Context, delta types.Secret) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	sec, err := c.Store.GetSecretByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if sec == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(sec); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := sec.Update(&delta, secretUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Validate
+	if err := sec.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateSecret(ctx, sec); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c SecretController) Query(ctx context.Context) ([]*types.Secret, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	secrets, err := c.Store.GetSecrets(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.Secret, 0, len(secrets))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, s := range secrets {
+		if ok := policy.CanRead(s); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named Secret.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c SecretController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	sec, err := c.Store.GetSecretByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if sec == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteSecretByName(ctx, sec.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c SecretController) Find(ctx context.Context, name string) (*types.Secret, error) {
+	result, err := c.Store.GetSecretByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,559 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSecretController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewSecretController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestSecretCreateOrReplace(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeSecret,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermCreate),
+		),
+	)
+
+	badSecret := types.FixtureSecret("bad")
+	badSecret.Name = "!@#!#$@#^$%&$%&$&$%&%^*%&(%@###"
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.Secret
+		fetchResult     *types.Secret
+		fetchErr        error
+		createErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Created",
+			ctx:         defaultCtx,
+			argument:    types.FixtureSecret("db-password"),
+			expectedErr: false,
+		},
+		{
+			name:        "Already Exists",
+			ctx:         defaultCtx,
+			argument:    types.FixtureSecret("db-password"),
+			fetchResult: types.FixtureSecret("db-password"),
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureSecret("api-token"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badSecret,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewSecretController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetSecretByName", mock.Anything, mock.Anything).
+				Return(test.fetchResult, test.fetchErr)
+
+			store.On("UpdateSecret", mock.Anything, mock.Anything).Return(test.createErr)
+
+			err := ctl.CreateOrReplace(test.ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestSecretCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermRead),
+		),
+	)
+
+	badSecret := types.FixtureSecret("bad")
+	badSecret.Name = "!@#!#$@#^$%&$%&$&$%&%^*%&(%@###"
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.Secret
+		fetchResult     *types.Secret
+		fetchErr        error
+		createErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Created",
+			ctx:         defaultCtx,
+			argument:    types.FixtureSecret("db-password"),
+			expectedErr: false,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixtureSecret("db-password"),
+			fetchResult:     types.FixtureSecret("db-password"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureSecret("grumpy"),
+			fetchErr:        errors.New("nein"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureSecret("api-token"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badSecret,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewSecretController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetSecretByName", mock.Anything, mock.Anything).
+				Return(test.fetchResult, test.fetchErr)
+
+			store.On("UpdateSecret", mock.Anything, mock.Anything).Return(test.createErr)
+
+			err := ctl.Create(test.ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestSecretDestroy(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermDelete),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermCreate),
+		),
+	)
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		secret          string
+		fetchResult     *types.Secret
+		fetchErr        error
+		deleteErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Deleted",
+			ctx:         defaultCtx,
+			secret:      "secret1",
+			fetchResult: types.FixtureSecret("secret1"),
+			expectedErr: false,
+		},
+		{
+			name:            "Does Not Exist",
+			ctx:             defaultCtx,
+			secret:          "secret1",
+			fetchResult:     nil,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Delete",
+			ctx:             defaultCtx,
+			secret:          "secret1",
+			fetchResult:     types.FixtureSecret("secret1"),
+			deleteErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			secret:          "secret1",
+			fetchResult:     types.FixtureSecret("secret1"),
+			fetchErr:        errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			secret:          "secret1",
+			fetchResult:     types.FixtureSecret("secret1"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewSecretController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.
+				On("GetSecretByName", mock.Anything, mock.Anything).
+				Return(tc.fetchResult, tc.fetchErr)
+			store.
+				On("DeleteSecretByName", mock.Anything, "secret1").
+				Return(tc.deleteErr)
+
+			// Exec Query
+			err := actions.Destroy(tc.ctx, tc.secret)
+
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if ok {
+					assert.Equal(tc.expectedErrCode, inferErr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Given was not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestSecretUpdate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermUpdate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermRead),
+		),
+	)
+
+	badSecret := types.FixtureSecret("secret1")
+	badSecret.Provider = "keychain"
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.Secret
+		fetchResult     *types.Secret
+		fetchErr        error
+		updateErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Updated",
+			ctx:         defaultCtx,
+			argument:    types.FixtureSecret("secret1"),
+			fetchResult: types.FixtureSecret("secret1"),
+			expectedErr: false,
+		},
+		{
+			name:            "Does Not Exist",
+			ctx:             defaultCtx,
+			argument:        types.FixtureSecret("secret1"),
+			fetchResult:     nil,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Update",
+			ctx:             defaultCtx,
+			argument:        types.FixtureSecret("secret1"),
+			fetchResult:     types.FixtureSecret("secret1"),
+			updateErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureSecret("secret1"),
+			fetchResult:     types.FixtureSecret("secret1"),
+			fetchErr:        errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureSecret("secret1"),
+			fetchResult:     types.FixtureSecret("secret1"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badSecret,
+			fetchResult:     types.FixtureSecret("secret1"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewSecretController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.
+				On("GetSecretByName", mock.Anything, mock.Anything).
+				Return(tc.fetchResult, tc.fetchErr)
+			store.
+				On("UpdateSecret", mock.Anything, mock.Anything).
+				Return(tc.updateErr)
+
+			// Exec Query
+			err := actions.Update(tc.ctx, *tc.argument)
+
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if ok {
+					assert.Equal(tc.expectedErrCode, inferErr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Given was not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestSecretQuery(t *testing.T) {
+	readCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermRead)))
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		secrets     []*types.Secret
+		expectedLen int
+		storeErr    error
+		expectedErr error
+	}{
+		{
+			name:        "No Params, No Secrets",
+			ctx:         readCtx,
+			secrets:     nil,
+			expectedLen: 0,
+			storeErr:    nil,
+			expectedErr: nil,
+		},
+		{
+			name: "No Params With Secrets",
+			ctx:  readCtx,
+			secrets: []*types.Secret{
+				types.FixtureSecret("homer"),
+				types.FixtureSecret("bart"),
+			},
+			expectedLen: 2,
+			storeErr:    nil,
+			expectedErr: nil,
+		},
+		{
+			name: "No Params With Only Create Access",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermCreate),
+			)),
+			secrets: []*types.Secret{
+				types.FixtureSecret("lisa"),
+				types.FixtureSecret("maggie"),
+			},
+			expectedLen: 0,
+			storeErr:    nil,
+			expectedErr: nil,
+		},
+		{
+			name: "Secret Param",
+			ctx:  readCtx,
+			secrets: []*types.Secret{
+				types.FixtureSecret("mr. burns"),
+			},
+			expectedLen: 1,
+			storeErr:    nil,
+			expectedErr: nil,
+		},
+		{
+			name:        "Store Failure",
+			ctx:         readCtx,
+			secrets:     nil,
+			expectedLen: 0,
+			storeErr:    errors.New(""),
+			expectedErr: NewError(InternalErr, errors.New("")),
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewSecretController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.On("GetSecrets", test.ctx).Return(test.secrets, test.storeErr)
+
+			results, err := ctl.Query(test.ctx)
+
+			assert.EqualValues(test.expectedErr, err)
+			assert.Len(results, test.expectedLen)
+		})
+	}
+}
+
+func TestSecretFind(t *testing.T) {
+	readCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeSecret, types.RulePermRead),
+	))
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		secret          *types.Secret
+		argument        string
+		expected        bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:            "Found",
+			ctx:             readCtx,
+			secret:          types.FixtureSecret("abe"),
+			argument:        "abe",
+			expected:        true,
+			expectedErrCode: 0,
+		},
+		{
+			name:            "Not Found",
+			ctx:             readCtx,
+			secret:          nil,
+			argument:        "fox mulder",
+			expected:        false,
+			expectedErrCode: NotFound,
+		},
+		{
+			name: "No Read Permission",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermCreate),
+			)),
+			secret:          types.FixtureSecret("troy maclure"),
+			argument:        "troy maclure",
+			expected:        false,
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewSecretController(store)
+
+			// Mock store methods
+			store.
+				On("GetSecretByName", test.ctx, test.argument).
+				Return(test.secret, nil)
+
+			assert := assert.New(t)
+			result, err := ctl.Find(test.ctx, test.argument)
+			if cerr, ok := err.(Error); ok {
+				assert.Equal(test.expectedErrCode, cerr.Code)
+			} else {
+				assert.NoError(err)
+			}
+			assert.Equal(test.expected, result != nil, "expects Find() to return an event")
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var componentUpdateFields = []string{
+	"Services",
+	"LabelSelector",
+	"FieldSelector",
+	"Rules",
+	"Interval",
+	"Handlers",
+}
+
+// ServiceComponentController allows querying service components in bulk or by
+// name.
+type ServiceComponentController struct {
+	Store  store.ServiceStore
+	Policy authorization.ServicePolicy
+}
+
+// NewServiceComponentController creates a new ServiceComponentController
+// backed by store.
+func NewServiceComponentController(store store.ServiceStore) ServiceComponentController {
+	return ServiceComponentController{
+		Store:  store,
+		Policy: authorization.Services,
+	}
+}
+
+// Create creates a new ServiceComponent resource.
+// It returns non-nil error if the new service component is invalid, update
+// permissions do not exist, or an internal error occurs while updating the
+// underlying Store.
+func (c ServiceComponentController) Create(ctx context.Context, component types.ServiceComponent) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &component)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if s, err := c.Store.GetServiceComponentByName(ctx, component.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if s != nil {
+		return NewErrorf(AlreadyExistsErr, component.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&component); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	// Validate
+	if err := validateServiceComponent(&component); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateServiceComponent(ctx, &component); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// CreateOrReplace creates or replaces a ServiceComponent resource.
+// It returns non-nil error if the service component is invalid, update
+// permissions do not exist, or an internal error occurs while updating the
+// underlying Store.
+func (c ServiceComponentController) CreateOrReplace(ctx context.Context, component types.ServiceComponent) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &component)
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if !(policy.CanCreate(&component) && policy.CanUpdate(&component)) {
+		return NewErrorf(PermissionDenied, "create/update")
+	}
+
+	// Validate
+	if err := validateServiceComponent(&component); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateServiceComponent(ctx, &component); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Update updates a service component.
+// It returns non-nil error if the new service component is invalid, create
+// permissions do not exist, or an internal error occurs while updating the
+// underlying Store.
+func (c ServiceComponentController) Update(ctx context.Context, delta types.ServiceComponent) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	component, err := c.Store.GetServiceComponentByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if component == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(component); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := component.Update(&delta, componentUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Validate
+	if err := validateServiceComponent(component); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateServiceComponent(ctx, component); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c ServiceComponentController) Query(ctx context.Context) ([]*types.ServiceComponent, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	components, err := c.Store.GetServiceComponents(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.ServiceComponent, 0, len(components))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, s := range components {
+		if ok := policy.CanRead(s); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named ServiceComponent.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c ServiceComponentController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	component, err := c.Store.GetServiceComponentByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if component == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteServiceComponentByName(ctx, component.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c ServiceComponentController) Find(ctx context.Context, name string) (*types.ServiceComponent, error) {
+	result, err := c.Store.GetServiceComponentByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
+
+// validateServiceComponent validates the given component along with its label
+// and field selectors.
+func validateServiceComponent(component *types.ServiceComponent) error {
+	var errs types.FieldErrors
+	errs.AddError("", component.Validate())
+	if _, err := selector.ParseLabelSelector(component.LabelSelector); err != nil {
+		errs.AddError("label_selector", err)
+	}
+	if _, err := selector.ParseFieldSelector(component.FieldSelector); err != nil {
+		errs.AddError("field_selector", err)
+	}
+	return errs.Err()
+}
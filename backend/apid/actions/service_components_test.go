@@ -0,0 +1,470 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewServiceComponentController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewServiceComponentController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestServiceComponentCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead),
+		),
+	)
+
+	selected := types.FixtureServiceComponent("web", "webstore")
+	selected.LabelSelector = "tier in (web, frontend)"
+	selected.FieldSelector = "check.name=http"
+
+	badLabels := types.FixtureServiceComponent("web", "webstore")
+	badLabels.LabelSelector = "tier in (web"
+
+	badFields := types.FixtureServiceComponent("web", "webstore")
+	badFields.FieldSelector = "check.name"
+
+	reserved := types.FixtureServiceComponent(types.ServiceHealthCheckName, "webstore")
+
+	combinedRule := types.FixtureServiceComponent("web", "webstore")
+	combinedRule.Rules = []types.ServiceRule{
+		{Name: "degraded", Status: 1, FailingCount: 1, FailingPercentage: 50},
+	}
+
+	noServices := types.FixtureServiceComponent("web", "webstore")
+	noServices.Services = nil
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.ServiceComponent
+		fetchResult     *types.ServiceComponent
+		updateErr       error
+		persisted       bool
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:      "Created",
+			ctx:       defaultCtx,
+			argument:  selected,
+			persisted: true,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixtureServiceComponent("web", "webstore"),
+			fetchResult:     types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Invalid Label Selector",
+			ctx:             defaultCtx,
+			argument:        badLabels,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Invalid Field Selector",
+			ctx:             defaultCtx,
+			argument:        badFields,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Reserved Name",
+			ctx:             defaultCtx,
+			argument:        reserved,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Count Combined With Percentage",
+			ctx:             defaultCtx,
+			argument:        combinedRule,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Member Of No Service",
+			ctx:             defaultCtx,
+			argument:        noServices,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:     "Dry Run",
+			ctx:      ContextWithDryRun(defaultCtx),
+			argument: selected,
+		},
+		{
+			name:            "Store Err on Create",
+			ctx:             defaultCtx,
+			argument:        types.FixtureServiceComponent("web", "webstore"),
+			updateErr:       errors.New("dunno"),
+			persisted:       true,
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceComponentController(store)
+
+			store.
+				On("GetServiceComponentByName", mock.Anything, tc.argument.Name).
+				Return(tc.fetchResult, nil)
+			store.
+				On("UpdateServiceComponent", mock.Anything).
+				Return(tc.updateErr)
+
+			err := ctl.Create(tc.ctx, *tc.argument)
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+			if tc.persisted {
+				store.AssertCalled(t, "UpdateServiceComponent", mock.Anything)
+			} else {
+				store.AssertNotCalled(t, "UpdateServiceComponent", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestServiceComponentCreateOrReplace(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+		),
+	)
+
+	// Replacing requires update permissions on top of create permissions.
+	store := &mockstore.MockStore{}
+	ctl := NewServiceComponentController(store)
+	err := ctl.CreateOrReplace(ctx, *types.FixtureServiceComponent("web", "webstore"))
+	assertErrCode(t, true, PermissionDenied, err)
+	store.AssertNotCalled(t, "UpdateServiceComponent", mock.Anything)
+}
+
+func TestServiceComponentUpdateFields(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermUpdate),
+		),
+	)
+
+	stored := types.FixtureServiceComponent("web", "webstore")
+	stored.LabelSelector = "tier=web"
+	stored.Environment = "production"
+
+	delta := types.FixtureServiceComponent("web", "checkout")
+	delta.Services = append(delta.Services, "webstore")
+	delta.LabelSelector = "tier in (web, frontend)"
+	delta.FieldSelector = "check.name=http"
+	delta.Rules = []types.ServiceRule{
+		{Name: "degraded", Status: 1, FailingCount: 1},
+		{Name: "down", Status: 2, FailingPercentage: 50},
+	}
+	delta.Interval = 30
+	delta.Handlers = []string{"pagerduty"}
+	delta.Environment = "default"
+
+	store := &mockstore.MockStore{}
+	store.On("GetServiceComponentByName", mock.Anything, "web").Return(stored, nil)
+	store.On("UpdateServiceComponent", mock.Anything).Return(nil)
+
+	ctl := NewServiceComponentController(store)
+	assert.NoError(t, ctl.Update(ctx, *delta))
+
+	updated := store.Calls[1].Arguments.Get(0).(*types.ServiceComponent)
+	assert.Equal(t, []string{"checkout", "webstore"}, updated.Services)
+	assert.Equal(t, "tier in (web, frontend)", updated.LabelSelector)
+	assert.Equal(t, "check.name=http", updated.FieldSelector)
+	assert.Equal(t, delta.Rules, updated.Rules)
+	assert.Equal(t, uint32(30), updated.Interval)
+	assert.Equal(t, []string{"pagerduty"}, updated.Handlers)
+	assert.Equal(t, "production", updated.Environment)
+}
+
+func TestServiceComponentUpdate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermUpdate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead),
+		),
+	)
+
+	badLabels := types.FixtureServiceComponent("web", "webstore")
+	badLabels.LabelSelector = "tier in (web"
+
+	noRules := types.FixtureServiceComponent("web", "webstore")
+	noRules.Rules = nil
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.ServiceComponent
+		fetchResult     *types.ServiceComponent
+		fetchErr        error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Updated",
+			ctx:         defaultCtx,
+			argument:    types.FixtureServiceComponent("web", "webstore"),
+			fetchResult: types.FixtureServiceComponent("web", "webstore"),
+		},
+		{
+			name:            "Does Not Exist",
+			ctx:             defaultCtx,
+			argument:        types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureServiceComponent("web", "webstore"),
+			fetchErr:        errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureServiceComponent("web", "webstore"),
+			fetchResult:     types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Invalid Label Selector",
+			ctx:             defaultCtx,
+			argument:        badLabels,
+			fetchResult:     types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Rules Removed",
+			ctx:             defaultCtx,
+			argument:        noRules,
+			fetchResult:     types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceComponentController(store)
+
+			store.
+				On("GetServiceComponentByName", mock.Anything, "web").
+				Return(tc.fetchResult, tc.fetchErr)
+			store.
+				On("UpdateServiceComponent", mock.Anything).
+				Return(nil)
+
+			err := ctl.Update(tc.ctx, *tc.argument)
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+			if tc.expectedErr {
+				store.AssertNotCalled(t, "UpdateServiceComponent", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestServiceComponentQuery(t *testing.T) {
+	rule := types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead)
+	rule.Environment = "default"
+	ctx := testutil.NewContext(testutil.ContextWithRules(rule))
+
+	web := types.FixtureServiceComponent("web", "webstore")
+	staging := types.FixtureServiceComponent("web", "webstore")
+	staging.Environment = "staging"
+
+	store := &mockstore.MockStore{}
+	store.
+		On("GetServiceComponents", ctx).
+		Return([]*types.ServiceComponent{web, staging}, nil)
+
+	ctl := NewServiceComponentController(store)
+	components, err := ctl.Query(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.ServiceComponent{web}, components)
+}
+
+func TestServiceComponentDestroy(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermDelete),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+		),
+	)
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		fetchResult     *types.ServiceComponent
+		deleteErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Deleted",
+			ctx:         defaultCtx,
+			fetchResult: types.FixtureServiceComponent("web", "webstore"),
+		},
+		{
+			name:            "Does Not Exist",
+			ctx:             defaultCtx,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Delete",
+			ctx:             defaultCtx,
+			fetchResult:     types.FixtureServiceComponent("web", "webstore"),
+			deleteErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			fetchResult:     types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceComponentController(store)
+
+			store.
+				On("GetServiceComponentByName", mock.Anything, "web").
+				Return(tc.fetchResult, nil)
+			store.
+				On("DeleteServiceComponentByName", mock.Anything, "web").
+				Return(tc.deleteErr)
+
+			err := ctl.Destroy(tc.ctx, "web")
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+		})
+	}
+}
+
+func TestServiceComponentFind(t *testing.T) {
+	readCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead),
+	))
+	noReadCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+	))
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		component       *types.ServiceComponent
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:      "Found",
+			ctx:       readCtx,
+			component: types.FixtureServiceComponent("web", "webstore"),
+		},
+		{
+			name:            "Not Found",
+			ctx:             readCtx,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "No Read Permission",
+			ctx:             noReadCtx,
+			component:       types.FixtureServiceComponent("web", "webstore"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceComponentController(store)
+
+			store.
+				On("GetServiceComponentByName", tc.ctx, "web").
+				Return(tc.component, nil)
+
+			result, err := ctl.Find(tc.ctx, "web")
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+			assert.Equal(t, err == nil, result != nil)
+		})
+	}
+}
+
+func TestValidateServiceComponent(t *testing.T) {
+	component := types.FixtureServiceComponent("web", "webstore")
+	component.LabelSelector = "tier in (web"
+	component.FieldSelector = "check.name=http"
+	component.Interval = 0
+
+	err := validateServiceComponent(component)
+	assert.Error(t, err)
+	details, ok := err.(types.FieldErrors)
+	assert.True(t, ok)
+	assert.Len(t, details, 2)
+	assert.Equal(t, "interval", details[0].Field)
+	assert.Equal(t, "label_selector", details[1].Field)
+}
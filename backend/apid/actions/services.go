@@ -0,0 +1,227 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var serviceUpdateFields = []string{
+	"Description",
+	"Handlers",
+}
+
+// ServiceController allows querying services in bulk or by name.
+type ServiceController struct {
+	Store  store.ServiceStore
+	Policy authorization.ServicePolicy
+}
+
+// NewServiceController creates a new ServiceController backed by store.
+func NewServiceController(store store.ServiceStore) ServiceController {
+	return ServiceController{
+		Store:  store,
+		Policy: authorization.Services,
+	}
+}
+
+// Create creates a new Service resource.
+// It returns non-nil error if the new service is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c ServiceController) Create(ctx context.Context, service types.Service) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &service)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if s, err := c.Store.GetServiceByName(ctx, service.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if s != nil {
+		return NewErrorf(AlreadyExistsErr, service.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&service); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	// Validate
+	if err := service.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateService(ctx, &service); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// CreateOrReplace creates or replaces a Service resource.
+// It returns non-nil error if the service is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c ServiceController) CreateOrReplace(ctx context.Context, service types.Service) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &service)
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if !(policy.CanCreate(&service) && policy.CanUpdate(&service)) {
+		return NewErrorf(PermissionDenied, "create/update")
+	}
+
+	// Validate
+	if err := service.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateService(ctx, &service); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Update updates a service.
+// It returns non-nil error if the new service is invalid, create permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c ServiceController) Update(ctx context.Context, delta types.Service) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	service, err := c.Store.GetServiceByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if service == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(service); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := service.Update(&delta, serviceUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Validate
+	if err := service.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Stop there on dry runs
+	if isDryRun(ctx) {
+		return nil
+	}
+
+	// Persist
+	if err := c.Store.UpdateService(ctx, service); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c ServiceController) Query(ctx context.Context) ([]*types.Service, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	services, err := c.Store.GetServices(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.Service, 0, len(services))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, s := range services {
+		if ok := policy.CanRead(s); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named Service.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c ServiceController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	service, err := c.Store.GetServiceByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if service == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteServiceByName(ctx, service.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c ServiceController) Find(ctx context.Context, name string) (*types.Service, error) {
+	result, err := c.Store.GetServiceByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,385 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewServiceController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewServiceController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestServiceCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead),
+		),
+	)
+
+	badHandlers := types.FixtureService("webstore")
+	badHandlers.Handlers = []string{"slack", "!@#"}
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.Service
+		fetchResult     *types.Service
+		updateErr       error
+		persisted       bool
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:      "Created",
+			ctx:       defaultCtx,
+			argument:  types.FixtureService("webstore"),
+			persisted: true,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixtureService("webstore"),
+			fetchResult:     types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Invalid Handler",
+			ctx:             defaultCtx,
+			argument:        badHandlers,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:     "Dry Run",
+			ctx:      ContextWithDryRun(defaultCtx),
+			argument: types.FixtureService("webstore"),
+		},
+		{
+			name:            "Store Err on Create",
+			ctx:             defaultCtx,
+			argument:        types.FixtureService("webstore"),
+			updateErr:       errors.New("dunno"),
+			persisted:       true,
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceController(store)
+
+			store.
+				On("GetServiceByName", mock.Anything, tc.argument.Name).
+				Return(tc.fetchResult, nil)
+			store.
+				On("UpdateService", mock.Anything).
+				Return(tc.updateErr)
+
+			err := ctl.Create(tc.ctx, *tc.argument)
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+			if tc.persisted {
+				store.AssertCalled(t, "UpdateService", mock.Anything)
+			} else {
+				store.AssertNotCalled(t, "UpdateService", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateFields(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermUpdate),
+		),
+	)
+
+	stored := types.FixtureService("webstore")
+	stored.Description = "storefront"
+	stored.Handlers = []string{"slack"}
+	stored.Environment = "production"
+
+	delta := types.FixtureService("webstore")
+	delta.Description = "storefront and checkout"
+	delta.Handlers = []string{"pagerduty"}
+	delta.Environment = "default"
+
+	store := &mockstore.MockStore{}
+	store.On("GetServiceByName", mock.Anything, "webstore").Return(stored, nil)
+	store.On("UpdateService", mock.Anything).Return(nil)
+
+	ctl := NewServiceController(store)
+	assert.NoError(t, ctl.Update(ctx, *delta))
+
+	// Only the description and handlers may be changed; the service keeps its
+	// namespace.
+	updated := store.Calls[1].Arguments.Get(0).(*types.Service)
+	assert.Equal(t, "storefront and checkout", updated.Description)
+	assert.Equal(t, []string{"pagerduty"}, updated.Handlers)
+	assert.Equal(t, "production", updated.Environment)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermUpdate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead),
+		),
+	)
+
+	badHandlers := types.FixtureService("webstore")
+	badHandlers.Handlers = []string{"!@#"}
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.Service
+		fetchResult     *types.Service
+		fetchErr        error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Updated",
+			ctx:         defaultCtx,
+			argument:    types.FixtureService("webstore"),
+			fetchResult: types.FixtureService("webstore"),
+		},
+		{
+			name:            "Does Not Exist",
+			ctx:             defaultCtx,
+			argument:        types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureService("webstore"),
+			fetchErr:        errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureService("webstore"),
+			fetchResult:     types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Invalid Handler",
+			ctx:             defaultCtx,
+			argument:        badHandlers,
+			fetchResult:     types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceController(store)
+
+			store.
+				On("GetServiceByName", mock.Anything, "webstore").
+				Return(tc.fetchResult, tc.fetchErr)
+			store.
+				On("UpdateService", mock.Anything).
+				Return(nil)
+
+			err := ctl.Update(tc.ctx, *tc.argument)
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+		})
+	}
+}
+
+func TestServiceQuery(t *testing.T) {
+	rule := types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead)
+	rule.Organization = "default"
+	ctx := testutil.NewContext(testutil.ContextWithRules(rule))
+
+	webstore := types.FixtureService("webstore")
+	billing := types.FixtureService("billing")
+	billing.Organization = "finance"
+
+	store := &mockstore.MockStore{}
+	store.
+		On("GetServices", ctx).
+		Return([]*types.Service{webstore, billing}, nil)
+
+	ctl := NewServiceController(store)
+	services, err := ctl.Query(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Service{webstore}, services)
+}
+
+func TestServiceDestroy(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermDelete),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+		),
+	)
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		fetchResult     *types.Service
+		deleteErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Deleted",
+			ctx:         defaultCtx,
+			fetchResult: types.FixtureService("webstore"),
+		},
+		{
+			name:            "Does Not Exist",
+			ctx:             defaultCtx,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Delete",
+			ctx:             defaultCtx,
+			fetchResult:     types.FixtureService("webstore"),
+			deleteErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			fetchResult:     types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceController(store)
+
+			store.
+				On("GetServiceByName", mock.Anything, "webstore").
+				Return(tc.fetchResult, nil)
+			store.
+				On("DeleteServiceByName", mock.Anything, "webstore").
+				Return(tc.deleteErr)
+
+			err := ctl.Destroy(tc.ctx, "webstore")
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+		})
+	}
+}
+
+func TestServiceFind(t *testing.T) {
+	readCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermRead),
+	))
+	noReadCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeService, types.RulePermCreate),
+	))
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		service         *types.Service
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:    "Found",
+			ctx:     readCtx,
+			service: types.FixtureService("webstore"),
+		},
+		{
+			name:            "Not Found",
+			ctx:             readCtx,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "No Read Permission",
+			ctx:             noReadCtx,
+			service:         types.FixtureService("webstore"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			ctl := NewServiceController(store)
+
+			store.
+				On("GetServiceByName", tc.ctx, "webstore").
+				Return(tc.service, nil)
+
+			result, err := ctl.Find(tc.ctx, "webstore")
+			assertErrCode(t, tc.expectedErr, tc.expectedErrCode, err)
+			assert.Equal(t, err == nil, result != nil)
+		})
+	}
+}
+
+// assertErrCode asserts that err is an Error of the given code when an error
+// is expected, or nil otherwise.
+func assertErrCode(t *testing.T, expected bool, code ErrCode, err error) {
+	t.Helper()
+	if !expected {
+		assert.NoError(t, err)
+		return
+	}
+	if cerr, ok := err.(Error); assert.True(t, ok, "expected an Error, got %v", err) {
+		assert.Equal(t, code, cerr.Code)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -82,10 +83,15 @@ func (a SilencedController) Create(ctx context.Context, newSilence *types.Silenc
 	ctx = addOrgEnvToContext(ctx, newSilence)
 	abilities := a.Policy.WithContext(ctx)
 
-	// Populate newSilence.ID with the subscription and checkName. Substitute a
-	// splat if one of the values does not exist. If both values are empty, the
-	// validator will return an error when attempting to update it in the store.
-	newSilence.ID, _ = types.SilencedID(newSilence.Subscription, newSilence.Check)
+	if err := normalizeLabelSelector(newSilence); err != nil {
+		return err
+	}
+
+	// Populate newSilence.ID with the subscription, checkName and label
+	// selector. Substitute a splat if one of the first values does not exist. If
+	// all values are empty, the validator will return an error when attempting
+	// to update it in the store.
+	newSilence.ID, _ = types.SilencedIDWithSelector(newSilence.Subscription, newSilence.Check, newSilence.LabelSelector)
 
 	// If begin timestamp was not already provided set it to the current time.
 	if newSilence.Begin == 0 {
@@ -129,10 +135,15 @@ func (a SilencedController) CreateOrReplace(ctx context.Context, newSilence type
 	ctx = addOrgEnvToContext(ctx, &newSilence)
 	abilities := a.Policy.WithContext(ctx)
 
-	// Populate newSilence.ID with the subscription and checkName. Substitute a
-	// splat if one of the values does not exist. If both values are empty, the
-	// validator will return an error when attempting to update it in the store.
-	newSilence.ID, _ = types.SilencedID(newSilence.Subscription, newSilence.Check)
+	if err := normalizeLabelSelector(&newSilence); err != nil {
+		return err
+	}
+
+	// Populate newSilence.ID with the subscription, checkName and label
+	// selector. Substitute a splat if one of the first values does not exist. If
+	// all values are empty, the validator will return an error when attempting
+	// to update it in the store.
+	newSilence.ID, _ = types.SilencedIDWithSelector(newSilence.Subscription, newSilence.Check, newSilence.LabelSelector)
 
 	// If begin timestamp was not already provided set it to the current time.
 	if newSilence.Begin == 0 {
@@ -227,3 +238,18 @@ func (a SilencedController) findSilencedEntry(ctx context.Context, id string) (*
 
 	return nil, NewErrorf(NotFound)
 }
+
+// normalizeLabelSelector rewrites the label selector of the given silenced
+// entry in its canonical form, so that equivalent selectors result in the same
+// entry ID.
+func normalizeLabelSelector(silenced *types.Silenced) error {
+	if silenced.LabelSelector == "" {
+		return nil
+	}
+	labels, err := selector.ParseLabelSelector(silenced.LabelSelector)
+	if err != nil {
+		return NewError(InvalidArgument, err)
+	}
+	silenced.LabelSelector = labels.String()
+	return nil
+}
@@ -406,6 +406,21 @@ func TestSilencedCreate(t *testing.T) {
 			expectedCreator: "actorID",
 			expectedId:      "unix:*",
 		},
+		{
+			name:            "Label Selector",
+			ctx:             actorCtx,
+			argument:        labelSilence("team = payments, region"),
+			expectedErr:     false,
+			expectedCreator: "actorID",
+			expectedId:      "*:*:team=payments,region",
+		},
+		{
+			name:            "Invalid Label Selector",
+			ctx:             defaultCtx,
+			argument:        labelSilence("team in (payments"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -451,6 +466,14 @@ func TestSilencedCreate(t *testing.T) {
 	}
 }
 
+func labelSilence(labelSelector string) *types.Silenced {
+	return &types.Silenced{
+		LabelSelector: labelSelector,
+		Organization:  "default",
+		Environment:   "default",
+	}
+}
+
 func TestSilencedUpdate(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithPerms(types.RuleTypeSilenced, types.RulePermUpdate),
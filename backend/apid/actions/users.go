@@ -97,7 +97,7 @@ func (a UserController) Create(ctx context.Context, newUser types.User) error {
 	}
 
 	// Persist
-	if err := a.Store.UpdateUser(&newUser); err != nil {
+	if err := a.Store.UpdateUser(ctx, &newUser); err != nil {
 		return NewError(InternalErr, err)
 	}
 
@@ -128,7 +128,7 @@ func (a UserController) CreateOrReplace(ctx context.Context, newUser types.User)
 	}
 
 	// Persist
-	if err := a.Store.UpdateUser(&newUser); err != nil {
+	if err := a.Store.UpdateUser(ctx, &newUser); err != nil {
 		return NewError(InternalErr, err)
 	}
 
@@ -278,7 +278,7 @@ func (a UserController) findUser(ctx context.Context, name string) (*types.User,
 }
 
 func (a UserController) updateUser(ctx context.Context, user *types.User) error {
-	if err := a.Store.UpdateUser(user); err != nil {
+	if err := a.Store.UpdateUser(ctx, user); err != nil {
 		return NewError(InternalErr, err)
 	}
 
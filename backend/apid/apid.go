@@ -16,6 +16,7 @@ import (
 	"github.com/sensu/sensu-go/backend/apid/routers"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/version"
 )
@@ -28,17 +29,21 @@ type APId struct {
 	// Port is the port APId is running on.
 	Port int
 
-	stopping      chan struct{}
-	running       *atomic.Value
-	wg            *sync.WaitGroup
-	errChan       chan error
-	HttpServer    *http.Server
-	bus           messaging.MessageBus
-	backendStatus func() types.StatusMap
-	store         store.Store
-	queueGetter   types.QueueGetter
-	tls           *types.TLSOptions
-	cluster       clientv3.Cluster
+	stopping       chan struct{}
+	running        *atomic.Value
+	wg             *sync.WaitGroup
+	errChan        chan error
+	HttpServer     *http.Server
+	bus            messaging.MessageBus
+	backendStatus  func() types.StatusMap
+	store          store.Store
+	queueGetter    types.QueueGetter
+	tls            *types.TLSOptions
+	cluster        clientv3.Cluster
+	graphqlLimits  graphql.Limits
+	graphqlTracing bool
+	rateLimiter    *middlewares.RateLimiter
+	auditRetention time.Duration
 }
 
 // Option is a functional option.
@@ -54,34 +59,65 @@ type Config struct {
 	TLS           *types.TLSOptions
 	BackendStatus func() types.StatusMap
 	Cluster       clientv3.Cluster
+	GraphQLLimits graphql.Limits
+
+	// GraphQLTracing enables the tracing extension of GraphQL responses.
+	GraphQLTracing bool
+
+	// RateLimit is the number of requests per second each user, or source IP
+	// for anonymous requests, can make, up to RateBurst at once; zero disables
+	// the limit.
+	RateLimit float64
+	RateBurst int
+
+	// AuditRetention is how long the entries of the audit log are kept; zero
+	// disables the audit log.
+	AuditRetention time.Duration
+
+	// GzipMinSize is the size, in bytes, from which responses are compressed
+	// with gzip; zero disables compression.
+	GzipMinSize int
 }
 
 // New creates a new APId.
 func New(c Config, opts ...Option) (*APId, error) {
 	a := &APId{
-		Host:          c.Host,
-		Port:          c.Port,
-		store:         c.Store,
-		queueGetter:   c.QueueGetter,
-		tls:           c.TLS,
-		backendStatus: c.BackendStatus,
-		bus:           c.Bus,
-		stopping:      make(chan struct{}, 1),
-		running:       &atomic.Value{},
-		wg:            &sync.WaitGroup{},
-		errChan:       make(chan error, 1),
-		cluster:       c.Cluster,
+		Host:           c.Host,
+		Port:           c.Port,
+		store:          c.Store,
+		queueGetter:    c.QueueGetter,
+		tls:            c.TLS,
+		backendStatus:  c.BackendStatus,
+		bus:            c.Bus,
+		stopping:       make(chan struct{}, 1),
+		running:        &atomic.Value{},
+		wg:             &sync.WaitGroup{},
+		errChan:        make(chan error, 1),
+		cluster:        c.Cluster,
+		graphqlLimits:  c.GraphQLLimits,
+		graphqlTracing: c.GraphQLTracing,
+		rateLimiter:    middlewares.NewRateLimiter(c.RateLimit, c.RateBurst),
+		auditRetention: c.AuditRetention,
 	}
 
 	router := mux.NewRouter().UseEncodedPath()
 	router.NotFoundHandler = middlewares.SimpleLogger{}.Then(http.HandlerFunc(notFoundHandler))
-	registerUnauthenticatedResources(router, a.backendStatus, a.store)
-	registerAuthenticationResources(router, a.store)
-	registerRestrictedResources(router, a.store, a.queueGetter, a.bus, a.cluster)
+	registerUnauthenticatedResources(router, a.backendStatus, a.store, a.rateLimiter)
+	registerAuthenticationResources(router, a.store, a.rateLimiter)
+	graphqlRouter := routers.NewGraphQLRouter(a.store, a.bus, a.queueGetter, a.graphqlLimits, a.graphqlTracing, a.auditRetention, middlewares.AuthenticateToken(
+		middlewares.Authentication{},
+		middlewares.AllowList{Store: a.store},
+		middlewares.Authorization{Store: a.store},
+	))
+	registerWebsocketResources(router, graphqlRouter, a.rateLimiter)
+	registerRestrictedResources(router, a.store, a.queueGetter, a.bus, a.cluster, graphqlRouter, a.rateLimiter, a.auditRetention)
+
+	// Streamed responses are written as they go
+	gzip := middlewares.Gzip{MinSize: c.GzipMinSize, Exclude: []string{"/pipeline/results"}}
 
 	a.HttpServer = &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", a.Host, a.Port),
-		Handler:      router,
+		Handler:      gzip.Then(middlewares.RequestID{}.Then(router)),
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
 	}
@@ -161,23 +197,27 @@ func registerUnauthenticatedResources(
 	router *mux.Router,
 	bStatus func() types.StatusMap,
 	store store.Store,
+	limiter *middlewares.RateLimiter,
 ) {
 	mountRouters(
 		NewSubrouter(
 			router.NewRoute(),
 			middlewares.SimpleLogger{},
+			middlewares.RateLimit{Limiter: limiter},
 			middlewares.LimitRequest{},
 			middlewares.Edition{Name: version.Edition},
 		),
 		routers.NewStatusRouter(bStatus, store),
+		routers.NewOpenAPIRouter(router),
 	)
 }
 
-func registerAuthenticationResources(router *mux.Router, store store.Store) {
+func registerAuthenticationResources(router *mux.Router, store store.Store, limiter *middlewares.RateLimiter) {
 	mountRouters(
 		NewSubrouter(
 			router.NewRoute(),
 			middlewares.SimpleLogger{},
+			middlewares.RateLimit{Limiter: limiter},
 			middlewares.RefreshToken{},
 			middlewares.LimitRequest{},
 			middlewares.Edition{Name: version.Edition},
@@ -186,30 +226,54 @@ func registerAuthenticationResources(router *mux.Router, store store.Store) {
 	)
 }
 
-func registerRestrictedResources(router *mux.Router, store store.Store, getter types.QueueGetter, bus messaging.MessageBus, cluster clientv3.Cluster) {
+// registerWebsocketResources registers the websocket endpoints, which
+// authenticate their connections themselves
+func registerWebsocketResources(router *mux.Router, graphqlRouter *routers.GraphQLRouter, limiter *middlewares.RateLimiter) {
+	mountRouters(
+		NewSubrouter(
+			router.NewRoute(),
+			middlewares.SimpleLogger{},
+			middlewares.RateLimit{Limiter: limiter},
+			middlewares.Edition{Name: version.Edition},
+		),
+		graphqlRouter.WebsocketRouter(),
+	)
+}
+
+func registerRestrictedResources(router *mux.Router, store store.Store, getter types.QueueGetter, bus messaging.MessageBus, cluster clientv3.Cluster, graphqlRouter *routers.GraphQLRouter, limiter *middlewares.RateLimiter, auditRetention time.Duration) {
 	mountRouters(
 		NewSubrouter(
 			router.NewRoute(),
 			middlewares.SimpleLogger{},
 			middlewares.Environment{Store: store},
 			middlewares.Authentication{},
+			middlewares.RateLimit{Limiter: limiter},
+			middlewares.Audit{Store: store, Retention: auditRetention, Exclude: []string{"/graphql"}},
 			middlewares.AllowList{Store: store},
 			middlewares.Authorization{Store: store},
 			middlewares.LimitRequest{},
 			middlewares.Edition{Name: version.Edition},
 		),
 		routers.NewAssetRouter(store),
+		routers.NewAuditRouter(store),
 		routers.NewChecksRouter(actions.NewCheckController(store, getter)),
-		routers.NewEntitiesRouter(store),
+		routers.NewEntitiesRouter(store, bus),
 		routers.NewEnvironmentsRouter(actions.NewEnvironmentController(store)),
 		routers.NewEventFiltersRouter(store),
 		routers.NewEventsRouter(store, bus),
-		routers.NewGraphQLRouter(store, bus, getter),
+		graphqlRouter,
 		routers.NewHandlersRouter(store),
 		routers.NewHooksRouter(store),
 		routers.NewMutatorsRouter(store),
+		routers.NewNamespacesRouter(actions.NewNamespaceController(store, store)),
+		routers.NewResourcesRouter(store),
 		routers.NewOrganizationsRouter(actions.NewOrganizationsController(store)),
+		routers.NewPipelineRouter(bus),
+		routers.NewPipelineErrorsRouter(store),
 		routers.NewRolesRouter(store),
+		routers.NewSecretsRouter(store),
+		routers.NewServicesRouter(store),
+		routers.NewServiceComponentsRouter(store),
 		routers.NewSilencedRouter(store),
 		routers.NewUsersRouter(store),
 		routers.NewExtensionsRouter(store),
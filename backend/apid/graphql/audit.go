@@ -0,0 +1,42 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.AuditEntryFieldResolvers = (*auditEntryImpl)(nil)
+
+//
+// Implement AuditEntryFieldResolvers
+//
+
+type auditEntryImpl struct {
+	schema.AuditEntryAliases
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*auditEntryImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// RequestID implements response to request for 'requestId' field.
+func (*auditEntryImpl) RequestID(p graphql.ResolveParams) (string, error) {
+	entry := p.Source.(*types.AuditEntry)
+	return entry.RequestID, nil
+}
+
+// Timestamp implements response to request for 'timestamp' field.
+func (*auditEntryImpl) Timestamp(p graphql.ResolveParams) (time.Time, error) {
+	entry := p.Source.(*types.AuditEntry)
+	return time.Unix(entry.Timestamp, 0), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*auditEntryImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.AuditEntry)
+	return ok
+}
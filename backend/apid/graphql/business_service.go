@@ -0,0 +1,204 @@
+package graphql
+
+import (
+	"sort"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/strings"
+)
+
+var _ schema.ServiceFieldResolvers = (*serviceImpl)(nil)
+var _ schema.ServiceComponentFieldResolvers = (*serviceComponentImpl)(nil)
+var _ schema.ServiceRuleFieldResolvers = (*serviceRuleImpl)(nil)
+
+//
+// Implement ServiceFieldResolvers
+//
+
+type serviceImpl struct {
+	schema.ServiceAliases
+	componentQuerier serviceComponentQuerier
+	eventQuerier     eventQuerier
+	handlerQuerier   handlerQuerier
+}
+
+func newServiceImpl(store store.Store) *serviceImpl {
+	return &serviceImpl{
+		componentQuerier: actions.NewServiceComponentController(store),
+		eventQuerier:     actions.NewEventController(store, nil),
+		handlerQuerier:   actions.NewHandlerController(store),
+	}
+}
+
+// ID implements response to request for 'id' field.
+func (*serviceImpl) ID(p graphql.ResolveParams) (string, error) {
+	return globalid.ServiceTranslator.EncodeToString(p.Source), nil
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*serviceImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// Handlers implements response to request for 'handlers' field.
+func (r *serviceImpl) Handlers(p graphql.ResolveParams) (interface{}, error) {
+	service := p.Source.(*types.Service)
+	ctx := types.SetContextFromResource(p.Context, service)
+	return fetchHandlersWithNames(ctx, r.handlerQuerier, service.Handlers)
+}
+
+// Components implements response to request for 'components' field.
+func (r *serviceImpl) Components(p graphql.ResolveParams) (interface{}, error) {
+	service := p.Source.(*types.Service)
+	ctx := types.SetContextFromResource(p.Context, service)
+	records, err := loadServiceComponents(ctx, r.componentQuerier)
+	if err != nil {
+		return []*types.ServiceComponent{}, err
+	}
+
+	components := make([]*types.ServiceComponent, 0, len(records))
+	for _, component := range records {
+		if strings.InArray(service.Name, component.Services) {
+			components = append(components, component)
+		}
+	}
+	return components, nil
+}
+
+// Health implements response to request for 'health' field.
+func (r *serviceImpl) Health(p graphql.ResolveParams) (interface{}, error) {
+	service := p.Source.(*types.Service)
+	ctx := types.SetContextFromResource(p.Context, service)
+	events, err := loadEvents(ctx, r.eventQuerier)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if event.HasCheck() && event.Entity.ID == service.Name && event.Check.Name == types.ServiceHealthCheckName {
+			return event, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*serviceImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.Service)
+	return ok
+}
+
+//
+// Implement ServiceComponentFieldResolvers
+//
+
+type serviceComponentImpl struct {
+	schema.ServiceComponentAliases
+	eventQuerier   eventQuerier
+	handlerQuerier handlerQuerier
+}
+
+func newServiceComponentImpl(store store.Store) *serviceComponentImpl {
+	return &serviceComponentImpl{
+		eventQuerier:   actions.NewEventController(store, nil),
+		handlerQuerier: actions.NewHandlerController(store),
+	}
+}
+
+// ID implements response to request for 'id' field.
+func (*serviceComponentImpl) ID(p graphql.ResolveParams) (string, error) {
+	return globalid.ServiceComponentTranslator.EncodeToString(p.Source), nil
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*serviceComponentImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// Interval implements response to request for 'interval' field.
+func (*serviceComponentImpl) Interval(p graphql.ResolveParams) (int, error) {
+	component := p.Source.(*types.ServiceComponent)
+	return int(component.Interval), nil
+}
+
+// Rules implements response to request for 'rules' field.
+func (*serviceComponentImpl) Rules(p graphql.ResolveParams) (interface{}, error) {
+	component := p.Source.(*types.ServiceComponent)
+	rules := make([]*types.ServiceRule, 0, len(component.Rules))
+	for i := range component.Rules {
+		rules = append(rules, &component.Rules[i])
+	}
+	return rules, nil
+}
+
+// Handlers implements response to request for 'handlers' field.
+func (r *serviceComponentImpl) Handlers(p graphql.ResolveParams) (interface{}, error) {
+	component := p.Source.(*types.ServiceComponent)
+	ctx := types.SetContextFromResource(p.Context, component)
+	return fetchHandlersWithNames(ctx, r.handlerQuerier, component.Handlers)
+}
+
+// Events implements response to request for 'events' field.
+func (r *serviceComponentImpl) Events(p graphql.ResolveParams) (interface{}, error) {
+	component := p.Source.(*types.ServiceComponent)
+	ctx := types.SetContextFromResource(p.Context, component)
+	records, err := loadEvents(ctx, r.eventQuerier)
+	if err != nil {
+		return []*types.Event{}, err
+	}
+
+	events := make([]*types.Event, 0, len(component.Services))
+	for _, event := range records {
+		if event.HasCheck() && event.Check.Name == component.Name && strings.InArray(event.Entity.ID, component.Services) {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Entity.ID < events[j].Entity.ID
+	})
+	return events, nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*serviceComponentImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.ServiceComponent)
+	return ok
+}
+
+//
+// Implement ServiceRuleFieldResolvers
+//
+
+type serviceRuleImpl struct {
+	schema.ServiceRuleAliases
+}
+
+// Status implements response to request for 'status' field.
+func (*serviceRuleImpl) Status(p graphql.ResolveParams) (int, error) {
+	rule := p.Source.(*types.ServiceRule)
+	return int(rule.Status), nil
+}
+
+// FailingCount implements response to request for 'failingCount' field.
+func (*serviceRuleImpl) FailingCount(p graphql.ResolveParams) (int, error) {
+	rule := p.Source.(*types.ServiceRule)
+	return int(rule.FailingCount), nil
+}
+
+// FailingPercentage implements response to request for 'failingPercentage'
+// field.
+func (*serviceRuleImpl) FailingPercentage(p graphql.ResolveParams) (int, error) {
+	rule := p.Source.(*types.ServiceRule)
+	return int(rule.FailingPercentage), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*serviceRuleImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.ServiceRule)
+	return ok
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceTypeComponentsField(t *testing.T) {
+	impl := &serviceImpl{componentQuerier: mockServiceComponentQuerier{els: []*types.ServiceComponent{
+		types.FixtureServiceComponent("web", "webstore"),
+		types.FixtureServiceComponent("db", "webstore"),
+		types.FixtureServiceComponent("ldap", "intranet"),
+	}}}
+
+	params := graphql.ResolveParams{Context: context.Background(), Source: types.FixtureService("webstore")}
+	res, err := impl.Components(params)
+	require.NoError(t, err)
+	components := res.([]*types.ServiceComponent)
+	require.Len(t, components, 2)
+	assert.Equal(t, "web", components[0].Name)
+	assert.Equal(t, "db", components[1].Name)
+}
+
+func TestServiceTypeHealthField(t *testing.T) {
+	health := types.FixtureEvent("webstore", types.ServiceHealthCheckName)
+	impl := &serviceImpl{eventQuerier: mockEventQuerier{els: []*types.Event{
+		types.FixtureEvent("webstore", "web"),
+		health,
+		types.FixtureEvent("intranet", types.ServiceHealthCheckName),
+	}}}
+
+	params := graphql.ResolveParams{Context: context.Background(), Source: types.FixtureService("webstore")}
+	res, err := impl.Health(params)
+	require.NoError(t, err)
+	assert.Equal(t, health, res)
+
+	params.Source = types.FixtureService("billing")
+	res, err = impl.Health(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestServiceComponentTypeEventsField(t *testing.T) {
+	component := types.FixtureServiceComponent("web", "webstore")
+	component.Services = append(component.Services, "intranet")
+	impl := &serviceComponentImpl{eventQuerier: mockEventQuerier{els: []*types.Event{
+		types.FixtureEvent("webstore", "web"),
+		types.FixtureEvent("webstore", "db"),
+		types.FixtureEvent("intranet", "web"),
+		types.FixtureEvent("web1", "web"),
+	}}}
+
+	params := graphql.ResolveParams{Context: context.Background(), Source: component}
+	res, err := impl.Events(params)
+	require.NoError(t, err)
+	events := res.([]*types.Event)
+	require.Len(t, events, 2)
+	assert.Equal(t, "intranet", events[0].Entity.ID)
+	assert.Equal(t, "webstore", events[1].Entity.ID)
+}
@@ -63,6 +63,13 @@ func (*checkCfgImpl) ExtendedAttributes(p graphql.ResolveParams) (interface{}, e
 	return wrapExtendedAttributes(check.ExtendedAttributes), nil
 }
 
+// ExtendedAttribute implements response to request for 'extendedAttribute' field.
+func (*checkCfgImpl) ExtendedAttribute(p schema.CheckConfigExtendedAttributeFieldResolverParams) (interface{}, error) {
+	check := p.Source.(*types.CheckConfig)
+	value, _ := check.ExtendedAttributes.Get(p.Args.Path)
+	return value, nil
+}
+
 // Handlers implements response to request for 'handlers' field.
 func (r *checkCfgImpl) Handlers(p graphql.ResolveParams) (interface{}, error) {
 	check := p.Source.(*types.CheckConfig)
@@ -102,11 +109,15 @@ func (r *checkCfgImpl) Silences(p graphql.ResolveParams) (interface{}, error) {
 // ToJSON implements response to request for 'toJSON' field.
 func (r *checkCfgImpl) ToJSON(p graphql.ResolveParams) (interface{}, error) {
 	check := p.Source.(*types.CheckConfig)
+	if !canViewCommand(p.Context, check) {
+		check = check.DeepCopy()
+		check.Command = types.RedactedSecret
+	}
 	return types.WrapResource(check), nil
 }
 
-func fetchHandlersWithNames(ctx context.Context, ctrl actions.HandlerController, names []string) ([]*types.Handler, error) {
-	handlers, err := ctrl.Query(ctx)
+func fetchHandlersWithNames(ctx context.Context, ctrl handlerQuerier, names []string) ([]*types.Handler, error) {
+	handlers, err := loadHandlers(ctx, ctrl)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +134,7 @@ func fetchHandlersWithNames(ctx context.Context, ctrl actions.HandlerController,
 }
 
 func fetchCheckConfigSilences(ctx context.Context, ctrl silenceQuerier, check namedCheck) ([]*types.Silenced, error) {
-	sls, err := ctrl.Query(ctx, "", "")
+	sls, err := loadSilences(ctx, ctrl)
 	matched := make([]*types.Silenced, 0, len(sls))
 	if err != nil {
 		return []*types.Silenced{}, err
@@ -198,6 +209,13 @@ func (*checkImpl) ExtendedAttributes(p graphql.ResolveParams) (interface{}, erro
 	return wrapExtendedAttributes(check.ExtendedAttributes), nil
 }
 
+// ExtendedAttribute implements response to request for 'extendedAttribute' field.
+func (*checkImpl) ExtendedAttribute(p schema.CheckExtendedAttributeFieldResolverParams) (interface{}, error) {
+	check := p.Source.(*types.Check)
+	value, _ := check.ExtendedAttributes.Get(p.Args.Path)
+	return value, nil
+}
+
 // LastOK implements response to request for 'lastOK' field.
 func (r *checkImpl) LastOK(p graphql.ResolveParams) (*time.Time, error) {
 	c := p.Source.(*types.Check)
@@ -210,6 +228,14 @@ func (r *checkImpl) Issued(p graphql.ResolveParams) (time.Time, error) {
 	return time.Unix(c.Issued, 0), nil
 }
 
+// Severity implements response to request for 'severity' field. The severity
+// is derived from the status, the events stored before severities were
+// introduced not carrying one.
+func (r *checkImpl) Severity(p graphql.ResolveParams) (string, error) {
+	c := p.Source.(*types.Check)
+	return types.Severity(c.Status, c.Severities), nil
+}
+
 // History implements response to request for 'history' field.
 func (r *checkImpl) History(p schema.CheckHistoryFieldResolverParams) (interface{}, error) {
 	check := p.Source.(*types.Check)
@@ -254,7 +280,7 @@ func (r *checkImpl) ProxyEntityID(p graphql.ResolveParams) (string, error) {
 }
 
 func fetchCheckSilences(ctx context.Context, ctrl silenceQuerier, check silenceableCheck) ([]*types.Silenced, error) {
-	sls, err := ctrl.Query(ctx, "", "")
+	sls, err := loadSilences(ctx, ctrl)
 	matched := make([]*types.Silenced, 0, len(sls))
 	if err != nil {
 		return matched, err
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
 	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,6 +64,19 @@ func TestCheckTypeLastOKFieldImpl(t *testing.T) {
 	assert.Equal(t, now.Unix(), res.Unix())
 }
 
+func TestCheckTypeSeverityFieldImpl(t *testing.T) {
+	check := types.FixtureCheck("test")
+	check.Status = 3
+	check.Severities = map[string]string{"3": "disaster"}
+
+	impl := checkImpl{}
+	params := graphql.ResolveParams{Source: check}
+
+	res, err := impl.Severity(params)
+	require.NoError(t, err)
+	assert.Equal(t, "disaster", res)
+}
+
 func TestCheckTypeIssuedFieldImpl(t *testing.T) {
 	now := time.Now()
 	check := types.FixtureCheck("test")
@@ -149,3 +163,21 @@ func TestCheckConfigTypeSilencesField(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, res, 2)
 }
+
+func TestCheckConfigTypeToJSONField(t *testing.T) {
+	check := types.FixtureCheckConfig("my-check")
+	impl := &checkCfgImpl{}
+
+	// The command is visible to viewers able to update the check
+	ctx := testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeCheck, types.RulePermUpdate))
+	res, err := impl.ToJSON(graphql.ResolveParams{Source: check, Context: ctx})
+	require.NoError(t, err)
+	assert.Equal(t, check.Command, res.(types.Wrapper).Value.(*types.CheckConfig).Command)
+
+	// And redacted for the others, like the command field
+	ctx = testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeCheck, types.RulePermRead))
+	res, err = impl.ToJSON(graphql.ResolveParams{Source: check, Context: ctx})
+	require.NoError(t, err)
+	assert.Equal(t, types.RedactedSecret, res.(types.Wrapper).Value.(*types.CheckConfig).Command)
+	assert.NotEqual(t, types.RedactedSecret, check.Command)
+}
@@ -32,7 +32,7 @@ type entityImpl struct {
 }
 
 func newEntityImpl(store store.Store) *entityImpl {
-	entityCtrl := actions.NewEntityController(store)
+	entityCtrl := actions.NewEntityController(store, nil)
 	eventCtrl := actions.NewEventController(store, nil)
 	silenceCtrl := actions.NewSilencedController(store)
 
@@ -65,6 +65,13 @@ func (*entityImpl) ExtendedAttributes(p graphql.ResolveParams) (interface{}, err
 	return wrapExtendedAttributes(entity.ExtendedAttributes), nil
 }
 
+// ExtendedAttribute implements response to request for 'extendedAttribute' field.
+func (*entityImpl) ExtendedAttribute(p schema.EntityExtendedAttributeFieldResolverParams) (interface{}, error) {
+	entity := p.Source.(*types.Entity)
+	value, _ := entity.ExtendedAttributes.Get(p.Args.Path)
+	return value, nil
+}
+
 // LastSeen implements response to request for 'executed' field.
 func (r *entityImpl) LastSeen(p graphql.ResolveParams) (*time.Time, error) {
 	e := p.Source.(*types.Entity)
@@ -77,7 +84,7 @@ func (r *entityImpl) Events(p schema.EntityEventsFieldResolverParams) (interface
 
 	// fetch
 	ctx := types.SetContextFromResource(p.Context, entity)
-	evs, err := r.eventQuerier.Query(ctx, entity.ID, "")
+	evs, err := loadEntityEvents(ctx, r.eventQuerier, entity)
 	if err != nil {
 		return 0, err
 	}
@@ -101,7 +108,7 @@ func (r *entityImpl) Related(p schema.EntityRelatedFieldResolverParams) (interfa
 
 	// fetch
 	ctx := types.SetContextFromResource(p.Context, entity)
-	entities, err := r.entityQuerier.Query(ctx)
+	entities, err := loadEntities(ctx, r.entityQuerier)
 	if err != nil {
 		return []*types.Entity{}, err
 	}
@@ -150,7 +157,7 @@ func (r *entityImpl) Status(p graphql.ResolveParams) (int, error) {
 
 	// fetch
 	ctx := types.SetContextFromResource(p.Context, entity)
-	evs, err := r.eventQuerier.Query(ctx, entity.ID, "")
+	evs, err := loadEntityEvents(ctx, r.eventQuerier, entity)
 	if err != nil {
 		return 0, err
 	}
@@ -183,7 +190,7 @@ func (r *entityImpl) Silences(p graphql.ResolveParams) (interface{}, error) {
 }
 
 func fetchEntitySilencedEntries(ctx context.Context, ctrl silenceQuerier, entity *types.Entity) ([]*types.Silenced, error) {
-	sls, err := ctrl.Query(ctx, "", "")
+	sls, err := loadSilences(ctx, ctrl)
 	matched := make([]*types.Silenced, 0, len(sls))
 	if err != nil {
 		return matched, err
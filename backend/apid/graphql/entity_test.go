@@ -43,9 +43,9 @@ func TestEntityTypeRelatedField(t *testing.T) {
 func TestEntityTypeStatusField(t *testing.T) {
 	entity := types.FixtureEntity("en")
 	mock := mockEventQuerier{els: []*types.Event{
-		types.FixtureEvent("a", entity.ID),
-		types.FixtureEvent("b", entity.ID),
-		types.FixtureEvent("c", entity.ID),
+		types.FixtureEvent(entity.ID, "a"),
+		types.FixtureEvent(entity.ID, "b"),
+		types.FixtureEvent(entity.ID, "c"),
 	}}
 
 	// params
@@ -59,7 +59,7 @@ func TestEntityTypeStatusField(t *testing.T) {
 	assert.Equal(t, 0, st)
 
 	// Add failing event
-	failingEv := types.FixtureEvent("a", entity.ID)
+	failingEv := types.FixtureEvent(entity.ID, "a")
 	failingEv.Check.Status = 2
 	mock.els = append(mock.els, failingEv)
 
@@ -85,12 +85,30 @@ func TestEntityTypeLastSeenField(t *testing.T) {
 	assert.Equal(t, res.Unix(), now.Unix())
 }
 
+func TestEntityTypeExtendedAttributeField(t *testing.T) {
+	entity := types.FixtureEntity("id")
+	entity.ExtendedAttributes = []byte(`{"aws":{"region":"us-west-2"}}`)
+	params := schema.EntityExtendedAttributeFieldResolverParams{}
+	params.Source = entity
+
+	impl := entityImpl{}
+	params.Args.Path = "aws.region"
+	res, err := impl.ExtendedAttribute(params)
+	require.NoError(t, err)
+	assert.Equal(t, "us-west-2", res)
+
+	params.Args.Path = "aws.zone"
+	res, err = impl.ExtendedAttribute(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
 func TestEntityTypeEventsField(t *testing.T) {
 	entity := types.FixtureEntity("en")
 	mock := mockEventQuerier{els: []*types.Event{
-		types.FixtureEvent("a", entity.ID),
-		types.FixtureEvent("b", entity.ID),
-		types.FixtureEvent("c", entity.ID),
+		types.FixtureEvent(entity.ID, "a"),
+		types.FixtureEvent(entity.ID, "b"),
+		types.FixtureEvent(entity.ID, "c"),
 	}}
 
 	// params
@@ -27,6 +27,9 @@ type envImpl struct {
 	entityCtrl     actions.EntityController
 	eventQuerier   eventQuerier
 	silenceQuerier silenceQuerier
+	handlerQuerier handlerQuerier
+	auditQuerier   auditQuerier
+	serviceQuerier serviceQuerier
 }
 
 func newEnvImpl(store store.Store, getter types.QueueGetter) *envImpl {
@@ -35,9 +38,12 @@ func newEnvImpl(store store.Store, getter types.QueueGetter) *envImpl {
 	return &envImpl{
 		orgFinder:      actions.NewOrganizationsController(store),
 		checksCtrl:     actions.NewCheckController(store, getter),
-		entityCtrl:     actions.NewEntityController(store),
+		entityCtrl:     actions.NewEntityController(store, nil),
 		eventQuerier:   eventsCtrl,
 		silenceQuerier: silenceCtrl,
+		handlerQuerier: actions.NewHandlerController(store),
+		auditQuerier:   actions.NewAuditController(store),
+		serviceQuerier: actions.NewServiceController(store),
 	}
 }
 
@@ -58,6 +64,12 @@ func (r *envImpl) Description(p graphql.ResolveParams) (string, error) {
 	return env.Description, nil
 }
 
+// EventRetention implements response to request for 'eventRetention' field.
+func (r *envImpl) EventRetention(p graphql.ResolveParams) (int, error) {
+	env := p.Source.(*types.Environment)
+	return int(env.EventRetention), nil
+}
+
 // ColourID implements response to request for 'colourId' field.
 // Experimental. Value is not persisted in any way at this time and is simply
 // derived from the name.
@@ -94,6 +106,29 @@ func (r *envImpl) Checks(p schema.EnvironmentChecksFieldResolverParams) (interfa
 	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
 	env := p.Source.(*types.Environment)
 	ctx := types.SetContextFromResource(p.Context, env)
+	page := pageArgs{
+		offset: p.Args.Offset,
+		limit:  p.Args.Limit,
+		first:  p.Args.First,
+		after:  p.Args.After,
+		last:   p.Args.Last,
+		before: p.Args.Before,
+	}
+
+	// Read the page from the store when the checks are listed in its order
+	pred, low, ok := page.storePredicate()
+	if ok && len(p.Args.Filter) == 0 && p.Args.OrderBy == schema.CheckListOrders.NAME {
+		records, err := r.checksCtrl.Query(store.ContextWithSelectionPredicate(ctx, pred))
+		if err != nil {
+			return res, err
+		}
+		res.paginateStore(records, low, pred, func() (int, error) {
+			records, err := r.checksCtrl.Query(ctx)
+			return len(records), err
+		})
+		return res, nil
+	}
+
 	records, err := r.checksCtrl.Query(ctx)
 	if err != nil {
 		return res, err
@@ -126,9 +161,11 @@ func (r *envImpl) Checks(p schema.EnvironmentChecksFieldResolverParams) (interfa
 	))
 
 	// paginate
-	l, h := clampSlice(p.Args.Offset, p.Args.Offset+p.Args.Limit, len(filteredChecks))
-	res.Nodes = filteredChecks[l:h]
-	res.PageInfo.totalCount = len(filteredChecks)
+	l, h, err := page.bounds(filteredChecks)
+	if err != nil {
+		return res, err
+	}
+	res.paginate(filteredChecks[l:h], l, len(filteredChecks))
 	return res, nil
 }
 
@@ -136,10 +173,32 @@ func (r *envImpl) Checks(p schema.EnvironmentChecksFieldResolverParams) (interfa
 func (r *envImpl) Silences(p schema.EnvironmentSilencesFieldResolverParams) (interface{}, error) {
 	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
 	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	page := pageArgs{
+		offset: p.Args.Offset,
+		limit:  p.Args.Limit,
+		first:  p.Args.First,
+		after:  p.Args.After,
+		last:   p.Args.Last,
+		before: p.Args.Before,
+	}
+
+	// Read the page from the store when the entries are listed in its order
+	pred, low, ok := page.storePredicate()
+	if ok && len(p.Args.Filter) == 0 && p.Args.OrderBy == schema.SilencesListOrders.ID_DESC {
+		records, err := r.silenceQuerier.Query(store.ContextWithSelectionPredicate(ctx, pred), "", "")
+		if err != nil {
+			return res, err
+		}
+		res.paginateStore(records, low, pred, func() (int, error) {
+			records, err := loadSilences(ctx, r.silenceQuerier)
+			return len(records), err
+		})
+		return res, nil
+	}
 
 	// finds all records
-	ctx := types.SetContextFromResource(p.Context, env)
-	records, err := r.silenceQuerier.Query(ctx, "", "")
+	records, err := loadSilences(ctx, r.silenceQuerier)
 	if err != nil {
 		return nil, err
 	}
@@ -177,9 +236,44 @@ func (r *envImpl) Silences(p schema.EnvironmentSilencesFieldResolverParams) (int
 		sort.Sort(types.SortSilencedByID(filteredSilences))
 	}
 
-	l, h := clampSlice(p.Args.Offset, p.Args.Offset+p.Args.Limit, len(filteredSilences))
-	res.Nodes = filteredSilences[l:h]
-	res.PageInfo.totalCount = len(filteredSilences)
+	// paginate
+	l, h, err := page.bounds(filteredSilences)
+	if err != nil {
+		return res, err
+	}
+	res.paginate(filteredSilences[l:h], l, len(filteredSilences))
+	return res, nil
+}
+
+// AuditEntries implements response to request for 'auditEntries' field.
+func (r *envImpl) AuditEntries(p schema.EnvironmentAuditEntriesFieldResolverParams) (interface{}, error) {
+	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
+	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	records, err := r.auditQuerier.Query(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	// sort records, newest first
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp > records[j].Timestamp
+	})
+
+	// paginate
+	page := pageArgs{
+		offset: p.Args.Offset,
+		limit:  p.Args.Limit,
+		first:  p.Args.First,
+		after:  p.Args.After,
+		last:   p.Args.Last,
+		before: p.Args.Before,
+	}
+	l, h, err := page.bounds(records)
+	if err != nil {
+		return res, err
+	}
+	res.paginate(records[l:h], l, len(records))
 	return res, nil
 }
 
@@ -188,7 +282,30 @@ func (r *envImpl) Entities(p schema.EnvironmentEntitiesFieldResolverParams) (int
 	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
 	env := p.Source.(*types.Environment)
 	ctx := types.SetContextFromResource(p.Context, env)
-	records, err := r.entityCtrl.Query(ctx)
+	page := pageArgs{
+		offset: p.Args.Offset,
+		limit:  p.Args.Limit,
+		first:  p.Args.First,
+		after:  p.Args.After,
+		last:   p.Args.Last,
+		before: p.Args.Before,
+	}
+
+	// Read the page from the store when the entities are listed in its order
+	pred, low, ok := page.storePredicate()
+	if ok && len(p.Args.Filter) == 0 && p.Args.OrderBy == schema.EntityListOrders.ID {
+		records, err := r.entityCtrl.Query(store.ContextWithSelectionPredicate(ctx, pred))
+		if err != nil {
+			return res, err
+		}
+		res.paginateStore(records, low, pred, func() (int, error) {
+			records, err := loadEntities(ctx, r.entityCtrl)
+			return len(records), err
+		})
+		return res, nil
+	}
+
+	records, err := loadEntities(ctx, r.entityCtrl)
 	if err != nil {
 		return nil, err
 	}
@@ -225,9 +342,11 @@ func (r *envImpl) Entities(p schema.EnvironmentEntitiesFieldResolverParams) (int
 	}
 
 	// paginate
-	l, h := clampSlice(p.Args.Offset, p.Args.Offset+p.Args.Limit, len(filteredEntities))
-	res.Nodes = filteredEntities[l:h]
-	res.PageInfo.totalCount = len(filteredEntities)
+	l, h, err := page.bounds(filteredEntities)
+	if err != nil {
+		return res, err
+	}
+	res.paginate(filteredEntities[l:h], l, len(filteredEntities))
 	return res, nil
 }
 
@@ -236,15 +355,75 @@ func (r *envImpl) Events(p schema.EnvironmentEventsFieldResolverParams) (interfa
 	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
 	env := p.Source.(*types.Environment)
 	ctx := types.SetContextFromResource(p.Context, env)
-	records, err := r.eventQuerier.Query(ctx, "", "")
+	records, err := loadEvents(ctx, r.eventQuerier)
 	if err != nil {
 		return res, err
 	}
 
 	// apply filters
+	filteredEvents, err := filterEvents(records, p.Args.Filter)
+	if err != nil {
+		return res, err
+	}
+
+	// sort records
+	if p.Args.OrderBy == schema.EventsListOrders.SEVERITY {
+		sort.Sort(types.EventsBySeverity(filteredEvents))
+	} else {
+		sort.Sort(types.EventsByTimestamp(
+			filteredEvents,
+			p.Args.OrderBy == schema.EventsListOrders.NEWEST,
+		))
+	}
+
+	// paginate
+	page := pageArgs{
+		offset: p.Args.Offset,
+		limit:  p.Args.Limit,
+		first:  p.Args.First,
+		after:  p.Args.After,
+		last:   p.Args.Last,
+		before: p.Args.Before,
+	}
+	l, h, err := page.bounds(filteredEvents)
+	if err != nil {
+		return res, err
+	}
+	res.paginate(filteredEvents[l:h], l, len(filteredEvents))
+	return res, nil
+}
+
+// EventAggregates implements response to request for 'eventAggregates' field.
+func (r *envImpl) EventAggregates(p schema.EnvironmentEventAggregatesFieldResolverParams) (interface{}, error) {
+	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	records, err := loadEvents(ctx, r.eventQuerier)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredEvents, err := filterEvents(records, p.Args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return aggregateEvents(filteredEvents), nil
+}
+
+// filterEvents returns the given events matching the given filter; either
+// event filter statements or a Sensu Query Expression.
+func filterEvents(records []*types.Event, filter string) ([]*types.Event, error) {
 	var filteredEvents []*types.Event
-	filter := p.Args.Filter
-	if len(filter) > 0 {
+	if isEventFilter(filter) {
+		predicate, err := newEventPredicate(filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range records {
+			if predicate(event) {
+				filteredEvents = append(filteredEvents, event)
+			}
+		}
+	} else if len(filter) > 0 {
 		predicate, err := eval.NewPredicate(filter)
 		if err != nil {
 			logger.WithError(err).Debug("error with given predicate")
@@ -260,21 +439,76 @@ func (r *envImpl) Events(p schema.EnvironmentEventsFieldResolverParams) (interfa
 	} else {
 		filteredEvents = records
 	}
+	return filteredEvents, nil
+}
 
-	// sort records
-	if p.Args.OrderBy == schema.EventsListOrders.SEVERITY {
-		sort.Sort(types.EventsBySeverity(filteredEvents))
+// Handlers implements response to request for 'handlers' field.
+func (r *envImpl) Handlers(p schema.EnvironmentHandlersFieldResolverParams) (interface{}, error) {
+	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
+	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	page := pageArgs{
+		offset: p.Args.Offset,
+		limit:  p.Args.Limit,
+		first:  p.Args.First,
+		after:  p.Args.After,
+		last:   p.Args.Last,
+		before: p.Args.Before,
+	}
+
+	// Read the page from the store when the handlers are listed in its order
+	pred, low, ok := page.storePredicate()
+	if ok && len(p.Args.Filter) == 0 && p.Args.OrderBy != schema.HandlerListOrders.NAME_DESC {
+		records, err := r.handlerQuerier.Query(store.ContextWithSelectionPredicate(ctx, pred))
+		if err != nil {
+			return res, err
+		}
+		res.paginateStore(records, low, pred, func() (int, error) {
+			records, err := loadHandlers(ctx, r.handlerQuerier)
+			return len(records), err
+		})
+		return res, nil
+	}
+
+	records, err := loadHandlers(ctx, r.handlerQuerier)
+	if err != nil {
+		return res, err
+	}
+
+	// apply filters
+	var filteredHandlers []*types.Handler
+	filter := p.Args.Filter
+	if len(filter) > 0 {
+		predicate, err := eval.NewPredicate(filter)
+		if err != nil {
+			logger.WithError(err).Debug("error with given predicate")
+		} else {
+			for _, record := range records {
+				if matched, err := predicate.Eval(record); err != nil {
+					logger.WithError(err).Debug("unable to filter record")
+				} else if matched {
+					filteredHandlers = append(filteredHandlers, record)
+				}
+			}
+		}
 	} else {
-		sort.Sort(types.EventsByTimestamp(
-			filteredEvents,
-			p.Args.OrderBy == schema.EventsListOrders.NEWEST,
-		))
+		filteredHandlers = records
 	}
 
-	// pagination
-	l, h := clampSlice(p.Args.Offset, p.Args.Offset+p.Args.Limit, len(filteredEvents))
-	res.Nodes = filteredEvents[l:h]
-	res.PageInfo.totalCount = len(filteredEvents)
+	// sort records
+	sort.Slice(filteredHandlers, func(i, j int) bool {
+		if p.Args.OrderBy == schema.HandlerListOrders.NAME_DESC {
+			return filteredHandlers[i].Name > filteredHandlers[j].Name
+		}
+		return filteredHandlers[i].Name < filteredHandlers[j].Name
+	})
+
+	// paginate
+	l, h, err := page.bounds(filteredHandlers)
+	if err != nil {
+		return res, err
+	}
+	res.paginate(filteredHandlers[l:h], l, len(filteredHandlers))
 	return res, nil
 }
 
@@ -282,7 +516,7 @@ func (r *envImpl) Events(p schema.EnvironmentEventsFieldResolverParams) (interfa
 func (r *envImpl) CheckHistory(p schema.EnvironmentCheckHistoryFieldResolverParams) (interface{}, error) {
 	env := p.Source.(*types.Environment)
 	ctx := types.SetContextFromResource(p.Context, env)
-	records, err := r.eventQuerier.Query(ctx, "", "")
+	records, err := loadEvents(ctx, r.eventQuerier)
 	if err != nil {
 		return []types.CheckHistory{}, err
 	}
@@ -309,13 +543,50 @@ func (r *envImpl) CheckHistory(p schema.EnvironmentCheckHistoryFieldResolverPara
 	return history[0:limit], nil
 }
 
+// Search implements response to request for 'search' field.
+func (r *envImpl) Search(p schema.EnvironmentSearchFieldResolverParams) (interface{}, error) {
+	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	events, err := loadEvents(ctx, r.eventQuerier)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := loadEntities(ctx, r.entityCtrl)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := r.checksCtrl.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := search(p.Args.Query, events, entities, checks)
+	limit := clampInt(p.Args.Limit, 0, len(results))
+	return results[0:limit], nil
+}
+
+// Services implements response to request for 'services' field.
+func (r *envImpl) Services(p graphql.ResolveParams) (interface{}, error) {
+	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	records, err := r.serviceQuerier.Query(ctx)
+	if err != nil {
+		return []*types.Service{}, err
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+	return records, nil
+}
+
 // Subscriptions implements response to request for 'subscriptions' field.
 func (r *envImpl) Subscriptions(p schema.EnvironmentSubscriptionsFieldResolverParams) (interface{}, error) {
 	set := string_utils.OccurrenceSet{}
 	env := p.Source.(*types.Environment)
 	ctx := types.SetContextFromResource(p.Context, env)
 
-	entities, err := r.entityCtrl.Query(ctx)
+	entities, err := loadEntities(ctx, r.entityCtrl)
 	if err != nil {
 		return set, err
 	}
@@ -1,10 +1,13 @@
 package graphql
 
 import (
+	"context"
 	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -70,3 +73,121 @@ func TestEnvironmentTypeSilencesField(t *testing.T) {
 	assert.Empty(t, res)
 	assert.Error(t, err)
 }
+
+func TestEnvironmentTypeHandlersField(t *testing.T) {
+	mock := mockHandlerQuerier{els: []*types.Handler{
+		types.FixtureHandler("b"),
+		types.FixtureHandler("c"),
+		types.FixtureHandler("a"),
+	}}
+	impl := &envImpl{handlerQuerier: mock}
+
+	// Params
+	params := schema.EnvironmentHandlersFieldResolverParams{}
+	params.Context = context.Background()
+	params.Source = types.FixtureEnvironment("xxx")
+	params.Args.Limit = 10
+
+	// Success
+	res, err := impl.Handlers(params)
+	require.NoError(t, err)
+	container := res.(offsetContainer)
+	handlers := container.Nodes.([]*types.Handler)
+	require.Len(t, handlers, 3)
+	assert.Equal(t, "a", handlers[0].Name)
+
+	// Descending
+	params.Args.OrderBy = schema.HandlerListOrders.NAME_DESC
+	res, err = impl.Handlers(params)
+	require.NoError(t, err)
+	handlers = res.(offsetContainer).Nodes.([]*types.Handler)
+	assert.Equal(t, "c", handlers[0].Name)
+
+	// Store err
+	impl.handlerQuerier = mockHandlerQuerier{err: errors.New("test")}
+	_, err = impl.Handlers(params)
+	assert.Error(t, err)
+}
+
+// pagedHandlerQuerier selects the page of handlers described by the selection
+// predicate of the context, its continue token being the position of the page.
+type pagedHandlerQuerier struct {
+	els   []*types.Handler
+	pages *int
+}
+
+func (m pagedHandlerQuerier) Query(ctx context.Context) ([]*types.Handler, error) {
+	pred := store.SelectionPredicateFromContext(ctx)
+	if pred == nil {
+		return m.els, nil
+	}
+	*m.pages++
+	low, _ := strconv.Atoi(pred.Continue)
+	high := clampInt(low+int(pred.Limit), low, len(m.els))
+	pred.Continue = ""
+	if high < len(m.els) {
+		pred.Continue = strconv.Itoa(high)
+	}
+	return m.els[low:high], nil
+}
+
+func TestEnvironmentTypeHandlersFieldStorePages(t *testing.T) {
+	var pages int
+	querier := pagedHandlerQuerier{els: fixtureHandlers(5), pages: &pages}
+	impl := &envImpl{handlerQuerier: querier}
+
+	params := schema.EnvironmentHandlersFieldResolverParams{}
+	params.Context = context.Background()
+	params.Source = types.FixtureEnvironment("xxx")
+	params.Args.First = 2
+
+	var names []string
+	for i := 0; i < 3; i++ {
+		res, err := impl.Handlers(params)
+		require.NoError(t, err)
+		container := res.(offsetContainer)
+		for _, handler := range container.Nodes.([]*types.Handler) {
+			names = append(names, handler.Name)
+		}
+		params.Args.After = container.PageInfo.endCursor
+		assert.Equal(t, i < 2, container.PageInfo.hasNext)
+	}
+	assert.Equal(t, []string{"handler0", "handler1", "handler2", "handler3", "handler4"}, names)
+	assert.Equal(t, 3, pages)
+
+	// Listed in another order, the cursor of the last page locates the handler
+	// within the whole list
+	params.Args.OrderBy = schema.HandlerListOrders.NAME_DESC
+	params.Args.After = encodeCursor(pageCursor{Key: nodeKey(querier.els[3]), Offset: 3})
+	res, err := impl.Handlers(params)
+	require.NoError(t, err)
+	handlers := res.(offsetContainer).Nodes.([]*types.Handler)
+	require.Len(t, handlers, 2)
+	assert.Equal(t, "handler2", handlers[0].Name)
+	assert.Equal(t, 3, pages)
+}
+
+func TestEnvironmentTypeAuditEntriesField(t *testing.T) {
+	older := types.FixtureAuditEntry("a")
+	newer := types.FixtureAuditEntry("b")
+	newer.Timestamp = older.Timestamp + 60
+	impl := &envImpl{auditQuerier: mockAuditQuerier{els: []*types.AuditEntry{older, newer}}}
+
+	// Params
+	params := schema.EnvironmentAuditEntriesFieldResolverParams{}
+	params.Context = context.Background()
+	params.Source = types.FixtureEnvironment("xxx")
+	params.Args.Limit = 10
+
+	// Success
+	res, err := impl.AuditEntries(params)
+	require.NoError(t, err)
+	entries := res.(offsetContainer).Nodes.([]*types.AuditEntry)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "b", entries[0].ID)
+
+	// Store err
+	impl.auditQuerier = mockAuditQuerier{err: errors.New("test")}
+	_, err = impl.AuditEntries(params)
+	assert.Error(t, err)
+}
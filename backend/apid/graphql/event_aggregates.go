@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+type eventAggregates struct {
+	Total         int
+	ByStatus      []eventCount
+	ByCheck       []eventCount
+	ByEntityClass []eventCount
+}
+
+type eventCount struct {
+	Key   string
+	Count int
+}
+
+// aggregateEvents counts the given events grouped by the status and name of
+// their check and the class of their entity. Groups are sorted by descending
+// count, then by key.
+func aggregateEvents(events []*types.Event) eventAggregates {
+	byStatus := map[string]int{}
+	byCheck := map[string]int{}
+	byEntityClass := map[string]int{}
+	for _, event := range events {
+		if event.HasCheck() {
+			byStatus[strconv.Itoa(int(event.Check.Status))]++
+			byCheck[event.Check.Name]++
+		}
+		if event.Entity != nil {
+			byEntityClass[event.Entity.Class]++
+		}
+	}
+
+	return eventAggregates{
+		Total:         len(events),
+		ByStatus:      sortedEventCounts(byStatus),
+		ByCheck:       sortedEventCounts(byCheck),
+		ByEntityClass: sortedEventCounts(byEntityClass),
+	}
+}
+
+func sortedEventCounts(counts map[string]int) []eventCount {
+	out := make([]eventCount, 0, len(counts))
+	for key, count := range counts {
+		out = append(out, eventCount{Key: key, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateEvents(t *testing.T) {
+	newEvent := func(entity, check string, status uint32, class string) *types.Event {
+		event := types.FixtureEvent(entity, check)
+		event.Check.Status = status
+		event.Entity.Class = class
+		return event
+	}
+	events := []*types.Event{
+		newEvent("a", "check-cpu", 2, types.EntityAgentClass),
+		newEvent("b", "check-cpu", 0, types.EntityAgentClass),
+		newEvent("c", "check-disk", 2, "proxy"),
+		{Entity: types.FixtureEntity("d")},
+	}
+
+	got := aggregateEvents(events)
+	assert.Equal(t, 4, got.Total)
+	assert.Equal(t, []eventCount{{"2", 2}, {"0", 1}}, got.ByStatus)
+	assert.Equal(t, []eventCount{{"check-cpu", 2}, {"check-disk", 1}}, got.ByCheck)
+	assert.Equal(t, []eventCount{{"agent", 3}, {"proxy", 1}}, got.ByEntityClass)
+}
+
+func TestEnvironmentTypeEventAggregatesField(t *testing.T) {
+	failing := types.FixtureEvent("a", "check-cpu")
+	failing.Check.Status = 2
+	passing := types.FixtureEvent("b", "check-cpu")
+	impl := &envImpl{eventQuerier: mockEventQuerier{els: []*types.Event{failing, passing}}}
+
+	params := schema.EnvironmentEventAggregatesFieldResolverParams{}
+	params.Context = context.Background()
+	params.Source = types.FixtureEnvironment("xxx")
+
+	// All events
+	res, err := impl.EventAggregates(params)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.(eventAggregates).Total)
+
+	// Filtered
+	params.Args.Filter = "status:incident"
+	res, err = impl.EventAggregates(params)
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.(eventAggregates).Total)
+	assert.Equal(t, []eventCount{{"2", 1}}, res.(eventAggregates).ByStatus)
+
+	// Store err
+	impl.eventQuerier = mockEventQuerier{err: errors.New("test")}
+	_, err = impl.EventAggregates(params)
+	assert.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.EventFilterFieldResolvers = (*eventFilterImpl)(nil)
+
+//
+// Implement EventFilterFieldResolvers
+//
+
+type eventFilterImpl struct {
+	schema.EventFilterAliases
+}
+
+// ID implements response to request for 'id' field.
+func (*eventFilterImpl) ID(p graphql.ResolveParams) (string, error) {
+	return globalid.FilterTranslator.EncodeToString(p.Source), nil
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*eventFilterImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// Type implements response to request for 'type' field.
+func (*eventFilterImpl) Type(p graphql.ResolveParams) (string, error) {
+	filter := p.Source.(*types.EventFilter)
+	if filter.Type == "" {
+		return types.EventFilterTypeGovaluate, nil
+	}
+	return filter.Type, nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*eventFilterImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.EventFilter)
+	return ok
+}
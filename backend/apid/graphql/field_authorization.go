@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+// fieldRule returns true if the viewer may access the resolved value of a
+// field.
+type fieldRule func(p graphql.ResolveParams, value interface{}) bool
+
+// fieldRules are the rules restricting access to fields, keyed by type and
+// field name. Fields the viewer does not have access to resolve to null, with
+// an error, while the rest of the query is still resolved.
+var fieldRules = map[string]fieldRule{
+	// Only viewers able to update checks can see their command.
+	"CheckConfig.command": func(p graphql.ResolveParams, _ interface{}) bool {
+		check, ok := p.Source.(*types.CheckConfig)
+		if !ok {
+			return false
+		}
+		return canViewCommand(p.Context, check)
+	},
+	"Check.command": func(p graphql.ResolveParams, _ interface{}) bool {
+		check, ok := p.Source.(*types.Check)
+		if !ok {
+			return false
+		}
+		policy := authorization.Checks.WithContext(p.Context)
+		return policy.CanUpdate(&types.CheckConfig{
+			Organization: check.Organization,
+			Environment:  check.Environment,
+		})
+	},
+	// Only viewers able to read users can see who created a silence.
+	"Silenced.creator": func(p graphql.ResolveParams, value interface{}) bool {
+		user, ok := value.(*types.User)
+		if !ok {
+			return value == nil
+		}
+		policy := authorization.Users.WithContext(p.Context)
+		return policy.CanRead(user)
+	},
+}
+
+// canViewCommand returns true if the viewer may see the command of the given
+// check, i.e. is able to update it. Fields exposing the check as a whole, like
+// its JSON representation, must redact the command otherwise.
+func canViewCommand(ctx context.Context, check *types.CheckConfig) bool {
+	policy := authorization.Checks.WithContext(ctx)
+	return policy.CanUpdate(check)
+}
+
+// fieldAccessError is returned when the viewer does not have access to a
+// field.
+type fieldAccessError struct {
+	typeName  string
+	fieldName string
+}
+
+func (e fieldAccessError) Error() string {
+	return fmt.Sprintf("unauthorized to access field '%s.%s'", e.typeName, e.fieldName)
+}
+
+// authorizeField is a middleware enforcing the field rules.
+func authorizeField(typeName, fieldName string, next graphql.FieldResolveFn) graphql.FieldResolveFn {
+	rule, ok := fieldRules[typeName+"."+fieldName]
+	if !ok {
+		return next
+	}
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		value, err := next(p)
+		if err != nil {
+			return value, err
+		}
+		if !rule(p, value) {
+			return nil, fieldAccessError{typeName: typeName, fieldName: fieldName}
+		}
+		return value, nil
+	}
+}
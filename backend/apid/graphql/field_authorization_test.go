@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizeField(t *testing.T) {
+	check := types.FixtureCheckConfig("check-cpu")
+	resolve := func(p graphql.ResolveParams) (interface{}, error) {
+		return p.Source.(*types.CheckConfig).Command, nil
+	}
+
+	testCases := []struct {
+		name    string
+		ctx     testutil.SetContextFn
+		wantErr bool
+	}{
+		{
+			name: "full access",
+			ctx:  testutil.ContextWithFullAccess,
+		},
+		{
+			name:    "read only",
+			ctx:     testutil.ContextWithPerms(types.RuleTypeCheck, types.RulePermRead),
+			wantErr: true,
+		},
+		{
+			name: "update",
+			ctx:  testutil.ContextWithPerms(types.RuleTypeCheck, types.RulePermUpdate),
+		},
+	}
+
+	fn := authorizeField("CheckConfig", "command", resolve)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := graphql.ResolveParams{Source: check, Context: testutil.NewContext(tc.ctx)}
+			res, err := fn(params)
+			if tc.wantErr {
+				assert.Equal(t, fieldAccessError{"CheckConfig", "command"}, err)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, check.Command, res)
+			}
+		})
+	}
+}
+
+func TestAuthorizeFieldWithoutRule(t *testing.T) {
+	called := false
+	resolve := func(p graphql.ResolveParams) (interface{}, error) {
+		called = true
+		return "name", nil
+	}
+
+	fn := authorizeField("CheckConfig", "name", resolve)
+	params := graphql.ResolveParams{Context: testutil.NewContext(testutil.ContextWithNoAccess)}
+	res, err := fn(params)
+	require.NoError(t, err)
+	assert.Equal(t, "name", res)
+	assert.True(t, called)
+}
+
+func TestAuthorizeFieldResolverError(t *testing.T) {
+	resolve := func(p graphql.ResolveParams) (interface{}, error) {
+		return nil, errors.New("not found")
+	}
+
+	fn := authorizeField("Silenced", "creator", resolve)
+	params := graphql.ResolveParams{Context: testutil.NewContext(testutil.ContextWithFullAccess)}
+	_, err := fn(params)
+	assert.EqualError(t, err, "not found")
+}
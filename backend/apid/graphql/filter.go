@@ -0,0 +1,129 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// eventPredicate returns true if the given event matches a filter.
+type eventPredicate func(*types.Event) bool
+
+// eventFilters are the statements, in the form 'key:value', that can be used
+// to filter events.
+var eventFilters = map[string]func(value string) (eventPredicate, error){
+	// status:2 or status:incident
+	"status": func(value string) (eventPredicate, error) {
+		if value == "incident" {
+			return func(e *types.Event) bool { return e.IsIncident() }, nil
+		}
+		status, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid status %q", value)
+		}
+		return func(e *types.Event) bool {
+			return e.HasCheck() && e.Check.Status == uint32(status)
+		}, nil
+	},
+	// silenced:true
+	"silenced": func(value string) (eventPredicate, error) {
+		silenced, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid silenced value %q", value)
+		}
+		return func(e *types.Event) bool { return e.IsSilenced() == silenced }, nil
+	},
+	// check:check-cpu
+	"check": func(value string) (eventPredicate, error) {
+		return func(e *types.Event) bool {
+			return e.HasCheck() && e.Check.Name == value
+		}, nil
+	},
+	// subscription:linux
+	"subscription": func(value string) (eventPredicate, error) {
+		return func(e *types.Event) bool {
+			if e.Entity == nil {
+				return false
+			}
+			for _, subscription := range e.Entity.Subscriptions {
+				if subscription == value {
+					return true
+				}
+			}
+			return false
+		}, nil
+	},
+	// label:region=us-west-1 or label:region!=us-west-1, where labels are the
+	// extended attributes of the entity.
+	"label": func(value string) (eventPredicate, error) {
+		negate := false
+		i := strings.Index(value, "!=")
+		if i >= 0 {
+			negate = true
+		} else {
+			i = strings.Index(value, "=")
+		}
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid label selector %q", value)
+		}
+		key := value[:i]
+		want := strings.TrimPrefix(value[i:], "!")[1:]
+		return func(e *types.Event) bool {
+			if e.Entity == nil {
+				return negate
+			}
+			label, err := e.Entity.Get(key)
+			matched := err == nil && label != nil && fmt.Sprint(label) == want
+			return matched != negate
+		}, nil
+	},
+}
+
+// isEventFilter returns true if the given filter only contains event filter
+// statements, rather than a Sensu Query Expression.
+func isEventFilter(filter string) bool {
+	statements := strings.Fields(filter)
+	for _, statement := range statements {
+		i := strings.Index(statement, ":")
+		if i < 0 {
+			return false
+		}
+		if _, ok := eventFilters[statement[:i]]; !ok {
+			return false
+		}
+	}
+	return len(statements) > 0
+}
+
+// newEventPredicate returns a predicate matching the events matching all the
+// whitespace separated statements of the given filter, e.g.
+// "status:incident silenced:false subscription:linux".
+func newEventPredicate(filter string) (eventPredicate, error) {
+	var predicates []eventPredicate
+	for _, statement := range strings.Fields(filter) {
+		i := strings.Index(statement, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid filter statement %q", statement)
+		}
+		newPredicate, ok := eventFilters[statement[:i]]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter %q", statement[:i])
+		}
+		predicate, err := newPredicate(statement[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	return func(e *types.Event) bool {
+		for _, predicate := range predicates {
+			if !predicate(e) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
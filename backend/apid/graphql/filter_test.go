@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEventFilter(t *testing.T) {
+	assert.True(t, isEventFilter("status:incident"))
+	assert.True(t, isEventFilter(" check:check-cpu  label:region=us-west-1 "))
+	assert.False(t, isEventFilter(""))
+	assert.False(t, isEventFilter("Check.Status == 2"))
+	assert.False(t, isEventFilter("status:2 Check.Status == 2"))
+	assert.False(t, isEventFilter("unknown:value"))
+}
+
+func TestNewEventPredicate(t *testing.T) {
+	event := types.FixtureEvent("webserver01", "check-cpu")
+	event.Check.Status = 2
+	event.Check.Silenced = []string{"linux:check-cpu"}
+	event.Entity.SetExtendedAttributes([]byte(`{"region":"us-west-1"}`))
+
+	testCases := []struct {
+		filter string
+		want   bool
+	}{
+		{"status:2", true},
+		{"status:0", false},
+		{"status:incident", true},
+		{"silenced:true", true},
+		{"silenced:false", false},
+		{"check:check-cpu", true},
+		{"check:check-mem", false},
+		{"subscription:linux", true},
+		{"subscription:windows", false},
+		{"label:region=us-west-1", true},
+		{"label:region=us-east-1", false},
+		{"label:region!=us-east-1", true},
+		{"label:zone=a", false},
+		{"label:zone!=a", true},
+		{"status:incident silenced:true check:check-cpu", true},
+		{"status:incident check:check-mem", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			predicate, err := newEventPredicate(tc.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, predicate(event))
+		})
+	}
+
+	for _, filter := range []string{"status:high", "silenced:maybe", "label:region", "label:=a", "unknown:a"} {
+		_, err := newEventPredicate(filter)
+		assert.Error(t, err, filter)
+	}
+}
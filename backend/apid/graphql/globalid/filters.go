@@ -0,0 +1,23 @@
+package globalid
+
+import "github.com/sensu/sensu-go/types"
+
+//
+// Event Filters
+//
+
+var filterName = "filters"
+
+// FilterTranslator global ID resource
+var FilterTranslator = commonTranslator{
+	name:       filterName,
+	encodeFunc: standardEncoder(filterName, "Name"),
+	decodeFunc: standardDecoder,
+	isResponsibleFunc: func(record interface{}) bool {
+		_, ok := record.(*types.EventFilter)
+		return ok
+	},
+}
+
+// Register event filter encoder/decoder
+func init() { registerTranslator(FilterTranslator) }
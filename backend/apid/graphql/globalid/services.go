@@ -0,0 +1,43 @@
+package globalid
+
+import "github.com/sensu/sensu-go/types"
+
+//
+// Services
+//
+
+var serviceName = "services"
+
+// ServiceTranslator global ID resource
+var ServiceTranslator = commonTranslator{
+	name:       serviceName,
+	encodeFunc: standardEncoder(serviceName, "Name"),
+	decodeFunc: standardDecoder,
+	isResponsibleFunc: func(record interface{}) bool {
+		_, ok := record.(*types.Service)
+		return ok
+	},
+}
+
+//
+// Service Components
+//
+
+var serviceComponentName = "service-components"
+
+// ServiceComponentTranslator global ID resource
+var ServiceComponentTranslator = commonTranslator{
+	name:       serviceComponentName,
+	encodeFunc: standardEncoder(serviceComponentName, "Name"),
+	decodeFunc: standardDecoder,
+	isResponsibleFunc: func(record interface{}) bool {
+		_, ok := record.(*types.ServiceComponent)
+		return ok
+	},
+}
+
+// Register service and service component encoders/decoders
+func init() {
+	registerTranslator(ServiceTranslator)
+	registerTranslator(ServiceComponentTranslator)
+}
@@ -11,6 +11,9 @@ import (
 
 var _ schema.HandlerFieldResolvers = (*handlerImpl)(nil)
 var _ schema.HandlerSocketFieldResolvers = (*handlerSocketImpl)(nil)
+var _ schema.HandlerSlackFieldResolvers = (*handlerSlackImpl)(nil)
+var _ schema.HandlerPagerDutyFieldResolvers = (*handlerPagerDutyImpl)(nil)
+var _ schema.HandlerEmailFieldResolvers = (*handlerEmailImpl)(nil)
 
 //
 // Implement HandlerFieldResolvers
@@ -82,3 +85,51 @@ func (*handlerSocketImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool
 	_, ok := s.(*types.HandlerSocket)
 	return ok
 }
+
+//
+// Implement HandlerSlackFieldResolvers
+//
+
+type handlerSlackImpl struct {
+	schema.HandlerSlackAliases
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*handlerSlackImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.HandlerSlack)
+	return ok
+}
+
+//
+// Implement HandlerPagerDutyFieldResolvers
+//
+
+type handlerPagerDutyImpl struct {
+	schema.HandlerPagerDutyAliases
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*handlerPagerDutyImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.HandlerPagerDuty)
+	return ok
+}
+
+//
+// Implement HandlerEmailFieldResolvers
+//
+
+type handlerEmailImpl struct {
+	schema.HandlerEmailAliases
+}
+
+// Port implements response to request for 'port' field.
+func (*handlerEmailImpl) Port(p graphql.ResolveParams) (int, error) {
+	email := p.Source.(*types.HandlerEmail)
+	return int(email.Port), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*handlerEmailImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.HandlerEmail)
+	return ok
+}
@@ -0,0 +1,232 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/types"
+)
+
+// loadersKey is the key of the loaders in the context of a request.
+type loadersKey struct{}
+
+// entityEventsThreshold is the number of entities of a namespace whose events
+// are fetched one entity at a time; past it, the events of the whole namespace
+// are fetched at once instead.
+const entityEventsThreshold = 25
+
+// loaders batch and cache the store lookups of the resolvers for the lifetime
+// of an operation. Rather than fetching the events, silences or handlers of a
+// namespace once per record of a list, they are fetched once per namespace and
+// shared by the resolvers of every record.
+type loaders struct {
+	mu      sync.Mutex
+	results map[string]*loaderResult
+
+	// requested are the IDs of the records requested one at a time, by
+	// global ID of their collection
+	requested map[string]map[string]bool
+}
+
+type loaderResult struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// ContextWithLoaders returns a copy of the context holding new, empty loaders;
+// it is expected to be called before executing each operation, so that results
+// are never shared across operations.
+func ContextWithLoaders(ctx context.Context) context.Context {
+	l := &loaders{
+		results:   map[string]*loaderResult{},
+		requested: map[string]map[string]bool{},
+	}
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+// batched records a request of the record of the given ID, in the collection
+// of the given global ID, and returns whether the whole collection should be
+// loaded instead: either it is already, or more than the given number of its
+// records were requested. Without loaders in the context, it never should.
+func batched(ctx context.Context, collection, id string, threshold int) bool {
+	l, ok := ctx.Value(loadersKey{}).(*loaders)
+	if !ok {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.results[collection]; ok {
+		return true
+	}
+	requested := l.requested[collection]
+	if requested == nil {
+		requested = map[string]bool{}
+		l.requested[collection] = requested
+	}
+	requested[id] = true
+	return len(requested) > threshold
+}
+
+// load returns the result of fetch for the given global ID, fetch only being
+// called the first time the ID is loaded. Without loaders in the context, fetch
+// is called every time.
+func load(ctx context.Context, id string, fetch func() (interface{}, error)) (interface{}, error) {
+	l, ok := ctx.Value(loadersKey{}).(*loaders)
+	if !ok {
+		return fetch()
+	}
+
+	l.mu.Lock()
+	res, ok := l.results[id]
+	if !ok {
+		res = &loaderResult{}
+		l.results[id] = res
+	}
+	l.mu.Unlock()
+
+	res.once.Do(func() {
+		res.value, res.err = fetch()
+	})
+	return res.value, res.err
+}
+
+// namespaceID returns the global ID of the collection of the given resource in
+// the namespace of the context, e.g. srn:events:default:default
+func namespaceID(ctx context.Context, resource string) string {
+	return "srn:" + resource + ":" +
+		types.ContextOrganization(ctx) + ":" +
+		types.ContextEnvironment(ctx)
+}
+
+// The records returned by the loaders are deep copies: resolvers are free to
+// sort or filter the slices in place, and to modify the records, without
+// altering the results cached for the other resolvers.
+
+// loadEvents returns the events of the namespace of the context.
+func loadEvents(ctx context.Context, querier eventQuerier) ([]*types.Event, error) {
+	records, err := cachedEvents(ctx, querier)
+	events := make([]*types.Event, 0, len(records))
+	for _, event := range records {
+		events = append(events, event.DeepCopy())
+	}
+	return events, err
+}
+
+// loadEntityEvents returns the events of the given entity. They are fetched
+// for the entity alone, unless the events of many entities of its namespace are
+// requested, in which case they are batched with the events of the namespace.
+func loadEntityEvents(ctx context.Context, querier eventQuerier, entity *types.Entity) ([]*types.Event, error) {
+	var records []*types.Event
+	var err error
+	if batched(ctx, namespaceID(ctx, "events"), entity.ID, entityEventsThreshold) {
+		records, err = cachedEvents(ctx, querier)
+	} else {
+		var value interface{}
+		value, err = load(ctx, namespaceID(ctx, "events")+":"+entity.ID, func() (interface{}, error) {
+			return querier.Query(ctx, entity.ID, "")
+		})
+		records, _ = value.([]*types.Event)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events := []*types.Event{}
+	for _, event := range records {
+		if event.Entity != nil && event.Entity.ID == entity.ID {
+			events = append(events, event.DeepCopy())
+		}
+	}
+	return events, nil
+}
+
+// cachedEvents returns the events of the namespace of the context, as cached
+// by the loaders.
+func cachedEvents(ctx context.Context, querier eventQuerier) ([]*types.Event, error) {
+	value, err := load(ctx, namespaceID(ctx, "events"), func() (interface{}, error) {
+		return querier.Query(ctx, "", "")
+	})
+	records, _ := value.([]*types.Event)
+	return records, err
+}
+
+// loadEntities returns the entities of the namespace of the context.
+func loadEntities(ctx context.Context, querier entityQuerier) ([]*types.Entity, error) {
+	value, err := load(ctx, namespaceID(ctx, "entities"), func() (interface{}, error) {
+		return querier.Query(ctx)
+	})
+	records, _ := value.([]*types.Entity)
+	entities := make([]*types.Entity, 0, len(records))
+	for _, entity := range records {
+		entities = append(entities, entity.DeepCopy())
+	}
+	return entities, err
+}
+
+// loadSilences returns the silenced entries of the namespace of the context.
+func loadSilences(ctx context.Context, querier silenceQuerier) ([]*types.Silenced, error) {
+	value, err := load(ctx, namespaceID(ctx, "silences"), func() (interface{}, error) {
+		return querier.Query(ctx, "", "")
+	})
+	records, _ := value.([]*types.Silenced)
+	silences := make([]*types.Silenced, 0, len(records))
+	for _, silenced := range records {
+		silences = append(silences, silenced.DeepCopy())
+	}
+	return silences, err
+}
+
+// loadHandlers returns the handlers of the namespace of the context.
+func loadHandlers(ctx context.Context, querier handlerQuerier) ([]*types.Handler, error) {
+	value, err := load(ctx, namespaceID(ctx, "handlers"), func() (interface{}, error) {
+		return querier.Query(ctx)
+	})
+	records, _ := value.([]*types.Handler)
+	handlers := make([]*types.Handler, 0, len(records))
+	for _, handler := range records {
+		handlers = append(handlers, handler.DeepCopy())
+	}
+	return handlers, err
+}
+
+// loadServiceComponents returns the service components of the namespace of the
+// context.
+func loadServiceComponents(ctx context.Context, querier serviceComponentQuerier) ([]*types.ServiceComponent, error) {
+	value, err := load(ctx, namespaceID(ctx, "service-components"), func() (interface{}, error) {
+		return querier.Query(ctx)
+	})
+	records, _ := value.([]*types.ServiceComponent)
+	components := make([]*types.ServiceComponent, 0, len(records))
+	for _, component := range records {
+		components = append(components, component.DeepCopy())
+	}
+	return components, err
+}
+
+// loadEnvironment returns the environment with the given name.
+func loadEnvironment(ctx context.Context, finder environmentFinder, org, env string) (*types.Environment, error) {
+	id := globalid.EnvironmentTranslator.EncodeToString(&types.Environment{
+		Organization: org,
+		Name:         env,
+	})
+	value, err := load(ctx, id, func() (interface{}, error) {
+		return finder.Find(ctx, org, env)
+	})
+	record, _ := value.(*types.Environment)
+	return record.DeepCopy(), err
+}
+
+// loadOrganization returns the organization with the given name.
+func loadOrganization(ctx context.Context, finder organizationFinder, org string) (*types.Organization, error) {
+	id := globalid.OrganizationTranslator.EncodeToString(&types.Organization{
+		Name: org,
+	})
+	value, err := load(ctx, id, func() (interface{}, error) {
+		return finder.Find(ctx, org)
+	})
+	record, _ := value.(*types.Organization)
+	return record.DeepCopy(), err
+}
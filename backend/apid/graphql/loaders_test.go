@@ -0,0 +1,143 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingEventQuerier struct {
+	mockEventQuerier
+	calls    int
+	entities []string
+}
+
+func (q *countingEventQuerier) Query(ctx context.Context, entity, check string) ([]*types.Event, error) {
+	q.calls++
+	q.entities = append(q.entities, entity)
+	events, err := q.mockEventQuerier.Query(ctx, entity, check)
+	if entity == "" {
+		return events, err
+	}
+	var entityEvents []*types.Event
+	for _, event := range events {
+		if event.Entity.ID == entity {
+			entityEvents = append(entityEvents, event)
+		}
+	}
+	return entityEvents, err
+}
+
+func TestLoadEntityEvents(t *testing.T) {
+	querier := &countingEventQuerier{mockEventQuerier: mockEventQuerier{els: []*types.Event{
+		types.FixtureEvent("a", "check-cpu"),
+		types.FixtureEvent("b", "check-cpu"),
+		types.FixtureEvent("a", "check-mem"),
+		types.FixtureEvent("c", "check-cpu"),
+	}}}
+
+	ctx := types.SetContextFromResource(context.Background(), types.FixtureEntity("a"))
+	ctx = ContextWithLoaders(ctx)
+
+	evs, err := loadEntityEvents(ctx, querier, types.FixtureEntity("a"))
+	require.NoError(t, err)
+	assert.Len(t, evs, 2)
+
+	evs, err = loadEntityEvents(ctx, querier, types.FixtureEntity("b"))
+	require.NoError(t, err)
+	assert.Len(t, evs, 1)
+
+	evs, err = loadEntityEvents(ctx, querier, types.FixtureEntity("a"))
+	require.NoError(t, err)
+	assert.Len(t, evs, 2)
+
+	// The events of a few entities are fetched once per entity
+	assert.Equal(t, []string{"a", "b"}, querier.entities)
+
+	// The cached events are not altered by the filtering
+	evs, err = loadEvents(ctx, querier)
+	require.NoError(t, err)
+	assert.Len(t, evs, 4)
+	assert.Equal(t, []string{"a", "b", ""}, querier.entities)
+
+	// Once the events of the namespace are loaded, they are used instead
+	evs, err = loadEntityEvents(ctx, querier, types.FixtureEntity("c"))
+	require.NoError(t, err)
+	assert.Len(t, evs, 1)
+	assert.Equal(t, 3, querier.calls)
+}
+
+func TestLoadManyEntityEvents(t *testing.T) {
+	var events []*types.Event
+	for i := 0; i <= entityEventsThreshold+1; i++ {
+		events = append(events, types.FixtureEvent(fmt.Sprintf("entity%d", i), "check-cpu"))
+	}
+	querier := &countingEventQuerier{mockEventQuerier: mockEventQuerier{els: events}}
+
+	ctx := types.SetContextFromResource(context.Background(), types.FixtureEntity("a"))
+	ctx = ContextWithLoaders(ctx)
+
+	// The events of the namespace are fetched at once past the threshold
+	for _, event := range events {
+		evs, err := loadEntityEvents(ctx, querier, event.Entity)
+		require.NoError(t, err)
+		require.Len(t, evs, 1)
+		assert.Equal(t, event.Entity.ID, evs[0].Entity.ID)
+	}
+	assert.Equal(t, entityEventsThreshold+1, querier.calls)
+	assert.Equal(t, "", querier.entities[entityEventsThreshold])
+}
+
+func TestLoadNamespaces(t *testing.T) {
+	querier := &countingEventQuerier{}
+
+	ctx := ContextWithLoaders(context.Background())
+	defaultCtx := types.SetContextFromResource(ctx, types.FixtureEntity("a"))
+	acmeCtx := context.WithValue(defaultCtx, types.OrganizationKey, "acme")
+
+	_, err := loadEvents(defaultCtx, querier)
+	require.NoError(t, err)
+	_, err = loadEvents(acmeCtx, querier)
+	require.NoError(t, err)
+	_, err = loadEvents(acmeCtx, querier)
+	require.NoError(t, err)
+	assert.Equal(t, 2, querier.calls)
+}
+
+func TestLoadWithoutLoaders(t *testing.T) {
+	querier := &countingEventQuerier{}
+	ctx := context.Background()
+
+	_, err := loadEvents(ctx, querier)
+	require.NoError(t, err)
+	_, err = loadEvents(ctx, querier)
+	require.NoError(t, err)
+	assert.Equal(t, 2, querier.calls)
+}
+
+func TestLoadDeepCopies(t *testing.T) {
+	querier := &countingEventQuerier{mockEventQuerier: mockEventQuerier{els: []*types.Event{
+		types.FixtureEvent("a", "check-cpu"),
+	}}}
+
+	ctx := types.SetContextFromResource(context.Background(), types.FixtureEntity("a"))
+	ctx = ContextWithLoaders(ctx)
+
+	evs, err := loadEvents(ctx, querier)
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	evs[0].Check.Output = "modified"
+	evs[0].Entity.Subscriptions[0] = "modified"
+
+	// The cached events are not altered by the resolvers
+	evs, err = loadEntityEvents(ctx, querier, types.FixtureEntity("a"))
+	require.NoError(t, err)
+	require.Len(t, evs, 1)
+	assert.NotEqual(t, "modified", evs[0].Check.Output)
+	assert.NotEqual(t, "modified", evs[0].Entity.Subscriptions[0])
+	assert.Equal(t, 1, querier.calls)
+}
@@ -0,0 +1,112 @@
+package graphql
+
+import (
+	"context"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+// mutationTypeName is the name of the root type of mutations.
+const mutationTypeName = "Mutation"
+
+// auditMutations returns a middleware recording the mutations executed in the
+// audit log, since the POST requests of the GraphQL endpoint are not: one entry
+// is recorded per mutation and namespace of the resources it applies to. A zero
+// retention disables it.
+func auditMutations(s store.AuditStore, retention time.Duration) graphql.FieldMiddleware {
+	ttl := int64(retention / time.Second)
+	return func(typeName, fieldName string, next graphql.FieldResolveFn) graphql.FieldResolveFn {
+		if typeName != mutationTypeName || ttl <= 0 {
+			return next
+		}
+		return func(p graphql.ResolveParams) (interface{}, error) {
+			timestamp := time.Now().Unix()
+			value, err := next(p)
+
+			outcome := types.AuditOutcomeSuccess
+			if err != nil {
+				outcome = types.AuditOutcomeFailure
+			}
+			var user string
+			if claims := jwt.GetClaimsFromContext(p.Context); claims != nil {
+				user = claims.Subject
+			}
+
+			input, _ := p.Args["input"].(map[string]interface{})
+			for _, ns := range mutationNamespaces(input) {
+				entry := &types.AuditEntry{
+					ID:           uuid.New().String(),
+					User:         user,
+					Verb:         mutationVerb(fieldName),
+					Resource:     "/graphql/" + fieldName,
+					Organization: ns.Org,
+					Environment:  ns.Env,
+					Outcome:      outcome,
+					RequestID:    types.ContextRequestID(p.Context),
+					Timestamp:    timestamp,
+				}
+				// The request may be cancelled once the response is written
+				if err := s.CreateAuditEntry(context.Background(), entry, ttl); err != nil {
+					logger.WithError(err).WithField("mutation", fieldName).Error("could not record audit entry")
+				}
+			}
+			return value, err
+		}
+	}
+}
+
+// mutationNamespaces returns the namespaces of the resources the given input of
+// a mutation applies to, given either as its namespace or as global IDs.
+func mutationNamespaces(input map[string]interface{}) []store.Namespace {
+	var namespaces []store.Namespace
+	add := func(ns store.Namespace) {
+		if ns.Org == "" || ns.Env == "" {
+			return
+		}
+		for _, n := range namespaces {
+			if n == ns {
+				return
+			}
+		}
+		namespaces = append(namespaces, ns)
+	}
+	addID := func(id interface{}) {
+		gid, _ := id.(string)
+		if components, err := globalid.Decode(gid); err == nil {
+			add(store.Namespace{Org: components.Organization(), Env: components.Environment()})
+		}
+	}
+
+	if ns, ok := input["ns"].(map[string]interface{}); ok {
+		org, _ := ns["organization"].(string)
+		env, _ := ns["environment"].(string)
+		add(store.Namespace{Org: org, Env: env})
+	}
+	if id, ok := input["id"]; ok {
+		addID(id)
+	}
+	if ids, ok := input["ids"].([]interface{}); ok {
+		for _, id := range ids {
+			addID(id)
+		}
+	}
+	return namespaces
+}
+
+// mutationVerb returns the action of a mutation, the first word of its name,
+// e.g. create for createCheck.
+func mutationVerb(fieldName string) string {
+	for i, r := range fieldName {
+		if unicode.IsUpper(r) {
+			return fieldName[:i]
+		}
+	}
+	return fieldName
+}
@@ -0,0 +1,96 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditMutations(t *testing.T) {
+	store := &mockstore.MockStore{}
+	var entries []*types.AuditEntry
+	store.On("CreateAuditEntry", mock.Anything, mock.Anything, int64(60)).Return(nil).
+		Run(func(args mock.Arguments) {
+			entries = append(entries, args.Get(1).(*types.AuditEntry))
+		})
+	middleware := auditMutations(store, time.Minute)
+
+	ctx := context.WithValue(context.Background(), types.ClaimsKey, &types.Claims{})
+	ctx.Value(types.ClaimsKey).(*types.Claims).Subject = "admin"
+	ctx = context.WithValue(ctx, types.RequestIDKey, "request1")
+
+	// Queries are not recorded
+	resolve := func(p graphql.ResolveParams) (interface{}, error) { return "ok", nil }
+	_, err := middleware("Query", "viewer", resolve)(graphql.ResolveParams{Context: ctx})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// Mutations are, in the namespace of their input
+	fn := middleware(mutationTypeName, "createCheck", resolve)
+	_, err = fn(graphql.ResolveParams{Context: ctx, Args: map[string]interface{}{
+		"input": map[string]interface{}{
+			"ns": map[string]interface{}{"organization": "acme", "environment": "dev"},
+		},
+	}})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	assert.NotEmpty(t, entry.ID)
+	assert.Equal(t, "admin", entry.User)
+	assert.Equal(t, "create", entry.Verb)
+	assert.Equal(t, "/graphql/createCheck", entry.Resource)
+	assert.Equal(t, "acme", entry.Organization)
+	assert.Equal(t, "dev", entry.Environment)
+	assert.Equal(t, types.AuditOutcomeSuccess, entry.Outcome)
+	assert.Equal(t, "request1", entry.RequestID)
+
+	// Along with their failure, once per namespace of the resources they
+	// apply to
+	entries = nil
+	event1 := types.FixtureEvent("entity1", "check1")
+	event2 := types.FixtureEvent("entity2", "check1")
+	event3 := types.FixtureEvent("entity3", "check1")
+	event3.Entity.Environment = "dev"
+	fn = middleware(mutationTypeName, "deleteEvents", func(graphql.ResolveParams) (interface{}, error) {
+		return nil, errors.New("error")
+	})
+	_, err = fn(graphql.ResolveParams{Context: ctx, Args: map[string]interface{}{
+		"input": map[string]interface{}{
+			"ids": []interface{}{
+				globalid.EventTranslator.EncodeToString(event1),
+				globalid.EventTranslator.EncodeToString(event2),
+				globalid.EventTranslator.EncodeToString(event3),
+			},
+		},
+	}})
+	assert.Error(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.Equal(t, "delete", entry.Verb)
+		assert.Equal(t, types.AuditOutcomeFailure, entry.Outcome)
+	}
+	assert.Equal(t, "default", entries[0].Environment)
+	assert.Equal(t, "dev", entries[1].Environment)
+}
+
+func TestAuditMutationsDisabled(t *testing.T) {
+	store := &mockstore.MockStore{}
+	resolve := func(p graphql.ResolveParams) (interface{}, error) { return "ok", nil }
+	fn := auditMutations(store, 0)(mutationTypeName, "createCheck", resolve)
+	_, err := fn(graphql.ResolveParams{Context: context.Background(), Args: map[string]interface{}{
+		"input": map[string]interface{}{
+			"ns": map[string]interface{}{"organization": "default", "environment": "default"},
+		},
+	}})
+	require.NoError(t, err)
+	store.AssertNotCalled(t, "CreateAuditEntry", mock.Anything, mock.Anything, mock.Anything)
+}
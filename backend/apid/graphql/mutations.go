@@ -1,6 +1,8 @@
 package graphql
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -30,12 +32,13 @@ type mutationsImpl struct {
 
 	silenceCreator   silenceCreator
 	silenceDestroyer silenceDestroyer
+	silenceQuerier   silenceQuerier
 }
 
 func newMutationImpl(store store.Store, getter types.QueueGetter, bus messaging.MessageBus) *mutationsImpl {
 	eventCtrl := actions.NewEventController(store, bus)
 	checkCtrl := actions.NewCheckController(store, getter)
-	entityCtrl := actions.NewEntityController(store)
+	entityCtrl := actions.NewEntityController(store, bus)
 	silenceCtrl := actions.NewSilencedController(store)
 
 	return &mutationsImpl{
@@ -50,6 +53,7 @@ func newMutationImpl(store store.Store, getter types.QueueGetter, bus messaging.
 
 		silenceCreator:   silenceCtrl,
 		silenceDestroyer: silenceCtrl,
+		silenceQuerier:   silenceCtrl,
 	}
 }
 
@@ -69,7 +73,9 @@ func (r *mutationsImpl) CreateCheck(p schema.MutationCreateCheckFieldResolverPar
 	check.Name = inputs.Name
 	check.Organization = inputs.Ns.Organization
 	check.Environment = inputs.Ns.Environment
-	copyCheckInputs(&check, inputs.Props)
+	if err := copyCheckInputs(&check, inputs.Props); err != nil {
+		return nil, err
+	}
 
 	err := r.checkCtrl.Create(p.Context, check)
 	if err != nil {
@@ -90,7 +96,9 @@ func (r *mutationsImpl) UpdateCheck(p schema.MutationUpdateCheckFieldResolverPar
 	check.Name = components.UniqueComponent()
 	check.Organization = components.Organization()
 	check.Environment = components.Environment()
-	copyCheckInputs(&check, inputs.Props)
+	if err := copyCheckInputs(&check, inputs.Props); err != nil {
+		return nil, err
+	}
 
 	err := r.checkCtrl.Update(p.Context, check)
 	if err != nil {
@@ -136,7 +144,7 @@ func (r *mutationsImpl) ExecuteCheck(p schema.MutationExecuteCheckFieldResolverP
 	}, nil
 }
 
-func copyCheckInputs(r *types.CheckConfig, ins *schema.CheckConfigInputs) {
+func copyCheckInputs(r *types.CheckConfig, ins *schema.CheckConfigInputs) error {
 	r.RuntimeAssets = ins.Assets
 	r.Command = ins.Command
 	r.Handlers = ins.Handlers
@@ -145,6 +153,20 @@ func copyCheckInputs(r *types.CheckConfig, ins *schema.CheckConfigInputs) {
 	r.LowFlapThreshold = uint32(ins.LowFlapThreshold)
 	r.Subscriptions = ins.Subscriptions
 	r.Publish = ins.Publish
+	if timeout, ok := ins.Timeout.(time.Duration); ok {
+		r.Timeout = uint32(timeout / time.Second)
+	}
+	if ttl, ok := ins.Ttl.(time.Duration); ok {
+		r.Ttl = int64(ttl / time.Second)
+	}
+	if ins.ExtendedAttributes != nil {
+		attrs, err := json.Marshal(ins.ExtendedAttributes)
+		if err != nil {
+			return err
+		}
+		r.SetExtendedAttributes(attrs)
+	}
+	return nil
 }
 
 type checkMutationPayload struct {
@@ -176,51 +198,107 @@ func (r *mutationsImpl) DeleteEntity(p schema.MutationDeleteEntityFieldResolverP
 
 // ResolveEvent implements response to request for the 'resolveEvent' field.
 func (r *mutationsImpl) ResolveEvent(p schema.MutationResolveEventFieldResolverParams) (interface{}, error) {
-	components, err := decodeEventGID(p.Args.Input.ID)
+	event, err := r.resolveEvent(p.Context, p.Args.Input.ID, p.Args.Input.Source)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx := setContextFromComponents(p.Context, components)
-	event, err := r.eventFinder.Find(ctx, components.EntityName(), components.CheckName())
-	if err != nil {
+	return map[string]interface{}{
+		"clientMutationId": p.Args.Input.ClientMutationID,
+		"event":            event,
+	}, nil
+}
+
+// DeleteEvent implements response to request for the 'deleteEvent' field.
+func (r *mutationsImpl) DeleteEvent(p schema.MutationDeleteEventFieldResolverParams) (interface{}, error) {
+	if err := r.deleteEvent(p.Context, p.Args.Input.ID); err != nil {
 		return nil, err
 	}
 
-	if event.HasCheck() && event.Check.Status > 0 {
-		event.Check.Status = 0
-		event.Check.Output = "Resolved manually with " + p.Args.Input.Source
-		event.Timestamp = int64(time.Now().Unix())
+	return map[string]interface{}{
+		"clientMutationId": p.Args.Input.ClientMutationID,
+		"deletedId":        p.Args.Input.ID,
+	}, nil
+}
 
-		err = r.eventReplacer.CreateOrReplace(ctx, *event)
-		if err != nil {
-			return nil, err
+// ResolveEvents implements response to request for the 'resolveEvents' field.
+// Failing to resolve one of the events does not prevent the others from being
+// resolved; errors are reported in the result of each event.
+func (r *mutationsImpl) ResolveEvents(p schema.MutationResolveEventsFieldResolverParams) (interface{}, error) {
+	results := make([]map[string]interface{}, 0, len(p.Args.Input.Ids))
+	for _, id := range p.Args.Input.Ids {
+		result := map[string]interface{}{"id": id}
+		if event, err := r.resolveEvent(p.Context, id, p.Args.Input.Source); err != nil {
+			result["errors"] = wrapInputErrors("ids", err)
+		} else {
+			result["event"] = event
+			result["errors"] = []stdErr{}
 		}
+		results = append(results, result)
 	}
 
 	return map[string]interface{}{
 		"clientMutationId": p.Args.Input.ClientMutationID,
-		"event":            event,
+		"results":          results,
 	}, nil
 }
 
-// DeleteEvent implements response to request for the 'deleteEvent' field.
-func (r *mutationsImpl) DeleteEvent(p schema.MutationDeleteEventFieldResolverParams) (interface{}, error) {
-	components, err := decodeEventGID(p.Args.Input.ID)
+// DeleteEvents implements response to request for the 'deleteEvents' field.
+// Failing to delete one of the events does not prevent the others from being
+// deleted; errors are reported in the result of each event.
+func (r *mutationsImpl) DeleteEvents(p schema.MutationDeleteEventsFieldResolverParams) (interface{}, error) {
+	results := make([]map[string]interface{}, 0, len(p.Args.Input.Ids))
+	for _, id := range p.Args.Input.Ids {
+		err := r.deleteEvent(p.Context, id)
+		results = append(results, map[string]interface{}{
+			"id":      id,
+			"deleted": err == nil,
+			"errors":  wrapInputErrors("ids", err),
+		})
+	}
+
+	return map[string]interface{}{
+		"clientMutationId": p.Args.Input.ClientMutationID,
+		"results":          results,
+	}, nil
+}
+
+// resolveEvent resolves the event with the given global ID, if its check is
+// failing, and returns it.
+func (r *mutationsImpl) resolveEvent(ctx context.Context, id, source string) (*types.Event, error) {
+	components, err := decodeEventGID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx := setContextFromComponents(p.Context, components)
-	err = r.eventDestroyer.Destroy(ctx, components.EntityName(), components.CheckName())
+	ctx = setContextFromComponents(ctx, components)
+	event, err := r.eventFinder.Find(ctx, components.EntityName(), components.CheckName())
 	if err != nil {
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"clientMutationId": p.Args.Input.ClientMutationID,
-		"deletedId":        p.Args.Input.ID,
-	}, nil
+	if event.HasCheck() && event.Check.Status > 0 {
+		event.Check.Status = 0
+		event.Check.Output = "Resolved manually with " + source
+		event.Timestamp = int64(time.Now().Unix())
+
+		err = r.eventReplacer.CreateOrReplace(ctx, *event)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// deleteEvent deletes the event with the given global ID.
+func (r *mutationsImpl) deleteEvent(ctx context.Context, id string) error {
+	components, err := decodeEventGID(id)
+	if err != nil {
+		return err
+	}
+
+	ctx = setContextFromComponents(ctx, components)
+	return r.eventDestroyer.Destroy(ctx, components.EntityName(), components.CheckName())
 }
 
 func decodeEventGID(gid string) (globalid.EventComponents, error) {
@@ -249,6 +327,7 @@ func (r *mutationsImpl) CreateSilence(p schema.MutationCreateSilenceFieldResolve
 	var silence types.Silenced
 	silence.Check = inputs.Check
 	silence.Subscription = inputs.Subscription
+	silence.LabelSelector = inputs.LabelSelector
 	silence.Organization = inputs.Ns.Organization
 	silence.Environment = inputs.Ns.Environment
 	copySilenceInputs(&silence, inputs.Props)
@@ -278,6 +357,37 @@ func (r *mutationsImpl) DeleteSilence(p schema.MutationDeleteSilenceFieldResolve
 	}, nil
 }
 
+// ClearSilencesForEntity implements response to request for the
+// 'clearSilencesForEntity' field.
+func (r *mutationsImpl) ClearSilencesForEntity(p schema.MutationClearSilencesForEntityFieldResolverParams) (interface{}, error) {
+	components, err := globalid.Decode(p.Args.Input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if components.Resource() != globalid.EntityTranslator.ForResourceNamed() {
+		return nil, errors.New("given id does not appear to reference entity")
+	}
+	ctx := setContextFromComponents(p.Context, components)
+
+	sub := types.GetEntitySubscription(components.UniqueComponent())
+	silences, err := r.silenceQuerier.Query(ctx, sub, "")
+	if err != nil {
+		return nil, err
+	}
+
+	deletedIDs := make([]string, 0, len(silences))
+	for _, silence := range silences {
+		if err := r.silenceDestroyer.Destroy(ctx, silence.ID); err != nil {
+			return nil, err
+		}
+		deletedIDs = append(deletedIDs, globalid.SilenceTranslator.EncodeToString(silence))
+	}
+	return map[string]interface{}{
+		"clientMutationId": p.Args.Input.ClientMutationID,
+		"deletedIds":       deletedIDs,
+	}, nil
+}
+
 func copySilenceInputs(r *types.Silenced, ins *schema.SilenceInputs) {
 	r.Begin = 0
 	if ins.Begin.After(time.Now()) {
@@ -1,13 +1,16 @@
 package graphql
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMutationTypeExecuteCheck(t *testing.T) {
@@ -76,6 +79,70 @@ func TestMutationTypeDeleteEventField(t *testing.T) {
 	assert.Nil(t, body)
 }
 
+func TestMutationTypeResolveEventsField(t *testing.T) {
+	evt := types.FixtureEvent("a", "b")
+	evt.Check.Status = 2
+	gid := globalid.EventTranslator.EncodeToString(evt)
+
+	inputs := schema.ResolveEventsInput{
+		ClientMutationID: "123",
+		Ids:              []string{gid, "tests"},
+		Source:           "test",
+	}
+	params := schema.MutationResolveEventsFieldResolverParams{}
+	params.Context = context.Background()
+	params.Args.Input = &inputs
+
+	impl := mutationsImpl{}
+	impl.eventFinder = mockEventFetcher{record: evt}
+	impl.eventReplacer = mockEventReplacer{}
+	body, err := impl.ResolveEvents(params)
+	require.NoError(t, err)
+
+	payload := body.(map[string]interface{})
+	assert.Equal(t, "123", payload["clientMutationId"])
+	results := payload["results"].([]map[string]interface{})
+	require.Len(t, results, 2)
+
+	assert.Equal(t, gid, results[0]["id"])
+	assert.Equal(t, evt, results[0]["event"])
+	assert.Empty(t, results[0]["errors"])
+	assert.Equal(t, uint32(0), evt.Check.Status)
+
+	assert.Equal(t, "tests", results[1]["id"])
+	assert.Nil(t, results[1]["event"])
+	assert.Len(t, results[1]["errors"], 1)
+}
+
+func TestMutationTypeDeleteEventsField(t *testing.T) {
+	gid := globalid.EventTranslator.EncodeToString(types.FixtureEvent("a", "b"))
+
+	inputs := schema.DeleteEventsInput{Ids: []string{gid, "tests"}}
+	params := schema.MutationDeleteEventsFieldResolverParams{}
+	params.Context = context.Background()
+	params.Args.Input = &inputs
+
+	// Partial success
+	impl := mutationsImpl{}
+	impl.eventDestroyer = mockEventDestroyer{}
+	body, err := impl.DeleteEvents(params)
+	require.NoError(t, err)
+	results := body.(map[string]interface{})["results"].([]map[string]interface{})
+	require.Len(t, results, 2)
+	assert.Equal(t, true, results[0]["deleted"])
+	assert.Empty(t, results[0]["errors"])
+	assert.Equal(t, false, results[1]["deleted"])
+	assert.Len(t, results[1]["errors"], 1)
+
+	// Destroy failed
+	impl.eventDestroyer = mockEventDestroyer{err: errors.New("test")}
+	body, err = impl.DeleteEvents(params)
+	require.NoError(t, err)
+	results = body.(map[string]interface{})["results"].([]map[string]interface{})
+	assert.Equal(t, false, results[0]["deleted"])
+	assert.Len(t, results[0]["errors"], 1)
+}
+
 func TestMutationTypeCreateSilenceField(t *testing.T) {
 	inputs := schema.CreateSilenceInput{
 		Ns:    schema.NewNamespaceInput("a", "b"),
@@ -116,3 +183,65 @@ func TestMutationTypeDeleteSilenceField(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, body)
 }
+
+func TestMutationTypeClearSilencesForEntityField(t *testing.T) {
+	entity := types.FixtureEntity("a")
+	silence := types.FixtureSilenced("entity:a:*")
+	inputs := schema.ClearSilencesForEntityInput{
+		ClientMutationID: "123",
+		ID:               globalid.EntityTranslator.EncodeToString(entity),
+	}
+	params := schema.MutationClearSilencesForEntityFieldResolverParams{}
+	params.Context = context.Background()
+	params.Args.Input = &inputs
+
+	// Success
+	impl := mutationsImpl{}
+	impl.silenceQuerier = mockSilenceQuerier{els: []*types.Silenced{silence}}
+	impl.silenceDestroyer = mockSilenceDestroyer{}
+	body, err := impl.ClearSilencesForEntity(params)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"clientMutationId": "123",
+		"deletedIds":       []string{globalid.SilenceTranslator.EncodeToString(silence)},
+	}, body)
+
+	// Destroy failed
+	impl.silenceDestroyer = mockSilenceDestroyer{err: errors.New("wow")}
+	body, err = impl.ClearSilencesForEntity(params)
+	assert.Error(t, err)
+	assert.Nil(t, body)
+
+	// Query failed
+	impl.silenceQuerier = mockSilenceQuerier{err: errors.New("wow")}
+	body, err = impl.ClearSilencesForEntity(params)
+	assert.Error(t, err)
+	assert.Nil(t, body)
+
+	// Not an entity
+	inputs.ID = globalid.SilenceTranslator.EncodeToString(silence)
+	body, err = impl.ClearSilencesForEntity(params)
+	assert.Error(t, err)
+	assert.Nil(t, body)
+}
+
+func TestCopyCheckInputs(t *testing.T) {
+	var check types.CheckConfig
+	inputs := schema.CheckConfigInputs{
+		Command:            "echo ok",
+		Interval:           60,
+		Timeout:            90 * time.Second,
+		Ttl:                5 * time.Minute,
+		ExtendedAttributes: map[string]interface{}{"team": "ops"},
+	}
+
+	require.NoError(t, copyCheckInputs(&check, &inputs))
+	assert.Equal(t, "echo ok", check.Command)
+	assert.EqualValues(t, 60, check.Interval)
+	assert.EqualValues(t, 90, check.Timeout)
+	assert.EqualValues(t, 300, check.Ttl)
+	assert.JSONEq(t, `{"team": "ops"}`, string(check.ExtendedAttributes))
+
+	inputs.ExtendedAttributes = map[string]interface{}{"invalid": func() {}}
+	assert.Error(t, copyCheckInputs(&check, &inputs))
+}
@@ -45,11 +45,11 @@ func (envNodeImpl) ResolveType(node interface{}, p graphql.ResolveTypeParams) *g
 }
 
 func findEnvironment(ctx context.Context, finder environmentFinder, res types.MultitenantResource) (interface{}, error) {
-	env, err := finder.Find(ctx, res.GetOrganization(), res.GetEnvironment())
+	env, err := loadEnvironment(ctx, finder, res.GetOrganization(), res.GetEnvironment())
 	return handleControllerResults(env, err)
 }
 
 func findOrganization(ctx context.Context, finder organizationFinder, res types.MultitenantResource) (interface{}, error) {
-	org, err := finder.Find(ctx, res.GetOrganization())
+	org, err := loadOrganization(ctx, finder, res.GetOrganization())
 	return handleControllerResults(org, err)
 }
@@ -27,10 +27,16 @@ func newNodeResolver(store store.Store, getter types.QueueGetter) *nodeResolver
 	registerAssetNodeResolver(register, store)
 	registerCheckNodeResolver(register, store, getter)
 	registerEntityNodeResolver(register, store)
+	registerEnvironmentNodeResolver(register, store)
+	registerFilterNodeResolver(register, store)
 	registerHandlerNodeResolver(register, store)
 	registerHookNodeResolver(register, store)
 	registerMutatorNodeResolver(register, store)
+	registerOrganizationNodeResolver(register, store)
 	registerRoleNodeResolver(register, store)
+	registerServiceNodeResolver(register, store)
+	registerServiceComponentNodeResolver(register, store)
+	registerSilenceNodeResolver(register, store)
 	registerUserNodeResolver(register, store)
 	registerEventNodeResolver(register, store)
 
@@ -124,7 +130,7 @@ type entityNodeResolver struct {
 }
 
 func registerEntityNodeResolver(register relay.NodeRegister, store store.EntityStore) {
-	controller := actions.NewEntityController(store)
+	controller := actions.NewEntityController(store, nil)
 	resolver := &entityNodeResolver{controller}
 	register.RegisterResolver(relay.NodeResolver{
 		ObjectType: schema.EntityType,
@@ -139,13 +145,58 @@ func (f *entityNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, err
 	return handleControllerResults(record, err)
 }
 
+// environments
+
+type environmentNodeResolver struct {
+	controller actions.EnvironmentController
+}
+
+func registerEnvironmentNodeResolver(register relay.NodeRegister, store store.EnvironmentStore) {
+	controller := actions.NewEnvironmentController(store)
+	resolver := &environmentNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.EnvironmentType,
+		Translator: globalid.EnvironmentTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *environmentNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	org := p.IDComponents.Organization()
+	record, err := f.controller.Find(ctx, org, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
+// filters
+
+type filterNodeResolver struct {
+	controller actions.EventFilterController
+}
+
+func registerFilterNodeResolver(register relay.NodeRegister, store store.EventFilterStore) {
+	controller := actions.NewEventFilterController(store)
+	resolver := &filterNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.EventFilterType,
+		Translator: globalid.FilterTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *filterNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
 // handlers
 
 type handlerNodeResolver struct {
 	controller actions.HandlerController
 }
 
-func registerHandlerNodeResolver(register relay.NodeRegister, store store.HandlerStore) {
+func registerHandlerNodeResolver(register relay.NodeRegister, store store.Store) {
 	controller := actions.NewHandlerController(store)
 	resolver := &handlerNodeResolver{controller}
 	register.RegisterResolver(relay.NodeResolver{
@@ -205,6 +256,72 @@ func (f *mutatorNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, er
 	return handleControllerResults(record, err)
 }
 
+// services
+
+type serviceNodeResolver struct {
+	controller actions.ServiceController
+}
+
+func registerServiceNodeResolver(register relay.NodeRegister, store store.ServiceStore) {
+	controller := actions.NewServiceController(store)
+	resolver := &serviceNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.ServiceType,
+		Translator: globalid.ServiceTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *serviceNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
+// service components
+
+type serviceComponentNodeResolver struct {
+	controller actions.ServiceComponentController
+}
+
+func registerServiceComponentNodeResolver(register relay.NodeRegister, store store.ServiceStore) {
+	controller := actions.NewServiceComponentController(store)
+	resolver := &serviceComponentNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.ServiceComponentType,
+		Translator: globalid.ServiceComponentTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *serviceComponentNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
+// organizations
+
+type organizationNodeResolver struct {
+	controller actions.OrganizationsController
+}
+
+func registerOrganizationNodeResolver(register relay.NodeRegister, store store.OrganizationStore) {
+	controller := actions.NewOrganizationsController(store)
+	resolver := &organizationNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.OrganizationType,
+		Translator: globalid.OrganizationTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *organizationNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
 // roles
 
 type roleNodeResolver struct {
@@ -227,6 +344,28 @@ func (f *roleNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error
 	return handleControllerResults(record, err)
 }
 
+// silences
+
+type silenceNodeResolver struct {
+	controller actions.SilencedController
+}
+
+func registerSilenceNodeResolver(register relay.NodeRegister, store store.SilencedStore) {
+	controller := actions.NewSilencedController(store)
+	resolver := &silenceNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.SilencedType,
+		Translator: globalid.SilenceTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *silenceNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
 // user
 
 type userNodeResolver struct {
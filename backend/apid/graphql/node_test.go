@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeResolverFind(t *testing.T) {
+	store := &mockstore.MockStore{}
+	resolver := newNodeResolver(store, queue.NewMemoryGetter())
+	ctx := testutil.NewContext(testutil.ContextWithFullAccess)
+
+	filter := types.FixtureEventFilter("allow-prod")
+	silence := types.FixtureSilenced("linux:check-cpu")
+	org := types.FixtureOrganization("acme")
+	env := types.FixtureEnvironment("prod")
+	store.On("GetEventFilterByName", mock.Anything, filter.Name).Return(filter, nil)
+	store.On("GetSilencedEntryByID", mock.Anything, silence.ID).Return(silence, nil)
+	store.On("GetOrganizationByName", mock.Anything, org.Name).Return(org, nil)
+	store.On("GetEnvironment", mock.Anything, env.Organization, env.Name).Return(env, nil)
+
+	testCases := []struct {
+		name   string
+		record interface{}
+		id     string
+	}{
+		{"filter", filter, globalid.FilterTranslator.EncodeToString(filter)},
+		{"silence", silence, globalid.SilenceTranslator.EncodeToString(silence)},
+		{"organization", org, globalid.OrganizationTranslator.EncodeToString(org)},
+		{"environment", env, globalid.EnvironmentTranslator.EncodeToString(env)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := resolver.Find(ctx, tc.id, graphql.ResolveInfo{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.record, res)
+			assert.NotNil(t, resolver.FindType(res))
+		})
+	}
+}
+
+func TestNodeResolverFindUnknownType(t *testing.T) {
+	resolver := newNodeResolver(&mockstore.MockStore{}, queue.NewMemoryGetter())
+	ctx := testutil.NewContext(testutil.ContextWithFullAccess)
+
+	_, err := resolver.Find(ctx, "srn:unknown:name", graphql.ResolveInfo{})
+	assert.Error(t, err)
+}
@@ -1,33 +1,224 @@
 package graphql
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"math"
+	"reflect"
 
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
 )
 
 var _ schema.OffsetPageInfoFieldResolvers = (*offsetPageInfoImpl)(nil)
 
 type offsetContainer struct {
 	Nodes    interface{}
+	Edges    []connectionEdge
 	PageInfo offsetPageInfo
 }
 
 type offsetPageInfo struct {
-	offset     int
-	limit      int
-	totalCount int
+	offset      int
+	limit       int
+	hasNext     bool
+	count       func() (int, error)
+	startCursor string
+	endCursor   string
+}
+
+type connectionEdge struct {
+	Node   interface{}
+	Cursor string
 }
 
 func newOffsetContainer(offset, limit int) offsetContainer {
 	container := offsetContainer{}
 	container.Nodes = make([]interface{}, 0)
+	container.Edges = make([]connectionEdge, 0)
 	container.PageInfo.offset = offset
 	container.PageInfo.limit = limit
 	return container
 }
 
+// pageArgs contains the arguments used to paginate a list. The cursor
+// arguments, first, after, last and before, take precedence over the offset and
+// limit when given.
+type pageArgs struct {
+	offset int
+	limit  int
+	first  int
+	after  string
+	last   int
+	before string
+}
+
+func (args pageArgs) hasCursors() bool {
+	return args.first != 0 || args.after != "" || args.last != 0 || args.before != ""
+}
+
+// bounds returns the bounds of the page within the given list, a slice.
+func (args pageArgs) bounds(nodes interface{}) (int, int, error) {
+	list := reflect.ValueOf(nodes)
+	length := list.Len()
+	if !args.hasCursors() {
+		low, high := clampSlice(args.offset, args.offset+args.limit, length)
+		return low, high, nil
+	}
+	if args.first < 0 || args.last < 0 {
+		return 0, 0, errors.New("first and last must be positive")
+	}
+
+	// https://facebook.github.io/relay/graphql/connections.htm#sec-Pagination-algorithm
+	low, high := 0, length
+	if args.after != "" {
+		after, err := cursorPosition(args.after, list)
+		if err != nil {
+			return 0, 0, err
+		}
+		low = clampInt(after+1, low, high)
+	}
+	if args.before != "" {
+		before, err := cursorPosition(args.before, list)
+		if err != nil {
+			return 0, 0, err
+		}
+		high = clampInt(before, low, high)
+	}
+	if args.first > 0 {
+		high = clampInt(low+args.first, low, high)
+	}
+	if args.last > 0 {
+		low = clampInt(high-args.last, low, high)
+	}
+	return low, high, nil
+}
+
+// storePredicate returns the predicate selecting the page from the store, and
+// the position of the page, when it can be read from the store rather than
+// from the whole list: the page must follow the beginning of the list or a
+// page read from the store. The list must be in the order of the keys of the
+// store and must not be filtered.
+func (args pageArgs) storePredicate() (*store.SelectionPredicate, int, bool) {
+	if args.first <= 0 || args.last != 0 || args.before != "" {
+		return nil, 0, false
+	}
+	if args.after == "" {
+		return &store.SelectionPredicate{Limit: int64(args.first)}, 0, true
+	}
+	after, err := decodeCursor(args.after)
+	if err != nil || after.Continue == "" {
+		return nil, 0, false
+	}
+	pred := &store.SelectionPredicate{Continue: after.Continue, Limit: int64(args.first)}
+	return pred, after.Offset + 1, true
+}
+
+// paginate sets the nodes of the page, a slice, given the list they were
+// sliced from, and their position in that list.
+func (c *offsetContainer) paginate(nodes interface{}, low, length int) {
+	c.setNodes(nodes, low, "")
+	c.PageInfo.hasNext = low+len(c.Edges) < length
+	c.PageInfo.count = func() (int, error) { return length, nil }
+}
+
+// paginateStore sets the nodes of the page, a slice, read from the store with
+// the given predicate, given their position in the list. Since the length of
+// the list is not known, it is only counted with the given function when
+// requested.
+func (c *offsetContainer) paginateStore(nodes interface{}, low int, pred *store.SelectionPredicate, count func() (int, error)) {
+	c.setNodes(nodes, low, pred.Continue)
+	c.PageInfo.hasNext = pred.Continue != ""
+	c.PageInfo.count = count
+}
+
+// setNodes sets the nodes of the page, a slice, given their position in the
+// list and the continue token of the store following the last one, if any.
+func (c *offsetContainer) setNodes(nodes interface{}, low int, next string) {
+	c.Nodes = nodes
+
+	val := reflect.ValueOf(nodes)
+	c.Edges = make([]connectionEdge, val.Len())
+	for i := range c.Edges {
+		node := val.Index(i).Interface()
+		c.Edges[i] = connectionEdge{
+			Node:   node,
+			Cursor: encodeCursor(pageCursor{Key: nodeKey(node), Offset: low + i}),
+		}
+	}
+	if n := len(c.Edges); n > 0 && next != "" {
+		// The page following the last node is read from the store
+		last := pageCursor{Key: nodeKey(c.Edges[n-1].Node), Offset: low + n - 1, Continue: next}
+		c.Edges[n-1].Cursor = encodeCursor(last)
+	}
+
+	c.PageInfo.offset = low
+	c.PageInfo.limit = len(c.Edges)
+	if len(c.Edges) > 0 {
+		c.PageInfo.startCursor = c.Edges[0].Cursor
+		c.PageInfo.endCursor = c.Edges[len(c.Edges)-1].Cursor
+	}
+}
+
+// pageCursor is the content of the opaque cursor of an edge: the key of its
+// node, its position when the page was read, and the continue token of the
+// store listing the nodes following it, if the page was read from the store.
+type pageCursor struct {
+	Key      string `json:"key"`
+	Offset   int    `json:"offset"`
+	Continue string `json:"continue,omitempty"`
+}
+
+// nodeKey returns the key identifying the given node within its list.
+func nodeKey(node interface{}) string {
+	switch n := node.(type) {
+	case *types.AuditEntry:
+		return n.ID
+	case interface{ URIPath() string }:
+		return n.URIPath()
+	}
+	return ""
+}
+
+// encodeCursor returns the opaque cursor of the given content.
+func encodeCursor(cursor pageCursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// decodeCursor returns the content of the given opaque cursor.
+func decodeCursor(cursor string) (pageCursor, error) {
+	var c pageCursor
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || json.Unmarshal(b, &c) != nil || c.Key == "" || c.Offset < 0 {
+		return c, errors.New("invalid cursor")
+	}
+	return c, nil
+}
+
+// cursorPosition returns the position of the node of the given cursor within
+// the given list. Since nodes may have been added or removed since the cursor
+// was issued, the node is looked up by its key, and its former position is
+// used if it is gone.
+func cursorPosition(cursor string, list reflect.Value) (int, error) {
+	c, err := decodeCursor(cursor)
+	if err != nil {
+		return 0, err
+	}
+	if c.Offset < list.Len() && nodeKey(list.Index(c.Offset).Interface()) == c.Key {
+		return c.Offset, nil
+	}
+	for i := 0; i < list.Len(); i++ {
+		if nodeKey(list.Index(i).Interface()) == c.Key {
+			return i, nil
+		}
+	}
+	return c.Offset, nil
+}
+
 //
 // Implement OffsetPageInfoFieldResolvers
 //
@@ -37,7 +228,7 @@ type offsetPageInfoImpl struct{}
 // HasNextPage implements response to request for 'hasNextPage' field.
 func (*offsetPageInfoImpl) HasNextPage(p graphql.ResolveParams) (bool, error) {
 	page := p.Source.(offsetPageInfo)
-	return (page.offset + page.limit) < page.totalCount, nil
+	return page.hasNext, nil
 }
 
 // HasPreviousPage implements response to request for 'hasPreviousPage' field.
@@ -49,9 +240,8 @@ func (*offsetPageInfoImpl) HasPreviousPage(p graphql.ResolveParams) (bool, error
 // NextOffset implements response to request for 'nextOffset' field.
 func (*offsetPageInfoImpl) NextOffset(p graphql.ResolveParams) (int, error) {
 	page := p.Source.(offsetPageInfo)
-	nextOffset := page.offset + page.limit
-	if nextOffset < page.totalCount {
-		return nextOffset, nil
+	if page.hasNext {
+		return page.offset + page.limit, nil
 	}
 	return 0, nil
 }
@@ -69,5 +259,20 @@ func (*offsetPageInfoImpl) PreviousOffset(p graphql.ResolveParams) (int, error)
 // TotalCount implements response to request for 'totalCount' field.
 func (*offsetPageInfoImpl) TotalCount(p graphql.ResolveParams) (int, error) {
 	page := p.Source.(offsetPageInfo)
-	return page.totalCount, nil
+	if page.count == nil {
+		return 0, nil
+	}
+	return page.count()
+}
+
+// StartCursor implements response to request for 'startCursor' field.
+func (*offsetPageInfoImpl) StartCursor(p graphql.ResolveParams) (string, error) {
+	page := p.Source.(offsetPageInfo)
+	return page.startCursor, nil
+}
+
+// EndCursor implements response to request for 'endCursor' field.
+func (*offsetPageInfoImpl) EndCursor(p graphql.ResolveParams) (string, error) {
+	page := p.Source.(offsetPageInfo)
+	return page.endCursor, nil
 }
@@ -0,0 +1,144 @@
+package graphql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtureHandlers(n int) []*types.Handler {
+	handlers := make([]*types.Handler, n)
+	for i := range handlers {
+		handlers[i] = types.FixtureHandler(fmt.Sprintf("handler%d", i))
+	}
+	return handlers
+}
+
+func TestPageArgsBounds(t *testing.T) {
+	handlers := fixtureHandlers(10)
+	cursor := func(i int) string {
+		return encodeCursor(pageCursor{Key: nodeKey(handlers[i]), Offset: i})
+	}
+
+	testCases := []struct {
+		name     string
+		args     pageArgs
+		wantLow  int
+		wantHigh int
+	}{
+		{"offset and limit", pageArgs{offset: 2, limit: 3}, 2, 5},
+		{"limit exceeding length", pageArgs{offset: 8, limit: 5}, 8, 10},
+		{"first", pageArgs{first: 4}, 0, 4},
+		{"first after", pageArgs{first: 4, after: cursor(2)}, 3, 7},
+		{"last", pageArgs{last: 3}, 7, 10},
+		{"last before", pageArgs{last: 3, before: cursor(5)}, 2, 5},
+		{"after and before", pageArgs{after: cursor(1), before: cursor(4)}, 2, 4},
+		{"cursors take precedence", pageArgs{offset: 5, limit: 5, first: 2}, 0, 2},
+		{
+			"after moved record",
+			pageArgs{first: 2, after: encodeCursor(pageCursor{Key: nodeKey(handlers[5]), Offset: 2})},
+			6, 8,
+		},
+		{
+			"after removed record",
+			pageArgs{first: 2, after: encodeCursor(pageCursor{Key: "/handlers/removed", Offset: 3})},
+			4, 6,
+		},
+		{
+			"after last record",
+			pageArgs{first: 2, after: encodeCursor(pageCursor{Key: "/handlers/removed", Offset: 20})},
+			10, 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			low, high, err := tc.args.bounds(handlers)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantLow, low)
+			assert.Equal(t, tc.wantHigh, high)
+		})
+	}
+
+	_, _, err := pageArgs{after: "invalid"}.bounds(handlers)
+	assert.Error(t, err)
+	_, _, err = pageArgs{first: -1}.bounds(handlers)
+	assert.Error(t, err)
+}
+
+func TestPageArgsStorePredicate(t *testing.T) {
+	after := encodeCursor(pageCursor{Key: "/handlers/a", Offset: 4, Continue: "token"})
+
+	pred, low, ok := pageArgs{first: 5}.storePredicate()
+	require.True(t, ok)
+	assert.Equal(t, &store.SelectionPredicate{Limit: 5}, pred)
+	assert.Equal(t, 0, low)
+
+	pred, low, ok = pageArgs{first: 5, after: after}.storePredicate()
+	require.True(t, ok)
+	assert.Equal(t, &store.SelectionPredicate{Continue: "token", Limit: 5}, pred)
+	assert.Equal(t, 5, low)
+
+	// Pages which do not follow a page read from the store
+	_, _, ok = pageArgs{offset: 5, limit: 5}.storePredicate()
+	assert.False(t, ok)
+	_, _, ok = pageArgs{last: 5}.storePredicate()
+	assert.False(t, ok)
+	_, _, ok = pageArgs{first: 5, before: after}.storePredicate()
+	assert.False(t, ok)
+	_, _, ok = pageArgs{first: 5, after: encodeCursor(pageCursor{Key: "/handlers/a", Offset: 4})}.storePredicate()
+	assert.False(t, ok)
+}
+
+func TestOffsetContainerPaginate(t *testing.T) {
+	records := fixtureHandlers(5)
+	container := newOffsetContainer(0, 0)
+	container.paginate(records[1:3], 1, len(records))
+
+	assert.Equal(t, records[1:3], container.Nodes)
+	require.Len(t, container.Edges, 2)
+	assert.Equal(t, records[1], container.Edges[0].Node)
+	assert.Equal(t, container.Edges[0].Cursor, container.PageInfo.startCursor)
+	assert.Equal(t, container.Edges[1].Cursor, container.PageInfo.endCursor)
+	assert.True(t, container.PageInfo.hasNext)
+	count, err := container.PageInfo.count()
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	cursor, err := decodeCursor(container.PageInfo.endCursor)
+	require.NoError(t, err)
+	assert.Equal(t, pageCursor{Key: "/handlers/handler2", Offset: 2}, cursor)
+}
+
+func TestOffsetContainerPaginateStore(t *testing.T) {
+	records := fixtureHandlers(2)
+	container := newOffsetContainer(0, 0)
+	pred := &store.SelectionPredicate{Continue: "token", Limit: 2}
+	container.paginateStore(records, 4, pred, func() (int, error) { return 10, nil })
+
+	require.Len(t, container.Edges, 2)
+	assert.True(t, container.PageInfo.hasNext)
+	assert.Equal(t, 4, container.PageInfo.offset)
+	count, err := container.PageInfo.count()
+	require.NoError(t, err)
+	assert.Equal(t, 10, count)
+
+	// The next page is read from the store after the last node
+	cursor, err := decodeCursor(container.PageInfo.endCursor)
+	require.NoError(t, err)
+	assert.Equal(t, pageCursor{Key: "/handlers/handler1", Offset: 5, Continue: "token"}, cursor)
+	cursor, err = decodeCursor(container.PageInfo.startCursor)
+	require.NoError(t, err)
+	assert.Empty(t, cursor.Continue)
+
+	// Last page
+	container.paginateStore(records, 4, &store.SelectionPredicate{Limit: 2}, nil)
+	assert.False(t, container.PageInfo.hasNext)
+	cursor, err = decodeCursor(container.PageInfo.endCursor)
+	require.NoError(t, err)
+	assert.Empty(t, cursor.Continue)
+}
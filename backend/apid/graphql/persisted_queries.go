@@ -0,0 +1,109 @@
+package graphql
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// DefaultPersistedQueryCacheSize is the default number of persisted queries
+// kept in memory.
+const DefaultPersistedQueryCacheSize = 1000
+
+var (
+	// ErrPersistedQueryNotFound is returned when the hash of a persisted query
+	// is unknown; clients are expected to retry with the full query. The
+	// message is the one expected by Apollo clients.
+	ErrPersistedQueryNotFound = errors.New("PersistedQueryNotFound")
+
+	// ErrPersistedQueryHashMismatch is returned when the hash of a query does
+	// not match the query.
+	ErrPersistedQueryHashMismatch = errors.New("provided sha256Hash does not match query")
+)
+
+// PersistedQueries implements automatic persisted queries, see
+// https://github.com/apollographql/apollo-link-persisted-queries. Clients send
+// the SHA-256 hash of a query rather than the query itself; unknown hashes are
+// rejected with ErrPersistedQueryNotFound, after which the client sends both,
+// persisting the query. Queries are kept in a bounded LRU cache.
+type PersistedQueries struct {
+	mu      sync.Mutex
+	size    int
+	ll      *list.List
+	queries map[string]*list.Element
+}
+
+type persistedQuery struct {
+	hash  string
+	query string
+}
+
+// NewPersistedQueries returns new persisted queries keeping at most the given
+// number of queries.
+func NewPersistedQueries(size int) *PersistedQueries {
+	return &PersistedQueries{
+		size:    size,
+		ll:      list.New(),
+		queries: make(map[string]*list.Element),
+	}
+}
+
+// Resolve returns the query of an operation given its query, if any, and
+// extensions. The query is returned as is if the operation does not use the
+// persistedQuery extension.
+func (c *PersistedQueries) Resolve(query string, extensions map[string]interface{}) (string, error) {
+	ext, _ := extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := ext["sha256Hash"].(string)
+	if hash == "" {
+		return query, nil
+	}
+	hash = strings.ToLower(hash)
+
+	if query == "" {
+		query, ok := c.get(hash)
+		if !ok {
+			return "", ErrPersistedQueryNotFound
+		}
+		return query, nil
+	}
+
+	sum := sha256.Sum256([]byte(query))
+	if hex.EncodeToString(sum[:]) != hash {
+		return "", ErrPersistedQueryHashMismatch
+	}
+	c.add(hash, query)
+	return query, nil
+}
+
+func (c *PersistedQueries) get(hash string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.queries[hash]
+	if !ok {
+		return "", false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*persistedQuery).query, true
+}
+
+func (c *PersistedQueries) add(hash, query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.queries[hash]; ok {
+		c.ll.MoveToFront(elem)
+		return
+	}
+	c.queries[hash] = c.ll.PushFront(&persistedQuery{hash: hash, query: query})
+
+	// Evict the least recently used queries
+	for c.ll.Len() > c.size {
+		elem := c.ll.Back()
+		c.ll.Remove(elem)
+		delete(c.queries, elem.Value.(*persistedQuery).hash)
+	}
+}
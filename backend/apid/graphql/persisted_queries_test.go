@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func persistedQueryExtensions(query string) map[string]interface{} {
+	sum := sha256.Sum256([]byte(query))
+	return map[string]interface{}{
+		"persistedQuery": map[string]interface{}{
+			"version":    float64(1),
+			"sha256Hash": hex.EncodeToString(sum[:]),
+		},
+	}
+}
+
+func TestPersistedQueriesResolve(t *testing.T) {
+	queries := NewPersistedQueries(2)
+
+	// Operations without the extension are left untouched
+	query, err := queries.Resolve("{ a }", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "{ a }", query)
+
+	// Unknown hash
+	_, err = queries.Resolve("", persistedQueryExtensions("{ a }"))
+	assert.Equal(t, ErrPersistedQueryNotFound, err)
+
+	// Mismatching hash
+	_, err = queries.Resolve("{ b }", persistedQueryExtensions("{ a }"))
+	assert.Equal(t, ErrPersistedQueryHashMismatch, err)
+
+	// Persisted query
+	_, err = queries.Resolve("{ a }", persistedQueryExtensions("{ a }"))
+	require.NoError(t, err)
+	query, err = queries.Resolve("", persistedQueryExtensions("{ a }"))
+	require.NoError(t, err)
+	assert.Equal(t, "{ a }", query)
+}
+
+func TestPersistedQueriesEviction(t *testing.T) {
+	queries := NewPersistedQueries(2)
+	for _, query := range []string{"{ a }", "{ b }"} {
+		_, err := queries.Resolve(query, persistedQueryExtensions(query))
+		require.NoError(t, err)
+	}
+
+	// Use { a }, making { b } the least recently used query
+	_, err := queries.Resolve("", persistedQueryExtensions("{ a }"))
+	require.NoError(t, err)
+
+	_, err = queries.Resolve("{ c }", persistedQueryExtensions("{ c }"))
+	require.NoError(t, err)
+
+	_, err = queries.Resolve("", persistedQueryExtensions("{ b }"))
+	assert.Equal(t, ErrPersistedQueryNotFound, err)
+	for _, query := range []string{"{ a }", "{ c }"} {
+		_, err = queries.Resolve("", persistedQueryExtensions(query))
+		assert.NoError(t, err)
+	}
+}
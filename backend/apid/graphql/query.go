@@ -26,7 +26,7 @@ type queryImpl struct {
 func newQueryImpl(store store.Store, resolver *nodeResolver, queue types.QueueGetter) *queryImpl {
 	return &queryImpl{
 		eventFinder:  actions.NewEventController(store, nil),
-		entityFinder: actions.NewEntityController(store),
+		entityFinder: actions.NewEntityController(store, nil),
 		checkFinder:  actions.NewCheckController(store, queue),
 		envFinder:    actions.NewEnvironmentController(store),
 		nodeResolver: resolver,
@@ -0,0 +1,894 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+	time "time"
+)
+
+// AuditEntryIDFieldResolver implement to resolve requests for the AuditEntry's id field.
+type AuditEntryIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
+// AuditEntryUserFieldResolver implement to resolve requests for the AuditEntry's user field.
+type AuditEntryUserFieldResolver interface {
+	// User implements response to request for user field.
+	User(p graphql.ResolveParams) (string, error)
+}
+
+// AuditEntryVerbFieldResolver implement to resolve requests for the AuditEntry's verb field.
+type AuditEntryVerbFieldResolver interface {
+	// Verb implements response to request for verb field.
+	Verb(p graphql.ResolveParams) (string, error)
+}
+
+// AuditEntryResourceFieldResolver implement to resolve requests for the AuditEntry's resource field.
+type AuditEntryResourceFieldResolver interface {
+	// Resource implements response to request for resource field.
+	Resource(p graphql.ResolveParams) (string, error)
+}
+
+// AuditEntryNamespaceFieldResolver implement to resolve requests for the AuditEntry's namespace field.
+type AuditEntryNamespaceFieldResolver interface {
+	// Namespace implements response to request for namespace field.
+	Namespace(p graphql.ResolveParams) (interface{}, error)
+}
+
+// AuditEntryOutcomeFieldResolver implement to resolve requests for the AuditEntry's outcome field.
+type AuditEntryOutcomeFieldResolver interface {
+	// Outcome implements response to request for outcome field.
+	Outcome(p graphql.ResolveParams) (string, error)
+}
+
+// AuditEntryStatusFieldResolver implement to resolve requests for the AuditEntry's status field.
+type AuditEntryStatusFieldResolver interface {
+	// Status implements response to request for status field.
+	Status(p graphql.ResolveParams) (int, error)
+}
+
+// AuditEntryRequestIDFieldResolver implement to resolve requests for the AuditEntry's requestId field.
+type AuditEntryRequestIDFieldResolver interface {
+	// RequestID implements response to request for requestId field.
+	RequestID(p graphql.ResolveParams) (string, error)
+}
+
+// AuditEntryTimestampFieldResolver implement to resolve requests for the AuditEntry's timestamp field.
+type AuditEntryTimestampFieldResolver interface {
+	// Timestamp implements response to request for timestamp field.
+	Timestamp(p graphql.ResolveParams) (time.Time, error)
+}
+
+//
+// AuditEntryFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'AuditEntry' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type AuditEntryFieldResolvers interface {
+	AuditEntryIDFieldResolver
+	AuditEntryUserFieldResolver
+	AuditEntryVerbFieldResolver
+	AuditEntryResourceFieldResolver
+	AuditEntryNamespaceFieldResolver
+	AuditEntryOutcomeFieldResolver
+	AuditEntryStatusFieldResolver
+	AuditEntryRequestIDFieldResolver
+	AuditEntryTimestampFieldResolver
+}
+
+// AuditEntryAliases implements all methods on AuditEntryFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type AuditEntryAliases struct{}
+
+// ID implements response to request for 'id' field.
+func (_ AuditEntryAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
+// User implements response to request for 'user' field.
+func (_ AuditEntryAliases) User(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'user'")
+	}
+	return ret, err
+}
+
+// Verb implements response to request for 'verb' field.
+func (_ AuditEntryAliases) Verb(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'verb'")
+	}
+	return ret, err
+}
+
+// Resource implements response to request for 'resource' field.
+func (_ AuditEntryAliases) Resource(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'resource'")
+	}
+	return ret, err
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (_ AuditEntryAliases) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Outcome implements response to request for 'outcome' field.
+func (_ AuditEntryAliases) Outcome(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'outcome'")
+	}
+	return ret, err
+}
+
+// Status implements response to request for 'status' field.
+func (_ AuditEntryAliases) Status(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'status'")
+	}
+	return ret, err
+}
+
+// RequestID implements response to request for 'requestId' field.
+func (_ AuditEntryAliases) RequestID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'requestId'")
+	}
+	return ret, err
+}
+
+// Timestamp implements response to request for 'timestamp' field.
+func (_ AuditEntryAliases) Timestamp(p graphql.ResolveParams) (time.Time, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(time.Time)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'timestamp'")
+	}
+	return ret, err
+}
+
+// AuditEntryType AuditEntry records a request which modified resources of the API.
+var AuditEntryType = graphql.NewType("AuditEntry", graphql.ObjectKind)
+
+// RegisterAuditEntry registers AuditEntry object type with given service.
+func RegisterAuditEntry(svc *graphql.Service, impl AuditEntryFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeAuditEntryDesc, impl)
+}
+func _ObjTypeAuditEntryIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
+func _ObjTypeAuditEntryUserHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryUserFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.User(frp)
+	}
+}
+
+func _ObjTypeAuditEntryVerbHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryVerbFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Verb(frp)
+	}
+}
+
+func _ObjTypeAuditEntryResourceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryResourceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Resource(frp)
+	}
+}
+
+func _ObjTypeAuditEntryNamespaceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryNamespaceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Namespace(frp)
+	}
+}
+
+func _ObjTypeAuditEntryOutcomeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryOutcomeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Outcome(frp)
+	}
+}
+
+func _ObjTypeAuditEntryStatusHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryStatusFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Status(frp)
+	}
+}
+
+func _ObjTypeAuditEntryRequestIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryRequestIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.RequestID(frp)
+	}
+}
+
+func _ObjTypeAuditEntryTimestampHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryTimestampFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Timestamp(frp)
+	}
+}
+
+func _ObjectTypeAuditEntryConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "AuditEntry records a request which modified resources of the API.",
+		Fields: graphql1.Fields{
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Unique identifier of the entry.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"namespace": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Namespace the request was made in.",
+				Name:              "namespace",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
+			},
+			"outcome": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Outcome is either success or failure.",
+				Name:              "outcome",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"requestId": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "RequestID is the identifier of the request, if any.",
+				Name:              "requestId",
+				Type:              graphql1.String,
+			},
+			"resource": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Resource is the path of the resource the request was made on.",
+				Name:              "resource",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"status": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Status is the HTTP status of the response to the request.",
+				Name:              "status",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"timestamp": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Timestamp is the time at which the request was made.",
+				Name:              "timestamp",
+				Type:              graphql1.NewNonNull(graphql1.DateTime),
+			},
+			"user": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "User is the name of the user who made the request.",
+				Name:              "user",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"verb": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Verb is the action requested, e.g. create, update, patch or delete.",
+				Name:              "verb",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see AuditEntryFieldResolvers.")
+		},
+		Name: "AuditEntry",
+	}
+}
+
+// describe AuditEntry's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeAuditEntryDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeAuditEntryConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"id":        _ObjTypeAuditEntryIDHandler,
+		"namespace": _ObjTypeAuditEntryNamespaceHandler,
+		"outcome":   _ObjTypeAuditEntryOutcomeHandler,
+		"requestId": _ObjTypeAuditEntryRequestIDHandler,
+		"resource":  _ObjTypeAuditEntryResourceHandler,
+		"status":    _ObjTypeAuditEntryStatusHandler,
+		"timestamp": _ObjTypeAuditEntryTimestampHandler,
+		"user":      _ObjTypeAuditEntryUserHandler,
+		"verb":      _ObjTypeAuditEntryVerbHandler,
+	},
+}
+
+// AuditEntryConnectionNodesFieldResolver implement to resolve requests for the AuditEntryConnection's nodes field.
+type AuditEntryConnectionNodesFieldResolver interface {
+	// Nodes implements response to request for nodes field.
+	Nodes(p graphql.ResolveParams) (interface{}, error)
+}
+
+// AuditEntryConnectionEdgesFieldResolver implement to resolve requests for the AuditEntryConnection's edges field.
+type AuditEntryConnectionEdgesFieldResolver interface {
+	// Edges implements response to request for edges field.
+	Edges(p graphql.ResolveParams) (interface{}, error)
+}
+
+// AuditEntryConnectionPageInfoFieldResolver implement to resolve requests for the AuditEntryConnection's pageInfo field.
+type AuditEntryConnectionPageInfoFieldResolver interface {
+	// PageInfo implements response to request for pageInfo field.
+	PageInfo(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// AuditEntryConnectionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'AuditEntryConnection' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type AuditEntryConnectionFieldResolvers interface {
+	AuditEntryConnectionNodesFieldResolver
+	AuditEntryConnectionEdgesFieldResolver
+	AuditEntryConnectionPageInfoFieldResolver
+}
+
+// AuditEntryConnectionAliases implements all methods on AuditEntryConnectionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type AuditEntryConnectionAliases struct{}
+
+// Nodes implements response to request for 'nodes' field.
+func (_ AuditEntryConnectionAliases) Nodes(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Edges implements response to request for 'edges' field.
+func (_ AuditEntryConnectionAliases) Edges(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// PageInfo implements response to request for 'pageInfo' field.
+func (_ AuditEntryConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// AuditEntryConnectionType A connection to a sequence of records.
+var AuditEntryConnectionType = graphql.NewType("AuditEntryConnection", graphql.ObjectKind)
+
+// RegisterAuditEntryConnection registers AuditEntryConnection object type with given service.
+func RegisterAuditEntryConnection(svc *graphql.Service, impl AuditEntryConnectionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeAuditEntryConnectionDesc, impl)
+}
+func _ObjTypeAuditEntryConnectionNodesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryConnectionNodesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Nodes(frp)
+	}
+}
+
+func _ObjTypeAuditEntryConnectionEdgesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryConnectionEdgesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Edges(frp)
+	}
+}
+
+func _ObjTypeAuditEntryConnectionPageInfoHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryConnectionPageInfoFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.PageInfo(frp)
+	}
+}
+
+func _ObjectTypeAuditEntryConnectionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A connection to a sequence of records.",
+		Fields: graphql1.Fields{
+			"edges": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "edges",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("AuditEntryEdge")))),
+			},
+			"nodes": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "nodes",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("AuditEntry")))),
+			},
+			"pageInfo": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "pageInfo",
+				Type:              graphql1.NewNonNull(graphql.OutputType("OffsetPageInfo")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see AuditEntryConnectionFieldResolvers.")
+		},
+		Name: "AuditEntryConnection",
+	}
+}
+
+// describe AuditEntryConnection's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeAuditEntryConnectionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeAuditEntryConnectionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"edges":    _ObjTypeAuditEntryConnectionEdgesHandler,
+		"nodes":    _ObjTypeAuditEntryConnectionNodesHandler,
+		"pageInfo": _ObjTypeAuditEntryConnectionPageInfoHandler,
+	},
+}
+
+// AuditEntryEdgeNodeFieldResolver implement to resolve requests for the AuditEntryEdge's node field.
+type AuditEntryEdgeNodeFieldResolver interface {
+	// Node implements response to request for node field.
+	Node(p graphql.ResolveParams) (interface{}, error)
+}
+
+// AuditEntryEdgeCursorFieldResolver implement to resolve requests for the AuditEntryEdge's cursor field.
+type AuditEntryEdgeCursorFieldResolver interface {
+	// Cursor implements response to request for cursor field.
+	Cursor(p graphql.ResolveParams) (string, error)
+}
+
+//
+// AuditEntryEdgeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'AuditEntryEdge' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type AuditEntryEdgeFieldResolvers interface {
+	AuditEntryEdgeNodeFieldResolver
+	AuditEntryEdgeCursorFieldResolver
+}
+
+// AuditEntryEdgeAliases implements all methods on AuditEntryEdgeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type AuditEntryEdgeAliases struct{}
+
+// Node implements response to request for 'node' field.
+func (_ AuditEntryEdgeAliases) Node(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Cursor implements response to request for 'cursor' field.
+func (_ AuditEntryEdgeAliases) Cursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'cursor'")
+	}
+	return ret, err
+}
+
+// AuditEntryEdgeType An edge in a connection.
+var AuditEntryEdgeType = graphql.NewType("AuditEntryEdge", graphql.ObjectKind)
+
+// RegisterAuditEntryEdge registers AuditEntryEdge object type with given service.
+func RegisterAuditEntryEdge(svc *graphql.Service, impl AuditEntryEdgeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeAuditEntryEdgeDesc, impl)
+}
+func _ObjTypeAuditEntryEdgeNodeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryEdgeNodeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Node(frp)
+	}
+}
+
+func _ObjTypeAuditEntryEdgeCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(AuditEntryEdgeCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Cursor(frp)
+	}
+}
+
+func _ObjectTypeAuditEntryEdgeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An edge in a connection.",
+		Fields: graphql1.Fields{
+			"cursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "cursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"node": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "node",
+				Type:              graphql.OutputType("AuditEntry"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see AuditEntryEdgeFieldResolvers.")
+		},
+		Name: "AuditEntryEdge",
+	}
+}
+
+// describe AuditEntryEdge's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeAuditEntryEdgeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeAuditEntryEdgeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"cursor": _ObjTypeAuditEntryEdgeCursorHandler,
+		"node":   _ObjTypeAuditEntryEdgeNodeHandler,
+	},
+}
@@ -58,6 +58,23 @@ type CheckConfigExtendedAttributesFieldResolver interface {
 	ExtendedAttributes(p graphql.ResolveParams) (interface{}, error)
 }
 
+// CheckConfigExtendedAttributeFieldResolverArgs contains arguments provided to extendedAttribute when selected
+type CheckConfigExtendedAttributeFieldResolverArgs struct {
+	Path string // Path - self descriptive
+}
+
+// CheckConfigExtendedAttributeFieldResolverParams contains contextual info to resolve extendedAttribute field
+type CheckConfigExtendedAttributeFieldResolverParams struct {
+	graphql.ResolveParams
+	Args CheckConfigExtendedAttributeFieldResolverArgs
+}
+
+// CheckConfigExtendedAttributeFieldResolver implement to resolve requests for the CheckConfig's extendedAttribute field.
+type CheckConfigExtendedAttributeFieldResolver interface {
+	// ExtendedAttribute implements response to request for extendedAttribute field.
+	ExtendedAttribute(p CheckConfigExtendedAttributeFieldResolverParams) (interface{}, error)
+}
+
 // CheckConfigHandlersFieldResolver implement to resolve requests for the CheckConfig's handlers field.
 type CheckConfigHandlersFieldResolver interface {
 	// Handlers implements response to request for handlers field.
@@ -236,6 +253,7 @@ type CheckConfigFieldResolvers interface {
 	CheckConfigCronFieldResolver
 	CheckConfigEnvVarsFieldResolver
 	CheckConfigExtendedAttributesFieldResolver
+	CheckConfigExtendedAttributeFieldResolver
 	CheckConfigHandlersFieldResolver
 	CheckConfigHighFlapThresholdFieldResolver
 	CheckConfigIntervalFieldResolver
@@ -386,6 +404,12 @@ func (_ CheckConfigAliases) ExtendedAttributes(p graphql.ResolveParams) (interfa
 	return val, err
 }
 
+// ExtendedAttribute implements response to request for 'extendedAttribute' field.
+func (_ CheckConfigAliases) ExtendedAttribute(p CheckConfigExtendedAttributeFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Handlers implements response to request for 'handlers' field.
 func (_ CheckConfigAliases) Handlers(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -641,6 +665,19 @@ func _ObjTypeCheckConfigExtendedAttributesHandler(impl interface{}) graphql1.Fie
 	}
 }
 
+func _ObjTypeCheckConfigExtendedAttributeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(CheckConfigExtendedAttributeFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := CheckConfigExtendedAttributeFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.ExtendedAttribute(frp)
+	}
+}
+
 func _ObjTypeCheckConfigHandlersHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(CheckConfigHandlersFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -781,9 +818,9 @@ func _ObjectTypeCheckConfigConfigFn() graphql1.ObjectConfig {
 			"command": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
-				Description:       "command is the command to be executed.",
+				Description:       "command is the command to be executed; null if the viewer is not allowed to\nupdate the check.",
 				Name:              "command",
-				Type:              graphql1.NewNonNull(graphql1.String),
+				Type:              graphql1.String,
 			},
 			"cron": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
@@ -799,6 +836,16 @@ func _ObjectTypeCheckConfigConfigFn() graphql1.ObjectConfig {
 				Name:              "envVars",
 				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
 			},
+			"extendedAttribute": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"path": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql1.String),
+				}},
+				DeprecationReason: "",
+				Description:       "extendedAttribute returns the value of the extended attribute at the given\ndot-separated path, e.g. \"aws.region\", if any.",
+				Name:              "extendedAttribute",
+				Type:              graphql.OutputType("JSON"),
+			},
 			"extendedAttributes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -976,6 +1023,7 @@ var _ObjectTypeCheckConfigDesc = graphql.ObjectDesc{
 		"command":              _ObjTypeCheckConfigCommandHandler,
 		"cron":                 _ObjTypeCheckConfigCronHandler,
 		"envVars":              _ObjTypeCheckConfigEnvVarsHandler,
+		"extendedAttribute":    _ObjTypeCheckConfigExtendedAttributeHandler,
 		"extendedAttributes":   _ObjTypeCheckConfigExtendedAttributesHandler,
 		"handlers":             _ObjTypeCheckConfigHandlersHandler,
 		"highFlapThreshold":    _ObjTypeCheckConfigHighFlapThresholdHandler,
@@ -1007,6 +1055,12 @@ type CheckConfigConnectionNodesFieldResolver interface {
 	Nodes(p graphql.ResolveParams) (interface{}, error)
 }
 
+// CheckConfigConnectionEdgesFieldResolver implement to resolve requests for the CheckConfigConnection's edges field.
+type CheckConfigConnectionEdgesFieldResolver interface {
+	// Edges implements response to request for edges field.
+	Edges(p graphql.ResolveParams) (interface{}, error)
+}
+
 // CheckConfigConnectionPageInfoFieldResolver implement to resolve requests for the CheckConfigConnection's pageInfo field.
 type CheckConfigConnectionPageInfoFieldResolver interface {
 	// PageInfo implements response to request for pageInfo field.
@@ -1076,6 +1130,7 @@ type CheckConfigConnectionPageInfoFieldResolver interface {
 //
 type CheckConfigConnectionFieldResolvers interface {
 	CheckConfigConnectionNodesFieldResolver
+	CheckConfigConnectionEdgesFieldResolver
 	CheckConfigConnectionPageInfoFieldResolver
 }
 
@@ -1132,6 +1187,12 @@ func (_ CheckConfigConnectionAliases) Nodes(p graphql.ResolveParams) (interface{
 	return val, err
 }
 
+// Edges implements response to request for 'edges' field.
+func (_ CheckConfigConnectionAliases) Edges(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // PageInfo implements response to request for 'pageInfo' field.
 func (_ CheckConfigConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -1152,6 +1213,13 @@ func _ObjTypeCheckConfigConnectionNodesHandler(impl interface{}) graphql1.FieldR
 	}
 }
 
+func _ObjTypeCheckConfigConnectionEdgesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(CheckConfigConnectionEdgesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Edges(frp)
+	}
+}
+
 func _ObjTypeCheckConfigConnectionPageInfoHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(CheckConfigConnectionPageInfoFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -1163,6 +1231,13 @@ func _ObjectTypeCheckConfigConnectionConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "A connection to a sequence of records.",
 		Fields: graphql1.Fields{
+			"edges": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "edges",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("CheckConfigEdge")))),
+			},
 			"nodes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -1195,6 +1270,7 @@ func _ObjectTypeCheckConfigConnectionConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeCheckConfigConnectionDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeCheckConfigConnectionConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
+		"edges":    _ObjTypeCheckConfigConnectionEdgesHandler,
 		"nodes":    _ObjTypeCheckConfigConnectionNodesHandler,
 		"pageInfo": _ObjTypeCheckConfigConnectionPageInfoHandler,
 	},
@@ -1442,6 +1518,23 @@ type CheckExtendedAttributesFieldResolver interface {
 	ExtendedAttributes(p graphql.ResolveParams) (interface{}, error)
 }
 
+// CheckExtendedAttributeFieldResolverArgs contains arguments provided to extendedAttribute when selected
+type CheckExtendedAttributeFieldResolverArgs struct {
+	Path string // Path - self descriptive
+}
+
+// CheckExtendedAttributeFieldResolverParams contains contextual info to resolve extendedAttribute field
+type CheckExtendedAttributeFieldResolverParams struct {
+	graphql.ResolveParams
+	Args CheckExtendedAttributeFieldResolverArgs
+}
+
+// CheckExtendedAttributeFieldResolver implement to resolve requests for the Check's extendedAttribute field.
+type CheckExtendedAttributeFieldResolver interface {
+	// ExtendedAttribute implements response to request for extendedAttribute field.
+	ExtendedAttribute(p CheckExtendedAttributeFieldResolverParams) (interface{}, error)
+}
+
 // CheckHandlersFieldResolver implement to resolve requests for the Check's handlers field.
 type CheckHandlersFieldResolver interface {
 	// Handlers implements response to request for handlers field.
@@ -1585,6 +1678,12 @@ type CheckStatusFieldResolver interface {
 	Status(p graphql.ResolveParams) (int, error)
 }
 
+// CheckSeverityFieldResolver implement to resolve requests for the Check's severity field.
+type CheckSeverityFieldResolver interface {
+	// Severity implements response to request for severity field.
+	Severity(p graphql.ResolveParams) (string, error)
+}
+
 // CheckTotalStateChangeFieldResolver implement to resolve requests for the Check's totalStateChange field.
 type CheckTotalStateChangeFieldResolver interface {
 	// TotalStateChange implements response to request for totalStateChange field.
@@ -1713,6 +1812,7 @@ type CheckFieldResolvers interface {
 	CheckCheckHooksFieldResolver
 	CheckEnvVarsFieldResolver
 	CheckExtendedAttributesFieldResolver
+	CheckExtendedAttributeFieldResolver
 	CheckHandlersFieldResolver
 	CheckHighFlapThresholdFieldResolver
 	CheckIntervalFieldResolver
@@ -1735,6 +1835,7 @@ type CheckFieldResolvers interface {
 	CheckOutputFieldResolver
 	CheckStateFieldResolver
 	CheckStatusFieldResolver
+	CheckSeverityFieldResolver
 	CheckTotalStateChangeFieldResolver
 	CheckHooksFieldResolver
 	CheckSilencedFieldResolver
@@ -1858,6 +1959,12 @@ func (_ CheckAliases) ExtendedAttributes(p graphql.ResolveParams) (interface{},
 	return val, err
 }
 
+// ExtendedAttribute implements response to request for 'extendedAttribute' field.
+func (_ CheckAliases) ExtendedAttribute(p CheckExtendedAttributeFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Handlers implements response to request for 'handlers' field.
 func (_ CheckAliases) Handlers(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -2102,6 +2209,19 @@ func (_ CheckAliases) Status(p graphql.ResolveParams) (int, error) {
 	return ret, err
 }
 
+// Severity implements response to request for 'severity' field.
+func (_ CheckAliases) Severity(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'severity'")
+	}
+	return ret, err
+}
+
 // TotalStateChange implements response to request for 'totalStateChange' field.
 func (_ CheckAliases) TotalStateChange(p graphql.ResolveParams) (int, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -2270,6 +2390,19 @@ func _ObjTypeCheckExtendedAttributesHandler(impl interface{}) graphql1.FieldReso
 	}
 }
 
+func _ObjTypeCheckExtendedAttributeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(CheckExtendedAttributeFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := CheckExtendedAttributeFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.ExtendedAttribute(frp)
+	}
+}
+
 func _ObjTypeCheckHandlersHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(CheckHandlersFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -2430,6 +2563,13 @@ func _ObjTypeCheckStatusHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeCheckSeverityHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(CheckSeverityFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Severity(frp)
+	}
+}
+
 func _ObjTypeCheckTotalStateChangeHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(CheckTotalStateChangeFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -2514,9 +2654,9 @@ func _ObjectTypeCheckConfigFn() graphql1.ObjectConfig {
 			"command": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
-				Description:       "command is the command to be executed.",
+				Description:       "command is the command to be executed; null if the viewer is not allowed to\nupdate the check.",
 				Name:              "command",
-				Type:              graphql1.NewNonNull(graphql1.String),
+				Type:              graphql1.String,
 			},
 			"cron": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
@@ -2546,6 +2686,16 @@ func _ObjectTypeCheckConfigFn() graphql1.ObjectConfig {
 				Name:              "executed",
 				Type:              graphql1.NewNonNull(graphql1.DateTime),
 			},
+			"extendedAttribute": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"path": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql1.String),
+				}},
+				DeprecationReason: "",
+				Description:       "extendedAttribute returns the value of the extended attribute at the given\ndot-separated path, e.g. \"aws.region\", if any.",
+				Name:              "extendedAttribute",
+				Type:              graphql.OutputType("JSON"),
+			},
 			"extendedAttributes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -2704,6 +2854,13 @@ func _ObjectTypeCheckConfigFn() graphql1.ObjectConfig {
 				Name:              "runtimeAssets",
 				Type:              graphql1.NewNonNull(graphql1.NewList(graphql.OutputType("Asset"))),
 			},
+			"severity": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Severity is the severity of the status, either ok, warning, critical, unknown\nor a custom severity the check maps the status to.",
+				Name:              "severity",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
 			"silenced": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -2798,6 +2955,7 @@ var _ObjectTypeCheckDesc = graphql.ObjectDesc{
 		"duration":             _ObjTypeCheckDurationHandler,
 		"envVars":              _ObjTypeCheckEnvVarsHandler,
 		"executed":             _ObjTypeCheckExecutedHandler,
+		"extendedAttribute":    _ObjTypeCheckExtendedAttributeHandler,
 		"extendedAttributes":   _ObjTypeCheckExtendedAttributesHandler,
 		"handlers":             _ObjTypeCheckHandlersHandler,
 		"highFlapThreshold":    _ObjTypeCheckHighFlapThresholdHandler,
@@ -2820,6 +2978,7 @@ var _ObjectTypeCheckDesc = graphql.ObjectDesc{
 		"publish":              _ObjTypeCheckPublishHandler,
 		"roundRobin":           _ObjTypeCheckRoundRobinHandler,
 		"runtimeAssets":        _ObjTypeCheckRuntimeAssetsHandler,
+		"severity":             _ObjTypeCheckSeverityHandler,
 		"silenced":             _ObjTypeCheckSilencedHandler,
 		"silences":             _ObjTypeCheckSilencesHandler,
 		"state":                _ObjTypeCheckStateHandler,
@@ -0,0 +1,53 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	graphql1 "github.com/graphql-go/graphql"
+	ast "github.com/graphql-go/graphql/language/ast"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+/*
+DurationType ... Duration The Duration type describes a length of time. Durations are serialized as
+strings, eg. "1m30s", and may be given either as strings, eg. "90s" or "5m", or
+as a number of seconds.
+*/
+var DurationType = graphql.NewType("Duration", graphql.ScalarKind)
+
+// RegisterDuration registers Duration object type with given service.
+func RegisterDuration(svc *graphql.Service, impl graphql.ScalarResolver) {
+	svc.RegisterScalar(_ScalarTypeDurationDesc, impl)
+}
+
+// describe Duration's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ScalarTypeDurationDesc = graphql.ScalarDesc{Config: func() graphql1.ScalarConfig {
+	return graphql1.ScalarConfig{
+		Description: "The Duration type describes a length of time. Durations are serialized as\nstrings, eg. \"1m30s\", and may be given either as strings, eg. \"90s\" or \"5m\", or\nas a number of seconds.",
+		Name:        "Duration",
+		ParseLiteral: func(_ ast.Value) interface{} {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ScalarResolver.")
+		},
+		ParseValue: func(_ interface{}) interface{} {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ScalarResolver.")
+		},
+		Serialize: func(_ interface{}) interface{} {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ScalarResolver.")
+		},
+	}
+}}
@@ -70,6 +70,24 @@ type EntityKeepaliveTimeoutFieldResolver interface {
 	KeepaliveTimeout(p graphql.ResolveParams) (int, error)
 }
 
+// EntityKeepaliveWarningTimeoutFieldResolver implement to resolve requests for the Entity's keepaliveWarningTimeout field.
+type EntityKeepaliveWarningTimeoutFieldResolver interface {
+	// KeepaliveWarningTimeout implements response to request for keepaliveWarningTimeout field.
+	KeepaliveWarningTimeout(p graphql.ResolveParams) (int, error)
+}
+
+// EntityKeepaliveCriticalTimeoutFieldResolver implement to resolve requests for the Entity's keepaliveCriticalTimeout field.
+type EntityKeepaliveCriticalTimeoutFieldResolver interface {
+	// KeepaliveCriticalTimeout implements response to request for keepaliveCriticalTimeout field.
+	KeepaliveCriticalTimeout(p graphql.ResolveParams) (int, error)
+}
+
+// EntityKeepaliveHandlersFieldResolver implement to resolve requests for the Entity's keepaliveHandlers field.
+type EntityKeepaliveHandlersFieldResolver interface {
+	// KeepaliveHandlers implements response to request for keepaliveHandlers field.
+	KeepaliveHandlers(p graphql.ResolveParams) ([]string, error)
+}
+
 // EntityUserFieldResolver implement to resolve requests for the Entity's user field.
 type EntityUserFieldResolver interface {
 	// User implements response to request for user field.
@@ -140,6 +158,23 @@ type EntityExtendedAttributesFieldResolver interface {
 	ExtendedAttributes(p graphql.ResolveParams) (interface{}, error)
 }
 
+// EntityExtendedAttributeFieldResolverArgs contains arguments provided to extendedAttribute when selected
+type EntityExtendedAttributeFieldResolverArgs struct {
+	Path string // Path - self descriptive
+}
+
+// EntityExtendedAttributeFieldResolverParams contains contextual info to resolve extendedAttribute field
+type EntityExtendedAttributeFieldResolverParams struct {
+	graphql.ResolveParams
+	Args EntityExtendedAttributeFieldResolverArgs
+}
+
+// EntityExtendedAttributeFieldResolver implement to resolve requests for the Entity's extendedAttribute field.
+type EntityExtendedAttributeFieldResolver interface {
+	// ExtendedAttribute implements response to request for extendedAttribute field.
+	ExtendedAttribute(p EntityExtendedAttributeFieldResolverParams) (interface{}, error)
+}
+
 //
 // EntityFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Entity' type.
@@ -212,6 +247,9 @@ type EntityFieldResolvers interface {
 	EntityDeregisterFieldResolver
 	EntityDeregistrationFieldResolver
 	EntityKeepaliveTimeoutFieldResolver
+	EntityKeepaliveWarningTimeoutFieldResolver
+	EntityKeepaliveCriticalTimeoutFieldResolver
+	EntityKeepaliveHandlersFieldResolver
 	EntityUserFieldResolver
 	EntityRedactFieldResolver
 	EntityStatusFieldResolver
@@ -220,6 +258,7 @@ type EntityFieldResolvers interface {
 	EntityIsSilencedFieldResolver
 	EntitySilencesFieldResolver
 	EntityExtendedAttributesFieldResolver
+	EntityExtendedAttributeFieldResolver
 }
 
 // EntityAliases implements all methods on EntityFieldResolvers interface by using reflection to
@@ -378,6 +417,45 @@ func (_ EntityAliases) KeepaliveTimeout(p graphql.ResolveParams) (int, error) {
 	return ret, err
 }
 
+// KeepaliveWarningTimeout implements response to request for 'keepaliveWarningTimeout' field.
+func (_ EntityAliases) KeepaliveWarningTimeout(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'keepaliveWarningTimeout'")
+	}
+	return ret, err
+}
+
+// KeepaliveCriticalTimeout implements response to request for 'keepaliveCriticalTimeout' field.
+func (_ EntityAliases) KeepaliveCriticalTimeout(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'keepaliveCriticalTimeout'")
+	}
+	return ret, err
+}
+
+// KeepaliveHandlers implements response to request for 'keepaliveHandlers' field.
+func (_ EntityAliases) KeepaliveHandlers(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'keepaliveHandlers'")
+	}
+	return ret, err
+}
+
 // User implements response to request for 'user' field.
 func (_ EntityAliases) User(p graphql.ResolveParams) (string, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -454,6 +532,12 @@ func (_ EntityAliases) ExtendedAttributes(p graphql.ResolveParams) (interface{},
 	return val, err
 }
 
+// ExtendedAttribute implements response to request for 'extendedAttribute' field.
+func (_ EntityAliases) ExtendedAttribute(p EntityExtendedAttributeFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 /*
 EntityType Entity is the Entity supplying the event. The default Entity for any
 Event is the running Agent process--if the Event is sent by an Agent.
@@ -534,6 +618,27 @@ func _ObjTypeEntityKeepaliveTimeoutHandler(impl interface{}) graphql1.FieldResol
 	}
 }
 
+func _ObjTypeEntityKeepaliveWarningTimeoutHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityKeepaliveWarningTimeoutFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.KeepaliveWarningTimeout(frp)
+	}
+}
+
+func _ObjTypeEntityKeepaliveCriticalTimeoutHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityKeepaliveCriticalTimeoutFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.KeepaliveCriticalTimeout(frp)
+	}
+}
+
+func _ObjTypeEntityKeepaliveHandlersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityKeepaliveHandlersFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.KeepaliveHandlers(frp)
+	}
+}
+
 func _ObjTypeEntityUserHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(EntityUserFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -602,6 +707,19 @@ func _ObjTypeEntityExtendedAttributesHandler(impl interface{}) graphql1.FieldRes
 	}
 }
 
+func _ObjTypeEntityExtendedAttributeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityExtendedAttributeFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := EntityExtendedAttributeFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.ExtendedAttribute(frp)
+	}
+}
+
 func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Entity is the Entity supplying the event. The default Entity for any\nEvent is the running Agent process--if the Event is sent by an Agent.",
@@ -638,6 +756,16 @@ func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 				Name:              "events",
 				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Event")))),
 			},
+			"extendedAttribute": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"path": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql1.String),
+				}},
+				DeprecationReason: "",
+				Description:       "extendedAttribute returns the value of the extended attribute at the given\ndot-separated path, e.g. \"aws.region\", if any.",
+				Name:              "extendedAttribute",
+				Type:              graphql.OutputType("JSON"),
+			},
 			"extendedAttributes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -659,6 +787,20 @@ func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 				Name:              "isSilenced",
 				Type:              graphql1.NewNonNull(graphql1.Boolean),
 			},
+			"keepaliveCriticalTimeout": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "KeepaliveCriticalTimeout is the time in seconds after which a critical\nkeepalive event is created, none is created if 0.",
+				Name:              "keepaliveCriticalTimeout",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"keepaliveHandlers": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "KeepaliveHandlers are the handlers of the keepalive events of the entity.",
+				Name:              "keepaliveHandlers",
+				Type:              graphql1.NewList(graphql1.NewNonNull(graphql1.String)),
+			},
 			"keepaliveTimeout": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -666,6 +808,13 @@ func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 				Name:              "keepaliveTimeout",
 				Type:              graphql1.NewNonNull(graphql1.Int),
 			},
+			"keepaliveWarningTimeout": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "KeepaliveWarningTimeout is the time in seconds after which a warning\nkeepalive event is created, defaults to the keepalive timeout.",
+				Name:              "keepaliveWarningTimeout",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
 			"lastSeen": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -759,24 +908,28 @@ func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeEntityDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeEntityConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"class":              _ObjTypeEntityClassHandler,
-		"deregister":         _ObjTypeEntityDeregisterHandler,
-		"deregistration":     _ObjTypeEntityDeregistrationHandler,
-		"events":             _ObjTypeEntityEventsHandler,
-		"extendedAttributes": _ObjTypeEntityExtendedAttributesHandler,
-		"id":                 _ObjTypeEntityIDHandler,
-		"isSilenced":         _ObjTypeEntityIsSilencedHandler,
-		"keepaliveTimeout":   _ObjTypeEntityKeepaliveTimeoutHandler,
-		"lastSeen":           _ObjTypeEntityLastSeenHandler,
-		"name":               _ObjTypeEntityNameHandler,
-		"namespace":          _ObjTypeEntityNamespaceHandler,
-		"redact":             _ObjTypeEntityRedactHandler,
-		"related":            _ObjTypeEntityRelatedHandler,
-		"silences":           _ObjTypeEntitySilencesHandler,
-		"status":             _ObjTypeEntityStatusHandler,
-		"subscriptions":      _ObjTypeEntitySubscriptionsHandler,
-		"system":             _ObjTypeEntitySystemHandler,
-		"user":               _ObjTypeEntityUserHandler,
+		"class":                    _ObjTypeEntityClassHandler,
+		"deregister":               _ObjTypeEntityDeregisterHandler,
+		"deregistration":           _ObjTypeEntityDeregistrationHandler,
+		"events":                   _ObjTypeEntityEventsHandler,
+		"extendedAttribute":        _ObjTypeEntityExtendedAttributeHandler,
+		"extendedAttributes":       _ObjTypeEntityExtendedAttributesHandler,
+		"id":                       _ObjTypeEntityIDHandler,
+		"isSilenced":               _ObjTypeEntityIsSilencedHandler,
+		"keepaliveCriticalTimeout": _ObjTypeEntityKeepaliveCriticalTimeoutHandler,
+		"keepaliveHandlers":        _ObjTypeEntityKeepaliveHandlersHandler,
+		"keepaliveTimeout":         _ObjTypeEntityKeepaliveTimeoutHandler,
+		"keepaliveWarningTimeout":  _ObjTypeEntityKeepaliveWarningTimeoutHandler,
+		"lastSeen":                 _ObjTypeEntityLastSeenHandler,
+		"name":                     _ObjTypeEntityNameHandler,
+		"namespace":                _ObjTypeEntityNamespaceHandler,
+		"redact":                   _ObjTypeEntityRedactHandler,
+		"related":                  _ObjTypeEntityRelatedHandler,
+		"silences":                 _ObjTypeEntitySilencesHandler,
+		"status":                   _ObjTypeEntityStatusHandler,
+		"subscriptions":            _ObjTypeEntitySubscriptionsHandler,
+		"system":                   _ObjTypeEntitySystemHandler,
+		"user":                     _ObjTypeEntityUserHandler,
 	},
 }
 
@@ -786,6 +939,12 @@ type EntityConnectionNodesFieldResolver interface {
 	Nodes(p graphql.ResolveParams) (interface{}, error)
 }
 
+// EntityConnectionEdgesFieldResolver implement to resolve requests for the EntityConnection's edges field.
+type EntityConnectionEdgesFieldResolver interface {
+	// Edges implements response to request for edges field.
+	Edges(p graphql.ResolveParams) (interface{}, error)
+}
+
 // EntityConnectionPageInfoFieldResolver implement to resolve requests for the EntityConnection's pageInfo field.
 type EntityConnectionPageInfoFieldResolver interface {
 	// PageInfo implements response to request for pageInfo field.
@@ -855,6 +1014,7 @@ type EntityConnectionPageInfoFieldResolver interface {
 //
 type EntityConnectionFieldResolvers interface {
 	EntityConnectionNodesFieldResolver
+	EntityConnectionEdgesFieldResolver
 	EntityConnectionPageInfoFieldResolver
 }
 
@@ -911,6 +1071,12 @@ func (_ EntityConnectionAliases) Nodes(p graphql.ResolveParams) (interface{}, er
 	return val, err
 }
 
+// Edges implements response to request for 'edges' field.
+func (_ EntityConnectionAliases) Edges(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // PageInfo implements response to request for 'pageInfo' field.
 func (_ EntityConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -931,6 +1097,13 @@ func _ObjTypeEntityConnectionNodesHandler(impl interface{}) graphql1.FieldResolv
 	}
 }
 
+func _ObjTypeEntityConnectionEdgesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityConnectionEdgesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Edges(frp)
+	}
+}
+
 func _ObjTypeEntityConnectionPageInfoHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(EntityConnectionPageInfoFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -942,6 +1115,13 @@ func _ObjectTypeEntityConnectionConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "A connection to a sequence of records.",
 		Fields: graphql1.Fields{
+			"edges": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "edges",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("EntityEdge")))),
+			},
 			"nodes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -974,11 +1154,218 @@ func _ObjectTypeEntityConnectionConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeEntityConnectionDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeEntityConnectionConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
+		"edges":    _ObjTypeEntityConnectionEdgesHandler,
 		"nodes":    _ObjTypeEntityConnectionNodesHandler,
 		"pageInfo": _ObjTypeEntityConnectionPageInfoHandler,
 	},
 }
 
+// EntityEdgeNodeFieldResolver implement to resolve requests for the EntityEdge's node field.
+type EntityEdgeNodeFieldResolver interface {
+	// Node implements response to request for node field.
+	Node(p graphql.ResolveParams) (interface{}, error)
+}
+
+// EntityEdgeCursorFieldResolver implement to resolve requests for the EntityEdge's cursor field.
+type EntityEdgeCursorFieldResolver interface {
+	// Cursor implements response to request for cursor field.
+	Cursor(p graphql.ResolveParams) (string, error)
+}
+
+//
+// EntityEdgeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'EntityEdge' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type EntityEdgeFieldResolvers interface {
+	EntityEdgeNodeFieldResolver
+	EntityEdgeCursorFieldResolver
+}
+
+// EntityEdgeAliases implements all methods on EntityEdgeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type EntityEdgeAliases struct{}
+
+// Node implements response to request for 'node' field.
+func (_ EntityEdgeAliases) Node(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Cursor implements response to request for 'cursor' field.
+func (_ EntityEdgeAliases) Cursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'cursor'")
+	}
+	return ret, err
+}
+
+// EntityEdgeType An edge in a connection.
+var EntityEdgeType = graphql.NewType("EntityEdge", graphql.ObjectKind)
+
+// RegisterEntityEdge registers EntityEdge object type with given service.
+func RegisterEntityEdge(svc *graphql.Service, impl EntityEdgeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeEntityEdgeDesc, impl)
+}
+func _ObjTypeEntityEdgeNodeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityEdgeNodeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Node(frp)
+	}
+}
+
+func _ObjTypeEntityEdgeCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityEdgeCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Cursor(frp)
+	}
+}
+
+func _ObjectTypeEntityEdgeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An edge in a connection.",
+		Fields: graphql1.Fields{
+			"cursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "cursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"node": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "node",
+				Type:              graphql.OutputType("Entity"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see EntityEdgeFieldResolvers.")
+		},
+		Name: "EntityEdge",
+	}
+}
+
+// describe EntityEdge's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeEntityEdgeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeEntityEdgeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"cursor": _ObjTypeEntityEdgeCursorHandler,
+		"node":   _ObjTypeEntityEdgeNodeHandler,
+	},
+}
+
 // SystemHostnameFieldResolver implement to resolve requests for the System's hostname field.
 type SystemHostnameFieldResolver interface {
 	// Hostname implements response to request for hostname field.
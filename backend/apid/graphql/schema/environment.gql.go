@@ -27,6 +27,12 @@ type EnvironmentNameFieldResolver interface {
 	Name(p graphql.ResolveParams) (string, error)
 }
 
+// EnvironmentEventRetentionFieldResolver implement to resolve requests for the Environment's eventRetention field.
+type EnvironmentEventRetentionFieldResolver interface {
+	// EventRetention implements response to request for eventRetention field.
+	EventRetention(p graphql.ResolveParams) (int, error)
+}
+
 // EnvironmentColourIDFieldResolver implement to resolve requests for the Environment's colourId field.
 type EnvironmentColourIDFieldResolver interface {
 	// ColourID implements response to request for colourId field.
@@ -45,6 +51,10 @@ type EnvironmentChecksFieldResolverArgs struct {
 	Limit   int            // Limit adds optional limit to the number of entries returned.
 	OrderBy CheckListOrder // OrderBy adds optional order to the records retrieved.
 	Filter  string         // Filter reduces the set using the given Sensu Query Expression predicate.
+	First   int            // First limits the page to the first n records, after the 'after' cursor.
+	After   string         // After returns the records following the given cursor.
+	Last    int            // Last limits the page to the last n records, before the 'before' cursor.
+	Before  string         // Before returns the records preceding the given cursor.
 }
 
 // EnvironmentChecksFieldResolverParams contains contextual info to resolve checks field
@@ -65,6 +75,10 @@ type EnvironmentEntitiesFieldResolverArgs struct {
 	Limit   int             // Limit adds optional limit to the number of entries returned.
 	OrderBy EntityListOrder // OrderBy adds optional order to the records retrieved.
 	Filter  string          // Filter reduces the set using the given Sensu Query Expression predicate.
+	First   int             // First limits the page to the first n records, after the 'after' cursor.
+	After   string          // After returns the records following the given cursor.
+	Last    int             // Last limits the page to the last n records, before the 'before' cursor.
+	Before  string          // Before returns the records preceding the given cursor.
 }
 
 // EnvironmentEntitiesFieldResolverParams contains contextual info to resolve entities field
@@ -84,7 +98,16 @@ type EnvironmentEventsFieldResolverArgs struct {
 	Offset  int             // Offset - self descriptive
 	Limit   int             // Limit adds optional limit to the number of entries returned.
 	OrderBy EventsListOrder // OrderBy adds optional order to the records retrieved.
-	Filter  string          // Filter reduces the set using the given Sensu Query Expression predicate.
+	Filter  string          /*
+	Filter reduces the set using the given Sensu Query Expression predicate, or
+	the given whitespace separated filter statements, all of which must match:
+	status:<status|incident>, silenced:<true|false>, check:<name>,
+	subscription:<name> and label:<key>=<value> or label:<key>!=<value>.
+	*/
+	First  int    // First limits the page to the first n records, after the 'after' cursor.
+	After  string // After returns the records following the given cursor.
+	Last   int    // Last limits the page to the last n records, before the 'before' cursor.
+	Before string // Before returns the records preceding the given cursor.
 }
 
 // EnvironmentEventsFieldResolverParams contains contextual info to resolve events field
@@ -99,12 +122,33 @@ type EnvironmentEventsFieldResolver interface {
 	Events(p EnvironmentEventsFieldResolverParams) (interface{}, error)
 }
 
+// EnvironmentEventAggregatesFieldResolverArgs contains arguments provided to eventAggregates when selected
+type EnvironmentEventAggregatesFieldResolverArgs struct {
+	Filter string // Filter reduces the set using the given filter, see the events field.
+}
+
+// EnvironmentEventAggregatesFieldResolverParams contains contextual info to resolve eventAggregates field
+type EnvironmentEventAggregatesFieldResolverParams struct {
+	graphql.ResolveParams
+	Args EnvironmentEventAggregatesFieldResolverArgs
+}
+
+// EnvironmentEventAggregatesFieldResolver implement to resolve requests for the Environment's eventAggregates field.
+type EnvironmentEventAggregatesFieldResolver interface {
+	// EventAggregates implements response to request for eventAggregates field.
+	EventAggregates(p EnvironmentEventAggregatesFieldResolverParams) (interface{}, error)
+}
+
 // EnvironmentSilencesFieldResolverArgs contains arguments provided to silences when selected
 type EnvironmentSilencesFieldResolverArgs struct {
 	Offset  int               // Offset - self descriptive
 	Limit   int               // Limit adds optional limit to the number of entries returned.
 	OrderBy SilencesListOrder // OrderBy adds optional order to the records retrieved.
 	Filter  string            // Filter reduces the set using the given Sensu Query Expression predicate.
+	First   int               // First limits the page to the first n records, after the 'after' cursor.
+	After   string            // After returns the records following the given cursor.
+	Last    int               // Last limits the page to the last n records, before the 'before' cursor.
+	Before  string            // Before returns the records preceding the given cursor.
 }
 
 // EnvironmentSilencesFieldResolverParams contains contextual info to resolve silences field
@@ -119,6 +163,58 @@ type EnvironmentSilencesFieldResolver interface {
 	Silences(p EnvironmentSilencesFieldResolverParams) (interface{}, error)
 }
 
+// EnvironmentAuditEntriesFieldResolverArgs contains arguments provided to auditEntries when selected
+type EnvironmentAuditEntriesFieldResolverArgs struct {
+	Offset int    // Offset - self descriptive
+	Limit  int    // Limit adds optional limit to the number of entries returned.
+	First  int    // First limits the page to the first n records, after the 'after' cursor.
+	After  string // After returns the records following the given cursor.
+	Last   int    // Last limits the page to the last n records, before the 'before' cursor.
+	Before string // Before returns the records preceding the given cursor.
+}
+
+// EnvironmentAuditEntriesFieldResolverParams contains contextual info to resolve auditEntries field
+type EnvironmentAuditEntriesFieldResolverParams struct {
+	graphql.ResolveParams
+	Args EnvironmentAuditEntriesFieldResolverArgs
+}
+
+// EnvironmentAuditEntriesFieldResolver implement to resolve requests for the Environment's auditEntries field.
+type EnvironmentAuditEntriesFieldResolver interface {
+	// AuditEntries implements response to request for auditEntries field.
+	AuditEntries(p EnvironmentAuditEntriesFieldResolverParams) (interface{}, error)
+}
+
+// EnvironmentHandlersFieldResolverArgs contains arguments provided to handlers when selected
+type EnvironmentHandlersFieldResolverArgs struct {
+	Offset  int              // Offset - self descriptive
+	Limit   int              // Limit adds optional limit to the number of entries returned.
+	OrderBy HandlerListOrder // OrderBy adds optional order to the records retrieved.
+	Filter  string           // Filter reduces the set using the given Sensu Query Expression predicate.
+	First   int              // First limits the page to the first n records, after the 'after' cursor.
+	After   string           // After returns the records following the given cursor.
+	Last    int              // Last limits the page to the last n records, before the 'before' cursor.
+	Before  string           // Before returns the records preceding the given cursor.
+}
+
+// EnvironmentHandlersFieldResolverParams contains contextual info to resolve handlers field
+type EnvironmentHandlersFieldResolverParams struct {
+	graphql.ResolveParams
+	Args EnvironmentHandlersFieldResolverArgs
+}
+
+// EnvironmentHandlersFieldResolver implement to resolve requests for the Environment's handlers field.
+type EnvironmentHandlersFieldResolver interface {
+	// Handlers implements response to request for handlers field.
+	Handlers(p EnvironmentHandlersFieldResolverParams) (interface{}, error)
+}
+
+// EnvironmentServicesFieldResolver implement to resolve requests for the Environment's services field.
+type EnvironmentServicesFieldResolver interface {
+	// Services implements response to request for services field.
+	Services(p graphql.ResolveParams) (interface{}, error)
+}
+
 // EnvironmentSubscriptionsFieldResolverArgs contains arguments provided to subscriptions when selected
 type EnvironmentSubscriptionsFieldResolverArgs struct {
 	OmitEntity bool                 // OmitEntity - Omit entity subscriptions from set.
@@ -155,6 +251,24 @@ type EnvironmentCheckHistoryFieldResolver interface {
 	CheckHistory(p EnvironmentCheckHistoryFieldResolverParams) (interface{}, error)
 }
 
+// EnvironmentSearchFieldResolverArgs contains arguments provided to search when selected
+type EnvironmentSearchFieldResolverArgs struct {
+	Query string // Query - self descriptive
+	Limit int    // Limit adds optional limit to the number of results returned.
+}
+
+// EnvironmentSearchFieldResolverParams contains contextual info to resolve search field
+type EnvironmentSearchFieldResolverParams struct {
+	graphql.ResolveParams
+	Args EnvironmentSearchFieldResolverArgs
+}
+
+// EnvironmentSearchFieldResolver implement to resolve requests for the Environment's search field.
+type EnvironmentSearchFieldResolver interface {
+	// Search implements response to request for search field.
+	Search(p EnvironmentSearchFieldResolverParams) (interface{}, error)
+}
+
 //
 // EnvironmentFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Environment' type.
@@ -220,14 +334,20 @@ type EnvironmentFieldResolvers interface {
 	EnvironmentIDFieldResolver
 	EnvironmentDescriptionFieldResolver
 	EnvironmentNameFieldResolver
+	EnvironmentEventRetentionFieldResolver
 	EnvironmentColourIDFieldResolver
 	EnvironmentOrganizationFieldResolver
 	EnvironmentChecksFieldResolver
 	EnvironmentEntitiesFieldResolver
 	EnvironmentEventsFieldResolver
+	EnvironmentEventAggregatesFieldResolver
 	EnvironmentSilencesFieldResolver
+	EnvironmentAuditEntriesFieldResolver
+	EnvironmentHandlersFieldResolver
+	EnvironmentServicesFieldResolver
 	EnvironmentSubscriptionsFieldResolver
 	EnvironmentCheckHistoryFieldResolver
+	EnvironmentSearchFieldResolver
 }
 
 // EnvironmentAliases implements all methods on EnvironmentFieldResolvers interface by using reflection to
@@ -316,6 +436,19 @@ func (_ EnvironmentAliases) Name(p graphql.ResolveParams) (string, error) {
 	return ret, err
 }
 
+// EventRetention implements response to request for 'eventRetention' field.
+func (_ EnvironmentAliases) EventRetention(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'eventRetention'")
+	}
+	return ret, err
+}
+
 // ColourID implements response to request for 'colourId' field.
 func (_ EnvironmentAliases) ColourID(p graphql.ResolveParams) (MutedColour, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -353,12 +486,36 @@ func (_ EnvironmentAliases) Events(p EnvironmentEventsFieldResolverParams) (inte
 	return val, err
 }
 
+// EventAggregates implements response to request for 'eventAggregates' field.
+func (_ EnvironmentAliases) EventAggregates(p EnvironmentEventAggregatesFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Silences implements response to request for 'silences' field.
 func (_ EnvironmentAliases) Silences(p EnvironmentSilencesFieldResolverParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
 	return val, err
 }
 
+// AuditEntries implements response to request for 'auditEntries' field.
+func (_ EnvironmentAliases) AuditEntries(p EnvironmentAuditEntriesFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Handlers implements response to request for 'handlers' field.
+func (_ EnvironmentAliases) Handlers(p EnvironmentHandlersFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Services implements response to request for 'services' field.
+func (_ EnvironmentAliases) Services(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Subscriptions implements response to request for 'subscriptions' field.
 func (_ EnvironmentAliases) Subscriptions(p EnvironmentSubscriptionsFieldResolverParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -371,6 +528,12 @@ func (_ EnvironmentAliases) CheckHistory(p EnvironmentCheckHistoryFieldResolverP
 	return val, err
 }
 
+// Search implements response to request for 'search' field.
+func (_ EnvironmentAliases) Search(p EnvironmentSearchFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // EnvironmentType Environment represents a Sensu environment in RBAC
 var EnvironmentType = graphql.NewType("Environment", graphql.ObjectKind)
 
@@ -399,6 +562,13 @@ func _ObjTypeEnvironmentNameHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeEnvironmentEventRetentionHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentEventRetentionFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.EventRetention(frp)
+	}
+}
+
 func _ObjTypeEnvironmentColourIDHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(EnvironmentColourIDFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -454,6 +624,19 @@ func _ObjTypeEnvironmentEventsHandler(impl interface{}) graphql1.FieldResolveFn
 	}
 }
 
+func _ObjTypeEnvironmentEventAggregatesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentEventAggregatesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := EnvironmentEventAggregatesFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.EventAggregates(frp)
+	}
+}
+
 func _ObjTypeEnvironmentSilencesHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(EnvironmentSilencesFieldResolver)
 	return func(p graphql1.ResolveParams) (interface{}, error) {
@@ -467,6 +650,39 @@ func _ObjTypeEnvironmentSilencesHandler(impl interface{}) graphql1.FieldResolveF
 	}
 }
 
+func _ObjTypeEnvironmentAuditEntriesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentAuditEntriesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := EnvironmentAuditEntriesFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.AuditEntries(frp)
+	}
+}
+
+func _ObjTypeEnvironmentHandlersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentHandlersFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := EnvironmentHandlersFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Handlers(frp)
+	}
+}
+
+func _ObjTypeEnvironmentServicesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentServicesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Services(frp)
+	}
+}
+
 func _ObjTypeEnvironmentSubscriptionsHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(EnvironmentSubscriptionsFieldResolver)
 	return func(p graphql1.ResolveParams) (interface{}, error) {
@@ -493,10 +709,57 @@ func _ObjTypeEnvironmentCheckHistoryHandler(impl interface{}) graphql1.FieldReso
 	}
 }
 
+func _ObjTypeEnvironmentSearchHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentSearchFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := EnvironmentSearchFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Search(frp)
+	}
+}
+
 func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Environment represents a Sensu environment in RBAC",
 		Fields: graphql1.Fields{
+			"auditEntries": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "After returns the records following the given cursor.",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "Before returns the records preceding the given cursor.",
+						Type:        graphql1.String,
+					},
+					"first": &graphql1.ArgumentConfig{
+						Description: "First limits the page to the first n records, after the 'after' cursor.",
+						Type:        graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						Description: "Last limits the page to the last n records, before the 'before' cursor.",
+						Type:        graphql1.Int,
+					},
+					"limit": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "Limit adds optional limit to the number of entries returned.",
+						Type:         graphql1.Int,
+					},
+					"offset": &graphql1.ArgumentConfig{
+						DefaultValue: 0,
+						Description:  "self descriptive",
+						Type:         graphql1.Int,
+					},
+				},
+				DeprecationReason: "",
+				Description:       "Audit log of the requests which modified resources of the environment, newest first.",
+				Name:              "auditEntries",
+				Type:              graphql1.NewNonNull(graphql.OutputType("AuditEntryConnection")),
+			},
 			"checkHistory": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
 					"filter": &graphql1.ArgumentConfig{
@@ -517,11 +780,27 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 			},
 			"checks": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "After returns the records following the given cursor.",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "Before returns the records preceding the given cursor.",
+						Type:        graphql1.String,
+					},
 					"filter": &graphql1.ArgumentConfig{
 						DefaultValue: "",
 						Description:  "Filter reduces the set using the given Sensu Query Expression predicate.",
 						Type:         graphql1.String,
 					},
+					"first": &graphql1.ArgumentConfig{
+						Description: "First limits the page to the first n records, after the 'after' cursor.",
+						Type:        graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						Description: "Last limits the page to the last n records, before the 'before' cursor.",
+						Type:        graphql1.Int,
+					},
 					"limit": &graphql1.ArgumentConfig{
 						DefaultValue: 10,
 						Description:  "Limit adds optional limit to the number of entries returned.",
@@ -559,11 +838,27 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 			},
 			"entities": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "After returns the records following the given cursor.",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "Before returns the records preceding the given cursor.",
+						Type:        graphql1.String,
+					},
 					"filter": &graphql1.ArgumentConfig{
 						DefaultValue: "",
 						Description:  "Filter reduces the set using the given Sensu Query Expression predicate.",
 						Type:         graphql1.String,
 					},
+					"first": &graphql1.ArgumentConfig{
+						Description: "First limits the page to the first n records, after the 'after' cursor.",
+						Type:        graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						Description: "Last limits the page to the last n records, before the 'before' cursor.",
+						Type:        graphql1.Int,
+					},
 					"limit": &graphql1.ArgumentConfig{
 						DefaultValue: 10,
 						Description:  "Limit adds optional limit to the number of entries returned.",
@@ -585,13 +880,47 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 				Name:              "entities",
 				Type:              graphql1.NewNonNull(graphql.OutputType("EntityConnection")),
 			},
+			"eventAggregates": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"filter": &graphql1.ArgumentConfig{
+					DefaultValue: "",
+					Description:  "Filter reduces the set using the given filter, see the events field.",
+					Type:         graphql1.String,
+				}},
+				DeprecationReason: "",
+				Description:       "Counts of the events of the environment, grouped by various attributes.",
+				Name:              "eventAggregates",
+				Type:              graphql1.NewNonNull(graphql.OutputType("EventAggregates")),
+			},
+			"eventRetention": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The number of days resolved events of the environment are kept, or 0 if they\nare kept indefinitely.",
+				Name:              "eventRetention",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
 			"events": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "After returns the records following the given cursor.",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "Before returns the records preceding the given cursor.",
+						Type:        graphql1.String,
+					},
 					"filter": &graphql1.ArgumentConfig{
 						DefaultValue: "",
-						Description:  "Filter reduces the set using the given Sensu Query Expression predicate.",
+						Description:  "Filter reduces the set using the given Sensu Query Expression predicate, or\nthe given whitespace separated filter statements, all of which must match:\nstatus:<status|incident>, silenced:<true|false>, check:<name>,\nsubscription:<name> and label:<key>=<value> or label:<key>!=<value>.",
 						Type:         graphql1.String,
 					},
+					"first": &graphql1.ArgumentConfig{
+						Description: "First limits the page to the first n records, after the 'after' cursor.",
+						Type:        graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						Description: "Last limits the page to the last n records, before the 'before' cursor.",
+						Type:        graphql1.Int,
+					},
 					"limit": &graphql1.ArgumentConfig{
 						DefaultValue: 10,
 						Description:  "Limit adds optional limit to the number of entries returned.",
@@ -613,6 +942,50 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 				Name:              "events",
 				Type:              graphql1.NewNonNull(graphql.OutputType("EventConnection")),
 			},
+			"handlers": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "After returns the records following the given cursor.",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "Before returns the records preceding the given cursor.",
+						Type:        graphql1.String,
+					},
+					"filter": &graphql1.ArgumentConfig{
+						DefaultValue: "",
+						Description:  "Filter reduces the set using the given Sensu Query Expression predicate.",
+						Type:         graphql1.String,
+					},
+					"first": &graphql1.ArgumentConfig{
+						Description: "First limits the page to the first n records, after the 'after' cursor.",
+						Type:        graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						Description: "Last limits the page to the last n records, before the 'before' cursor.",
+						Type:        graphql1.Int,
+					},
+					"limit": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "Limit adds optional limit to the number of entries returned.",
+						Type:         graphql1.Int,
+					},
+					"offset": &graphql1.ArgumentConfig{
+						DefaultValue: 0,
+						Description:  "self descriptive",
+						Type:         graphql1.Int,
+					},
+					"orderBy": &graphql1.ArgumentConfig{
+						DefaultValue: "NAME",
+						Description:  "OrderBy adds optional order to the records retrieved.",
+						Type:         graphql.InputType("HandlerListOrder"),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "All handlers associated with the environment.",
+				Name:              "handlers",
+				Type:              graphql1.NewNonNull(graphql.OutputType("HandlerConnection")),
+			},
 			"id": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -634,13 +1007,53 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 				Name:              "organization",
 				Type:              graphql1.NewNonNull(graphql.OutputType("Organization")),
 			},
+			"search": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"limit": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "Limit adds optional limit to the number of results returned.",
+						Type:         graphql1.Int,
+					},
+					"query": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.NewNonNull(graphql1.String),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "Search returns the events, entities and checks of the environment matching\nall of the whitespace separated terms of the given query, most relevant\nfirst. Terms are matched against entity and check names, event output and\nlabels; terms of the form key=value match labels exactly.",
+				Name:              "search",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("SearchResult")))),
+			},
+			"services": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "All business services of the environment.",
+				Name:              "services",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Service")))),
+			},
 			"silences": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "After returns the records following the given cursor.",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "Before returns the records preceding the given cursor.",
+						Type:        graphql1.String,
+					},
 					"filter": &graphql1.ArgumentConfig{
 						DefaultValue: "",
 						Description:  "Filter reduces the set using the given Sensu Query Expression predicate.",
 						Type:         graphql1.String,
 					},
+					"first": &graphql1.ArgumentConfig{
+						Description: "First limits the page to the first n records, after the 'after' cursor.",
+						Type:        graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						Description: "Last limits the page to the last n records, before the 'before' cursor.",
+						Type:        graphql1.Int,
+					},
 					"limit": &graphql1.ArgumentConfig{
 						DefaultValue: 10,
 						Description:  "Limit adds optional limit to the number of entries returned.",
@@ -699,17 +1112,23 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeEnvironmentDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeEnvironmentConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"checkHistory":  _ObjTypeEnvironmentCheckHistoryHandler,
-		"checks":        _ObjTypeEnvironmentChecksHandler,
-		"colourId":      _ObjTypeEnvironmentColourIDHandler,
-		"description":   _ObjTypeEnvironmentDescriptionHandler,
-		"entities":      _ObjTypeEnvironmentEntitiesHandler,
-		"events":        _ObjTypeEnvironmentEventsHandler,
-		"id":            _ObjTypeEnvironmentIDHandler,
-		"name":          _ObjTypeEnvironmentNameHandler,
-		"organization":  _ObjTypeEnvironmentOrganizationHandler,
-		"silences":      _ObjTypeEnvironmentSilencesHandler,
-		"subscriptions": _ObjTypeEnvironmentSubscriptionsHandler,
+		"auditEntries":    _ObjTypeEnvironmentAuditEntriesHandler,
+		"checkHistory":    _ObjTypeEnvironmentCheckHistoryHandler,
+		"checks":          _ObjTypeEnvironmentChecksHandler,
+		"colourId":        _ObjTypeEnvironmentColourIDHandler,
+		"description":     _ObjTypeEnvironmentDescriptionHandler,
+		"entities":        _ObjTypeEnvironmentEntitiesHandler,
+		"eventAggregates": _ObjTypeEnvironmentEventAggregatesHandler,
+		"eventRetention":  _ObjTypeEnvironmentEventRetentionHandler,
+		"events":          _ObjTypeEnvironmentEventsHandler,
+		"handlers":        _ObjTypeEnvironmentHandlersHandler,
+		"id":              _ObjTypeEnvironmentIDHandler,
+		"name":            _ObjTypeEnvironmentNameHandler,
+		"organization":    _ObjTypeEnvironmentOrganizationHandler,
+		"search":          _ObjTypeEnvironmentSearchHandler,
+		"services":        _ObjTypeEnvironmentServicesHandler,
+		"silences":        _ObjTypeEnvironmentSilencesHandler,
+		"subscriptions":   _ObjTypeEnvironmentSubscriptionsHandler,
 	},
 }
 
@@ -917,6 +1336,51 @@ type _EnumTypeEventsListOrderValues struct {
 	SEVERITY EventsListOrder
 }
 
+// HandlerListOrder self descriptive
+type HandlerListOrder string
+
+// HandlerListOrders holds enum values
+var HandlerListOrders = _EnumTypeHandlerListOrderValues{
+	NAME:      "NAME",
+	NAME_DESC: "NAME_DESC",
+}
+
+// HandlerListOrderType self descriptive
+var HandlerListOrderType = graphql.NewType("HandlerListOrder", graphql.EnumKind)
+
+// RegisterHandlerListOrder registers HandlerListOrder object type with given service.
+func RegisterHandlerListOrder(svc *graphql.Service) {
+	svc.RegisterEnum(_EnumTypeHandlerListOrderDesc)
+}
+func _EnumTypeHandlerListOrderConfigFn() graphql1.EnumConfig {
+	return graphql1.EnumConfig{
+		Description: "self descriptive",
+		Name:        "HandlerListOrder",
+		Values: graphql1.EnumValueConfigMap{
+			"NAME": &graphql1.EnumValueConfig{
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Value:             "NAME",
+			},
+			"NAME_DESC": &graphql1.EnumValueConfig{
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Value:             "NAME_DESC",
+			},
+		},
+	}
+}
+
+// describe HandlerListOrder's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _EnumTypeHandlerListOrderDesc = graphql.EnumDesc{Config: _EnumTypeHandlerListOrderConfigFn}
+
+type _EnumTypeHandlerListOrderValues struct {
+	// NAME - self descriptive
+	NAME HandlerListOrder
+	// NAME_DESC - self descriptive
+	NAME_DESC HandlerListOrder
+}
+
 // SilencesListOrder self descriptive
 type SilencesListOrder string
 
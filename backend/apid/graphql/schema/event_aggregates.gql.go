@@ -0,0 +1,484 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// EventAggregatesTotalFieldResolver implement to resolve requests for the EventAggregates's total field.
+type EventAggregatesTotalFieldResolver interface {
+	// Total implements response to request for total field.
+	Total(p graphql.ResolveParams) (int, error)
+}
+
+// EventAggregatesByStatusFieldResolver implement to resolve requests for the EventAggregates's byStatus field.
+type EventAggregatesByStatusFieldResolver interface {
+	// ByStatus implements response to request for byStatus field.
+	ByStatus(p graphql.ResolveParams) (interface{}, error)
+}
+
+// EventAggregatesByCheckFieldResolver implement to resolve requests for the EventAggregates's byCheck field.
+type EventAggregatesByCheckFieldResolver interface {
+	// ByCheck implements response to request for byCheck field.
+	ByCheck(p graphql.ResolveParams) (interface{}, error)
+}
+
+// EventAggregatesByEntityClassFieldResolver implement to resolve requests for the EventAggregates's byEntityClass field.
+type EventAggregatesByEntityClassFieldResolver interface {
+	// ByEntityClass implements response to request for byEntityClass field.
+	ByEntityClass(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// EventAggregatesFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'EventAggregates' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type EventAggregatesFieldResolvers interface {
+	EventAggregatesTotalFieldResolver
+	EventAggregatesByStatusFieldResolver
+	EventAggregatesByCheckFieldResolver
+	EventAggregatesByEntityClassFieldResolver
+}
+
+// EventAggregatesAliases implements all methods on EventAggregatesFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type EventAggregatesAliases struct{}
+
+// Total implements response to request for 'total' field.
+func (_ EventAggregatesAliases) Total(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'total'")
+	}
+	return ret, err
+}
+
+// ByStatus implements response to request for 'byStatus' field.
+func (_ EventAggregatesAliases) ByStatus(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// ByCheck implements response to request for 'byCheck' field.
+func (_ EventAggregatesAliases) ByCheck(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// ByEntityClass implements response to request for 'byEntityClass' field.
+func (_ EventAggregatesAliases) ByEntityClass(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// EventAggregatesType EventAggregates describes counts of events grouped by various attributes.
+var EventAggregatesType = graphql.NewType("EventAggregates", graphql.ObjectKind)
+
+// RegisterEventAggregates registers EventAggregates object type with given service.
+func RegisterEventAggregates(svc *graphql.Service, impl EventAggregatesFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeEventAggregatesDesc, impl)
+}
+func _ObjTypeEventAggregatesTotalHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventAggregatesTotalFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Total(frp)
+	}
+}
+
+func _ObjTypeEventAggregatesByStatusHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventAggregatesByStatusFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ByStatus(frp)
+	}
+}
+
+func _ObjTypeEventAggregatesByCheckHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventAggregatesByCheckFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ByCheck(frp)
+	}
+}
+
+func _ObjTypeEventAggregatesByEntityClassHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventAggregatesByEntityClassFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ByEntityClass(frp)
+	}
+}
+
+func _ObjectTypeEventAggregatesConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "EventAggregates describes counts of events grouped by various attributes.",
+		Fields: graphql1.Fields{
+			"byCheck": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Number of events grouped by the name of their check.",
+				Name:              "byCheck",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("EventCount")))),
+			},
+			"byEntityClass": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Number of events grouped by the class of their entity, e.g. proxy.",
+				Name:              "byEntityClass",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("EventCount")))),
+			},
+			"byStatus": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Number of events grouped by the status of their check, e.g. 2.",
+				Name:              "byStatus",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("EventCount")))),
+			},
+			"total": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Total number of events.",
+				Name:              "total",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see EventAggregatesFieldResolvers.")
+		},
+		Name: "EventAggregates",
+	}
+}
+
+// describe EventAggregates's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeEventAggregatesDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeEventAggregatesConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"byCheck":       _ObjTypeEventAggregatesByCheckHandler,
+		"byEntityClass": _ObjTypeEventAggregatesByEntityClassHandler,
+		"byStatus":      _ObjTypeEventAggregatesByStatusHandler,
+		"total":         _ObjTypeEventAggregatesTotalHandler,
+	},
+}
+
+// EventCountKeyFieldResolver implement to resolve requests for the EventCount's key field.
+type EventCountKeyFieldResolver interface {
+	// Key implements response to request for key field.
+	Key(p graphql.ResolveParams) (string, error)
+}
+
+// EventCountCountFieldResolver implement to resolve requests for the EventCount's count field.
+type EventCountCountFieldResolver interface {
+	// Count implements response to request for count field.
+	Count(p graphql.ResolveParams) (int, error)
+}
+
+//
+// EventCountFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'EventCount' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type EventCountFieldResolvers interface {
+	EventCountKeyFieldResolver
+	EventCountCountFieldResolver
+}
+
+// EventCountAliases implements all methods on EventCountFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type EventCountAliases struct{}
+
+// Key implements response to request for 'key' field.
+func (_ EventCountAliases) Key(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'key'")
+	}
+	return ret, err
+}
+
+// Count implements response to request for 'count' field.
+func (_ EventCountAliases) Count(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'count'")
+	}
+	return ret, err
+}
+
+// EventCountType EventCount describes the number of events sharing a given value.
+var EventCountType = graphql.NewType("EventCount", graphql.ObjectKind)
+
+// RegisterEventCount registers EventCount object type with given service.
+func RegisterEventCount(svc *graphql.Service, impl EventCountFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeEventCountDesc, impl)
+}
+func _ObjTypeEventCountKeyHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventCountKeyFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Key(frp)
+	}
+}
+
+func _ObjTypeEventCountCountHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventCountCountFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Count(frp)
+	}
+}
+
+func _ObjectTypeEventCountConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "EventCount describes the number of events sharing a given value.",
+		Fields: graphql1.Fields{
+			"count": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The number of events.",
+				Name:              "count",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"key": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The value shared by the events.",
+				Name:              "key",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see EventCountFieldResolvers.")
+		},
+		Name: "EventCount",
+	}
+}
+
+// describe EventCount's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeEventCountDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeEventCountConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"count": _ObjTypeEventCountCountHandler,
+		"key":   _ObjTypeEventCountKeyHandler,
+	},
+}
@@ -0,0 +1,419 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// EventFilterIDFieldResolver implement to resolve requests for the EventFilter's id field.
+type EventFilterIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
+// EventFilterNamespaceFieldResolver implement to resolve requests for the EventFilter's namespace field.
+type EventFilterNamespaceFieldResolver interface {
+	// Namespace implements response to request for namespace field.
+	Namespace(p graphql.ResolveParams) (interface{}, error)
+}
+
+// EventFilterNameFieldResolver implement to resolve requests for the EventFilter's name field.
+type EventFilterNameFieldResolver interface {
+	// Name implements response to request for name field.
+	Name(p graphql.ResolveParams) (string, error)
+}
+
+// EventFilterActionFieldResolver implement to resolve requests for the EventFilter's action field.
+type EventFilterActionFieldResolver interface {
+	// Action implements response to request for action field.
+	Action(p graphql.ResolveParams) (string, error)
+}
+
+// EventFilterStatementsFieldResolver implement to resolve requests for the EventFilter's statements field.
+type EventFilterStatementsFieldResolver interface {
+	// Statements implements response to request for statements field.
+	Statements(p graphql.ResolveParams) ([]string, error)
+}
+
+// EventFilterTypeFieldResolver implement to resolve requests for the EventFilter's type field.
+type EventFilterTypeFieldResolver interface {
+	// Type implements response to request for type field.
+	Type(p graphql.ResolveParams) (string, error)
+}
+
+// EventFilterRuntimeAssetsFieldResolver implement to resolve requests for the EventFilter's runtimeAssets field.
+type EventFilterRuntimeAssetsFieldResolver interface {
+	// RuntimeAssets implements response to request for runtimeAssets field.
+	RuntimeAssets(p graphql.ResolveParams) ([]string, error)
+}
+
+// EventFilterWhenFieldResolver implement to resolve requests for the EventFilter's when field.
+type EventFilterWhenFieldResolver interface {
+	// When implements response to request for when field.
+	When(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// EventFilterFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'EventFilter' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type EventFilterFieldResolvers interface {
+	EventFilterIDFieldResolver
+	EventFilterNamespaceFieldResolver
+	EventFilterNameFieldResolver
+	EventFilterActionFieldResolver
+	EventFilterStatementsFieldResolver
+	EventFilterTypeFieldResolver
+	EventFilterRuntimeAssetsFieldResolver
+	EventFilterWhenFieldResolver
+}
+
+// EventFilterAliases implements all methods on EventFilterFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type EventFilterAliases struct{}
+
+// ID implements response to request for 'id' field.
+func (_ EventFilterAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (_ EventFilterAliases) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Name implements response to request for 'name' field.
+func (_ EventFilterAliases) Name(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'name'")
+	}
+	return ret, err
+}
+
+// Action implements response to request for 'action' field.
+func (_ EventFilterAliases) Action(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'action'")
+	}
+	return ret, err
+}
+
+// Statements implements response to request for 'statements' field.
+func (_ EventFilterAliases) Statements(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'statements'")
+	}
+	return ret, err
+}
+
+// Type implements response to request for 'type' field.
+func (_ EventFilterAliases) Type(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'type'")
+	}
+	return ret, err
+}
+
+// RuntimeAssets implements response to request for 'runtimeAssets' field.
+func (_ EventFilterAliases) RuntimeAssets(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'runtimeAssets'")
+	}
+	return ret, err
+}
+
+// When implements response to request for 'when' field.
+func (_ EventFilterAliases) When(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// EventFilterType An EventFilter is a filter specification.
+var EventFilterType = graphql.NewType("EventFilter", graphql.ObjectKind)
+
+// RegisterEventFilter registers EventFilter object type with given service.
+func RegisterEventFilter(svc *graphql.Service, impl EventFilterFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeEventFilterDesc, impl)
+}
+func _ObjTypeEventFilterIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
+func _ObjTypeEventFilterNamespaceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterNamespaceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Namespace(frp)
+	}
+}
+
+func _ObjTypeEventFilterNameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterNameFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Name(frp)
+	}
+}
+
+func _ObjTypeEventFilterActionHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterActionFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Action(frp)
+	}
+}
+
+func _ObjTypeEventFilterStatementsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterStatementsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Statements(frp)
+	}
+}
+
+func _ObjTypeEventFilterTypeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterTypeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Type(frp)
+	}
+}
+
+func _ObjTypeEventFilterRuntimeAssetsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterRuntimeAssetsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.RuntimeAssets(frp)
+	}
+}
+
+func _ObjTypeEventFilterWhenHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventFilterWhenFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.When(frp)
+	}
+}
+
+func _ObjectTypeEventFilterConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An EventFilter is a filter specification.",
+		Fields: graphql1.Fields{
+			"action": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Action specifies whether events matching the filter are allowed to continue\nthrough the pipeline or denied, i.e. allow.",
+				Name:              "action",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The globally unique identifier of the record.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
+			"name": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Name is the unique identifier for a filter.",
+				Name:              "name",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"namespace": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Namespace in which this record resides.",
+				Name:              "namespace",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
+			},
+			"runtimeAssets": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "RuntimeAssets are the assets providing libraries to javascript statements.",
+				Name:              "runtimeAssets",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+			"statements": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Statements is a list of boolean expressions, all of which must be true for\nan event to match the filter.",
+				Name:              "statements",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+			"type": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Type is the language of the statements of the filter, either govaluate or\njavascript.",
+				Name:              "type",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"when": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "When indicates the days and times during which the filter is in effect.",
+				Name:              "when",
+				Type:              graphql.OutputType("TimeWindowWhen"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see EventFilterFieldResolvers.")
+		},
+		Name: "EventFilter",
+	}
+}
+
+// describe EventFilter's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeEventFilterDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeEventFilterConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"action":        _ObjTypeEventFilterActionHandler,
+		"id":            _ObjTypeEventFilterIDHandler,
+		"name":          _ObjTypeEventFilterNameHandler,
+		"namespace":     _ObjTypeEventFilterNamespaceHandler,
+		"runtimeAssets": _ObjTypeEventFilterRuntimeAssetsHandler,
+		"statements":    _ObjTypeEventFilterStatementsHandler,
+		"type":          _ObjTypeEventFilterTypeHandler,
+		"when":          _ObjTypeEventFilterWhenHandler,
+	},
+}
@@ -50,6 +50,24 @@ type HandlerSocketFieldResolver interface {
 	Socket(p graphql.ResolveParams) (interface{}, error)
 }
 
+// HandlerSlackFieldResolver implement to resolve requests for the Handler's slack field.
+type HandlerSlackFieldResolver interface {
+	// Slack implements response to request for slack field.
+	Slack(p graphql.ResolveParams) (interface{}, error)
+}
+
+// HandlerPagerdutyFieldResolver implement to resolve requests for the Handler's pagerduty field.
+type HandlerPagerdutyFieldResolver interface {
+	// Pagerduty implements response to request for pagerduty field.
+	Pagerduty(p graphql.ResolveParams) (interface{}, error)
+}
+
+// HandlerEmailFieldResolver implement to resolve requests for the Handler's email field.
+type HandlerEmailFieldResolver interface {
+	// Email implements response to request for email field.
+	Email(p graphql.ResolveParams) (interface{}, error)
+}
+
 // HandlerMutatorFieldResolver implement to resolve requests for the Handler's mutator field.
 type HandlerMutatorFieldResolver interface {
 	// Mutator implements response to request for mutator field.
@@ -74,6 +92,12 @@ type HandlerEnvVarsFieldResolver interface {
 	EnvVars(p graphql.ResolveParams) ([]string, error)
 }
 
+// HandlerRuntimeAssetsFieldResolver implement to resolve requests for the Handler's runtimeAssets field.
+type HandlerRuntimeAssetsFieldResolver interface {
+	// RuntimeAssets implements response to request for runtimeAssets field.
+	RuntimeAssets(p graphql.ResolveParams) ([]string, error)
+}
+
 //
 // HandlerFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Handler' type.
@@ -143,10 +167,14 @@ type HandlerFieldResolvers interface {
 	HandlerCommandFieldResolver
 	HandlerTimeoutFieldResolver
 	HandlerSocketFieldResolver
+	HandlerSlackFieldResolver
+	HandlerPagerdutyFieldResolver
+	HandlerEmailFieldResolver
 	HandlerMutatorFieldResolver
 	HandlerHandlersFieldResolver
 	HandlerFiltersFieldResolver
 	HandlerEnvVarsFieldResolver
+	HandlerRuntimeAssetsFieldResolver
 }
 
 // HandlerAliases implements all methods on HandlerFieldResolvers interface by using reflection to
@@ -273,6 +301,24 @@ func (_ HandlerAliases) Socket(p graphql.ResolveParams) (interface{}, error) {
 	return val, err
 }
 
+// Slack implements response to request for 'slack' field.
+func (_ HandlerAliases) Slack(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Pagerduty implements response to request for 'pagerduty' field.
+func (_ HandlerAliases) Pagerduty(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Email implements response to request for 'email' field.
+func (_ HandlerAliases) Email(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Mutator implements response to request for 'mutator' field.
 func (_ HandlerAliases) Mutator(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -311,6 +357,19 @@ func (_ HandlerAliases) EnvVars(p graphql.ResolveParams) ([]string, error) {
 	return ret, err
 }
 
+// RuntimeAssets implements response to request for 'runtimeAssets' field.
+func (_ HandlerAliases) RuntimeAssets(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'runtimeAssets'")
+	}
+	return ret, err
+}
+
 // HandlerType A Handler is a handler specification.
 var HandlerType = graphql.NewType("Handler", graphql.ObjectKind)
 
@@ -367,6 +426,27 @@ func _ObjTypeHandlerSocketHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeHandlerSlackHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerSlackFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Slack(frp)
+	}
+}
+
+func _ObjTypeHandlerPagerdutyHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerPagerdutyFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Pagerduty(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Email(frp)
+	}
+}
+
 func _ObjTypeHandlerMutatorHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(HandlerMutatorFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -395,6 +475,13 @@ func _ObjTypeHandlerEnvVarsHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeHandlerRuntimeAssetsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerRuntimeAssetsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.RuntimeAssets(frp)
+	}
+}
+
 func _ObjectTypeHandlerConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "A Handler is a handler specification.",
@@ -406,6 +493,13 @@ func _ObjectTypeHandlerConfigFn() graphql1.ObjectConfig {
 				Name:              "command",
 				Type:              graphql1.String,
 			},
+			"email": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Email contains configuration for an email handler.",
+				Name:              "email",
+				Type:              graphql.OutputType("HandlerEmail"),
+			},
 			"envVars": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -455,6 +549,27 @@ func _ObjectTypeHandlerConfigFn() graphql1.ObjectConfig {
 				Name:              "namespace",
 				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
 			},
+			"pagerduty": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "PagerDuty contains configuration for a PagerDuty handler.",
+				Name:              "pagerduty",
+				Type:              graphql.OutputType("HandlerPagerDuty"),
+			},
+			"runtimeAssets": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "RuntimeAssets are a list of assets required to execute the handler command.",
+				Name:              "runtimeAssets",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+			"slack": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Slack contains configuration for a Slack handler.",
+				Name:              "slack",
+				Type:              graphql.OutputType("HandlerSlack"),
+			},
 			"socket": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -495,17 +610,21 @@ func _ObjectTypeHandlerConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeHandlerDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeHandlerConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"command":   _ObjTypeHandlerCommandHandler,
-		"envVars":   _ObjTypeHandlerEnvVarsHandler,
-		"filters":   _ObjTypeHandlerFiltersHandler,
-		"handlers":  _ObjTypeHandlerHandlersHandler,
-		"id":        _ObjTypeHandlerIDHandler,
-		"mutator":   _ObjTypeHandlerMutatorHandler,
-		"name":      _ObjTypeHandlerNameHandler,
-		"namespace": _ObjTypeHandlerNamespaceHandler,
-		"socket":    _ObjTypeHandlerSocketHandler,
-		"timeout":   _ObjTypeHandlerTimeoutHandler,
-		"type":      _ObjTypeHandlerTypeHandler,
+		"command":       _ObjTypeHandlerCommandHandler,
+		"email":         _ObjTypeHandlerEmailHandler,
+		"envVars":       _ObjTypeHandlerEnvVarsHandler,
+		"filters":       _ObjTypeHandlerFiltersHandler,
+		"handlers":      _ObjTypeHandlerHandlersHandler,
+		"id":            _ObjTypeHandlerIDHandler,
+		"mutator":       _ObjTypeHandlerMutatorHandler,
+		"name":          _ObjTypeHandlerNameHandler,
+		"namespace":     _ObjTypeHandlerNamespaceHandler,
+		"pagerduty":     _ObjTypeHandlerPagerdutyHandler,
+		"runtimeAssets": _ObjTypeHandlerRuntimeAssetsHandler,
+		"slack":         _ObjTypeHandlerSlackHandler,
+		"socket":        _ObjTypeHandlerSocketHandler,
+		"timeout":       _ObjTypeHandlerTimeoutHandler,
+		"type":          _ObjTypeHandlerTypeHandler,
 	},
 }
 
@@ -721,3 +840,1255 @@ var _ObjectTypeHandlerSocketDesc = graphql.ObjectDesc{
 		"port": _ObjTypeHandlerSocketPortHandler,
 	},
 }
+
+// HandlerSlackChannelFieldResolver implement to resolve requests for the HandlerSlack's channel field.
+type HandlerSlackChannelFieldResolver interface {
+	// Channel implements response to request for channel field.
+	Channel(p graphql.ResolveParams) (string, error)
+}
+
+// HandlerSlackUsernameFieldResolver implement to resolve requests for the HandlerSlack's username field.
+type HandlerSlackUsernameFieldResolver interface {
+	// Username implements response to request for username field.
+	Username(p graphql.ResolveParams) (string, error)
+}
+
+// HandlerSlackTemplateFieldResolver implement to resolve requests for the HandlerSlack's template field.
+type HandlerSlackTemplateFieldResolver interface {
+	// Template implements response to request for template field.
+	Template(p graphql.ResolveParams) (string, error)
+}
+
+//
+// HandlerSlackFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'HandlerSlack' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type HandlerSlackFieldResolvers interface {
+	HandlerSlackChannelFieldResolver
+	HandlerSlackUsernameFieldResolver
+	HandlerSlackTemplateFieldResolver
+}
+
+// HandlerSlackAliases implements all methods on HandlerSlackFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type HandlerSlackAliases struct{}
+
+// Channel implements response to request for 'channel' field.
+func (_ HandlerSlackAliases) Channel(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'channel'")
+	}
+	return ret, err
+}
+
+// Username implements response to request for 'username' field.
+func (_ HandlerSlackAliases) Username(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'username'")
+	}
+	return ret, err
+}
+
+// Template implements response to request for 'template' field.
+func (_ HandlerSlackAliases) Template(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'template'")
+	}
+	return ret, err
+}
+
+/*
+HandlerSlackType HandlerSlack contains configuration for a Slack handler. The webhook URL is
+omitted, as it is enough to post messages.
+*/
+var HandlerSlackType = graphql.NewType("HandlerSlack", graphql.ObjectKind)
+
+// RegisterHandlerSlack registers HandlerSlack object type with given service.
+func RegisterHandlerSlack(svc *graphql.Service, impl HandlerSlackFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeHandlerSlackDesc, impl)
+}
+func _ObjTypeHandlerSlackChannelHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerSlackChannelFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Channel(frp)
+	}
+}
+
+func _ObjTypeHandlerSlackUsernameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerSlackUsernameFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Username(frp)
+	}
+}
+
+func _ObjTypeHandlerSlackTemplateHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerSlackTemplateFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Template(frp)
+	}
+}
+
+func _ObjectTypeHandlerSlackConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "HandlerSlack contains configuration for a Slack handler. The webhook URL is\nomitted, as it is enough to post messages.",
+		Fields: graphql1.Fields{
+			"channel": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Channel overrides the channel of the webhook, e.g. #alerts.",
+				Name:              "channel",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"template": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Template is the Go template of the message, executed with the event.",
+				Name:              "template",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"username": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Username overrides the username of the webhook.",
+				Name:              "username",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see HandlerSlackFieldResolvers.")
+		},
+		Name: "HandlerSlack",
+	}
+}
+
+// describe HandlerSlack's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeHandlerSlackDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeHandlerSlackConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"channel":  _ObjTypeHandlerSlackChannelHandler,
+		"template": _ObjTypeHandlerSlackTemplateHandler,
+		"username": _ObjTypeHandlerSlackUsernameHandler,
+	},
+}
+
+// HandlerPagerDutySummaryTemplateFieldResolver implement to resolve requests for the HandlerPagerDuty's summaryTemplate field.
+type HandlerPagerDutySummaryTemplateFieldResolver interface {
+	// SummaryTemplate implements response to request for summaryTemplate field.
+	SummaryTemplate(p graphql.ResolveParams) (string, error)
+}
+
+//
+// HandlerPagerDutyFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'HandlerPagerDuty' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type HandlerPagerDutyFieldResolvers interface {
+	HandlerPagerDutySummaryTemplateFieldResolver
+}
+
+// HandlerPagerDutyAliases implements all methods on HandlerPagerDutyFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type HandlerPagerDutyAliases struct{}
+
+// SummaryTemplate implements response to request for 'summaryTemplate' field.
+func (_ HandlerPagerDutyAliases) SummaryTemplate(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'summaryTemplate'")
+	}
+	return ret, err
+}
+
+/*
+HandlerPagerDutyType HandlerPagerDuty contains configuration for a PagerDuty handler. The routing
+key is omitted, as it is enough to trigger incidents.
+*/
+var HandlerPagerDutyType = graphql.NewType("HandlerPagerDuty", graphql.ObjectKind)
+
+// RegisterHandlerPagerDuty registers HandlerPagerDuty object type with given service.
+func RegisterHandlerPagerDuty(svc *graphql.Service, impl HandlerPagerDutyFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeHandlerPagerDutyDesc, impl)
+}
+func _ObjTypeHandlerPagerDutySummaryTemplateHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerPagerDutySummaryTemplateFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.SummaryTemplate(frp)
+	}
+}
+
+func _ObjectTypeHandlerPagerDutyConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "HandlerPagerDuty contains configuration for a PagerDuty handler. The routing\nkey is omitted, as it is enough to trigger incidents.",
+		Fields: graphql1.Fields{"summaryTemplate": &graphql1.Field{
+			Args:              graphql1.FieldConfigArgument{},
+			DeprecationReason: "",
+			Description:       "SummaryTemplate is the Go template of the summary of incidents, executed with the event.",
+			Name:              "summaryTemplate",
+			Type:              graphql1.NewNonNull(graphql1.String),
+		}},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see HandlerPagerDutyFieldResolvers.")
+		},
+		Name: "HandlerPagerDuty",
+	}
+}
+
+// describe HandlerPagerDuty's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeHandlerPagerDutyDesc = graphql.ObjectDesc{
+	Config:        _ObjectTypeHandlerPagerDutyConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{"summaryTemplate": _ObjTypeHandlerPagerDutySummaryTemplateHandler},
+}
+
+// HandlerEmailHostFieldResolver implement to resolve requests for the HandlerEmail's host field.
+type HandlerEmailHostFieldResolver interface {
+	// Host implements response to request for host field.
+	Host(p graphql.ResolveParams) (string, error)
+}
+
+// HandlerEmailPortFieldResolver implement to resolve requests for the HandlerEmail's port field.
+type HandlerEmailPortFieldResolver interface {
+	// Port implements response to request for port field.
+	Port(p graphql.ResolveParams) (int, error)
+}
+
+// HandlerEmailFromFieldResolver implement to resolve requests for the HandlerEmail's from field.
+type HandlerEmailFromFieldResolver interface {
+	// From implements response to request for from field.
+	From(p graphql.ResolveParams) (string, error)
+}
+
+// HandlerEmailToFieldResolver implement to resolve requests for the HandlerEmail's to field.
+type HandlerEmailToFieldResolver interface {
+	// To implements response to request for to field.
+	To(p graphql.ResolveParams) ([]string, error)
+}
+
+// HandlerEmailSubjectTemplateFieldResolver implement to resolve requests for the HandlerEmail's subjectTemplate field.
+type HandlerEmailSubjectTemplateFieldResolver interface {
+	// SubjectTemplate implements response to request for subjectTemplate field.
+	SubjectTemplate(p graphql.ResolveParams) (string, error)
+}
+
+// HandlerEmailBodyTemplateFieldResolver implement to resolve requests for the HandlerEmail's bodyTemplate field.
+type HandlerEmailBodyTemplateFieldResolver interface {
+	// BodyTemplate implements response to request for bodyTemplate field.
+	BodyTemplate(p graphql.ResolveParams) (string, error)
+}
+
+// HandlerEmailTlsFieldResolver implement to resolve requests for the HandlerEmail's tls field.
+type HandlerEmailTlsFieldResolver interface {
+	// Tls implements response to request for tls field.
+	Tls(p graphql.ResolveParams) (bool, error)
+}
+
+//
+// HandlerEmailFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'HandlerEmail' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type HandlerEmailFieldResolvers interface {
+	HandlerEmailHostFieldResolver
+	HandlerEmailPortFieldResolver
+	HandlerEmailFromFieldResolver
+	HandlerEmailToFieldResolver
+	HandlerEmailSubjectTemplateFieldResolver
+	HandlerEmailBodyTemplateFieldResolver
+	HandlerEmailTlsFieldResolver
+}
+
+// HandlerEmailAliases implements all methods on HandlerEmailFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type HandlerEmailAliases struct{}
+
+// Host implements response to request for 'host' field.
+func (_ HandlerEmailAliases) Host(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'host'")
+	}
+	return ret, err
+}
+
+// Port implements response to request for 'port' field.
+func (_ HandlerEmailAliases) Port(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'port'")
+	}
+	return ret, err
+}
+
+// From implements response to request for 'from' field.
+func (_ HandlerEmailAliases) From(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'from'")
+	}
+	return ret, err
+}
+
+// To implements response to request for 'to' field.
+func (_ HandlerEmailAliases) To(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'to'")
+	}
+	return ret, err
+}
+
+// SubjectTemplate implements response to request for 'subjectTemplate' field.
+func (_ HandlerEmailAliases) SubjectTemplate(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'subjectTemplate'")
+	}
+	return ret, err
+}
+
+// BodyTemplate implements response to request for 'bodyTemplate' field.
+func (_ HandlerEmailAliases) BodyTemplate(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'bodyTemplate'")
+	}
+	return ret, err
+}
+
+// Tls implements response to request for 'tls' field.
+func (_ HandlerEmailAliases) Tls(p graphql.ResolveParams) (bool, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(bool)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'tls'")
+	}
+	return ret, err
+}
+
+/*
+HandlerEmailType HandlerEmail contains configuration for an email handler. The credentials of
+the SMTP server are omitted.
+*/
+var HandlerEmailType = graphql.NewType("HandlerEmail", graphql.ObjectKind)
+
+// RegisterHandlerEmail registers HandlerEmail object type with given service.
+func RegisterHandlerEmail(svc *graphql.Service, impl HandlerEmailFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeHandlerEmailDesc, impl)
+}
+func _ObjTypeHandlerEmailHostHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailHostFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Host(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailPortHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailPortFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Port(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailFromHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailFromFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.From(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailToHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailToFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.To(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailSubjectTemplateHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailSubjectTemplateFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.SubjectTemplate(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailBodyTemplateHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailBodyTemplateFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.BodyTemplate(frp)
+	}
+}
+
+func _ObjTypeHandlerEmailTlsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEmailTlsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Tls(frp)
+	}
+}
+
+func _ObjectTypeHandlerEmailConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "HandlerEmail contains configuration for an email handler. The credentials of\nthe SMTP server are omitted.",
+		Fields: graphql1.Fields{
+			"bodyTemplate": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "BodyTemplate is the Go template of the body of the emails, executed with the event.",
+				Name:              "bodyTemplate",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"from": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "From is the sender address of the emails.",
+				Name:              "from",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"host": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Host is the address of the SMTP server.",
+				Name:              "host",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"port": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Port is the port of the SMTP server, 25 by default.",
+				Name:              "port",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"subjectTemplate": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "SubjectTemplate is the Go template of the subject of the emails, executed with the event.",
+				Name:              "subjectTemplate",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"tls": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "TLS connects to the SMTP server over TLS, instead of with STARTTLS.",
+				Name:              "tls",
+				Type:              graphql1.NewNonNull(graphql1.Boolean),
+			},
+			"to": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "To is the list of the recipient addresses of the emails.",
+				Name:              "to",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see HandlerEmailFieldResolvers.")
+		},
+		Name: "HandlerEmail",
+	}
+}
+
+// describe HandlerEmail's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeHandlerEmailDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeHandlerEmailConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"bodyTemplate":    _ObjTypeHandlerEmailBodyTemplateHandler,
+		"from":            _ObjTypeHandlerEmailFromHandler,
+		"host":            _ObjTypeHandlerEmailHostHandler,
+		"port":            _ObjTypeHandlerEmailPortHandler,
+		"subjectTemplate": _ObjTypeHandlerEmailSubjectTemplateHandler,
+		"tls":             _ObjTypeHandlerEmailTlsHandler,
+		"to":              _ObjTypeHandlerEmailToHandler,
+	},
+}
+
+// HandlerConnectionNodesFieldResolver implement to resolve requests for the HandlerConnection's nodes field.
+type HandlerConnectionNodesFieldResolver interface {
+	// Nodes implements response to request for nodes field.
+	Nodes(p graphql.ResolveParams) (interface{}, error)
+}
+
+// HandlerConnectionEdgesFieldResolver implement to resolve requests for the HandlerConnection's edges field.
+type HandlerConnectionEdgesFieldResolver interface {
+	// Edges implements response to request for edges field.
+	Edges(p graphql.ResolveParams) (interface{}, error)
+}
+
+// HandlerConnectionPageInfoFieldResolver implement to resolve requests for the HandlerConnection's pageInfo field.
+type HandlerConnectionPageInfoFieldResolver interface {
+	// PageInfo implements response to request for pageInfo field.
+	PageInfo(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// HandlerConnectionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'HandlerConnection' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type HandlerConnectionFieldResolvers interface {
+	HandlerConnectionNodesFieldResolver
+	HandlerConnectionEdgesFieldResolver
+	HandlerConnectionPageInfoFieldResolver
+}
+
+// HandlerConnectionAliases implements all methods on HandlerConnectionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type HandlerConnectionAliases struct{}
+
+// Nodes implements response to request for 'nodes' field.
+func (_ HandlerConnectionAliases) Nodes(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Edges implements response to request for 'edges' field.
+func (_ HandlerConnectionAliases) Edges(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// PageInfo implements response to request for 'pageInfo' field.
+func (_ HandlerConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// HandlerConnectionType A connection to a sequence of records.
+var HandlerConnectionType = graphql.NewType("HandlerConnection", graphql.ObjectKind)
+
+// RegisterHandlerConnection registers HandlerConnection object type with given service.
+func RegisterHandlerConnection(svc *graphql.Service, impl HandlerConnectionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeHandlerConnectionDesc, impl)
+}
+func _ObjTypeHandlerConnectionNodesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerConnectionNodesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Nodes(frp)
+	}
+}
+
+func _ObjTypeHandlerConnectionEdgesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerConnectionEdgesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Edges(frp)
+	}
+}
+
+func _ObjTypeHandlerConnectionPageInfoHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerConnectionPageInfoFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.PageInfo(frp)
+	}
+}
+
+func _ObjectTypeHandlerConnectionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A connection to a sequence of records.",
+		Fields: graphql1.Fields{
+			"edges": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "edges",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("HandlerEdge")))),
+			},
+			"nodes": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "nodes",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Handler")))),
+			},
+			"pageInfo": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "pageInfo",
+				Type:              graphql1.NewNonNull(graphql.OutputType("OffsetPageInfo")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see HandlerConnectionFieldResolvers.")
+		},
+		Name: "HandlerConnection",
+	}
+}
+
+// describe HandlerConnection's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeHandlerConnectionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeHandlerConnectionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"edges":    _ObjTypeHandlerConnectionEdgesHandler,
+		"nodes":    _ObjTypeHandlerConnectionNodesHandler,
+		"pageInfo": _ObjTypeHandlerConnectionPageInfoHandler,
+	},
+}
+
+// HandlerEdgeNodeFieldResolver implement to resolve requests for the HandlerEdge's node field.
+type HandlerEdgeNodeFieldResolver interface {
+	// Node implements response to request for node field.
+	Node(p graphql.ResolveParams) (interface{}, error)
+}
+
+// HandlerEdgeCursorFieldResolver implement to resolve requests for the HandlerEdge's cursor field.
+type HandlerEdgeCursorFieldResolver interface {
+	// Cursor implements response to request for cursor field.
+	Cursor(p graphql.ResolveParams) (string, error)
+}
+
+//
+// HandlerEdgeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'HandlerEdge' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type HandlerEdgeFieldResolvers interface {
+	HandlerEdgeNodeFieldResolver
+	HandlerEdgeCursorFieldResolver
+}
+
+// HandlerEdgeAliases implements all methods on HandlerEdgeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type HandlerEdgeAliases struct{}
+
+// Node implements response to request for 'node' field.
+func (_ HandlerEdgeAliases) Node(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Cursor implements response to request for 'cursor' field.
+func (_ HandlerEdgeAliases) Cursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'cursor'")
+	}
+	return ret, err
+}
+
+// HandlerEdgeType An edge in a connection.
+var HandlerEdgeType = graphql.NewType("HandlerEdge", graphql.ObjectKind)
+
+// RegisterHandlerEdge registers HandlerEdge object type with given service.
+func RegisterHandlerEdge(svc *graphql.Service, impl HandlerEdgeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeHandlerEdgeDesc, impl)
+}
+func _ObjTypeHandlerEdgeNodeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEdgeNodeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Node(frp)
+	}
+}
+
+func _ObjTypeHandlerEdgeCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(HandlerEdgeCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Cursor(frp)
+	}
+}
+
+func _ObjectTypeHandlerEdgeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An edge in a connection.",
+		Fields: graphql1.Fields{
+			"cursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "cursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"node": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "node",
+				Type:              graphql.OutputType("Handler"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see HandlerEdgeFieldResolvers.")
+		},
+		Name: "HandlerEdge",
+	}
+}
+
+// describe HandlerEdge's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeHandlerEdgeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeHandlerEdgeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"cursor": _ObjTypeHandlerEdgeCursorHandler,
+		"node":   _ObjTypeHandlerEdgeNodeHandler,
+	},
+}
@@ -129,6 +129,40 @@ type MutationDeleteEventFieldResolver interface {
 	DeleteEvent(p MutationDeleteEventFieldResolverParams) (interface{}, error)
 }
 
+// MutationResolveEventsFieldResolverArgs contains arguments provided to resolveEvents when selected
+type MutationResolveEventsFieldResolverArgs struct {
+	Input *ResolveEventsInput // Input - self descriptive
+}
+
+// MutationResolveEventsFieldResolverParams contains contextual info to resolve resolveEvents field
+type MutationResolveEventsFieldResolverParams struct {
+	graphql.ResolveParams
+	Args MutationResolveEventsFieldResolverArgs
+}
+
+// MutationResolveEventsFieldResolver implement to resolve requests for the Mutation's resolveEvents field.
+type MutationResolveEventsFieldResolver interface {
+	// ResolveEvents implements response to request for resolveEvents field.
+	ResolveEvents(p MutationResolveEventsFieldResolverParams) (interface{}, error)
+}
+
+// MutationDeleteEventsFieldResolverArgs contains arguments provided to deleteEvents when selected
+type MutationDeleteEventsFieldResolverArgs struct {
+	Input *DeleteEventsInput // Input - self descriptive
+}
+
+// MutationDeleteEventsFieldResolverParams contains contextual info to resolve deleteEvents field
+type MutationDeleteEventsFieldResolverParams struct {
+	graphql.ResolveParams
+	Args MutationDeleteEventsFieldResolverArgs
+}
+
+// MutationDeleteEventsFieldResolver implement to resolve requests for the Mutation's deleteEvents field.
+type MutationDeleteEventsFieldResolver interface {
+	// DeleteEvents implements response to request for deleteEvents field.
+	DeleteEvents(p MutationDeleteEventsFieldResolverParams) (interface{}, error)
+}
+
 // MutationCreateSilenceFieldResolverArgs contains arguments provided to createSilence when selected
 type MutationCreateSilenceFieldResolverArgs struct {
 	Input *CreateSilenceInput // Input - self descriptive
@@ -163,6 +197,23 @@ type MutationDeleteSilenceFieldResolver interface {
 	DeleteSilence(p MutationDeleteSilenceFieldResolverParams) (interface{}, error)
 }
 
+// MutationClearSilencesForEntityFieldResolverArgs contains arguments provided to clearSilencesForEntity when selected
+type MutationClearSilencesForEntityFieldResolverArgs struct {
+	Input *ClearSilencesForEntityInput // Input - self descriptive
+}
+
+// MutationClearSilencesForEntityFieldResolverParams contains contextual info to resolve clearSilencesForEntity field
+type MutationClearSilencesForEntityFieldResolverParams struct {
+	graphql.ResolveParams
+	Args MutationClearSilencesForEntityFieldResolverArgs
+}
+
+// MutationClearSilencesForEntityFieldResolver implement to resolve requests for the Mutation's clearSilencesForEntity field.
+type MutationClearSilencesForEntityFieldResolver interface {
+	// ClearSilencesForEntity implements response to request for clearSilencesForEntity field.
+	ClearSilencesForEntity(p MutationClearSilencesForEntityFieldResolverParams) (interface{}, error)
+}
+
 //
 // MutationFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Mutation' type.
@@ -232,8 +283,11 @@ type MutationFieldResolvers interface {
 	MutationDeleteEntityFieldResolver
 	MutationResolveEventFieldResolver
 	MutationDeleteEventFieldResolver
+	MutationResolveEventsFieldResolver
+	MutationDeleteEventsFieldResolver
 	MutationCreateSilenceFieldResolver
 	MutationDeleteSilenceFieldResolver
+	MutationClearSilencesForEntityFieldResolver
 }
 
 // MutationAliases implements all methods on MutationFieldResolvers interface by using reflection to
@@ -325,6 +379,18 @@ func (_ MutationAliases) DeleteEvent(p MutationDeleteEventFieldResolverParams) (
 	return val, err
 }
 
+// ResolveEvents implements response to request for 'resolveEvents' field.
+func (_ MutationAliases) ResolveEvents(p MutationResolveEventsFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// DeleteEvents implements response to request for 'deleteEvents' field.
+func (_ MutationAliases) DeleteEvents(p MutationDeleteEventsFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // CreateSilence implements response to request for 'createSilence' field.
 func (_ MutationAliases) CreateSilence(p MutationCreateSilenceFieldResolverParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -337,6 +403,12 @@ func (_ MutationAliases) DeleteSilence(p MutationDeleteSilenceFieldResolverParam
 	return val, err
 }
 
+// ClearSilencesForEntity implements response to request for 'clearSilencesForEntity' field.
+func (_ MutationAliases) ClearSilencesForEntity(p MutationClearSilencesForEntityFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // MutationType The root query for implementing GraphQL mutations.
 var MutationType = graphql.NewType("Mutation", graphql.ObjectKind)
 
@@ -435,6 +507,32 @@ func _ObjTypeMutationDeleteEventHandler(impl interface{}) graphql1.FieldResolveF
 	}
 }
 
+func _ObjTypeMutationResolveEventsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(MutationResolveEventsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := MutationResolveEventsFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.ResolveEvents(frp)
+	}
+}
+
+func _ObjTypeMutationDeleteEventsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(MutationDeleteEventsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := MutationDeleteEventsFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.DeleteEvents(frp)
+	}
+}
+
 func _ObjTypeMutationCreateSilenceHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(MutationCreateSilenceFieldResolver)
 	return func(p graphql1.ResolveParams) (interface{}, error) {
@@ -461,10 +559,33 @@ func _ObjTypeMutationDeleteSilenceHandler(impl interface{}) graphql1.FieldResolv
 	}
 }
 
+func _ObjTypeMutationClearSilencesForEntityHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(MutationClearSilencesForEntityFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := MutationClearSilencesForEntityFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.ClearSilencesForEntity(frp)
+	}
+}
+
 func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "The root query for implementing GraphQL mutations.",
 		Fields: graphql1.Fields{
+			"clearSilencesForEntity": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql.InputType("ClearSilencesForEntityInput")),
+				}},
+				DeprecationReason: "",
+				Description:       "Removes the silences targeting the subscription of the given entity.",
+				Name:              "clearSilencesForEntity",
+				Type:              graphql.OutputType("ClearSilencesForEntityPayload"),
+			},
 			"createCheck": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
 					Description: "self descriptive",
@@ -515,6 +636,16 @@ func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 				Name:              "deleteEvent",
 				Type:              graphql.OutputType("DeleteRecordPayload"),
 			},
+			"deleteEvents": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql.InputType("DeleteEventsInput")),
+				}},
+				DeprecationReason: "",
+				Description:       "Deletes the given events.",
+				Name:              "deleteEvents",
+				Type:              graphql.OutputType("DeleteEventsPayload"),
+			},
 			"deleteSilence": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
 					Description: "self descriptive",
@@ -545,6 +676,16 @@ func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 				Name:              "resolveEvent",
 				Type:              graphql.OutputType("ResolveEventPayload"),
 			},
+			"resolveEvents": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql.InputType("ResolveEventsInput")),
+				}},
+				DeprecationReason: "",
+				Description:       "Resolves the given events.",
+				Name:              "resolveEvents",
+				Type:              graphql.OutputType("ResolveEventsPayload"),
+			},
 			"updateCheck": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
 					Description: "self descriptive",
@@ -573,15 +714,18 @@ func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeMutationDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeMutationConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"createCheck":   _ObjTypeMutationCreateCheckHandler,
-		"createSilence": _ObjTypeMutationCreateSilenceHandler,
-		"deleteCheck":   _ObjTypeMutationDeleteCheckHandler,
-		"deleteEntity":  _ObjTypeMutationDeleteEntityHandler,
-		"deleteEvent":   _ObjTypeMutationDeleteEventHandler,
-		"deleteSilence": _ObjTypeMutationDeleteSilenceHandler,
-		"executeCheck":  _ObjTypeMutationExecuteCheckHandler,
-		"resolveEvent":  _ObjTypeMutationResolveEventHandler,
-		"updateCheck":   _ObjTypeMutationUpdateCheckHandler,
+		"clearSilencesForEntity": _ObjTypeMutationClearSilencesForEntityHandler,
+		"createCheck":            _ObjTypeMutationCreateCheckHandler,
+		"createSilence":          _ObjTypeMutationCreateSilenceHandler,
+		"deleteCheck":            _ObjTypeMutationDeleteCheckHandler,
+		"deleteEntity":           _ObjTypeMutationDeleteEntityHandler,
+		"deleteEvent":            _ObjTypeMutationDeleteEventHandler,
+		"deleteEvents":           _ObjTypeMutationDeleteEventsHandler,
+		"deleteSilence":          _ObjTypeMutationDeleteSilenceHandler,
+		"executeCheck":           _ObjTypeMutationExecuteCheckHandler,
+		"resolveEvent":           _ObjTypeMutationResolveEventHandler,
+		"resolveEvents":          _ObjTypeMutationResolveEventsHandler,
+		"updateCheck":            _ObjTypeMutationUpdateCheckHandler,
 	},
 }
 
@@ -874,6 +1018,13 @@ type CheckConfigInputs struct {
 	Command string
 	// Interval - interval is the time interval, in seconds, in which the check should be run. Defaults to 60.
 	Interval int
+	// Timeout - timeout is the time to wait before the execution of the check is aborted.
+	Timeout interface{}
+	/*
+	   Ttl - ttl is the time after which the check is considered stale if no new result
+	   was received.
+	*/
+	Ttl interface{}
 	/*
 	   LowFlapThreshold - lowFlapThreshold is the flap detection low threshold (% state change) for
 	   the check. Sensu uses the same flap detection algorithm as Nagios.
@@ -892,6 +1043,8 @@ type CheckConfigInputs struct {
 	Publish bool
 	// Assets - Provide a list of valid assets that are required to execute the check.
 	Assets []string
+	// ExtendedAttributes - extendedAttributes store arbitrary JSON-encoded data.
+	ExtendedAttributes interface{}
 }
 
 // CheckConfigInputsType self descriptive
@@ -913,6 +1066,10 @@ func _InputTypeCheckConfigInputsConfigFn() graphql1.InputObjectConfig {
 				Description: "command to run.",
 				Type:        graphql1.String,
 			},
+			"extendedAttributes": &graphql1.InputObjectFieldConfig{
+				Description: "extendedAttributes store arbitrary JSON-encoded data.",
+				Type:        graphql.InputType("JSON"),
+			},
 			"handlers": &graphql1.InputObjectFieldConfig{
 				Description: "handlers are the event handler for the check (incidents and/or metrics).",
 				Type:        graphql1.NewList(graphql1.NewNonNull(graphql1.String)),
@@ -939,6 +1096,14 @@ func _InputTypeCheckConfigInputsConfigFn() graphql1.InputObjectConfig {
 				Description: "subscriptions refers to the list of subscribers for the check.",
 				Type:        graphql1.NewList(graphql1.NewNonNull(graphql1.String)),
 			},
+			"timeout": &graphql1.InputObjectFieldConfig{
+				Description: "timeout is the time to wait before the execution of the check is aborted.",
+				Type:        graphql.InputType("Duration"),
+			},
+			"ttl": &graphql1.InputObjectFieldConfig{
+				Description: "ttl is the time after which the check is considered stale if no new result\nwas received.",
+				Type:        graphql.InputType("Duration"),
+			},
 		},
 		Name: "CheckConfigInputs",
 	}
@@ -1954,130 +2119,63 @@ var _ObjectTypeResolveEventPayloadDesc = graphql.ObjectDesc{
 	},
 }
 
-// CreateSilenceInput self descriptive
-type CreateSilenceInput struct {
+// ResolveEventsInput self descriptive
+type ResolveEventsInput struct {
 	// ClientMutationID - A unique identifier for the client performing the mutation.
 	ClientMutationID string
-	// Ns - namespace the resulting resource will belong to.
-	Ns *NamespaceInput
-	// Check - check associated with the silenced entry; optional.
-	Check string
-	// Subscription - subscription associated with the silenced entry; optional.
-	Subscription string
-	// Props - properties of the silence
-	Props *SilenceInputs
+	// Ids - Global IDs of the events to resolve.
+	Ids []string
+	// Source - The source of the resolve request
+	Source string
 }
 
-// CreateSilenceInputType self descriptive
-var CreateSilenceInputType = graphql.NewType("CreateSilenceInput", graphql.InputKind)
+// ResolveEventsInputType self descriptive
+var ResolveEventsInputType = graphql.NewType("ResolveEventsInput", graphql.InputKind)
 
-// RegisterCreateSilenceInput registers CreateSilenceInput object type with given service.
-func RegisterCreateSilenceInput(svc *graphql.Service) {
-	svc.RegisterInput(_InputTypeCreateSilenceInputDesc)
+// RegisterResolveEventsInput registers ResolveEventsInput object type with given service.
+func RegisterResolveEventsInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeResolveEventsInputDesc)
 }
-func _InputTypeCreateSilenceInputConfigFn() graphql1.InputObjectConfig {
+func _InputTypeResolveEventsInputConfigFn() graphql1.InputObjectConfig {
 	return graphql1.InputObjectConfig{
 		Description: "self descriptive",
 		Fields: graphql1.InputObjectConfigFieldMap{
-			"check": &graphql1.InputObjectFieldConfig{
-				Description: "check associated with the silenced entry; optional.",
-				Type:        graphql1.String,
-			},
 			"clientMutationId": &graphql1.InputObjectFieldConfig{
 				Description: "A unique identifier for the client performing the mutation.",
 				Type:        graphql1.String,
 			},
-			"ns": &graphql1.InputObjectFieldConfig{
-				DefaultValue: map[string]interface{}{
-					"environment":  "default",
-					"organization": "default",
-				},
-				Description: "namespace the resulting resource will belong to.",
-				Type:        graphql.InputType("NamespaceInput"),
-			},
-			"props": &graphql1.InputObjectFieldConfig{
-				Description: "properties of the silence",
-				Type:        graphql1.NewNonNull(graphql.InputType("SilenceInputs")),
-			},
-			"subscription": &graphql1.InputObjectFieldConfig{
-				Description: "subscription associated with the silenced entry; optional.",
-				Type:        graphql1.String,
-			},
-		},
-		Name: "CreateSilenceInput",
-	}
-}
-
-// describe CreateSilenceInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
-var _InputTypeCreateSilenceInputDesc = graphql.InputDesc{Config: _InputTypeCreateSilenceInputConfigFn}
-
-// SilenceInputs self descriptive
-type SilenceInputs struct {
-	// Reason is used to provide context to the entry
-	Reason string
-	// Begin is a timestamp at which the silenced entry takes effect.
-	Begin time.Time
-	/*
-	   ExpireOnResolve defaults to false, clears the entry on resolution when set
-	   to true
-	*/
-	ExpireOnResolve bool
-	// Expire is the number of seconds the entry will live
-	Expire int
-}
-
-// SilenceInputsType self descriptive
-var SilenceInputsType = graphql.NewType("SilenceInputs", graphql.InputKind)
-
-// RegisterSilenceInputs registers SilenceInputs object type with given service.
-func RegisterSilenceInputs(svc *graphql.Service) {
-	svc.RegisterInput(_InputTypeSilenceInputsDesc)
-}
-func _InputTypeSilenceInputsConfigFn() graphql1.InputObjectConfig {
-	return graphql1.InputObjectConfig{
-		Description: "self descriptive",
-		Fields: graphql1.InputObjectConfigFieldMap{
-			"begin": &graphql1.InputObjectFieldConfig{
-				Description: "Begin is a timestamp at which the silenced entry takes effect.",
-				Type:        graphql1.DateTime,
-			},
-			"expire": &graphql1.InputObjectFieldConfig{
-				DefaultValue: -1,
-				Description:  "Expire is the number of seconds the entry will live",
-				Type:         graphql1.Int,
-			},
-			"expireOnResolve": &graphql1.InputObjectFieldConfig{
-				DefaultValue: true,
-				Description:  "ExpireOnResolve defaults to false, clears the entry on resolution when set\nto true",
-				Type:         graphql1.Boolean,
+			"ids": &graphql1.InputObjectFieldConfig{
+				Description: "Global IDs of the events to resolve.",
+				Type:        graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.ID))),
 			},
-			"reason": &graphql1.InputObjectFieldConfig{
-				Description: "Reason is used to provide context to the entry",
-				Type:        graphql1.String,
+			"source": &graphql1.InputObjectFieldConfig{
+				DefaultValue: "GraphQL",
+				Description:  "The source of the resolve request",
+				Type:         graphql1.String,
 			},
 		},
-		Name: "SilenceInputs",
+		Name: "ResolveEventsInput",
 	}
 }
 
-// describe SilenceInputs's configuration; kept private to avoid unintentional tampering of configuration at runtime.
-var _InputTypeSilenceInputsDesc = graphql.InputDesc{Config: _InputTypeSilenceInputsConfigFn}
+// describe ResolveEventsInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeResolveEventsInputDesc = graphql.InputDesc{Config: _InputTypeResolveEventsInputConfigFn}
 
-// CreateSilencePayloadClientMutationIDFieldResolver implement to resolve requests for the CreateSilencePayload's clientMutationId field.
-type CreateSilencePayloadClientMutationIDFieldResolver interface {
+// ResolveEventsPayloadClientMutationIDFieldResolver implement to resolve requests for the ResolveEventsPayload's clientMutationId field.
+type ResolveEventsPayloadClientMutationIDFieldResolver interface {
 	// ClientMutationID implements response to request for clientMutationId field.
 	ClientMutationID(p graphql.ResolveParams) (string, error)
 }
 
-// CreateSilencePayloadSilenceFieldResolver implement to resolve requests for the CreateSilencePayload's silence field.
-type CreateSilencePayloadSilenceFieldResolver interface {
-	// Silence implements response to request for silence field.
-	Silence(p graphql.ResolveParams) (interface{}, error)
+// ResolveEventsPayloadResultsFieldResolver implement to resolve requests for the ResolveEventsPayload's results field.
+type ResolveEventsPayloadResultsFieldResolver interface {
+	// Results implements response to request for results field.
+	Results(p graphql.ResolveParams) (interface{}, error)
 }
 
 //
-// CreateSilencePayloadFieldResolvers represents a collection of methods whose products represent the
-// response values of the 'CreateSilencePayload' type.
+// ResolveEventsPayloadFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ResolveEventsPayload' type.
 //
 // == Example SDL
 //
@@ -2136,12 +2234,12 @@ type CreateSilencePayloadSilenceFieldResolver interface {
 //     return ok
 //   }
 //
-type CreateSilencePayloadFieldResolvers interface {
-	CreateSilencePayloadClientMutationIDFieldResolver
-	CreateSilencePayloadSilenceFieldResolver
+type ResolveEventsPayloadFieldResolvers interface {
+	ResolveEventsPayloadClientMutationIDFieldResolver
+	ResolveEventsPayloadResultsFieldResolver
 }
 
-// CreateSilencePayloadAliases implements all methods on CreateSilencePayloadFieldResolvers interface by using reflection to
+// ResolveEventsPayloadAliases implements all methods on ResolveEventsPayloadFieldResolvers interface by using reflection to
 // match name of field to a field on the given value. Intent is reduce friction
 // of writing new resolvers by removing all the instances where you would simply
 // have the resolvers method return a field.
@@ -2186,10 +2284,10 @@ type CreateSilencePayloadFieldResolvers interface {
 //     return r.BreedsById(dog.BreedIDs)
 //   }
 //
-type CreateSilencePayloadAliases struct{}
+type ResolveEventsPayloadAliases struct{}
 
 // ClientMutationID implements response to request for 'clientMutationId' field.
-func (_ CreateSilencePayloadAliases) ClientMutationID(p graphql.ResolveParams) (string, error) {
+func (_ ResolveEventsPayloadAliases) ClientMutationID(p graphql.ResolveParams) (string, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
 	ret, ok := val.(string)
 	if err != nil {
@@ -2201,34 +2299,34 @@ func (_ CreateSilencePayloadAliases) ClientMutationID(p graphql.ResolveParams) (
 	return ret, err
 }
 
-// Silence implements response to request for 'silence' field.
-func (_ CreateSilencePayloadAliases) Silence(p graphql.ResolveParams) (interface{}, error) {
+// Results implements response to request for 'results' field.
+func (_ ResolveEventsPayloadAliases) Results(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
 	return val, err
 }
 
-// CreateSilencePayloadType self descriptive
-var CreateSilencePayloadType = graphql.NewType("CreateSilencePayload", graphql.ObjectKind)
+// ResolveEventsPayloadType self descriptive
+var ResolveEventsPayloadType = graphql.NewType("ResolveEventsPayload", graphql.ObjectKind)
 
-// RegisterCreateSilencePayload registers CreateSilencePayload object type with given service.
-func RegisterCreateSilencePayload(svc *graphql.Service, impl CreateSilencePayloadFieldResolvers) {
-	svc.RegisterObject(_ObjectTypeCreateSilencePayloadDesc, impl)
+// RegisterResolveEventsPayload registers ResolveEventsPayload object type with given service.
+func RegisterResolveEventsPayload(svc *graphql.Service, impl ResolveEventsPayloadFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeResolveEventsPayloadDesc, impl)
 }
-func _ObjTypeCreateSilencePayloadClientMutationIDHandler(impl interface{}) graphql1.FieldResolveFn {
-	resolver := impl.(CreateSilencePayloadClientMutationIDFieldResolver)
+func _ObjTypeResolveEventsPayloadClientMutationIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ResolveEventsPayloadClientMutationIDFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
 		return resolver.ClientMutationID(frp)
 	}
 }
 
-func _ObjTypeCreateSilencePayloadSilenceHandler(impl interface{}) graphql1.FieldResolveFn {
-	resolver := impl.(CreateSilencePayloadSilenceFieldResolver)
+func _ObjTypeResolveEventsPayloadResultsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ResolveEventsPayloadResultsFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
-		return resolver.Silence(frp)
+		return resolver.Results(frp)
 	}
 }
 
-func _ObjectTypeCreateSilencePayloadConfigFn() graphql1.ObjectConfig {
+func _ObjectTypeResolveEventsPayloadConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "self descriptive",
 		Fields: graphql1.Fields{
@@ -2239,12 +2337,12 @@ func _ObjectTypeCreateSilencePayloadConfigFn() graphql1.ObjectConfig {
 				Name:              "clientMutationId",
 				Type:              graphql1.String,
 			},
-			"silence": &graphql1.Field{
+			"results": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
-				Description:       "The newly created silence.",
-				Name:              "silence",
-				Type:              graphql1.NewNonNull(graphql.OutputType("Silenced")),
+				Description:       "The result of resolving each of the given events, in the order given.",
+				Name:              "results",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("ResolveEventResult")))),
 			},
 		},
 		Interfaces: []*graphql1.Interface{},
@@ -2254,17 +2352,1305 @@ func _ObjectTypeCreateSilencePayloadConfigFn() graphql1.ObjectConfig {
 			// these fields are updated with instantiated resolvers. If these
 			// defaults are called it is most certainly programmer err.
 			// If you're see this comment then: 'Whoops! Sorry, my bad.'
-			panic("Unimplemented; see CreateSilencePayloadFieldResolvers.")
+			panic("Unimplemented; see ResolveEventsPayloadFieldResolvers.")
 		},
-		Name: "CreateSilencePayload",
+		Name: "ResolveEventsPayload",
 	}
 }
 
-// describe CreateSilencePayload's configuration; kept private to avoid unintentional tampering of configuration at runtime.
-var _ObjectTypeCreateSilencePayloadDesc = graphql.ObjectDesc{
-	Config: _ObjectTypeCreateSilencePayloadConfigFn,
+// describe ResolveEventsPayload's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeResolveEventsPayloadDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeResolveEventsPayloadConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"clientMutationId": _ObjTypeCreateSilencePayloadClientMutationIDHandler,
-		"silence":          _ObjTypeCreateSilencePayloadSilenceHandler,
+		"clientMutationId": _ObjTypeResolveEventsPayloadClientMutationIDHandler,
+		"results":          _ObjTypeResolveEventsPayloadResultsHandler,
+	},
+}
+
+// ResolveEventResultIDFieldResolver implement to resolve requests for the ResolveEventResult's id field.
+type ResolveEventResultIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
+// ResolveEventResultEventFieldResolver implement to resolve requests for the ResolveEventResult's event field.
+type ResolveEventResultEventFieldResolver interface {
+	// Event implements response to request for event field.
+	Event(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ResolveEventResultErrorsFieldResolver implement to resolve requests for the ResolveEventResult's errors field.
+type ResolveEventResultErrorsFieldResolver interface {
+	// Errors implements response to request for errors field.
+	Errors(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// ResolveEventResultFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ResolveEventResult' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ResolveEventResultFieldResolvers interface {
+	ResolveEventResultIDFieldResolver
+	ResolveEventResultEventFieldResolver
+	ResolveEventResultErrorsFieldResolver
+}
+
+// ResolveEventResultAliases implements all methods on ResolveEventResultFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ResolveEventResultAliases struct{}
+
+// ID implements response to request for 'id' field.
+func (_ ResolveEventResultAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
+// Event implements response to request for 'event' field.
+func (_ ResolveEventResultAliases) Event(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Errors implements response to request for 'errors' field.
+func (_ ResolveEventResultAliases) Errors(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// ResolveEventResultType self descriptive
+var ResolveEventResultType = graphql.NewType("ResolveEventResult", graphql.ObjectKind)
+
+// RegisterResolveEventResult registers ResolveEventResult object type with given service.
+func RegisterResolveEventResult(svc *graphql.Service, impl ResolveEventResultFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeResolveEventResultDesc, impl)
+}
+func _ObjTypeResolveEventResultIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ResolveEventResultIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
+func _ObjTypeResolveEventResultEventHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ResolveEventResultEventFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Event(frp)
+	}
+}
+
+func _ObjTypeResolveEventResultErrorsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ResolveEventResultErrorsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Errors(frp)
+	}
+}
+
+func _ObjectTypeResolveEventResultConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.Fields{
+			"errors": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Includes any failed preconditions or unrecoverable errors that occurred while\nresolving the event.",
+				Name:              "errors",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Error")))),
+			},
+			"event": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The event that was resolved; null if it could not be resolved.",
+				Name:              "event",
+				Type:              graphql.OutputType("Event"),
+			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Global ID of the event.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ResolveEventResultFieldResolvers.")
+		},
+		Name: "ResolveEventResult",
+	}
+}
+
+// describe ResolveEventResult's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeResolveEventResultDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeResolveEventResultConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"errors": _ObjTypeResolveEventResultErrorsHandler,
+		"event":  _ObjTypeResolveEventResultEventHandler,
+		"id":     _ObjTypeResolveEventResultIDHandler,
+	},
+}
+
+// DeleteEventsInput self descriptive
+type DeleteEventsInput struct {
+	// ClientMutationID - A unique identifier for the client performing the mutation.
+	ClientMutationID string
+	// Ids - Global IDs of the events to delete.
+	Ids []string
+}
+
+// DeleteEventsInputType self descriptive
+var DeleteEventsInputType = graphql.NewType("DeleteEventsInput", graphql.InputKind)
+
+// RegisterDeleteEventsInput registers DeleteEventsInput object type with given service.
+func RegisterDeleteEventsInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeDeleteEventsInputDesc)
+}
+func _InputTypeDeleteEventsInputConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"clientMutationId": &graphql1.InputObjectFieldConfig{
+				Description: "A unique identifier for the client performing the mutation.",
+				Type:        graphql1.String,
+			},
+			"ids": &graphql1.InputObjectFieldConfig{
+				Description: "Global IDs of the events to delete.",
+				Type:        graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.ID))),
+			},
+		},
+		Name: "DeleteEventsInput",
+	}
+}
+
+// describe DeleteEventsInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeDeleteEventsInputDesc = graphql.InputDesc{Config: _InputTypeDeleteEventsInputConfigFn}
+
+// DeleteEventsPayloadClientMutationIDFieldResolver implement to resolve requests for the DeleteEventsPayload's clientMutationId field.
+type DeleteEventsPayloadClientMutationIDFieldResolver interface {
+	// ClientMutationID implements response to request for clientMutationId field.
+	ClientMutationID(p graphql.ResolveParams) (string, error)
+}
+
+// DeleteEventsPayloadResultsFieldResolver implement to resolve requests for the DeleteEventsPayload's results field.
+type DeleteEventsPayloadResultsFieldResolver interface {
+	// Results implements response to request for results field.
+	Results(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// DeleteEventsPayloadFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'DeleteEventsPayload' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type DeleteEventsPayloadFieldResolvers interface {
+	DeleteEventsPayloadClientMutationIDFieldResolver
+	DeleteEventsPayloadResultsFieldResolver
+}
+
+// DeleteEventsPayloadAliases implements all methods on DeleteEventsPayloadFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type DeleteEventsPayloadAliases struct{}
+
+// ClientMutationID implements response to request for 'clientMutationId' field.
+func (_ DeleteEventsPayloadAliases) ClientMutationID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'clientMutationId'")
+	}
+	return ret, err
+}
+
+// Results implements response to request for 'results' field.
+func (_ DeleteEventsPayloadAliases) Results(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// DeleteEventsPayloadType self descriptive
+var DeleteEventsPayloadType = graphql.NewType("DeleteEventsPayload", graphql.ObjectKind)
+
+// RegisterDeleteEventsPayload registers DeleteEventsPayload object type with given service.
+func RegisterDeleteEventsPayload(svc *graphql.Service, impl DeleteEventsPayloadFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeDeleteEventsPayloadDesc, impl)
+}
+func _ObjTypeDeleteEventsPayloadClientMutationIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(DeleteEventsPayloadClientMutationIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ClientMutationID(frp)
+	}
+}
+
+func _ObjTypeDeleteEventsPayloadResultsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(DeleteEventsPayloadResultsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Results(frp)
+	}
+}
+
+func _ObjectTypeDeleteEventsPayloadConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.Fields{
+			"clientMutationId": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "A unique identifier for the client performing the mutation.",
+				Name:              "clientMutationId",
+				Type:              graphql1.String,
+			},
+			"results": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The result of deleting each of the given events, in the order given.",
+				Name:              "results",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("DeleteEventResult")))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see DeleteEventsPayloadFieldResolvers.")
+		},
+		Name: "DeleteEventsPayload",
+	}
+}
+
+// describe DeleteEventsPayload's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeDeleteEventsPayloadDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeDeleteEventsPayloadConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"clientMutationId": _ObjTypeDeleteEventsPayloadClientMutationIDHandler,
+		"results":          _ObjTypeDeleteEventsPayloadResultsHandler,
+	},
+}
+
+// DeleteEventResultIDFieldResolver implement to resolve requests for the DeleteEventResult's id field.
+type DeleteEventResultIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
+// DeleteEventResultDeletedFieldResolver implement to resolve requests for the DeleteEventResult's deleted field.
+type DeleteEventResultDeletedFieldResolver interface {
+	// Deleted implements response to request for deleted field.
+	Deleted(p graphql.ResolveParams) (bool, error)
+}
+
+// DeleteEventResultErrorsFieldResolver implement to resolve requests for the DeleteEventResult's errors field.
+type DeleteEventResultErrorsFieldResolver interface {
+	// Errors implements response to request for errors field.
+	Errors(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// DeleteEventResultFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'DeleteEventResult' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type DeleteEventResultFieldResolvers interface {
+	DeleteEventResultIDFieldResolver
+	DeleteEventResultDeletedFieldResolver
+	DeleteEventResultErrorsFieldResolver
+}
+
+// DeleteEventResultAliases implements all methods on DeleteEventResultFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type DeleteEventResultAliases struct{}
+
+// ID implements response to request for 'id' field.
+func (_ DeleteEventResultAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
+// Deleted implements response to request for 'deleted' field.
+func (_ DeleteEventResultAliases) Deleted(p graphql.ResolveParams) (bool, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(bool)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'deleted'")
+	}
+	return ret, err
+}
+
+// Errors implements response to request for 'errors' field.
+func (_ DeleteEventResultAliases) Errors(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// DeleteEventResultType self descriptive
+var DeleteEventResultType = graphql.NewType("DeleteEventResult", graphql.ObjectKind)
+
+// RegisterDeleteEventResult registers DeleteEventResult object type with given service.
+func RegisterDeleteEventResult(svc *graphql.Service, impl DeleteEventResultFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeDeleteEventResultDesc, impl)
+}
+func _ObjTypeDeleteEventResultIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(DeleteEventResultIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
+func _ObjTypeDeleteEventResultDeletedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(DeleteEventResultDeletedFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Deleted(frp)
+	}
+}
+
+func _ObjTypeDeleteEventResultErrorsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(DeleteEventResultErrorsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Errors(frp)
+	}
+}
+
+func _ObjectTypeDeleteEventResultConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.Fields{
+			"deleted": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Whether the event was deleted.",
+				Name:              "deleted",
+				Type:              graphql1.NewNonNull(graphql1.Boolean),
+			},
+			"errors": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Includes any failed preconditions or unrecoverable errors that occurred while\ndeleting the event.",
+				Name:              "errors",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Error")))),
+			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Global ID of the event.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see DeleteEventResultFieldResolvers.")
+		},
+		Name: "DeleteEventResult",
+	}
+}
+
+// describe DeleteEventResult's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeDeleteEventResultDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeDeleteEventResultConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"deleted": _ObjTypeDeleteEventResultDeletedHandler,
+		"errors":  _ObjTypeDeleteEventResultErrorsHandler,
+		"id":      _ObjTypeDeleteEventResultIDHandler,
+	},
+}
+
+// CreateSilenceInput self descriptive
+type CreateSilenceInput struct {
+	// ClientMutationID - A unique identifier for the client performing the mutation.
+	ClientMutationID string
+	// Ns - namespace the resulting resource will belong to.
+	Ns *NamespaceInput
+	// Check - check associated with the silenced entry; optional.
+	Check string
+	// Subscription - subscription associated with the silenced entry; optional.
+	Subscription string
+	/*
+	   LabelSelector - labelSelector restricts the silenced entry to the events whose entity and
+	   check labels match the selector, e.g. "team=payments"; optional.
+	*/
+	LabelSelector string
+	// Props - properties of the silence
+	Props *SilenceInputs
+}
+
+// CreateSilenceInputType self descriptive
+var CreateSilenceInputType = graphql.NewType("CreateSilenceInput", graphql.InputKind)
+
+// RegisterCreateSilenceInput registers CreateSilenceInput object type with given service.
+func RegisterCreateSilenceInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeCreateSilenceInputDesc)
+}
+func _InputTypeCreateSilenceInputConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"check": &graphql1.InputObjectFieldConfig{
+				Description: "check associated with the silenced entry; optional.",
+				Type:        graphql1.String,
+			},
+			"clientMutationId": &graphql1.InputObjectFieldConfig{
+				Description: "A unique identifier for the client performing the mutation.",
+				Type:        graphql1.String,
+			},
+			"labelSelector": &graphql1.InputObjectFieldConfig{
+				Description: "labelSelector restricts the silenced entry to the events whose entity and\ncheck labels match the selector, e.g. \"team=payments\"; optional.",
+				Type:        graphql1.String,
+			},
+			"ns": &graphql1.InputObjectFieldConfig{
+				DefaultValue: map[string]interface{}{
+					"environment":  "default",
+					"organization": "default",
+				},
+				Description: "namespace the resulting resource will belong to.",
+				Type:        graphql.InputType("NamespaceInput"),
+			},
+			"props": &graphql1.InputObjectFieldConfig{
+				Description: "properties of the silence",
+				Type:        graphql1.NewNonNull(graphql.InputType("SilenceInputs")),
+			},
+			"subscription": &graphql1.InputObjectFieldConfig{
+				Description: "subscription associated with the silenced entry; optional.",
+				Type:        graphql1.String,
+			},
+		},
+		Name: "CreateSilenceInput",
+	}
+}
+
+// describe CreateSilenceInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeCreateSilenceInputDesc = graphql.InputDesc{Config: _InputTypeCreateSilenceInputConfigFn}
+
+// SilenceInputs self descriptive
+type SilenceInputs struct {
+	// Reason is used to provide context to the entry
+	Reason string
+	// Begin is a timestamp at which the silenced entry takes effect.
+	Begin time.Time
+	/*
+	   ExpireOnResolve defaults to false, clears the entry on resolution when set
+	   to true
+	*/
+	ExpireOnResolve bool
+	// Expire is the number of seconds the entry will live
+	Expire int
+}
+
+// SilenceInputsType self descriptive
+var SilenceInputsType = graphql.NewType("SilenceInputs", graphql.InputKind)
+
+// RegisterSilenceInputs registers SilenceInputs object type with given service.
+func RegisterSilenceInputs(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeSilenceInputsDesc)
+}
+func _InputTypeSilenceInputsConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"begin": &graphql1.InputObjectFieldConfig{
+				Description: "Begin is a timestamp at which the silenced entry takes effect.",
+				Type:        graphql1.DateTime,
+			},
+			"expire": &graphql1.InputObjectFieldConfig{
+				DefaultValue: -1,
+				Description:  "Expire is the number of seconds the entry will live",
+				Type:         graphql1.Int,
+			},
+			"expireOnResolve": &graphql1.InputObjectFieldConfig{
+				DefaultValue: true,
+				Description:  "ExpireOnResolve defaults to false, clears the entry on resolution when set\nto true",
+				Type:         graphql1.Boolean,
+			},
+			"reason": &graphql1.InputObjectFieldConfig{
+				Description: "Reason is used to provide context to the entry",
+				Type:        graphql1.String,
+			},
+		},
+		Name: "SilenceInputs",
+	}
+}
+
+// describe SilenceInputs's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeSilenceInputsDesc = graphql.InputDesc{Config: _InputTypeSilenceInputsConfigFn}
+
+// CreateSilencePayloadClientMutationIDFieldResolver implement to resolve requests for the CreateSilencePayload's clientMutationId field.
+type CreateSilencePayloadClientMutationIDFieldResolver interface {
+	// ClientMutationID implements response to request for clientMutationId field.
+	ClientMutationID(p graphql.ResolveParams) (string, error)
+}
+
+// CreateSilencePayloadSilenceFieldResolver implement to resolve requests for the CreateSilencePayload's silence field.
+type CreateSilencePayloadSilenceFieldResolver interface {
+	// Silence implements response to request for silence field.
+	Silence(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// CreateSilencePayloadFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'CreateSilencePayload' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type CreateSilencePayloadFieldResolvers interface {
+	CreateSilencePayloadClientMutationIDFieldResolver
+	CreateSilencePayloadSilenceFieldResolver
+}
+
+// CreateSilencePayloadAliases implements all methods on CreateSilencePayloadFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type CreateSilencePayloadAliases struct{}
+
+// ClientMutationID implements response to request for 'clientMutationId' field.
+func (_ CreateSilencePayloadAliases) ClientMutationID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'clientMutationId'")
+	}
+	return ret, err
+}
+
+// Silence implements response to request for 'silence' field.
+func (_ CreateSilencePayloadAliases) Silence(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// CreateSilencePayloadType self descriptive
+var CreateSilencePayloadType = graphql.NewType("CreateSilencePayload", graphql.ObjectKind)
+
+// RegisterCreateSilencePayload registers CreateSilencePayload object type with given service.
+func RegisterCreateSilencePayload(svc *graphql.Service, impl CreateSilencePayloadFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeCreateSilencePayloadDesc, impl)
+}
+func _ObjTypeCreateSilencePayloadClientMutationIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(CreateSilencePayloadClientMutationIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ClientMutationID(frp)
+	}
+}
+
+func _ObjTypeCreateSilencePayloadSilenceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(CreateSilencePayloadSilenceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Silence(frp)
+	}
+}
+
+func _ObjectTypeCreateSilencePayloadConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.Fields{
+			"clientMutationId": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "A unique identifier for the client performing the mutation.",
+				Name:              "clientMutationId",
+				Type:              graphql1.String,
+			},
+			"silence": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The newly created silence.",
+				Name:              "silence",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Silenced")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see CreateSilencePayloadFieldResolvers.")
+		},
+		Name: "CreateSilencePayload",
+	}
+}
+
+// describe CreateSilencePayload's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeCreateSilencePayloadDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeCreateSilencePayloadConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"clientMutationId": _ObjTypeCreateSilencePayloadClientMutationIDHandler,
+		"silence":          _ObjTypeCreateSilencePayloadSilenceHandler,
+	},
+}
+
+// ClearSilencesForEntityInput self descriptive
+type ClearSilencesForEntityInput struct {
+	// ClientMutationID - A unique identifier for the client performing the mutation.
+	ClientMutationID string
+	// ID - Global ID of the entity whose silences are removed.
+	ID string
+}
+
+// ClearSilencesForEntityInputType self descriptive
+var ClearSilencesForEntityInputType = graphql.NewType("ClearSilencesForEntityInput", graphql.InputKind)
+
+// RegisterClearSilencesForEntityInput registers ClearSilencesForEntityInput object type with given service.
+func RegisterClearSilencesForEntityInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeClearSilencesForEntityInputDesc)
+}
+func _InputTypeClearSilencesForEntityInputConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"clientMutationId": &graphql1.InputObjectFieldConfig{
+				Description: "A unique identifier for the client performing the mutation.",
+				Type:        graphql1.String,
+			},
+			"id": &graphql1.InputObjectFieldConfig{
+				Description: "Global ID of the entity whose silences are removed.",
+				Type:        graphql1.NewNonNull(graphql1.ID),
+			},
+		},
+		Name: "ClearSilencesForEntityInput",
+	}
+}
+
+// describe ClearSilencesForEntityInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeClearSilencesForEntityInputDesc = graphql.InputDesc{Config: _InputTypeClearSilencesForEntityInputConfigFn}
+
+// ClearSilencesForEntityPayloadClientMutationIDFieldResolver implement to resolve requests for the ClearSilencesForEntityPayload's clientMutationId field.
+type ClearSilencesForEntityPayloadClientMutationIDFieldResolver interface {
+	// ClientMutationID implements response to request for clientMutationId field.
+	ClientMutationID(p graphql.ResolveParams) (string, error)
+}
+
+// ClearSilencesForEntityPayloadDeletedIdsFieldResolver implement to resolve requests for the ClearSilencesForEntityPayload's deletedIds field.
+type ClearSilencesForEntityPayloadDeletedIdsFieldResolver interface {
+	// DeletedIds implements response to request for deletedIds field.
+	DeletedIds(p graphql.ResolveParams) ([]string, error)
+}
+
+//
+// ClearSilencesForEntityPayloadFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ClearSilencesForEntityPayload' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ClearSilencesForEntityPayloadFieldResolvers interface {
+	ClearSilencesForEntityPayloadClientMutationIDFieldResolver
+	ClearSilencesForEntityPayloadDeletedIdsFieldResolver
+}
+
+// ClearSilencesForEntityPayloadAliases implements all methods on ClearSilencesForEntityPayloadFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ClearSilencesForEntityPayloadAliases struct{}
+
+// ClientMutationID implements response to request for 'clientMutationId' field.
+func (_ ClearSilencesForEntityPayloadAliases) ClientMutationID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'clientMutationId'")
+	}
+	return ret, err
+}
+
+// DeletedIds implements response to request for 'deletedIds' field.
+func (_ ClearSilencesForEntityPayloadAliases) DeletedIds(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'deletedIds'")
+	}
+	return ret, err
+}
+
+// ClearSilencesForEntityPayloadType self descriptive
+var ClearSilencesForEntityPayloadType = graphql.NewType("ClearSilencesForEntityPayload", graphql.ObjectKind)
+
+// RegisterClearSilencesForEntityPayload registers ClearSilencesForEntityPayload object type with given service.
+func RegisterClearSilencesForEntityPayload(svc *graphql.Service, impl ClearSilencesForEntityPayloadFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeClearSilencesForEntityPayloadDesc, impl)
+}
+func _ObjTypeClearSilencesForEntityPayloadClientMutationIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ClearSilencesForEntityPayloadClientMutationIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ClientMutationID(frp)
+	}
+}
+
+func _ObjTypeClearSilencesForEntityPayloadDeletedIdsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ClearSilencesForEntityPayloadDeletedIdsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.DeletedIds(frp)
+	}
+}
+
+func _ObjectTypeClearSilencesForEntityPayloadConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.Fields{
+			"clientMutationId": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "A unique identifier for the client performing the mutation.",
+				Name:              "clientMutationId",
+				Type:              graphql1.String,
+			},
+			"deletedIds": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Global IDs of the removed silences.",
+				Name:              "deletedIds",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.ID))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ClearSilencesForEntityPayloadFieldResolvers.")
+		},
+		Name: "ClearSilencesForEntityPayload",
+	}
+}
+
+// describe ClearSilencesForEntityPayload's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeClearSilencesForEntityPayloadDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeClearSilencesForEntityPayloadConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"clientMutationId": _ObjTypeClearSilencesForEntityPayloadClientMutationIDHandler,
+		"deletedIds":       _ObjTypeClearSilencesForEntityPayloadDeletedIdsHandler,
 	},
 }
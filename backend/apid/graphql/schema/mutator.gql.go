@@ -44,6 +44,12 @@ type MutatorEnvVarsFieldResolver interface {
 	EnvVars(p graphql.ResolveParams) ([]string, error)
 }
 
+// MutatorRuntimeAssetsFieldResolver implement to resolve requests for the Mutator's runtimeAssets field.
+type MutatorRuntimeAssetsFieldResolver interface {
+	// RuntimeAssets implements response to request for runtimeAssets field.
+	RuntimeAssets(p graphql.ResolveParams) ([]string, error)
+}
+
 //
 // MutatorFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Mutator' type.
@@ -112,6 +118,7 @@ type MutatorFieldResolvers interface {
 	MutatorCommandFieldResolver
 	MutatorTimeoutFieldResolver
 	MutatorEnvVarsFieldResolver
+	MutatorRuntimeAssetsFieldResolver
 }
 
 // MutatorAliases implements all methods on MutatorFieldResolvers interface by using reflection to
@@ -232,6 +239,19 @@ func (_ MutatorAliases) EnvVars(p graphql.ResolveParams) ([]string, error) {
 	return ret, err
 }
 
+// RuntimeAssets implements response to request for 'runtimeAssets' field.
+func (_ MutatorAliases) RuntimeAssets(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'runtimeAssets'")
+	}
+	return ret, err
+}
+
 // MutatorType A Mutator is a mutator specification.
 var MutatorType = graphql.NewType("Mutator", graphql.ObjectKind)
 
@@ -281,6 +301,13 @@ func _ObjTypeMutatorEnvVarsHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeMutatorRuntimeAssetsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(MutatorRuntimeAssetsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.RuntimeAssets(frp)
+	}
+}
+
 func _ObjectTypeMutatorConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "A Mutator is a mutator specification.",
@@ -320,6 +347,13 @@ func _ObjectTypeMutatorConfigFn() graphql1.ObjectConfig {
 				Name:              "namespace",
 				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
 			},
+			"runtimeAssets": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "RuntimeAssets are a list of assets required to execute the mutator command.",
+				Name:              "runtimeAssets",
+				Type:              graphql1.NewList(graphql1.NewNonNull(graphql1.String)),
+			},
 			"timeout": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -346,11 +380,12 @@ func _ObjectTypeMutatorConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeMutatorDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeMutatorConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"command":   _ObjTypeMutatorCommandHandler,
-		"envVars":   _ObjTypeMutatorEnvVarsHandler,
-		"id":        _ObjTypeMutatorIDHandler,
-		"name":      _ObjTypeMutatorNameHandler,
-		"namespace": _ObjTypeMutatorNamespaceHandler,
-		"timeout":   _ObjTypeMutatorTimeoutHandler,
+		"command":       _ObjTypeMutatorCommandHandler,
+		"envVars":       _ObjTypeMutatorEnvVarsHandler,
+		"id":            _ObjTypeMutatorIDHandler,
+		"name":          _ObjTypeMutatorNameHandler,
+		"namespace":     _ObjTypeMutatorNamespaceHandler,
+		"runtimeAssets": _ObjTypeMutatorRuntimeAssetsHandler,
+		"timeout":       _ObjTypeMutatorTimeoutHandler,
 	},
 }
@@ -296,7 +296,8 @@ func _ObjectTypeOrganizationConfigFn() graphql1.ObjectConfig {
 				Type:              graphql1.NewNonNull(graphql1.String),
 			},
 		},
-		Interfaces: []*graphql1.Interface{},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
 		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
 			// NOTE:
 			// Panic by default. Intent is that when Service is invoked, values of
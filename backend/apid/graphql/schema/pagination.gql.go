@@ -38,6 +38,18 @@ type OffsetPageInfoTotalCountFieldResolver interface {
 	TotalCount(p graphql.ResolveParams) (int, error)
 }
 
+// OffsetPageInfoStartCursorFieldResolver implement to resolve requests for the OffsetPageInfo's startCursor field.
+type OffsetPageInfoStartCursorFieldResolver interface {
+	// StartCursor implements response to request for startCursor field.
+	StartCursor(p graphql.ResolveParams) (string, error)
+}
+
+// OffsetPageInfoEndCursorFieldResolver implement to resolve requests for the OffsetPageInfo's endCursor field.
+type OffsetPageInfoEndCursorFieldResolver interface {
+	// EndCursor implements response to request for endCursor field.
+	EndCursor(p graphql.ResolveParams) (string, error)
+}
+
 //
 // OffsetPageInfoFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'OffsetPageInfo' type.
@@ -105,6 +117,8 @@ type OffsetPageInfoFieldResolvers interface {
 	OffsetPageInfoNextOffsetFieldResolver
 	OffsetPageInfoPreviousOffsetFieldResolver
 	OffsetPageInfoTotalCountFieldResolver
+	OffsetPageInfoStartCursorFieldResolver
+	OffsetPageInfoEndCursorFieldResolver
 }
 
 // OffsetPageInfoAliases implements all methods on OffsetPageInfoFieldResolvers interface by using reflection to
@@ -219,6 +233,32 @@ func (_ OffsetPageInfoAliases) TotalCount(p graphql.ResolveParams) (int, error)
 	return ret, err
 }
 
+// StartCursor implements response to request for 'startCursor' field.
+func (_ OffsetPageInfoAliases) StartCursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'startCursor'")
+	}
+	return ret, err
+}
+
+// EndCursor implements response to request for 'endCursor' field.
+func (_ OffsetPageInfoAliases) EndCursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'endCursor'")
+	}
+	return ret, err
+}
+
 // OffsetPageInfoType Information about the current page.
 var OffsetPageInfoType = graphql.NewType("OffsetPageInfo", graphql.ObjectKind)
 
@@ -261,10 +301,31 @@ func _ObjTypeOffsetPageInfoTotalCountHandler(impl interface{}) graphql1.FieldRes
 	}
 }
 
+func _ObjTypeOffsetPageInfoStartCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(OffsetPageInfoStartCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.StartCursor(frp)
+	}
+}
+
+func _ObjTypeOffsetPageInfoEndCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(OffsetPageInfoEndCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.EndCursor(frp)
+	}
+}
+
 func _ObjectTypeOffsetPageInfoConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Information about the current page.",
 		Fields: graphql1.Fields{
+			"endCursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Cursor of the last item of the page; empty if the page has no items.",
+				Name:              "endCursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
 			"hasNextPage": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -293,6 +354,13 @@ func _ObjectTypeOffsetPageInfoConfigFn() graphql1.ObjectConfig {
 				Name:              "previousOffset",
 				Type:              graphql1.Int,
 			},
+			"startCursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Cursor of the first item of the page; empty if the page has no items.",
+				Name:              "startCursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
 			"totalCount": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -318,10 +386,12 @@ func _ObjectTypeOffsetPageInfoConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeOffsetPageInfoDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeOffsetPageInfoConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
+		"endCursor":       _ObjTypeOffsetPageInfoEndCursorHandler,
 		"hasNextPage":     _ObjTypeOffsetPageInfoHasNextPageHandler,
 		"hasPreviousPage": _ObjTypeOffsetPageInfoHasPreviousPageHandler,
 		"nextOffset":      _ObjTypeOffsetPageInfoNextOffsetHandler,
 		"previousOffset":  _ObjTypeOffsetPageInfoPreviousOffsetHandler,
+		"startCursor":     _ObjTypeOffsetPageInfoStartCursorHandler,
 		"totalCount":      _ObjTypeOffsetPageInfoTotalCountHandler,
 	},
 }
@@ -18,8 +18,9 @@ func RegisterSchema(svc *graphql.Service) {
 }
 func _SchemaConfigFn() graphql1.SchemaConfig {
 	return graphql1.SchemaConfig{
-		Mutation: graphql.Object("Mutation"),
-		Query:    graphql.Object("Query"),
+		Mutation:     graphql.Object("Mutation"),
+		Query:        graphql.Object("Query"),
+		Subscription: graphql.Object("Subscription"),
 	}
 }
 
@@ -469,3 +470,321 @@ var _ObjectTypeQueryDesc = graphql.ObjectDesc{
 		"viewer":      _ObjTypeQueryViewerHandler,
 	},
 }
+
+// SubscriptionEventFieldResolverArgs contains arguments provided to event when selected
+type SubscriptionEventFieldResolverArgs struct {
+	Ns     *NamespaceInput // Ns - self descriptive
+	Entity string          // Entity - self descriptive
+	Check  string          // Check - self descriptive
+}
+
+// SubscriptionEventFieldResolverParams contains contextual info to resolve event field
+type SubscriptionEventFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionEventFieldResolverArgs
+}
+
+// SubscriptionEventFieldResolver implement to resolve requests for the Subscription's event field.
+type SubscriptionEventFieldResolver interface {
+	// Event implements response to request for event field.
+	Event(p SubscriptionEventFieldResolverParams) (interface{}, error)
+}
+
+// SubscriptionEntityFieldResolverArgs contains arguments provided to entity when selected
+type SubscriptionEntityFieldResolverArgs struct {
+	Ns   *NamespaceInput // Ns - self descriptive
+	Name string          // Name - self descriptive
+}
+
+// SubscriptionEntityFieldResolverParams contains contextual info to resolve entity field
+type SubscriptionEntityFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionEntityFieldResolverArgs
+}
+
+// SubscriptionEntityFieldResolver implement to resolve requests for the Subscription's entity field.
+type SubscriptionEntityFieldResolver interface {
+	// Entity implements response to request for entity field.
+	Entity(p SubscriptionEntityFieldResolverParams) (interface{}, error)
+}
+
+// SubscriptionCheckResultFieldResolverArgs contains arguments provided to checkResult when selected
+type SubscriptionCheckResultFieldResolverArgs struct {
+	Ns     *NamespaceInput // Ns - self descriptive
+	Entity string          // Entity - self descriptive
+	Check  string          // Check - self descriptive
+}
+
+// SubscriptionCheckResultFieldResolverParams contains contextual info to resolve checkResult field
+type SubscriptionCheckResultFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionCheckResultFieldResolverArgs
+}
+
+// SubscriptionCheckResultFieldResolver implement to resolve requests for the Subscription's checkResult field.
+type SubscriptionCheckResultFieldResolver interface {
+	// CheckResult implements response to request for checkResult field.
+	CheckResult(p SubscriptionCheckResultFieldResolverParams) (interface{}, error)
+}
+
+//
+// SubscriptionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'Subscription' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type SubscriptionFieldResolvers interface {
+	SubscriptionEventFieldResolver
+	SubscriptionEntityFieldResolver
+	SubscriptionCheckResultFieldResolver
+}
+
+// SubscriptionAliases implements all methods on SubscriptionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type SubscriptionAliases struct{}
+
+// Event implements response to request for 'event' field.
+func (_ SubscriptionAliases) Event(p SubscriptionEventFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Entity implements response to request for 'entity' field.
+func (_ SubscriptionAliases) Entity(p SubscriptionEntityFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// CheckResult implements response to request for 'checkResult' field.
+func (_ SubscriptionAliases) CheckResult(p SubscriptionCheckResultFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// SubscriptionType The subscription root of Sensu's GraphQL interface.
+var SubscriptionType = graphql.NewType("Subscription", graphql.ObjectKind)
+
+// RegisterSubscription registers Subscription object type with given service.
+func RegisterSubscription(svc *graphql.Service, impl SubscriptionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSubscriptionDesc, impl)
+}
+func _ObjTypeSubscriptionEventHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SubscriptionEventFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionEventFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Event(frp)
+	}
+}
+
+func _ObjTypeSubscriptionEntityHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SubscriptionEntityFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionEntityFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Entity(frp)
+	}
+}
+
+func _ObjTypeSubscriptionCheckResultHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SubscriptionCheckResultFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionCheckResultFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.CheckResult(frp)
+	}
+}
+
+func _ObjectTypeSubscriptionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "The subscription root of Sensu's GraphQL interface.",
+		Fields: graphql1.Fields{
+			"checkResult": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"check": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"entity": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"ns": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql.InputType("NamespaceInput"),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "CheckResult publishes the events produced by the execution of checks,\nkeepalives excluded. Optionally constrained to a namespace, an entity and a\ncheck.",
+				Name:              "checkResult",
+				Type:              graphql.OutputType("Event"),
+			},
+			"entity": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"name": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"ns": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql.InputType("NamespaceInput"),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "Entity publishes the entities as their agents send keepalives. Optionally\nconstrained to a namespace and an entity.",
+				Name:              "entity",
+				Type:              graphql.OutputType("Entity"),
+			},
+			"event": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"check": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"entity": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"ns": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql.InputType("NamespaceInput"),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "Event publishes the events processed by the backend as they are updated.\nOptionally constrained to a namespace, an entity and a check.",
+				Name:              "event",
+				Type:              graphql.OutputType("Event"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SubscriptionFieldResolvers.")
+		},
+		Name: "Subscription",
+	}
+}
+
+// describe Subscription's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSubscriptionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSubscriptionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"checkResult": _ObjTypeSubscriptionCheckResultHandler,
+		"entity":      _ObjTypeSubscriptionEntityHandler,
+		"event":       _ObjTypeSubscriptionEventHandler,
+	},
+}
@@ -0,0 +1,5 @@
+package schema
+
+// Resolver interfaces and type definitions are generated from the schema
+// definition language files of this package.
+//go:generate go run ../../../../scripts/gengraphql .
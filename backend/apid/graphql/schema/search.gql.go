@@ -0,0 +1,244 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// SearchResultItemType SearchResultItem is a resource matching a search query.
+var SearchResultItemType = graphql.NewType("SearchResultItem", graphql.UnionKind)
+
+// RegisterSearchResultItem registers SearchResultItem object type with given service.
+func RegisterSearchResultItem(svc *graphql.Service, impl graphql.UnionTypeResolver) {
+	svc.RegisterUnion(_UnionTypeSearchResultItemDesc, impl)
+}
+func _UnionTypeSearchResultItemConfigFn() graphql1.UnionConfig {
+	return graphql1.UnionConfig{
+		Description: "SearchResultItem is a resource matching a search query.",
+		Name:        "SearchResultItem",
+		ResolveType: func(_ graphql1.ResolveTypeParams) *graphql1.Object {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see UnionTypeResolver.")
+		},
+		Types: []*graphql1.Object{
+			graphql.Object("Event"),
+			graphql.Object("Entity"),
+			graphql.Object("CheckConfig")},
+	}
+}
+
+// describe SearchResultItem's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _UnionTypeSearchResultItemDesc = graphql.UnionDesc{Config: _UnionTypeSearchResultItemConfigFn}
+
+// SearchResultItemFieldResolver implement to resolve requests for the SearchResult's item field.
+type SearchResultItemFieldResolver interface {
+	// Item implements response to request for item field.
+	Item(p graphql.ResolveParams) (interface{}, error)
+}
+
+// SearchResultScoreFieldResolver implement to resolve requests for the SearchResult's score field.
+type SearchResultScoreFieldResolver interface {
+	// Score implements response to request for score field.
+	Score(p graphql.ResolveParams) (float64, error)
+}
+
+//
+// SearchResultFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'SearchResult' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type SearchResultFieldResolvers interface {
+	SearchResultItemFieldResolver
+	SearchResultScoreFieldResolver
+}
+
+// SearchResultAliases implements all methods on SearchResultFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type SearchResultAliases struct{}
+
+// Item implements response to request for 'item' field.
+func (_ SearchResultAliases) Item(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Score implements response to request for 'score' field.
+func (_ SearchResultAliases) Score(p graphql.ResolveParams) (float64, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Float.ParseValue(val).(float64)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'score'")
+	}
+	return ret, err
+}
+
+// SearchResultType SearchResult describes a resource matching a search query.
+var SearchResultType = graphql.NewType("SearchResult", graphql.ObjectKind)
+
+// RegisterSearchResult registers SearchResult object type with given service.
+func RegisterSearchResult(svc *graphql.Service, impl SearchResultFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSearchResultDesc, impl)
+}
+func _ObjTypeSearchResultItemHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SearchResultItemFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Item(frp)
+	}
+}
+
+func _ObjTypeSearchResultScoreHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SearchResultScoreFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Score(frp)
+	}
+}
+
+func _ObjectTypeSearchResultConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "SearchResult describes a resource matching a search query.",
+		Fields: graphql1.Fields{
+			"item": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The matching resource.",
+				Name:              "item",
+				Type:              graphql1.NewNonNull(graphql.OutputType("SearchResultItem")),
+			},
+			"score": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Score indicates the relevance of the result; higher is more relevant.",
+				Name:              "score",
+				Type:              graphql1.NewNonNull(graphql1.Float),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SearchResultFieldResolvers.")
+		},
+		Name: "SearchResult",
+	}
+}
+
+// describe SearchResult's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSearchResultDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSearchResultConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"item":  _ObjTypeSearchResultItemHandler,
+		"score": _ObjTypeSearchResultScoreHandler,
+	},
+}
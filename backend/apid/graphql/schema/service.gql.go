@@ -0,0 +1,1128 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// ServiceIDFieldResolver implement to resolve requests for the Service's id field.
+type ServiceIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceNamespaceFieldResolver implement to resolve requests for the Service's namespace field.
+type ServiceNamespaceFieldResolver interface {
+	// Namespace implements response to request for namespace field.
+	Namespace(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ServiceNameFieldResolver implement to resolve requests for the Service's name field.
+type ServiceNameFieldResolver interface {
+	// Name implements response to request for name field.
+	Name(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceDescriptionFieldResolver implement to resolve requests for the Service's description field.
+type ServiceDescriptionFieldResolver interface {
+	// Description implements response to request for description field.
+	Description(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceHandlersFieldResolver implement to resolve requests for the Service's handlers field.
+type ServiceHandlersFieldResolver interface {
+	// Handlers implements response to request for handlers field.
+	Handlers(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ServiceComponentsFieldResolver implement to resolve requests for the Service's components field.
+type ServiceComponentsFieldResolver interface {
+	// Components implements response to request for components field.
+	Components(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ServiceHealthFieldResolver implement to resolve requests for the Service's health field.
+type ServiceHealthFieldResolver interface {
+	// Health implements response to request for health field.
+	Health(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// ServiceFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'Service' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ServiceFieldResolvers interface {
+	ServiceIDFieldResolver
+	ServiceNamespaceFieldResolver
+	ServiceNameFieldResolver
+	ServiceDescriptionFieldResolver
+	ServiceHandlersFieldResolver
+	ServiceComponentsFieldResolver
+	ServiceHealthFieldResolver
+}
+
+// ServiceAliases implements all methods on ServiceFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ServiceAliases struct{}
+
+// ID implements response to request for 'id' field.
+func (_ ServiceAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (_ ServiceAliases) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Name implements response to request for 'name' field.
+func (_ ServiceAliases) Name(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'name'")
+	}
+	return ret, err
+}
+
+// Description implements response to request for 'description' field.
+func (_ ServiceAliases) Description(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'description'")
+	}
+	return ret, err
+}
+
+// Handlers implements response to request for 'handlers' field.
+func (_ ServiceAliases) Handlers(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Components implements response to request for 'components' field.
+func (_ ServiceAliases) Components(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Health implements response to request for 'health' field.
+func (_ ServiceAliases) Health(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+/*
+ServiceType A Service is a business service, whose health is derived from the events of
+its components.
+*/
+var ServiceType = graphql.NewType("Service", graphql.ObjectKind)
+
+// RegisterService registers Service object type with given service.
+func RegisterService(svc *graphql.Service, impl ServiceFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeServiceDesc, impl)
+}
+func _ObjTypeServiceIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
+func _ObjTypeServiceNamespaceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceNamespaceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Namespace(frp)
+	}
+}
+
+func _ObjTypeServiceNameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceNameFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Name(frp)
+	}
+}
+
+func _ObjTypeServiceDescriptionHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceDescriptionFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Description(frp)
+	}
+}
+
+func _ObjTypeServiceHandlersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceHandlersFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Handlers(frp)
+	}
+}
+
+func _ObjTypeServiceComponentsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Components(frp)
+	}
+}
+
+func _ObjTypeServiceHealthHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceHealthFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Health(frp)
+	}
+}
+
+func _ObjectTypeServiceConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A Service is a business service, whose health is derived from the events of\nits components.",
+		Fields: graphql1.Fields{
+			"components": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Components are the components of the service.",
+				Name:              "components",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("ServiceComponent")))),
+			},
+			"description": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Description is a human readable description of the service.",
+				Name:              "description",
+				Type:              graphql1.String,
+			},
+			"handlers": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Handlers are the handlers of the health events of the service.",
+				Name:              "handlers",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Handler")))),
+			},
+			"health": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Health is the latest health event of the service, whose status is the most\nsevere of its components, if the service was evaluated.",
+				Name:              "health",
+				Type:              graphql.OutputType("Event"),
+			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The globally unique identifier of the record.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
+			"name": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Name is the unique identifier for a service.",
+				Name:              "name",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"namespace": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Namespace in which this record resides.",
+				Name:              "namespace",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ServiceFieldResolvers.")
+		},
+		Name: "Service",
+	}
+}
+
+// describe Service's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeServiceDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeServiceConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"components":  _ObjTypeServiceComponentsHandler,
+		"description": _ObjTypeServiceDescriptionHandler,
+		"handlers":    _ObjTypeServiceHandlersHandler,
+		"health":      _ObjTypeServiceHealthHandler,
+		"id":          _ObjTypeServiceIDHandler,
+		"name":        _ObjTypeServiceNameHandler,
+		"namespace":   _ObjTypeServiceNamespaceHandler,
+	},
+}
+
+// ServiceComponentIDFieldResolver implement to resolve requests for the ServiceComponent's id field.
+type ServiceComponentIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceComponentNamespaceFieldResolver implement to resolve requests for the ServiceComponent's namespace field.
+type ServiceComponentNamespaceFieldResolver interface {
+	// Namespace implements response to request for namespace field.
+	Namespace(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ServiceComponentNameFieldResolver implement to resolve requests for the ServiceComponent's name field.
+type ServiceComponentNameFieldResolver interface {
+	// Name implements response to request for name field.
+	Name(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceComponentServicesFieldResolver implement to resolve requests for the ServiceComponent's services field.
+type ServiceComponentServicesFieldResolver interface {
+	// Services implements response to request for services field.
+	Services(p graphql.ResolveParams) ([]string, error)
+}
+
+// ServiceComponentLabelSelectorFieldResolver implement to resolve requests for the ServiceComponent's labelSelector field.
+type ServiceComponentLabelSelectorFieldResolver interface {
+	// LabelSelector implements response to request for labelSelector field.
+	LabelSelector(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceComponentFieldSelectorFieldResolver implement to resolve requests for the ServiceComponent's fieldSelector field.
+type ServiceComponentFieldSelectorFieldResolver interface {
+	// FieldSelector implements response to request for fieldSelector field.
+	FieldSelector(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceComponentRulesFieldResolver implement to resolve requests for the ServiceComponent's rules field.
+type ServiceComponentRulesFieldResolver interface {
+	// Rules implements response to request for rules field.
+	Rules(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ServiceComponentIntervalFieldResolver implement to resolve requests for the ServiceComponent's interval field.
+type ServiceComponentIntervalFieldResolver interface {
+	// Interval implements response to request for interval field.
+	Interval(p graphql.ResolveParams) (int, error)
+}
+
+// ServiceComponentHandlersFieldResolver implement to resolve requests for the ServiceComponent's handlers field.
+type ServiceComponentHandlersFieldResolver interface {
+	// Handlers implements response to request for handlers field.
+	Handlers(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ServiceComponentEventsFieldResolver implement to resolve requests for the ServiceComponent's events field.
+type ServiceComponentEventsFieldResolver interface {
+	// Events implements response to request for events field.
+	Events(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// ServiceComponentFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ServiceComponent' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ServiceComponentFieldResolvers interface {
+	ServiceComponentIDFieldResolver
+	ServiceComponentNamespaceFieldResolver
+	ServiceComponentNameFieldResolver
+	ServiceComponentServicesFieldResolver
+	ServiceComponentLabelSelectorFieldResolver
+	ServiceComponentFieldSelectorFieldResolver
+	ServiceComponentRulesFieldResolver
+	ServiceComponentIntervalFieldResolver
+	ServiceComponentHandlersFieldResolver
+	ServiceComponentEventsFieldResolver
+}
+
+// ServiceComponentAliases implements all methods on ServiceComponentFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ServiceComponentAliases struct{}
+
+// ID implements response to request for 'id' field.
+func (_ ServiceComponentAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (_ ServiceComponentAliases) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Name implements response to request for 'name' field.
+func (_ ServiceComponentAliases) Name(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'name'")
+	}
+	return ret, err
+}
+
+// Services implements response to request for 'services' field.
+func (_ ServiceComponentAliases) Services(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'services'")
+	}
+	return ret, err
+}
+
+// LabelSelector implements response to request for 'labelSelector' field.
+func (_ ServiceComponentAliases) LabelSelector(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'labelSelector'")
+	}
+	return ret, err
+}
+
+// FieldSelector implements response to request for 'fieldSelector' field.
+func (_ ServiceComponentAliases) FieldSelector(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'fieldSelector'")
+	}
+	return ret, err
+}
+
+// Rules implements response to request for 'rules' field.
+func (_ ServiceComponentAliases) Rules(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Interval implements response to request for 'interval' field.
+func (_ ServiceComponentAliases) Interval(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'interval'")
+	}
+	return ret, err
+}
+
+// Handlers implements response to request for 'handlers' field.
+func (_ ServiceComponentAliases) Handlers(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Events implements response to request for 'events' field.
+func (_ ServiceComponentAliases) Events(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+/*
+ServiceComponentType A ServiceComponent selects the events of a part of one or more business
+services, and derives its status from the number of those which are failing.
+*/
+var ServiceComponentType = graphql.NewType("ServiceComponent", graphql.ObjectKind)
+
+// RegisterServiceComponent registers ServiceComponent object type with given service.
+func RegisterServiceComponent(svc *graphql.Service, impl ServiceComponentFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeServiceComponentDesc, impl)
+}
+func _ObjTypeServiceComponentIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
+func _ObjTypeServiceComponentNamespaceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentNamespaceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Namespace(frp)
+	}
+}
+
+func _ObjTypeServiceComponentNameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentNameFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Name(frp)
+	}
+}
+
+func _ObjTypeServiceComponentServicesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentServicesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Services(frp)
+	}
+}
+
+func _ObjTypeServiceComponentLabelSelectorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentLabelSelectorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.LabelSelector(frp)
+	}
+}
+
+func _ObjTypeServiceComponentFieldSelectorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentFieldSelectorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.FieldSelector(frp)
+	}
+}
+
+func _ObjTypeServiceComponentRulesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentRulesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Rules(frp)
+	}
+}
+
+func _ObjTypeServiceComponentIntervalHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentIntervalFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Interval(frp)
+	}
+}
+
+func _ObjTypeServiceComponentHandlersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentHandlersFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Handlers(frp)
+	}
+}
+
+func _ObjTypeServiceComponentEventsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceComponentEventsFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Events(frp)
+	}
+}
+
+func _ObjectTypeServiceComponentConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A ServiceComponent selects the events of a part of one or more business\nservices, and derives its status from the number of those which are failing.",
+		Fields: graphql1.Fields{
+			"events": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Events are the latest events of the component, one for each of its services\nwhich was evaluated.",
+				Name:              "events",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Event")))),
+			},
+			"fieldSelector": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "FieldSelector selects the events of the component by their fields.",
+				Name:              "fieldSelector",
+				Type:              graphql1.String,
+			},
+			"handlers": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Handlers are the handlers of the events of the component.",
+				Name:              "handlers",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Handler")))),
+			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The globally unique identifier of the record.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
+			"interval": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Interval is the interval, in seconds, at which the component is evaluated.",
+				Name:              "interval",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"labelSelector": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "LabelSelector selects the events of the component by their labels.",
+				Name:              "labelSelector",
+				Type:              graphql1.String,
+			},
+			"name": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Name is the unique identifier for a service component.",
+				Name:              "name",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"namespace": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Namespace in which this record resides.",
+				Name:              "namespace",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
+			},
+			"rules": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Rules give the status of the component.",
+				Name:              "rules",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("ServiceRule")))),
+			},
+			"services": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Services are the names of the services the component belongs to.",
+				Name:              "services",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ServiceComponentFieldResolvers.")
+		},
+		Name: "ServiceComponent",
+	}
+}
+
+// describe ServiceComponent's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeServiceComponentDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeServiceComponentConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"events":        _ObjTypeServiceComponentEventsHandler,
+		"fieldSelector": _ObjTypeServiceComponentFieldSelectorHandler,
+		"handlers":      _ObjTypeServiceComponentHandlersHandler,
+		"id":            _ObjTypeServiceComponentIDHandler,
+		"interval":      _ObjTypeServiceComponentIntervalHandler,
+		"labelSelector": _ObjTypeServiceComponentLabelSelectorHandler,
+		"name":          _ObjTypeServiceComponentNameHandler,
+		"namespace":     _ObjTypeServiceComponentNamespaceHandler,
+		"rules":         _ObjTypeServiceComponentRulesHandler,
+		"services":      _ObjTypeServiceComponentServicesHandler,
+	},
+}
+
+// ServiceRuleNameFieldResolver implement to resolve requests for the ServiceRule's name field.
+type ServiceRuleNameFieldResolver interface {
+	// Name implements response to request for name field.
+	Name(p graphql.ResolveParams) (string, error)
+}
+
+// ServiceRuleStatusFieldResolver implement to resolve requests for the ServiceRule's status field.
+type ServiceRuleStatusFieldResolver interface {
+	// Status implements response to request for status field.
+	Status(p graphql.ResolveParams) (int, error)
+}
+
+// ServiceRuleFailingCountFieldResolver implement to resolve requests for the ServiceRule's failingCount field.
+type ServiceRuleFailingCountFieldResolver interface {
+	// FailingCount implements response to request for failingCount field.
+	FailingCount(p graphql.ResolveParams) (int, error)
+}
+
+// ServiceRuleFailingPercentageFieldResolver implement to resolve requests for the ServiceRule's failingPercentage field.
+type ServiceRuleFailingPercentageFieldResolver interface {
+	// FailingPercentage implements response to request for failingPercentage field.
+	FailingPercentage(p graphql.ResolveParams) (int, error)
+}
+
+//
+// ServiceRuleFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ServiceRule' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ServiceRuleFieldResolvers interface {
+	ServiceRuleNameFieldResolver
+	ServiceRuleStatusFieldResolver
+	ServiceRuleFailingCountFieldResolver
+	ServiceRuleFailingPercentageFieldResolver
+}
+
+// ServiceRuleAliases implements all methods on ServiceRuleFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ServiceRuleAliases struct{}
+
+// Name implements response to request for 'name' field.
+func (_ ServiceRuleAliases) Name(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'name'")
+	}
+	return ret, err
+}
+
+// Status implements response to request for 'status' field.
+func (_ ServiceRuleAliases) Status(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'status'")
+	}
+	return ret, err
+}
+
+// FailingCount implements response to request for 'failingCount' field.
+func (_ ServiceRuleAliases) FailingCount(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'failingCount'")
+	}
+	return ret, err
+}
+
+// FailingPercentage implements response to request for 'failingPercentage' field.
+func (_ ServiceRuleAliases) FailingPercentage(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := graphql1.Int.ParseValue(val).(int)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'failingPercentage'")
+	}
+	return ret, err
+}
+
+/*
+ServiceRuleType A ServiceRule gives a status to a service component when more of its events
+are failing than tolerated.
+*/
+var ServiceRuleType = graphql.NewType("ServiceRule", graphql.ObjectKind)
+
+// RegisterServiceRule registers ServiceRule object type with given service.
+func RegisterServiceRule(svc *graphql.Service, impl ServiceRuleFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeServiceRuleDesc, impl)
+}
+func _ObjTypeServiceRuleNameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceRuleNameFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Name(frp)
+	}
+}
+
+func _ObjTypeServiceRuleStatusHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceRuleStatusFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Status(frp)
+	}
+}
+
+func _ObjTypeServiceRuleFailingCountHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceRuleFailingCountFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.FailingCount(frp)
+	}
+}
+
+func _ObjTypeServiceRuleFailingPercentageHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ServiceRuleFailingPercentageFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.FailingPercentage(frp)
+	}
+}
+
+func _ObjectTypeServiceRuleConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A ServiceRule gives a status to a service component when more of its events\nare failing than tolerated.",
+		Fields: graphql1.Fields{
+			"failingCount": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "FailingCount is the number of failing events tolerated by the rule.",
+				Name:              "failingCount",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"failingPercentage": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "FailingPercentage is the percentage of failing events tolerated by the rule.",
+				Name:              "failingPercentage",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+			"name": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Name is the name of the rule.",
+				Name:              "name",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"status": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Status is the status given by the rule, 1 (warning) or 2 (critical).",
+				Name:              "status",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ServiceRuleFieldResolvers.")
+		},
+		Name: "ServiceRule",
+	}
+}
+
+// describe ServiceRule's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeServiceRuleDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeServiceRuleConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"failingCount":      _ObjTypeServiceRuleFailingCountHandler,
+		"failingPercentage": _ObjTypeServiceRuleFailingPercentageHandler,
+		"name":              _ObjTypeServiceRuleNameHandler,
+		"status":            _ObjTypeServiceRuleStatusHandler,
+	},
+}
@@ -63,6 +63,12 @@ type SilencedSubscriptionFieldResolver interface {
 	Subscription(p graphql.ResolveParams) (string, error)
 }
 
+// SilencedLabelSelectorFieldResolver implement to resolve requests for the Silenced's labelSelector field.
+type SilencedLabelSelectorFieldResolver interface {
+	// LabelSelector implements response to request for labelSelector field.
+	LabelSelector(p graphql.ResolveParams) (string, error)
+}
+
 // SilencedOrganizationFieldResolver implement to resolve requests for the Silenced's organization field.
 type SilencedOrganizationFieldResolver interface {
 	// Organization implements response to request for organization field.
@@ -152,6 +158,7 @@ type SilencedFieldResolvers interface {
 	SilencedCheckFieldResolver
 	SilencedReasonFieldResolver
 	SilencedSubscriptionFieldResolver
+	SilencedLabelSelectorFieldResolver
 	SilencedOrganizationFieldResolver
 	SilencedEnvironmentFieldResolver
 	SilencedBeginFieldResolver
@@ -307,6 +314,19 @@ func (_ SilencedAliases) Subscription(p graphql.ResolveParams) (string, error) {
 	return ret, err
 }
 
+// LabelSelector implements response to request for 'labelSelector' field.
+func (_ SilencedAliases) LabelSelector(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'labelSelector'")
+	}
+	return ret, err
+}
+
 // Organization implements response to request for 'organization' field.
 func (_ SilencedAliases) Organization(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -402,6 +422,13 @@ func _ObjTypeSilencedSubscriptionHandler(impl interface{}) graphql1.FieldResolve
 	}
 }
 
+func _ObjTypeSilencedLabelSelectorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SilencedLabelSelectorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.LabelSelector(frp)
+	}
+}
+
 func _ObjTypeSilencedOrganizationHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(SilencedOrganizationFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -444,9 +471,9 @@ func _ObjectTypeSilencedConfigFn() graphql1.ObjectConfig {
 			"creator": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
-				Description:       "Creator is the author of the silenced entry",
+				Description:       "Creator is the author of the silenced entry; null if the viewer is not\nallowed to read users.",
 				Name:              "creator",
-				Type:              graphql1.NewNonNull(graphql.OutputType("User")),
+				Type:              graphql.OutputType("User"),
 			},
 			"environment": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
@@ -483,6 +510,13 @@ func _ObjectTypeSilencedConfigFn() graphql1.ObjectConfig {
 				Name:              "id",
 				Type:              graphql1.NewNonNull(graphql1.ID),
 			},
+			"labelSelector": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "LabelSelector restricts the entry to the events whose entity and check\nlabels match the selector, e.g. \"team=payments\".",
+				Name:              "labelSelector",
+				Type:              graphql1.String,
+			},
 			"organization": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -500,7 +534,7 @@ func _ObjectTypeSilencedConfigFn() graphql1.ObjectConfig {
 			"storeId": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
-				Description:       "ID is the combination of subscription and check name (subscription:checkname)",
+				Description:       "ID is the combination of subscription and check name (subscription:checkname),\nfollowed by the label selector of the entry if any.",
 				Name:              "storeId",
 				Type:              graphql1.NewNonNull(graphql1.String),
 			},
@@ -539,6 +573,7 @@ var _ObjectTypeSilencedDesc = graphql.ObjectDesc{
 		"expireOnResolve": _ObjTypeSilencedExpireOnResolveHandler,
 		"expires":         _ObjTypeSilencedExpiresHandler,
 		"id":              _ObjTypeSilencedIDHandler,
+		"labelSelector":   _ObjTypeSilencedLabelSelectorHandler,
 		"organization":    _ObjTypeSilencedOrganizationHandler,
 		"reason":          _ObjTypeSilencedReasonHandler,
 		"storeId":         _ObjTypeSilencedStoreIDHandler,
@@ -552,6 +587,12 @@ type SilencedConnectionNodesFieldResolver interface {
 	Nodes(p graphql.ResolveParams) (interface{}, error)
 }
 
+// SilencedConnectionEdgesFieldResolver implement to resolve requests for the SilencedConnection's edges field.
+type SilencedConnectionEdgesFieldResolver interface {
+	// Edges implements response to request for edges field.
+	Edges(p graphql.ResolveParams) (interface{}, error)
+}
+
 // SilencedConnectionPageInfoFieldResolver implement to resolve requests for the SilencedConnection's pageInfo field.
 type SilencedConnectionPageInfoFieldResolver interface {
 	// PageInfo implements response to request for pageInfo field.
@@ -621,6 +662,7 @@ type SilencedConnectionPageInfoFieldResolver interface {
 //
 type SilencedConnectionFieldResolvers interface {
 	SilencedConnectionNodesFieldResolver
+	SilencedConnectionEdgesFieldResolver
 	SilencedConnectionPageInfoFieldResolver
 }
 
@@ -677,6 +719,12 @@ func (_ SilencedConnectionAliases) Nodes(p graphql.ResolveParams) (interface{},
 	return val, err
 }
 
+// Edges implements response to request for 'edges' field.
+func (_ SilencedConnectionAliases) Edges(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // PageInfo implements response to request for 'pageInfo' field.
 func (_ SilencedConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -697,6 +745,13 @@ func _ObjTypeSilencedConnectionNodesHandler(impl interface{}) graphql1.FieldReso
 	}
 }
 
+func _ObjTypeSilencedConnectionEdgesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SilencedConnectionEdgesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Edges(frp)
+	}
+}
+
 func _ObjTypeSilencedConnectionPageInfoHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(SilencedConnectionPageInfoFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -708,6 +763,13 @@ func _ObjectTypeSilencedConnectionConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "A connection to a sequence of records.",
 		Fields: graphql1.Fields{
+			"edges": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "edges",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("SilencedEdge")))),
+			},
 			"nodes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -740,7 +802,214 @@ func _ObjectTypeSilencedConnectionConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeSilencedConnectionDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeSilencedConnectionConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
+		"edges":    _ObjTypeSilencedConnectionEdgesHandler,
 		"nodes":    _ObjTypeSilencedConnectionNodesHandler,
 		"pageInfo": _ObjTypeSilencedConnectionPageInfoHandler,
 	},
 }
+
+// SilencedEdgeNodeFieldResolver implement to resolve requests for the SilencedEdge's node field.
+type SilencedEdgeNodeFieldResolver interface {
+	// Node implements response to request for node field.
+	Node(p graphql.ResolveParams) (interface{}, error)
+}
+
+// SilencedEdgeCursorFieldResolver implement to resolve requests for the SilencedEdge's cursor field.
+type SilencedEdgeCursorFieldResolver interface {
+	// Cursor implements response to request for cursor field.
+	Cursor(p graphql.ResolveParams) (string, error)
+}
+
+//
+// SilencedEdgeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'SilencedEdge' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type SilencedEdgeFieldResolvers interface {
+	SilencedEdgeNodeFieldResolver
+	SilencedEdgeCursorFieldResolver
+}
+
+// SilencedEdgeAliases implements all methods on SilencedEdgeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type SilencedEdgeAliases struct{}
+
+// Node implements response to request for 'node' field.
+func (_ SilencedEdgeAliases) Node(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Cursor implements response to request for 'cursor' field.
+func (_ SilencedEdgeAliases) Cursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'cursor'")
+	}
+	return ret, err
+}
+
+// SilencedEdgeType An edge in a connection.
+var SilencedEdgeType = graphql.NewType("SilencedEdge", graphql.ObjectKind)
+
+// RegisterSilencedEdge registers SilencedEdge object type with given service.
+func RegisterSilencedEdge(svc *graphql.Service, impl SilencedEdgeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSilencedEdgeDesc, impl)
+}
+func _ObjTypeSilencedEdgeNodeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SilencedEdgeNodeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Node(frp)
+	}
+}
+
+func _ObjTypeSilencedEdgeCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SilencedEdgeCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Cursor(frp)
+	}
+}
+
+func _ObjectTypeSilencedEdgeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An edge in a connection.",
+		Fields: graphql1.Fields{
+			"cursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "cursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"node": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "node",
+				Type:              graphql.OutputType("Silenced"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SilencedEdgeFieldResolvers.")
+		},
+		Name: "SilencedEdge",
+	}
+}
+
+// describe SilencedEdge's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSilencedEdgeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSilencedEdgeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"cursor": _ObjTypeSilencedEdgeCursorHandler,
+		"node":   _ObjTypeSilencedEdgeNodeHandler,
+	},
+}
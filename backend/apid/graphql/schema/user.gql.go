@@ -8,6 +8,12 @@ import (
 	graphql "github.com/sensu/sensu-go/graphql"
 )
 
+// UserIDFieldResolver implement to resolve requests for the User's id field.
+type UserIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (string, error)
+}
+
 // UserUsernameFieldResolver implement to resolve requests for the User's username field.
 type UserUsernameFieldResolver interface {
 	// Username implements response to request for username field.
@@ -94,6 +100,7 @@ type UserHasPasswordFieldResolver interface {
 //   }
 //
 type UserFieldResolvers interface {
+	UserIDFieldResolver
 	UserUsernameFieldResolver
 	UserRolesFieldResolver
 	UserDisabledFieldResolver
@@ -147,6 +154,19 @@ type UserFieldResolvers interface {
 //
 type UserAliases struct{}
 
+// ID implements response to request for 'id' field.
+func (_ UserAliases) ID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'id'")
+	}
+	return ret, err
+}
+
 // Username implements response to request for 'username' field.
 func (_ UserAliases) Username(p graphql.ResolveParams) (string, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -199,6 +219,13 @@ var UserType = graphql.NewType("User", graphql.ObjectKind)
 func RegisterUser(svc *graphql.Service, impl UserFieldResolvers) {
 	svc.RegisterObject(_ObjectTypeUserDesc, impl)
 }
+func _ObjTypeUserIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UserIDFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(frp)
+	}
+}
+
 func _ObjTypeUserUsernameHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(UserUsernameFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -245,6 +272,13 @@ func _ObjectTypeUserConfigFn() graphql1.ObjectConfig {
 				Name:              "hasPassword",
 				Type:              graphql1.NewNonNull(graphql1.Boolean),
 			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The globally unique identifier of the record.",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
 			"roles": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -260,7 +294,8 @@ func _ObjectTypeUserConfigFn() graphql1.ObjectConfig {
 				Type:              graphql1.NewNonNull(graphql1.String),
 			},
 		},
-		Interfaces: []*graphql1.Interface{},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
 		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
 			// NOTE:
 			// Panic by default. Intent is that when Service is invoked, values of
@@ -279,6 +314,7 @@ var _ObjectTypeUserDesc = graphql.ObjectDesc{
 	FieldHandlers: map[string]graphql.FieldHandler{
 		"disabled":    _ObjTypeUserDisabledHandler,
 		"hasPassword": _ObjTypeUserHasPasswordHandler,
+		"id":          _ObjTypeUserIDHandler,
 		"roles":       _ObjTypeUserRolesHandler,
 		"username":    _ObjTypeUserUsernameHandler,
 	},
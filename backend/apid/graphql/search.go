@@ -0,0 +1,159 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Weights of the fields of resources matched by search terms.
+const (
+	searchWeightExactName  = 10.0
+	searchWeightNamePrefix = 5.0
+	searchWeightName       = 3.0
+	searchWeightLabel      = 2.0
+	searchWeightOutput     = 1.0
+)
+
+type searchResult struct {
+	Item  interface{}
+	Score float64
+}
+
+// searchDocument describes the fields of a resource matched by search terms.
+type searchDocument struct {
+	names  []string
+	labels map[string]string
+	output string
+}
+
+// score returns the relevance of the document to the given terms; zero if any
+// of the terms does not match the document. Each term contributes the weight
+// of the most relevant field it matches.
+func (d searchDocument) score(terms []string) float64 {
+	var total float64
+	for _, term := range terms {
+		best := d.scoreTerm(term)
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+	return total
+}
+
+func (d searchDocument) scoreTerm(term string) float64 {
+	// key=value terms only match labels
+	if i := strings.Index(term, "="); i > 0 {
+		if value, ok := d.labels[term[:i]]; ok && value == term[i+1:] {
+			return searchWeightLabel
+		}
+		return 0
+	}
+
+	var best float64
+	for _, name := range d.names {
+		name = strings.ToLower(name)
+		switch {
+		case name == term:
+			best = math.Max(best, searchWeightExactName)
+		case strings.HasPrefix(name, term):
+			best = math.Max(best, searchWeightNamePrefix)
+		case strings.Contains(name, term):
+			best = math.Max(best, searchWeightName)
+		}
+	}
+	for key, value := range d.labels {
+		if strings.Contains(key, term) || strings.Contains(value, term) {
+			best = math.Max(best, searchWeightLabel)
+		}
+	}
+	if strings.Contains(d.output, term) {
+		best = math.Max(best, searchWeightOutput)
+	}
+	return best
+}
+
+// searchLabels returns the top-level extended attributes of a resource, which
+// are used as its labels, as lowercase strings.
+func searchLabels(attrs []byte) map[string]string {
+	var values map[string]interface{}
+	if len(attrs) == 0 || json.Unmarshal(attrs, &values) != nil {
+		return nil
+	}
+	labels := make(map[string]string, len(values))
+	for key, value := range values {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			continue
+		}
+		labels[strings.ToLower(key)] = strings.ToLower(fmt.Sprint(value))
+	}
+	return labels
+}
+
+func entityDocument(entity *types.Entity) searchDocument {
+	return searchDocument{
+		names:  []string{entity.ID},
+		labels: searchLabels(entity.ExtendedAttributes),
+	}
+}
+
+func checkDocument(check *types.CheckConfig) searchDocument {
+	return searchDocument{
+		names:  []string{check.Name},
+		labels: searchLabels(check.ExtendedAttributes),
+	}
+}
+
+func eventDocument(event *types.Event) searchDocument {
+	var doc searchDocument
+	if event.Entity != nil {
+		doc.names = append(doc.names, event.Entity.ID)
+		doc.labels = searchLabels(event.Entity.ExtendedAttributes)
+	}
+	if event.Check != nil {
+		doc.names = append(doc.names, event.Check.Name)
+		doc.output = strings.ToLower(event.Check.Output)
+	}
+	return doc
+}
+
+// search returns the given events, entities and checks matching the given
+// query, sorted by descending relevance.
+func search(
+	query string,
+	events []*types.Event,
+	entities []*types.Entity,
+	checks []*types.CheckConfig,
+) []searchResult {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return []searchResult{}
+	}
+
+	results := []searchResult{}
+	add := func(item interface{}, doc searchDocument) {
+		if score := doc.score(terms); score > 0 {
+			results = append(results, searchResult{Item: item, Score: score})
+		}
+	}
+	for _, event := range events {
+		add(event, eventDocument(event))
+	}
+	for _, entity := range entities {
+		add(entity, entityDocument(entity))
+	}
+	for _, check := range checks {
+		add(check, checkDocument(check))
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
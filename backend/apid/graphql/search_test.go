@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	webEntity := types.FixtureEntity("web-01")
+	webEntity.ExtendedAttributes = []byte(`{"region":"eu-west","team":{"name":"ops"}}`)
+	dbEntity := types.FixtureEntity("db-01")
+	dbEntity.ExtendedAttributes = []byte(`{"region":"us-east"}`)
+
+	diskCheck := types.FixtureCheckConfig("check-disk")
+	webCheck := types.FixtureCheckConfig("web")
+
+	event := types.FixtureEvent("db-01", "check-disk")
+	event.Entity = dbEntity
+	event.Check.Output = "DISK CRITICAL: /var is 98% full"
+
+	events := []*types.Event{event}
+	entities := []*types.Entity{webEntity, dbEntity}
+	checks := []*types.CheckConfig{diskCheck, webCheck}
+
+	testCases := []struct {
+		name  string
+		query string
+		want  []interface{}
+	}{
+		{"empty", " ", []interface{}{}},
+		{"no match", "nope", []interface{}{}},
+		{"ranked by name", "web", []interface{}{webCheck, webEntity}},
+		{"label", "eu-west", []interface{}{webEntity}},
+		{"label selector", "region=us-east", []interface{}{event, dbEntity}},
+		{"nested labels are ignored", "ops", []interface{}{}},
+		{"output", "critical", []interface{}{event}},
+		{"all terms must match", "disk full", []interface{}{event}},
+		{"case insensitive", "WEB-01", []interface{}{webEntity}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := search(tc.query, events, entities, checks)
+			items := make([]interface{}, len(results))
+			for i, result := range results {
+				items[i] = result.Item
+			}
+			require.Equal(t, tc.want, items)
+		})
+	}
+}
+
+func TestSearchScore(t *testing.T) {
+	doc := searchDocument{names: []string{"web-01"}, output: "ok"}
+	assert.Equal(t, searchWeightNamePrefix, doc.score([]string{"web"}))
+	assert.Equal(t, searchWeightExactName+searchWeightOutput, doc.score([]string{"web-01", "ok"}))
+	assert.Zero(t, doc.score([]string{"web", "db"}))
+}
@@ -1,6 +1,8 @@
 package graphql
 
 import (
+	"time"
+
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
@@ -13,26 +15,47 @@ type ServiceConfig struct {
 	Store       store.Store
 	Bus         messaging.MessageBus
 	QueueGetter types.QueueGetter
+	Limits      graphql.Limits
+
+	// AuditRetention is how long the audit entries of the mutations executed
+	// are kept; zero disables them.
+	AuditRetention time.Duration
 }
 
 // NewService instantiates new GraphQL service
 func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	svc := graphql.NewService()
+	svc.SetLimits(cfg.Limits)
+	svc.UseMiddleware(authorizeField)
+	svc.UseMiddleware(auditMutations(cfg.Store, cfg.AuditRetention))
 	store := cfg.Store
 	nodeResolver := newNodeResolver(store, cfg.QueueGetter)
 
 	// Register types
 	schema.RegisterAsset(svc, &assetImpl{})
+	schema.RegisterAuditEntry(svc, &auditEntryImpl{})
+	schema.RegisterAuditEntryConnection(svc, &schema.AuditEntryConnectionAliases{})
+	schema.RegisterAuditEntryEdge(svc, &schema.AuditEntryEdgeAliases{})
+	schema.RegisterDuration(svc, graphql.DurationScalar{})
 	schema.RegisterEnvironment(svc, newEnvImpl(store, cfg.QueueGetter))
 	schema.RegisterEnvironmentNode(svc, envNodeImpl{})
 	schema.RegisterErrCode(svc)
 	schema.RegisterError(svc, nil)
 	schema.RegisterEvent(svc, &eventImpl{})
+	schema.RegisterEventAggregates(svc, &schema.EventAggregatesAliases{})
+	schema.RegisterEventCount(svc, &schema.EventCountAliases{})
+	schema.RegisterEventFilter(svc, &eventFilterImpl{})
 	schema.RegisterEventsListOrder(svc)
 	schema.RegisterHandler(svc, newHandlerImpl(store))
+	schema.RegisterHandlerConnection(svc, &schema.HandlerConnectionAliases{})
+	schema.RegisterHandlerEdge(svc, &schema.HandlerEdgeAliases{})
+	schema.RegisterHandlerListOrder(svc)
 	schema.RegisterHandlerSocket(svc, &handlerSocketImpl{})
+	schema.RegisterHandlerSlack(svc, &handlerSlackImpl{})
+	schema.RegisterHandlerPagerDuty(svc, &handlerPagerDutyImpl{})
+	schema.RegisterHandlerEmail(svc, &handlerEmailImpl{})
 	schema.RegisterIcon(svc)
-	schema.RegisterJSON(svc, jsonImpl{})
+	schema.RegisterJSON(svc, graphql.JSONScalar{})
 	schema.RegisterQuery(svc, newQueryImpl(store, nodeResolver, cfg.QueueGetter))
 	schema.RegisterMutator(svc, &mutatorImpl{})
 	schema.RegisterMutedColour(svc)
@@ -43,10 +66,20 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterOffsetPageInfo(svc, &offsetPageInfoImpl{})
 	schema.RegisterProxyRequests(svc, &schema.ProxyRequestsAliases{})
 	schema.RegisterResolveEventPayload(svc, &schema.ResolveEventPayloadAliases{})
+	schema.RegisterResolveEventsInput(svc)
+	schema.RegisterResolveEventsPayload(svc, &schema.ResolveEventsPayloadAliases{})
+	schema.RegisterResolveEventResult(svc, &schema.ResolveEventResultAliases{})
 	schema.RegisterSchema(svc)
+	schema.RegisterSearchResult(svc, &schema.SearchResultAliases{})
+	schema.RegisterSearchResultItem(svc, nil)
+	schema.RegisterService(svc, newServiceImpl(store))
+	schema.RegisterServiceComponent(svc, newServiceComponentImpl(store))
+	schema.RegisterServiceRule(svc, &serviceRuleImpl{})
 	schema.RegisterSilenced(svc, newSilencedImpl(store, cfg.QueueGetter))
 	schema.RegisterSilencedConnection(svc, &schema.SilencedConnectionAliases{})
+	schema.RegisterSilencedEdge(svc, &schema.SilencedEdgeAliases{})
 	schema.RegisterStandardError(svc, stdErrImpl{})
+	schema.RegisterSubscription(svc, subscriptionImpl{})
 	schema.RegisterSubscriptionSet(svc, subscriptionSetImpl{})
 	schema.RegisterSubscriptionSetOrder(svc)
 	schema.RegisterSubscriptionOccurences(svc, &schema.SubscriptionOccurencesAliases{})
@@ -57,12 +90,14 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterCheck(svc, newCheckImpl(store))
 	schema.RegisterCheckConfig(svc, newCheckCfgImpl(store))
 	schema.RegisterCheckConfigConnection(svc, &schema.CheckConfigConnectionAliases{})
+	schema.RegisterCheckConfigEdge(svc, &schema.CheckConfigEdgeAliases{})
 	schema.RegisterCheckHistory(svc, &checkHistoryImpl{})
 	schema.RegisterCheckListOrder(svc)
 
 	// Register entity types
 	schema.RegisterEntity(svc, newEntityImpl(store))
 	schema.RegisterEntityConnection(svc, &schema.EntityConnectionAliases{})
+	schema.RegisterEntityEdge(svc, &schema.EntityEdgeAliases{})
 	schema.RegisterEntityListOrder(svc)
 	schema.RegisterDeregistration(svc, &deregistrationImpl{})
 	schema.RegisterNetwork(svc, &networkImpl{})
@@ -72,6 +107,7 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	// Register event types
 	schema.RegisterEvent(svc, &eventImpl{})
 	schema.RegisterEventConnection(svc, &schema.EventConnectionAliases{})
+	schema.RegisterEventEdge(svc, &schema.EventEdgeAliases{})
 
 	// Register hook types
 	schema.RegisterHook(svc, &hookImpl{})
@@ -97,8 +133,13 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterCreateCheckPayload(svc, &checkMutationPayload{})
 	schema.RegisterCreateSilenceInput(svc)
 	schema.RegisterCreateSilencePayload(svc, &schema.CreateSilencePayloadAliases{})
+	schema.RegisterClearSilencesForEntityInput(svc)
+	schema.RegisterClearSilencesForEntityPayload(svc, &schema.ClearSilencesForEntityPayloadAliases{})
 	schema.RegisterDeleteRecordInput(svc)
 	schema.RegisterDeleteRecordPayload(svc, &deleteRecordPayload{})
+	schema.RegisterDeleteEventsInput(svc)
+	schema.RegisterDeleteEventsPayload(svc, &schema.DeleteEventsPayloadAliases{})
+	schema.RegisterDeleteEventResult(svc, &schema.DeleteEventResultAliases{})
 	schema.RegisterExecuteCheckInput(svc)
 	schema.RegisterExecuteCheckPayload(svc, &schema.ExecuteCheckPayloadAliases{})
 	schema.RegisterResolveEventInput(svc)
@@ -54,12 +54,34 @@ type environmentFinder interface {
 	Find(ctx context.Context, org, env string) (*types.Environment, error)
 }
 
+// audit
+
+type auditQuerier interface {
+	Query(ctx context.Context) ([]*types.AuditEntry, error)
+}
+
+// handlers
+
+type handlerQuerier interface {
+	Query(ctx context.Context) ([]*types.Handler, error)
+}
+
 // organizations
 
 type organizationFinder interface {
 	Find(ctx context.Context, name string) (*types.Organization, error)
 }
 
+// services
+
+type serviceQuerier interface {
+	Query(ctx context.Context) ([]*types.Service, error)
+}
+
+type serviceComponentQuerier interface {
+	Query(ctx context.Context) ([]*types.ServiceComponent, error)
+}
+
 // silences
 
 type silenceCreator interface {
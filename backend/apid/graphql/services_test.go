@@ -55,6 +55,14 @@ func (m mockEventFetcher) Find(ctx context.Context, entity, check string) (*type
 	return m.record, m.err
 }
 
+type mockEventReplacer struct {
+	err error
+}
+
+func (m mockEventReplacer) CreateOrReplace(ctx context.Context, event types.Event) error {
+	return m.err
+}
+
 type mockEventDestroyer struct {
 	err error
 }
@@ -103,3 +111,41 @@ type mockSilenceQuerier struct {
 func (m mockSilenceQuerier) Query(_ context.Context, _, _ string) ([]*types.Silenced, error) {
 	return m.els, m.err
 }
+
+type mockHandlerQuerier struct {
+	els []*types.Handler
+	err error
+}
+
+func (m mockHandlerQuerier) Query(_ context.Context) ([]*types.Handler, error) {
+	return m.els, m.err
+}
+
+type mockAuditQuerier struct {
+	els []*types.AuditEntry
+	err error
+}
+
+func (m mockAuditQuerier) Query(_ context.Context) ([]*types.AuditEntry, error) {
+	return m.els, m.err
+}
+
+// services
+
+type mockServiceQuerier struct {
+	els []*types.Service
+	err error
+}
+
+func (m mockServiceQuerier) Query(_ context.Context) ([]*types.Service, error) {
+	return m.els, m.err
+}
+
+type mockServiceComponentQuerier struct {
+	els []*types.ServiceComponent
+	err error
+}
+
+func (m mockServiceComponentQuerier) Query(_ context.Context) ([]*types.ServiceComponent, error) {
+	return m.els, m.err
+}
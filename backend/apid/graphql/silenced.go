@@ -65,8 +65,9 @@ func (r *silencedImpl) Environment(p graphql.ResolveParams) (interface{}, error)
 // Expires implements response to request for 'expires' field.
 func (r *silencedImpl) Expires(p graphql.ResolveParams) (*time.Time, error) {
 	s := p.Source.(*types.Silenced)
+	// The expiration of stored entries is the time left until they are deleted
 	if s.Expire > 0 {
-		return convertTs(s.Begin + s.Expire), nil
+		return convertTs(time.Now().Unix() + s.Expire), nil
 	}
 	return nil, nil
 }
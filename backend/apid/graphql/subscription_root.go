@@ -0,0 +1,131 @@
+package graphql
+
+import (
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.SubscriptionFieldResolvers = (*subscriptionImpl)(nil)
+
+// subscriptionTopics maps the fields of the subscription root to the topic of
+// the message bus their values are published to.
+var subscriptionTopics = map[string]string{
+	"event":       messaging.TopicEvent,
+	"entity":      messaging.TopicKeepalive,
+	"checkResult": messaging.TopicEvent,
+}
+
+// Keys of the root object given to the resolvers of the subscription root.
+const (
+	rootTopicKey   = "topic"
+	rootMessageKey = "message"
+)
+
+// newSubscriptionRoot returns the root object of a subscription given a
+// message received from a topic of the message bus.
+func newSubscriptionRoot(topic string, msg interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		rootTopicKey:   topic,
+		rootMessageKey: msg,
+	}
+}
+
+// messageFromRoot returns the event published to the given topic if it is the
+// source of the root object.
+func messageFromRoot(source interface{}, topic string) *types.Event {
+	root, ok := source.(map[string]interface{})
+	if !ok || root[rootTopicKey] != topic {
+		return nil
+	}
+	event, _ := root[rootMessageKey].(*types.Event)
+	return event
+}
+
+//
+// Implement SubscriptionFieldResolvers
+//
+
+type subscriptionImpl struct{}
+
+// Event implements response to request for 'event' field.
+func (subscriptionImpl) Event(p schema.SubscriptionEventFieldResolverParams) (interface{}, error) {
+	event := messageFromRoot(p.Source, messaging.TopicEvent)
+	if event == nil || !matchEvent(event, p.Args.Ns, p.Args.Entity, p.Args.Check) {
+		return nil, nil
+	}
+
+	abilities := authorization.Events.WithContext(p.Context)
+	if !abilities.CanRead(event) {
+		return nil, nil
+	}
+	return event, nil
+}
+
+// Entity implements response to request for 'entity' field.
+func (subscriptionImpl) Entity(p schema.SubscriptionEntityFieldResolverParams) (interface{}, error) {
+	keepalive := messageFromRoot(p.Source, messaging.TopicKeepalive)
+	if keepalive == nil || keepalive.Entity == nil {
+		return nil, nil
+	}
+
+	entity := keepalive.Entity
+	if !matchNamespace(entity, p.Args.Ns) {
+		return nil, nil
+	}
+	if p.Args.Name != "" && p.Args.Name != entity.ID {
+		return nil, nil
+	}
+
+	abilities := authorization.Entities.WithContext(p.Context)
+	if !abilities.CanRead(entity) {
+		return nil, nil
+	}
+	return entity, nil
+}
+
+// CheckResult implements response to request for 'checkResult' field.
+func (subscriptionImpl) CheckResult(p schema.SubscriptionCheckResultFieldResolverParams) (interface{}, error) {
+	event := messageFromRoot(p.Source, messaging.TopicEvent)
+	if event == nil || !event.HasCheck() || event.Check.Name == keepalived.KeepaliveCheckName {
+		return nil, nil
+	}
+	if !matchEvent(event, p.Args.Ns, p.Args.Entity, p.Args.Check) {
+		return nil, nil
+	}
+
+	abilities := authorization.Events.WithContext(p.Context)
+	if !abilities.CanRead(event) {
+		return nil, nil
+	}
+	return event, nil
+}
+
+// matchEvent returns true if the event belongs to the given namespace, entity
+// and check; empty arguments match any value.
+func matchEvent(event *types.Event, ns *schema.NamespaceInput, entity, check string) bool {
+	if event.Entity == nil || !matchNamespace(event.Entity, ns) {
+		return false
+	}
+	if entity != "" && entity != event.Entity.ID {
+		return false
+	}
+	if check != "" && (!event.HasCheck() || check != event.Check.Name) {
+		return false
+	}
+	return true
+}
+
+// matchNamespace returns true if the resource belongs to the given namespace;
+// a nil namespace or an empty environment matches any value.
+func matchNamespace(resource types.MultitenantResource, ns *schema.NamespaceInput) bool {
+	if ns == nil {
+		return true
+	}
+	if ns.Organization != resource.GetOrganization() {
+		return false
+	}
+	return ns.Environment == "" || ns.Environment == resource.GetEnvironment()
+}
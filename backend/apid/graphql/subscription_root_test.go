@@ -0,0 +1,138 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionTypeEventField(t *testing.T) {
+	event := types.FixtureEvent("webserver01", "check-cpu")
+	impl := subscriptionImpl{}
+
+	testCases := []struct {
+		name   string
+		source interface{}
+		args   schema.SubscriptionEventFieldResolverArgs
+		want   bool
+	}{
+		{
+			name:   "any event",
+			source: newSubscriptionRoot(messaging.TopicEvent, event),
+			want:   true,
+		},
+		{
+			name:   "matching event",
+			source: newSubscriptionRoot(messaging.TopicEvent, event),
+			args: schema.SubscriptionEventFieldResolverArgs{
+				Ns:     schema.NewNamespaceInput("default", "default"),
+				Entity: "webserver01",
+				Check:  "check-cpu",
+			},
+			want: true,
+		},
+		{
+			name:   "other namespace",
+			source: newSubscriptionRoot(messaging.TopicEvent, event),
+			args: schema.SubscriptionEventFieldResolverArgs{
+				Ns: schema.NewNamespaceInput("acme", ""),
+			},
+		},
+		{
+			name:   "other check",
+			source: newSubscriptionRoot(messaging.TopicEvent, event),
+			args: schema.SubscriptionEventFieldResolverArgs{
+				Check: "check-mem",
+			},
+		},
+		{
+			name:   "other topic",
+			source: newSubscriptionRoot(messaging.TopicKeepalive, event),
+		},
+		{
+			name: "no message",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := schema.SubscriptionEventFieldResolverParams{Args: tc.args}
+			params.Context = testutil.NewContext(testutil.ContextWithFullAccess)
+			params.Source = tc.source
+
+			res, err := impl.Event(params)
+			require.NoError(t, err)
+			if tc.want {
+				assert.Equal(t, event, res)
+			} else {
+				assert.Nil(t, res)
+			}
+		})
+	}
+
+	// Events the viewer does not have access to are not published
+	params := schema.SubscriptionEventFieldResolverParams{}
+	params.Context = testutil.NewContext(testutil.ContextWithNoAccess)
+	params.Source = newSubscriptionRoot(messaging.TopicEvent, event)
+	res, err := impl.Event(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestSubscriptionTypeEntityField(t *testing.T) {
+	keepalive := types.FixtureEvent("webserver01", "keepalive")
+	impl := subscriptionImpl{}
+
+	params := schema.SubscriptionEntityFieldResolverParams{}
+	params.Context = testutil.NewContext(testutil.ContextWithFullAccess)
+	params.Source = newSubscriptionRoot(messaging.TopicKeepalive, keepalive)
+	params.Args.Name = "webserver01"
+
+	res, err := impl.Entity(params)
+	require.NoError(t, err)
+	assert.Equal(t, keepalive.Entity, res)
+
+	params.Args.Name = "webserver02"
+	res, err = impl.Entity(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	params.Args.Name = ""
+	params.Source = newSubscriptionRoot(messaging.TopicEvent, keepalive)
+	res, err = impl.Entity(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestSubscriptionTypeCheckResultField(t *testing.T) {
+	impl := subscriptionImpl{}
+
+	params := schema.SubscriptionCheckResultFieldResolverParams{}
+	params.Context = testutil.NewContext(testutil.ContextWithFullAccess)
+
+	event := types.FixtureEvent("webserver01", "check-cpu")
+	params.Source = newSubscriptionRoot(messaging.TopicEvent, event)
+	res, err := impl.CheckResult(params)
+	require.NoError(t, err)
+	assert.Equal(t, event, res)
+
+	// Keepalives are not check results
+	keepalive := types.FixtureEvent("webserver01", "keepalive")
+	params.Source = newSubscriptionRoot(messaging.TopicEvent, keepalive)
+	res, err = impl.CheckResult(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Neither are metrics
+	metrics := types.FixtureEvent("webserver01", "check-cpu")
+	metrics.Check = nil
+	params.Source = newSubscriptionRoot(messaging.TopicEvent, metrics)
+	res, err = impl.CheckResult(params)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
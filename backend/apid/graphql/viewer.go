@@ -27,7 +27,7 @@ type viewerImpl struct {
 func newViewerImpl(store store.Store, getter types.QueueGetter, bus messaging.MessageBus) *viewerImpl {
 	return &viewerImpl{
 		checksCtrl: actions.NewCheckController(store, getter),
-		entityCtrl: actions.NewEntityController(store),
+		entityCtrl: actions.NewEntityController(store, bus),
 		eventsCtrl: actions.NewEventController(store, bus),
 		usersCtrl:  actions.NewUserController(store),
 		orgsCtrl:   actions.NewOrganizationsController(store),
@@ -0,0 +1,497 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	graphqlgo "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+// WebsocketProtocol is the websocket subprotocol used to serve GraphQL
+// operations, see
+// https://github.com/apollographql/subscriptions-transport-ws/blob/master/PROTOCOL.md
+const WebsocketProtocol = "graphql-ws"
+
+// Types of the messages of the graphql-ws protocol.
+const (
+	gqlConnectionInit      = "connection_init"
+	gqlConnectionAck       = "connection_ack"
+	gqlConnectionError     = "connection_error"
+	gqlConnectionKeepAlive = "ka"
+	gqlConnectionTerminate = "connection_terminate"
+	gqlStart               = "start"
+	gqlStop                = "stop"
+	gqlData                = "data"
+	gqlError               = "error"
+	gqlComplete            = "complete"
+)
+
+const (
+	// wsKeepAliveInterval is the interval at which keep-alive messages are sent
+	// to the clients.
+	wsKeepAliveInterval = 30 * time.Second
+
+	// wsWriteTimeout is the time allowed to write a message to a client.
+	wsWriteTimeout = 10 * time.Second
+
+	// wsOperationBufferSize is the number of messages of the message bus
+	// buffered for a subscription; messages are dropped when it is exceeded.
+	wsOperationBufferSize = 100
+)
+
+// operationMessage is a message of the graphql-ws protocol.
+type operationMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// initPayload is the payload of a message initializing a connection. Browsers
+// cannot set the headers of websocket requests, so the access token is given
+// here instead, with or without the Bearer prefix.
+type initPayload struct {
+	Authorization string `json:"authorization"`
+}
+
+// startPayload is the payload of a message starting an operation.
+type startPayload struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// WebsocketAuthenticator returns the context of the given websocket upgrade
+// request, authenticated and authorized with the given access token, or an
+// error if the token is rejected.
+type WebsocketAuthenticator func(r *http.Request, token string) (context.Context, error)
+
+// WebsocketHandler serves GraphQL operations over websocket connections using
+// the graphql-ws protocol. Queries and mutations are executed once, while
+// subscriptions are executed every time a message is published to the topics
+// of the message bus backing the selected fields, until the client stops them.
+//
+// Connections are authenticated when initialized by the client, with the
+// access token of the payload of its connection_init message, or else of the
+// Authorization header of the upgrade request. They are closed once the token
+// expires.
+type WebsocketHandler struct {
+	service      *graphql.Service
+	bus          messaging.MessageBus
+	authenticate WebsocketAuthenticator
+	upgrader     *websocket.Upgrader
+}
+
+// NewWebsocketHandler instantiates new websocket handler.
+func NewWebsocketHandler(service *graphql.Service, bus messaging.MessageBus, authenticate WebsocketAuthenticator) *WebsocketHandler {
+	return &WebsocketHandler{
+		service:      service,
+		bus:          bus,
+		authenticate: authenticate,
+		upgrader: &websocket.Upgrader{
+			Subprotocols: []string{WebsocketProtocol},
+		},
+	}
+}
+
+// ServeHTTP upgrades the connection and serves its operations until it is
+// closed.
+func (h *WebsocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.WithError(err).Error("unable to upgrade graphql websocket connection")
+		return
+	}
+
+	c := newWSConnection(r, conn, h.service, h.bus, h.authenticate)
+	c.serve()
+}
+
+// wsConnection holds the state of a websocket connection. Its context is only
+// set once the connection is initialized, by the goroutine serving it.
+type wsConnection struct {
+	id           string
+	req          *http.Request
+	ctx          context.Context
+	cancel       context.CancelFunc
+	conn         *websocket.Conn
+	service      *graphql.Service
+	bus          messaging.MessageBus
+	authenticate WebsocketAuthenticator
+
+	writeMu sync.Mutex
+	opsMu   sync.Mutex
+	ops     map[string]*wsOperation
+}
+
+func newWSConnection(req *http.Request, conn *websocket.Conn, service *graphql.Service, bus messaging.MessageBus, authenticate WebsocketAuthenticator) *wsConnection {
+	return &wsConnection{
+		id:           uuid.New().String(),
+		req:          req,
+		conn:         conn,
+		service:      service,
+		bus:          bus,
+		authenticate: authenticate,
+		ops:          make(map[string]*wsOperation),
+	}
+}
+
+// serve reads the messages of the client until the connection is closed or
+// terminated.
+func (c *wsConnection) serve() {
+	defer c.close()
+
+	for {
+		var msg operationMessage
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.WithError(err).Debug("graphql websocket connection closed")
+			}
+			return
+		}
+
+		switch msg.Type {
+		case gqlConnectionInit:
+			if c.ctx != nil {
+				c.writeError(gqlConnectionError, msg.ID, errors.New("connection already initialized"))
+				continue
+			}
+			expiry, err := c.init(msg.Payload)
+			if err != nil {
+				c.writeError(gqlConnectionError, msg.ID, err)
+				return
+			}
+			c.write(operationMessage{Type: gqlConnectionAck})
+			c.write(operationMessage{Type: gqlConnectionKeepAlive})
+			go c.keepAlive(expiry)
+		case gqlStart, gqlStop:
+			if c.ctx == nil {
+				c.writeError(gqlError, msg.ID, errors.New("connection not initialized"))
+				continue
+			}
+			if msg.Type == gqlStart {
+				c.start(msg.ID, msg.Payload)
+			} else {
+				c.stop(msg.ID)
+			}
+		case gqlConnectionTerminate:
+			return
+		default:
+			c.writeError(gqlConnectionError, msg.ID, fmt.Errorf("unknown message type %q", msg.Type))
+		}
+	}
+}
+
+// init authenticates the connection with the access token of the given
+// payload, or else of the upgrade request, and returns the time the token
+// expires at, zero if it does not.
+func (c *wsConnection) init(rawPayload json.RawMessage) (time.Time, error) {
+	var payload initPayload
+	if len(rawPayload) > 0 {
+		if err := json.Unmarshal(rawPayload, &payload); err != nil {
+			return time.Time{}, fmt.Errorf("invalid payload: %s", err)
+		}
+	}
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+	if token == "" {
+		token = jwt.ExtractBearerToken(c.req)
+	}
+	if token == "" {
+		return time.Time{}, errors.New("missing access token")
+	}
+
+	ctx, err := c.authenticate(c.req, token)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var expiry time.Time
+	if claims := jwt.GetClaimsFromContext(ctx); claims != nil && claims.ExpiresAt > 0 {
+		expiry = time.Unix(claims.ExpiresAt, 0)
+	}
+
+	// reset org & env keys to empty state so that all resources are queryable.
+	ctx = context.WithValue(ctx, types.OrganizationKey, "")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "")
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return expiry, nil
+}
+
+// keepAlive periodically sends keep-alive messages until the connection is
+// closed, and closes it once the given expiry of its access token is reached,
+// if any.
+func (c *wsConnection) keepAlive(expiry time.Time) {
+	ticker := time.NewTicker(wsKeepAliveInterval)
+	defer ticker.Stop()
+
+	var expired <-chan time.Time
+	if !expiry.IsZero() {
+		timer := time.NewTimer(time.Until(expiry))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			c.write(operationMessage{Type: gqlConnectionKeepAlive})
+		case <-expired:
+			c.writeError(gqlConnectionError, "", errors.New("access token expired"))
+			message := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "access token expired")
+			_ = c.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(wsWriteTimeout))
+			// Unblock the goroutine serving the connection, which closes it
+			_ = c.conn.UnderlyingConn().Close()
+			return
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// start starts an operation. Queries and mutations are executed right away,
+// while subscriptions are bound to the topics of the message bus.
+func (c *wsConnection) start(id string, rawPayload json.RawMessage) {
+	var payload startPayload
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		c.writeError(gqlError, id, fmt.Errorf("invalid payload: %s", err))
+		return
+	}
+
+	topics := subscriptionTopicsOf(payload.Query)
+	if len(topics) == 0 {
+		result := c.service.Do(ContextWithLoaders(c.ctx), payload.Query, payload.Variables)
+		c.writeResult(id, result)
+		c.write(operationMessage{ID: id, Type: gqlComplete})
+		return
+	}
+
+	// Validate the subscription before binding it to the message bus; without
+	// a message, the fields of the subscription root resolve to null.
+	if result := c.service.Do(c.ctx, payload.Query, payload.Variables); result.HasErrors() {
+		errs, _ := json.Marshal(result.Errors)
+		c.write(operationMessage{ID: id, Type: gqlError, Payload: errs})
+		return
+	}
+
+	c.opsMu.Lock()
+	defer c.opsMu.Unlock()
+
+	if _, ok := c.ops[id]; ok {
+		c.writeError(gqlError, id, errors.New("operation already started"))
+		return
+	}
+
+	op := &wsOperation{
+		id:      id,
+		payload: payload,
+		roots:   make(chan map[string]interface{}, wsOperationBufferSize),
+		done:    make(chan struct{}),
+	}
+	for _, topic := range topics {
+		subscriber := &wsSubscriber{
+			topic: topic,
+			ch:    make(chan interface{}, wsOperationBufferSize),
+			op:    op,
+		}
+		consumer := fmt.Sprintf("graphql:%s:%s", c.id, id)
+		sub, err := c.bus.Subscribe(topic, consumer, subscriber)
+		if err != nil {
+			op.cancel()
+			c.writeError(gqlError, id, err)
+			return
+		}
+		op.subscriptions = append(op.subscriptions, sub)
+		go subscriber.forward()
+	}
+
+	c.ops[id] = op
+	go c.execute(op)
+}
+
+// execute executes the subscription every time a message is received, until
+// the operation is stopped.
+func (c *wsConnection) execute(op *wsOperation) {
+	for {
+		select {
+		case root := <-op.roots:
+			ctx := ContextWithLoaders(c.ctx)
+			result := c.service.DoWithRoot(ctx, op.payload.Query, op.payload.Variables, root)
+			if isEmptyResult(result) {
+				continue
+			}
+			c.writeResult(op.id, result)
+		case <-op.done:
+			return
+		}
+	}
+}
+
+// stop stops a subscription and notifies the client.
+func (c *wsConnection) stop(id string) {
+	c.opsMu.Lock()
+	op, ok := c.ops[id]
+	delete(c.ops, id)
+	c.opsMu.Unlock()
+
+	if ok {
+		op.cancel()
+	}
+	c.write(operationMessage{ID: id, Type: gqlComplete})
+}
+
+// close stops all subscriptions and closes the connection.
+func (c *wsConnection) close() {
+	c.opsMu.Lock()
+	for id, op := range c.ops {
+		op.cancel()
+		delete(c.ops, id)
+	}
+	c.opsMu.Unlock()
+
+	if c.cancel != nil {
+		c.cancel()
+	}
+	if err := c.conn.Close(); err != nil {
+		logger.WithError(err).Debug("error closing graphql websocket connection")
+	}
+}
+
+func (c *wsConnection) write(msg operationMessage) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		logger.WithError(err).Debug("unable to write graphql websocket message")
+		return
+	}
+	if err := c.conn.WriteJSON(msg); err != nil {
+		logger.WithError(err).Debug("unable to write graphql websocket message")
+	}
+}
+
+func (c *wsConnection) writeResult(id string, result *graphqlgo.Result) {
+	if len(result.Errors) > 0 {
+		logger.
+			WithField("errors", result.Errors).
+			Error("error(s) occurred while executing GraphQL operation")
+	}
+
+	payload, err := json.Marshal(result)
+	if err != nil {
+		c.writeError(gqlError, id, err)
+		return
+	}
+	c.write(operationMessage{ID: id, Type: gqlData, Payload: payload})
+}
+
+func (c *wsConnection) writeError(typ, id string, err error) {
+	payload, _ := json.Marshal(map[string]string{"message": err.Error()})
+	c.write(operationMessage{ID: id, Type: typ, Payload: payload})
+}
+
+// wsOperation holds the state of a subscription.
+type wsOperation struct {
+	id            string
+	payload       startPayload
+	roots         chan map[string]interface{}
+	subscriptions []messaging.Subscription
+	done          chan struct{}
+}
+
+// cancel unbinds the subscription from the message bus.
+func (op *wsOperation) cancel() {
+	for _, sub := range op.subscriptions {
+		if err := sub.Cancel(); err != nil {
+			logger.WithError(err).Error("unable to cancel graphql subscription")
+		}
+	}
+	close(op.done)
+}
+
+// wsSubscriber receives the messages of a topic of the message bus for a
+// subscription.
+type wsSubscriber struct {
+	topic string
+	ch    chan interface{}
+	op    *wsOperation
+}
+
+// Receiver returns the channel the subscriber receives messages from.
+func (s *wsSubscriber) Receiver() chan<- interface{} {
+	return s.ch
+}
+
+// forward passes the received messages to the subscription without ever
+// blocking the message bus; messages are dropped when the client can't keep
+// up.
+func (s *wsSubscriber) forward() {
+	for {
+		select {
+		case msg := <-s.ch:
+			select {
+			case s.op.roots <- newSubscriptionRoot(s.topic, msg):
+			default:
+				logger.WithField("topic", s.topic).Warn("graphql subscription buffer full, dropping message")
+			}
+		case <-s.op.done:
+			return
+		}
+	}
+}
+
+// subscriptionTopicsOf returns the topics of the message bus backing the
+// fields selected by the subscription of the given query. No topic is returned
+// if the query does not contain a valid subscription.
+func subscriptionTopicsOf(query string) []string {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil
+	}
+
+	var topics []string
+	seen := map[string]bool{}
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok || op.Operation != ast.OperationTypeSubscription || op.SelectionSet == nil {
+			continue
+		}
+		for _, selection := range op.SelectionSet.Selections {
+			field, ok := selection.(*ast.Field)
+			if !ok || field.Name == nil {
+				continue
+			}
+			topic, ok := subscriptionTopics[field.Name.Value]
+			if ok && !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// isEmptyResult returns true if none of the fields of the subscription root
+// resolved to a value.
+func isEmptyResult(result *graphqlgo.Result) bool {
+	if result.HasErrors() {
+		return false
+	}
+	data, _ := result.Data.(map[string]interface{})
+	for _, value := range data {
+		if value != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,252 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/testing/mockring"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authenticateTestToken accepts the "valid" token, and the "expiring" token
+// expiring a second from now
+func authenticateTestToken(r *http.Request, token string) (context.Context, error) {
+	claims := &types.Claims{}
+	switch token {
+	case "valid":
+	case "expiring":
+		claims.ExpiresAt = time.Now().Add(time.Second).Unix()
+	default:
+		return nil, errors.New("Invalid token given")
+	}
+	ctx := context.WithValue(r.Context(), types.ClaimsKey, claims)
+	return testutil.ApplyContext(ctx, testutil.ContextWithFullAccess), nil
+}
+
+func newWebsocketTestServer(t *testing.T, header http.Header) (*websocket.Conn, messaging.MessageBus, func()) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	svc, err := NewService(ServiceConfig{
+		Store:       &mockstore.MockStore{},
+		Bus:         bus,
+		QueueGetter: queue.NewMemoryGetter(),
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(NewWebsocketHandler(svc, bus, authenticateTestToken))
+
+	dialer := websocket.Dialer{Subprotocols: []string{WebsocketProtocol}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), header)
+	require.NoError(t, err)
+	assert.Equal(t, WebsocketProtocol, conn.Subprotocol())
+
+	return conn, bus, func() {
+		_ = conn.Close()
+		server.Close()
+		_ = bus.Stop()
+	}
+}
+
+func readOperationMessage(t *testing.T, conn *websocket.Conn) operationMessage {
+	var msg operationMessage
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func initConnection(t *testing.T, conn *websocket.Conn, token string) {
+	payload, err := json.Marshal(initPayload{Authorization: "Bearer " + token})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(operationMessage{Type: gqlConnectionInit, Payload: payload}))
+	assert.Equal(t, gqlConnectionAck, readOperationMessage(t, conn).Type)
+	assert.Equal(t, gqlConnectionKeepAlive, readOperationMessage(t, conn).Type)
+}
+
+func startOperation(t *testing.T, conn *websocket.Conn, id, query string) {
+	payload, err := json.Marshal(startPayload{Query: query})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(operationMessage{ID: id, Type: gqlStart, Payload: payload}))
+}
+
+func TestWebsocketSubscription(t *testing.T) {
+	conn, bus, cleanup := newWebsocketTestServer(t, nil)
+	defer cleanup()
+
+	initConnection(t, conn, "valid")
+
+	startOperation(t, conn, "1", `subscription { checkResult(entity: "webserver01") { check { name } } }`)
+
+	// The subscription is bound to the bus once validated; publish until the
+	// first result is received.
+	var msg operationMessage
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		msg = readOperationMessage(t, conn)
+	}()
+
+	events := []*types.Event{
+		types.FixtureEvent("webserver02", "check-cpu"),
+		types.FixtureEvent("webserver01", "keepalive"),
+		types.FixtureEvent("webserver01", "check-cpu"),
+	}
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+loop:
+	for {
+		select {
+		case <-received:
+			break loop
+		case <-ticker.C:
+			for _, event := range events {
+				require.NoError(t, bus.Publish(messaging.TopicEvent, event))
+			}
+		}
+	}
+
+	assert.Equal(t, "1", msg.ID)
+	assert.Equal(t, gqlData, msg.Type)
+	assert.JSONEq(t, `{"data": {"checkResult": {"check": {"name": "check-cpu"}}}}`, string(msg.Payload))
+
+	require.NoError(t, conn.WriteJSON(operationMessage{ID: "1", Type: gqlStop}))
+	for {
+		msg = readOperationMessage(t, conn)
+		if msg.Type != gqlData {
+			break
+		}
+	}
+	assert.Equal(t, "1", msg.ID)
+	assert.Equal(t, gqlComplete, msg.Type)
+}
+
+func TestWebsocketInvalidSubscription(t *testing.T) {
+	conn, _, cleanup := newWebsocketTestServer(t, nil)
+	defer cleanup()
+
+	initConnection(t, conn, "valid")
+	startOperation(t, conn, "1", `subscription { checkResult(unknown: true) { id } }`)
+
+	msg := readOperationMessage(t, conn)
+	assert.Equal(t, "1", msg.ID)
+	assert.Equal(t, gqlError, msg.Type)
+}
+
+func TestWebsocketQuery(t *testing.T) {
+	conn, _, cleanup := newWebsocketTestServer(t, nil)
+	defer cleanup()
+
+	initConnection(t, conn, "valid")
+	startOperation(t, conn, "1", `query { __typename }`)
+
+	msg := readOperationMessage(t, conn)
+	assert.Equal(t, gqlData, msg.Type)
+	assert.JSONEq(t, `{"data": {"__typename": "Query"}}`, string(msg.Payload))
+
+	msg = readOperationMessage(t, conn)
+	assert.Equal(t, "1", msg.ID)
+	assert.Equal(t, gqlComplete, msg.Type)
+}
+
+func TestWebsocketAuthentication(t *testing.T) {
+	testCases := []struct {
+		name    string
+		header  http.Header
+		payload string
+		wantErr string
+	}{
+		{"payload token", nil, `{"authorization": "valid"}`, ""},
+		{"bearer payload token", nil, `{"authorization": "Bearer valid"}`, ""},
+		{"header token", http.Header{"Authorization": {"Bearer valid"}}, "", ""},
+		{"payload token first", http.Header{"Authorization": {"Bearer invalid"}}, `{"authorization": "valid"}`, ""},
+		{"missing token", nil, "", "missing access token"},
+		{"invalid token", nil, `{"authorization": "invalid"}`, "Invalid token given"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, _, cleanup := newWebsocketTestServer(t, tc.header)
+			defer cleanup()
+
+			// Operations are only served once the connection is initialized
+			startOperation(t, conn, "1", `query { __typename }`)
+			msg := readOperationMessage(t, conn)
+			assert.Equal(t, gqlError, msg.Type)
+			assert.JSONEq(t, `{"message": "connection not initialized"}`, string(msg.Payload))
+
+			init := operationMessage{Type: gqlConnectionInit}
+			if tc.payload != "" {
+				init.Payload = json.RawMessage(tc.payload)
+			}
+			require.NoError(t, conn.WriteJSON(init))
+			msg = readOperationMessage(t, conn)
+			if tc.wantErr != "" {
+				assert.Equal(t, gqlConnectionError, msg.Type)
+				assert.JSONEq(t, fmt.Sprintf(`{"message": %q}`, tc.wantErr), string(msg.Payload))
+
+				// And the connection is closed
+				_, _, err := conn.ReadMessage()
+				assert.Error(t, err)
+				return
+			}
+			assert.Equal(t, gqlConnectionAck, msg.Type)
+			assert.Equal(t, gqlConnectionKeepAlive, readOperationMessage(t, conn).Type)
+
+			startOperation(t, conn, "1", `query { __typename }`)
+			assert.Equal(t, gqlData, readOperationMessage(t, conn).Type)
+		})
+	}
+}
+
+func TestWebsocketInitializedOnce(t *testing.T) {
+	conn, _, cleanup := newWebsocketTestServer(t, nil)
+	defer cleanup()
+
+	initConnection(t, conn, "valid")
+	require.NoError(t, conn.WriteJSON(operationMessage{Type: gqlConnectionInit}))
+	msg := readOperationMessage(t, conn)
+	assert.Equal(t, gqlConnectionError, msg.Type)
+	assert.JSONEq(t, `{"message": "connection already initialized"}`, string(msg.Payload))
+
+	// The connection is still served
+	startOperation(t, conn, "1", `query { __typename }`)
+	assert.Equal(t, gqlData, readOperationMessage(t, conn).Type)
+}
+
+func TestWebsocketTokenExpiry(t *testing.T) {
+	conn, _, cleanup := newWebsocketTestServer(t, nil)
+	defer cleanup()
+
+	initConnection(t, conn, "expiring")
+	msg := readOperationMessage(t, conn)
+	assert.Equal(t, gqlConnectionError, msg.Type)
+	assert.JSONEq(t, `{"message": "access token expired"}`, string(msg.Payload))
+
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), err)
+}
+
+func TestSubscriptionTopicsOf(t *testing.T) {
+	assert.Equal(t,
+		[]string{messaging.TopicEvent, messaging.TopicKeepalive},
+		subscriptionTopicsOf(`subscription { event { id } checkResult { id } entity { id } }`),
+	)
+	assert.Empty(t, subscriptionTopicsOf(`query { viewer { __typename } }`))
+	assert.Empty(t, subscriptionTopicsOf(`subscription {`))
+}
@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Audit is an HTTP middleware that records the requests modifying resources
+// in the audit log, along with the user who made them and their outcome.
+type Audit struct {
+	Store store.AuditStore
+
+	// Retention is how long entries are kept; zero disables the audit log.
+	Retention time.Duration
+
+	// Exclude lists the paths of the requests not to record, like those of the
+	// GraphQL endpoint, whose queries are made with POST and whose mutations
+	// are recorded by the GraphQL service itself.
+	Exclude []string
+}
+
+// Then middleware
+func (a Audit) Then(next http.Handler) http.Handler {
+	if a.Retention <= 0 {
+		return next
+	}
+	ttl := int64(a.Retention / time.Second)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verb := types.AuditVerb(r.Method)
+		if verb == "" || a.excluded(r.URL.Path) || isDryRun(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		entry := &types.AuditEntry{
+			ID:           uuid.New().String(),
+			Verb:         verb,
+			Resource:     r.URL.Path,
+			Organization: types.ContextOrganization(r.Context()),
+			Environment:  types.ContextEnvironment(r.Context()),
+			RequestID:    types.ContextRequestID(r.Context()),
+			Timestamp:    time.Now().Unix(),
+		}
+		if claims := jwt.GetClaimsFromContext(r.Context()); claims != nil {
+			entry.User = claims.Subject
+		}
+
+		writerWithCapture := makeResponseWriterWithCapture(w)
+		next.ServeHTTP(writerWithCapture, r)
+
+		entry.Status = writerWithCapture.Status()
+		entry.Outcome = types.AuditOutcome(entry.Status)
+
+		// The request may be cancelled once the response is written
+		if err := a.Store.CreateAuditEntry(context.Background(), entry, ttl); err != nil {
+			logger.WithError(err).WithField("resource", entry.Resource).Error("could not record audit entry")
+		}
+	})
+}
+
+// isDryRun returns true if the given request is a dry run, which does not
+// modify resources.
+func isDryRun(r *http.Request) bool {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dryRun"))
+	return dryRun
+}
+
+func (a Audit) excluded(path string) bool {
+	for _, excluded := range a.Exclude {
+		if path == excluded {
+			return true
+		}
+	}
+	return false
+}
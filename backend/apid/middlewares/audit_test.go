@@ -0,0 +1,64 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAudit(t *testing.T) {
+	store := &mockstore.MockStore{}
+	var entry *types.AuditEntry
+	store.On("CreateAuditEntry", mock.Anything, mock.Anything, int64(3600)).Run(func(args mock.Arguments) {
+		entry = args.Get(1).(*types.AuditEntry)
+	}).Return(nil)
+
+	mware := Audit{Store: store, Retention: time.Hour, Exclude: []string{"/graphql"}}
+	handler := mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	req := httptest.NewRequest(http.MethodDelete, "/checks/check-cpu", nil)
+	claims, _ := jwt.NewClaims("foo")
+	ctx := jwt.SetClaimsIntoContext(req, claims)
+	ctx = context.WithValue(ctx, types.OrganizationKey, "acme")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "dev")
+	ctx = context.WithValue(ctx, types.RequestIDKey, "abc")
+	handler.ServeHTTP(httptest.NewRecorder(), req.WithContext(ctx))
+
+	store.AssertNumberOfCalls(t, "CreateAuditEntry", 1)
+	assert.NotEmpty(t, entry.ID)
+	assert.Equal(t, "foo", entry.User)
+	assert.Equal(t, "delete", entry.Verb)
+	assert.Equal(t, "/checks/check-cpu", entry.Resource)
+	assert.Equal(t, "acme", entry.Organization)
+	assert.Equal(t, "dev", entry.Environment)
+	assert.Equal(t, types.AuditOutcomeFailure, entry.Outcome)
+	assert.Equal(t, http.StatusForbidden, entry.Status)
+	assert.Equal(t, "abc", entry.RequestID)
+	assert.NotZero(t, entry.Timestamp)
+
+	// Requests which do not modify resources are not recorded
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/checks", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/graphql", nil))
+	store.AssertNumberOfCalls(t, "CreateAuditEntry", 1)
+}
+
+func TestAuditDisabled(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Audit{Store: store}
+	handler := mware.Then(testHandler())
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/checks", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	store.AssertNotCalled(t, "CreateAuditEntry", mock.Anything, mock.Anything, mock.Anything)
+}
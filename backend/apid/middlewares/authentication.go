@@ -1,8 +1,11 @@
 package middlewares
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/types"
@@ -43,6 +46,50 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 	})
 }
 
+// AuthenticateToken returns a function authenticating the given request with
+// the given access token rather than with its Authorization header, through
+// the given middlewares: it returns the context given to the request by the
+// middlewares, or the error they rejected it with. It serves the requests that
+// cannot carry the token in their headers, like the websocket connections of
+// browsers.
+func AuthenticateToken(ms ...HTTPMiddleware) func(*http.Request, string) (context.Context, error) {
+	return func(r *http.Request, token string) (context.Context, error) {
+		req := r.WithContext(r.Context())
+		req.Header = make(http.Header, len(r.Header)+1)
+		for key, values := range r.Header {
+			req.Header[key] = values
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		var ctx context.Context
+		rejection := &rejectionWriter{header: http.Header{}}
+		Apply(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		}), ms...).ServeHTTP(rejection, req)
+		if ctx == nil {
+			return nil, errors.New(strings.TrimSpace(rejection.body.String()))
+		}
+		return ctx, nil
+	}
+}
+
+// rejectionWriter records the body of the response of a middleware rejecting
+// a request
+type rejectionWriter struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (w *rejectionWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *rejectionWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *rejectionWriter) WriteHeader(int) {}
+
 // BasicAuthentication is HTTP middleware for basic authentication
 func BasicAuthentication(next http.Handler, store AuthStore) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
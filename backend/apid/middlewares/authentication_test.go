@@ -8,6 +8,7 @@ import (
 
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMiddlewareNoCredentials(t *testing.T) {
@@ -58,3 +59,21 @@ func TestMiddlewareInvalidJWT(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
 }
+
+func TestAuthenticateToken(t *testing.T) {
+	authenticate := AuthenticateToken(Authentication{})
+	req, _ := http.NewRequest("GET", "/graphql", nil)
+
+	// The claims of a valid token are set into the context
+	_, tokenString, _ := jwt.AccessToken("foo")
+	ctx, err := authenticate(req, tokenString)
+	require.NoError(t, err)
+	claims := jwt.GetClaimsFromContext(ctx)
+	require.NotNil(t, claims)
+	assert.Equal(t, "foo", claims.Subject)
+	assert.Empty(t, req.Header.Get("Authorization"))
+
+	// Invalid tokens are rejected with the error of the middleware
+	_, err = authenticate(req, "foobar")
+	assert.EqualError(t, err, "Invalid token given")
+}
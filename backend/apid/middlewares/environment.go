@@ -15,7 +15,8 @@ const (
 
 // Environment retrieves any organization and environment passed as query
 // parameters and validate their existence against the data store and then add
-// them to the request context
+// them to the request context. A namespace passed as query parameter overrides
+// both the organization and the environment.
 type Environment struct {
 	Store store.Store
 }
@@ -30,6 +31,14 @@ func (m Environment) Then(next http.Handler) http.Handler {
 		if org = r.URL.Query().Get("org"); org == "" {
 			org = defaultOrganization
 		}
+		if name := r.URL.Query().Get("namespace"); name != "" {
+			ns := types.Namespace{Name: name}
+			if err := ns.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			org, env = ns.GetOrganization(), ns.GetEnvironment()
+		}
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, types.OrganizationKey, org)
@@ -101,3 +101,41 @@ func TestValidateWildcard(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, res.StatusCode)
 }
+
+func TestEnvironmentNamespace(t *testing.T) {
+	mware := Environment{Store: &mockstore.MockStore{}}
+	server := httptest.NewServer(mware.Then(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "acme", types.ContextOrganization(r.Context()))
+			assert.Equal(t, "production", types.ContextEnvironment(r.Context()))
+		}),
+	))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	query := req.URL.Query()
+	query.Add("org", "foo")
+	query.Add("namespace", "acme/production")
+	req.URL.RawQuery = query.Encode()
+
+	res, err := http.DefaultClient.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestEnvironmentInvalidNamespace(t *testing.T) {
+	mware := Environment{Store: &mockstore.MockStore{}}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	query := req.URL.Query()
+	query.Add("namespace", "production")
+	req.URL.RawQuery = query.Encode()
+
+	res, err := http.DefaultClient.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
@@ -0,0 +1,44 @@
+package middlewares
+
+import (
+	"compress/gzip"
+	"net/http"
+
+	"github.com/NYTimes/gziphandler"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+// Gzip is an HTTP middleware that compresses the responses with gzip, when
+// accepted by the client. Responses are buffered until MinSize bytes are
+// written, smaller ones being written as is.
+type Gzip struct {
+	// MinSize is the size, in bytes, from which responses are compressed; zero
+	// disables compression.
+	MinSize int
+
+	// Exclude lists the paths of the streamed responses, like Server-Sent
+	// Events, which must not be buffered.
+	Exclude []string
+}
+
+// Then middleware
+func (g Gzip) Then(next http.Handler) http.Handler {
+	if g.MinSize <= 0 {
+		return next
+	}
+	compress, err := gziphandler.NewGzipLevelAndMinSize(gzip.DefaultCompression, g.MinSize)
+	if err != nil {
+		logger.WithError(err).Error("responses will not be compressed")
+		return next
+	}
+	compressed := compress(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Websocket connections are hijacked from the response
+		if r.Header.Get("Upgrade") != "" || utilstrings.InArray(r.URL.Path, g.Exclude) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		compressed.ServeHTTP(w, r)
+	})
+}
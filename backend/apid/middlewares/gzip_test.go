@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGzip(t *testing.T) {
+	large := strings.Repeat(`{"name":"check-cpu"}`, 100)
+	mware := Gzip{MinSize: 1400, Exclude: []string{"/stream"}}
+	handler := mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("small") != "" {
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		_, _ = w.Write([]byte(large))
+	}))
+
+	testCases := []struct {
+		name         string
+		path         string
+		encoding     string
+		wantEncoding string
+	}{
+		{"compressed", "/checks", "gzip", "gzip"},
+		{"not accepted", "/checks", "", ""},
+		{"too small", "/checks?small=true", "gzip", ""},
+		{"excluded", "/stream", "gzip", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.Header.Set("Accept-Encoding", tc.encoding)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tc.wantEncoding, w.Header().Get("Content-Encoding"))
+			if tc.wantEncoding == "" {
+				return
+			}
+			reader, err := gzip.NewReader(w.Body)
+			require.NoError(t, err)
+			body, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, large, string(body))
+		})
+	}
+}
+
+func TestGzipDisabled(t *testing.T) {
+	next := testHandler()
+	handler := Gzip{}.Then(next)
+
+	req := httptest.NewRequest(http.MethodGet, "/checks", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+}
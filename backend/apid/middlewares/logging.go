@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/sensu/sensu-go/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -32,6 +33,9 @@ func (m SimpleLogger) Then(next http.Handler) http.Handler {
 			"path":     r.URL.Path,
 			"method":   r.Method,
 		})
+		if id := types.ContextRequestID(r.Context()); id != "" {
+			logEntry = logEntry.WithField("request_id", id)
+		}
 		logEntry.Info("request completed")
 	})
 }
@@ -0,0 +1,117 @@
+package middlewares
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter holds a token bucket per client of the API, each one refilled
+// at the given rate, in requests per second, up to the given burst.
+type RateLimiter struct {
+	Rate  float64
+	Burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter; a zero rate disables it.
+func NewRateLimiter(r float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = int(math.Ceil(r))
+	}
+	return &RateLimiter{
+		Rate:    r,
+		Burst:   burst,
+		buckets: map[string]*bucket{},
+	}
+}
+
+// Reserve takes a token from the bucket of the given client, and returns how
+// long the client must wait before retrying when the bucket is empty.
+func (l *RateLimiter) Reserve(key string) (time.Duration, bool) {
+	now := time.Now()
+
+	l.mu.Lock()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.Rate), l.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	l.mu.Unlock()
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// sweep forgets the buckets that have been refilled since they were last used,
+// as they are equivalent to new ones. It must be called with the lock held.
+func (l *RateLimiter) sweep(now time.Time) {
+	refill := time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+	if now.Sub(l.lastSweep) < refill {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// RateLimit is an HTTP middleware that limits the rate of requests of each
+// authenticated user, or of each source IP for anonymous requests. Requests
+// exceeding the limit are rejected with 429 Too Many Requests, along with the
+// number of seconds to wait in the Retry-After header.
+type RateLimit struct {
+	Limiter *RateLimiter
+}
+
+// Then middleware
+func (m RateLimit) Then(next http.Handler) http.Handler {
+	if m.Limiter == nil || m.Limiter.Rate <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delay, ok := m.Limiter.Reserve(rateLimitKey(r))
+		if !ok {
+			retryAfter := int(math.Ceil(delay.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rateLimitKey identifies the client of the given request, by the user given
+// in its access token if authenticated, by its source IP otherwise.
+func rateLimitKey(r *http.Request) string {
+	if claims := jwt.GetClaimsFromContext(r.Context()); claims != nil && claims.Subject != "" {
+		return "user:" + claims.Subject
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
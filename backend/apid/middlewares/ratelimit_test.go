@@ -0,0 +1,77 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	mware := RateLimit{Limiter: NewRateLimiter(0.5, 2)}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		res, err := http.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	res, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, "2", res.Header.Get("Retry-After"))
+}
+
+func TestRateLimitPerUser(t *testing.T) {
+	mware := RateLimit{Limiter: NewRateLimiter(0.5, 1)}
+	handler := mware.Then(testHandler())
+
+	request := func(username string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if username != "" {
+			claims, _ := jwt.NewClaims(username)
+			req = req.WithContext(jwt.SetClaimsIntoContext(req, claims))
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request("foo"))
+	assert.Equal(t, http.StatusTooManyRequests, request("foo"))
+
+	// Other users and anonymous requests have their own limit
+	assert.Equal(t, http.StatusOK, request("bar"))
+	assert.Equal(t, http.StatusOK, request(""))
+	assert.Equal(t, http.StatusTooManyRequests, request(""))
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	mware := RateLimit{Limiter: NewRateLimiter(0, 0)}
+	handler := mware.Then(testHandler())
+
+	for i := 0; i < 10; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	limiter := NewRateLimiter(1000, 1)
+	_, ok := limiter.Reserve("foo")
+	assert.True(t, ok)
+	assert.Len(t, limiter.buckets, 1)
+
+	limiter.lastSweep = limiter.lastSweep.Add(-time.Second)
+	limiter.buckets["foo"].lastSeen = limiter.buckets["foo"].lastSeen.Add(-time.Second)
+	_, ok = limiter.Reserve("bar")
+	assert.True(t, ok)
+	assert.Len(t, limiter.buckets, 1)
+	assert.Contains(t, limiter.buckets, "bar")
+}
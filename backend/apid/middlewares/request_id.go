@@ -0,0 +1,39 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/types"
+)
+
+// RequestIDHeader is the header carrying the ID of a request, in both the
+// request and its response.
+const RequestIDHeader = "X-Request-ID"
+
+// validRequestID matches the request IDs provided by clients which are kept,
+// so they can't be used to inject arbitrary content in the logs.
+var validRequestID = regexp.MustCompile(`^[a-zA-Z0-9._:-]{1,128}$`)
+
+// RequestID is an HTTP middleware that identifies each request, so the events
+// and notifications it results in can be traced back to it. The ID provided by
+// the client in the X-Request-ID header is kept if valid, otherwise a new one
+// is generated. It is written in the response headers and injected in the
+// context of the request.
+type RequestID struct{}
+
+// Then middleware
+func (m RequestID) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = uuid.New().String()
+		}
+		w.Header().Set(RequestIDHeader, id)
+
+		ctx := context.WithValue(r.Context(), types.RequestIDKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
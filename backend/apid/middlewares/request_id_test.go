@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	var id string
+	handler := RequestID{}.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id = types.ContextRequestID(r.Context())
+	}))
+
+	testCases := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"generated", "", ""},
+		{"provided", "abc-123", "abc-123"},
+		{"invalid characters", "abc\n123", ""},
+		{"too long", strings.Repeat("a", 129), ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/checks", nil)
+			if tc.header != "" {
+				req.Header.Set(RequestIDHeader, tc.header)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.NotEmpty(t, id)
+			assert.Equal(t, id, w.Header().Get(RequestIDHeader))
+			if tc.expected != "" {
+				assert.Equal(t, tc.expected, id)
+			} else {
+				assert.NotEqual(t, tc.header, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const contentTypeJSON = "application/json"
+
+// pathVarRegexp matches the variables of mux path templates, along with their
+// optional pattern, e.g. {id} or {id:[0-9]+}.
+var pathVarRegexp = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// Resource associates the paths of a resource with its type, so the operations
+// on them are described with the schema of the resource.
+type Resource struct {
+	// Path lists the resources with GET and creates one with POST.
+	Path string
+
+	// ItemPath reads, replaces, patches and deletes a single resource; it
+	// defaults to Path/{id}.
+	ItemPath string
+
+	// Type is a value of the resource type, e.g. types.CheckConfig{}.
+	Type interface{}
+
+	// DryRun is true if creating and updating the resource can be dry runs.
+	DryRun bool
+
+	// Paginated is true if the resources can be listed a page at a time.
+	Paginated bool
+}
+
+// Generate returns the document describing the routes of the given router and
+// its subrouters; the operations on the paths of the given resources are
+// described with their schemas, the other ones with generic JSON values.
+func Generate(info Info, router *mux.Router, resources []Resource) (*Document, error) {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]*PathItem{},
+		Components: Components{
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+	}
+	schemas := Schemas{}
+	schemas["Error"] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"error": {Type: "string"},
+			"code":  {Type: "integer", Format: "int32"},
+		},
+	}
+
+	collections, items := map[string]*Schema{}, map[string]*Schema{}
+	dryRuns, paginated := map[string]bool{}, map[string]bool{}
+	for _, resource := range resources {
+		schema := schemas.For(reflect.TypeOf(resource.Type))
+		itemPath := resource.ItemPath
+		if itemPath == "" {
+			itemPath = resource.Path + "/{id}"
+		}
+		collections[resource.Path] = schema
+		items[itemPath] = schema
+		dryRuns[resource.Path] = resource.DryRun
+		dryRuns[itemPath] = resource.DryRun
+		paginated[resource.Path] = resource.Paginated
+	}
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		tpl, err := route.GetPathTemplate()
+		if err != nil {
+			// Routes without path, like those of subrouters
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil || len(methods) == 0 {
+			// Routes accepting any method are not operations of the API
+			return nil
+		}
+		path := pathVarRegexp.ReplaceAllString(tpl, "{$1}")
+
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		for _, method := range methods {
+			op := newOperation(method, path)
+			if schema, ok := collections[path]; ok {
+				describeCollection(op, method, schema, paginated[path])
+			} else if schema, ok := items[path]; ok {
+				describeItem(op, method, schema)
+			} else {
+				op.Responses["2XX"] = jsonResponse("Success", &Schema{})
+			}
+			if dryRuns[path] && method != http.MethodGet && method != http.MethodDelete {
+				op.Parameters = append(op.Parameters, Parameter{Name: "dryRun", In: "query", Description: "validates the resource without storing it", Schema: &Schema{Type: "boolean"}})
+			}
+			(*item)[strings.ToLower(method)] = op
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Components.Schemas = schemas
+	return doc, nil
+}
+
+// newOperation returns the operation of the given method on the given path,
+// with its path parameters and error response.
+func newOperation(method, path string) *Operation {
+	op := &Operation{
+		OperationID: operationID(method, path),
+		Responses: map[string]*Response{
+			"default": jsonResponse("Error", &Schema{Ref: "#/components/schemas/Error"}),
+		},
+	}
+	for _, match := range pathVarRegexp.FindAllStringSubmatch(path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	return op
+}
+
+func describeCollection(op *Operation, method string, schema *Schema, paginated bool) {
+	switch method {
+	case http.MethodGet:
+		if paginated {
+			op.Parameters = append(op.Parameters,
+				Parameter{Name: "limit", In: "query", Description: "maximum number of resources to list", Schema: &Schema{Type: "integer", Format: "int64"}},
+				Parameter{Name: "continue", In: "query", Description: "token of the page to list, as given by the Sensu-Continue header", Schema: &Schema{Type: "string"}},
+			)
+		}
+		op.Parameters = append(op.Parameters,
+			Parameter{Name: "labelSelector", In: "query", Description: "selects resources by their labels", Schema: &Schema{Type: "string"}},
+			Parameter{Name: "fieldSelector", In: "query", Description: "selects resources by their fields", Schema: &Schema{Type: "string"}},
+		)
+		op.Responses["200"] = jsonResponse("OK", &Schema{Type: "array", Items: schema})
+	case http.MethodPost:
+		op.RequestBody = jsonRequestBody(schema)
+		op.Responses["204"] = &Response{Description: "Created"}
+	default:
+		op.Responses["2XX"] = jsonResponse("Success", &Schema{})
+	}
+}
+
+func describeItem(op *Operation, method string, schema *Schema) {
+	switch method {
+	case http.MethodGet:
+		op.Responses["200"] = jsonResponse("OK", schema)
+		op.Responses["304"] = &Response{Description: "Not Modified"}
+	case http.MethodPut:
+		op.RequestBody = jsonRequestBody(schema)
+		op.Responses["204"] = &Response{Description: "Created or replaced"}
+	case http.MethodPatch:
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				"application/merge-patch+json": {Schema: &Schema{Type: "object"}},
+				"application/json-patch+json":  {Schema: &Schema{Type: "array", Items: &Schema{Type: "object"}}},
+			},
+		}
+		op.Responses["200"] = jsonResponse("OK", schema)
+	case http.MethodDelete:
+		op.Responses["204"] = &Response{Description: "Deleted"}
+	default:
+		op.Responses["2XX"] = jsonResponse("Success", &Schema{})
+	}
+}
+
+func jsonRequestBody(schema *Schema) *RequestBody {
+	return &RequestBody{
+		Required: true,
+		Content:  map[string]MediaType{contentTypeJSON: {Schema: schema}},
+	}
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content:     map[string]MediaType{contentTypeJSON: {Schema: schema}},
+	}
+}
+
+// operationID returns a unique identifier of the given operation, derived
+// from its method and path, e.g. getChecksById for GET /checks/{id}.
+func operationID(method, path string) string {
+	id := strings.ToLower(method)
+	for _, segment := range strings.Split(path, "/") {
+		if match := pathVarRegexp.FindStringSubmatch(segment); match != nil {
+			id += "By" + title(match[1])
+			continue
+		}
+		id += title(segment)
+	}
+	return id
+}
+
+// title returns the given path segment in title case, without punctuation.
+func title(segment string) string {
+	words := strings.FieldsFunc(segment, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9')
+	})
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, "")
+}
@@ -0,0 +1,76 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	handler := func(http.ResponseWriter, *http.Request) {}
+	router := mux.NewRouter()
+	sub := router.NewRoute().Subrouter()
+	sub.HandleFunc("/resources", handler).Methods(http.MethodGet, http.MethodPost)
+	sub.HandleFunc("/resources/{id}", handler).Methods(http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete)
+	sub.HandleFunc("/resources/{id}/items/{item:[0-9]+}", handler).Methods(http.MethodPost)
+	router.HandleFunc("/info", handler).Methods(http.MethodGet)
+	router.HandleFunc("/any", handler)
+
+	info := Info{Title: "Test", Version: "1.0.0"}
+	resources := []Resource{{Path: "/resources", Type: testResource{}, DryRun: true, Paginated: true}}
+	doc, err := Generate(info, router, resources)
+	require.NoError(t, err)
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.Equal(t, info, doc.Info)
+	assert.Contains(t, doc.Components.Schemas, "testResource")
+	assert.Contains(t, doc.Components.Schemas, "Error")
+	assert.Len(t, doc.Paths, 4)
+	assert.NotContains(t, doc.Paths, "/any")
+
+	ref := &Schema{Ref: "#/components/schemas/testResource"}
+
+	collection := *doc.Paths["/resources"]
+	require.Len(t, collection, 2)
+	assert.Equal(t, "getResources", collection["get"].OperationID)
+	assert.Len(t, collection["get"].Parameters, 4)
+	assert.Equal(t, &Schema{Type: "array", Items: ref}, collection["get"].Responses["200"].Content[contentTypeJSON].Schema)
+	assert.Equal(t, ref, collection["post"].RequestBody.Content[contentTypeJSON].Schema)
+	assert.Equal(t, "dryRun", collection["post"].Parameters[0].Name)
+
+	item := *doc.Paths["/resources/{id}"]
+	require.Len(t, item, 4)
+	assert.Equal(t, "getResourcesById", item["get"].OperationID)
+	assert.Equal(t, []Parameter{{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "string"}}}, item["get"].Parameters)
+	assert.Equal(t, ref, item["get"].Responses["200"].Content[contentTypeJSON].Schema)
+	assert.Equal(t, ref, item["put"].RequestBody.Content[contentTypeJSON].Schema)
+	assert.Contains(t, item["patch"].RequestBody.Content, "application/merge-patch+json")
+	assert.Contains(t, item["delete"].Responses, "204")
+	assert.Len(t, item["put"].Parameters, 2)
+	assert.Len(t, item["delete"].Parameters, 1)
+
+	action := *doc.Paths["/resources/{id}/items/{item}"]
+	require.Contains(t, action, "post")
+	assert.Equal(t, "postResourcesByIdItemsByItem", action["post"].OperationID)
+	assert.Len(t, action["post"].Parameters, 2)
+	assert.Contains(t, action["post"].Responses, "2XX")
+
+	assert.Contains(t, *doc.Paths["/info"], "get")
+
+	// Only the collections which can be paginated are given a limit
+	resources[0].Paginated = false
+	doc, err = Generate(info, router, resources)
+	require.NoError(t, err)
+	for _, param := range (*doc.Paths["/resources"])["get"].Parameters {
+		assert.NotEqual(t, "limit", param.Name)
+		assert.NotEqual(t, "continue", param.Name)
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	assert.Equal(t, "getRbacOrganizationsByOrganizationEnvironments", operationID(http.MethodGet, "/rbac/organizations/{organization}/environments"))
+	assert.Equal(t, "postApiOpenapiJson", operationID(http.MethodPost, "/api/openapi.json"))
+}
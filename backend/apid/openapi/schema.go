@@ -0,0 +1,105 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// Schemas holds the schemas of the named struct types described so far, by
+// name, so they can be referenced from the document components.
+type Schemas map[string]*Schema
+
+// For returns the schema of the JSON encoding of the given type. Named struct
+// types are described once in the schemas and referenced.
+func (s Schemas) For(t reflect.Type) *Schema {
+	if t == rawMessageType {
+		return &Schema{}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return s.For(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are encoded in base64
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.For(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.For(t.Elem())}
+	case reflect.Struct:
+		if t == timeType {
+			return &Schema{Type: "string", Format: "date-time"}
+		}
+		if t.Name() == "" {
+			return s.structSchema(t)
+		}
+		if _, ok := s[t.Name()]; !ok {
+			// Reserve the name first, as the type may be recursive
+			s[t.Name()] = &Schema{}
+			*s[t.Name()] = *s.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + t.Name()}
+	}
+	// Interfaces, and any value they may hold
+	return &Schema{}
+}
+
+// structSchema returns the schema of the given struct type, with a property
+// per exported field. Types with a custom JSON encoding, like those with
+// extended attributes, may have additional properties.
+func (s Schemas) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	if reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		schema.AdditionalProperties = true
+	}
+	s.addProperties(schema, t)
+	return schema
+}
+
+func (s Schemas) addProperties(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			// The fields of embedded structs are promoted
+			s.addProperties(schema, fieldType)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = s.For(field.Type)
+	}
+}
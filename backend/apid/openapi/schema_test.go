@@ -0,0 +1,70 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testBase struct {
+	Name string `json:"name"`
+}
+
+type testResource struct {
+	testBase
+	Count      int               `json:"count,omitempty"`
+	Ratio      float64           `json:"ratio"`
+	Enabled    bool              `json:"enabled"`
+	Tags       []string          `json:"tags"`
+	Labels     map[string]string `json:"labels"`
+	Data       []byte            `json:"data"`
+	Raw        json.RawMessage   `json:"raw"`
+	Value      interface{}       `json:"value"`
+	Created    time.Time         `json:"created"`
+	Parent     *testResource     `json:"parent"`
+	Untagged   string
+	Ignored    string `json:"-"`
+	unexported string
+}
+
+func TestSchemasFor(t *testing.T) {
+	schemas := Schemas{}
+	schema := schemas.For(reflect.TypeOf(&testResource{}))
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/testResource"}, schema)
+
+	resource := schemas["testResource"]
+	assert.Equal(t, "object", resource.Type)
+	assert.Nil(t, resource.AdditionalProperties)
+	assert.Equal(t, map[string]*Schema{
+		"name":     {Type: "string"},
+		"count":    {Type: "integer", Format: "int64"},
+		"ratio":    {Type: "number", Format: "double"},
+		"enabled":  {Type: "boolean"},
+		"tags":     {Type: "array", Items: &Schema{Type: "string"}},
+		"labels":   {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		"data":     {Type: "string", Format: "byte"},
+		"raw":      {},
+		"value":    {},
+		"created":  {Type: "string", Format: "date-time"},
+		"parent":   {Ref: "#/components/schemas/testResource"},
+		"Untagged": {Type: "string"},
+	}, resource.Properties)
+}
+
+type testMarshaler struct {
+	Name string `json:"name"`
+}
+
+func (m *testMarshaler) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"name": m.Name, "extra": "value"})
+}
+
+func TestSchemasForMarshaler(t *testing.T) {
+	schemas := Schemas{}
+	schemas.For(reflect.TypeOf(testMarshaler{}))
+	assert.Equal(t, true, schemas["testMarshaler"].AdditionalProperties)
+	assert.Contains(t, schemas["testMarshaler"].Properties, "name")
+}
@@ -24,15 +24,16 @@ func NewAssetRouter(store store.AssetStore) *AssetsRouter {
 
 // Mount the AssetsRouter to a parent Router
 func (r *AssetsRouter) Mount(parent *mux.Router) {
-	routes := ResourceRoute{Router: parent, PathPrefix: "/assets"}
-	routes.GetAll(r.list)
+	routes := ResourceRoute{Router: parent, PathPrefix: "/assets", DryRun: true}
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Post(r.create)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *AssetsRouter) list(req *http.Request) (interface{}, error) {
-	records, err := r.controller.Query(req.Context())
+	records, err := r.controller.Query(listContext(req))
 	return records, err
 }
 
@@ -64,3 +65,14 @@ func (r *AssetsRouter) createOrReplace(req *http.Request) (interface{}, error) {
 	err := r.controller.CreateOrReplace(req.Context(), asset)
 	return asset, err
 }
+
+func (r *AssetsRouter) patch(req *http.Request) (interface{}, error) {
+	asset := types.Asset{}
+	ctx, err := patchRecord(req, r.find, &asset)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, asset)
+	return asset, err
+}
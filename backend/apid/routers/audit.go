@@ -0,0 +1,31 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// AuditRouter handles requests for /audit
+type AuditRouter struct {
+	controller actions.AuditController
+}
+
+// NewAuditRouter instantiates new router for reading the audit log
+func NewAuditRouter(store store.AuditStore) *AuditRouter {
+	return &AuditRouter{
+		controller: actions.NewAuditController(store),
+	}
+}
+
+// Mount the AuditRouter to a parent Router
+func (r *AuditRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{Router: parent, PathPrefix: "/audit"}
+	routes.GetAllPaginated(r.list)
+}
+
+func (r *AuditRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(listContext(req))
+}
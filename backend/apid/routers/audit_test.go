@@ -0,0 +1,32 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRouterList(t *testing.T) {
+	store := &mockstore.MockStore{}
+	entries := []*types.AuditEntry{types.FixtureAuditEntry("a"), types.FixtureAuditEntry("b")}
+	store.On("GetAuditEntries", mock.Anything).Return(entries, nil)
+
+	router := mux.NewRouter()
+	NewAuditRouter(store).Mount(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newRequest(t, http.MethodGet, "/audit", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var result []*types.AuditEntry
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	assert.Equal(t, entries, result)
+}
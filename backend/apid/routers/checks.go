@@ -38,12 +38,13 @@ func NewChecksRouter(ctrl CheckController) *ChecksRouter {
 
 // Mount the ChecksRouter to a parent Router
 func (r *ChecksRouter) Mount(parent *mux.Router) {
-	routes := ResourceRoute{Router: parent, PathPrefix: "/checks"}
-	routes.GetAll(r.list)
+	routes := ResourceRoute{Router: parent, PathPrefix: "/checks", DryRun: true}
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 
 	// Custom
 	routes.Path("{id}/hooks/{type}", r.addCheckHook).Methods(http.MethodPut)
@@ -54,7 +55,7 @@ func (r *ChecksRouter) Mount(parent *mux.Router) {
 }
 
 func (r *ChecksRouter) list(req *http.Request) (interface{}, error) {
-	records, err := r.controller.Query(req.Context())
+	records, err := r.controller.Query(listContext(req))
 	return records, err
 }
 
@@ -88,6 +89,17 @@ func (r *ChecksRouter) createOrReplace(req *http.Request) (interface{}, error) {
 	return cfg, err
 }
 
+func (r *ChecksRouter) patch(req *http.Request) (interface{}, error) {
+	cfg := types.CheckConfig{}
+	ctx, err := patchRecord(req, r.find, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, cfg)
+	return cfg, err
+}
+
 func (r *ChecksRouter) destroy(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	id, err := url.PathUnescape(params["id"])
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -15,6 +16,7 @@ import (
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -123,6 +125,50 @@ func TestPutCheck(t *testing.T) {
 	controller.AssertCalled(t, "CreateOrReplace", mock.Anything, mock.AnythingOfType("types.CheckConfig"))
 }
 
+func TestPatchCheck(t *testing.T) {
+	testCases := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+	}{
+		{"merge patch", "application/merge-patch+json", `{"publish":false}`, http.StatusOK},
+		{"json patch", "application/json-patch+json", `[{"op":"replace","path":"/publish","value":false}]`, http.StatusOK},
+		{"identity field", "application/merge-patch+json", `{"name":"check2"}`, http.StatusBadRequest},
+		{"failed test", "application/json-patch+json", `[{"op":"test","path":"/publish","value":false}]`, http.StatusBadRequest},
+		{"unsupported type", "text/plain", `publish=false`, http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller, server := newCheckTest(t)
+			defer server.Close()
+
+			client := new(http.Client)
+
+			fixture := types.FixtureCheckConfig("check1")
+			controller.On("Find", mock.Anything, "check1").Return(fixture, nil)
+			controller.On("CreateOrReplace", mock.Anything, mock.AnythingOfType("types.CheckConfig")).Return(nil)
+			endpoint := "/checks/check1"
+			req := newRequest(t, http.MethodPatch, server.URL+endpoint, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantStatus != http.StatusOK {
+				controller.AssertNotCalled(t, "CreateOrReplace", mock.Anything, mock.Anything)
+				return
+			}
+
+			controller.AssertCalled(t, "CreateOrReplace", mock.Anything, mock.MatchedBy(func(check types.CheckConfig) bool {
+				return check.Name == "check1" && !check.Publish && check.Interval == fixture.Interval
+			}))
+		})
+	}
+}
+
 func TestGetCheck(t *testing.T) {
 	controller, server := newCheckTest(t)
 	defer server.Close()
@@ -0,0 +1,148 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+//
+// getHandler takes an action handler closure reading a resource and returns a
+// new handler that executes the closure and writes the response, like
+// actionHandler. The revision of the resource in the store is written in the
+// ETag header; when it matches the If-None-Match header, the resource is not
+// written.
+//
+//    GET /checks/check-cpu                          --> 200 OK, ETag: "42"
+//    GET /checks/check-cpu, If-None-Match: "42"     --> 304 Not Modified
+//
+func getHandler(action actionHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var revision int64
+		record, err := action(r.WithContext(store.ContextWithRevision(r.Context(), &revision)))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		// Stores which do not support preconditions do not set the revision
+		if !isNil(record) && revision > 0 {
+			etag := revisionETag(revision)
+			w.Header().Set("ETag", etag)
+			if etagMatches(r.Header.Get("If-None-Match"), etag) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+
+		respondWith(w, r, record)
+	}
+}
+
+//
+// conditionalHandler takes an action handler closure modifying a resource and
+// returns a new handler that executes the closure and writes the response,
+// like actionHandler. The If-Match and If-None-Match headers are given to the
+// store as preconditions on the revision of the resource, checked atomically
+// with its modification.
+//
+//    PUT /checks/check-cpu, If-Match: "42"     --> 412 if modified since read
+//    PUT /checks/check-cpu, If-None-Match: *   --> 412 if it already exists
+//
+func conditionalHandler(action actionHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := preconditionContext(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		actionHandler(action)(w, r.WithContext(ctx))
+	}
+}
+
+// preconditionContext returns the context of the given request, carrying the
+// store preconditions given by its If-Match and If-None-Match headers.
+func preconditionContext(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+
+	if header := r.Header.Get("If-Match"); header != "" {
+		revisions, any := headerRevisions(header)
+		switch {
+		case any:
+			// Resources that do not exist are at revision zero
+			ctx = store.ContextWithPrecondition(ctx, store.Precondition{Revision: 0, Match: false})
+		case len(revisions) == 0:
+			// None of the entity tags can match a revision
+			return nil, actions.NewErrorf(actions.PreconditionFailed, "resource does not match %s", header)
+		case len(revisions) > 1:
+			// The store can not check whether a resource is at any of several
+			// revisions
+			return nil, actions.NewErrorf(actions.InvalidArgument, "If-Match lists several entity tags")
+		default:
+			ctx = store.ContextWithPrecondition(ctx, store.Precondition{Revision: revisions[0], Match: true})
+		}
+	}
+
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		revisions, any := headerRevisions(header)
+		if any {
+			ctx = store.ContextWithPrecondition(ctx, store.Precondition{Revision: 0, Match: true})
+		}
+		for _, revision := range revisions {
+			ctx = store.ContextWithPrecondition(ctx, store.Precondition{Revision: revision, Match: false})
+		}
+	}
+
+	return ctx, nil
+}
+
+// headerRevisions returns the revisions given by the entity tags listed in
+// the given If-Match or If-None-Match header value, ignoring those which are
+// not revisions, and whether the header matches any entity tag.
+func headerRevisions(header string) (revisions []int64, any bool) {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" {
+			return nil, true
+		}
+		revision, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 64)
+		if err != nil || revision <= 0 {
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, false
+}
+
+// revisionETag returns the entity tag of a resource at the given revision.
+func revisionETag(revision int64) string {
+	return `"` + strconv.FormatInt(revision, 10) + `"`
+}
+
+// etagMatches returns whether the given entity tag, empty for missing
+// resources, is listed in the given If-Match or If-None-Match header value.
+func etagMatches(header, etag string) bool {
+	if header == "" || etag == "" {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func isNil(record interface{}) bool {
+	if record == nil {
+		return true
+	}
+	value := reflect.ValueOf(record)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
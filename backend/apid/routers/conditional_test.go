@@ -0,0 +1,256 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHandlerETag(t *testing.T) {
+	check := types.FixtureCheckConfig("check1")
+	revision := int64(42)
+
+	handler := getHandler(func(r *http.Request) (interface{}, error) {
+		if rev := store.RevisionFromContext(r.Context()); rev != nil {
+			*rev = revision
+		}
+		return check, nil
+	})
+
+	req := newRequest(t, http.MethodGet, "/checks/check1", nil)
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, `"42"`, res.Header().Get("ETag"))
+
+	req = newRequest(t, http.MethodGet, "/checks/check1", nil)
+	req.Header.Set("If-None-Match", `"42"`)
+	res = httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusNotModified, res.Code)
+	assert.Empty(t, res.Body.String())
+
+	// The ETag changes with the revision of the resource
+	revision = 43
+	req = newRequest(t, http.MethodGet, "/checks/check1", nil)
+	req.Header.Set("If-None-Match", `"42"`)
+	res = httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, `"43"`, res.Header().Get("ETag"))
+
+	// Resources of stores without revisions are not given an ETag
+	revision = 0
+	req = newRequest(t, http.MethodGet, "/checks/check1", nil)
+	res = httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Empty(t, res.Header().Get("ETag"))
+}
+
+func TestPreconditionContext(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ifMatch       string
+		ifNoneMatch   string
+		want          []store.Precondition
+		wantErrorCode actions.ErrCode
+		wantError     bool
+	}{
+		{
+			name: "no preconditions",
+		},
+		{
+			name:    "matching revision",
+			ifMatch: `"42"`,
+			want:    []store.Precondition{{Revision: 42, Match: true}},
+		},
+		{
+			name:    "weak revision",
+			ifMatch: `W/"42"`,
+			want:    []store.Precondition{{Revision: 42, Match: true}},
+		},
+		{
+			name:    "existing resource",
+			ifMatch: "*",
+			want:    []store.Precondition{{Revision: 0, Match: false}},
+		},
+		{
+			name:    "foreign tags are ignored",
+			ifMatch: `"stale", "42"`,
+			want:    []store.Precondition{{Revision: 42, Match: true}},
+		},
+		{
+			name:          "only foreign tags",
+			ifMatch:       `"stale"`,
+			wantError:     true,
+			wantErrorCode: actions.PreconditionFailed,
+		},
+		{
+			name:          "several revisions",
+			ifMatch:       `"41", "42"`,
+			wantError:     true,
+			wantErrorCode: actions.InvalidArgument,
+		},
+		{
+			name:        "missing resource",
+			ifNoneMatch: "*",
+			want:        []store.Precondition{{Revision: 0, Match: true}},
+		},
+		{
+			name:        "other revisions",
+			ifNoneMatch: `"41", "42", "stale"`,
+			want: []store.Precondition{
+				{Revision: 41, Match: false},
+				{Revision: 42, Match: false},
+			},
+		},
+		{
+			name:        "both headers",
+			ifMatch:     `"42"`,
+			ifNoneMatch: `"41"`,
+			want: []store.Precondition{
+				{Revision: 42, Match: true},
+				{Revision: 41, Match: false},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newRequest(t, http.MethodPut, "/checks/check1", nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			if tc.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.ifNoneMatch)
+			}
+
+			ctx, err := preconditionContext(req)
+			if tc.wantError {
+				code, ok := actions.StatusFromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tc.wantErrorCode, code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, store.PreconditionsFromContext(ctx))
+		})
+	}
+}
+
+func TestConditionalRequests(t *testing.T) {
+	fixture := types.FixtureCheckConfig("check1")
+
+	testCases := []struct {
+		name       string
+		method     string
+		ifMatch    string
+		storeErr   error
+		wantStatus int
+	}{
+		{"put without preconditions", http.MethodPut, "", nil, http.StatusOK},
+		{"put matching etag", http.MethodPut, `"42"`, nil, http.StatusOK},
+		{"put stale etag", http.MethodPut, `"42"`, store.ErrPreconditionFailed, http.StatusPreconditionFailed},
+		{"put foreign etag", http.MethodPut, `"stale"`, nil, http.StatusPreconditionFailed},
+		{"delete matching etag", http.MethodDelete, `"42"`, nil, http.StatusNoContent},
+		{"delete stale etag", http.MethodDelete, `"42"`, store.ErrPreconditionFailed, http.StatusPreconditionFailed},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller, server := newCheckTest(t)
+			defer server.Close()
+
+			var err error
+			if tc.storeErr != nil {
+				err = actions.NewError(actions.InternalErr, tc.storeErr)
+			}
+			// The preconditions are given to the controller, for the store
+			withPreconditions := mock.MatchedBy(func(ctx context.Context) bool {
+				return (tc.ifMatch != "") == (len(store.PreconditionsFromContext(ctx)) > 0)
+			})
+			controller.On("CreateOrReplace", withPreconditions, mock.Anything).Return(err)
+			controller.On("Destroy", withPreconditions, "check1").Return(err)
+
+			var body io.Reader
+			if tc.method == http.MethodPut {
+				b, _ := json.Marshal(fixture)
+				body = bytes.NewReader(b)
+			}
+			req := newRequest(t, tc.method, server.URL+"/checks/check1", body)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			resp, err := new(http.Client).Do(req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestConcurrentPatch(t *testing.T) {
+	testCases := []struct {
+		name       string
+		ifMatch    string
+		conflicts  int
+		wantStatus int
+		wantCalls  int
+	}{
+		{"no conflict", "", 0, http.StatusOK, 1},
+		{"concurrent update", "", 1, http.StatusOK, 2},
+		{"continuous updates", "", maxPatchAttempts, http.StatusPreconditionFailed, maxPatchAttempts},
+		{"given precondition", `"41"`, 1, http.StatusPreconditionFailed, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller, server := newCheckTest(t)
+			defer server.Close()
+
+			// The revision of the check increases with every find
+			revision := int64(41)
+			controller.On("Find", mock.Anything, "check1").Return(types.FixtureCheckConfig("check1"), nil).Run(func(args mock.Arguments) {
+				revision++
+				if rev := store.RevisionFromContext(args.Get(0).(context.Context)); rev != nil {
+					*rev = revision
+				}
+			})
+			// The patched check is stored at the revision it was found at
+			atFoundRevision := mock.MatchedBy(func(ctx context.Context) bool {
+				for _, p := range store.PreconditionsFromContext(ctx) {
+					if p == (store.Precondition{Revision: revision, Match: true}) {
+						return true
+					}
+				}
+				return false
+			})
+			conflict := actions.NewError(actions.InternalErr, store.ErrPreconditionFailed)
+			if tc.conflicts > 0 {
+				controller.On("CreateOrReplace", mock.Anything, mock.Anything).Return(conflict).Times(tc.conflicts)
+			}
+			controller.On("CreateOrReplace", atFoundRevision, mock.Anything).Return(nil)
+
+			req := newRequest(t, http.MethodPatch, server.URL+"/checks/check1", strings.NewReader(`{"publish":false}`))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			resp, err := new(http.Client).Do(req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			controller.AssertNumberOfCalls(t, "CreateOrReplace", tc.wantCalls)
+		})
+	}
+}
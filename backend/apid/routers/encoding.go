@@ -0,0 +1,94 @@
+package routers
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/sensu/sensu-go/types"
+)
+
+var marshalerType = reflect.TypeOf((*proto.Marshaler)(nil)).Elem()
+
+// acceptsProtobuf returns whether the client accepts resources serialized with
+// their protobuf definitions, as given by the Accept header of the request.
+func acceptsProtobuf(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil || mediaType != types.ContentTypeProtobuf {
+			continue
+		}
+		if q := params["q"]; q != "" && strings.Trim(q, "0.") == "" {
+			// The client refuses protobuf
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// isProtobuf returns whether the given request body is serialized with
+// protobuf, as given by its Content-Type header.
+func isProtobuf(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == types.ContentTypeProtobuf
+}
+
+// marshalProtobuf serializes the given resource, or list of resources, with
+// their protobuf definitions; the returned bool is false if they have none.
+func marshalProtobuf(resources interface{}) ([]byte, bool, error) {
+	value := reflect.ValueOf(resources)
+	if value.Kind() != reflect.Slice {
+		msg, ok := protoMarshaler(value)
+		if !ok {
+			return nil, false, nil
+		}
+		b, err := msg.Marshal()
+		return b, true, err
+	}
+
+	// Lists are serialized as a sequence of length-delimited messages
+	elem := value.Type().Elem()
+	if elem.Kind() != reflect.Ptr {
+		elem = reflect.PtrTo(elem)
+	}
+	if !elem.Implements(marshalerType) {
+		return nil, false, nil
+	}
+	var buf bytes.Buffer
+	for i := 0; i < value.Len(); i++ {
+		msg, ok := protoMarshaler(value.Index(i))
+		if !ok {
+			// nil elements can not be serialized
+			return nil, false, nil
+		}
+		b, err := msg.Marshal()
+		if err != nil {
+			return nil, true, err
+		}
+		_, _ = buf.Write(proto.EncodeVarint(uint64(len(b))))
+		_, _ = buf.Write(b)
+	}
+	return buf.Bytes(), true, nil
+}
+
+// protoMarshaler returns the given value as a protobuf message, if it is one.
+// Messages are implemented by pointers, values are hence copied to pointers.
+func protoMarshaler(value reflect.Value) (proto.Marshaler, bool) {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, false
+		}
+	} else if value.IsValid() {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		value = ptr
+	} else {
+		return nil, false
+	}
+	msg, ok := value.Interface().(proto.Marshaler)
+	return msg, ok
+}
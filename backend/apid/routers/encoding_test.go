@@ -0,0 +1,87 @@
+package routers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptsProtobuf(t *testing.T) {
+	testCases := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{types.ContentTypeProtobuf, true},
+		{"application/json, " + types.ContentTypeProtobuf + ";q=0.9", true},
+		{types.ContentTypeProtobuf + ";q=0", false},
+		{types.ContentTypeProtobuf + ";q=0.0", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.accept, func(t *testing.T) {
+			req := newRequest(t, http.MethodGet, "/checks", nil)
+			req.Header.Set("Accept", tc.accept)
+			assert.Equal(t, tc.want, acceptsProtobuf(req))
+		})
+	}
+}
+
+func TestRespondWithProtobuf(t *testing.T) {
+	check1 := types.FixtureCheckConfig("check1")
+	check2 := types.FixtureCheckConfig("check2")
+
+	req := newRequest(t, http.MethodGet, "/checks", nil)
+	req.Header.Set("Accept", types.ContentTypeProtobuf)
+
+	// Resources
+	res := httptest.NewRecorder()
+	respondWith(res, req, *check1)
+	assert.Equal(t, types.ContentTypeProtobuf, res.Header().Get("Content-Type"))
+	var check types.CheckConfig
+	require.NoError(t, proto.Unmarshal(res.Body.Bytes(), &check))
+	assert.True(t, check1.Equal(&check))
+
+	// Lists of resources
+	res = httptest.NewRecorder()
+	respondWith(res, req, []*types.CheckConfig{check1, check2})
+	assert.Equal(t, types.ContentTypeProtobuf, res.Header().Get("Content-Type"))
+	buf := proto.NewBuffer(res.Body.Bytes())
+	for _, want := range []*types.CheckConfig{check1, check2} {
+		var check types.CheckConfig
+		require.NoError(t, buf.DecodeMessage(&check))
+		assert.True(t, want.Equal(&check))
+	}
+
+	// Values without protobuf definitions are written as JSON
+	res = httptest.NewRecorder()
+	respondWith(res, req, []string{"check1"})
+	assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+	assert.JSONEq(t, `["check1"]`, res.Body.String())
+}
+
+func TestPutCheckProtobuf(t *testing.T) {
+	controller, server := newCheckTest(t)
+	defer server.Close()
+
+	check := types.FixtureCheckConfig("check1")
+	types.SyncObjectMeta(check)
+	isCheck := mock.MatchedBy(func(c types.CheckConfig) bool { return check.Equal(&c) })
+	controller.On("CreateOrReplace", mock.Anything, isCheck).Return(nil)
+	b, err := proto.Marshal(check)
+	require.NoError(t, err)
+	req := newRequest(t, http.MethodPut, server.URL+"/checks/check1", bytes.NewReader(b))
+	req.Header.Set("Content-Type", types.ContentTypeProtobuf)
+
+	resp, err := new(http.Client).Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	controller.AssertCalled(t, "CreateOrReplace", mock.Anything, isCheck)
+}
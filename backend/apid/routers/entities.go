@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -16,20 +17,21 @@ type EntitiesRouter struct {
 }
 
 // NewEntitiesRouter instantiates new router for controlling entities resources
-func NewEntitiesRouter(store store.EntityStore) *EntitiesRouter {
+func NewEntitiesRouter(store store.EntityStore, bus messaging.MessageBus) *EntitiesRouter {
 	return &EntitiesRouter{
-		controller: actions.NewEntityController(store),
+		controller: actions.NewEntityController(store, bus),
 	}
 }
 
 // Mount the EntitiesRouter to a parent Router
 func (r *EntitiesRouter) Mount(parent *mux.Router) {
 	routes := ResourceRoute{Router: parent, PathPrefix: "/entities"}
-	routes.GetAll(r.list)
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Del(r.destroy)
 	routes.Post(r.create)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *EntitiesRouter) destroy(req *http.Request) (interface{}, error) {
@@ -53,7 +55,7 @@ func (r *EntitiesRouter) find(req *http.Request) (interface{}, error) {
 }
 
 func (r *EntitiesRouter) list(req *http.Request) (interface{}, error) {
-	records, err := r.controller.Query(req.Context())
+	records, err := r.controller.Query(listContext(req))
 	return records, err
 }
 
@@ -74,3 +76,14 @@ func (r *EntitiesRouter) createOrReplace(req *http.Request) (interface{}, error)
 
 	return entity, r.controller.CreateOrReplace(req.Context(), entity)
 }
+
+func (r *EntitiesRouter) patch(req *http.Request) (interface{}, error) {
+	entity := types.Entity{}
+	ctx, err := patchRecord(req, r.find, &entity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, entity)
+	return entity, err
+}
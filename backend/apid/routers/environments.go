@@ -34,11 +34,12 @@ func NewEnvironmentsRouter(ctrl EnvironmentController) *EnvironmentsRouter {
 // Mount the EnvironmentsRouter to a parent Router
 func (r *EnvironmentsRouter) Mount(parent *mux.Router) {
 	routes := ResourceRoute{Router: parent, PathPrefix: "/rbac/organizations"}
-	routes.Path("{organization}/environments", r.list).Methods(http.MethodGet)
-	routes.Path("{organization}/environments/{environment}", r.find).Methods(http.MethodGet)
+	routes.ListPath("{organization}/environments", r.list)
+	routes.GetPath("{organization}/environments/{environment}", r.find)
 	routes.Path("{organization}/environments", r.create).Methods(http.MethodPost)
-	routes.Path("{organization}/environments/{environment}", r.createOrReplace).Methods(http.MethodPut)
-	routes.Path("{organization}/environments/{environment}", r.destroy).Methods(http.MethodDelete)
+	routes.ConditionalPath("{organization}/environments/{environment}", r.createOrReplace).Methods(http.MethodPut)
+	routes.ConditionalPath("{organization}/environments/{environment}", retryPatch(r.patch)).Methods(http.MethodPatch)
+	routes.ConditionalPath("{organization}/environments/{environment}", r.destroy).Methods(http.MethodDelete)
 }
 
 func (r *EnvironmentsRouter) list(req *http.Request) (interface{}, error) {
@@ -100,6 +101,17 @@ func (r *EnvironmentsRouter) createOrReplace(req *http.Request) (interface{}, er
 	return env, err
 }
 
+func (r *EnvironmentsRouter) patch(req *http.Request) (interface{}, error) {
+	env := types.Environment{}
+	ctx, err := patchRecord(req, r.find, &env)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, env)
+	return env, err
+}
+
 func (r *EnvironmentsRouter) destroy(req *http.Request) (interface{}, error) {
 	p := mux.Vars(req)
 	org, err := url.PathUnescape(p["organization"])
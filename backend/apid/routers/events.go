@@ -3,6 +3,7 @@ package routers
 import (
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
@@ -14,28 +15,30 @@ import (
 // EventsRouter handles requests for /events
 type EventsRouter struct {
 	controller actions.EventController
+	results    actions.CheckResultController
 }
 
 // NewEventsRouter instantiates new events controller
-func NewEventsRouter(store store.EventStore, bus messaging.MessageBus) *EventsRouter {
+func NewEventsRouter(store store.Store, bus messaging.MessageBus) *EventsRouter {
 	return &EventsRouter{
 		controller: actions.NewEventController(store, bus),
+		results:    actions.NewCheckResultController(store, bus),
 	}
 }
 
 // Mount the EventsRouter to a parent Router
 func (r *EventsRouter) Mount(parent *mux.Router) {
 	routes := ResourceRoute{Router: parent, PathPrefix: "/events"}
-	routes.GetAll(r.list)
-	routes.Path("{entity}", r.listByEntity).Methods(http.MethodGet)
-	routes.Path("{entity}/{check}", r.find).Methods(http.MethodGet)
-	routes.Path("{entity}/{check}", r.destroy).Methods(http.MethodDelete)
-	routes.Path("{entity}/{check}", r.createOrReplace).Methods(http.MethodPut)
+	routes.GetAllPaginated(r.list)
+	routes.ListPath("{entity}", r.listByEntity)
+	routes.GetPath("{entity}/{check}", r.find)
+	routes.ConditionalPath("{entity}/{check}", r.destroy).Methods(http.MethodDelete)
+	routes.ConditionalPath("{entity}/{check}", r.createOrReplace).Methods(http.MethodPut)
 	routes.Post(r.create)
 }
 
 func (r *EventsRouter) list(req *http.Request) (interface{}, error) {
-	records, err := r.controller.Query(req.Context(), "", "")
+	records, err := r.controller.Query(listContext(req), "", "")
 	return records, err
 }
 
@@ -61,12 +64,34 @@ func (r *EventsRouter) destroy(req *http.Request) (interface{}, error) {
 	return nil, r.controller.Destroy(req.Context(), entity, check)
 }
 
+//
+// create publishes the given event to the pipeline. Events without an entity
+// are check results submitted by external systems, for the proxy entity given
+// by the proxy_entity_id of their check; missing entities are registered
+// unless the "autoRegister" query parameter is false.
+//
+//    POST /events?autoRegister=false   --> 200 OK
+//
+//    {"check": {"name": "backup", "proxy_entity_id": "db01", "cron": "0 2 * * *", "status": 0, "output": "OK"}}
+//
 func (r *EventsRouter) create(req *http.Request) (interface{}, error) {
 	event := types.Event{}
 	if err := UnmarshalBody(req, &event); err != nil {
 		return nil, err
 	}
 
+	if event.Entity == nil && event.HasCheck() {
+		opts := actions.CheckResultOptions{AutoRegister: true}
+		if value := req.URL.Query().Get("autoRegister"); value != "" {
+			autoRegister, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, actions.NewErrorf(actions.InvalidArgument, "invalid autoRegister %q", value)
+			}
+			opts.AutoRegister = autoRegister
+		}
+		return r.results.Submit(req.Context(), *event.Check, opts)
+	}
+
 	err := r.controller.Create(req.Context(), event)
 	return event, err
 }
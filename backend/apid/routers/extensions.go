@@ -25,14 +25,15 @@ func NewExtensionsRouter(store store.ExtensionRegistry) *ExtensionsRouter {
 // Mount the ExtensionsRouter to a parent Router
 func (r *ExtensionsRouter) Mount(parent *mux.Router) {
 	routes := ResourceRoute{Router: parent, PathPrefix: "/extensions"}
-	routes.GetAll(r.list)
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Put(r.register)
+	routes.Patch(r.patch)
 	routes.Del(r.deregister)
 }
 
 func (r *ExtensionsRouter) list(req *http.Request) (interface{}, error) {
-	records, err := r.controller.Query(req.Context())
+	records, err := r.controller.Query(listContext(req))
 	return records, err
 }
 
@@ -55,6 +56,17 @@ func (r *ExtensionsRouter) register(req *http.Request) (interface{}, error) {
 	return extension, err
 }
 
+func (r *ExtensionsRouter) patch(req *http.Request) (interface{}, error) {
+	extension := types.Extension{}
+	ctx, err := patchRecord(req, r.find, &extension)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.Register(ctx, extension)
+	return extension, err
+}
+
 func (r *ExtensionsRouter) deregister(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	extensionPath, err := url.PathUnescape(params["id"])
@@ -24,16 +24,17 @@ func NewEventFiltersRouter(store store.EventFilterStore) *EventFiltersRouter {
 
 // Mount the EventFiltersRouter to a parent Router
 func (r *EventFiltersRouter) Mount(parent *mux.Router) {
-	routes := ResourceRoute{Router: parent, PathPrefix: "/filters"}
-	routes.GetAll(r.list)
+	routes := ResourceRoute{Router: parent, PathPrefix: "/filters", DryRun: true}
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *EventFiltersRouter) list(req *http.Request) (interface{}, error) {
-	return r.controller.Query(req.Context())
+	return r.controller.Query(listContext(req))
 }
 
 func (r *EventFiltersRouter) find(req *http.Request) (interface{}, error) {
@@ -65,6 +66,17 @@ func (r *EventFiltersRouter) createOrReplace(req *http.Request) (interface{}, er
 	return filter, err
 }
 
+func (r *EventFiltersRouter) patch(req *http.Request) (interface{}, error) {
+	filter := types.EventFilter{}
+	ctx, err := patchRecord(req, r.find, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, filter)
+	return filter, err
+}
+
 func (r *EventFiltersRouter) destroy(req *http.Request) (interface{}, error) {
 	params := actions.QueryParams(mux.Vars(req))
 	id, err := url.PathUnescape(params["id"])
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
+	graphqlgo "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
 	graphql "github.com/sensu/sensu-go/backend/apid/graphql"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
@@ -16,25 +20,67 @@ import (
 
 // GraphQLRouter handles requests for /events
 type GraphQLRouter struct {
-	service *graphqlservice.Service
+	service          *graphqlservice.Service
+	websocket        *graphql.WebsocketHandler
+	persistedQueries *graphql.PersistedQueries
+	tracing          bool
 }
 
-// NewGraphQLRouter instantiates new events controller
-func NewGraphQLRouter(store store.Store, bus messaging.MessageBus, getter types.QueueGetter) *GraphQLRouter {
+// tracedResult is the result of an operation, including the tracing extension.
+type tracedResult struct {
+	*graphqlgo.Result
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// NewGraphQLRouter instantiates new events controller. Its websocket
+// connections are authenticated with the given authenticator.
+func NewGraphQLRouter(store store.Store, bus messaging.MessageBus, getter types.QueueGetter, limits graphqlservice.Limits, tracing bool, auditRetention time.Duration, authenticate graphql.WebsocketAuthenticator) *GraphQLRouter {
 	service, err := graphql.NewService(graphql.ServiceConfig{
-		Store:       store,
-		Bus:         bus,
-		QueueGetter: getter,
+		Store:          store,
+		Bus:            bus,
+		QueueGetter:    getter,
+		Limits:         limits,
+		AuditRetention: auditRetention,
 	})
 	if err != nil {
 		logger.WithError(err).Panic("unable to configure graphql service")
 	}
-	return &GraphQLRouter{service}
+	return &GraphQLRouter{
+		service:          service,
+		websocket:        graphql.NewWebsocketHandler(service, bus, authenticate),
+		persistedQueries: graphql.NewPersistedQueries(graphql.DefaultPersistedQueryCacheSize),
+		tracing:          tracing,
+	}
 }
 
 // Mount the GraphQLRouter to a parent Router
 func (r *GraphQLRouter) Mount(parent *mux.Router) {
 	parent.HandleFunc("/graphql", actionHandler(r.query)).Methods(http.MethodPost)
+	parent.HandleFunc("/graphql/schema", r.schema).Methods(http.MethodGet)
+}
+
+// WebsocketRouter returns the router of the websocket endpoint of the
+// GraphQL service, to mount apart from the router itself: its connections are
+// authenticated once initialized, since browsers cannot set the headers of
+// websocket requests.
+func (r *GraphQLRouter) WebsocketRouter() Router {
+	return graphQLWebsocketRouter{handler: r.websocket}
+}
+
+type graphQLWebsocketRouter struct {
+	handler *graphql.WebsocketHandler
+}
+
+// Mount the websocket endpoint to a parent Router
+func (r graphQLWebsocketRouter) Mount(parent *mux.Router) {
+	parent.Handle("/graphql", r.handler).Methods(http.MethodGet)
+}
+
+// schema writes the schema of the service in the GraphQL schema definition
+// language.
+func (r *GraphQLRouter) schema(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = io.WriteString(w, r.service.SDL())
 }
 
 func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
@@ -71,10 +117,32 @@ func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
 		// Extract query and variables
 		query, _ := op["query"].(string)
 		queryVars, _ := op["variables"].(map[string]interface{})
+		extensions, _ := op["extensions"].(map[string]interface{})
 
-		// Execute given query
-		result := r.service.Do(ctx, query, queryVars)
-		results = append(results, result)
+		// Resolve persisted queries given their hash
+		query, err := r.persistedQueries.Resolve(query, extensions)
+		if err != nil {
+			results = append(results, &graphqlgo.Result{Errors: gqlerrors.FormatErrors(err)})
+			continue
+		}
+
+		// Execute given query; store lookups are batched & cached for the
+		// duration of the operation.
+		opCtx := graphql.ContextWithLoaders(ctx)
+		var tracer *graphqlservice.Tracer
+		if r.tracing {
+			tracer = graphqlservice.NewTracer()
+			opCtx = graphqlservice.ContextWithTracer(opCtx, tracer)
+		}
+		result := r.service.Do(opCtx, query, queryVars)
+		if tracer != nil {
+			results = append(results, &tracedResult{
+				Result:     result,
+				Extensions: map[string]interface{}{"tracing": tracer.Extension()},
+			})
+		} else {
+			results = append(results, result)
+		}
 		if len(result.Errors) > 0 {
 			logger.
 				WithField("errors", result.Errors).
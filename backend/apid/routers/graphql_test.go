@@ -2,15 +2,23 @@ package routers
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/testutil"
+	graphqlservice "github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/testing/mockbus"
 	"github.com/sensu/sensu-go/testing/mockqueue"
 	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func setupGraphQLRouter() *GraphQLRouter {
@@ -21,7 +29,7 @@ func setupGraphQLRouter() *GraphQLRouter {
 	getter := &mockqueue.Getter{}
 	getter.On("GetQueue", mock.Anything).Return(queue)
 
-	router := NewGraphQLRouter(store, bus, getter)
+	router := NewGraphQLRouter(store, bus, getter, graphqlservice.Limits{}, false, 0, nil)
 	return router
 }
 
@@ -77,3 +85,81 @@ func TestHttpGraphQLBatchRequest(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestHttpGraphQLPersistedQuery(t *testing.T) {
+	router := setupGraphQLRouter()
+	query := "{ __typename }"
+	sum := sha256.Sum256([]byte(query))
+	extensions := map[string]interface{}{
+		"persistedQuery": map[string]interface{}{
+			"version":    1,
+			"sha256Hash": hex.EncodeToString(sum[:]),
+		},
+	}
+
+	// Unknown hash
+	req, err := setupRequest(http.MethodPost, "/graphql", map[string]interface{}{
+		"extensions": extensions,
+	})
+	require.NoError(t, err)
+	res, err := router.query(req)
+	require.NoError(t, err)
+	require.Len(t, res.(*graphql.Result).Errors, 1)
+	assert.Equal(t, "PersistedQueryNotFound", res.(*graphql.Result).Errors[0].Message)
+
+	// Query and hash
+	req, err = setupRequest(http.MethodPost, "/graphql", map[string]interface{}{
+		"query":      query,
+		"extensions": extensions,
+	})
+	require.NoError(t, err)
+	res, err = router.query(req)
+	require.NoError(t, err)
+	assert.Empty(t, res.(*graphql.Result).Errors)
+
+	// Known hash
+	req, err = setupRequest(http.MethodPost, "/graphql", map[string]interface{}{
+		"extensions": extensions,
+	})
+	require.NoError(t, err)
+	res, err = router.query(req)
+	require.NoError(t, err)
+	assert.Empty(t, res.(*graphql.Result).Errors)
+	assert.Equal(t, map[string]interface{}{"__typename": "Query"}, res.(*graphql.Result).Data)
+}
+
+func TestHttpGraphQLSchema(t *testing.T) {
+	router := setupGraphQLRouter()
+	parent := mux.NewRouter()
+	router.Mount(parent)
+
+	req, err := http.NewRequest(http.MethodGet, "/graphql/schema", nil)
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	parent.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	assert.Contains(t, rec.Body.String(), "schema {\n  query: Query\n")
+	assert.Contains(t, rec.Body.String(), "type CheckConfig implements Node")
+}
+
+func TestHttpGraphQLTracing(t *testing.T) {
+	router := setupGraphQLRouter()
+	router.tracing = true
+
+	req, err := setupRequest(http.MethodPost, "/graphql", map[string]interface{}{
+		"query": "{ __typename }",
+	})
+	require.NoError(t, err)
+	res, err := router.query(req)
+	require.NoError(t, err)
+
+	result, ok := res.(*tracedResult)
+	require.True(t, ok)
+	assert.Empty(t, result.Errors)
+	tracing := result.Extensions["tracing"].(map[string]interface{})
+	assert.Equal(t, 1, tracing["version"])
+	assert.Contains(t, tracing, "parsing")
+	assert.Contains(t, tracing, "validation")
+}
@@ -16,7 +16,7 @@ type HandlersRouter struct {
 }
 
 // NewHandlersRouter instantiates new router for controlling handler resources
-func NewHandlersRouter(store store.HandlerStore) *HandlersRouter {
+func NewHandlersRouter(store store.Store) *HandlersRouter {
 	return &HandlersRouter{
 		controller: actions.NewHandlerController(store),
 	}
@@ -24,12 +24,13 @@ func NewHandlersRouter(store store.HandlerStore) *HandlersRouter {
 
 // Mount the HandlersRouter to a parent Router
 func (r *HandlersRouter) Mount(parent *mux.Router) {
-	routes := ResourceRoute{Router: parent, PathPrefix: "/handlers"}
+	routes := ResourceRoute{Router: parent, PathPrefix: "/handlers", DryRun: true}
 	routes.Post(r.create)
 	routes.Del(r.destroy)
-	routes.GetAll(r.list)
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *HandlersRouter) create(req *http.Request) (interface{}, error) {
@@ -50,6 +51,17 @@ func (r *HandlersRouter) createOrReplace(req *http.Request) (interface{}, error)
 	return handler, r.controller.CreateOrReplace(req.Context(), handler)
 }
 
+func (r *HandlersRouter) patch(req *http.Request) (interface{}, error) {
+	handler := types.Handler{}
+	ctx, err := patchRecord(req, r.find, &handler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, handler)
+	return handler, err
+}
+
 func (r *HandlersRouter) destroy(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	id, err := url.PathUnescape(params["id"])
@@ -69,5 +81,5 @@ func (r *HandlersRouter) find(req *http.Request) (interface{}, error) {
 }
 
 func (r *HandlersRouter) list(req *http.Request) (interface{}, error) {
-	return r.controller.Query(req.Context())
+	return r.controller.Query(listContext(req))
 }
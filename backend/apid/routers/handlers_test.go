@@ -1,7 +1,87 @@
 package routers
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
 
 func TestHttpApiHandlersGet(t *testing.T) {
 
 }
+
+func TestHandlersRouterDryRun(t *testing.T) {
+	handler := types.FixtureHandler("slack")
+	handler.Mutator = "mutator"
+	body, err := json.Marshal(handler)
+	require.NoError(t, err)
+
+	// The stored handler is named and namespaced in its metadata
+	expected := *handler
+	types.SyncObjectMeta(&expected)
+
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		mutator    *types.Mutator
+		wantStatus int
+		wantStored bool
+	}{
+		{"create", http.MethodPost, "/handlers?dryRun=true", types.FixtureMutator("mutator"), http.StatusOK, false},
+		{"replace", http.MethodPut, "/handlers/slack?dryRun=true", types.FixtureMutator("mutator"), http.StatusOK, false},
+		{"missing reference", http.MethodPost, "/handlers?dryRun=true", nil, http.StatusBadRequest, false},
+		{"invalid dry run", http.MethodPost, "/handlers?dryRun=maybe", nil, http.StatusBadRequest, false},
+		{"not a dry run", http.MethodPost, "/handlers?dryRun=false", nil, http.StatusOK, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := &mockstore.MockStore{}
+			st.On("GetHandlerByName", mock.Anything, "slack").Return((*types.Handler)(nil), nil)
+			st.On("GetMutatorByName", mock.Anything, "mutator").Return(tc.mutator, nil)
+			st.On("GetExtension", mock.Anything, "mutator").Return((*types.Extension)(nil), store.ErrNoExtension)
+			st.On("UpdateHandler", mock.Anything, mock.Anything).Return(nil)
+			router := mux.NewRouter()
+			NewHandlersRouter(st).Mount(router)
+
+			req := newRequest(t, tc.method, tc.path, bytes.NewReader(body))
+			res := httptest.NewRecorder()
+			router.ServeHTTP(res, req)
+			assert.Equal(t, tc.wantStatus, res.Code)
+
+			if tc.wantStored {
+				st.AssertCalled(t, "UpdateHandler", mock.Anything, mock.Anything)
+				return
+			}
+			st.AssertNotCalled(t, "UpdateHandler", mock.Anything, mock.Anything)
+			if tc.wantStatus == http.StatusOK {
+				var result types.Handler
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&result))
+				assert.Equal(t, expected, result)
+			}
+		})
+	}
+}
+
+func TestResourceRouteDryRunUnsupported(t *testing.T) {
+	router := mux.NewRouter()
+	routes := ResourceRoute{Router: router, PathPrefix: "/users"}
+	routes.Post(func(req *http.Request) (interface{}, error) {
+		t.Fatal("the action must not run")
+		return nil, nil
+	})
+
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, newRequest(t, http.MethodPost, "/users?dryRun=true", nil))
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+}
@@ -24,16 +24,17 @@ func NewHooksRouter(store store.HookConfigStore) *HooksRouter {
 
 // Mount the HooksRouter to a parent Router
 func (r *HooksRouter) Mount(parent *mux.Router) {
-	routes := ResourceRoute{Router: parent, PathPrefix: "/hooks"}
-	routes.GetAll(r.list)
+	routes := ResourceRoute{Router: parent, PathPrefix: "/hooks", DryRun: true}
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *HooksRouter) list(req *http.Request) (interface{}, error) {
-	records, err := r.controller.Query(req.Context())
+	records, err := r.controller.Query(listContext(req))
 	return records, err
 }
 
@@ -67,6 +68,17 @@ func (r *HooksRouter) createOrReplace(req *http.Request) (interface{}, error) {
 	return cfg, err
 }
 
+func (r *HooksRouter) patch(req *http.Request) (interface{}, error) {
+	cfg := types.HookConfig{}
+	ctx, err := patchRecord(req, r.find, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, cfg)
+	return cfg, err
+}
+
 func (r *HooksRouter) destroy(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	id, err := url.PathUnescape(params["id"])
@@ -24,16 +24,17 @@ func NewMutatorsRouter(store store.MutatorStore) *MutatorsRouter {
 
 // Mount the MutatorsRouter to a parent Router
 func (r *MutatorsRouter) Mount(parent *mux.Router) {
-	routes := ResourceRoute{Router: parent, PathPrefix: "/mutators"}
-	routes.GetAll(r.list)
+	routes := ResourceRoute{Router: parent, PathPrefix: "/mutators", DryRun: true}
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *MutatorsRouter) list(req *http.Request) (interface{}, error) {
-	return r.controller.Query(req.Context())
+	return r.controller.Query(listContext(req))
 }
 
 func (r *MutatorsRouter) find(req *http.Request) (interface{}, error) {
@@ -64,6 +65,17 @@ func (r *MutatorsRouter) createOrReplace(req *http.Request) (interface{}, error)
 	return mutator, r.controller.CreateOrReplace(req.Context(), mutator)
 }
 
+func (r *MutatorsRouter) patch(req *http.Request) (interface{}, error) {
+	mutator := types.Mutator{}
+	ctx, err := patchRecord(req, r.find, &mutator)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, mutator)
+	return mutator, err
+}
+
 func (r *MutatorsRouter) destroy(req *http.Request) (interface{}, error) {
 	params := actions.QueryParams(mux.Vars(req))
 	name, err := url.PathUnescape(params["id"])
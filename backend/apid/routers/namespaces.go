@@ -0,0 +1,102 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/types"
+)
+
+// NamespaceController represents the controller needs of the NamespacesRouter.
+type NamespaceController interface {
+	Query(context.Context) ([]*types.Namespace, error)
+	Find(context.Context, string, string) (*types.Namespace, error)
+	Create(context.Context, types.Namespace) error
+	CreateOrReplace(context.Context, types.Namespace) error
+	Destroy(context.Context, string, string) error
+}
+
+// NamespacesRouter handles requests for /namespaces
+type NamespacesRouter struct {
+	controller NamespaceController
+}
+
+// NewNamespacesRouter instantiates new router for controlling namespaces
+func NewNamespacesRouter(ctrl NamespaceController) *NamespacesRouter {
+	return &NamespacesRouter{
+		controller: ctrl,
+	}
+}
+
+// Mount the NamespacesRouter to a parent Router
+func (r *NamespacesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{Router: parent, PathPrefix: "/namespaces"}
+	routes.GetAll(r.list)
+	routes.GetPath("{organization}/{environment}", r.find)
+	routes.Post(r.create)
+	routes.ConditionalPath("{organization}/{environment}", r.createOrReplace).Methods(http.MethodPut)
+	routes.ConditionalPath("{organization}/{environment}", r.destroy).Methods(http.MethodDelete)
+}
+
+func (r *NamespacesRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *NamespacesRouter) find(req *http.Request) (interface{}, error) {
+	org, env, err := namespaceVars(req)
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), org, env)
+}
+
+func (r *NamespacesRouter) create(req *http.Request) (interface{}, error) {
+	ns := types.Namespace{}
+	if err := UnmarshalBody(req, &ns); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), ns)
+	return ns, err
+}
+
+func (r *NamespacesRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	ns := types.Namespace{}
+	if err := UnmarshalBody(req, &ns); err != nil {
+		return nil, err
+	}
+	org, env, err := namespaceVars(req)
+	if err != nil {
+		return nil, err
+	}
+	ns.Name = types.NewNamespace(org, env).Name
+
+	err = r.controller.CreateOrReplace(req.Context(), ns)
+	return ns, err
+}
+
+func (r *NamespacesRouter) destroy(req *http.Request) (interface{}, error) {
+	org, env, err := namespaceVars(req)
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), org, env)
+	return nil, err
+}
+
+// namespaceVars returns the organization and environment of the namespace
+// addressed by the request
+func namespaceVars(req *http.Request) (string, string, error) {
+	p := mux.Vars(req)
+	org, err := url.PathUnescape(p["organization"])
+	if err != nil {
+		return "", "", err
+	}
+	env, err := url.PathUnescape(p["environment"])
+	if err != nil {
+		return "", "", err
+	}
+	return org, env, nil
+}
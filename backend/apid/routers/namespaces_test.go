@@ -0,0 +1,163 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockNamespaceController struct {
+	mock.Mock
+}
+
+func (m *mockNamespaceController) Query(ctx context.Context) ([]*types.Namespace, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*types.Namespace), args.Error(1)
+}
+
+func (m *mockNamespaceController) Find(ctx context.Context, org, env string) (*types.Namespace, error) {
+	args := m.Called(ctx, org, env)
+	return args.Get(0).(*types.Namespace), args.Error(1)
+}
+
+func (m *mockNamespaceController) Create(ctx context.Context, ns types.Namespace) error {
+	return m.Called(ctx, ns).Error(0)
+}
+
+func (m *mockNamespaceController) CreateOrReplace(ctx context.Context, ns types.Namespace) error {
+	return m.Called(ctx, ns).Error(0)
+}
+
+func (m *mockNamespaceController) Destroy(ctx context.Context, org, env string) error {
+	return m.Called(ctx, org, env).Error(0)
+}
+
+func newNamespaceTest(t *testing.T) (*mockNamespaceController, *httptest.Server) {
+	controller := &mockNamespaceController{}
+	nsRouter := NewNamespacesRouter(controller)
+	router := mux.NewRouter()
+	nsRouter.Mount(router)
+
+	return controller, httptest.NewServer(router)
+}
+
+func TestPostNamespace(t *testing.T) {
+	controller, server := newNamespaceTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	ns := types.FixtureNamespace("production")
+	controller.On("Create", mock.Anything, *ns).Return(nil)
+	b, _ := json.Marshal(ns)
+	req := newRequest(t, http.MethodPost, server.URL+"/namespaces", bytes.NewReader(b))
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+
+	controller.AssertCalled(t, "Create", mock.Anything, *ns)
+}
+
+func TestPutNamespace(t *testing.T) {
+	controller, server := newNamespaceTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	ns := types.FixtureNamespace("production")
+	controller.On("Find", mock.Anything, "default", "production").Return(ns, nil)
+	controller.On("CreateOrReplace", mock.Anything, *ns).Return(nil)
+	b, _ := json.Marshal(types.Namespace{Description: ns.Description})
+	req := newRequest(t, http.MethodPut, server.URL+ns.URIPath(), bytes.NewReader(b))
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+
+	controller.AssertCalled(t, "CreateOrReplace", mock.Anything, *ns)
+}
+
+func TestGetNamespace(t *testing.T) {
+	controller, server := newNamespaceTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	controller.On("Find", mock.Anything, "default", "production").Return(types.FixtureNamespace("production"), nil)
+	req := newRequest(t, http.MethodGet, server.URL+"/namespaces/default/production", nil)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+
+	result := types.Namespace{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, "default/production", result.Name)
+}
+
+func TestDeleteNamespace(t *testing.T) {
+	controller, server := newNamespaceTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	controller.On("Find", mock.Anything, "default", "production").Return(types.FixtureNamespace("production"), nil)
+	controller.On("Destroy", mock.Anything, "default", "production").Return(nil)
+	req := newRequest(t, http.MethodDelete, server.URL+"/namespaces/default/production", nil)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+
+	controller.AssertCalled(t, "Destroy", mock.Anything, "default", "production")
+}
+
+func TestGetAllNamespaces(t *testing.T) {
+	controller, server := newNamespaceTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	fixtures := []*types.Namespace{types.FixtureNamespace("default")}
+	controller.On("Query", mock.Anything).Return(fixtures, nil)
+	req := newRequest(t, http.MethodGet, server.URL+"/namespaces", nil)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	if resp.StatusCode >= 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+
+	results := []*types.Namespace{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&results))
+	assert.Len(t, results, 1)
+}
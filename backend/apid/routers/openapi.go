@@ -0,0 +1,67 @@
+package routers
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/version"
+)
+
+// openAPIResources are the resources of the API described by their schemas.
+var openAPIResources = []openapi.Resource{
+	{Path: "/assets", Type: types.Asset{}, DryRun: true, Paginated: true},
+	{Path: "/audit", Type: types.AuditEntry{}, Paginated: true},
+	{Path: "/checks", Type: types.CheckConfig{}, DryRun: true, Paginated: true},
+	{Path: "/entities", Type: types.Entity{}, Paginated: true},
+	{Path: "/events", ItemPath: "/events/{entity}/{check}", Type: types.Event{}, Paginated: true},
+	{Path: "/extensions", Type: types.Extension{}, Paginated: true},
+	{Path: "/filters", Type: types.EventFilter{}, DryRun: true, Paginated: true},
+	{Path: "/handlers", Type: types.Handler{}, DryRun: true, Paginated: true},
+	{Path: "/hooks", Type: types.HookConfig{}, DryRun: true, Paginated: true},
+	{Path: "/mutators", Type: types.Mutator{}, DryRun: true, Paginated: true},
+	{Path: "/rbac/organizations", Type: types.Organization{}},
+	{Path: "/rbac/organizations/{organization}/environments", ItemPath: "/rbac/organizations/{organization}/environments/{environment}", Type: types.Environment{}},
+	{Path: "/rbac/roles", Type: types.Role{}},
+	{Path: "/rbac/users", Type: types.User{}},
+	{Path: "/service-components", Type: types.ServiceComponent{}, DryRun: true, Paginated: true},
+	{Path: "/services", Type: types.Service{}, DryRun: true, Paginated: true},
+	{Path: "/silenced", Type: types.Silenced{}, Paginated: true},
+}
+
+// OpenAPIRouter handles requests for /api/openapi.json, the OpenAPI document
+// describing the routes of the API.
+type OpenAPIRouter struct {
+	root *mux.Router
+
+	once sync.Once
+	doc  *openapi.Document
+	err  error
+}
+
+// NewOpenAPIRouter instantiates new router describing the routes of the given
+// root router.
+func NewOpenAPIRouter(root *mux.Router) *OpenAPIRouter {
+	return &OpenAPIRouter{root: root}
+}
+
+// Mount the OpenAPIRouter to a parent Router
+func (r *OpenAPIRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/api/openapi.json", actionHandler(r.document)).Methods(http.MethodGet)
+}
+
+// document generates the document on the first request, once all the routes
+// of the API are mounted.
+func (r *OpenAPIRouter) document(req *http.Request) (interface{}, error) {
+	r.once.Do(func() {
+		info := openapi.Info{Title: "Sensu API", Version: version.Semver()}
+		r.doc, r.err = openapi.Generate(info, r.root, openAPIResources)
+	})
+	if r.err != nil {
+		return nil, actions.NewError(actions.InternalErr, r.err)
+	}
+	return r.doc, nil
+}
@@ -0,0 +1,34 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIRouter(t *testing.T) {
+	store := &mockstore.MockStore{}
+	router := mux.NewRouter()
+	NewOpenAPIRouter(router).Mount(router)
+	NewAssetRouter(store).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var doc openapi.Document
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Contains(t, doc.Paths, "/api/openapi.json")
+	assert.Contains(t, doc.Paths, "/assets")
+	assert.Contains(t, doc.Paths, "/assets/{id}")
+	assert.Contains(t, doc.Components.Schemas, "Asset")
+}
@@ -38,6 +38,7 @@ func (r *OrganizationsRouter) Mount(parent *mux.Router) {
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 }
 
 func (r *OrganizationsRouter) list(req *http.Request) (interface{}, error) {
@@ -75,6 +76,17 @@ func (r *OrganizationsRouter) createOrReplace(req *http.Request) (interface{}, e
 	return org, err
 }
 
+func (r *OrganizationsRouter) patch(req *http.Request) (interface{}, error) {
+	org := types.Organization{}
+	ctx, err := patchRecord(req, r.find, &org)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, org)
+	return org, err
+}
+
 func (r *OrganizationsRouter) destroy(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	id, err := url.PathUnescape(params["id"])
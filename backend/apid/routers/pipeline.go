@@ -0,0 +1,177 @@
+package routers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// streamDuration is how long streams are written before being closed,
+	// under the write timeout of the API server; clients reconnect to follow
+	// them further.
+	streamDuration = 10 * time.Second
+
+	// streamBufferSize is the number of messages buffered for a stream; when
+	// the client is too slow to read them, the next ones are dropped rather
+	// than holding the message bus.
+	streamBufferSize = 100
+)
+
+// PipelineRouter handles requests for /pipeline
+type PipelineRouter struct {
+	bus messaging.MessageBus
+}
+
+// NewPipelineRouter instantiates new router observing the pipeline.
+func NewPipelineRouter(bus messaging.MessageBus) *PipelineRouter {
+	return &PipelineRouter{bus: bus}
+}
+
+// Mount the PipelineRouter to a parent Router
+func (r *PipelineRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/pipeline/results", r.streamResults).Methods(http.MethodGet)
+}
+
+//
+// streamResults writes the results of the handlers executed by the pipeline,
+// as they are executed, as Server-Sent Events. Only the results of the events
+// of the organization and environment of the request are written, and those
+// of the handler given by the "handler" query parameter if any.
+//
+//    GET /pipeline/results?handler=slack   --> 200 OK
+//
+//    event: handler-result
+//    data: {"handler":"slack","success":false,"error":"...",...}
+//
+func (r *PipelineRouter) streamResults(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, actions.NewError(actions.InternalErr, errors.New("streaming is not supported")))
+		return
+	}
+
+	ctx := req.Context()
+	abilities := authorization.Handlers.WithContext(ctx)
+	if !abilities.CanList() {
+		writeError(w, actions.NewErrorf(actions.PermissionDenied))
+		return
+	}
+
+	subscriber := newStreamSubscriber()
+	subscription, err := r.bus.Subscribe(messaging.TopicHandlerResult, "apid-stream-"+uuid.New().String(), subscriber)
+	if err != nil {
+		writeError(w, actions.NewError(actions.InternalErr, err))
+		return
+	}
+	defer func() {
+		if err := subscription.Cancel(); err != nil {
+			logger.WithError(err).Error("could not unsubscribe from handler results")
+		}
+		subscriber.close()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	org, env := types.ContextOrganization(ctx), types.ContextEnvironment(ctx)
+	handler := req.URL.Query().Get("handler")
+	timeout := time.NewTimer(streamDuration)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeout.C:
+			return
+		case msg := <-subscriber.messages:
+			result, ok := msg.(*types.HandlerResult)
+			if !ok || (handler != "" && result.Handler != handler) {
+				continue
+			}
+			if (org != types.OrganizationTypeAll && result.Organization != org) ||
+				(env != types.EnvironmentTypeAll && result.Environment != env) {
+				continue
+			}
+			if !abilities.CanRead(&types.Handler{Name: result.Handler, Organization: result.Organization, Environment: result.Environment}) {
+				continue
+			}
+			if err := writeServerSentEvent(w, "handler-result", result); err != nil {
+				logger.WithError(err).Error("failed to write response")
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeServerSentEvent writes the given value as a Server-Sent Event of the
+// given type.
+func writeServerSentEvent(w http.ResponseWriter, event string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// streamSubscriber receives the messages of the bus for a stream. The bus
+// waits for messages to be received, they are hence buffered and dropped when
+// the buffer is full. Once closed, messages being sent are still received for
+// a grace period, as the bus may send them after the subscription is
+// cancelled.
+type streamSubscriber struct {
+	receiver chan interface{}
+	messages chan interface{}
+	done     chan struct{}
+}
+
+func newStreamSubscriber() *streamSubscriber {
+	s := &streamSubscriber{
+		receiver: make(chan interface{}),
+		messages: make(chan interface{}, streamBufferSize),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		for {
+			select {
+			case msg := <-s.receiver:
+				select {
+				case s.messages <- msg:
+				default:
+				}
+			case <-s.done:
+				grace := time.After(time.Second)
+				for {
+					select {
+					case <-s.receiver:
+					case <-grace:
+						return
+					}
+				}
+			}
+		}
+	}()
+	return s
+}
+
+// Receiver returns the channel the bus sends messages to.
+func (s *streamSubscriber) Receiver() chan<- interface{} {
+	return s.receiver
+}
+
+func (s *streamSubscriber) close() {
+	close(s.done)
+}
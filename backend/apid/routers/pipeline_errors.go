@@ -0,0 +1,56 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// PipelineErrorsRouter handles requests for /errors, the events whose handlers
+// ultimately failed in the pipeline.
+type PipelineErrorsRouter struct {
+	controller actions.PipelineErrorController
+}
+
+// NewPipelineErrorsRouter instantiates new pipeline errors controller
+func NewPipelineErrorsRouter(store store.ErrorStore) *PipelineErrorsRouter {
+	return &PipelineErrorsRouter{
+		controller: actions.NewPipelineErrorController(store),
+	}
+}
+
+// Mount the PipelineErrorsRouter to a parent Router
+func (r *PipelineErrorsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{Router: parent, PathPrefix: "/errors"}
+	routes.GetAll(r.list)
+	routes.ListPath("{entity}", r.list)
+	routes.ListPath("{entity}/{check}", r.list)
+	routes.GetPath("{entity}/{check}/{timestamp}", r.find)
+	routes.Path("{entity}/{check}/{timestamp}", r.destroy).Methods(http.MethodDelete)
+}
+
+func (r *PipelineErrorsRouter) list(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	records, err := r.controller.Query(req.Context(), entity, check)
+	return records, err
+}
+
+func (r *PipelineErrorsRouter) find(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	record, err := r.controller.Find(req.Context(), entity, check, params["timestamp"])
+	return record, err
+}
+
+func (r *PipelineErrorsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	return nil, r.controller.Destroy(req.Context(), entity, check, params["timestamp"])
+}
@@ -0,0 +1,92 @@
+package routers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineRouterStreamResults(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeHandler, types.RulePermRead),
+		),
+	)
+	router := mux.NewRouter()
+	NewPipelineRouter(bus).Mount(router)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Keep the context of the request, cancelled when the client leaves
+		reqCtx := req.Context()
+		for _, key := range []interface{}{types.AuthorizationActorKey, types.OrganizationKey, types.EnvironmentKey} {
+			reqCtx = context.WithValue(reqCtx, key, ctx.Value(key))
+		}
+		router.ServeHTTP(w, req.WithContext(reqCtx))
+	}))
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/pipeline/results?handler=slack")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	// The stream is subscribed once the response headers are written
+	results := []*types.HandlerResult{
+		{Handler: "pagerduty", Organization: "default", Environment: "default"},
+		{Handler: "slack", Organization: "acme", Environment: "default"},
+		{Handler: "slack", Organization: "default", Environment: "default", Success: true, Duration: 1.5},
+	}
+	for _, result := range results {
+		require.NoError(t, bus.Publish(messaging.TopicHandlerResult, result))
+	}
+
+	reader := bufio.NewReader(res.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: handler-result\n", line)
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, "data: "))
+
+	var result types.HandlerResult
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &result))
+	assert.Equal(t, *results[2], result)
+}
+
+func TestPipelineRouterStreamResultsUnauthorized(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead),
+		),
+	)
+	router := mux.NewRouter()
+	NewPipelineRouter(bus).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/pipeline/results", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req.WithContext(ctx))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,81 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ResourcesRouter handles requests for /import and /export, importing and
+// exporting resources as a stream of JSON documents wrapping them, as read by
+// `sensuctl create`.
+type ResourcesRouter struct {
+	controller actions.ResourceController
+}
+
+// NewResourcesRouter instantiates new router for importing and exporting
+// resources
+func NewResourcesRouter(store store.Store) *ResourcesRouter {
+	return &ResourcesRouter{
+		controller: actions.NewResourceController(store),
+	}
+}
+
+// Mount the ResourcesRouter to a parent Router
+func (r *ResourcesRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/import", actionHandler(r.importResources)).Methods(http.MethodPost)
+	parent.HandleFunc("/export", r.exportResources).Methods(http.MethodGet)
+}
+
+// importResources imports the resources of the request body atomically; they
+// are only validated, along with their references to other resources, when
+// the "dryRun" query parameter is true.
+func (r *ResourcesRouter) importResources(req *http.Request) (interface{}, error) {
+	dryRun, err := dryRunParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []types.Resource{}
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	for dec.More() {
+		var w types.Wrapper
+		if err := dec.Decode(&w); err != nil {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "resource %d: %s", len(resources), err)
+		}
+		resources = append(resources, w.Value)
+	}
+
+	if err := r.controller.Import(req.Context(), resources, dryRun); err != nil {
+		return nil, err
+	}
+
+	wrappers := make([]types.Wrapper, len(resources))
+	for i, resource := range resources {
+		wrappers[i] = types.WrapResource(resource)
+	}
+	return wrappers, nil
+}
+
+// exportResources writes the exported resources, one JSON document per line.
+func (r *ResourcesRouter) exportResources(w http.ResponseWriter, req *http.Request) {
+	resources, err := r.controller.Export(req.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	for _, resource := range resources {
+		if err := enc.Encode(types.WrapResource(resource)); err != nil {
+			logger.WithError(err).Error("failed to write response")
+			return
+		}
+	}
+}
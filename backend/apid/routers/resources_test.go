@@ -0,0 +1,90 @@
+package routers
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newResourcesTest(t *testing.T) (*mockstore.MockStore, *mux.Router) {
+	store := &mockstore.MockStore{}
+	router := mux.NewRouter()
+	NewResourcesRouter(store).Mount(router)
+
+	return store, router
+}
+
+func TestImportResources(t *testing.T) {
+	body := `{"type": "CheckConfig", "spec": {"name": "check", "command": "true", "interval": 60, "organization": "default", "environment": "default"}}
+{"type": "Handler", "spec": {"name": "handler", "type": "pipe", "command": "cat", "organization": "default", "environment": "default"}}`
+
+	testCases := []struct {
+		name       string
+		query      string
+		body       string
+		wantStatus int
+		wantStored bool
+	}{
+		{"import", "", body, http.StatusOK, true},
+		{"dry run", "?dryRun=true", body, http.StatusOK, false},
+		{"invalid dry run", "?dryRun=maybe", body, http.StatusBadRequest, false},
+		{"unknown type", "", `{"type": "Pizza", "spec": {}}`, http.StatusBadRequest, false},
+		{"invalid resource", "", `{"type": "CheckConfig", "spec": {"name": "check"}}`, http.StatusUnprocessableEntity, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store, router := newResourcesTest(t)
+			store.On("UpdateResources", mock.Anything, mock.Anything).Return(nil)
+
+			req := newRequest(t, http.MethodPost, "/import"+tc.query, strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			router.ServeHTTP(res, req)
+			assert.Equal(t, tc.wantStatus, res.Code)
+
+			if !tc.wantStored {
+				store.AssertNotCalled(t, "UpdateResources", mock.Anything, mock.Anything)
+				return
+			}
+			store.AssertCalled(t, "UpdateResources", mock.Anything, mock.MatchedBy(func(resources []types.Resource) bool {
+				return len(resources) == 2
+			}))
+		})
+	}
+}
+
+func TestExportResources(t *testing.T) {
+	store, router := newResourcesTest(t)
+
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{}, nil)
+	store.On("GetHookConfigs", mock.Anything).Return([]*types.HookConfig{}, nil)
+	store.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{types.FixtureCheckConfig("check")}, nil)
+	store.On("GetEventFilters", mock.Anything).Return([]*types.EventFilter{}, nil)
+	store.On("GetMutators", mock.Anything).Return([]*types.Mutator{}, nil)
+	store.On("GetHandlers", mock.Anything).Return([]*types.Handler{types.FixtureHandler("handler")}, nil)
+
+	req := newRequest(t, http.MethodGet, "/export", nil)
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var kinds []string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		var w struct {
+			Type string `json:"type"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &w))
+		kinds = append(kinds, w.Type)
+	}
+	assert.Equal(t, []string{"CheckConfig", "Handler"}, kinds)
+}
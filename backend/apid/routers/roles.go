@@ -30,6 +30,7 @@ func (r *RolesRouter) Mount(parent *mux.Router) {
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 
 	// Custom
 	routes.Path("{id}/rules/{type}", r.addRule).Methods(http.MethodPut)
@@ -71,6 +72,17 @@ func (r *RolesRouter) createOrReplace(req *http.Request) (interface{}, error) {
 	return cfg, err
 }
 
+func (r *RolesRouter) patch(req *http.Request) (interface{}, error) {
+	cfg := types.Role{}
+	ctx, err := patchRecord(req, r.find, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, cfg)
+	return cfg, err
+}
+
 func (r *RolesRouter) destroy(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
 	id, err := url.PathUnescape(params["id"])
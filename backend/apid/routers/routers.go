@@ -1,22 +1,35 @@
 package routers
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"path"
+	"reflect"
+	"strconv"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/patch"
 )
 
 type errorBody struct {
-	Message string `json:"error"`
-	Code    uint32 `json:"code"`
+	Message string            `json:"error"`
+	Code    uint32            `json:"code"`
+	Details types.FieldErrors `json:"details,omitempty"`
 }
 
-// respondWith given writer and resource, marshal to JSON and write response.
-func respondWith(w http.ResponseWriter, resources interface{}) {
+// respondWith given writer and resource, marshal to JSON, or to protobuf when
+// accepted by the client, and write response.
+func respondWith(w http.ResponseWriter, r *http.Request, resources interface{}) {
 	// Set content-type to JSON
 	w.Header().Set("Content-Type", "application/json")
 
@@ -27,7 +40,17 @@ func respondWith(w http.ResponseWriter, resources interface{}) {
 	}
 
 	// Marshal
-	bytes, err := json.Marshal(resources)
+	var bytes []byte
+	var err error
+	var isProto bool
+	if acceptsProtobuf(r) {
+		bytes, isProto, err = marshalProtobuf(resources)
+	}
+	if isProto {
+		w.Header().Set("Content-Type", types.ContentTypeProtobuf)
+	} else if err == nil {
+		bytes, err = json.Marshal(resources)
+	}
 	if err != nil {
 		writeError(w, err)
 		return
@@ -52,7 +75,13 @@ func writeError(w http.ResponseWriter, err error) {
 	if ok {
 		errBody.Message = actionErr.Message
 		errBody.Code = uint32(actionErr.Code)
+		errBody.Details = actionErr.Details
 		st = HTTPStatusFromCode(actionErr.Code)
+
+		// The resource was well-formed but is invalid, as detailed
+		if actionErr.Code == actions.InvalidArgument && len(actionErr.Details) > 0 {
+			st = http.StatusUnprocessableEntity
+		}
 	} else {
 		errBody.Message = err.Error()
 	}
@@ -93,6 +122,8 @@ func HTTPStatusFromCode(code actions.ErrCode) int {
 		return http.StatusUnauthorized
 	case actions.Unauthenticated:
 		return http.StatusUnauthorized
+	case actions.PreconditionFailed:
+		return http.StatusPreconditionFailed
 	}
 
 	logger.WithField("code", code).Error("unknown error code")
@@ -126,17 +157,154 @@ func actionHandler(action actionHandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		respondWith(w, records)
+		respondWith(w, r, records)
 	}
 }
 
 type actionHandlerFunc func(r *http.Request) (interface{}, error)
 
+//
+// listHandler takes a action handler closure listing resources and returns a
+// new handler that executes the closure and writes the response, like
+// actionHandler. When the resources are paginated, the "limit" query parameter
+// limits the number of resources listed; when there are more, the token of the
+// next page is written in the Sensu-Continue header and given as the
+// "continue" query parameter to list them. Otherwise, these parameters are
+// rejected. The "labelSelector" and "fieldSelector" query parameters select
+// the resources listed; they are applied to each page, which may hence hold
+// less resources than the limit.
+//
+//    GET /checks?limit=100                --> 200 OK, Sensu-Continue: abc
+//    GET /checks?limit=100&continue=abc   --> 200 OK
+//    GET /checks?labelSelector=region=eu&fieldSelector=publish=true
+//    GET /rbac/users?limit=100            --> 400 Bad Request
+//
+// The closure of paginated resources must list them with the context returned
+// by listContext, so that the page is only selected by that call to the store.
+func listHandler(action actionHandlerFunc, paginated bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pred, err := selectionPredicate(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if pred != nil && !paginated {
+			writeError(w, actions.NewErrorf(actions.InvalidArgument, "the limit and continue parameters are not supported by this resource"))
+			return
+		}
+		labels, fields, err := selectors(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if pred != nil {
+			r = r.WithContext(context.WithValue(r.Context(), listPredicateKey{}, pred))
+		}
+
+		records, err := action(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if records, err = selectRecords(records, labels, fields); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if pred != nil && pred.Continue != "" {
+			w.Header().Set(types.PaginationContinueHeader, pred.Continue)
+		}
+		respondWith(w, r, records)
+	}
+}
+
+type listPredicateKey struct{}
+
+// listContext returns the context of the given request listing resources with
+// the store, selecting the page requested if any. It must only be used for
+// that call, since the store updates the selection predicate of the context.
+func listContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if pred, ok := ctx.Value(listPredicateKey{}).(*store.SelectionPredicate); ok {
+		return store.ContextWithSelectionPredicate(ctx, pred)
+	}
+	return ctx
+}
+
+// selectionPredicate returns the selection predicate described by the query
+// parameters of the given request, if any.
+func selectionPredicate(r *http.Request) (*store.SelectionPredicate, error) {
+	query := r.URL.Query()
+	limit, token := query.Get("limit"), query.Get("continue")
+	if limit == "" && token == "" {
+		return nil, nil
+	}
+
+	pred := &store.SelectionPredicate{Continue: token}
+	if limit != "" {
+		n, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || n < 0 {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "invalid limit %q", limit)
+		}
+		pred.Limit = n
+	}
+	return pred, nil
+}
+
+// selectors returns the label and field selectors given in the query
+// parameters of the given request.
+func selectors(r *http.Request) (labels, fields selector.Selector, err error) {
+	query := r.URL.Query()
+	if labels, err = selector.ParseLabelSelector(query.Get("labelSelector")); err != nil {
+		return nil, nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	if fields, err = selector.ParseFieldSelector(query.Get("fieldSelector")); err != nil {
+		return nil, nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	return labels, fields, nil
+}
+
+// selectRecords returns the given slice of records, only keeping the records
+// matched by the given label and field selectors.
+func selectRecords(records interface{}, labels, fields selector.Selector) (interface{}, error) {
+	if labels.Empty() && fields.Empty() {
+		return records, nil
+	}
+	value := reflect.ValueOf(records)
+	if value.Kind() != reflect.Slice {
+		return records, nil
+	}
+
+	selected := reflect.MakeSlice(value.Type(), 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		record := value.Index(i)
+		if record.Kind() != reflect.Ptr && record.CanAddr() {
+			// Extended attributes are read through pointer receivers
+			record = record.Addr()
+		}
+		if !labels.Matches(selector.LabelSet(record.Interface())) {
+			continue
+		}
+		if !fields.Empty() {
+			set, err := selector.FieldSet(record.Interface())
+			if err != nil {
+				return nil, err
+			}
+			if !fields.Matches(set) {
+				continue
+			}
+		}
+		selected = reflect.Append(selected, value.Index(i))
+	}
+	return selected.Interface(), nil
+}
+
 //
 // ResourceRoute mounts resources in a convetional RESTful manner.
 //
 //   routes := ResourceRoute{PathPrefix: "checks", Router: ...}
 //   routes.GetAll(myIndexAction) // given action is mounted at GET /checks
+//   routes.GetAllPaginated(myIndexAction) // same, listing a page at a time
 //   routes.Get(myShowAction)     // given action is mounted at GET /checks/:id
 //   routes.Put(myCreateAction)   // given action is mounted at PUT /checks/:id
 //   routes.Patch(myUpdateAction) // given action is mounted at PATCH /checks/:id
@@ -144,41 +312,59 @@ type actionHandlerFunc func(r *http.Request) (interface{}, error)
 //   routes.Del(myCreateAction)   // given action is mounted at DELETE /checks/:id
 //   routes.Path("{id}/publish", publishAction).Methods(http.MethodDelete) // when you need something customer
 //
+// Resources mounted with Get are given an ETag, and Put, Patch and Del honor
+// the If-Match and If-None-Match headers, see conditionalHandler. Post, Put
+// and Patch are dry runs when the "dryRun" query parameter is true.
+//
+//   PUT /checks/check-cpu?dryRun=true   --> 200 OK, the check is not stored
+//
 type ResourceRoute struct {
 	Router     *mux.Router
 	PathPrefix string
+
+	// DryRun is true if the actions given to Post, Put and Patch support dry
+	// runs, see actions.ContextWithDryRun; otherwise dry runs are rejected.
+	DryRun bool
 }
 
-// GetAll reads all
+// GetAll reads all; results may be selected using the labelSelector and
+// fieldSelector query parameters, see listHandler.
 func (r *ResourceRoute) GetAll(fn actionHandlerFunc) *mux.Route {
-	return r.Path("", fn).Methods(http.MethodGet)
+	fullPath := path.Join(r.PathPrefix, "")
+	return r.Router.HandleFunc(fullPath, listHandler(fn, false)).Methods(http.MethodGet)
+}
+
+// GetAllPaginated reads all like GetAll, and results may be paginated using
+// the limit and continue query parameters; the given action must list them
+// with the context returned by listContext.
+func (r *ResourceRoute) GetAllPaginated(fn actionHandlerFunc) *mux.Route {
+	fullPath := path.Join(r.PathPrefix, "")
+	return r.Router.HandleFunc(fullPath, listHandler(fn, true)).Methods(http.MethodGet)
 }
 
 // Get reads
 func (r *ResourceRoute) Get(fn actionHandlerFunc) *mux.Route {
-	return r.Path("{id}", fn).Methods(http.MethodGet)
+	return r.GetPath("{id}", fn)
 }
 
 // Post creates
 func (r *ResourceRoute) Post(fn actionHandlerFunc) *mux.Route {
-	return r.Path("", fn).Methods(http.MethodPost)
+	return r.Path("", r.dryRunAction(fn)).Methods(http.MethodPost)
 }
 
-// TODO: uncomment this and use it once controller update fits
-// http patch semantics.
-// Patch updates/modifies
-//func (r *ResourceRoute) Patch(fn actionHandlerFunc) *mux.Route {
-//	return r.path("{id}", fn).Methods(http.MethodPatch)
-//}
+// Patch updates/modifies, see patchRecord
+func (r *ResourceRoute) Patch(fn actionHandlerFunc) *mux.Route {
+	return r.ConditionalPath("{id}", r.dryRunAction(retryPatch(fn))).Methods(http.MethodPatch)
+}
 
 // Put updates/replaces
 func (r *ResourceRoute) Put(fn actionHandlerFunc) *mux.Route {
-	return r.Path("{id}", fn).Methods(http.MethodPut)
+	return r.ConditionalPath("{id}", r.dryRunAction(fn)).Methods(http.MethodPut)
 }
 
 // Del deletes
 func (r *ResourceRoute) Del(fn actionHandlerFunc) *mux.Route {
-	return r.Path("{id}", fn).Methods(http.MethodDelete)
+	return r.ConditionalPath("{id}", fn).Methods(http.MethodDelete)
 }
 
 // Path adds custom path
@@ -187,17 +373,194 @@ func (r *ResourceRoute) Path(p string, fn actionHandlerFunc) *mux.Route {
 	return handleAction(r.Router, fullPath, fn)
 }
 
+// ListPath adds custom path listing resources with GET, like GetAll
+func (r *ResourceRoute) ListPath(p string, fn actionHandlerFunc) *mux.Route {
+	fullPath := path.Join(r.PathPrefix, p)
+	return r.Router.HandleFunc(fullPath, listHandler(fn, false)).Methods(http.MethodGet)
+}
+
+// GetPath adds custom path reading resources, given an ETag
+func (r *ResourceRoute) GetPath(p string, fn actionHandlerFunc) *mux.Route {
+	fullPath := path.Join(r.PathPrefix, p)
+	return r.Router.HandleFunc(fullPath, getHandler(fn)).Methods(http.MethodGet)
+}
+
+// ConditionalPath adds custom path modifying resources, when they satisfy the
+// preconditions of the request.
+func (r *ResourceRoute) ConditionalPath(p string, fn actionHandlerFunc) *mux.Route {
+	fullPath := path.Join(r.PathPrefix, p)
+	return r.Router.HandleFunc(fullPath, conditionalHandler(fn))
+}
+
+// dryRunAction returns the given action creating or updating resources, run
+// as a dry run when requested.
+func (r *ResourceRoute) dryRunAction(fn actionHandlerFunc) actionHandlerFunc {
+	return func(req *http.Request) (interface{}, error) {
+		dryRun, err := dryRunParam(req)
+		if err != nil {
+			return nil, err
+		}
+		if !dryRun {
+			return fn(req)
+		}
+		if !r.DryRun {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "dry runs are not supported for %s", r.PathPrefix)
+		}
+		return fn(req.WithContext(actions.ContextWithDryRun(req.Context())))
+	}
+}
+
+// dryRunParam returns the value of the "dryRun" query parameter of the given
+// request, false if not given.
+func dryRunParam(req *http.Request) (bool, error) {
+	value := req.URL.Query().Get("dryRun")
+	if value == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, actions.NewErrorf(actions.InvalidArgument, "invalid dryRun %q", value)
+	}
+	return dryRun, nil
+}
+
 func handleAction(router *mux.Router, path string, fn actionHandlerFunc) *mux.Route {
 	return router.HandleFunc(path, actionHandler(fn))
 }
 
+// patchIdentityFields are the fields identifying resources, which can not be
+// patched.
+var patchIdentityFields = []string{"name", "id", "organization", "environment"}
+
+//
+// patchRecord finds the record with the given action, applies the patch given
+// in the request body and unmarshals the result into the given record. JSON
+// merge patches (RFC 7386) and JSON patches (RFC 6902) are supported, as
+// given by the Content-Type header; patches without media type are applied as
+// merge patches.
+//
+//   PATCH /checks/check-cpu
+//   Content-Type: application/merge-patch+json
+//   {"publish": false}
+//
+//   PATCH /checks/check-cpu
+//   Content-Type: application/json-patch+json
+//   [{"op": "replace", "path": "/publish", "value": false}]
+//
+// The returned context requires the record to still be at the revision it was
+// found at, so that storing the patched record with it fails with
+// ErrPreconditionFailed rather than overwriting a concurrent update.
+func patchRecord(req *http.Request, find actionHandlerFunc, record interface{}) (context.Context, error) {
+	var revision int64
+	current, err := find(req.WithContext(store.ContextWithRevision(req.Context(), &revision)))
+	if err != nil {
+		return nil, err
+	}
+	doc, err := json.Marshal(current)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := patch.Apply(req.Header.Get("Content-Type"), doc, body)
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	var before, after map[string]interface{}
+	if err := json.Unmarshal(patched, &after); err != nil {
+		return nil, actions.NewErrorf(actions.InvalidArgument, "patched resource is not an object")
+	}
+	_ = json.Unmarshal(doc, &before)
+	for _, field := range patchIdentityFields {
+		if !reflect.DeepEqual(before[field], after[field]) {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "field %q can not be patched", field)
+		}
+	}
+
+	if err := json.Unmarshal(patched, record); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	ctx := req.Context()
+	if revision > 0 {
+		ctx = store.ContextWithPrecondition(ctx, store.Precondition{Revision: revision, Match: true})
+	}
+	return ctx, nil
+}
+
+// maxPatchAttempts is the number of times a patch is applied to a record
+// updated concurrently before giving up.
+const maxPatchAttempts = 5
+
+// retryPatch returns the given patch action, applied again to the updated
+// record when the record was updated concurrently. Requests with their own
+// preconditions are not retried, as their patch was meant for the revision they
+// were given.
+func retryPatch(fn actionHandlerFunc) actionHandlerFunc {
+	return func(req *http.Request) (interface{}, error) {
+		if req.Header.Get("If-Match") != "" || req.Header.Get("If-None-Match") != "" {
+			return fn(req)
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		for attempt := 1; ; attempt++ {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			result, err := fn(req)
+			if code, ok := actions.StatusFromError(err); ok && code == actions.PreconditionFailed && attempt < maxPatchAttempts {
+				continue
+			}
+			return result, err
+		}
+	}
+}
+
+// UnmarshalBody decodes the body of the given request into the given record,
+// from JSON or, if it is a protobuf message, from protobuf as given by the
+// Content-Type header.
 func UnmarshalBody(req *http.Request, record interface{}) error {
+	if msg, ok := record.(proto.Unmarshaler); ok && isProtobuf(req) {
+		b, err := ioutil.ReadAll(req.Body)
+		if err == nil {
+			err = msg.Unmarshal(b)
+		}
+		if err != nil {
+			logger.WithError(err).Error("unable to read request body")
+			return err
+		}
+		syncObjectMeta(req, record)
+		return nil
+	}
+
 	err := json.NewDecoder(req.Body).Decode(&record)
 	if err != nil {
 		logger.WithError(err).Error("unable to read request body")
 		return err
 	}
-	// TODO: Support other types of requests other than JSON?
 
+	syncObjectMeta(req, record)
 	return nil
 }
+
+// syncObjectMeta reconciles the object metadata of the given record, if any,
+// with its name, organization and environment, and records the user who
+// submitted it.
+func syncObjectMeta(req *http.Request, record interface{}) {
+	r, ok := record.(types.MetaResource)
+	if !ok {
+		return
+	}
+	types.SyncObjectMeta(r)
+
+	if claims := jwt.GetClaimsFromContext(req.Context()); claims != nil {
+		meta := r.GetObjectMeta()
+		meta.CreatedBy = claims.Subject
+		r.SetObjectMeta(meta)
+	}
+}
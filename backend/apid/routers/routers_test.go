@@ -2,12 +2,21 @@ package routers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func newRequest(t *testing.T, method, endpoint string, body io.Reader) *http.Request {
@@ -32,3 +41,166 @@ func newRequest(t *testing.T, method, endpoint string, body io.Reader) *http.Req
 	ctx = context.WithValue(ctx, types.AuthorizationActorKey, actor)
 	return req.WithContext(ctx)
 }
+
+func TestListHandler(t *testing.T) {
+	handler := listHandler(func(r *http.Request) (interface{}, error) {
+		// The page is only selected by the store call given the list context
+		if store.SelectionPredicateFromContext(r.Context()) != nil {
+			return nil, errors.New("selection predicate in the request context")
+		}
+		pred := store.SelectionPredicateFromContext(listContext(r))
+		if pred != nil {
+			pred.Continue = "next"
+		}
+		return []string{}, nil
+	}, true)
+
+	testCases := []struct {
+		name         string
+		query        string
+		wantStatus   int
+		wantContinue string
+	}{
+		{"no pagination", "", http.StatusOK, ""},
+		{"limit", "?limit=2", http.StatusOK, "next"},
+		{"continue", "?limit=2&continue=abc", http.StatusOK, "next"},
+		{"invalid limit", "?limit=two", http.StatusBadRequest, ""},
+		{"negative limit", "?limit=-1", http.StatusBadRequest, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newRequest(t, http.MethodGet, "/checks"+tc.query, nil)
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, req)
+
+			assert.Equal(t, tc.wantStatus, res.Code)
+			assert.Equal(t, tc.wantContinue, res.Header().Get(types.PaginationContinueHeader))
+		})
+	}
+}
+
+func TestListHandlerNotPaginated(t *testing.T) {
+	handler := listHandler(func(r *http.Request) (interface{}, error) {
+		return []string{}, nil
+	}, false)
+
+	for query, wantStatus := range map[string]int{
+		"":                 http.StatusOK,
+		"?limit=2":         http.StatusBadRequest,
+		"?continue=abc":    http.StatusBadRequest,
+		"?labelSelector=a": http.StatusOK,
+	} {
+		req := newRequest(t, http.MethodGet, "/rbac/users"+query, nil)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		assert.Equal(t, wantStatus, res.Code, query)
+	}
+}
+
+func TestSelectionPredicate(t *testing.T) {
+	req := newRequest(t, http.MethodGet, "/checks?limit=10&continue=abc", nil)
+	pred, err := selectionPredicate(req)
+	require.NoError(t, err)
+	assert.Equal(t, &store.SelectionPredicate{Limit: 10, Continue: "abc"}, pred)
+
+	req = newRequest(t, http.MethodGet, "/checks", nil)
+	pred, err = selectionPredicate(req)
+	require.NoError(t, err)
+	assert.Nil(t, pred)
+}
+
+func TestListHandlerSelectors(t *testing.T) {
+	eu := types.FixtureCheckConfig("eu")
+	eu.ExtendedAttributes = []byte(`{"region":"eu"}`)
+	unpublished := types.FixtureCheckConfig("unpublished")
+	unpublished.ExtendedAttributes = []byte(`{"region":"eu"}`)
+	unpublished.Publish = false
+	us := types.FixtureCheckConfig("us")
+	us.ExtendedAttributes = []byte(`{"region":"us"}`)
+
+	handler := listHandler(func(r *http.Request) (interface{}, error) {
+		return []*types.CheckConfig{eu, unpublished, us}, nil
+	}, true)
+
+	testCases := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantNames  []string
+	}{
+		{"no selectors", "", http.StatusOK, []string{"eu", "unpublished", "us"}},
+		{"label selector", "?labelSelector=region%3Deu", http.StatusOK, []string{"eu", "unpublished"}},
+		{"field selector", "?fieldSelector=publish%3Dtrue", http.StatusOK, []string{"eu", "us"}},
+		{"both selectors", "?labelSelector=region%3Deu&fieldSelector=publish%3Dtrue", http.StatusOK, []string{"eu"}},
+		{"invalid label selector", "?labelSelector=region+in+(eu", http.StatusBadRequest, nil},
+		{"invalid field selector", "?fieldSelector=publish", http.StatusBadRequest, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newRequest(t, http.MethodGet, "/checks"+tc.query, nil)
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, req)
+
+			require.Equal(t, tc.wantStatus, res.Code)
+			if tc.wantNames == nil {
+				return
+			}
+			var checks []*types.CheckConfig
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&checks))
+			names := []string{}
+			for _, check := range checks {
+				names = append(names, check.Name)
+			}
+			assert.Equal(t, tc.wantNames, names)
+		})
+	}
+}
+
+func TestUnmarshalBodyObjectMeta(t *testing.T) {
+	body := `{"name":"check","organization":"acme","environment":"production","metadata":{"labels":{"team":"ops"}}}`
+	req := newRequest(t, http.MethodPost, "/checks", strings.NewReader(body))
+	claims := &types.Claims{StandardClaims: jwt.StandardClaims{Subject: "alice"}}
+	req = req.WithContext(context.WithValue(req.Context(), types.ClaimsKey, claims))
+
+	var check types.CheckConfig
+	require.NoError(t, UnmarshalBody(req, &check))
+
+	assert.Equal(t, "check", check.ObjectMeta.Name)
+	assert.Equal(t, "acme/production", check.ObjectMeta.Namespace)
+	assert.Equal(t, map[string]string{"team": "ops"}, check.Labels)
+	assert.Equal(t, "alice", check.CreatedBy)
+
+	// The metadata names and namespaces the resource when it has none
+	body = `{"metadata":{"name":"handler","namespace":"acme/production"}}`
+	req = newRequest(t, http.MethodPost, "/handlers", strings.NewReader(body))
+
+	var handler types.Handler
+	require.NoError(t, UnmarshalBody(req, &handler))
+
+	assert.Equal(t, "handler", handler.Name)
+	assert.Equal(t, "acme", handler.Organization)
+	assert.Equal(t, "production", handler.Environment)
+	assert.Empty(t, handler.CreatedBy)
+}
+
+func TestWriteErrorDetails(t *testing.T) {
+	check := types.FixtureCheckConfig("check")
+	check.Interval = 0
+
+	w := httptest.NewRecorder()
+	writeError(w, actions.NewError(actions.InvalidArgument, check.Validate()))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	var body errorBody
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, uint32(actions.InvalidArgument), body.Code)
+	assert.Equal(t, types.FieldErrors{
+		{Field: "interval", Message: "must be greater than 0 or a valid cron schedule must be provided"},
+	}, body.Details)
+
+	// The other invalid arguments are bad requests
+	w = httptest.NewRecorder()
+	writeError(w, actions.NewErrorf(actions.InvalidArgument, "invalid limit"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.NotContains(t, w.Body.String(), "details")
+}
@@ -0,0 +1,87 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// SecretsRouter handles /secrets requests.
+type SecretsRouter struct {
+	controller actions.SecretController
+}
+
+// NewSecretsRouter creates a new SecretsRouter.
+func NewSecretsRouter(store store.SecretStore) *SecretsRouter {
+	return &SecretsRouter{
+		controller: actions.NewSecretController(store),
+	}
+}
+
+// Mount the SecretsRouter to a parent Router
+func (r *SecretsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{Router: parent, PathPrefix: "/secrets", DryRun: true}
+	routes.GetAllPaginated(r.list)
+	routes.Get(r.find)
+	routes.Post(r.create)
+	routes.Del(r.destroy)
+	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
+}
+
+func (r *SecretsRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(listContext(req))
+}
+
+func (r *SecretsRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *SecretsRouter) create(req *http.Request) (interface{}, error) {
+	sec := types.Secret{}
+	if err := UnmarshalBody(req, &sec); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), sec)
+	return sec, err
+}
+
+func (r *SecretsRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	secret := types.Secret{}
+	if err := UnmarshalBody(req, &secret); err != nil {
+		return nil, err
+	}
+
+	return secret, r.controller.CreateOrReplace(req.Context(), secret)
+}
+
+func (r *SecretsRouter) patch(req *http.Request) (interface{}, error) {
+	secret := types.Secret{}
+	ctx, err := patchRecord(req, r.find, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, secret)
+	return secret, err
+}
+
+func (r *SecretsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
@@ -0,0 +1,87 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ServiceComponentsRouter handles /service-components requests.
+type ServiceComponentsRouter struct {
+	controller actions.ServiceComponentController
+}
+
+// NewServiceComponentsRouter creates a new ServiceComponentsRouter.
+func NewServiceComponentsRouter(store store.ServiceStore) *ServiceComponentsRouter {
+	return &ServiceComponentsRouter{
+		controller: actions.NewServiceComponentController(store),
+	}
+}
+
+// Mount the ServiceComponentsRouter to a parent Router
+func (r *ServiceComponentsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{Router: parent, PathPrefix: "/service-components", DryRun: true}
+	routes.GetAllPaginated(r.list)
+	routes.Get(r.find)
+	routes.Post(r.create)
+	routes.Del(r.destroy)
+	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
+}
+
+func (r *ServiceComponentsRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(listContext(req))
+}
+
+func (r *ServiceComponentsRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *ServiceComponentsRouter) create(req *http.Request) (interface{}, error) {
+	component := types.ServiceComponent{}
+	if err := UnmarshalBody(req, &component); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), component)
+	return component, err
+}
+
+func (r *ServiceComponentsRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	component := types.ServiceComponent{}
+	if err := UnmarshalBody(req, &component); err != nil {
+		return nil, err
+	}
+
+	return component, r.controller.CreateOrReplace(req.Context(), component)
+}
+
+func (r *ServiceComponentsRouter) patch(req *http.Request) (interface{}, error) {
+	component := types.ServiceComponent{}
+	ctx, err := patchRecord(req, r.find, &component)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, component)
+	return component, err
+}
+
+func (r *ServiceComponentsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
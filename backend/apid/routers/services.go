@@ -0,0 +1,87 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ServicesRouter handles /services requests.
+type ServicesRouter struct {
+	controller actions.ServiceController
+}
+
+// NewServicesRouter creates a new ServicesRouter.
+func NewServicesRouter(store store.ServiceStore) *ServicesRouter {
+	return &ServicesRouter{
+		controller: actions.NewServiceController(store),
+	}
+}
+
+// Mount the ServicesRouter to a parent Router
+func (r *ServicesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{Router: parent, PathPrefix: "/services", DryRun: true}
+	routes.GetAllPaginated(r.list)
+	routes.Get(r.find)
+	routes.Post(r.create)
+	routes.Del(r.destroy)
+	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
+}
+
+func (r *ServicesRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(listContext(req))
+}
+
+func (r *ServicesRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *ServicesRouter) create(req *http.Request) (interface{}, error) {
+	service := types.Service{}
+	if err := UnmarshalBody(req, &service); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), service)
+	return service, err
+}
+
+func (r *ServicesRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	service := types.Service{}
+	if err := UnmarshalBody(req, &service); err != nil {
+		return nil, err
+	}
+
+	return service, r.controller.CreateOrReplace(req.Context(), service)
+}
+
+func (r *ServicesRouter) patch(req *http.Request) (interface{}, error) {
+	service := types.Service{}
+	ctx, err := patchRecord(req, r.find, &service)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.controller.CreateOrReplace(ctx, service)
+	return service, err
+}
+
+func (r *ServicesRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
@@ -25,20 +25,21 @@ func NewSilencedRouter(store store.Store) *SilencedRouter {
 // Mount the SilencedRouter to a parent Router
 func (r *SilencedRouter) Mount(parent *mux.Router) {
 	routes := ResourceRoute{Router: parent, PathPrefix: "/silenced"}
-	routes.GetAll(r.list)
+	routes.GetAllPaginated(r.list)
 	routes.Get(r.find)
 	routes.Post(r.create)
 	routes.Del(r.destroy)
 	routes.Put(r.createOrReplace)
+	routes.Patch(r.patch)
 
 	// Custom
-	routes.Path("subscriptions/{subscription}", r.list).Methods(http.MethodGet)
-	routes.Path("checks/{check}", r.list).Methods(http.MethodGet)
+	routes.ListPath("subscriptions/{subscription}", r.list)
+	routes.ListPath("checks/{check}", r.list)
 }
 
 func (r *SilencedRouter) list(req *http.Request) (interface{}, error) {
 	params := mux.Vars(req)
-	return r.controller.Query(req.Context(), params["subscription"], params["check"])
+	return r.controller.Query(listContext(req), params["subscription"], params["check"])
 }
 
 func (r *SilencedRouter) find(req *http.Request) (interface{}, error) {
@@ -70,6 +71,22 @@ func (r *SilencedRouter) createOrReplace(req *http.Request) (interface{}, error)
 	return cfg, err
 }
 
+func (r *SilencedRouter) patch(req *http.Request) (interface{}, error) {
+	cfg := types.Silenced{}
+	ctx, err := patchRecord(req, r.find, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	// The ID of silenced entries is derived from their subscription, check and
+	// label selector
+	if id, _ := types.SilencedIDWithSelector(cfg.Subscription, cfg.Check, cfg.LabelSelector); id != cfg.ID {
+		return nil, actions.NewErrorf(actions.InvalidArgument, "subscription, check and label selector can not be patched")
+	}
+
+	err = r.controller.CreateOrReplace(ctx, cfg)
+	return cfg, err
+}
+
 func (r *SilencedRouter) destroy(req *http.Request) (interface{}, error) {
 	params := actions.QueryParams(mux.Vars(req))
 	id, err := url.PathUnescape(params["id"])
@@ -0,0 +1,41 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Audit is global instance of AuditPolicy
+var Audit = AuditPolicy{}
+
+// AuditPolicy defines access control for the audit log.
+type AuditPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *AuditPolicy) Resource() string {
+	return types.RuleTypeAudit
+}
+
+// Context info this instance of the policy is associated with
+func (p *AuditPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p AuditPolicy) WithContext(ctx context.Context) AuditPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *AuditPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *AuditPolicy) CanRead(entry *types.AuditEntry) bool {
+	return canPerformOn(p, entry.Organization, entry.Environment, types.RulePermRead)
+}
@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Secrets is global instance of SecretPolicy
+var Secrets = SecretPolicy{}
+
+// SecretPolicy ...
+type SecretPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *SecretPolicy) Resource() string {
+	return types.RuleTypeSecret
+}
+
+// Context info this instance of the policy is associated with
+func (p *SecretPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p SecretPolicy) WithContext(ctx context.Context) SecretPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *SecretPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *SecretPolicy) CanRead(secret *types.Secret) bool {
+	return canPerformOn(p, secret.Organization, secret.Environment, types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *SecretPolicy) CanCreate(secret *types.Secret) bool {
+	return canPerformOn(p, secret.Organization, secret.Environment, types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *SecretPolicy) CanUpdate(secret *types.Secret) bool {
+	return canPerformOn(p, secret.Organization, secret.Environment, types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *SecretPolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
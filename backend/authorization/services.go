@@ -0,0 +1,57 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Services is global instance of ServicePolicy, covering business services
+// and their components
+var Services = ServicePolicy{}
+
+// ServicePolicy ...
+type ServicePolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *ServicePolicy) Resource() string {
+	return types.RuleTypeService
+}
+
+// Context info this instance of the policy is associated with
+func (p *ServicePolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p ServicePolicy) WithContext(ctx context.Context) ServicePolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *ServicePolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *ServicePolicy) CanRead(resource types.MultitenantResource) bool {
+	return canPerformOn(p, resource.GetOrganization(), resource.GetEnvironment(), types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *ServicePolicy) CanCreate(resource types.MultitenantResource) bool {
+	return canPerformOn(p, resource.GetOrganization(), resource.GetEnvironment(), types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *ServicePolicy) CanUpdate(resource types.MultitenantResource) bool {
+	return canPerformOn(p, resource.GetOrganization(), resource.GetEnvironment(), types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *ServicePolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
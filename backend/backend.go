@@ -4,7 +4,9 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"runtime/debug"
+	"strings"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/agentd"
@@ -21,9 +23,14 @@ import (
 	"github.com/sensu/sensu-go/backend/queue"
 	"github.com/sensu/sensu-go/backend/ring"
 	"github.com/sensu/sensu-go/backend/schedulerd"
+	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/sensu/sensu-go/backend/servicesd"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sensu/sensu-go/backend/store/postgres"
+	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/types"
 )
@@ -32,9 +39,10 @@ import (
 // and coordinating the daemons
 type Backend struct {
 	Daemons []daemon.Daemon
-	Etcd    *etcd.Etcd
+	Etcd    *etcd.Etcd // nil when using an external etcd cluster
 	Store   store.Store
 
+	client       *clientv3.Client
 	done         chan struct{}
 	shutdownChan chan struct{}
 }
@@ -62,46 +70,85 @@ func Initialize(config *Config) (*Backend, error) {
 		}
 	}
 
-	// Initialize and start etcd, because we'll need to provide an etcd client to
+	// Connect to the external etcd cluster if configured, or initialize and
+	// start etcd otherwise, because we'll need to provide an etcd client to
 	// the Wizard bus, which requires etcd to be started.
-	cfg := etcd.NewConfig()
-	cfg.DataDir = config.StateDir
-	cfg.ListenClientURL = config.EtcdListenClientURL
-	cfg.ListenPeerURL = config.EtcdListenPeerURL
-	cfg.InitialCluster = config.EtcdInitialCluster
-	cfg.InitialClusterState = config.EtcdInitialClusterState
-	cfg.InitialAdvertisePeerURL = config.EtcdInitialAdvertisePeerURL
-	cfg.Name = config.EtcdName
-
-	if config.TLS != nil {
-		cfg.TLSConfig = &etcd.TLSConfig{
-			Info: etcd.TLSInfo{
-				CertFile:      config.TLS.CertFile,
-				KeyFile:       config.TLS.KeyFile,
-				TrustedCAFile: config.TLS.TrustedCAFile,
+	var (
+		e      *etcd.Etcd
+		client *clientv3.Client
+	)
+	if len(config.EtcdClientEndpoints) > 0 || config.EtcdDiscoverySRV != "" {
+		client, err = etcd.NewClusterClient(&etcd.ClientConfig{
+			Endpoints:    config.EtcdClientEndpoints,
+			DiscoverySRV: config.EtcdDiscoverySRV,
+			TLSInfo: etcd.TLSInfo{
+				CertFile:      config.EtcdClientCertFile,
+				KeyFile:       config.EtcdClientKeyFile,
+				TrustedCAFile: config.EtcdClientTrustedCAFile,
 			},
-			TLS: tlsConfig,
+			Username: config.EtcdClientUsername,
+			Password: config.EtcdClientPassword,
+		})
+		if err != nil {
+			return nil, errors.New("error connecting to etcd: " + err.Error())
+		}
+	} else {
+		cfg := etcd.NewConfig()
+		cfg.DataDir = config.StateDir
+		cfg.ListenClientURL = config.EtcdListenClientURL
+		cfg.ListenPeerURL = config.EtcdListenPeerURL
+		cfg.InitialCluster = config.EtcdInitialCluster
+		cfg.InitialClusterState = config.EtcdInitialClusterState
+		cfg.InitialAdvertisePeerURL = config.EtcdInitialAdvertisePeerURL
+		cfg.Name = config.EtcdName
+
+		if config.TLS != nil {
+			cfg.TLSConfig = &etcd.TLSConfig{
+				Info: etcd.TLSInfo{
+					CertFile:      config.TLS.CertFile,
+					KeyFile:       config.TLS.KeyFile,
+					TrustedCAFile: config.TLS.TrustedCAFile,
+				},
+				TLS: tlsConfig,
+			}
 		}
-	}
 
-	// Start etcd
-	e, err := etcd.NewEtcd(cfg)
-	if err != nil {
-		return nil, errors.New("error starting etcd: " + err.Error())
-	}
+		// Start etcd
+		e, err = etcd.NewEtcd(cfg)
+		if err != nil {
+			return nil, errors.New("error starting etcd: " + err.Error())
+		}
 
-	// Create an etcd client for our daemons
-	client, err := e.NewClient()
-	if err != nil {
-		return nil, errors.New("error initializing an etcd client: " + err.Error())
+		// Create an etcd client for our daemons
+		client, err = e.NewClient()
+		if err != nil {
+			return nil, errors.New("error initializing an etcd client: " + err.Error())
+		}
 	}
 
 	// Initialize the store, which lives on top of etcd
-	store := etcdstore.NewStore(client, e.Name())
-	if err = seeds.SeedInitialData(store); err != nil {
+	etcdStore := etcdstore.NewStore(client, config.EtcdName)
+	if err = seeds.SeedInitialData(etcdStore); err != nil {
 		return nil, errors.New("error initializing the store: " + err.Error())
 	}
 
+	// Keep the events in PostgreSQL rather than etcd if configured; its store
+	// is started first so that it is available to the other daemons
+	var store store.Store = etcdStore
+	if config.EventStorePostgresURL != "" {
+		pgStore, err := postgres.Open(etcdStore, config.EventStorePostgresURL)
+		if err != nil {
+			return nil, errors.New("error initializing the postgresql event store: " + err.Error())
+		}
+		b.Daemons = append(b.Daemons, pgStore)
+		store = pgStore
+	}
+
+	// Serve the resources read the most by eventd and schedulerd from memory;
+	// the cache is started before them so that they never miss a change
+	cachedStore := cache.New(store)
+	b.Daemons = append(b.Daemons, cachedStore)
+
 	// Initialize an etcd getter
 	queueGetter := queue.EtcdGetter{Client: client}
 
@@ -114,11 +161,20 @@ func Initialize(config *Config) (*Backend, error) {
 	}
 	b.Daemons = append(b.Daemons, bus)
 
+	// Secrets held by Vault are only available if its server is configured
+	if config.VaultAddress != "" {
+		secrets.RegisterProvider(types.SecretProviderVault, secrets.NewVaultProvider(config.VaultAddress, config.VaultToken))
+	}
+
 	// Initialize pipelined
 	pipeline, err := pipelined.New(pipelined.Config{
 		Store: store,
 		Bus:   bus,
 		ExtensionExecutorGetter: rpc.NewGRPCExtensionExecutor,
+		AssetCacheDir:           filepath.Join(config.StateDir, "cache"),
+		AssetCacheMaxSize:       config.AssetCacheMaxSize,
+		AssetCacheMaxAge:        config.AssetCacheMaxAge,
+		AssetMirror:             config.AssetMirror,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", pipeline.Name(), err.Error())
@@ -127,7 +183,7 @@ func Initialize(config *Config) (*Backend, error) {
 
 	// Initialize eventd
 	event, err := eventd.New(eventd.Config{
-		Store:          store,
+		Store:          cachedStore,
 		Bus:            bus,
 		MonitorFactory: monitor.EtcdFactory(client),
 	})
@@ -138,7 +194,7 @@ func Initialize(config *Config) (*Backend, error) {
 
 	// Initialize schedulerd
 	scheduler, err := schedulerd.New(schedulerd.Config{
-		Store:       store,
+		Store:       cachedStore,
 		Bus:         bus,
 		QueueGetter: queueGetter,
 	})
@@ -154,6 +210,10 @@ func Initialize(config *Config) (*Backend, error) {
 		Bus:   bus,
 		Store: store,
 		TLS:   config.TLS,
+
+		AgentAuthTrustedCAFile: config.AgentAuthTrustedCAFile,
+		AgentAuthCRLFile:       config.AgentAuthCRLFile,
+		RequireAgentCert:       config.RequireAgentCert,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", agent.Name(), err.Error())
@@ -172,6 +232,16 @@ func Initialize(config *Config) (*Backend, error) {
 	}
 	b.Daemons = append(b.Daemons, keepalive)
 
+	// Initialize servicesd
+	services, err := servicesd.New(servicesd.Config{
+		Store: store,
+		Bus:   bus,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing %s: %s", services.Name(), err.Error())
+	}
+	b.Daemons = append(b.Daemons, services)
+
 	// Initialize apid
 	api, err := apid.New(apid.Config{
 		Host:          config.APIHost,
@@ -182,6 +252,15 @@ func Initialize(config *Config) (*Backend, error) {
 		TLS:           config.TLS,
 		BackendStatus: b.Status,
 		Cluster:       clientv3.NewCluster(client),
+		GraphQLLimits: graphql.Limits{
+			MaxDepth:      config.GraphQLMaxDepth,
+			MaxComplexity: config.GraphQLMaxComplexity,
+		},
+		GraphQLTracing: config.GraphQLTracing,
+		RateLimit:      config.APIRateLimit,
+		RateBurst:      config.APIRateBurst,
+		AuditRetention: config.AuditRetention,
+		GzipMinSize:    config.APIGzipMinSize,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", api.Name(), err.Error())
@@ -203,6 +282,7 @@ func Initialize(config *Config) (*Backend, error) {
 	// Add etcd and store to our backend, since it's needed across the methods
 	b.Etcd = e
 	b.Store = store
+	b.client = client
 
 	return b, nil
 }
@@ -238,7 +318,9 @@ func (b *Backend) Run() error {
 	}
 
 	// Add etcd to our errGroup, since it's not included in the daemon list
-	eg.errors = append(eg.errors, b.Etcd)
+	if b.Etcd != nil {
+		eg.errors = append(eg.errors, b.Etcd)
+	}
 	eg.Go()
 
 	select {
@@ -256,7 +338,13 @@ func (b *Backend) Run() error {
 			logger.WithField("panic", trace).WithError(err.(error)).
 				Error("recovering from panic due to error, shutting down etcd")
 		}
-		err := b.Etcd.Shutdown()
+		var err error
+		if b.Etcd != nil {
+			err = b.Etcd.Shutdown()
+		} else {
+			// The external etcd cluster outlives the backend
+			err = b.client.Close()
+		}
 		if derr == nil {
 			derr = err
 		}
@@ -321,15 +409,15 @@ func (e errGroup) Err() <-chan error {
 
 // Migration performs the migration of data inside the store
 func (b *Backend) Migration() error {
-	logger.Infof("starting migration on the store with URL '%s'", b.Etcd.LoopbackURL())
-	migration.Run(b.Etcd.LoopbackURL())
+	logger.Infof("starting migration on the store with URLs '%s'", strings.Join(b.client.Endpoints(), ","))
+	migration.Run(b.client)
 	return nil
 }
 
 // Status returns a map of component name to boolean healthy indicator.
 func (b *Backend) Status() types.StatusMap {
 	sm := map[string]bool{
-		"store": b.Etcd.Healthy(),
+		"store": b.storeHealthy(),
 	}
 
 	for _, d := range b.Daemons {
@@ -339,6 +427,14 @@ func (b *Backend) Status() types.StatusMap {
 	return sm
 }
 
+// storeHealthy returns whether etcd is healthy, embedded or not.
+func (b *Backend) storeHealthy() bool {
+	if b.Etcd != nil {
+		return b.Etcd.Healthy()
+	}
+	return etcd.ClientHealthy(b.client)
+}
+
 // Stop the Backend cleanly.
 func (b *Backend) Stop() {
 	close(b.shutdownChan)
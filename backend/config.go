@@ -1,6 +1,10 @@
 package backend
 
-import "github.com/sensu/sensu-go/types"
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
 
 const (
 	// DefaultEtcdName is the default etcd member node name (single-node cluster only)
@@ -22,10 +26,33 @@ type Config struct {
 	AgentHost string
 	AgentPort int
 
+	// Agent authentication with client certificates, verified against the
+	// trusted CA file and the CRL file; basic authentication remains allowed
+	// unless RequireAgentCert is true
+	AgentAuthTrustedCAFile string
+	AgentAuthCRLFile       string
+	RequireAgentCert       bool
+
 	// Apid Configuration
 	APIHost string
 	APIPort int
 
+	// API rate limiting, in requests per second per user; zero disables it
+	APIRateLimit float64
+	APIRateBurst int
+
+	// Retention of the audit log of the API; zero disables it
+	AuditRetention time.Duration
+
+	// Size of the API responses, in bytes, from which they are compressed;
+	// zero disables compression
+	APIGzipMinSize int
+
+	// GraphQL configuration; zero disables the limit
+	GraphQLMaxDepth      int
+	GraphQLMaxComplexity int
+	GraphQLTracing       bool
+
 	// Dashboardd Configuration
 	DashboardHost string
 	DashboardPort int
@@ -33,6 +60,21 @@ type Config struct {
 	// Pipelined Configuration
 	DeregistrationHandler string
 
+	// Runtime assets of filters, handlers and mutators; zero disables the
+	// limits of the asset cache
+	AssetMirror       string
+	AssetCacheMaxSize int64
+	AssetCacheMaxAge  time.Duration
+
+	// HashiCorp Vault server of the vault secrets provider, which is not
+	// available if the address is empty
+	VaultAddress string
+	VaultToken   string
+
+	// Connection URL of the PostgreSQL database storing the events, which are
+	// stored in etcd if it is empty
+	EventStorePostgresURL string
+
 	// Etcd configuration
 	EtcdInitialAdvertisePeerURL string
 	EtcdInitialClusterToken     string
@@ -42,5 +84,16 @@ type Config struct {
 	EtcdListenPeerURL           string
 	EtcdName                    string
 
+	// External etcd cluster used in place of the embedded etcd, whose
+	// configuration above is then ignored, if its client endpoints are given
+	// or discovered with the DNS SRV records of a domain
+	EtcdClientEndpoints     []string
+	EtcdDiscoverySRV        string
+	EtcdClientCertFile      string
+	EtcdClientKeyFile       string
+	EtcdClientTrustedCAFile string
+	EtcdClientUsername      string
+	EtcdClientPassword      string
+
 	TLS *types.TLSOptions
 }
@@ -0,0 +1,103 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/srv"
+	"github.com/coreos/etcd/pkg/transport"
+)
+
+// ClientDialTimeout is the amount of time given to the clients of an external
+// etcd cluster to connect to it.
+const ClientDialTimeout = 5 * time.Second
+
+// ClientConfig is a configuration of the clients of an external etcd cluster,
+// used in place of the embedded etcd.
+type ClientConfig struct {
+	// Endpoints are the client URLs of the members of the cluster
+	Endpoints []string
+
+	// DiscoverySRV is the domain whose DNS SRV records list the client URLs of
+	// the members of the cluster, as _etcd-client-ssl._tcp and _etcd-client._tcp
+	// records, when no endpoints are given
+	DiscoverySRV string
+
+	// TLSInfo holds the client certificate and key authenticating the backend,
+	// and the CA verifying the certificates of the members of the cluster
+	TLSInfo TLSInfo
+
+	// Username and Password authenticate the backend when the cluster has
+	// authentication enabled
+	Username string
+	Password string
+}
+
+// endpoints returns the client URLs of the members of the cluster, looking
+// them up if they are discovered with DNS.
+func (c *ClientConfig) endpoints() ([]string, error) {
+	if len(c.Endpoints) > 0 {
+		return c.Endpoints, nil
+	}
+	if c.DiscoverySRV == "" {
+		return nil, errors.New("no etcd endpoints or discovery domain specified")
+	}
+	clients, err := srv.GetClient("etcd-client", c.DiscoverySRV)
+	if err != nil {
+		return nil, err
+	}
+	if len(clients.Endpoints) == 0 {
+		return nil, errors.New("no etcd endpoints found for the discovery domain " + c.DiscoverySRV)
+	}
+	return clients.Endpoints, nil
+}
+
+// NewClusterClient returns a new etcd v3 client of the external cluster of the
+// given configuration. Clients must be closed after use.
+func NewClusterClient(config *ClientConfig) (*clientv3.Client, error) {
+	endpoints, err := config.endpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: ClientDialTimeout,
+		Username:    config.Username,
+		Password:    config.Password,
+	}
+
+	info := (transport.TLSInfo)(config.TLSInfo)
+	if !info.Empty() || info.TrustedCAFile != "" {
+		cfg.TLS, err = info.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return clientv3.New(cfg)
+}
+
+// ClientHealthy returns whether one of the endpoints of the given client is
+// healthy. The endpoints are checked concurrently.
+func ClientHealthy(client *clientv3.Client) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), ClientDialTimeout)
+	defer cancel()
+
+	endpoints := client.Endpoints()
+	results := make(chan error, len(endpoints))
+	for _, endpoint := range endpoints {
+		go func(endpoint string) {
+			_, err := client.Status(ctx, endpoint)
+			results <- err
+		}(endpoint)
+	}
+	for range endpoints {
+		if err := <-results; err == nil {
+			return true
+		}
+	}
+	return false
+}
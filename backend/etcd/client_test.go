@@ -0,0 +1,169 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCerts writes in the given directory a CA, and a certificate it
+// issued for 127.0.0.1, used by both the etcd members and their clients.
+func writeTestCerts(t *testing.T, dir string) TLSInfo {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "etcd-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "etcd"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	info := TLSInfo{
+		CertFile:      filepath.Join(dir, "etcd.pem"),
+		KeyFile:       filepath.Join(dir, "etcd-key.pem"),
+		TrustedCAFile: filepath.Join(dir, "ca.pem"),
+	}
+	for file, block := range map[string]*pem.Block{
+		info.CertFile:      {Type: "CERTIFICATE", Bytes: der},
+		info.KeyFile:       {Type: "EC PRIVATE KEY", Bytes: keyDER},
+		info.TrustedCAFile: {Type: "CERTIFICATE", Bytes: caDER},
+	} {
+		require.NoError(t, ioutil.WriteFile(file, pem.EncodeToMemory(block), 0600))
+	}
+	return info
+}
+
+func TestNewClusterClient(t *testing.T) {
+	e, cleanup := NewTestEtcd(t)
+	defer cleanup()
+
+	client, err := NewClusterClient(&ClientConfig{
+		Endpoints: []string{"http://127.0.0.1:1", e.LoopbackURL()},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Put(context.Background(), "key", "value")
+	require.NoError(t, err)
+	resp, err := client.Get(context.Background(), "key")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Kvs))
+	assert.Equal(t, "value", string(resp.Kvs[0].Value))
+
+	// One healthy endpoint is enough
+	assert.True(t, ClientHealthy(client))
+}
+
+func TestNewClusterClientAuth(t *testing.T) {
+	e, cleanup := NewTestEtcd(t)
+	defer cleanup()
+
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = client.UserAdd(context.Background(), "root", "P@ssw0rd!")
+	require.NoError(t, err)
+	_, err = client.UserGrantRole(context.Background(), "root", "root")
+	require.NoError(t, err)
+	_, err = client.AuthEnable(context.Background())
+	require.NoError(t, err)
+
+	authClient, err := NewClusterClient(&ClientConfig{
+		Endpoints: []string{e.LoopbackURL()},
+		Username:  "root",
+		Password:  "P@ssw0rd!",
+	})
+	require.NoError(t, err)
+	defer authClient.Close()
+	_, err = authClient.Put(context.Background(), "key", "value")
+	require.NoError(t, err)
+
+	// Clients without credentials are not allowed anymore
+	anonymousClient, err := NewClusterClient(&ClientConfig{
+		Endpoints: []string{e.LoopbackURL()},
+	})
+	require.NoError(t, err)
+	defer anonymousClient.Close()
+	_, err = anonymousClient.Put(context.Background(), "key", "value")
+	assert.Error(t, err)
+}
+
+func TestNewClusterClientTLS(t *testing.T) {
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+
+	ports := make([]int, 2)
+	require.NoError(t, testutil.RandomPorts(ports))
+	clURL := fmt.Sprintf("https://127.0.0.1:%d", ports[0])
+	apURL := fmt.Sprintf("https://127.0.0.1:%d", ports[1])
+
+	info := writeTestCerts(t, tmpDir)
+	cfg := NewConfig()
+	cfg.DataDir = tmpDir
+	cfg.ListenClientURL = clURL
+	cfg.ListenPeerURL = apURL
+	cfg.InitialCluster = fmt.Sprintf("default=%s", apURL)
+	cfg.InitialAdvertisePeerURL = apURL
+	cfg.TLSConfig = &TLSConfig{Info: info}
+	e, err := NewEtcd(cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, e.Shutdown())
+	}()
+
+	client, err := NewClusterClient(&ClientConfig{
+		Endpoints: []string{clURL},
+		TLSInfo:   info,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Put(context.Background(), "key", "value")
+	require.NoError(t, err)
+	assert.True(t, ClientHealthy(client))
+}
+
+func TestNewClusterClientNoEndpoints(t *testing.T) {
+	_, err := NewClusterClient(&ClientConfig{})
+	assert.Error(t, err)
+}
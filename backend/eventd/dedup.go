@@ -0,0 +1,39 @@
+package eventd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// setDedupKey renders the deduplication key template of the check of the
+// event, if any, into the deduplication key of the event. The events with
+// the same deduplication key share the same event stream.
+func setDedupKey(event *types.Event) error {
+	// Never trust the deduplication key of the events received
+	event.DedupKey = ""
+	if event.Check.DedupKey == "" {
+		return nil
+	}
+
+	tmpl, err := template.New("dedup_key").Option("missingkey=error").Parse(event.Check.DedupKey)
+	if err != nil {
+		return fmt.Errorf("dedup key template is invalid: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return fmt.Errorf("could not render the dedup key: %s", err)
+	}
+
+	key := strings.TrimSpace(buf.String())
+	if err := types.ValidateName(key); err != nil {
+		return fmt.Errorf("dedup key %q %s", key, err)
+	}
+	event.DedupKey = key
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package eventd
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockring"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDedupKey(t *testing.T) {
+	testCases := []struct {
+		name        string
+		template    string
+		received    string
+		expectedKey string
+		expectedErr bool
+	}{
+		{
+			name: "no dedup key",
+		},
+		{
+			name:     "received dedup key is discarded",
+			received: "service1",
+		},
+		{
+			name:        "global dedup key",
+			template:    "service1",
+			expectedKey: "service1",
+		},
+		{
+			name:        "dedup key template",
+			template:    "{{ .Check.ProxyEntityID }}-{{ .Check.Name }}",
+			expectedKey: "router1-check1",
+		},
+		{
+			name:        "invalid template",
+			template:    "{{ .Check.ProxyEntityID ",
+			expectedErr: true,
+		},
+		{
+			name:        "missing field",
+			template:    "{{ .Check.Foo }}",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid dedup key",
+			template:    "{{ .Entity.ID }}/{{ .Check.Name }}",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := types.FixtureEvent("poller1", "check1")
+			event.Check.ProxyEntityID = "router1"
+			event.Check.DedupKey = tc.template
+			event.DedupKey = tc.received
+
+			err := setDedupKey(event)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedKey, event.DedupKey)
+		})
+	}
+}
+
+func TestEventDeduplication(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	// The previous event of the stream was reported by another poller
+	prevEvent := types.FixtureEvent("poller1", "check1")
+	prevEvent.DedupKey = "service1"
+	prevEvent.Check.DedupKey = "service1"
+	prevEvent.Check.Status = 2
+	prevEvent.Check.History = []types.CheckHistory{{Status: 2, Executed: 1}}
+	prevEvent.Check.Occurrences = 1
+
+	mockStore := &mockstore.MockStore{}
+	mockStore.On("GetEnvironment", mock.Anything, "default", "default").Return(types.FixtureEnvironment("default"), nil)
+	mockStore.On("GetEventByEntityCheck", mock.Anything, "service1", "check1").Return(prevEvent, nil)
+	mockStore.On("UpdateEvent", mock.AnythingOfType("*types.Event")).Return(nil)
+	mockStore.On("GetSilencedEntriesBySubscription", mock.Anything).Return([]*types.Silenced{}, nil)
+	mockStore.On("GetSilencedEntriesByCheckName", mock.Anything).Return([]*types.Silenced{}, nil)
+
+	e, err := New(Config{Store: mockStore, Bus: bus})
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("poller2", "check1")
+	event.Check.DedupKey = "service1"
+	event.Check.Status = 2
+	require.NoError(t, e.handleMessage(event))
+
+	mockStore.AssertNotCalled(t, "GetEventByEntityCheck", mock.Anything, "poller2", "check1")
+	assert.Equal(t, "service1", event.StreamKey())
+	assert.Equal(t, 2, len(event.Check.History))
+	assert.Equal(t, int64(2), event.Check.Occurrences)
+}
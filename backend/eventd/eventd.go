@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"sync"
 	"time"
 
@@ -38,6 +39,8 @@ type Eventd struct {
 	mu             *sync.Mutex
 	shutdownChan   chan struct{}
 	wg             *sync.WaitGroup
+
+	retentionInterval time.Duration
 }
 
 // Option is a functional option.
@@ -48,6 +51,10 @@ type Config struct {
 	Store          store.Store
 	Bus            messaging.MessageBus
 	MonitorFactory monitor.Factory
+
+	// RetentionInterval is the interval at which expired events are purged,
+	// DefaultRetentionInterval if zero.
+	RetentionInterval time.Duration
 }
 
 // New creates a new Eventd.
@@ -62,6 +69,11 @@ func New(c Config, opts ...Option) (*Eventd, error) {
 		eventChan:      make(chan interface{}, 100),
 		wg:             &sync.WaitGroup{},
 		mu:             &sync.Mutex{},
+
+		retentionInterval: c.RetentionInterval,
+	}
+	if e.retentionInterval == 0 {
+		e.retentionInterval = DefaultRetentionInterval
 	}
 	for _, o := range opts {
 		if err := o(e); err != nil {
@@ -85,6 +97,8 @@ func (e *Eventd) Start() error {
 		return err
 	}
 	e.startHandlers()
+	e.wg.Add(1)
+	e.startReaper()
 
 	return nil
 }
@@ -148,17 +162,30 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 		return err
 	}
 
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+	// Redact the sensitive fields of the event before it is stored or handled
+	if err := e.redact(ctx, event); err != nil {
+		return err
+	}
+
 	// If the event does not contain a check (rather, it contains metrics)
 	// publish the event without writing to the store
 	if !event.HasCheck() {
 		return e.bus.Publish(messaging.TopicEvent, event)
 	}
 
-	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
-	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+	// Derive the severity of the check result from its status
+	event.Check.SetSeverity()
+
+	// Collapse the events with the same deduplication key
+	if err := setDedupKey(event); err != nil {
+		return err
+	}
 
 	prevEvent, err := e.store.GetEventByEntityCheck(
-		ctx, event.Entity.ID, event.Check.Name,
+		ctx, event.StreamKey(), event.Check.Name,
 	)
 	if err != nil {
 		return err
@@ -200,20 +227,9 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 
 	if event.Check.Ttl > 0 {
 		// Reset the TTL monitor
-		var monitorKey string
-
-		// Typically we want the entity ID to be the thing we monitor, but if
-		// it's a round robin check and there is no proxy entity, then just use
-		// the check name instead.
-		if event.Check.RoundRobin && event.Entity.Class != types.EntityProxyClass {
-			monitorKey = event.Check.Name
-		} else {
-			monitorKey = event.Entity.ID
-		}
-
 		timeout := int64(event.Check.Ttl)
 		supervisor := e.monitorFactory(e)
-		err := supervisor.Monitor(context.TODO(), monitorKey, event, timeout)
+		err := supervisor.Monitor(context.TODO(), ttlMonitorName(event), event, timeout)
 		if err == nil {
 			// HandleUpdate also publishes the event
 			err = e.HandleUpdate(event)
@@ -224,6 +240,35 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 	return e.bus.Publish(messaging.TopicEvent, event)
 }
 
+// redact redacts from the given event the fields configured for its entity
+// and its environment. The environment is read once per event, which the
+// store cache serves from memory.
+func (e *Eventd) redact(ctx context.Context, event *types.Event) error {
+	env, err := e.store.GetEnvironment(ctx, event.Entity.Organization, event.Entity.Environment)
+	if err != nil {
+		return err
+	}
+
+	var fields []string
+	if env != nil {
+		fields = env.Redact
+	}
+	return event.Redact(fields)
+}
+
+// ttlMonitorName returns the name of the monitor of the TTL of the check of
+// the given event. Each pair of event stream and check is monitored, within
+// its organization and environment, apart from the keepalives of the entity.
+// Round robin checks without proxy entity are monitored regardless of the
+// agent which executed them.
+func ttlMonitorName(event *types.Event) string {
+	entity := event.StreamKey()
+	if event.Check.RoundRobin && event.Entity.Class != types.EntityProxyClass {
+		entity = ""
+	}
+	return path.Join("check", event.Entity.Organization, event.Entity.Environment, event.Check.Name, entity)
+}
+
 func updateOccurrences(event *types.Event) {
 	if !event.HasCheck() {
 		return
@@ -282,7 +327,7 @@ func (e *Eventd) createFailedCheckEvent(ctx context.Context, event *types.Event)
 	}
 
 	lastCheckResult, err := e.store.GetEventByEntityCheck(
-		ctx, event.Entity.ID, event.Check.Name,
+		ctx, event.StreamKey(), event.Check.Name,
 	)
 	if err != nil {
 		return nil, err
@@ -292,6 +337,7 @@ func (e *Eventd) createFailedCheckEvent(ctx context.Context, event *types.Event)
 
 	lastCheckResult.Check.Output = output
 	lastCheckResult.Check.Status = 1
+	lastCheckResult.Check.SetSeverity()
 	lastCheckResult.Timestamp = time.Now().Unix()
 
 	return lastCheckResult, nil
@@ -40,6 +40,8 @@ func TestEventHandling(t *testing.T) {
 
 	event := types.FixtureEvent("entity", "check")
 
+	mockStore.On("GetEnvironment", mock.Anything, "default", "default").Return(types.FixtureEnvironment("default"), nil)
+
 	var nilEvent *types.Event
 	// no previous event.
 	mockStore.On(
@@ -106,6 +108,8 @@ func TestEventMonitor(t *testing.T) {
 	event := types.FixtureEvent("entity", "check")
 	event.Check.Ttl = 90
 
+	mockStore.On("GetEnvironment", mock.Anything, "default", "default").Return(types.FixtureEnvironment("default"), nil)
+
 	var nilEvent *types.Event
 	// no previous event.
 	mockStore.On(
@@ -135,6 +139,64 @@ func TestEventMonitor(t *testing.T) {
 	assert.Equal(t, types.EventPassingState, event.Check.State)
 }
 
+func TestEventRedaction(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	env := types.FixtureEnvironment("default")
+	env.Redact = []string{"token"}
+
+	mockStore := &mockstore.MockStore{}
+	mockStore.On("GetEnvironment", mock.Anything, "default", "default").Return(env, nil)
+	mockStore.On("GetEventByEntityCheck", mock.Anything, "entity", "check").Return((*types.Event)(nil), nil)
+	mockStore.On("UpdateEvent", mock.AnythingOfType("*types.Event")).Return(nil)
+	mockStore.On("GetSilencedEntriesBySubscription", mock.Anything).Return([]*types.Silenced{}, nil)
+	mockStore.On("GetSilencedEntriesByCheckName", mock.Anything).Return([]*types.Silenced{}, nil)
+
+	e, err := New(Config{Store: mockStore, Bus: bus})
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("entity", "check")
+	event.Check.Command = "check-api --token abc --password s3cr3t"
+	require.NoError(t, e.handleMessage(event))
+
+	mockStore.AssertCalled(t, "UpdateEvent", mock.MatchedBy(func(event *types.Event) bool {
+		return event.Check.Command == "check-api --token REDACTED --password REDACTED"
+	}))
+}
+
+func TestEventSeverity(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	mockStore := &mockstore.MockStore{}
+	mockStore.On("GetEnvironment", mock.Anything, "default", "default").Return(types.FixtureEnvironment("default"), nil)
+	mockStore.On("GetEventByEntityCheck", mock.Anything, "entity", "check").Return((*types.Event)(nil), nil)
+	mockStore.On("UpdateEvent", mock.AnythingOfType("*types.Event")).Return(nil)
+	mockStore.On("GetSilencedEntriesBySubscription", mock.Anything).Return([]*types.Silenced{}, nil)
+	mockStore.On("GetSilencedEntriesByCheckName", mock.Anything).Return([]*types.Silenced{}, nil)
+
+	e, err := New(Config{Store: mockStore, Bus: bus})
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("entity", "check")
+	event.Check.Status = 2
+	require.NoError(t, e.handleMessage(event))
+	assert.Equal(t, types.SeverityCritical, event.Check.Severity)
+
+	event = types.FixtureEvent("entity", "check")
+	event.Check.Status = 4
+	event.Check.Severities = map[string]string{"4": "disaster"}
+	require.NoError(t, e.handleMessage(event))
+	assert.Equal(t, "disaster", event.Check.Severity)
+}
+
 func TestCheckOccurrences(t *testing.T) {
 	testCases := []struct {
 		name                         string
@@ -212,3 +274,20 @@ func TestCheckOccurrences(t *testing.T) {
 		})
 	}
 }
+
+func TestTTLMonitorName(t *testing.T) {
+	event := types.FixtureEvent("entity1", "check1")
+	assert.Equal(t, "check/default/default/check1/entity1", ttlMonitorName(event))
+
+	// Monitors of the checks of an entity are distinct
+	other := types.FixtureEvent("entity1", "check2")
+	assert.NotEqual(t, ttlMonitorName(event), ttlMonitorName(other))
+
+	// Round robin checks are monitored regardless of the agent
+	event.Check.RoundRobin = true
+	assert.Equal(t, "check/default/default/check1", ttlMonitorName(event))
+
+	// Unless they have a proxy entity
+	event.Entity.Class = types.EntityProxyClass
+	assert.Equal(t, "check/default/default/check1/entity1", ttlMonitorName(event))
+}
@@ -0,0 +1,99 @@
+package eventd
+
+import (
+	"context"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultRetentionInterval is the interval at which eventd purges the resolved
+// events which are older than the event retention of their environment.
+const DefaultRetentionInterval = time.Hour
+
+// startReaper periodically purges expired events until eventd is stopped.
+func (e *Eventd) startReaper() {
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.retentionInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-e.shutdownChan:
+				return
+			case <-ticker.C:
+				if err := e.purgeExpiredEvents(context.Background(), time.Now()); err != nil {
+					logger.WithError(err).Error("error purging expired events")
+				}
+			}
+		}
+	}()
+}
+
+// purgeExpiredEvents deletes the resolved events whose last update predates
+// the event retention of their environment, relative to now. Environments
+// without event retention are skipped.
+func (e *Eventd) purgeExpiredEvents(ctx context.Context, now time.Time) error {
+	orgs, err := e.store.GetOrganizations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, org := range orgs {
+		envs, err := e.store.GetEnvironments(ctx, org.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, env := range envs {
+			if env.EventRetention == 0 {
+				continue
+			}
+
+			fields := logrus.Fields{
+				"organization": env.Organization,
+				"environment":  env.Name,
+			}
+			envCtx := context.WithValue(ctx, types.OrganizationKey, env.Organization)
+			envCtx = context.WithValue(envCtx, types.EnvironmentKey, env.Name)
+			expiration := now.Add(-time.Duration(env.EventRetention) * 24 * time.Hour).Unix()
+
+			purged, err := e.purgeEnvironmentEvents(envCtx, expiration)
+			if err != nil {
+				logger.WithFields(fields).WithError(err).Error("error purging expired events")
+			}
+			if purged > 0 {
+				fields["purged"] = purged
+				logger.WithFields(fields).Info("purged expired events")
+			}
+		}
+	}
+
+	return nil
+}
+
+// purgeEnvironmentEvents deletes the resolved events of the environment stored
+// in ctx whose last update predates the given expiration, in unix timestamp
+// format, and returns how many were deleted.
+func (e *Eventd) purgeEnvironmentEvents(ctx context.Context, expiration int64) (int, error) {
+	events, err := e.store.GetEvents(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, event := range events {
+		if !event.HasCheck() || event.Check.Status != 0 || event.Timestamp >= expiration {
+			continue
+		}
+		if err := e.store.DeleteEventByEntityCheck(ctx, event.StreamKey(), event.Check.Name); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
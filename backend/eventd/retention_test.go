@@ -0,0 +1,59 @@
+package eventd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeExpiredEvents(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-49 * time.Hour).Unix()
+
+	resolved := types.FixtureEvent("entity1", "check1")
+	resolved.Timestamp = expired
+	failing := types.FixtureEvent("entity1", "check2")
+	failing.Timestamp = expired
+	failing.Check.Status = 2
+	recent := types.FixtureEvent("entity1", "check3")
+	recent.Timestamp = now.Unix()
+	keepalive := types.FixtureEvent("entity2", "keepalive")
+	keepalive.Timestamp = expired
+
+	retained := types.FixtureEnvironment("default")
+	retained.EventRetention = 2
+	unlimited := types.FixtureEnvironment("dev")
+
+	store := &mockstore.MockStore{}
+	store.On("GetOrganizations", mock.Anything).Return([]*types.Organization{types.FixtureOrganization("default")}, nil)
+	store.On("GetEnvironments", mock.Anything, "default").Return([]*types.Environment{retained, unlimited}, nil)
+	store.On("GetEvents", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(types.EnvironmentKey) == "default"
+	})).Return([]*types.Event{resolved, failing, recent, keepalive}, nil)
+	store.On("DeleteEventByEntityCheck", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	e, err := New(Config{Store: store})
+	require.NoError(t, err)
+	require.NoError(t, e.purgeExpiredEvents(context.Background(), now))
+
+	store.AssertNumberOfCalls(t, "GetEvents", 1)
+	store.AssertNumberOfCalls(t, "DeleteEventByEntityCheck", 2)
+	store.AssertCalled(t, "DeleteEventByEntityCheck", mock.Anything, "entity1", "check1")
+	store.AssertCalled(t, "DeleteEventByEntityCheck", mock.Anything, "entity2", "keepalive")
+}
+
+func TestPurgeExpiredEventsStoreErr(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("GetOrganizations", mock.Anything).Return([]*types.Organization(nil), errors.New("error"))
+
+	e, err := New(Config{Store: store})
+	require.NoError(t, err)
+	assert.Error(t, e.purgeExpiredEvents(context.Background(), time.Now()))
+}
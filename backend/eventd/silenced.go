@@ -2,9 +2,9 @@ package eventd
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 	stringsutil "github.com/sensu/sensu-go/util/strings"
@@ -45,6 +45,14 @@ func getSilenced(ctx context.Context, event *types.Event, s store.Store) error {
 		entries = append(entries, results...)
 	}
 
+	// Retrieve silenced entries for all subscriptions, including those only
+	// selecting events by their labels
+	results, err = s.GetSilencedEntriesBySubscription(ctx, "*")
+	if err != nil {
+		return err
+	}
+	entries = append(entries, results...)
+
 	// Retrieve silenced entries using the check name
 	results, err = s.GetSilencedEntriesByCheckName(ctx, event.Check.Name)
 	if err != nil {
@@ -71,52 +79,77 @@ func silencedBy(event *types.Event, silencedEntries []*types.Silenced) []string
 
 	// Loop through every silenced entries in order to determine if it applies to
 	// the given event
+	now := time.Now().Unix()
+	var labels map[string]string
 	for _, entry := range silencedEntries {
-
-		// Is this event silenced for all subscriptions? (e.g. *:check_cpu)
-		if entry.ID == fmt.Sprintf("*:%s", event.Check.Name) && entry.StartSilence(time.Now().Unix()) {
-			silencedBy = addToSilencedBy(entry.ID, silencedBy)
+		// Entries scheduled ahead of time do not apply until they begin
+		if !entry.StartSilence(now) {
 			continue
 		}
 
-		// Is this event silenced by the entity subscription? (e.g. entity:id:*)
-		if entry.ID == fmt.Sprintf("%s:*", types.GetEntitySubscription(event.Entity.ID)) && entry.StartSilence(time.Now().Unix()) {
-			silencedBy = addToSilencedBy(entry.ID, silencedBy)
+		// Is this event silenced for all checks or for this particular check?
+		// (e.g. *:check_cpu)
+		if entry.Check != "" && entry.Check != "*" && entry.Check != event.Check.Name {
 			continue
 		}
 
-		// Is this event silenced for this particular entity? (e.g.
-		// entity:id:check_cpu)
-		if entry.ID == fmt.Sprintf("%s:%s", types.GetEntitySubscription(event.Entity.ID), event.Check.Name) && entry.StartSilence(time.Now().Unix()) {
-			silencedBy = addToSilencedBy(entry.ID, silencedBy)
+		if !silencesSubscription(event, entry.Subscription) {
 			continue
 		}
 
-		for _, subscription := range event.Check.Subscriptions {
-			// Make sure the entity is subscribed to this specific subscription
-			if !stringsutil.InArray(subscription, event.Entity.Subscriptions) {
-				continue
+		// Does this event have the labels selected by the entry? (e.g.
+		// team=payments)
+		if entry.LabelSelector != "" {
+			if labels == nil {
+				labels = eventLabels(event)
 			}
-
-			// Is this event silenced by one of the check subscription? (e.g.
-			// load-balancer:*)
-			if entry.ID == fmt.Sprintf("%s:*", subscription) && entry.StartSilence(time.Now().Unix()) {
-				silencedBy = addToSilencedBy(entry.ID, silencedBy)
-				continue
-			}
-
-			// Is this event silenced by one of the check subscription for this
-			// particular check? (e.g. load-balancer:check_cpu)
-			if entry.ID == fmt.Sprintf("%s:%s", subscription, event.Check.Name) && entry.StartSilence(time.Now().Unix()) {
-				silencedBy = addToSilencedBy(entry.ID, silencedBy)
+			sel, err := selector.ParseLabelSelector(entry.LabelSelector)
+			if err != nil || !sel.Matches(labels) {
 				continue
 			}
 		}
+
+		silencedBy = addToSilencedBy(entry.ID, silencedBy)
 	}
 
 	return silencedBy
 }
 
+// silencesSubscription determines whether a silenced entry for the given
+// subscription applies to the event
+func silencesSubscription(event *types.Event, subscription string) bool {
+	// Is this event silenced for all subscriptions? (e.g. *:check_cpu)
+	if subscription == "" || subscription == "*" {
+		return true
+	}
+
+	// Is this event silenced by the entity subscription? (e.g. entity:id:*)
+	if subscription == types.GetEntitySubscription(event.Entity.ID) {
+		return true
+	}
+
+	// Is this event silenced by one of the check subscriptions? (e.g.
+	// load-balancer:*) Make sure the entity is subscribed to this specific
+	// subscription
+	return stringsutil.InArray(subscription, event.Check.Subscriptions) &&
+		stringsutil.InArray(subscription, event.Entity.Subscriptions)
+}
+
+// eventLabels returns the labels of the entity of the event, along with the
+// labels of its check, which take precedence.
+func eventLabels(event *types.Event) map[string]string {
+	labels := map[string]string{}
+	if event.Entity != nil {
+		for key, value := range selector.LabelSet(event.Entity) {
+			labels[key] = value
+		}
+	}
+	for key, value := range selector.LabelSet(event.Check) {
+		labels[key] = value
+	}
+	return labels
+}
+
 func handleExpireOnResolveEntries(ctx context.Context, event *types.Event, store store.Store) error {
 	if !event.HasCheck() || !event.IsResolution() {
 		return nil
@@ -130,6 +163,12 @@ func handleExpireOnResolveEntries(ctx context.Context, event *types.Event, store
 			return err
 		}
 
+		// The entry may have expired or been deleted since the event was
+		// silenced, in which case it no longer silences the event
+		if silencedEntry == nil {
+			continue
+		}
+
 		if silencedEntry.ExpireOnResolve {
 			err := store.DeleteSilencedEntryByID(ctx, silencedID)
 			if err != nil {
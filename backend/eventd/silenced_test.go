@@ -3,11 +3,13 @@ package eventd
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetSilenced(t *testing.T) {
@@ -168,6 +170,42 @@ func TestSilencedBy(t *testing.T) {
 			},
 			expectedEntries: []string{"linux:check_cpu"},
 		},
+		{
+			name:  "not silenced by entries scheduled ahead",
+			event: types.FixtureEvent("foo", "check_cpu"),
+			entries: []*types.Silenced{
+				scheduledSilenced("entity:foo:*", time.Now().Add(time.Hour)),
+				scheduledSilenced("*:check_cpu", time.Now().Add(-time.Hour)),
+			},
+			expectedEntries: []string{"*:check_cpu"},
+		},
+		{
+			name:  "silenced by labels",
+			event: labeledEvent(`{"team":"payments"}`, `{"severity":"low"}`),
+			entries: []*types.Silenced{
+				labelSilenced("", "", "team=payments"),
+				labelSilenced("", "", "team=payments,severity in (low,medium)"),
+				labelSilenced("", "check_cpu", "team=payments"),
+				labelSilenced("linux", "", "team"),
+			},
+			expectedEntries: []string{
+				"*:*:team=payments",
+				"*:*:team=payments,severity in (low,medium)",
+				"*:check_cpu:team=payments",
+				"linux:*:team",
+			},
+		},
+		{
+			name:  "not silenced by labels",
+			event: labeledEvent(`{"team":"payments"}`, `{"team":"checkout"}`),
+			entries: []*types.Silenced{
+				labelSilenced("", "", "team=payments"),
+				labelSilenced("", "check_mem", "team=checkout"),
+				labelSilenced("windows", "", "team=checkout"),
+				labelSilenced("", "", "team in (payments"),
+			},
+			expectedEntries: []string{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -252,3 +290,44 @@ func TestHandleExpireOnResolveEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleExpireOnResolveDeletedEntries(t *testing.T) {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.History = []types.CheckHistory{{Status: 1}}
+	event.Check.Status = 0
+	event.Check.Silenced = []string{"sub1:check1", "sub2:check1"}
+
+	mockStore := &mockstore.MockStore{}
+	mockStore.On("GetSilencedEntryByID", mock.Anything, "sub1:check1").Return((*types.Silenced)(nil), nil)
+	mockStore.On("GetSilencedEntryByID", mock.Anything, "sub2:check1").Return(types.FixtureSilenced("sub2:check1"), nil)
+
+	require.NoError(t, handleExpireOnResolveEntries(ctx, event, mockStore))
+	assert.Equal(t, []string{"sub2:check1"}, event.Check.Silenced)
+	mockStore.AssertNotCalled(t, "DeleteSilencedEntryByID", mock.Anything, mock.Anything)
+}
+
+func scheduledSilenced(id string, begin time.Time) *types.Silenced {
+	silenced := types.FixtureSilenced(id)
+	silenced.Begin = begin.Unix()
+	return silenced
+}
+
+func labelSilenced(subscription, check, labelSelector string) *types.Silenced {
+	id, _ := types.SilencedIDWithSelector(subscription, check, labelSelector)
+	return &types.Silenced{
+		ID:            id,
+		Subscription:  subscription,
+		Check:         check,
+		LabelSelector: labelSelector,
+	}
+}
+
+func labeledEvent(entityLabels, checkLabels string) *types.Event {
+	event := types.FixtureEvent("foo", "check_cpu")
+	event.Entity.ExtendedAttributes = []byte(entityLabels)
+	event.Check.ExtendedAttributes = []byte(checkLabels)
+	return event
+}
@@ -3,6 +3,7 @@ package keepalived
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
@@ -17,10 +18,17 @@ type Deregisterer interface {
 	Deregister(e *types.Entity) error
 }
 
+// DeregistrationStore specifies the storage requirements of the
+// Deregistration.
+type DeregistrationStore interface {
+	store.EntityStore
+	store.EventStore
+}
+
 // Deregistration is an adapter for deregistering an entity from the store and
 // publishing a deregistration event to WizardBus.
 type Deregistration struct {
-	Store      store.Store
+	Store      DeregistrationStore
 	MessageBus messaging.MessageBus
 }
 
@@ -51,6 +59,7 @@ func (adapterPtr *Deregistration) Deregister(entity *types.Entity) error {
 
 		event.Check.Output = "Resolving due to entity deregistering"
 		event.Check.Status = 0
+		event.Check.SetSeverity()
 		event.Check.History = []types.CheckHistory{}
 
 		if err := adapterPtr.MessageBus.Publish(messaging.TopicEvent, event); err != nil {
@@ -59,25 +68,32 @@ func (adapterPtr *Deregistration) Deregister(entity *types.Entity) error {
 	}
 
 	if entity.Deregistration.Handler != "" {
-		deregistrationCheck := &types.Check{
-			Name:          "deregistration",
-			Interval:      entity.KeepaliveTimeout,
-			Subscriptions: []string{""},
-			Command:       "",
-			Handlers:      []string{entity.Deregistration.Handler},
-			Environment:   entity.Environment,
-			Organization:  entity.Organization,
-			Status:        1,
-		}
-
-		deregistrationEvent := &types.Event{
-			Entity: entity,
-			Check:  deregistrationCheck,
-		}
-
+		deregistrationEvent := NewDeregistrationEvent(entity)
 		return adapterPtr.MessageBus.Publish(messaging.TopicEvent, deregistrationEvent)
 	}
 
 	logger.WithField("entity", entity.GetID()).Info("entity deregistered")
 	return nil
 }
+
+// NewDeregistrationEvent returns the event announcing the deregistration of
+// the given entity to its deregistration handler.
+func NewDeregistrationEvent(entity *types.Entity) *types.Event {
+	deregistrationCheck := &types.Check{
+		Name:          DeregistrationCheckName,
+		Interval:      entity.KeepaliveTimeout,
+		Subscriptions: []string{""},
+		Command:       "",
+		Handlers:      []string{entity.Deregistration.Handler},
+		Environment:   entity.Environment,
+		Organization:  entity.Organization,
+		Status:        1,
+		Severity:      types.SeverityWarning,
+	}
+
+	return &types.Event{
+		Timestamp: time.Now().Unix(),
+		Entity:    entity,
+		Check:     deregistrationCheck,
+	}
+}
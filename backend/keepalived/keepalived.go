@@ -9,6 +9,7 @@ import (
 	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -28,6 +29,10 @@ const (
 	// a keepalive timeout occurs.
 	KeepaliveHandlerName = "keepalive"
 
+	// DeregistrationCheckName is the name of the check that is created when an
+	// entity is deregistered.
+	DeregistrationCheckName = "deregistration"
+
 	// RegistrationCheckName is the name of the check that is created when an
 	// entity sends a keepalive and the entity does not yet exist in the store.
 	RegistrationCheckName = "registration"
@@ -213,11 +218,18 @@ func (k *Keepalived) processKeepalives() {
 			continue
 		}
 
+		// The keepalives of proxy entities are not monitored, since they are
+		// not expected to be sent again
+		if !entity.HasKeepalives() {
+			logger.WithField("entity", entity.ID).Debug("ignoring keepalive of proxy entity")
+			continue
+		}
+
 		if err := k.handleEntityRegistration(entity); err != nil {
 			logger.WithError(err).Error("error handling entity registration")
 		}
 
-		timeout := int64(entity.KeepaliveTimeout)
+		timeout := warningTimeout(entity)
 		supervisor := k.monitorFactory(k)
 		if err := supervisor.Monitor(context.TODO(), entity.ID, event, timeout); err != nil {
 			logger.WithError(err).Error("error monitoring entity")
@@ -253,11 +265,29 @@ func (k *Keepalived) handleEntityRegistration(entity *types.Entity) error {
 	return err
 }
 
+// warningTimeout returns the number of seconds without keepalive after which
+// a warning keepalive event is created for the entity.
+func warningTimeout(entity *types.Entity) int64 {
+	if entity.KeepaliveWarningTimeout > 0 {
+		return int64(entity.KeepaliveWarningTimeout)
+	}
+	return int64(entity.KeepaliveTimeout)
+}
+
+// keepaliveHandlers returns the handlers of the keepalive events of the
+// entity.
+func keepaliveHandlers(entity *types.Entity) []string {
+	if len(entity.KeepaliveHandlers) > 0 {
+		return entity.KeepaliveHandlers
+	}
+	return []string{KeepaliveHandlerName}
+}
+
 func createKeepaliveEvent(entity *types.Entity) *types.Event {
 	keepaliveCheck := &types.Check{
 		Name:         KeepaliveCheckName,
 		Interval:     entity.KeepaliveTimeout,
-		Handlers:     []string{KeepaliveHandlerName},
+		Handlers:     keepaliveHandlers(entity),
 		Environment:  entity.Environment,
 		Organization: entity.Organization,
 		Status:       1,
@@ -318,13 +348,35 @@ func (k *Keepalived) handleUpdate(e *types.Event) error {
 }
 
 // HandleFailure checks if the entity should be deregistered, and emits a
-// keepalive event if the entity is still valid.
+// keepalive event if the entity is still valid. The keepalive event is a
+// warning until the keepalive critical timeout of the entity, if any, has
+// elapsed since it was last seen, in which case it is critical.
 func (k *Keepalived) HandleFailure(e *types.Event) error {
 	// Note, we don't want to use the e parameter here as we're
 	// constructing a new event instead.
 	entity := e.Entity
 	ctx := types.SetContextFromResource(context.Background(), entity)
 
+	// The monitored event can predate the last keepalive of the entity, so
+	// rely on the stored entity to know when it was last seen.
+	storedEntity, err := k.store.GetEntityByID(ctx, entity.ID)
+	if err != nil {
+		return err
+	}
+	if storedEntity == nil {
+		// the entity was deregistered or deleted in the meantime.
+		logger.WithField("entity", entity.GetID()).Debug("keepalive timed out for a deleted entity")
+		return nil
+	}
+	entity = storedEntity
+
+	// the entity became a proxy entity in the meantime, its keepalives are not
+	// monitored anymore.
+	if !entity.HasKeepalives() {
+		logger.WithField("entity", entity.GetID()).Debug("keepalive timed out for a proxy entity")
+		return k.store.DeleteFailingKeepalive(ctx, entity)
+	}
+
 	deregisterer := &Deregistration{
 		Store:      k.store,
 		MessageBus: k.bus,
@@ -334,14 +386,40 @@ func (k *Keepalived) HandleFailure(e *types.Event) error {
 		return deregisterer.Deregister(entity)
 	}
 
+	now := time.Now().Unix()
+	elapsed := warningTimeout(entity)
+	if entity.LastSeen > 0 {
+		elapsed = now - entity.LastSeen
+	}
+	critical := int64(entity.KeepaliveCriticalTimeout)
+
 	// this is a real keepalive event, emit it.
 	event := createKeepaliveEvent(entity)
 	event.Check.Status = 1
+	if critical > 0 && elapsed >= critical {
+		event.Check.Status = 2
+	}
+	event.Check.History[0].Status = event.Check.Status
 	if err := k.bus.Publish(messaging.TopicEventRaw, event); err != nil {
 		return err
 	}
 
-	logger.WithField("entity", entity.GetID()).Info("keepalive timed out, creating keepalive event for entity")
-	timeout := time.Now().Unix() + int64(entity.KeepaliveTimeout)
+	logger.WithFields(logrus.Fields{
+		"entity": entity.GetID(),
+		"status": event.Check.Status,
+	}).Info("keepalive timed out, creating keepalive event for entity")
+
+	// Monitor the entity until its keepalive critical timeout, to escalate the
+	// warning keepalive event.
+	if event.Check.Status == 1 && critical > 0 {
+		remaining := critical - elapsed
+		supervisor := k.monitorFactory(k)
+		if err := supervisor.Monitor(context.TODO(), entity.ID, event, remaining); err != nil {
+			return err
+		}
+		return k.store.UpdateFailingKeepalive(ctx, entity, now+remaining)
+	}
+
+	timeout := now + warningTimeout(entity)
 	return k.store.UpdateFailingKeepalive(ctx, entity, timeout)
 }
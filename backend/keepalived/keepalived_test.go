@@ -3,6 +3,7 @@ package keepalived
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/monitor"
@@ -44,6 +45,17 @@ func fakeFactory(monitor.Handler) monitor.Supervisor {
 // type assertion
 var _ monitor.Supervisor = fakeMonitorSupervisor{}
 
+// recordingMonitorSupervisor records the ttls of the monitors it is asked to
+// start.
+type recordingMonitorSupervisor struct {
+	ttls *[]int64
+}
+
+func (r recordingMonitorSupervisor) Monitor(_ context.Context, _ string, _ *types.Event, ttl int64) error {
+	*r.ttls = append(*r.ttls, ttl)
+	return nil
+}
+
 func newKeepalivedTest(t *testing.T) *keepalivedTest {
 	store := &mockstore.MockStore{}
 	deregisterer := &mockDeregisterer{}
@@ -164,6 +176,7 @@ func TestEventProcessing(t *testing.T) {
 	event := types.FixtureEvent("entity", "keepalive")
 	event.Check.Status = 1
 
+	test.Store.On("GetEntityByID", mock.Anything, "entity").Return(event.Entity, nil)
 	test.Store.On("UpdateEntity", mock.Anything, event.Entity).Return(nil)
 	test.Store.On("DeleteFailingKeepalive", mock.Anything, event.Entity).Return(nil)
 
@@ -171,6 +184,18 @@ func TestEventProcessing(t *testing.T) {
 	assert.NoError(t, test.Keepalived.Stop())
 }
 
+func TestEventProcessingProxyEntity(t *testing.T) {
+	test := newKeepalivedTest(t)
+	test.Store.On("GetFailingKeepalives", mock.Anything).Return([]*types.KeepaliveRecord{}, nil)
+	require.NoError(t, test.Keepalived.Start())
+	event := types.FixtureEvent("entity", "keepalive")
+	event.Entity.Class = types.EntityProxyClass
+
+	test.Keepalived.keepaliveChan <- event
+	assert.NoError(t, test.Keepalived.Stop())
+	test.Store.AssertNotCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+}
+
 type testSubscriber struct {
 	ch chan interface{}
 }
@@ -194,8 +219,8 @@ func TestProcessRegistration(t *testing.T) {
 	}{
 		{
 			name:        "Registered Entity Without Agent Class",
-			entity:      newEntityWithClass("router"),
-			storeEntity: newEntityWithClass("router"),
+			entity:      newEntityWithClass(types.EntityProxyClass),
+			storeEntity: newEntityWithClass(types.EntityProxyClass),
 			expectedLen: 0,
 		},
 		{
@@ -252,3 +277,151 @@ func TestCreateKeepaliveEvent(t *testing.T) {
 	assert.Equal(t, uint32(1), keepaliveEvent.Check.History[0].Status)
 	assert.NotEqual(t, int64(0), keepaliveEvent.Check.History[0].Executed)
 }
+
+func TestCreateKeepaliveEventHandlers(t *testing.T) {
+	entity := types.FixtureEntity("entity1")
+	entity.KeepaliveHandlers = []string{"ops", "slack"}
+	keepaliveEvent := createKeepaliveEvent(entity)
+	assert.Equal(t, []string{"ops", "slack"}, keepaliveEvent.Check.Handlers)
+}
+
+func TestHandleFailure(t *testing.T) {
+	now := time.Now().Unix()
+
+	tt := []struct {
+		name           string
+		lastSeen       int64
+		warning        uint32
+		critical       uint32
+		expectedStatus uint32
+		expectedTTLs   []int64
+	}{
+		{
+			name:           "Warning Without Critical Timeout",
+			lastSeen:       now - 120,
+			expectedStatus: 1,
+		},
+		{
+			name:           "Warning Before Critical Timeout",
+			lastSeen:       now - 120,
+			critical:       300,
+			expectedStatus: 1,
+			expectedTTLs:   []int64{180},
+		},
+		{
+			name:           "Custom Warning Timeout",
+			lastSeen:       now - 60,
+			warning:        60,
+			critical:       300,
+			expectedStatus: 1,
+			expectedTTLs:   []int64{240},
+		},
+		{
+			name:           "Critical Timeout Elapsed",
+			lastSeen:       now - 300,
+			critical:       300,
+			expectedStatus: 2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			messageBus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+				RingGetter: &mockring.Getter{},
+			})
+			require.NoError(t, err)
+			require.NoError(t, messageBus.Start())
+			defer func() { assert.NoError(t, messageBus.Stop()) }()
+
+			tsub := testSubscriber{
+				ch: make(chan interface{}, 1),
+			}
+			subscription, err := messageBus.Subscribe(messaging.TopicEventRaw, "testSubscriber", tsub)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, subscription.Cancel()) }()
+
+			var ttls []int64
+			factory := func(monitor.Handler) monitor.Supervisor {
+				return recordingMonitorSupervisor{ttls: &ttls}
+			}
+
+			entity := types.FixtureEntity("entity1")
+			entity.LastSeen = tc.lastSeen
+			entity.KeepaliveWarningTimeout = tc.warning
+			entity.KeepaliveCriticalTimeout = tc.critical
+
+			store := &mockstore.MockStore{}
+			store.On("GetEntityByID", mock.Anything, "entity1").Return(entity, nil)
+			store.On("UpdateFailingKeepalive", mock.Anything, entity, mock.AnythingOfType("int64")).Return(nil)
+
+			keepalived, err := New(Config{Store: store, Bus: messageBus, MonitorFactory: factory})
+			require.NoError(t, err)
+
+			// The monitored event carries an outdated entity
+			monitored := types.FixtureEvent("entity1", "keepalive")
+			require.NoError(t, keepalived.HandleFailure(monitored))
+
+			msg := <-tsub.ch
+			event, ok := msg.(*types.Event)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedStatus, event.Check.Status)
+			assert.Equal(t, tc.expectedTTLs, ttls)
+		})
+	}
+}
+
+func TestHandleFailureDeletedEntity(t *testing.T) {
+	messageBus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, messageBus.Start())
+	defer func() { assert.NoError(t, messageBus.Stop()) }()
+
+	tsub := testSubscriber{
+		ch: make(chan interface{}, 1),
+	}
+	subscription, err := messageBus.Subscribe(messaging.TopicEventRaw, "testSubscriber", tsub)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, subscription.Cancel()) }()
+
+	store := &mockstore.MockStore{}
+	store.On("GetEntityByID", mock.Anything, "entity1").Return((*types.Entity)(nil), nil)
+
+	keepalived, err := New(Config{Store: store, Bus: messageBus, MonitorFactory: fakeFactory})
+	require.NoError(t, err)
+
+	require.NoError(t, keepalived.HandleFailure(types.FixtureEvent("entity1", "keepalive")))
+	assert.Equal(t, 0, len(tsub.ch))
+	store.AssertNotCalled(t, "UpdateFailingKeepalive", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleFailureProxyEntity(t *testing.T) {
+	messageBus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, messageBus.Start())
+	defer func() { assert.NoError(t, messageBus.Stop()) }()
+
+	tsub := testSubscriber{
+		ch: make(chan interface{}, 1),
+	}
+	subscription, err := messageBus.Subscribe(messaging.TopicEventRaw, "testSubscriber", tsub)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, subscription.Cancel()) }()
+
+	entity := types.FixtureEntity("entity1")
+	entity.Class = types.EntityProxyClass
+	store := &mockstore.MockStore{}
+	store.On("GetEntityByID", mock.Anything, "entity1").Return(entity, nil)
+	store.On("DeleteFailingKeepalive", mock.Anything, entity).Return(nil)
+
+	keepalived, err := New(Config{Store: store, Bus: messageBus, MonitorFactory: fakeFactory})
+	require.NoError(t, err)
+
+	require.NoError(t, keepalived.HandleFailure(types.FixtureEvent("entity1", "keepalive")))
+	assert.Equal(t, 0, len(tsub.ch))
+	store.AssertCalled(t, "DeleteFailingKeepalive", mock.Anything, entity)
+	store.AssertNotCalled(t, "UpdateFailingKeepalive", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -20,6 +20,10 @@ const (
 	// from agents, subscribe to this.
 	TopicEventRaw = "sensu:event-raw"
 
+	// TopicHandlerResult is the topic for the results of the execution of
+	// handlers by the pipeline.
+	TopicHandlerResult = "sensu:handler-result"
+
 	// TopicSubscriptions is the topic prefix for each subscription
 	TopicSubscriptions = "sensu:check"
 )
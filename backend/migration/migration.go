@@ -1,12 +1,15 @@
 package migration
 
-import "github.com/sirupsen/logrus"
+import (
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sirupsen/logrus"
+)
 
 var logger = logrus.WithFields(logrus.Fields{
 	"component": "migration",
 })
 
 // Run lauches the migration process
-func Run(storeURL string) {
-	environments(storeURL)
+func Run(client *clientv3.Client) {
+	environments(client)
 }
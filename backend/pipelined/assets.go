@@ -0,0 +1,77 @@
+package pipelined
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/types"
+)
+
+// AssetCacheGCInterval is the interval at which pipelined evicts runtime
+// assets from the asset cache, if the cache is limited.
+const AssetCacheGCInterval = time.Hour
+
+// installRuntimeAssets retrieves the given runtime assets of the resource from
+// the store, and installs those relevant to the entity of the given event in
+// the asset cache directory.
+func (p *Pipelined) installRuntimeAssets(resource types.MultitenantResource, names []string, event *types.Event) (*assetmanager.RuntimeAssetSet, error) {
+	ctx := types.SetContextFromResource(context.Background(), resource)
+	assets := make([]types.Asset, 0, len(names))
+	for _, name := range names {
+		asset, err := p.store.GetAssetByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if asset == nil {
+			return nil, fmt.Errorf("asset %q does not exist", name)
+		}
+		assets = append(assets, *asset)
+	}
+
+	manager := assetmanager.New(p.assetCacheDir, event.Entity)
+	manager.SetMirror(p.assetMirror)
+	set := manager.RegisterSet(assets)
+	if err := set.InstallAll(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// collectAssetGarbage periodically evicts runtime assets from the asset cache,
+// according to its limits, until pipelined is stopped.
+func (p *Pipelined) collectAssetGarbage() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(AssetCacheGCInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := assetmanager.CollectGarbage(p.assetCacheDir, p.assetCacheMaxSize, p.assetCacheMaxAge); err != nil {
+			logger.WithError(err).Error("error evicting runtime assets from the cache")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-p.stopping:
+			return
+		}
+	}
+}
+
+// commandEnv returns the environment of mutator and handler commands: the
+// environment of the backend, with the paths of the given runtime assets if
+// any, overridden by the given environment variables and secrets.
+func commandEnv(assets *assetmanager.RuntimeAssetSet, vars []string, secrets []string) []string {
+	env := make([]string, 0, len(vars)+len(secrets))
+	env = append(append(env, vars...), secrets...)
+	if assets != nil {
+		return append(assets.Env(), env...)
+	}
+	if len(env) == 0 {
+		return nil
+	}
+	return append(os.Environ(), env...)
+}
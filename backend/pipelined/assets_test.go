@@ -0,0 +1,133 @@
+package pipelined
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// assetServer serves a gzipped tarball of the given executables, in its bin
+// directory, and returns the corresponding asset.
+func assetServer(t *testing.T, name string, executables map[string]string) (*httptest.Server, *types.Asset) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for filename, content := range executables {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: "bin/" + filename,
+			Mode: 0755,
+			Size: int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	tarball := buf.Bytes()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarball)
+	}))
+
+	sum := sha512.Sum512(tarball)
+	asset := types.FixtureAsset(name)
+	asset.URL = server.URL + "/" + name + ".tar.gz"
+	asset.Sha512 = hex.EncodeToString(sum[:])
+	asset.Filters = nil
+	return server, asset
+}
+
+func TestPipelinedPipeHandlerRuntimeAssets(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "sensu-pipelined-assets")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	server, asset := assetServer(t, "greeter", map[string]string{
+		"greet": "#!/bin/sh\necho hello $(cat)\n",
+	})
+	defer server.Close()
+
+	store := &mockstore.MockStore{}
+	store.On("GetAssetByName", mock.Anything, "greeter").Return(asset, nil)
+	store.On("GetAssetByName", mock.Anything, "missing").Return((*types.Asset)(nil), nil)
+	p := &Pipelined{store: store, assetCacheDir: cacheDir}
+
+	handler := types.FixtureHandler("greet")
+	handler.Command = "greet"
+	handler.RuntimeAssets = []string{"greeter"}
+	event := types.FixtureEvent("entity1", "check1")
+
+	execution, err := p.pipeHandler(handler, event, []byte("world"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, execution.Status)
+	assert.Equal(t, "hello world\n", execution.Output)
+
+	handler.RuntimeAssets = []string{"missing"}
+	_, err = p.pipeHandler(handler, event, []byte("world"))
+	assert.Error(t, err)
+}
+
+func TestPipelinedPipeMutatorRuntimeAssets(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "sensu-pipelined-assets")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	server, asset := assetServer(t, "mutators", map[string]string{
+		"only-check": "#!/bin/sh\necho '{\"check\":\"mutated\"}'\n",
+	})
+	defer server.Close()
+
+	store := &mockstore.MockStore{}
+	store.On("GetAssetByName", mock.Anything, "mutators").Return(asset, nil)
+	p := &Pipelined{store: store, assetCacheDir: cacheDir}
+
+	mutator := types.FixtureMutator("only-check")
+	mutator.Command = "only-check"
+	mutator.RuntimeAssets = []string{"mutators"}
+	event := types.FixtureEvent("entity1", "check1")
+
+	output, err := p.pipeMutator(mutator, event)
+	require.NoError(t, err)
+
+	var mutated map[string]string
+	require.NoError(t, json.Unmarshal(output, &mutated))
+	assert.Equal(t, "mutated", mutated["check"])
+}
+
+func TestPipelinedRuntimeAssetsMirror(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "sensu-pipelined-assets")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	server, asset := assetServer(t, "greeter", map[string]string{
+		"greet": "#!/bin/sh\necho hello\n",
+	})
+	defer server.Close()
+	asset.URL = "http://unreachable.invalid/greeter.tar.gz"
+
+	store := &mockstore.MockStore{}
+	store.On("GetAssetByName", mock.Anything, "greeter").Return(asset, nil)
+	p := &Pipelined{store: store, assetCacheDir: cacheDir, assetMirror: server.URL}
+
+	handler := types.FixtureHandler("greet")
+	handler.Command = "greet"
+	handler.RuntimeAssets = []string{"greeter"}
+
+	execution, err := p.pipeHandler(handler, types.FixtureEvent("entity1", "check1"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", execution.Output)
+}
@@ -0,0 +1,168 @@
+package pipelined
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultEmailSubjectTemplate is the template of the subject of the emails
+	// of email handlers which do not specify one.
+	DefaultEmailSubjectTemplate = "{{ .Entity.ID }}{{ with .Check }}/{{ .Name }} (status {{ .Status }}){{ end }}"
+
+	// DefaultEmailBodyTemplate is the template of the body of the emails of
+	// email handlers which do not specify one.
+	DefaultEmailBodyTemplate = `Entity: {{ .Entity.ID }}
+{{ with .Check }}Check: {{ .Name }}
+Status: {{ .Status }}
+Output: {{ .Output }}
+{{ end }}`
+
+	// DefaultEmailPort is the port of the SMTP server of email handlers which
+	// do not specify one.
+	DefaultEmailPort uint32 = 25
+
+	// DefaultEmailTimeout specifies the default time in seconds waited for the
+	// SMTP server of email handlers to accept an email.
+	DefaultEmailTimeout uint32 = 10
+)
+
+// emailHandler sends an email describing the event, following the templates
+// of a Sensu email handler, through its SMTP server.
+func (p *Pipelined) emailHandler(handler *types.Handler, event *types.Event) error {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"environment":  handler.Environment,
+		"organization": handler.Organization,
+		"handler":      handler.Name,
+	}
+
+	config := handler.Email
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	subject, err := executeEventTemplate(config.SubjectTemplate, DefaultEmailSubjectTemplate, event)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to execute email subject template")
+		return err
+	}
+	body, err := executeEventTemplate(config.BodyTemplate, DefaultEmailBodyTemplate, event)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to execute email body template")
+		return err
+	}
+
+	timeout := handler.Timeout
+	if timeout == 0 {
+		timeout = DefaultEmailTimeout
+	}
+	if err := sendEmail(config, time.Duration(timeout)*time.Second, emailMessage(config, subject, body)); err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to execute event handler")
+		return err
+	}
+
+	logger.WithFields(fields).Info("event email handler executed")
+	return nil
+}
+
+// sendEmail sends the given message to the recipients of the given email
+// handler configuration, within the given timeout. The connection is upgraded
+// with STARTTLS when the server supports it, unless it uses TLS already.
+func sendEmail(config *types.HandlerEmail, timeout time.Duration, message []byte) error {
+	port := config.Port
+	if port == 0 {
+		port = DefaultEmailPort
+	}
+	addr := net.JoinHostPort(config.Host, strconv.FormatUint(uint64(port), 10))
+	tlsConfig := &tls.Config{
+		ServerName:         config.Host,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	var conn net.Conn
+	var err error
+	dialer := &net.Dialer{Timeout: timeout}
+	if config.TLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok && !config.TLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support authentication")
+		}
+		auth := smtp.PlainAuth("", config.Username, config.Password, config.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(config.From); err != nil {
+		return err
+	}
+	for _, to := range config.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// emailMessage returns the plain text email with the given subject and body,
+// from the sender to the recipients of the given email handler configuration.
+func emailMessage(config *types.HandlerEmail, subject, body string) []byte {
+	// Line breaks in the subject would start other headers
+	subject = strings.Join(strings.Fields(subject), " ")
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(config.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.Replace(strings.Replace(body, "\r\n", "\n", -1), "\n", "\r\n", -1))
+	return msg.Bytes()
+}
@@ -0,0 +1,104 @@
+package pipelined
+
+import (
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// smtpTranscript is what a fake SMTP server received from a client.
+type smtpTranscript struct {
+	commands []string
+	data     string
+}
+
+// fakeSMTPServer accepts a single SMTP session on the given listener,
+// supporting plain authentication, and sends its transcript once done.
+func fakeSMTPServer(t *testing.T, listener net.Listener, done chan<- smtpTranscript) {
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer conn.Close()
+
+	var transcript smtpTranscript
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			break
+		}
+		transcript.commands = append(transcript.commands, line)
+		switch verb := strings.ToUpper(strings.Fields(line)[0]); verb {
+		case "EHLO":
+			_ = tp.PrintfLine("250-localhost")
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			_ = tp.PrintfLine("235 2.7.0 Authentication successful")
+		case "DATA":
+			_ = tp.PrintfLine("354 Go ahead")
+			data, _ := tp.ReadDotBytes()
+			transcript.data = string(data)
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 Bye")
+			done <- transcript
+			return
+		default:
+			_ = tp.PrintfLine("250 OK")
+		}
+	}
+	done <- transcript
+}
+
+func TestPipelinedEmailHandler(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	done := make(chan smtpTranscript, 1)
+	go fakeSMTPServer(t, listener, done)
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, _ := strconv.ParseUint(port, 10, 32)
+
+	p := &Pipelined{}
+	handler := types.FixtureEmailHandler("email", "127.0.0.1", uint32(portNum))
+	handler.Email.Username = "sensu"
+	handler.Email.Password = "P@ssw0rd!"
+	handler.Email.SubjectTemplate = "{{ .Check.Name }}\r\nBcc: evil@example.com"
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Status = 2
+	event.Check.Output = "disk full"
+
+	require.NoError(t, p.emailHandler(handler, event))
+	transcript := <-done
+
+	assert.Contains(t, transcript.commands, "MAIL FROM:<sensu@example.com>")
+	assert.Contains(t, transcript.commands, "RCPT TO:<ops@example.com>")
+	assert.True(t, strings.HasPrefix(transcript.commands[1], "AUTH PLAIN"))
+	assert.Contains(t, transcript.data, "To: ops@example.com\n")
+	assert.Contains(t, transcript.data, "Subject: check1 Bcc: evil@example.com\n")
+	assert.Contains(t, transcript.data, "Check: check1\nStatus: 2\nOutput: disk full\n")
+}
+
+func TestPipelinedEmailHandlerFailure(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().(*net.TCPAddr)
+	listener.Close()
+
+	p := &Pipelined{}
+	handler := types.FixtureEmailHandler("email", "127.0.0.1", uint32(addr.Port))
+	event := types.FixtureEvent("entity1", "check1")
+
+	assert.Error(t, p.emailHandler(handler, event))
+}
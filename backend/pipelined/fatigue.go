@@ -0,0 +1,68 @@
+package pipelined
+
+import (
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/dynamic"
+)
+
+const (
+	// defaultFatigueOccurrences is the number of occurrences of an incident
+	// before it is handled by the fatigue_check filter, unless the check
+	// specifies its own "fatigue_occurrences" attribute.
+	defaultFatigueOccurrences = 1
+
+	// defaultFatigueRefresh is the number of seconds after which an ongoing
+	// incident is handled again by the fatigue_check filter, unless the check
+	// specifies its own "fatigue_refresh" attribute.
+	defaultFatigueRefresh = 1800
+)
+
+// fatigueCheck returns true if the given event should be filtered to reduce
+// notification fatigue. Incidents are handled once they reach the
+// "fatigue_occurrences" extended attribute of their check, then every
+// "fatigue_refresh" seconds, based on the interval of the check. Resolutions are only handled if the incident
+// they resolve was handled.
+func fatigueCheck(event *types.Event) bool {
+	if !event.HasCheck() {
+		return false
+	}
+
+	occurrences := fatigueAttribute(event.Check, "fatigue_occurrences", defaultFatigueOccurrences)
+	if event.IsResolution() {
+		return event.Check.OccurrencesWatermark < occurrences
+	}
+	if !event.IsIncident() {
+		return false
+	}
+	if event.Check.Occurrences < occurrences {
+		return true
+	}
+
+	// Handle the incident again every refresh, in occurrences of the check
+	refresh := fatigueAttribute(event.Check, "fatigue_refresh", defaultFatigueRefresh)
+	if event.Check.Interval == 0 || refresh < int64(event.Check.Interval) {
+		return false
+	}
+	every := refresh / int64(event.Check.Interval)
+	return (event.Check.Occurrences-occurrences)%every != 0
+}
+
+// fatigueAttribute returns the given numeric extended attribute of the given
+// check, or the given default value if the check does not specify it.
+func fatigueAttribute(check *types.Check, name string, defaultValue int64) int64 {
+	value, err := dynamic.GetField(check, name)
+	if err != nil {
+		return defaultValue
+	}
+	switch v := value.(type) {
+	case float64:
+		if v > 0 {
+			return int64(v)
+		}
+	case int64:
+		if v > 0 {
+			return v
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,99 @@
+package pipelined
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFatigueCheck(t *testing.T) {
+	testCases := []struct {
+		name        string
+		status      uint32
+		history     []types.CheckHistory
+		occurrences int64
+		watermark   int64
+		attributes  string
+		expected    bool
+	}{
+		{
+			name:        "OK",
+			status:      0,
+			occurrences: 1,
+			expected:    false,
+		},
+		{
+			name:        "First occurrence",
+			status:      2,
+			occurrences: 1,
+			expected:    false,
+		},
+		{
+			name:        "Second occurrence",
+			status:      2,
+			occurrences: 2,
+			expected:    true,
+		},
+		{
+			name:        "Refreshed occurrence",
+			status:      2,
+			occurrences: 31,
+			expected:    false,
+		},
+		{
+			name:        "Custom refresh",
+			status:      2,
+			occurrences: 6,
+			attributes:  `{"fatigue_refresh":300}`,
+			expected:    false,
+		},
+		{
+			name:        "Before custom occurrences",
+			status:      2,
+			occurrences: 2,
+			attributes:  `{"fatigue_occurrences":3}`,
+			expected:    true,
+		},
+		{
+			name:        "Custom occurrences",
+			status:      2,
+			occurrences: 3,
+			attributes:  `{"fatigue_occurrences":3}`,
+			expected:    false,
+		},
+		{
+			name:        "Handled incident resolution",
+			status:      0,
+			history:     []types.CheckHistory{{Status: 2}},
+			occurrences: 1,
+			watermark:   3,
+			attributes:  `{"fatigue_occurrences":3}`,
+			expected:    false,
+		},
+		{
+			name:        "Unhandled incident resolution",
+			status:      0,
+			history:     []types.CheckHistory{{Status: 2}},
+			occurrences: 1,
+			watermark:   2,
+			attributes:  `{"fatigue_occurrences":3}`,
+			expected:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := types.FixtureEvent("entity1", "check1")
+			event.Check.Interval = 60
+			event.Check.Status = tc.status
+			event.Check.History = tc.history
+			event.Check.Occurrences = tc.occurrences
+			event.Check.OccurrencesWatermark = tc.watermark
+			if tc.attributes != "" {
+				event.Check.SetExtendedAttributes([]byte(tc.attributes))
+			}
+			assert.Equal(t, tc.expected, fatigueCheck(event))
+		})
+	}
+}
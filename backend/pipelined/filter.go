@@ -10,9 +10,15 @@ import (
 	utillogging "github.com/sensu/sensu-go/util/logging"
 )
 
-func evaluateEventFilterStatement(event *types.Event, statement string) bool {
+func evaluateEventFilterStatement(event *types.Event, filter *types.EventFilter, statement string, libraries []string) bool {
 	parameters := map[string]interface{}{"event": event}
-	result, err := eval.EvaluatePredicate(statement, parameters)
+	var result bool
+	var err error
+	if filter.Type == types.EventFilterTypeJavaScript {
+		result, err = eval.EvaluateJavaScriptPredicate(statement, parameters, libraries...)
+	} else {
+		result, err = eval.EvaluatePredicate(statement, parameters)
+	}
 	if err != nil {
 		fields := utillogging.EventFields(event, false)
 		fields["statement"] = statement
@@ -30,8 +36,9 @@ func evaluateEventFilterStatement(event *types.Event, statement string) bool {
 	return result
 }
 
-// Returns true if the event should be filtered.
-func evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
+// Returns true if the event should be filtered. The given libraries are loaded
+// before evaluating the statements of JavaScript filters.
+func evaluateEventFilter(event *types.Event, filter *types.EventFilter, libraries []string) bool {
 	if filter.When != nil {
 		inWindows, err := filter.When.InWindows(time.Now().UTC())
 		if err != nil {
@@ -51,7 +58,7 @@ func evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
 	}
 
 	for _, statement := range filter.Statements {
-		match := evaluateEventFilterStatement(event, statement)
+		match := evaluateEventFilterStatement(event, filter, statement, libraries)
 
 		// Allow - One of the statements did not match, filter the event
 		if filter.Action == types.EventFilterActionAllow && !match {
@@ -121,6 +128,24 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 			continue
 		}
 
+		// Do not filter the event if its check is not flapping.
+		if filterName == "not_flapping" {
+			if event.IsFlapping() {
+				return true
+			}
+
+			continue
+		}
+
+		// Do not filter the event if it does not cause notification fatigue.
+		if filterName == "fatigue_check" {
+			if fatigueCheck(event) {
+				return true
+			}
+
+			continue
+		}
+
 		// Retrieve the filter from the store with its name
 		ctx := types.SetContextFromResource(context.Background(), event.Entity)
 		filter, err := p.store.GetEventFilterByName(ctx, filterName)
@@ -131,10 +156,17 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 		}
 
 		if filter != nil {
+			libraries, err := p.filterLibraries(event, filter)
+			if err != nil {
+				logger.WithFields(fields).WithError(err).
+					Errorf("could not install the runtime assets of the filter %s", filterName)
+				return false
+			}
+
 			// Execute the filter, evaluating each of its
 			// statements against the event. The event is rejected
 			// if the product of all statements is true.
-			filtered := evaluateEventFilter(event, filter)
+			filtered := evaluateEventFilter(event, filter, libraries)
 			if filtered {
 				return true
 			}
@@ -168,3 +200,17 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 
 	return false
 }
+
+// filterLibraries installs the runtime assets of the given JavaScript filter,
+// returning the JavaScript libraries they provide in their lib directory.
+func (p *Pipelined) filterLibraries(event *types.Event, filter *types.EventFilter) ([]string, error) {
+	if filter.Type != types.EventFilterTypeJavaScript || len(filter.RuntimeAssets) == 0 {
+		return nil, nil
+	}
+
+	set, err := p.installRuntimeAssets(filter, filter.RuntimeAssets, event)
+	if err != nil {
+		return nil, err
+	}
+	return set.Scripts()
+}
@@ -38,10 +38,32 @@ func TestPipelinedFilter(t *testing.T) {
 		Action:     types.EventFilterActionDeny,
 		Statements: []string{`event.Check.Output == "foo"`},
 	}
+	denyFilterCritical := &types.EventFilter{
+		Name:       "denyFilterCritical",
+		Action:     types.EventFilterActionDeny,
+		Statements: []string{`event.Check.Severity == "critical"`},
+	}
+	allowJSFilterFoo := &types.EventFilter{
+		Name:       "allowJSFilterFoo",
+		Action:     types.EventFilterActionAllow,
+		Type:       types.EventFilterTypeJavaScript,
+		Statements: []string{`event.check.output == "foo" && event.check.status % 2 == 1`},
+	}
+	denyJSFilterLib := &types.EventFilter{
+		Name:          "denyJSFilterLib",
+		Action:        types.EventFilterActionDeny,
+		Type:          types.EventFilterTypeJavaScript,
+		Statements:    []string{`isFoo(event)`},
+		RuntimeAssets: []string{"missing"},
+	}
 	store.On("GetEventFilterByName", mock.Anything, "allowFilterBar").Return(allowFilterBar, nil)
 	store.On("GetEventFilterByName", mock.Anything, "allowFilterFoo").Return(allowFilterFoo, nil)
 	store.On("GetEventFilterByName", mock.Anything, "denyFilterBar").Return(denyFilterBar, nil)
 	store.On("GetEventFilterByName", mock.Anything, "denyFilterFoo").Return(denyFilterFoo, nil)
+	store.On("GetEventFilterByName", mock.Anything, "denyFilterCritical").Return(denyFilterCritical, nil)
+	store.On("GetEventFilterByName", mock.Anything, "allowJSFilterFoo").Return(allowJSFilterFoo, nil)
+	store.On("GetEventFilterByName", mock.Anything, "denyJSFilterLib").Return(denyJSFilterLib, nil)
+	store.On("GetAssetByName", mock.Anything, "missing").Return((*types.Asset)(nil), nil)
 	store.On("GetEventFilterByName", mock.Anything, "extension_filter").Return((*types.EventFilter)(nil), nil)
 	store.On("GetExtension", mock.Anything, "extension_filter").Return(&types.Extension{URL: "http://127.0.0.1"}, nil)
 
@@ -55,6 +77,7 @@ func TestPipelinedFilter(t *testing.T) {
 		name     string
 		status   uint32
 		history  []types.CheckHistory
+		state    string
 		metrics  *types.Metrics
 		silenced []string
 		filters  []string
@@ -124,6 +147,36 @@ func TestPipelinedFilter(t *testing.T) {
 			filters:  []string{"denyFilterFoo"},
 			expected: true,
 		},
+		{
+			name:     "Severity Filter With No Match",
+			status:   1,
+			filters:  []string{"denyFilterCritical"},
+			expected: false,
+		},
+		{
+			name:     "Severity Filter With Match",
+			status:   2,
+			filters:  []string{"denyFilterCritical"},
+			expected: true,
+		},
+		{
+			name:     "JavaScript Filter With Match",
+			status:   1,
+			filters:  []string{"allowJSFilterFoo"},
+			expected: false,
+		},
+		{
+			name:     "JavaScript Filter With No Match",
+			status:   2,
+			filters:  []string{"allowJSFilterFoo"},
+			expected: true,
+		},
+		{
+			name:     "JavaScript Filter With Missing Asset",
+			status:   1,
+			filters:  []string{"denyJSFilterLib"},
+			expected: false,
+		},
 		{
 			name:     "Deny Filters With One Match",
 			status:   1,
@@ -170,6 +223,20 @@ func TestPipelinedFilter(t *testing.T) {
 			filters:  []string{"is_incident"},
 			expected: false,
 		},
+		{
+			name:     "Flapping",
+			status:   1,
+			state:    types.EventFlappingState,
+			filters:  []string{"is_incident", "not_flapping"},
+			expected: true,
+		},
+		{
+			name:     "Not Flapping",
+			status:   1,
+			state:    types.EventFailingState,
+			filters:  []string{"is_incident", "not_flapping"},
+			expected: false,
+		},
 		{
 			name:     "Extension filter",
 			filters:  []string{"extension_filter"},
@@ -190,6 +257,7 @@ func TestPipelinedFilter(t *testing.T) {
 					Status:   tc.status,
 					History:  tc.history,
 					Output:   "foo",
+					State:    tc.state,
 					Silenced: tc.silenced,
 				},
 				Entity: &types.Entity{
@@ -198,6 +266,7 @@ func TestPipelinedFilter(t *testing.T) {
 				},
 				Metrics: tc.metrics,
 			}
+			event.Check.SetSeverity()
 
 			filtered := p.filterEvent(handler, event)
 			assert.Equal(t, tc.expected, filtered)
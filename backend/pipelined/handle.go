@@ -6,13 +6,20 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/types"
 	utillogging "github.com/sensu/sensu-go/util/logging"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,8 +27,22 @@ const (
 	// DefaultSocketTimeout specifies the default socket dial
 	// timeout in seconds for TCP and UDP handlers.
 	DefaultSocketTimeout uint32 = 60
+
+	// DefaultSocketReconnectInterval specifies the default time in seconds
+	// waited before reconnecting to the socket of TCP and UDP handlers.
+	DefaultSocketReconnectInterval uint32 = 1
+
+	// DefaultHandlerRetryBackoff specifies the default time in seconds
+	// waited before executing a failed handler again.
+	DefaultHandlerRetryBackoff uint32 = 1
+
+	// MaxHandlerRetryBackoff caps the time waited before executing a failed
+	// handler again, as it doubles after each failure.
+	MaxHandlerRetryBackoff = time.Minute
 )
 
+var errUnknownHandlerType = errors.New("unknown handler type")
+
 type handlerExtensionUnion struct {
 	*types.Extension
 	*types.Handler
@@ -45,14 +66,20 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 	var handlerList []string
 
 	if event.HasCheck() {
-		handlerList = append(handlerList, event.Check.Handlers...)
+		// Failures caused by a failing dependency are not handled
+		if dependency := p.failingDependency(ctx, event); dependency != "" {
+			logger.WithFields(fields).WithField("dependency", dependency).
+				Info("check handlers suppressed, dependency failing")
+		} else {
+			handlerList = append(handlerList, event.Check.Handlers...)
+		}
 	}
 
 	if event.HasMetrics() {
 		handlerList = append(handlerList, event.Metrics.Handlers...)
 	}
 
-	handlers, err := p.expandHandlers(ctx, handlerList, 1)
+	handlers, err := p.expandHandlers(ctx, handlerList, nil)
 	if err != nil {
 		return err
 	}
@@ -62,6 +89,9 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 		return nil
 	}
 
+	d := &delivery{ctx: ctx, event: event, pending: 1, failures: map[string]string{}}
+	defer d.complete(p, "", "")
+
 	for _, u := range handlers {
 		handler := u.Handler
 		fields["handler"] = handler.Name
@@ -78,32 +108,275 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 
 		logger.WithFields(fields).Info("sending event to handler")
 
-		switch handler.Type {
-		case "pipe":
-			if _, err := p.pipeHandler(handler, eventData); err != nil {
-				logger.WithFields(fields).Error(err)
-			}
-		case "tcp", "udp":
-			if _, err := p.socketHandler(handler, eventData); err != nil {
-				logger.WithFields(fields).Error(err)
-			}
-		case "grpc":
-			if _, err := p.grpcHandler(u.Extension, event, eventData); err != nil {
-				logger.WithFields(fields).Error(err)
-			}
-		default:
-			return errors.New("unknown handler type")
+		backoff := time.Duration(handler.RetryBackoff) * time.Second
+		if backoff == 0 {
+			backoff = time.Duration(DefaultHandlerRetryBackoff) * time.Second
 		}
+		d.add()
+		if err := p.executeHandlerAttempt(d, &handlerExecution{
+			u:         u,
+			eventData: eventData,
+			result:    newHandlerResult(handler, event),
+			start:     time.Now(),
+			attempt:   1,
+			backoff:   backoff,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delivery tracks the handling of an event by its handlers, so that the
+// handlers which ultimately failed are dead-lettered together once all of them
+// completed, retries included.
+type delivery struct {
+	ctx      context.Context
+	event    *types.Event
+	mu       sync.Mutex
+	pending  int
+	failures map[string]string
+}
+
+func (d *delivery) add() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pending++
+}
+
+// complete records the completion of the given handler, and its failure if
+// not empty. The failures are dead-lettered once nothing is pending anymore.
+func (d *delivery) complete(p *Pipelined, handler, failure string) {
+	d.mu.Lock()
+	if failure != "" {
+		d.failures[handler] = failure
+	}
+	d.pending--
+	last := d.pending == 0
+	d.mu.Unlock()
+
+	if last && len(d.failures) > 0 {
+		p.deadLetter(d.ctx, d.event, d.failures)
+	}
+}
+
+// handlerExecution is the execution of a handler for an event, across its
+// attempts.
+type handlerExecution struct {
+	u         handlerExtensionUnion
+	eventData []byte
+	result    *types.HandlerResult
+	start     time.Time
+	attempt   uint32
+	backoff   time.Duration
+	err       error
+}
+
+// executeHandlerAttempt executes the given handler once. When it fails and the
+// handler allows another attempt, the attempt is scheduled in its own
+// goroutine, so that failing handlers never hold up the pipelines.
+func (p *Pipelined) executeHandlerAttempt(d *delivery, e *handlerExecution) error {
+	e.result.Status = 0
+	e.err = p.executeHandler(e.u, d.event, e.eventData, e.result)
+	if e.err == errUnknownHandlerType {
+		d.complete(p, "", "")
+		return e.err
+	}
+
+	if (e.err != nil || e.result.Status != 0) && e.attempt < e.u.Handler.MaxAttempts {
+		fields := utillogging.EventFields(d.event, false)
+		fields["handler"] = e.u.Handler.Name
+		fields["attempt"] = e.attempt
+		logger.WithFields(fields).WithError(e.err).Warnf("handler failed, retrying in %s", e.backoff)
+		p.retryHandler(d, e)
+		return nil
 	}
 
+	p.completeHandler(d, e)
 	return nil
 }
 
+// retryHandler waits for the backoff of the given execution and then attempts
+// it again, waiting longer after each failure. The last failure is kept if
+// pipelined is stopped meanwhile.
+func (p *Pipelined) retryHandler(d *delivery, e *handlerExecution) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		timer := time.NewTimer(e.backoff)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-p.stopping:
+			p.completeHandler(d, e)
+			return
+		}
+
+		e.attempt++
+		if e.backoff *= 2; e.backoff > MaxHandlerRetryBackoff {
+			e.backoff = MaxHandlerRetryBackoff
+		}
+		_ = p.executeHandlerAttempt(d, e)
+	}()
+}
+
+// completeHandler publishes the result of the last attempt of the given
+// execution, and records its failure for the dead letter.
+func (p *Pipelined) completeHandler(d *delivery, e *handlerExecution) {
+	result := e.result
+	result.Duration = time.Since(e.start).Seconds()
+	if e.err != nil {
+		fields := utillogging.EventFields(d.event, false)
+		fields["handler"] = e.u.Handler.Name
+		logger.WithFields(fields).Error(e.err)
+		result.Error = e.err.Error()
+	}
+	result.Success = e.err == nil && result.Status == 0
+	p.publishHandlerResult(result)
+
+	failure := ""
+	if !result.Success {
+		failure = handlerFailure(result)
+	}
+	d.complete(p, e.u.Handler.Name, failure)
+}
+
+// executeHandler executes the given handler once with the given event data,
+// setting the exit status of pipe handlers in the given result.
+func (p *Pipelined) executeHandler(u handlerExtensionUnion, event *types.Event, eventData []byte, result *types.HandlerResult) (err error) {
+	handler := u.Handler
+	switch handler.Type {
+	case "pipe":
+		var execution *command.Execution
+		if execution, err = p.pipeHandler(handler, event, eventData); execution != nil {
+			result.Status = execution.Status
+		}
+	case "tcp", "udp":
+		_, err = p.socketHandler(handler, eventData)
+	case "slack":
+		err = p.slackHandler(handler, event)
+	case "pagerduty":
+		err = p.pagerDutyHandler(handler, event)
+	case "email":
+		err = p.emailHandler(handler, event)
+	case "grpc":
+		var response rpc.HandleEventResponse
+		if response, err = p.grpcHandler(u.Extension, event, eventData); err == nil && response.Error != "" {
+			err = errors.New(response.Error)
+		}
+	default:
+		return errUnknownHandlerType
+	}
+	return err
+}
+
+// handlerFailure describes the failure of the handler of the given result.
+func handlerFailure(result *types.HandlerResult) string {
+	if result.Error != "" {
+		return result.Error
+	}
+	return fmt.Sprintf("exited with status %d", result.Status)
+}
+
+// deadLetter stores the given event, whose given handlers ultimately failed,
+// as a pipeline error, so the failures are not silently dropped and the event
+// can be inspected and submitted again through the API. Pipeline errors are
+// stored per entity and check, the failures of metrics are only logged.
+func (p *Pipelined) deadLetter(ctx context.Context, event *types.Event, failures map[string]string) {
+	fields := utillogging.EventFields(event, false)
+	if !event.HasCheck() {
+		logger.WithFields(fields).Warn("failed handlers of metrics not stored")
+		return
+	}
+
+	names := make([]string, 0, len(failures))
+	for name := range failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	messages := make([]string, len(names))
+	for i, name := range names {
+		messages[i] = fmt.Sprintf("%s: %s", name, failures[name])
+	}
+
+	perr := &types.Error{
+		Name:      strings.Join(names, ","),
+		Message:   strings.Join(messages, "; "),
+		Component: "pipelined",
+		Timestamp: time.Now().Unix(),
+		Event:     *event,
+	}
+	if err := p.store.CreateError(ctx, perr); err != nil {
+		logger.WithFields(fields).WithError(err).Error("could not store the failed handlers of the event")
+	}
+}
+
+// failingDependency returns the first dependency of the check of the given
+// event failing, if the event is an incident. Dependencies which can't be
+// retrieved are considered passing, so failures are not missed.
+func (p *Pipelined) failingDependency(ctx context.Context, event *types.Event) string {
+	if !event.IsIncident() {
+		return ""
+	}
+	for _, dependency := range event.Check.DependsOn {
+		entity, check, err := types.ParseCheckDependency(dependency)
+		if err != nil {
+			continue
+		}
+		if entity == "" {
+			entity = event.Entity.ID
+		}
+		upstream, err := p.store.GetEventByEntityCheck(ctx, entity, check)
+		if err != nil {
+			logger.WithError(err).WithField("dependency", dependency).
+				Warn("could not retrieve the event of the dependency")
+			continue
+		}
+		if upstream != nil && upstream.IsIncident() {
+			return dependency
+		}
+	}
+	return ""
+}
+
+// newHandlerResult returns the result of the execution of the given handler
+// for the given event, to be completed once executed.
+func newHandlerResult(handler *types.Handler, event *types.Event) *types.HandlerResult {
+	result := &types.HandlerResult{
+		Handler:      handler.Name,
+		Type:         handler.Type,
+		Organization: event.Entity.Organization,
+		Environment:  event.Entity.Environment,
+		Entity:       event.Entity.ID,
+		RequestID:    event.RequestID,
+		Executed:     time.Now().Unix(),
+	}
+	if event.HasCheck() {
+		result.Check = event.Check.Name
+	}
+	return result
+}
+
+// publishHandlerResult publishes the given result on the message bus, so the
+// activity of the pipeline can be observed.
+func (p *Pipelined) publishHandlerResult(result *types.HandlerResult) {
+	if p.bus == nil {
+		return
+	}
+	if err := p.bus.Publish(messaging.TopicHandlerResult, result); err != nil {
+		logger.WithError(err).Warn("could not publish handler result")
+	}
+}
+
 // expandHandlers turns a list of Sensu handler names into a list of
 // handlers, while expanding handler sets with support for some
-// nesting. Handlers are fetched from etcd.
-func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, level int) (map[string]handlerExtensionUnion, error) {
-	if level > 3 {
+// nesting. Handlers are fetched from etcd. The given sets are the handler
+// sets including the handlers, from the outermost one, so that sets including
+// themselves are not expanded endlessly. Handlers are only listed once.
+func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, sets []string) (map[string]handlerExtensionUnion, error) {
+	if len(sets) >= types.HandlerSetMaxDepth {
 		return nil, errors.New("handler sets cannot be deeply nested")
 	}
 
@@ -149,9 +422,24 @@ func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, level
 			}
 		}
 
+		// Subdued handlers, or handler sets, are not executed
+		if handler.IsSubdued() {
+			logger.WithFields(fields).Info("handler subdued")
+			continue
+		}
+
 		if handler.Type == "set" {
-			level++
-			setHandlers, err := p.expandHandlers(ctx, handler.Handlers, level)
+			if utilstrings.InArray(handler.Name, sets) {
+				logger.
+					WithFields(fields).
+					WithField("sets", sets).
+					Error("handler set includes itself")
+				continue
+			}
+
+			// Copy the sets so the sibling sets do not share the same array
+			path := append(append([]string{}, sets...), handler.Name)
+			setHandlers, err := p.expandHandlers(ctx, handler.Handlers, path)
 
 			if err != nil {
 				logger.
@@ -161,7 +449,7 @@ func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, level
 			} else {
 				for name, u := range setHandlers {
 					if _, ok := expanded[name]; !ok {
-						expanded[name] = handlerExtensionUnion{Handler: u.Handler}
+						expanded[name] = u
 					}
 				}
 			}
@@ -177,13 +465,7 @@ func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, level
 
 // pipeHandler fork/executes a child process for a Sensu pipe handler
 // command and writes the mutated eventData to it via STDIN.
-func (p *Pipelined) pipeHandler(handler *types.Handler, eventData []byte) (*command.Execution, error) {
-	handlerExec := &command.Execution{}
-	handlerExec.Command = handler.Command
-	handlerExec.Timeout = int(handler.Timeout)
-	handlerExec.Env = handler.EnvVars
-	handlerExec.Input = string(eventData[:])
-
+func (p *Pipelined) pipeHandler(handler *types.Handler, event *types.Event, eventData []byte) (*command.Execution, error) {
 	// Prepare log entry
 	fields := logrus.Fields{
 		"environment":  handler.Environment,
@@ -191,13 +473,35 @@ func (p *Pipelined) pipeHandler(handler *types.Handler, eventData []byte) (*comm
 		"handler":      handler.Name,
 	}
 
+	var assets *assetmanager.RuntimeAssetSet
+	if len(handler.RuntimeAssets) > 0 {
+		var err error
+		if assets, err = p.installRuntimeAssets(handler, handler.RuntimeAssets, event); err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to install the runtime assets of the handler")
+			return nil, err
+		}
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), handler)
+	secretVars, err := secrets.Resolve(ctx, p.store, handler.Secrets)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to resolve the secrets of the handler")
+		return nil, err
+	}
+
+	handlerExec := &command.Execution{}
+	handlerExec.Command = handler.Command
+	handlerExec.Timeout = int(handler.Timeout)
+	handlerExec.Env = commandEnv(assets, handler.EnvVars, secretVars)
+	handlerExec.Input = string(eventData[:])
+
 	result, err := command.ExecuteCommand(context.Background(), handlerExec)
 
 	if err != nil {
 		logger.WithFields(fields).WithError(err).Error("failed to execute event pipe handler")
 	} else {
 		fields["status"] = result.Status
-		fields["output"] = result.Output
+		fields["output"] = types.RedactSecrets(result.Output, secretVars)
 		logger.WithFields(fields).Info("event pipe handler executed")
 	}
 
@@ -205,12 +509,15 @@ func (p *Pipelined) pipeHandler(handler *types.Handler, eventData []byte) (*comm
 }
 
 // socketHandler creates either a TCP or UDP client to write eventData
-// to a socket. The provided handler Type determines the protocol.
+// to a socket. The provided handler Type determines the protocol. When
+// sending the event fails, the handler reconnects to the socket as many times
+// as configured to send it again.
 func (p *Pipelined) socketHandler(handler *types.Handler, eventData []byte) (conn net.Conn, err error) {
 	protocol := handler.Type
 	host := handler.Socket.Host
 	port := handler.Socket.Port
 	timeout := handler.Timeout
+	interval := handler.Socket.ReconnectInterval
 
 	// Prepare log entry
 	fields := logrus.Fields{
@@ -224,15 +531,47 @@ func (p *Pipelined) socketHandler(handler *types.Handler, eventData []byte) (con
 	if timeout == 0 {
 		timeout = DefaultSocketTimeout
 	}
+	if interval == 0 {
+		interval = DefaultSocketReconnectInterval
+	}
 
 	address := fmt.Sprintf("%s:%d", host, port)
 	timeoutDuration := time.Duration(timeout) * time.Second
 
 	logger.WithFields(fields).Debug("sending event to socket handler")
 
-	conn, err = net.DialTimeout(protocol, address, timeoutDuration)
+	var bytes int
+	for attempt := uint32(0); ; attempt++ {
+		conn, bytes, err = sendToSocket(protocol, address, timeoutDuration, eventData)
+		if err == nil || attempt >= handler.Socket.ReconnectAttempts {
+			break
+		}
+
+		logger.WithFields(fields).WithError(err).Warn("failed to send event to socket handler, reconnecting")
+		select {
+		case <-time.After(time.Duration(interval) * time.Second):
+		case <-p.stopping:
+			return conn, err
+		}
+	}
+
 	if err != nil {
-		return nil, err
+		logger.WithFields(fields).WithError(err).Error("failed to execute event handler")
+		return conn, err
+	}
+
+	fields["bytes"] = bytes
+	logger.WithFields(fields).Info("event socket handler executed")
+
+	return conn, nil
+}
+
+// sendToSocket dials the given address and writes the given data to it, each
+// within the given timeout.
+func sendToSocket(protocol, address string, timeout time.Duration, data []byte) (conn net.Conn, bytes int, err error) {
+	conn, err = net.DialTimeout(protocol, address, timeout)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer func() {
 		e := conn.Close()
@@ -241,16 +580,11 @@ func (p *Pipelined) socketHandler(handler *types.Handler, eventData []byte) (con
 		}
 	}()
 
-	bytes, err := conn.Write(eventData)
-
-	if err != nil {
-		logger.WithFields(fields).WithError(err).Error("failed to execute event handler")
-	} else {
-		fields["bytes"] = bytes
-		logger.WithFields(fields).Info("event socket handler executed")
+	if err = conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+		return conn, 0, err
 	}
-
-	return conn, nil
+	bytes, err = conn.Write(data)
+	return conn, bytes, err
 }
 
 func (p *Pipelined) grpcHandler(ext *types.Extension, evt *types.Event, mutated []byte) (rpc.HandleEventResponse, error) {
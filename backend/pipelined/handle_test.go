@@ -9,8 +9,11 @@ import (
 	"net"
 	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/sensu/sensu-go/backend/messaging"
 	storre "github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -99,6 +102,205 @@ func TestPipelinedHandleEvent(t *testing.T) {
 	m.AssertCalled(t, "HandleEvent", event, mock.Anything)
 }
 
+func TestPipelinedFailingDependency(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store}
+
+	event := types.FixtureEvent("app01", "app")
+	event.Check.Status = 2
+	event.Check.DependsOn = []string{"disk", "db01/postgres"}
+
+	passing := types.FixtureEvent("app01", "disk")
+	failing := types.FixtureEvent("db01", "postgres")
+	failing.Check.Status = 2
+	store.On("GetEventByEntityCheck", mock.Anything, "app01", "disk").Return(passing, nil)
+	store.On("GetEventByEntityCheck", mock.Anything, "db01", "postgres").Return(failing, nil).Once()
+	assert.Equal(t, "db01/postgres", p.failingDependency(context.Background(), event))
+
+	// Dependencies which don't exist are passing
+	store.On("GetEventByEntityCheck", mock.Anything, "db01", "postgres").Return((*types.Event)(nil), nil)
+	assert.Equal(t, "", p.failingDependency(context.Background(), event))
+
+	// Resolutions are always handled
+	event.Check.Status = 0
+	assert.Equal(t, "", p.failingDependency(context.Background(), event))
+}
+
+func TestPipelinedHandleEventSuppressedByDependency(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store}
+
+	event := types.FixtureEvent("app01", "app")
+	event.Check.Status = 2
+	event.Check.Handlers = []string{"handler1"}
+	event.Check.DependsOn = []string{"db01/postgres"}
+
+	failing := types.FixtureEvent("db01", "postgres")
+	failing.Check.Status = 2
+	store.On("GetEventByEntityCheck", mock.Anything, "db01", "postgres").Return(failing, nil)
+
+	// The handlers of the check are not retrieved
+	assert.NoError(t, p.handleEvent(event))
+	store.AssertNotCalled(t, "GetHandlerByName", mock.Anything, "handler1")
+}
+
+type resultSubscriber chan interface{}
+
+func (s resultSubscriber) Receiver() chan<- interface{} {
+	return s
+}
+
+func TestPipelinedHandleEventPublishesResults(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	results := make(resultSubscriber, 1)
+	_, err = bus.Subscribe(messaging.TopicHandlerResult, "test", results)
+	require.NoError(t, err)
+
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store, bus: bus}
+
+	event := &types.Event{
+		Entity: types.FixtureEntity("entity1"),
+		Check:  types.FixtureCheck("check1"),
+	}
+	event.Check.Handlers = []string{"extension1"}
+	event.RequestID = "abc"
+	extension := &types.Extension{URL: "http://127.0.0.1"}
+	store.On("GetHandlerByName", mock.Anything, "extension1").Return((*types.Handler)(nil), nil)
+	store.On("GetExtension", mock.Anything, "extension1").Return(extension, nil)
+	m := &mockExec{}
+	m.On("HandleEvent", event, mock.Anything).Return(rpc.HandleEventResponse{Error: "boom"}, nil)
+	p.extensionExecutor = func(*types.Extension) (rpc.ExtensionExecutor, error) {
+		return m, nil
+	}
+	store.On("CreateError", mock.Anything, mock.Anything).Return(nil)
+
+	assert.NoError(t, p.handleEvent(event))
+	msg := <-results
+	require.IsType(t, &types.HandlerResult{}, msg)
+	result := msg.(*types.HandlerResult)
+	assert.Equal(t, "http://127.0.0.1", result.Handler)
+	assert.Equal(t, "grpc", result.Type)
+	assert.Equal(t, "entity1", result.Entity)
+	assert.Equal(t, "check1", result.Check)
+	assert.False(t, result.Success)
+	assert.Equal(t, "boom", result.Error)
+	assert.Equal(t, "abc", result.RequestID)
+}
+
+func TestPipelinedHandleEventRetries(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store, bus: bus, stopping: make(chan struct{}), wg: &sync.WaitGroup{}}
+
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Handlers = []string{"handler1"}
+	handler := types.FixtureHandler("handler1")
+	handler.Type = "grpc"
+	handler.MaxAttempts = 2
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+	m := &mockExec{}
+	m.On("HandleEvent", event, mock.Anything).Return(rpc.HandleEventResponse{Error: "boom"}, nil).Once()
+	m.On("HandleEvent", event, mock.Anything).Return(rpc.HandleEventResponse{Output: "ok"}, nil).Once()
+	p.extensionExecutor = func(*types.Extension) (rpc.ExtensionExecutor, error) {
+		return m, nil
+	}
+
+	// The retry is executed in the background
+	assert.NoError(t, p.handleEvent(event))
+	m.AssertNumberOfCalls(t, "HandleEvent", 1)
+	p.wg.Wait()
+	m.AssertNumberOfCalls(t, "HandleEvent", 2)
+	store.AssertNotCalled(t, "CreateError", mock.Anything, mock.Anything)
+}
+
+func TestPipelinedFailingHandlerDoesNotBlockPipelines(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store, stopping: make(chan struct{}), wg: &sync.WaitGroup{}}
+
+	failing := types.FixtureHandler("failing")
+	failing.Type = "grpc"
+	failing.MaxAttempts = 3
+	failing.RetryBackoff = 60
+	healthy := types.FixtureHandler("healthy")
+	healthy.Type = "grpc"
+	store.On("GetHandlerByName", mock.Anything, "failing").Return(failing, nil)
+	store.On("GetHandlerByName", mock.Anything, "healthy").Return(healthy, nil)
+	store.On("CreateError", mock.Anything, mock.Anything).Return(nil)
+
+	failingEvent := types.FixtureEvent("entity1", "check1")
+	failingEvent.Check.Handlers = []string{"failing"}
+	healthyEvent := types.FixtureEvent("entity1", "check2")
+	healthyEvent.Check.Handlers = []string{"healthy"}
+
+	handled := make(chan struct{})
+	m := &mockExec{}
+	m.On("HandleEvent", failingEvent, mock.Anything).Return(rpc.HandleEventResponse{Error: "boom"}, nil)
+	m.On("HandleEvent", healthyEvent, mock.Anything).Return(rpc.HandleEventResponse{}, nil).
+		Run(func(mock.Arguments) { close(handled) })
+	p.extensionExecutor = func(*types.Extension) (rpc.ExtensionExecutor, error) {
+		return m, nil
+	}
+
+	// A single pipeline handles both events, while the failing handler waits
+	// to be retried
+	events := make(chan interface{}, 2)
+	p.createPipelines(1, events)
+	events <- failingEvent
+	events <- healthyEvent
+
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not handled while a failing handler waits to be retried")
+	}
+
+	// The pending retry is abandoned once stopped, and its failure stored
+	close(p.stopping)
+	p.wg.Wait()
+	m.AssertNumberOfCalls(t, "HandleEvent", 2)
+	store.AssertCalled(t, "CreateError", mock.Anything, mock.MatchedBy(func(perr *types.Error) bool {
+		return perr.Name == "failing" && perr.Event.Check.Name == "check1"
+	}))
+}
+
+func TestPipelinedHandleEventDeadLetter(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store, bus: bus, stopping: make(chan struct{})}
+
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Handlers = []string{"handler1"}
+	handler := types.FixtureHandler("handler1")
+	handler.Type = "grpc"
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+	m := &mockExec{}
+	m.On("HandleEvent", event, mock.Anything).Return(rpc.HandleEventResponse{Error: "boom"}, nil)
+	p.extensionExecutor = func(*types.Extension) (rpc.ExtensionExecutor, error) {
+		return m, nil
+	}
+	store.On("CreateError", mock.Anything, mock.Anything).Return(nil)
+
+	assert.NoError(t, p.handleEvent(event))
+	m.AssertNumberOfCalls(t, "HandleEvent", 1)
+	store.AssertCalled(t, "CreateError", mock.Anything, mock.MatchedBy(func(perr *types.Error) bool {
+		return perr.Name == "handler1" && perr.Message == "handler1: boom" &&
+			perr.Component == "pipelined" && perr.Event.Check.Name == "check1"
+	}))
+}
+
 func TestPipelinedExpandHandlers(t *testing.T) {
 	p := &Pipelined{}
 	store := &mockstore.MockStore{}
@@ -109,7 +311,7 @@ func TestPipelinedExpandHandlers(t *testing.T) {
 
 	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler1, nil)
 
-	oneLevel, err := p.expandHandlers(ctx, []string{"handler1"}, 1)
+	oneLevel, err := p.expandHandlers(ctx, []string{"handler1"}, nil)
 	assert.NoError(t, err)
 
 	expanded := map[string]handlerExtensionUnion{"handler1": {Handler: handler1}}
@@ -132,7 +334,7 @@ func TestPipelinedExpandHandlers(t *testing.T) {
 	store.On("GetExtension", mock.Anything, "handler3").Return(&types.Extension{URL: "http://localhost"}, nil)
 	store.On("GetExtension", mock.Anything, "handler4").Return(&types.Extension{URL: "http://localhost"}, nil)
 
-	twoLevels, err := p.expandHandlers(ctx, []string{"handler3"}, 1)
+	twoLevels, err := p.expandHandlers(ctx, []string{"handler3"}, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expanded, twoLevels)
 
@@ -141,13 +343,80 @@ func TestPipelinedExpandHandlers(t *testing.T) {
 	handler4.Handlers = []string{"handler2", "handler3"}
 
 	store.On("GetHandlerByName", mock.Anything, "handler4").Return(handler4, nil)
-	threeLevels, err := p.expandHandlers(ctx, []string{"handler4"}, 1)
+	threeLevels, err := p.expandHandlers(ctx, []string{"handler4"}, nil)
 
 	assert.NoError(t, err)
 
 	assert.Equal(t, expanded, threeLevels)
 }
 
+func TestPipelinedExpandHandlersCycles(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store}
+
+	handler1 := types.FixtureHandler("handler1")
+	set1 := types.FixtureSetHandler("set1", "handler1", "set2")
+	set2 := types.FixtureSetHandler("set2", "set1", "handler1")
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, handler1.Organization)
+
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler1, nil)
+	store.On("GetHandlerByName", mock.Anything, "set1").Return(set1, nil)
+	store.On("GetHandlerByName", mock.Anything, "set2").Return(set2, nil)
+
+	expanded, err := p.expandHandlers(ctx, []string{"set1", "handler1"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]handlerExtensionUnion{"handler1": {Handler: handler1}}, expanded)
+
+	// Sets nested too deeply are not expanded
+	handler2 := types.FixtureHandler("handler2")
+	set3 := types.FixtureSetHandler("set3", "set4")
+	set4 := types.FixtureSetHandler("set4", "set5")
+	set5 := types.FixtureSetHandler("set5", "handler2")
+	store.On("GetHandlerByName", mock.Anything, "handler2").Return(handler2, nil)
+	store.On("GetHandlerByName", mock.Anything, "set3").Return(set3, nil)
+	store.On("GetHandlerByName", mock.Anything, "set4").Return(set4, nil)
+	store.On("GetHandlerByName", mock.Anything, "set5").Return(set5, nil)
+
+	expanded, err = p.expandHandlers(ctx, []string{"set4"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]handlerExtensionUnion{"handler2": {Handler: handler2}}, expanded)
+
+	expanded, err = p.expandHandlers(ctx, []string{"set3"}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, expanded)
+}
+
+func TestPipelinedExpandHandlersSubdued(t *testing.T) {
+	p := &Pipelined{}
+	store := &mockstore.MockStore{}
+	p.store = store
+
+	subdue := &types.TimeWindowWhen{
+		Days: types.TimeWindowDays{
+			All: []*types.TimeWindowTimeRange{
+				{Begin: "12:00AM", End: "12:00PM"},
+				{Begin: "12:00PM", End: "12:00AM"},
+			},
+		},
+	}
+	handler1 := types.FixtureHandler("handler1")
+	handler1.Subdue = subdue
+	handler2 := types.FixtureHandler("handler2")
+	set := types.FixtureHandler("set")
+	set.Type = "set"
+	set.Handlers = []string{"handler2"}
+	set.Subdue = subdue
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, handler1.Organization)
+
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler1, nil)
+	store.On("GetHandlerByName", mock.Anything, "handler2").Return(handler2, nil)
+	store.On("GetHandlerByName", mock.Anything, "set").Return(set, nil)
+
+	expanded, err := p.expandHandlers(ctx, []string{"handler1", "handler2", "set"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]handlerExtensionUnion{"handler2": {Handler: handler2}}, expanded)
+}
+
 func TestPipelinedPipeHandler(t *testing.T) {
 	p := &Pipelined{}
 
@@ -157,13 +426,38 @@ func TestPipelinedPipeHandler(t *testing.T) {
 	event := &types.Event{}
 	eventData, _ := json.Marshal(event)
 
-	handlerExec, err := p.pipeHandler(handler, eventData)
+	handlerExec, err := p.pipeHandler(handler, event, eventData)
 
 	assert.NoError(t, err)
 	assert.Equal(t, string(eventData[:]), handlerExec.Output)
 	assert.Equal(t, 0, handlerExec.Status)
 }
 
+func TestPipelinedPipeHandlerSecrets(t *testing.T) {
+	require.NoError(t, os.Setenv("SENSU_TEST_HANDLER_SECRET", "hunter2"))
+	defer os.Unsetenv("SENSU_TEST_HANDLER_SECRET")
+
+	secret := types.FixtureSecret("db-password")
+	secret.ID = "SENSU_TEST_HANDLER_SECRET"
+	store := &mockstore.MockStore{}
+	store.On("GetSecretByName", mock.Anything, "db-password").Return(secret, nil)
+	store.On("GetSecretByName", mock.Anything, "missing").Return((*types.Secret)(nil), nil)
+	p := &Pipelined{store: store}
+
+	handler := types.FixtureHandler("handler1")
+	handler.Command = "printenv PASSWORD"
+	handler.Secrets = []types.SecretReference{{Name: "PASSWORD", Secret: "db-password"}}
+
+	handlerExec, err := p.pipeHandler(handler, &types.Event{}, []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2\n", handlerExec.Output)
+
+	// The handlers whose secrets can't be resolved are not executed
+	handler.Secrets = []types.SecretReference{{Name: "PASSWORD", Secret: "missing"}}
+	_, err = p.pipeHandler(handler, &types.Event{}, []byte("{}"))
+	assert.Error(t, err)
+}
+
 func TestPipelinedTcpHandler(t *testing.T) {
 	ready := make(chan struct{})
 	done := make(chan struct{})
@@ -220,6 +514,56 @@ func TestPipelinedTcpHandler(t *testing.T) {
 	<-done
 }
 
+func TestPipelinedTcpHandlerReconnect(t *testing.T) {
+	// Find a free port, on which nothing listens until the handler reconnects
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().(*net.TCPAddr)
+	require.NoError(t, listener.Close())
+
+	p := &Pipelined{}
+	handler := &types.Handler{
+		Type: "tcp",
+		Socket: &types.HandlerSocket{
+			Host:              "127.0.0.1",
+			Port:              uint32(address.Port),
+			ReconnectAttempts: 2,
+			ReconnectInterval: 1,
+		},
+	}
+	eventData := []byte(`{}`)
+
+	// Fail to send the event without reconnect attempts
+	handler.Socket.ReconnectAttempts = 0
+	_, err = p.socketHandler(handler, eventData)
+	assert.Error(t, err)
+	handler.Socket.ReconnectAttempts = 2
+
+	received := make(chan []byte, 1)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		listener, err := net.Listen("tcp", address.String())
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer listener.Close()
+
+		conn, err := listener.Accept()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		buffer, err := ioutil.ReadAll(conn)
+		assert.NoError(t, err)
+		received <- buffer
+	}()
+
+	_, err = p.socketHandler(handler, eventData)
+	require.NoError(t, err)
+	assert.Equal(t, eventData, <-received)
+}
+
 func TestPipelinedUdpHandler(t *testing.T) {
 	ready := make(chan struct{})
 	done := make(chan struct{})
@@ -5,13 +5,21 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/types"
 	utillogging "github.com/sensu/sensu-go/util/logging"
 )
 
+// DefaultMutatorTimeout specifies the default time in seconds a pipe mutator
+// command may run before it is killed, along with its child processes, for
+// mutators which do not specify a timeout.
+const DefaultMutatorTimeout uint32 = 60
+
 // mutateEvent mutates (transforms) a Sensu event into a serialized
 // format (byte slice) to be provided to a Sensu event handler.
 func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]byte, error) {
@@ -30,7 +38,22 @@ func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]b
 		return eventData, nil
 	}
 
-	if handler.Mutator == "only_check_output" {
+	switch handler.Mutator {
+	case "json":
+		eventData, err := p.jsonMutator(event)
+		if err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to mutate event")
+			return nil, err
+		}
+		return eventData, nil
+	case "pretty_json":
+		eventData, err := p.prettyJSONMutator(event)
+		if err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to mutate event")
+			return nil, err
+		}
+		return eventData, nil
+	case "only_check_output":
 		if event.HasCheck() {
 			eventData := p.onlyCheckOutputMutator(event)
 			return eventData, nil
@@ -77,8 +100,9 @@ func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]b
 	return eventData, nil
 }
 
-// jsonMutator produces the JSON encoding of the Sensu event. This
-// mutator is used when a Sensu handler does not specify one.
+// jsonMutator produces the compact JSON encoding of the Sensu event. This
+// mutator is used when a Sensu handler does not specify one, or specifies the
+// built-in "json" mutator.
 func (p *Pipelined) jsonMutator(event *types.Event) ([]byte, error) {
 	eventData, err := json.Marshal(event)
 
@@ -89,6 +113,18 @@ func (p *Pipelined) jsonMutator(event *types.Event) ([]byte, error) {
 	return eventData, nil
 }
 
+// prettyJSONMutator produces the indented JSON encoding of the Sensu event,
+// followed by a line break, for handlers whose output is read by humans.
+func (p *Pipelined) prettyJSONMutator(event *types.Event) ([]byte, error) {
+	eventData, err := json.MarshalIndent(event, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(eventData, '\n'), nil
+}
+
 // onlyCheckOutputMutator returns only the check output from the Sensu
 // event. This mutator is considered to be "built-in" (1.x parity), it
 // is most commonly used by tcp/udp handlers (e.g. influxdb). This
@@ -106,10 +142,29 @@ func (p *Pipelined) onlyCheckOutputMutator(event *types.Event) []byte {
 // STDIN, and captures the command output (STDOUT/ERR) to be used as
 // the mutated event data for a Sensu event handler.
 func (p *Pipelined) pipeMutator(mutator *types.Mutator, event *types.Event) ([]byte, error) {
+	timeout := mutator.Timeout
+	if timeout == 0 {
+		timeout = DefaultMutatorTimeout
+	}
+
+	var assets *assetmanager.RuntimeAssetSet
+	if len(mutator.RuntimeAssets) > 0 {
+		var err error
+		if assets, err = p.installRuntimeAssets(mutator, mutator.RuntimeAssets, event); err != nil {
+			return nil, fmt.Errorf("could not install the runtime assets of the mutator: %s", err)
+		}
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), mutator)
+	secretVars, err := secrets.Resolve(ctx, p.store, mutator.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve the secrets of the mutator: %s", err)
+	}
+
 	mutatorExec := &command.Execution{}
 	mutatorExec.Command = mutator.Command
-	mutatorExec.Timeout = int(mutator.Timeout)
-	mutatorExec.Env = mutator.EnvVars
+	mutatorExec.Timeout = int(timeout)
+	mutatorExec.Env = commandEnv(assets, mutator.EnvVars, secretVars)
 
 	eventData, err := json.Marshal(event)
 	if err != nil {
@@ -122,6 +177,8 @@ func (p *Pipelined) pipeMutator(mutator *types.Mutator, event *types.Event) ([]b
 
 	if err != nil {
 		return nil, err
+	} else if result.Status == command.TimeoutExitStatus && result.Output == command.TimeoutOutput {
+		return nil, fmt.Errorf("pipe mutator execution timed out after %ds", timeout)
 	} else if result.Status != 0 {
 		return nil, errors.New("pipe mutator execution returned non-zero exit status")
 	}
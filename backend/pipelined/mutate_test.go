@@ -8,6 +8,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -28,6 +29,8 @@ func TestHelperMutatorProcess(t *testing.T) {
 	switch command {
 	case "cat":
 		fmt.Fprintf(os.Stdout, "%s", stdin)
+	case "env":
+		fmt.Fprintf(os.Stdout, "%s:%s", os.Getenv("API_TOKEN"), os.Getenv("PATH"))
 	}
 	os.Exit(0)
 }
@@ -63,6 +66,41 @@ func TestPipelinedJsonMutator(t *testing.T) {
 	assert.Equal(t, expected, output)
 }
 
+func TestPipelinedPrettyJSONMutator(t *testing.T) {
+	p, err := New(Config{Store: nil, Bus: nil})
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("entity1", "check1")
+
+	output, err := p.prettyJSONMutator(event)
+	require.NoError(t, err)
+
+	expected, _ := json.MarshalIndent(event, "", "  ")
+	assert.Equal(t, append(expected, '\n'), output)
+}
+
+func TestPipelinedBuiltinMutate(t *testing.T) {
+	p, err := New(Config{Store: nil, Bus: nil})
+	require.NoError(t, err)
+
+	handler := types.FakeHandlerCommand("cat")
+	handler.Type = "pipe"
+	event := types.FixtureEvent("entity1", "check1")
+
+	// Built-in mutators are not looked up in the store
+	handler.Mutator = "json"
+	eventData, err := p.mutateEvent(handler, event)
+	require.NoError(t, err)
+	expected, _ := json.Marshal(event)
+	assert.Equal(t, expected, eventData)
+
+	handler.Mutator = "pretty_json"
+	eventData, err = p.mutateEvent(handler, event)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(eventData), "{\n  \""))
+	assert.True(t, strings.HasSuffix(string(eventData), "}\n"))
+}
+
 func TestPipelinedOnlyCheckOutputMutator(t *testing.T) {
 	p, err := New(Config{Store: nil, Bus: nil})
 	require.NoError(t, err)
@@ -137,3 +175,33 @@ func TestPipelinedPipeMutator(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, output)
 }
+
+func TestPipelinedPipeMutatorEnvVars(t *testing.T) {
+	p, err := New(Config{Store: nil, Bus: nil})
+	require.NoError(t, err)
+
+	mutator := types.FakeMutatorCommand("env")
+	mutator.EnvVars = append(mutator.EnvVars, "API_TOKEN=c2VjcmV0=")
+
+	output, err := p.pipeMutator(mutator, &types.Event{})
+	require.NoError(t, err)
+
+	// The environment of the backend is inherited
+	assert.Equal(t, "c2VjcmV0=:"+os.Getenv("PATH"), string(output))
+}
+
+func TestPipelinedPipeMutatorTimeout(t *testing.T) {
+	p, err := New(Config{Store: nil, Bus: nil})
+	require.NoError(t, err)
+
+	// The background process keeps the output open until it is killed too
+	mutator := types.FixtureMutator("sleep")
+	mutator.Command = "sleep 10 & wait"
+	mutator.Timeout = 1
+
+	started := time.Now()
+	_, err = p.pipeMutator(mutator, &types.Event{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	assert.True(t, time.Since(started) < 5*time.Second)
+}
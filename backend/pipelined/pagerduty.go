@@ -0,0 +1,134 @@
+package pipelined
+
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPagerDutySummaryTemplate is the template of the summary of the
+	// incidents of PagerDuty handlers which do not specify one.
+	DefaultPagerDutySummaryTemplate = "{{ .Entity.ID }}/{{ .Check.Name }}: {{ .Check.Output }}"
+
+	// PagerDuty Events API v2 actions
+	pagerDutyTrigger     = "trigger"
+	pagerDutyAcknowledge = "acknowledge"
+	pagerDutyResolve     = "resolve"
+)
+
+// pagerDutyEventsURL is the endpoint of the PagerDuty Events API v2.
+var pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDutySeverities are the severities of incidents, by check status.
+var pagerDutySeverities = map[uint32]string{
+	1: "warning",
+	2: "critical",
+}
+
+// pagerDutyEvent is the payload of the PagerDuty Events API v2.
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+// pagerDutyPayload describes the incident of triggered events.
+type pagerDutyPayload struct {
+	Summary       string                 `json:"summary"`
+	Source        string                 `json:"source"`
+	Severity      string                 `json:"severity"`
+	Timestamp     string                 `json:"timestamp,omitempty"`
+	Component     string                 `json:"component,omitempty"`
+	CustomDetails map[string]interface{} `json:"custom_details,omitempty"`
+}
+
+// pagerDutyHandler triggers, acknowledges or resolves the PagerDuty incident
+// of the entity and check of the event, depending on the status of the check,
+// with the Events API v2. Incidents are triggered for failing checks,
+// acknowledged while silenced, and resolved once the check passes again.
+func (p *Pipelined) pagerDutyHandler(handler *types.Handler, event *types.Event) error {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"environment":  handler.Environment,
+		"organization": handler.Organization,
+		"handler":      handler.Name,
+	}
+
+	if err := handler.PagerDuty.Validate(); err != nil {
+		return err
+	}
+
+	action := pagerDutyAction(event)
+	if action == "" {
+		logger.WithFields(fields).Debug("no pagerduty incident to update")
+		return nil
+	}
+	fields["action"] = action
+
+	pdEvent := pagerDutyEvent{
+		RoutingKey:  handler.PagerDuty.RoutingKey,
+		EventAction: action,
+		DedupKey:    pagerDutyDedupKey(event),
+	}
+	if action == pagerDutyTrigger {
+		summary, err := executeEventTemplate(handler.PagerDuty.SummaryTemplate, DefaultPagerDutySummaryTemplate, event)
+		if err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to execute pagerduty summary template")
+			return err
+		}
+		pdEvent.Payload = &pagerDutyPayload{
+			Summary:   summary,
+			Source:    event.Entity.ID,
+			Severity:  pagerDutySeverity(event),
+			Component: event.Check.Name,
+			CustomDetails: map[string]interface{}{
+				"status":      event.Check.Status,
+				"output":      event.Check.Output,
+				"occurrences": event.Check.Occurrences,
+			},
+		}
+		if event.Timestamp > 0 {
+			pdEvent.Payload.Timestamp = time.Unix(event.Timestamp, 0).UTC().Format(time.RFC3339)
+		}
+	}
+
+	if err := postWebhook(handler, pagerDutyEventsURL, pdEvent); err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to execute event handler")
+		return err
+	}
+
+	logger.WithFields(fields).Info("event pagerduty handler executed")
+	return nil
+}
+
+// pagerDutyAction returns the action to take on the incident of the given
+// event, or an empty string if the incident is left as is.
+func pagerDutyAction(event *types.Event) string {
+	switch {
+	case event.IsResolution():
+		return pagerDutyResolve
+	case !event.IsIncident():
+		return ""
+	case event.IsSilenced():
+		return pagerDutyAcknowledge
+	default:
+		return pagerDutyTrigger
+	}
+}
+
+// pagerDutyDedupKey returns the key identifying the incident of the given
+// event, one per entity and check.
+func pagerDutyDedupKey(event *types.Event) string {
+	return event.Entity.ID + "/" + event.Check.Name
+}
+
+// pagerDutySeverity returns the severity of the incident of the given event.
+func pagerDutySeverity(event *types.Event) string {
+	if severity, ok := pagerDutySeverities[event.Check.Status]; ok {
+		return severity
+	}
+	return "error"
+}
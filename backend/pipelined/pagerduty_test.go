@@ -0,0 +1,93 @@
+package pipelined
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelinedPagerDutyHandler(t *testing.T) {
+	events := make(chan pagerDutyEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	defer func(url string) { pagerDutyEventsURL = url }(pagerDutyEventsURL)
+	pagerDutyEventsURL = server.URL
+
+	p := &Pipelined{}
+	handler := types.FixturePagerDutyHandler("pagerduty", "abc")
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Status = 2
+	event.Check.Output = "disk full"
+
+	// Incidents are triggered for failing checks
+	require.NoError(t, p.pagerDutyHandler(handler, event))
+	pdEvent := <-events
+	assert.Equal(t, "abc", pdEvent.RoutingKey)
+	assert.Equal(t, "trigger", pdEvent.EventAction)
+	assert.Equal(t, "entity1/check1", pdEvent.DedupKey)
+	require.NotNil(t, pdEvent.Payload)
+	assert.Equal(t, "entity1/check1: disk full", pdEvent.Payload.Summary)
+	assert.Equal(t, "entity1", pdEvent.Payload.Source)
+	assert.Equal(t, "critical", pdEvent.Payload.Severity)
+
+	// Acknowledged while silenced
+	event.Check.Silenced = []string{"entity:entity1:check1"}
+	require.NoError(t, p.pagerDutyHandler(handler, event))
+	pdEvent = <-events
+	assert.Equal(t, "acknowledge", pdEvent.EventAction)
+	assert.Nil(t, pdEvent.Payload)
+
+	// And resolved once passing again
+	event.Check.History = append(event.Check.History, types.CheckHistory{Status: 2})
+	event.Check.Status = 0
+	require.NoError(t, p.pagerDutyHandler(handler, event))
+	pdEvent = <-events
+	assert.Equal(t, "resolve", pdEvent.EventAction)
+	assert.Equal(t, "entity1/check1", pdEvent.DedupKey)
+}
+
+func TestPipelinedPagerDutyHandlerFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":"invalid event"}`))
+	}))
+	defer server.Close()
+	defer func(url string) { pagerDutyEventsURL = url }(pagerDutyEventsURL)
+	pagerDutyEventsURL = server.URL
+
+	p := &Pipelined{}
+	handler := types.FixturePagerDutyHandler("pagerduty", "abc")
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Status = 1
+
+	err := p.pagerDutyHandler(handler, event)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid event")
+}
+
+func TestPagerDutyAction(t *testing.T) {
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.History = nil
+
+	// Passing checks without incident are not sent
+	assert.Equal(t, "", pagerDutyAction(event))
+
+	event.Check.Status = 1
+	assert.Equal(t, "trigger", pagerDutyAction(event))
+	assert.Equal(t, "warning", pagerDutySeverity(event))
+	event.Check.Status = 127
+	assert.Equal(t, "error", pagerDutySeverity(event))
+}
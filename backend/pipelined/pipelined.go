@@ -4,6 +4,7 @@ package pipelined
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
@@ -35,6 +36,10 @@ type Pipelined struct {
 	store             store.Store
 	bus               messaging.MessageBus
 	extensionExecutor ExtensionExecutorGetterFunc
+	assetCacheDir     string
+	assetCacheMaxSize int64
+	assetCacheMaxAge  time.Duration
+	assetMirror       string
 }
 
 // Config configures a Pipelined.
@@ -42,6 +47,19 @@ type Config struct {
 	Store                   store.Store
 	Bus                     messaging.MessageBus
 	ExtensionExecutorGetter ExtensionExecutorGetterFunc
+
+	// AssetCacheDir is the directory where the runtime assets of filters,
+	// handlers and mutators are installed.
+	AssetCacheDir string
+
+	// AssetCacheMaxSize and AssetCacheMaxAge limit the size of the asset cache
+	// and the time unused assets are kept in it; zero disables the limit.
+	AssetCacheMaxSize int64
+	AssetCacheMaxAge  time.Duration
+
+	// AssetMirror is the base URL runtime assets are downloaded from instead of
+	// their own URL, if set.
+	AssetMirror string
 }
 
 // Option is a functional option used to configure Pipelined.
@@ -53,6 +71,10 @@ func New(c Config, options ...Option) (*Pipelined, error) {
 		store:             c.Store,
 		bus:               c.Bus,
 		extensionExecutor: c.ExtensionExecutorGetter,
+		assetCacheDir:     c.AssetCacheDir,
+		assetCacheMaxSize: c.AssetCacheMaxSize,
+		assetCacheMaxAge:  c.AssetCacheMaxAge,
+		assetMirror:       c.AssetMirror,
 		stopping:          make(chan struct{}, 1),
 		running:           &atomic.Value{},
 		wg:                &sync.WaitGroup{},
@@ -83,6 +105,11 @@ func (p *Pipelined) Start() error {
 
 	p.createPipelines(PipelineCount, p.eventChan)
 
+	if p.assetCacheMaxSize > 0 || p.assetCacheMaxAge > 0 {
+		p.wg.Add(1)
+		go p.collectAssetGarbage()
+	}
+
 	return nil
 }
 
@@ -0,0 +1,85 @@
+package pipelined
+
+import (
+	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultSlackTemplate is the template of the messages posted by Slack
+	// handlers which do not specify one.
+	DefaultSlackTemplate = "{{ .Entity.ID }}{{ with .Check }}/{{ .Name }}: {{ .Output }}{{ end }}"
+
+	// slackUnknownColor is the color of the messages of events whose check
+	// status is unknown, or which have no check.
+	slackUnknownColor = "#808080"
+)
+
+// slackColors are the colors of the messages of events, by check status.
+var slackColors = map[uint32]string{
+	0: "good",
+	1: "warning",
+	2: "danger",
+}
+
+// slackMessage is the payload posted to Slack incoming webhooks.
+type slackMessage struct {
+	Channel     string            `json:"channel,omitempty"`
+	Username    string            `json:"username,omitempty"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+// slackAttachment is a message attachment, which is colored unlike a plain
+// text message.
+type slackAttachment struct {
+	Fallback string `json:"fallback"`
+	Color    string `json:"color"`
+	Text     string `json:"text"`
+}
+
+// slackHandler posts a message describing the event, colored according to the
+// status of its check, to the incoming webhook of a Sensu Slack handler.
+func (p *Pipelined) slackHandler(handler *types.Handler, event *types.Event) error {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"environment":  handler.Environment,
+		"organization": handler.Organization,
+		"handler":      handler.Name,
+	}
+
+	if err := handler.Slack.Validate(); err != nil {
+		return err
+	}
+
+	text, err := executeEventTemplate(handler.Slack.Template, DefaultSlackTemplate, event)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to execute slack message template")
+		return err
+	}
+
+	message := slackMessage{
+		Channel:  handler.Slack.Channel,
+		Username: handler.Slack.Username,
+		Attachments: []slackAttachment{
+			{Fallback: text, Color: slackColor(event), Text: text},
+		},
+	}
+	if err := postWebhook(handler, handler.Slack.WebhookURL, message); err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to execute event handler")
+		return err
+	}
+
+	logger.WithFields(fields).Info("event slack handler executed")
+	return nil
+}
+
+// slackColor returns the color of the message of the given event.
+func slackColor(event *types.Event) string {
+	if !event.HasCheck() {
+		return slackUnknownColor
+	}
+	if color, ok := slackColors[event.Check.Status]; ok {
+		return color
+	}
+	return slackUnknownColor
+}
@@ -0,0 +1,79 @@
+package pipelined
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelinedSlackHandler(t *testing.T) {
+	messages := make(chan slackMessage, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message slackMessage
+		if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		messages <- message
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	p := &Pipelined{}
+	handler := types.FixtureSlackHandler("slack", server.URL)
+	handler.Slack.Channel = "#alerts"
+	handler.Slack.Username = "sensu"
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Status = 2
+	event.Check.Output = "disk full"
+
+	require.NoError(t, p.slackHandler(handler, event))
+	message := <-messages
+	assert.Equal(t, "#alerts", message.Channel)
+	assert.Equal(t, "sensu", message.Username)
+	require.Len(t, message.Attachments, 1)
+	assert.Equal(t, "entity1/check1: disk full", message.Attachments[0].Text)
+	assert.Equal(t, "danger", message.Attachments[0].Color)
+
+	handler.Slack.Template = "{{ .Check.Name }} is {{ .Check.Status }}"
+	event.Check.Status = 0
+	require.NoError(t, p.slackHandler(handler, event))
+	message = <-messages
+	assert.Equal(t, "check1 is 0", message.Attachments[0].Text)
+	assert.Equal(t, "good", message.Attachments[0].Color)
+}
+
+func TestPipelinedSlackHandlerFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("no_service"))
+	}))
+	defer server.Close()
+
+	p := &Pipelined{}
+	handler := types.FixtureSlackHandler("slack", server.URL)
+	event := types.FixtureEvent("entity1", "check1")
+
+	err := p.slackHandler(handler, event)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no_service")
+
+	// Templates are executed with the event
+	handler.Slack.Template = "{{ .Nope }}"
+	assert.Error(t, p.slackHandler(handler, event))
+}
+
+func TestSlackColor(t *testing.T) {
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Status = 1
+	assert.Equal(t, "warning", slackColor(event))
+	event.Check.Status = 3
+	assert.Equal(t, slackUnknownColor, slackColor(event))
+	event.Check = nil
+	assert.Equal(t, slackUnknownColor, slackColor(event))
+}
@@ -0,0 +1,65 @@
+package pipelined
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DefaultWebhookTimeout specifies the default time in seconds waited for the
+// services of Slack and PagerDuty handlers to respond.
+const DefaultWebhookTimeout uint32 = 10
+
+// postWebhook posts the given payload as JSON to the given URL of a service
+// of the given handler, within the timeout of the handler. Responses with an
+// unsuccessful status are returned as errors, along with the beginning of
+// their body.
+func postWebhook(handler *types.Handler, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	timeout := handler.Timeout
+	if timeout == 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	client := &http.Client{Timeout: time.Duration(timeout) * time.Second}
+
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s responded %s: %s", handler.Type, resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// executeEventTemplate returns the given Go template, or the given default
+// one when empty, executed with the given event.
+func executeEventTemplate(text, defaultText string, event *types.Event) (string, error) {
+	if text == "" {
+		text = defaultText
+	}
+	tmpl, err := template.New("").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
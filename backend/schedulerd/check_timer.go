@@ -5,7 +5,7 @@ import (
 	"encoding/binary"
 	"time"
 
-	"github.com/robfig/cron"
+	"github.com/sensu/sensu-go/types"
 )
 
 // A CheckTimer handles starting and stopping timers for a given check
@@ -148,7 +148,7 @@ func (timerPtr *CronTimer) Stop() {
 // NextCronTime calculates how much time is between the current time and the
 // time indidcated by the cron string
 func NextCronTime(now time.Time, cronStr string) (time.Duration, error) {
-	schedule, err := cron.ParseStandard(cronStr)
+	schedule, err := types.ParseCron(cronStr)
 	if err != nil {
 		return 0, err
 	}
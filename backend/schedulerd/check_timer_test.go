@@ -22,6 +22,14 @@ func TestNextCronTime(t *testing.T) {
 	assert.True(t, nextCron >= 0)
 	assert.True(t, now.Add(nextCron).Minute() == 0)
 
+	// Cron string with a time zone is evaluated in that time zone
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.Nil(t, err)
+	nextCron, err = NextCronTime(now, "CRON_TZ=Asia/Tokyo 0 9 * * *")
+	assert.Nil(t, err)
+	assert.True(t, nextCron >= 0)
+	assert.Equal(t, 9, now.Add(nextCron).In(tokyo).Hour())
+
 	// Invalid cron string will return an error
 	nextCron, err = NextCronTime(now, "invalid")
 	assert.NotNil(t, err)
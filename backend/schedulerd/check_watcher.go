@@ -60,6 +60,10 @@ func (c *CheckWatcher) startScheduler(check *types.CheckConfig) error {
 
 // Start starts the CheckWatcher.
 func (c *CheckWatcher) Start() error {
+	// Watch the checks before listing them, so that none of their changes are
+	// missed in between
+	watchChan := c.store.GetCheckConfigWatcher(c.ctx)
+
 	// for each check
 	checkConfigs, err := c.store.GetCheckConfigs(c.ctx)
 	if err != nil {
@@ -75,19 +79,18 @@ func (c *CheckWatcher) Start() error {
 		}
 	}
 
-	go c.startWatcher()
+	go c.startWatcher(watchChan)
 
 	return nil
 }
 
-func (c *CheckWatcher) startWatcher() {
-	watchChan := c.store.GetCheckConfigWatcher(c.ctx)
+func (c *CheckWatcher) startWatcher(watchChan <-chan store.WatchEventCheckConfig) {
 	for {
 		select {
 		case watchEvent, ok := <-watchChan:
 			if !ok {
-				// The watchChan has closed. Restart the watcher.
-				watchChan = c.store.GetCheckConfigWatcher(c.ctx)
+				// The watchChan is only closed once the context is cancelled
+				watchChan = nil
 				continue
 			}
 			c.handleWatchEvent(watchEvent)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sirupsen/logrus"
@@ -132,6 +133,14 @@ func (c *CheckExecutor) buildRequest(check *types.CheckConfig) (*types.CheckRequ
 		}
 	}
 
+	// Resolve the secrets of the check, which are only known to the agents
+	// executing it
+	secretVars, err := secrets.Resolve(ctx, c.store, check.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	request.Secrets = secretVars
+
 	request.Issued = time.Now().Unix()
 
 	return request, nil
@@ -238,9 +247,11 @@ func (a *AdhocRequestExecutor) publishProxyCheckRequests(entities []*types.Entit
 }
 
 func (a *AdhocRequestExecutor) execute(check *types.CheckConfig) error {
-	request, _ := a.buildRequest(check)
+	request, err := a.buildRequest(check)
+	if err != nil {
+		return err
+	}
 	request.Config = check
-	var err error
 	for _, sub := range check.Subscriptions {
 		topic := messaging.SubscriptionTopic(check.Organization, check.Environment, sub)
 		logger.WithFields(logrus.Fields{
@@ -257,7 +268,12 @@ func (a *AdhocRequestExecutor) execute(check *types.CheckConfig) error {
 }
 
 func (a *AdhocRequestExecutor) buildRequest(check *types.CheckConfig) (*types.CheckRequest, error) {
-	return &types.CheckRequest{Issued: time.Now().Unix()}, nil
+	ctx := types.SetContextFromResource(context.Background(), check)
+	secretVars, err := secrets.Resolve(ctx, a.store, check.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	return &types.CheckRequest{Secrets: secretVars, Issued: time.Now().Unix()}, nil
 }
 
 func publishProxyCheckRequests(e Executor, entities []*types.Entity, check *types.CheckConfig) error {
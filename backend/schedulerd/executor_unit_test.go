@@ -0,0 +1,46 @@
+package schedulerd
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBuildRequestSecrets(t *testing.T) {
+	require.NoError(t, os.Setenv("SENSU_TEST_CHECK_SECRET", "hunter2"))
+	defer os.Unsetenv("SENSU_TEST_CHECK_SECRET")
+
+	secret := types.FixtureSecret("db-password")
+	secret.ID = "SENSU_TEST_CHECK_SECRET"
+	store := &mockstore.MockStore{}
+	store.On("GetSecretByName", mock.Anything, "db-password").Return(secret, nil)
+	store.On("GetSecretByName", mock.Anything, "missing").Return((*types.Secret)(nil), nil)
+
+	exec := NewCheckExecutor(nil, nil, "default", "default", store)
+	check := types.FixtureCheckConfig("check1")
+	check.RuntimeAssets = nil
+	check.CheckHooks = nil
+	check.Secrets = []types.SecretReference{{Name: "PASSWORD", Secret: "db-password"}}
+
+	request, err := exec.buildRequest(check)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PASSWORD=hunter2"}, request.Secrets)
+
+	adhoc := NewAdhocRequestExecutor(context.Background(), store, &queue.Memory{}, nil)
+	defer adhoc.Stop()
+	request, err = adhoc.buildRequest(check)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PASSWORD=hunter2"}, request.Secrets)
+
+	// The checks whose secrets can't be resolved are not requested
+	check.Secrets = []types.SecretReference{{Name: "PASSWORD", Secret: "missing"}}
+	_, err = exec.buildRequest(check)
+	assert.Error(t, err)
+}
@@ -0,0 +1,20 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// EnvProvider provides the secrets held by the environment variables of the
+// backend, identified by their names.
+type EnvProvider struct{}
+
+// Get returns the value of the environment variable with the given name.
+func (EnvProvider) Get(ctx context.Context, id string) (string, error) {
+	value, ok := os.LookupEnv(id)
+	if !ok {
+		return "", fmt.Errorf("environment variable %q is not set", id)
+	}
+	return value, nil
+}
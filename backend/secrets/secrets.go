@@ -0,0 +1,74 @@
+// Package secrets resolves the secrets referenced by checks, handlers and
+// mutators into the environment variables of their commands, fetching their
+// values from the providers holding them at execution time.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Provider retrieves the values of the secrets it holds.
+type Provider interface {
+	// Get returns the value of the secret with the given ID.
+	Get(ctx context.Context, id string) (string, error)
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{
+		types.SecretProviderEnv: EnvProvider{},
+	}
+)
+
+// RegisterProvider registers the provider of the secrets with the given
+// provider name, replacing the previous one, if any. The env provider is
+// registered by default.
+func RegisterProvider(name string, provider Provider) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[name] = provider
+}
+
+func getProvider(name string) (Provider, bool) {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+	provider, ok := providers[name]
+	return provider, ok
+}
+
+// Resolve returns the values of the given secret references, as NAME=value
+// environment variables. The secrets are fetched from the organization and
+// environment of the given context.
+func Resolve(ctx context.Context, store store.SecretStore, refs []types.SecretReference) ([]string, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	vars := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		secret, err := store.GetSecretByName(ctx, ref.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch the secret %q: %s", ref.Secret, err)
+		} else if secret == nil {
+			return nil, fmt.Errorf("secret %q not found", ref.Secret)
+		}
+
+		provider, ok := getProvider(secret.Provider)
+		if !ok {
+			return nil, fmt.Errorf("no provider %q for the secret %q", secret.Provider, secret.Name)
+		}
+
+		value, err := provider.Get(ctx, secret.ID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the secret %q: %s", secret.Name, err)
+		}
+		vars = append(vars, ref.Name+"="+value)
+	}
+
+	return vars, nil
+}
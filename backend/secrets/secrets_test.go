@@ -0,0 +1,194 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	require.NoError(t, os.Setenv("SENSU_TEST_SECRET", "hunter2"))
+	defer os.Unsetenv("SENSU_TEST_SECRET")
+
+	ctx := context.Background()
+	envSecret := types.FixtureSecret("env-secret")
+	envSecret.ID = "SENSU_TEST_SECRET"
+	unsetSecret := types.FixtureSecret("unset-secret")
+	unsetSecret.ID = "SENSU_TEST_UNSET_SECRET"
+	unknownSecret := types.FixtureSecret("unknown-secret")
+	unknownSecret.Provider = "keychain"
+
+	store := &mockstore.MockStore{}
+	store.On("GetSecretByName", ctx, "env-secret").Return(envSecret, nil)
+	store.On("GetSecretByName", ctx, "unset-secret").Return(unsetSecret, nil)
+	store.On("GetSecretByName", ctx, "unknown-secret").Return(unknownSecret, nil)
+	store.On("GetSecretByName", ctx, "missing-secret").Return((*types.Secret)(nil), nil)
+	store.On("GetSecretByName", ctx, "store-error").Return((*types.Secret)(nil), errors.New("error"))
+
+	tests := []struct {
+		name     string
+		secret   string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "env secret",
+			secret:   "env-secret",
+			expected: []string{"PASSWORD=hunter2"},
+		},
+		{
+			name:    "unset env secret",
+			secret:  "unset-secret",
+			wantErr: true,
+		},
+		{
+			name:    "unknown provider",
+			secret:  "unknown-secret",
+			wantErr: true,
+		},
+		{
+			name:    "missing secret",
+			secret:  "missing-secret",
+			wantErr: true,
+		},
+		{
+			name:    "store error",
+			secret:  "store-error",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars, err := Resolve(ctx, store, []types.SecretReference{{Name: "PASSWORD", Secret: tc.secret}})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, vars)
+		})
+	}
+
+	vars, err := Resolve(ctx, store, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, vars)
+}
+
+func TestVaultProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors":["permission denied"]}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/sensu":
+			fmt.Fprint(w, `{"data":{"data":{"password":"hunter2","port":5432},"metadata":{"version":1}}}`)
+		case "/v1/kv/sensu":
+			fmt.Fprint(w, `{"data":{"password":"swordfish"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[]}`)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	provider := NewVaultProvider(server.URL+"/", "token")
+
+	tests := []struct {
+		name     string
+		id       string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "kv version 2",
+			id:       "secret/data/sensu#password",
+			expected: "hunter2",
+		},
+		{
+			name:     "kv version 2 non-string value",
+			id:       "secret/data/sensu#port",
+			expected: "5432",
+		},
+		{
+			name:     "kv version 1",
+			id:       "/kv/sensu#password",
+			expected: "swordfish",
+		},
+		{
+			name:    "missing key",
+			id:      "secret/data/sensu#username",
+			wantErr: true,
+		},
+		{
+			name:    "missing secret",
+			id:      "secret/data/missing#password",
+			wantErr: true,
+		},
+		{
+			name:    "invalid id",
+			id:      "secret/data/sensu",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := provider.Get(ctx, tc.id)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+
+	provider.Token = "invalid"
+	_, err := provider.Get(ctx, "secret/data/sensu#password")
+	assert.Error(t, err)
+}
+
+func TestRegisterProvider(t *testing.T) {
+	ctx := context.Background()
+	secret := types.FixtureSecret("vault-secret")
+	secret.Provider = types.SecretProviderVault
+	secret.ID = "secret/data/sensu#password"
+
+	store := &mockstore.MockStore{}
+	store.On("GetSecretByName", mock.Anything, "vault-secret").Return(secret, nil)
+	refs := []types.SecretReference{{Name: "PASSWORD", Secret: "vault-secret"}}
+
+	// No vault provider is registered by default
+	_, err := Resolve(ctx, store, refs)
+	assert.Error(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"data":{"password":"hunter2"},"metadata":{}}}`)
+	}))
+	defer server.Close()
+
+	RegisterProvider(types.SecretProviderVault, NewVaultProvider(server.URL, "token"))
+	defer func() {
+		providersMu.Lock()
+		delete(providers, types.SecretProviderVault)
+		providersMu.Unlock()
+	}()
+
+	vars, err := Resolve(ctx, store, refs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PASSWORD=hunter2"}, vars)
+}
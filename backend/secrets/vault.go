@@ -0,0 +1,92 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultVaultTimeout is the default timeout of the requests to Vault
+const DefaultVaultTimeout = 10 * time.Second
+
+// VaultProvider provides the secrets held by the key/value secrets engine of
+// HashiCorp Vault, through its HTTP API. The secrets are identified by their
+// path and their key, separated by a "#", e.g. "secret/data/sensu#password".
+// Both versions of the engine are supported.
+type VaultProvider struct {
+	// Address is the URL of the Vault server
+	Address string
+
+	// Token is the token authenticating the backend
+	Token string
+
+	// Client is the HTTP client of the requests
+	Client *http.Client
+}
+
+// NewVaultProvider returns a new VaultProvider of the Vault server at the
+// given address, authenticated by the given token.
+func NewVaultProvider(address, token string) *VaultProvider {
+	return &VaultProvider{
+		Address: strings.TrimSuffix(address, "/"),
+		Token:   token,
+		Client:  &http.Client{Timeout: DefaultVaultTimeout},
+	}
+}
+
+// vaultResponse is the response of Vault to a read of a secret
+type vaultResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []string               `json:"errors"`
+}
+
+// Get returns the value of the key of the secret at the path given by id.
+func (p *VaultProvider) Get(ctx context.Context, id string) (string, error) {
+	parts := strings.SplitN(id, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid vault secret id %q, must be of the form path#key", id)
+	}
+	path, key := strings.Trim(parts[0], "/"), parts[1]
+
+	req, err := http.NewRequest(http.MethodGet, p.Address+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("X-Vault-Token", p.Token)
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var secret vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("invalid vault response: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned status %d: %s", resp.StatusCode, strings.Join(secret.Errors, ", "))
+	}
+
+	// The data of version 2 of the engine is nested, along with its metadata
+	data := secret.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"].(map[string]interface{}); ok {
+			data = nested
+		}
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in vault secret %q", key, path)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	return string(b), err
+}
@@ -0,0 +1,240 @@
+// Package selector implements label and field selectors, selecting resources
+// with requirements on the values of their labels or fields, using the syntax
+// of Kubernetes selectors:
+//
+//   region=eu,publish=true
+//   region in (eu, us),!deprecated
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Operator is the operator of a requirement.
+type Operator string
+
+const (
+	// Equals requires the value of the key to be the given value
+	Equals Operator = "="
+	// DoubleEquals is a synonym of Equals
+	DoubleEquals Operator = "=="
+	// NotEquals requires the value of the key to be missing or to differ from
+	// the given value
+	NotEquals Operator = "!="
+	// In requires the value of the key to be one of the given values
+	In Operator = "in"
+	// NotIn requires the value of the key to be missing or not to be one of the
+	// given values
+	NotIn Operator = "notin"
+	// Exists requires the key to be present
+	Exists Operator = "exists"
+	// DoesNotExist requires the key to be missing
+	DoesNotExist Operator = "!"
+)
+
+// Requirement is a requirement on the value of a key.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches returns whether the given set of values satisfies the requirement.
+func (r Requirement) Matches(set map[string]string) bool {
+	value, ok := set[r.Key]
+	switch r.Operator {
+	case Equals, DoubleEquals, In:
+		return ok && r.hasValue(value)
+	case NotEquals, NotIn:
+		return !ok || !r.hasValue(value)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+func (r Requirement) hasValue(value string) bool {
+	for _, v := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the requirement in the selector syntax.
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	case In, NotIn:
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ","))
+	}
+	return r.Key + string(r.Operator) + strings.Join(r.Values, "")
+}
+
+// Selector selects the sets of values satisfying all of its requirements. The
+// empty selector selects everything.
+type Selector []Requirement
+
+// Matches returns whether the given set of values satisfies the requirements
+// of the selector.
+func (s Selector) Matches(set map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns whether the selector selects everything.
+func (s Selector) Empty() bool {
+	return len(s) == 0
+}
+
+// String returns the selector in the selector syntax.
+func (s Selector) String() string {
+	requirements := make([]string, len(s))
+	for i, r := range s {
+		requirements[i] = r.String()
+	}
+	return strings.Join(requirements, ",")
+}
+
+// ParseLabelSelector parses the given label selector, a comma separated list
+// of requirements among "key=value", "key==value", "key!=value",
+// "key in (a,b)", "key notin (a,b)", "key" and "!key".
+func ParseLabelSelector(selector string) (Selector, error) {
+	return parse(selector, true)
+}
+
+// ParseFieldSelector parses the given field selector, a comma separated list
+// of requirements among "key=value", "key==value" and "key!=value". Keys of
+// nested fields are dot-separated paths, e.g. "check.name".
+func ParseFieldSelector(selector string) (Selector, error) {
+	return parse(selector, false)
+}
+
+func parse(selector string, labels bool) (Selector, error) {
+	terms, err := splitTerms(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	s := Selector{}
+	for _, term := range terms {
+		var r Requirement
+		if labels {
+			r, err = parseLabelRequirement(term)
+		} else {
+			r, err = parseFieldRequirement(term)
+		}
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, r)
+	}
+	return s, nil
+}
+
+// splitTerms splits the given selector on the commas found outside of
+// parentheses, ignoring empty terms.
+func splitTerms(selector string) ([]string, error) {
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("unexpected '(' in selector %q", selector)
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unexpected ')' in selector %q", selector)
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("missing ')' in selector %q", selector)
+	}
+	terms = append(terms, selector[start:])
+
+	nonEmpty := terms[:0]
+	for _, term := range terms {
+		if term = strings.TrimSpace(term); term != "" {
+			nonEmpty = append(nonEmpty, term)
+		}
+	}
+	return nonEmpty, nil
+}
+
+func parseLabelRequirement(term string) (Requirement, error) {
+	if strings.HasPrefix(term, "!") && !strings.Contains(term, "=") {
+		return newRequirement(strings.TrimSpace(term[1:]), DoesNotExist, nil)
+	}
+
+	if i := strings.Index(term, "("); i >= 0 {
+		fields := strings.Fields(term[:i])
+		if len(fields) != 2 || !strings.HasSuffix(term, ")") {
+			return Requirement{}, fmt.Errorf("invalid requirement %q", term)
+		}
+		op := Operator(fields[1])
+		if op != In && op != NotIn {
+			return Requirement{}, fmt.Errorf("invalid operator %q in requirement %q", op, term)
+		}
+		var values []string
+		for _, value := range strings.Split(term[i+1:len(term)-1], ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return Requirement{}, fmt.Errorf("missing values in requirement %q", term)
+		}
+		sort.Strings(values)
+		return newRequirement(fields[0], op, values)
+	}
+
+	if !strings.Contains(term, "=") {
+		return newRequirement(term, Exists, nil)
+	}
+	return parseFieldRequirement(term)
+}
+
+func parseFieldRequirement(term string) (Requirement, error) {
+	for _, op := range []Operator{NotEquals, DoubleEquals, Equals} {
+		if i := strings.Index(term, string(op)); i >= 0 {
+			value := strings.TrimSpace(term[i+len(op):])
+			if strings.ContainsAny(value, "=!") {
+				return Requirement{}, fmt.Errorf("invalid value in requirement %q", term)
+			}
+			return newRequirement(strings.TrimSpace(term[:i]), op, []string{value})
+		}
+	}
+	return Requirement{}, fmt.Errorf("missing operator in requirement %q", term)
+}
+
+func newRequirement(key string, op Operator, values []string) (Requirement, error) {
+	if key == "" {
+		return Requirement{}, errors.New("missing key in requirement")
+	}
+	if strings.ContainsAny(key, " \t!=(),") {
+		return Requirement{}, fmt.Errorf("invalid key %q", key)
+	}
+	return Requirement{Key: key, Operator: op, Values: values}, nil
+}
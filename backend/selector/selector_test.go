@@ -0,0 +1,100 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	testCases := []struct {
+		selector string
+		want     Selector
+		wantErr  bool
+	}{
+		{"", Selector{}, false},
+		{"region=eu", Selector{{"region", Equals, []string{"eu"}}}, false},
+		{"region==eu", Selector{{"region", DoubleEquals, []string{"eu"}}}, false},
+		{"region != eu", Selector{{"region", NotEquals, []string{"eu"}}}, false},
+		{"region in (us, eu)", Selector{{"region", In, []string{"eu", "us"}}}, false},
+		{"region notin (eu)", Selector{{"region", NotIn, []string{"eu"}}}, false},
+		{"region", Selector{{"region", Exists, nil}}, false},
+		{"!region", Selector{{"region", DoesNotExist, nil}}, false},
+		{
+			"region in (eu,us),tier=db,!deprecated",
+			Selector{
+				{"region", In, []string{"eu", "us"}},
+				{"tier", Equals, []string{"db"}},
+				{"deprecated", DoesNotExist, nil},
+			},
+			false,
+		},
+		{"=eu", nil, true},
+		{"region in ()", nil, true},
+		{"region in (eu", nil, true},
+		{"region within (eu)", nil, true},
+		{"region=eu=us", nil, true},
+		{"my region", nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.selector, func(t *testing.T) {
+			s, err := ParseLabelSelector(tc.selector)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, s)
+		})
+	}
+}
+
+func TestParseFieldSelector(t *testing.T) {
+	s, err := ParseFieldSelector("check.name=disk,publish!=false")
+	require.NoError(t, err)
+	assert.Equal(t, Selector{
+		{"check.name", Equals, []string{"disk"}},
+		{"publish", NotEquals, []string{"false"}},
+	}, s)
+
+	for _, selector := range []string{"publish", "!publish", "name in (disk)"} {
+		_, err := ParseFieldSelector(selector)
+		assert.Error(t, err, selector)
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	set := map[string]string{"region": "eu", "tier": "db"}
+	testCases := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"region=eu", true},
+		{"region=us", false},
+		{"region!=us", true},
+		{"zone!=a", true},
+		{"region in (eu,us)", true},
+		{"region notin (eu,us)", false},
+		{"zone notin (a)", true},
+		{"tier", true},
+		{"zone", false},
+		{"!zone", true},
+		{"!tier", false},
+		{"region=eu,tier=web", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.selector, func(t *testing.T) {
+			s, err := ParseLabelSelector(tc.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, s.Matches(set))
+		})
+	}
+}
+
+func TestSelectorString(t *testing.T) {
+	s, err := ParseLabelSelector("region in (us,eu), tier==db,!deprecated,zone")
+	require.NoError(t, err)
+	assert.Equal(t, "region in (eu,us),tier==db,!deprecated,zone", s.String())
+}
@@ -0,0 +1,84 @@
+package selector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/dynamic"
+)
+
+// LabelSet returns the labels of the given resource, matched by label
+// selectors. The labels of a resource are the labels of its object metadata,
+// and the top-level scalar values of its extended attributes not overridden by
+// those.
+func LabelSet(v interface{}) map[string]string {
+	set := map[string]string{}
+	if getter, ok := v.(dynamic.AttrGetter); ok {
+		var values map[string]interface{}
+		if attrs := getter.GetExtendedAttributes(); len(attrs) > 0 && decode(attrs, &values) == nil {
+			for key, value := range values {
+				if s, ok := scalar(value); ok {
+					set[key] = s
+				}
+			}
+		}
+	}
+	if r, ok := v.(types.MetaResource); ok {
+		for key, value := range r.GetObjectMeta().Labels {
+			set[key] = value
+		}
+	}
+	return set
+}
+
+// FieldSet returns the fields of the given resource, matched by field
+// selectors. The fields of a resource are the scalar values of its JSON
+// representation, keyed by their dot-separated path.
+func FieldSet(v interface{}) (map[string]string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]interface{}
+	if err := decode(b, &values); err != nil {
+		return nil, err
+	}
+	set := map[string]string{}
+	flatten("", values, set)
+	return set, nil
+}
+
+func flatten(prefix string, values map[string]interface{}, set map[string]string) {
+	for key, value := range values {
+		if nested, ok := value.(map[string]interface{}); ok {
+			flatten(prefix+key+".", nested, set)
+		} else if s, ok := scalar(value); ok {
+			set[prefix+key] = s
+		}
+	}
+}
+
+// scalar returns the string representation of the given decoded JSON value,
+// if it is a string, a number or a boolean.
+func scalar(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return fmt.Sprint(v), true
+	}
+	return "", false
+}
+
+// decode unmarshals the given JSON, keeping numbers as written so they are
+// matched as given in selectors.
+func decode(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
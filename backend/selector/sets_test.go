@@ -0,0 +1,36 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelSet(t *testing.T) {
+	check := types.FixtureCheckConfig("check")
+	assert.Empty(t, LabelSet(check))
+
+	check.ExtendedAttributes = []byte(`{"region":"eu","replicas":3,"team":{"name":"ops"}}`)
+	assert.Equal(t, map[string]string{"region": "eu", "replicas": "3"}, LabelSet(check))
+
+	// The labels of the metadata take precedence over the extended attributes
+	check.Labels = map[string]string{"region": "us", "tier": "web"}
+	assert.Equal(t, map[string]string{"region": "us", "replicas": "3", "tier": "web"}, LabelSet(check))
+
+	assert.Empty(t, LabelSet("not a resource"))
+}
+
+func TestFieldSet(t *testing.T) {
+	event := types.FixtureEvent("entity", "check")
+	event.Check.ExtendedAttributes = []byte(`{"region":"eu"}`)
+
+	set, err := FieldSet(event)
+	require.NoError(t, err)
+	assert.Equal(t, "entity", set["entity.id"])
+	assert.Equal(t, "check", set["check.name"])
+	assert.Equal(t, "eu", set["check.region"])
+	assert.Equal(t, "60", set["check.interval"])
+	assert.Equal(t, "true", set["check.publish"])
+}
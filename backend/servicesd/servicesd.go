@@ -0,0 +1,391 @@
+// Package servicesd derives the health of business services from the events
+// of their components.
+package servicesd
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+// DefaultTickInterval is the default interval at which the service components
+// due for evaluation are looked for.
+const DefaultTickInterval = time.Second
+
+// Servicesd evaluates the components of business services at their interval:
+// the status of a component is given by its rules, from the number of failing
+// events among those it selects, and is published as an event of the entity
+// of each of its services, checked after the component. The health of a
+// service, published as its ServiceHealthCheckName event, is the most severe
+// status of its components.
+type Servicesd struct {
+	store        store.Store
+	bus          messaging.MessageBus
+	tickInterval time.Duration
+	states       map[string]componentState
+	stopping     chan struct{}
+	wg           sync.WaitGroup
+	errChan      chan error
+}
+
+// componentState is the last status derived for a service component
+type componentState struct {
+	status    uint32
+	evaluated time.Time
+}
+
+// Option is a functional option.
+type Option func(*Servicesd) error
+
+// Config configures Servicesd.
+type Config struct {
+	Store store.Store
+	Bus   messaging.MessageBus
+}
+
+// New creates a new Servicesd.
+func New(c Config, opts ...Option) (*Servicesd, error) {
+	s := &Servicesd{
+		store:        c.Store,
+		bus:          c.Bus,
+		tickInterval: DefaultTickInterval,
+		states:       map[string]componentState{},
+		stopping:     make(chan struct{}),
+		errChan:      make(chan error, 1),
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// TickInterval sets the interval at which the service components due for
+// evaluation are looked for.
+func TickInterval(interval time.Duration) Option {
+	return func(s *Servicesd) error {
+		s.tickInterval = interval
+		return nil
+	}
+}
+
+// Start starts the daemon, returning an error if preconditions for startup
+// fail.
+func (s *Servicesd) Start() error {
+	s.wg.Add(1)
+	go s.run()
+	return nil
+}
+
+// Stop stops the daemon, returning an error if one was encountered during
+// shutdown.
+func (s *Servicesd) Stop() error {
+	close(s.stopping)
+	s.wg.Wait()
+	close(s.errChan)
+	return nil
+}
+
+// Status returns nil if the Daemon is healthy, otherwise it returns an error.
+func (s *Servicesd) Status() error {
+	return nil
+}
+
+// Err returns a channel that the caller can use to listen for terminal errors
+// indicating a premature shutdown of the Daemon.
+func (s *Servicesd) Err() <-chan error {
+	return s.errChan
+}
+
+// Name returns the daemon name
+func (s *Servicesd) Name() string {
+	return "servicesd"
+}
+
+func (s *Servicesd) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.tickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case now := <-ticker.C:
+			if err := s.evaluate(now); err != nil {
+				logger.WithError(err).Error("error evaluating service components")
+			}
+		}
+	}
+}
+
+// evaluate evaluates the service components due at the given time, in all
+// organizations and environments.
+func (s *Servicesd) evaluate(now time.Time) error {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, types.OrganizationTypeAll)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, types.EnvironmentTypeAll)
+	components, err := s.store.GetServiceComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespaces := map[string][]*types.ServiceComponent{}
+	due := map[string]bool{}
+	for _, component := range components {
+		key := componentKey(component)
+		namespace := path.Join(component.Organization, component.Environment)
+		namespaces[namespace] = append(namespaces[namespace], component)
+
+		interval := time.Duration(component.Interval) * time.Second
+		if state, ok := s.states[key]; !ok || now.Sub(state.evaluated) >= interval {
+			due[namespace] = true
+		}
+	}
+
+	// Forget the deleted components
+	for key := range s.states {
+		if namespace := path.Dir(key); !componentExists(namespaces[namespace], path.Base(key)) {
+			delete(s.states, key)
+		}
+	}
+
+	for namespace := range due {
+		if err := s.evaluateNamespace(namespaces[namespace], now); err != nil {
+			logger.WithError(err).WithField("namespace", namespace).Error("error evaluating service components")
+		}
+	}
+	return nil
+}
+
+// evaluateNamespace evaluates the components due at the given time among the
+// given components, all of the same organization and environment, and
+// publishes the events of their services.
+func (s *Servicesd) evaluateNamespace(components []*types.ServiceComponent, now time.Time) error {
+	org, env := components[0].Organization, components[0].Environment
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, org)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, env)
+
+	services, err := s.store.GetServices(ctx)
+	if err != nil {
+		return err
+	}
+	servicesByName := make(map[string]*types.Service, len(services))
+	for _, service := range services {
+		servicesByName[service.Name] = service
+	}
+
+	events, err := s.store.GetEvents(ctx)
+	if err != nil {
+		return err
+	}
+	members := memberEvents(events, servicesByName)
+
+	evaluated := map[string]*types.ServiceComponent{}
+	for _, component := range components {
+		key := componentKey(component)
+		interval := time.Duration(component.Interval) * time.Second
+		if state, ok := s.states[key]; ok && now.Sub(state.evaluated) < interval {
+			continue
+		}
+
+		failing, total := members.count(component)
+		status, rule := component.Status(failing, total)
+		s.states[key] = componentState{status: status, evaluated: now}
+
+		for _, name := range component.Services {
+			service, ok := servicesByName[name]
+			if !ok {
+				continue
+			}
+			evaluated[name] = component
+			check := newCheck(component.Name, component, status, componentOutput(failing, total, rule), now)
+			check.Handlers = component.Handlers
+			if err := s.publish(ctx, service, check, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Publish the health of the services of the evaluated components
+	for name, component := range evaluated {
+		service := servicesByName[name]
+		status, output := s.health(service, components)
+		check := newCheck(types.ServiceHealthCheckName, component, status, output, now)
+		check.Handlers = service.Handlers
+		if err := s.publish(ctx, service, check, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// health returns the status of the given service, the most severe of its
+// evaluated components, along with the status of each of them.
+func (s *Servicesd) health(service *types.Service, components []*types.ServiceComponent) (uint32, string) {
+	var status uint32
+	var statuses []string
+	for _, component := range components {
+		state, ok := s.states[componentKey(component)]
+		if !ok || !utilstrings.InArray(service.Name, component.Services) {
+			continue
+		}
+		if severity(state.status) > severity(status) {
+			status = state.status
+		}
+		statuses = append(statuses, fmt.Sprintf("%s is %s", component.Name, types.DefaultSeverity(state.status)))
+	}
+	sort.Strings(statuses)
+	return status, strings.Join(statuses, ", ")
+}
+
+// publish publishes the event of the given check for the entity of the given
+// service, registering the entity if it does not exist.
+func (s *Servicesd) publish(ctx context.Context, service *types.Service, check *types.Check, now time.Time) error {
+	entity, err := s.store.GetEntityByID(ctx, service.Name)
+	if err != nil {
+		return err
+	}
+	if entity == nil {
+		entity = &types.Entity{
+			ID:            service.Name,
+			Class:         types.EntityProxyClass,
+			Organization:  service.Organization,
+			Environment:   service.Environment,
+			Subscriptions: []string{types.GetEntitySubscription(service.Name)},
+		}
+		if err := s.store.UpdateEntity(ctx, entity); err != nil {
+			return err
+		}
+	}
+
+	event := &types.Event{
+		Timestamp: now.Unix(),
+		Entity:    entity,
+		Check:     check,
+	}
+	return s.bus.Publish(messaging.TopicEventRaw, event)
+}
+
+// newCheck returns the check of an event derived from the given component
+func newCheck(name string, component *types.ServiceComponent, status uint32, output string, now time.Time) *types.Check {
+	return &types.Check{
+		Name:         name,
+		Interval:     component.Interval,
+		Status:       status,
+		Output:       output,
+		Issued:       now.Unix(),
+		Executed:     now.Unix(),
+		Organization: component.Organization,
+		Environment:  component.Environment,
+	}
+}
+
+// members are the events which service components may select, along with
+// their labels and fields
+type members []member
+
+type member struct {
+	event  *types.Event
+	labels map[string]string
+	fields map[string]string
+}
+
+// memberEvents returns the events which service components may select: the
+// events of checks, apart from those derived for the given services.
+func memberEvents(events []*types.Event, services map[string]*types.Service) members {
+	result := make(members, 0, len(events))
+	for _, event := range events {
+		if !event.HasCheck() || event.Entity == nil {
+			continue
+		}
+		if _, ok := services[event.Entity.ID]; ok {
+			continue
+		}
+
+		labels := selector.LabelSet(event.Entity)
+		for key, value := range selector.LabelSet(event.Check) {
+			labels[key] = value
+		}
+		fields, err := selector.FieldSet(event)
+		if err != nil {
+			logger.WithError(err).Error("error getting the fields of an event")
+			continue
+		}
+		result = append(result, member{event: event, labels: labels, fields: fields})
+	}
+	return result
+}
+
+// count returns the number of failing events selected by the given component,
+// and the number of events it selects.
+func (m members) count(component *types.ServiceComponent) (failing, total int) {
+	labels, err := selector.ParseLabelSelector(component.LabelSelector)
+	if err != nil {
+		return 0, 0
+	}
+	fields, err := selector.ParseFieldSelector(component.FieldSelector)
+	if err != nil {
+		return 0, 0
+	}
+
+	for _, member := range m {
+		if !labels.Matches(member.labels) || !fields.Matches(member.fields) {
+			continue
+		}
+		total++
+		if member.event.Check.Status != 0 {
+			failing++
+		}
+	}
+	return failing, total
+}
+
+// componentOutput returns the output of the event of a component
+func componentOutput(failing, total int, rule *types.ServiceRule) string {
+	if total == 0 {
+		return "no events selected"
+	}
+	output := fmt.Sprintf("%d of %d events failing (%d%%)", failing, total, failing*100/total)
+	if rule != nil {
+		output += fmt.Sprintf(", exceeding rule %s", rule.Name)
+	}
+	return output
+}
+
+// severity ranks the given status by severity: critical, unknown, warning,
+// then ok.
+func severity(status uint32) int {
+	switch status {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return 3
+	}
+	return 2
+}
+
+func componentKey(component *types.ServiceComponent) string {
+	return path.Join(component.Organization, component.Environment, component.Name)
+}
+
+func componentExists(components []*types.ServiceComponent, name string) bool {
+	for _, component := range components {
+		if component.Name == name {
+			return true
+		}
+	}
+	return false
+}
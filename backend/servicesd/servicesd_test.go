@@ -0,0 +1,245 @@
+package servicesd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockring"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type channelSubscriber struct {
+	ch chan interface{}
+}
+
+func (c channelSubscriber) Receiver() chan<- interface{} {
+	return c.ch
+}
+
+func fixtureEvent(entity, check string, status uint32, labels map[string]string) *types.Event {
+	event := types.FixtureEvent(entity, check)
+	event.Check.Status = status
+	event.Entity.Labels = labels
+	return event
+}
+
+func newServicesdTest(t *testing.T, store *mockstore.MockStore) (*Servicesd, chan interface{}) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	ch := make(chan interface{}, 10)
+	_, err = bus.Subscribe(messaging.TopicEventRaw, "servicesd-test", channelSubscriber{ch})
+	require.NoError(t, err)
+
+	s, err := New(Config{Store: store, Bus: bus})
+	require.NoError(t, err)
+	return s, ch
+}
+
+func receiveEvents(t *testing.T, ch chan interface{}, n int) map[string]*types.Event {
+	events := map[string]*types.Event{}
+	for i := 0; i < n; i++ {
+		select {
+		case msg := <-ch:
+			event := msg.(*types.Event)
+			require.NoError(t, event.Validate())
+			events[event.Check.Name] = event
+		case <-time.After(time.Second):
+			t.Fatalf("received %d events out of %d", i, n)
+		}
+	}
+	return events
+}
+
+func TestServicesdEvaluate(t *testing.T) {
+	web := types.FixtureServiceComponent("web", "webstore")
+	web.LabelSelector = "tier=web"
+	web.Rules = []types.ServiceRule{
+		{Name: "degraded", Status: 1},
+		{Name: "down", Status: 2, FailingPercentage: 30},
+	}
+	db := types.FixtureServiceComponent("db", "webstore")
+	db.FieldSelector = "check.name=mysql"
+	service := types.FixtureService("webstore")
+	service.Handlers = []string{"pagerduty"}
+
+	webLabels := map[string]string{"tier": "web"}
+	events := []*types.Event{
+		fixtureEvent("web1", "http", 2, webLabels),
+		fixtureEvent("web2", "http", 0, webLabels),
+		fixtureEvent("web3", "http", 0, webLabels),
+		fixtureEvent("db1", "mysql", 0, nil),
+		// The events of the service itself are never selected
+		fixtureEvent("webstore", "web", 2, webLabels),
+	}
+
+	store := &mockstore.MockStore{}
+	store.On("GetServiceComponents", mock.Anything).Return([]*types.ServiceComponent{web, db}, nil)
+	store.On("GetServices", mock.Anything).Return([]*types.Service{service}, nil)
+	store.On("GetEvents", mock.Anything).Return(events, nil)
+	store.On("GetEntityByID", mock.Anything, "webstore").Return((*types.Entity)(nil), nil)
+	store.On("UpdateEntity", mock.Anything, mock.Anything).Return(nil)
+
+	s, ch := newServicesdTest(t, store)
+	now := time.Now()
+	require.NoError(t, s.evaluate(now))
+
+	received := receiveEvents(t, ch, 3)
+	require.Contains(t, received, "web")
+	assert.Equal(t, "webstore", received["web"].Entity.ID)
+	assert.Equal(t, types.EntityProxyClass, received["web"].Entity.Class)
+	assert.Equal(t, uint32(2), received["web"].Check.Status)
+	assert.Equal(t, "1 of 3 events failing (33%), exceeding rule down", received["web"].Check.Output)
+
+	require.Contains(t, received, "db")
+	assert.Equal(t, uint32(0), received["db"].Check.Status)
+	assert.Equal(t, "0 of 1 events failing (0%)", received["db"].Check.Output)
+
+	require.Contains(t, received, types.ServiceHealthCheckName)
+	health := received[types.ServiceHealthCheckName]
+	assert.Equal(t, uint32(2), health.Check.Status)
+	assert.Equal(t, "db is ok, web is critical", health.Check.Output)
+	assert.Equal(t, []string{"pagerduty"}, health.Check.Handlers)
+	store.AssertCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+
+	// The components are not evaluated again before their interval
+	require.NoError(t, s.evaluate(now.Add(time.Second)))
+	select {
+	case msg := <-ch:
+		t.Fatalf("unexpected event %v", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestServicesdForgetsDeletedComponents(t *testing.T) {
+	web := types.FixtureServiceComponent("web", "webstore")
+
+	store := &mockstore.MockStore{}
+	store.On("GetServiceComponents", mock.Anything).Return([]*types.ServiceComponent{web}, nil).Once()
+	store.On("GetServiceComponents", mock.Anything).Return([]*types.ServiceComponent{}, nil)
+	store.On("GetServices", mock.Anything).Return([]*types.Service{}, nil)
+	store.On("GetEvents", mock.Anything).Return([]*types.Event{}, nil)
+
+	s, _ := newServicesdTest(t, store)
+	require.NoError(t, s.evaluate(time.Now()))
+	assert.Len(t, s.states, 1)
+	require.NoError(t, s.evaluate(time.Now()))
+	assert.Len(t, s.states, 0)
+}
+
+func TestServicesdSharedComponents(t *testing.T) {
+	cache := types.FixtureServiceComponent("cache", "webstore")
+	cache.Services = []string{"webstore", "checkout", "billing"}
+	cache.LabelSelector = "tier=cache"
+	cache.Rules = []types.ServiceRule{{Name: "degraded", Status: 1}}
+	cache.Handlers = []string{"slack"}
+	// Selecting no events, the search component is unknown
+	search := types.FixtureServiceComponent("search", "checkout")
+	search.FieldSelector = "check.name=elasticsearch"
+
+	webstore := types.FixtureService("webstore")
+	webstore.Handlers = []string{"pagerduty"}
+	checkout := types.FixtureService("checkout")
+	checkout.Handlers = []string{"email"}
+
+	cacheLabels := map[string]string{"tier": "cache"}
+	events := []*types.Event{
+		fixtureEvent("cache1", "redis", 1, cacheLabels),
+		fixtureEvent("cache2", "redis", 0, cacheLabels),
+	}
+
+	store := &mockstore.MockStore{}
+	store.On("GetServiceComponents", mock.Anything).Return([]*types.ServiceComponent{cache, search}, nil)
+	store.On("GetServices", mock.Anything).Return([]*types.Service{webstore, checkout}, nil)
+	store.On("GetEvents", mock.Anything).Return(events, nil)
+	store.On("GetEntityByID", mock.Anything, mock.Anything).Return((*types.Entity)(nil), nil)
+	store.On("UpdateEntity", mock.Anything, mock.Anything).Return(nil)
+
+	s, ch := newServicesdTest(t, store)
+	require.NoError(t, s.evaluate(time.Now()))
+
+	// The events of components are published for each of their existing
+	// services, followed by the health of those services.
+	received := map[string]*types.Event{}
+	for i := 0; i < 5; i++ {
+		select {
+		case msg := <-ch:
+			event := msg.(*types.Event)
+			received[event.Entity.ID+"/"+event.Check.Name] = event
+		case <-time.After(time.Second):
+			t.Fatalf("received %d events out of 5", i)
+		}
+	}
+	require.Len(t, received, 5)
+	assert.NotContains(t, received, "billing/cache")
+
+	for _, key := range []string{"webstore/cache", "checkout/cache"} {
+		require.Contains(t, received, key)
+		assert.Equal(t, uint32(1), received[key].Check.Status)
+		assert.Equal(t, "1 of 2 events failing (50%), exceeding rule degraded", received[key].Check.Output)
+		assert.Equal(t, []string{"slack"}, received[key].Check.Handlers)
+	}
+
+	require.Contains(t, received, "checkout/search")
+	assert.Equal(t, uint32(3), received["checkout/search"].Check.Status)
+	assert.Equal(t, "no events selected", received["checkout/search"].Check.Output)
+
+	health := received["webstore/"+types.ServiceHealthCheckName]
+	require.NotNil(t, health)
+	assert.Equal(t, uint32(1), health.Check.Status)
+	assert.Equal(t, "cache is warning", health.Check.Output)
+	assert.Equal(t, []string{"pagerduty"}, health.Check.Handlers)
+
+	// An unknown component prevails over a warning one
+	health = received["checkout/"+types.ServiceHealthCheckName]
+	require.NotNil(t, health)
+	assert.Equal(t, uint32(3), health.Check.Status)
+	assert.Equal(t, "cache is warning, search is unknown", health.Check.Output)
+	assert.Equal(t, []string{"email"}, health.Check.Handlers)
+}
+
+func TestServicesdInvalidSelector(t *testing.T) {
+	web := types.FixtureServiceComponent("web", "webstore")
+	web.LabelSelector = "tier in (web"
+	service := types.FixtureService("webstore")
+
+	events := []*types.Event{
+		fixtureEvent("web1", "http", 2, map[string]string{"tier": "web"}),
+	}
+
+	store := &mockstore.MockStore{}
+	store.On("GetServiceComponents", mock.Anything).Return([]*types.ServiceComponent{web}, nil)
+	store.On("GetServices", mock.Anything).Return([]*types.Service{service}, nil)
+	store.On("GetEvents", mock.Anything).Return(events, nil)
+	store.On("GetEntityByID", mock.Anything, "webstore").Return(types.FixtureEntity("webstore"), nil)
+
+	s, ch := newServicesdTest(t, store)
+	require.NoError(t, s.evaluate(time.Now()))
+
+	// A component whose selector cannot be parsed selects no events rather
+	// than all of them.
+	received := receiveEvents(t, ch, 2)
+	require.Contains(t, received, "web")
+	assert.Equal(t, uint32(3), received["web"].Check.Status)
+	assert.Equal(t, "no events selected", received["web"].Check.Output)
+	store.AssertNotCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+}
+
+func TestComponentOutput(t *testing.T) {
+	assert.Equal(t, "no events selected", componentOutput(0, 0, nil))
+	assert.Equal(t, "2 of 4 events failing (50%), exceeding rule down", componentOutput(2, 4, &types.ServiceRule{Name: "down"}))
+}
+
+func TestSeverity(t *testing.T) {
+	assert.True(t, severity(2) > severity(3))
+	assert.True(t, severity(3) > severity(1))
+	assert.True(t, severity(1) > severity(0))
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var requests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "sensu_store_cache_requests_total",
+		Help: "The number of requests of the store cache, by resource and result (hit or miss).",
+	},
+	[]string{"resource", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(requests)
+}
+
+// namespace is the organization and environment of cached resources
+type namespace struct {
+	org string
+	env string
+}
+
+// contextNamespace returns the namespace of the given context, and false if it
+// spans several namespaces, which are never served from the cache
+func contextNamespace(ctx context.Context) (namespace, bool) {
+	org, _ := ctx.Value(types.OrganizationKey).(string)
+	env, _ := ctx.Value(types.EnvironmentKey).(string)
+	if org == "" || env == "" || org == types.OrganizationTypeAll || env == types.EnvironmentTypeAll {
+		return namespace{}, false
+	}
+	return namespace{org: org, env: env}, true
+}
+
+// update is a change of a cached object, received from a store watcher, and
+// the revision of the store it was made at, zero if unknown
+type update struct {
+	action   store.WatchActionType
+	name     string
+	object   interface{}
+	revision int64
+}
+
+// objects are the cached objects of a namespace, by name, and the revision of
+// the store they were loaded at, zero if unknown. Until they are loaded, the
+// updates received are kept pending.
+type objects struct {
+	loaded   bool
+	byName   map[string]interface{}
+	revision int64
+	pending  []update
+}
+
+// apply applies the given update, unless the objects were loaded after it was
+// made: the watcher may lag behind the store they were loaded from.
+func (o *objects) apply(u update) {
+	if u.revision > 0 && u.revision <= o.revision {
+		return
+	}
+	if u.action == store.WatchDelete {
+		delete(o.byName, u.name)
+		return
+	}
+	o.byName[u.name] = u.object
+}
+
+// resource caches the objects of a kind of resource, one namespace at a time:
+// the objects of a namespace are loaded from the store when first requested,
+// then kept up to date by the updates of the watcher of the resource.
+type resource struct {
+	name string
+	load func(context.Context) (map[string]interface{}, error)
+
+	mu         sync.RWMutex
+	watching   bool
+	namespaces map[namespace]*objects
+}
+
+func newResource(name string, load func(context.Context) (map[string]interface{}, error)) *resource {
+	return &resource{
+		name:       name,
+		load:       load,
+		namespaces: map[namespace]*objects{},
+	}
+}
+
+// setWatching records whether the resource is watched. Cached objects are
+// dropped once it is not anymore, since they would get stale.
+func (r *resource) setWatching(watching bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watching = watching
+	if !watching {
+		r.namespaces = map[namespace]*objects{}
+	}
+}
+
+// view calls f with the cached objects of the namespace of the given context,
+// loading them first if needed, and returns whether f was called. It is not
+// when the resource is not watched, the context spans several namespaces, or
+// the objects of its namespace are being loaded by another request, or the
+// context requests the revision of the objects read, which is not cached; the
+// store must then be queried instead. The objects must not be modified by f.
+func (r *resource) view(ctx context.Context, f func(map[string]interface{})) (bool, error) {
+	ns, ok := contextNamespace(ctx)
+	if !ok || store.RevisionFromContext(ctx) != nil {
+		return false, nil
+	}
+
+	r.mu.RLock()
+	o := r.namespaces[ns]
+	if r.watching && o != nil && o.loaded {
+		f(o.byName)
+		r.mu.RUnlock()
+		requests.WithLabelValues(r.name, "hit").Inc()
+		return true, nil
+	}
+	watching := r.watching
+	r.mu.RUnlock()
+
+	requests.WithLabelValues(r.name, "miss").Inc()
+	if !watching || o != nil {
+		return false, nil
+	}
+
+	r.mu.Lock()
+	if _, ok := r.namespaces[ns]; ok || !r.watching {
+		r.mu.Unlock()
+		return false, nil
+	}
+	o = &objects{}
+	r.namespaces[ns] = o
+	r.mu.Unlock()
+
+	var revision int64
+	byName, err := r.load(store.ContextWithRevision(ctx, &revision))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.namespaces[ns] != o {
+		// The resource stopped being watched meanwhile
+		return false, err
+	}
+	if err != nil {
+		delete(r.namespaces, ns)
+		return false, err
+	}
+	o.byName = byName
+	o.revision = revision
+	for _, u := range o.pending {
+		o.apply(u)
+	}
+	o.pending = nil
+	o.loaded = true
+	f(o.byName)
+	return true, nil
+}
+
+// update applies a change received from the watcher of the resource. Changes
+// to the namespaces not cached are ignored: they are loaded once requested.
+func (r *resource) update(ns namespace, u update) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	o := r.namespaces[ns]
+	if o == nil {
+		return
+	}
+	if !o.loaded {
+		o.pending = append(o.pending, u)
+		return
+	}
+	o.apply(u)
+}
@@ -0,0 +1,372 @@
+// Package cache provides an in-memory cache of the resources read the most by
+// the backend, in front of its store.
+package cache
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Store is a store.Store serving check configurations, entities, environments
+// and silenced entries from memory, and everything else from the store it wraps. It is also
+// a daemon watching the wrapped store, to keep the cached resources up to date.
+//
+// The resources of an organization and environment are loaded when first
+// requested, and then never read from the wrapped store again. Requests
+// spanning several organizations or environments, and those made while the
+// daemon is stopped, are not cached. Changes made through the store are only
+// visible once they are received from the watchers, shortly after.
+type Store struct {
+	store.Store
+
+	checks       *resource
+	entities     *resource
+	environments *resource
+	silenced     *resource
+
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	errChan chan error
+}
+
+// New returns a Store caching the resources of the given store.
+func New(s store.Store) *Store {
+	c := &Store{
+		Store:   s,
+		errChan: make(chan error, 1),
+	}
+	c.checks = newResource("checks", c.loadCheckConfigs)
+	c.entities = newResource("entities", c.loadEntities)
+	c.environments = newResource("environments", c.loadEnvironment)
+	c.silenced = newResource("silenced", c.loadSilencedEntries)
+	return c
+}
+
+// Start starts watching the wrapped store. The watchers are started before
+// returning, so that no change made afterwards is missed.
+func (c *Store) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	checks := c.Store.GetCheckConfigWatcher(ctx)
+	entities := c.Store.GetEntityWatcher(ctx)
+	environments := c.Store.GetEnvironmentWatcher(ctx)
+	silenced := c.Store.GetSilencedWatcher(ctx)
+
+	for _, r := range c.resources() {
+		r.setWatching(true)
+	}
+
+	c.wg.Add(4)
+	go func() {
+		defer c.wg.Done()
+		for event := range checks {
+			check := event.CheckConfig
+			c.checks.update(
+				namespace{org: check.Organization, env: check.Environment},
+				update{action: event.Action, name: check.Name, object: check, revision: event.Revision},
+			)
+		}
+	}()
+	go func() {
+		defer c.wg.Done()
+		for event := range entities {
+			entity := event.Entity
+			c.entities.update(
+				namespace{org: entity.Organization, env: entity.Environment},
+				update{action: event.Action, name: entity.ID, object: entity, revision: event.Revision},
+			)
+		}
+	}()
+	go func() {
+		defer c.wg.Done()
+		for event := range environments {
+			env := event.Environment
+			c.environments.update(
+				namespace{org: env.Organization, env: env.Name},
+				update{action: event.Action, name: env.Name, object: env, revision: event.Revision},
+			)
+		}
+	}()
+	go func() {
+		defer c.wg.Done()
+		for event := range silenced {
+			entry := event.Silenced
+			c.silenced.update(
+				namespace{org: entry.Organization, env: entry.Environment},
+				update{action: event.Action, name: entry.ID, object: entry, revision: event.Revision},
+			)
+		}
+	}()
+	return nil
+}
+
+// Stop stops watching the wrapped store, and drops the cached resources.
+func (c *Store) Stop() error {
+	for _, r := range c.resources() {
+		r.setWatching(false)
+	}
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+	close(c.errChan)
+	return nil
+}
+
+func (c *Store) resources() []*resource {
+	return []*resource{c.checks, c.entities, c.environments, c.silenced}
+}
+
+// Status returns nil: the wrapped store is queried whenever the cache cannot
+// serve a request.
+func (c *Store) Status() error {
+	return nil
+}
+
+// Err returns a channel that the caller can use to listen for terminal errors
+// indicating a premature shutdown of the Daemon.
+func (c *Store) Err() <-chan error {
+	return c.errChan
+}
+
+// Name returns the daemon name
+func (c *Store) Name() string {
+	return "cache"
+}
+
+func (c *Store) loadCheckConfigs(ctx context.Context) (map[string]interface{}, error) {
+	checks, err := c.Store.GetCheckConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]interface{}, len(checks))
+	for _, check := range checks {
+		byName[check.Name] = check
+	}
+	return byName, nil
+}
+
+func (c *Store) loadEntities(ctx context.Context) (map[string]interface{}, error) {
+	entities, err := c.Store.GetEntities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]interface{}, len(entities))
+	for _, entity := range entities {
+		byName[entity.ID] = entity
+	}
+	return byName, nil
+}
+
+// loadEnvironment loads the environment of the context, the only object of its
+// namespace
+func (c *Store) loadEnvironment(ctx context.Context) (map[string]interface{}, error) {
+	ns, _ := contextNamespace(ctx)
+	env, err := c.Store.GetEnvironment(ctx, ns.org, ns.env)
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string]interface{}{}
+	if env != nil {
+		byName[env.Name] = env
+	}
+	return byName, nil
+}
+
+func (c *Store) loadSilencedEntries(ctx context.Context) (map[string]interface{}, error) {
+	entries, err := c.Store.GetSilencedEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]interface{}, len(entries))
+	for _, entry := range entries {
+		byName[entry.ID] = entry
+	}
+	return byName, nil
+}
+
+// GetCheckConfigs returns check configurations in an (optional) organization.
+func (c *Store) GetCheckConfigs(ctx context.Context) ([]*types.CheckConfig, error) {
+	checks := []*types.CheckConfig{}
+	ok, err := c.checks.view(ctx, func(byName map[string]interface{}) {
+		for _, object := range byName {
+			checks = append(checks, object.(*types.CheckConfig).DeepCopy())
+		}
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetCheckConfigs(ctx)
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+	return checks, nil
+}
+
+// GetCheckConfigByName returns a check configuration by name.
+func (c *Store) GetCheckConfigByName(ctx context.Context, name string) (*types.CheckConfig, error) {
+	if name == "" {
+		return c.Store.GetCheckConfigByName(ctx, name)
+	}
+	var check *types.CheckConfig
+	ok, err := c.checks.view(ctx, func(byName map[string]interface{}) {
+		if object, ok := byName[name]; ok {
+			check = object.(*types.CheckConfig).DeepCopy()
+		}
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetCheckConfigByName(ctx, name)
+	}
+	return check, nil
+}
+
+// GetEntities returns the entities of the organization and environment of the
+// context.
+func (c *Store) GetEntities(ctx context.Context) ([]*types.Entity, error) {
+	entities := []*types.Entity{}
+	ok, err := c.entities.view(ctx, func(byName map[string]interface{}) {
+		for _, object := range byName {
+			entities = append(entities, object.(*types.Entity).DeepCopy())
+		}
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetEntities(ctx)
+	}
+	sort.Slice(entities, func(i, j int) bool { return entities[i].ID < entities[j].ID })
+	return entities, nil
+}
+
+// GetEntityByID returns an entity using the given id and the organization
+// stored in ctx.
+func (c *Store) GetEntityByID(ctx context.Context, id string) (*types.Entity, error) {
+	if id == "" {
+		return c.Store.GetEntityByID(ctx, id)
+	}
+	var entity *types.Entity
+	ok, err := c.entities.view(ctx, func(byName map[string]interface{}) {
+		if object, ok := byName[id]; ok {
+			entity = object.(*types.Entity).DeepCopy()
+		}
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetEntityByID(ctx, id)
+	}
+	return entity, nil
+}
+
+// GetEnvironment returns an environment using the given org and env. The
+// result is nil if none was found.
+func (c *Store) GetEnvironment(ctx context.Context, org, env string) (*types.Environment, error) {
+	nsCtx := context.WithValue(ctx, types.OrganizationKey, org)
+	nsCtx = context.WithValue(nsCtx, types.EnvironmentKey, env)
+	var environment *types.Environment
+	ok, err := c.environments.view(nsCtx, func(byName map[string]interface{}) {
+		if object, ok := byName[env]; ok {
+			environment = object.(*types.Environment).DeepCopy()
+		}
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetEnvironment(ctx, org, env)
+	}
+	return environment, nil
+}
+
+// silencedEntries returns the cached silenced entries of the namespace of the
+// context matching the given predicate, sorted by ID like those of etcd
+func (c *Store) silencedEntries(ctx context.Context, match func(*types.Silenced) bool) ([]*types.Silenced, bool, error) {
+	entries := []*types.Silenced{}
+	ok, err := c.silenced.view(ctx, func(byName map[string]interface{}) {
+		for _, object := range byName {
+			if entry := object.(*types.Silenced); match(entry) {
+				entries = append(entries, entry.DeepCopy())
+			}
+		}
+	})
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	return entries, ok, err
+}
+
+// GetSilencedEntries returns all silenced entries.
+func (c *Store) GetSilencedEntries(ctx context.Context) ([]*types.Silenced, error) {
+	entries, ok, err := c.silencedEntries(ctx, func(*types.Silenced) bool { return true })
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetSilencedEntries(ctx)
+	}
+	return entries, nil
+}
+
+// GetSilencedEntriesBySubscription returns all entries for the given
+// subscription, i.e. those whose ID starts with it.
+func (c *Store) GetSilencedEntriesBySubscription(ctx context.Context, subscription string) ([]*types.Silenced, error) {
+	if subscription == "" {
+		return c.Store.GetSilencedEntriesBySubscription(ctx, subscription)
+	}
+	entries, ok, err := c.silencedEntries(ctx, func(entry *types.Silenced) bool {
+		return strings.HasPrefix(entry.ID, subscription)
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetSilencedEntriesBySubscription(ctx, subscription)
+	}
+	return entries, nil
+}
+
+// GetSilencedEntriesByCheckName returns all entries for the given check name.
+func (c *Store) GetSilencedEntriesByCheckName(ctx context.Context, checkName string) ([]*types.Silenced, error) {
+	if checkName == "" {
+		return c.Store.GetSilencedEntriesByCheckName(ctx, checkName)
+	}
+	entries, ok, err := c.silencedEntries(ctx, func(entry *types.Silenced) bool {
+		return entry.Check == checkName
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetSilencedEntriesByCheckName(ctx, checkName)
+	}
+	return entries, nil
+}
+
+// GetSilencedEntryByID returns an entry using the given id.
+func (c *Store) GetSilencedEntryByID(ctx context.Context, id string) (*types.Silenced, error) {
+	if id == "" {
+		return c.Store.GetSilencedEntryByID(ctx, id)
+	}
+	var entry *types.Silenced
+	ok, err := c.silenced.view(ctx, func(byName map[string]interface{}) {
+		if object, ok := byName[id]; ok {
+			entry = object.(*types.Silenced).DeepCopy()
+		}
+	})
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return c.Store.GetSilencedEntryByID(ctx, id)
+	}
+	return entry, nil
+}
@@ -0,0 +1,377 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type watchers struct {
+	checks       chan store.WatchEventCheckConfig
+	entities     chan store.WatchEventEntity
+	environments chan store.WatchEventEnvironment
+	silenced     chan store.WatchEventSilenced
+}
+
+func newStoreTest(t *testing.T) (*Store, *mockstore.MockStore, watchers) {
+	w := watchers{
+		checks:       make(chan store.WatchEventCheckConfig),
+		entities:     make(chan store.WatchEventEntity),
+		environments: make(chan store.WatchEventEnvironment),
+		silenced:     make(chan store.WatchEventSilenced),
+	}
+	st := &mockstore.MockStore{}
+	st.On("GetCheckConfigWatcher", mock.Anything).Return((<-chan store.WatchEventCheckConfig)(w.checks))
+	st.On("GetEntityWatcher", mock.Anything).Return((<-chan store.WatchEventEntity)(w.entities))
+	st.On("GetEnvironmentWatcher", mock.Anything).Return((<-chan store.WatchEventEnvironment)(w.environments))
+	st.On("GetSilencedWatcher", mock.Anything).Return((<-chan store.WatchEventSilenced)(w.silenced))
+
+	s := New(st)
+	require.NoError(t, s.Start())
+	return s, st, w
+}
+
+func (w watchers) close() {
+	close(w.checks)
+	close(w.entities)
+	close(w.environments)
+	close(w.silenced)
+}
+
+func namespaceContext(org, env string) context.Context {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, org)
+	return context.WithValue(ctx, types.EnvironmentKey, env)
+}
+
+// loadContext matches the context the objects of the namespace of the given
+// context are loaded with
+func loadContext(ctx context.Context) interface{} {
+	ns, _ := contextNamespace(ctx)
+	return mock.MatchedBy(func(loadCtx context.Context) bool {
+		loadNs, ok := contextNamespace(loadCtx)
+		return ok && loadNs == ns && store.RevisionFromContext(loadCtx) != nil
+	})
+}
+
+// eventually retries the given assertion until it succeeds, since watch
+// events are applied asynchronously
+func eventually(t *testing.T, f func() bool) {
+	for i := 0; i < 100; i++ {
+		if f() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("condition not met")
+}
+
+func counterValue(t *testing.T, resource, result string) float64 {
+	m := &dto.Metric{}
+	require.NoError(t, requests.WithLabelValues(resource, result).Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func TestCheckConfigs(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	ctx := namespaceContext("default", "default")
+	check1 := types.FixtureCheckConfig("check1")
+	check2 := types.FixtureCheckConfig("check2")
+	st.On("GetCheckConfigs", loadContext(ctx)).Return([]*types.CheckConfig{check2, check1}, nil).Once()
+
+	hits := counterValue(t, "checks", "hit")
+	misses := counterValue(t, "checks", "miss")
+
+	// The checks of the namespace are loaded once
+	checks, err := s.GetCheckConfigs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.CheckConfig{check1, check2}, checks)
+	check, err := s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Equal(t, check1, check)
+	check, err = s.GetCheckConfigByName(ctx, "check3")
+	require.NoError(t, err)
+	assert.Nil(t, check)
+	st.AssertNumberOfCalls(t, "GetCheckConfigs", 1)
+	st.AssertNotCalled(t, "GetCheckConfigByName", mock.Anything, mock.Anything)
+
+	assert.Equal(t, misses+1, counterValue(t, "checks", "miss"))
+	assert.Equal(t, hits+2, counterValue(t, "checks", "hit"))
+
+	// The checks returned are copies
+	checks[0].Interval = 1
+	check, err = s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Equal(t, check1.Interval, check.Interval)
+
+	// And are kept up to date by the watcher
+	check3 := types.FixtureCheckConfig("check3")
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchCreate, CheckConfig: check3}
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchDelete, CheckConfig: &types.CheckConfig{
+		Name:         "check1",
+		Organization: "default",
+		Environment:  "default",
+	}}
+	eventually(t, func() bool {
+		checks, err := s.GetCheckConfigs(ctx)
+		require.NoError(t, err)
+		return assert.ObjectsAreEqual([]*types.CheckConfig{check2, check3}, checks)
+	})
+
+	// Changes to the other namespaces are ignored
+	other := types.FixtureCheckConfig("check4")
+	other.Environment = "dev"
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchCreate, CheckConfig: other}
+	devCtx := namespaceContext("default", "dev")
+	st.On("GetCheckConfigs", loadContext(devCtx)).Return([]*types.CheckConfig{}, nil).Once()
+	checks, err = s.GetCheckConfigs(devCtx)
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+}
+
+func TestCheckConfigsLoadError(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	ctx := namespaceContext("default", "default")
+	st.On("GetCheckConfigs", loadContext(ctx)).Return([]*types.CheckConfig(nil), errors.New("error")).Once()
+	_, err := s.GetCheckConfigByName(ctx, "check1")
+	assert.Error(t, err)
+
+	// The namespace is loaded again on the next request
+	st.On("GetCheckConfigs", loadContext(ctx)).Return([]*types.CheckConfig{types.FixtureCheckConfig("check1")}, nil).Once()
+	check, err := s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.NotNil(t, check)
+}
+
+func TestCheckConfigsStaleUpdates(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	ctx := namespaceContext("default", "default")
+	loaded := types.FixtureCheckConfig("check1")
+	loaded.Interval = 20
+	loading := make(chan struct{})
+	st.On("GetCheckConfigs", loadContext(ctx)).Return([]*types.CheckConfig{loaded}, nil).Run(func(args mock.Arguments) {
+		// The checks are listed at revision 10, while updates are received
+		*store.RevisionFromContext(args.Get(0).(context.Context)) = 10
+		<-loading
+	}).Once()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		check, err := s.GetCheckConfigByName(ctx, "check1")
+		require.NoError(t, err)
+		assert.Equal(t, loaded, check)
+	}()
+
+	stale := types.FixtureCheckConfig("check1")
+	stale.Interval = 10
+	created := types.FixtureCheckConfig("check2")
+	eventually(t, func() bool {
+		s.checks.mu.RLock()
+		defer s.checks.mu.RUnlock()
+		return s.checks.namespaces[namespace{org: "default", env: "default"}] != nil
+	})
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchUpdate, CheckConfig: stale, Revision: 5}
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchCreate, CheckConfig: created, Revision: 12}
+	// Wait for the updates to be pending, with an update of another namespace
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchCreate, CheckConfig: &types.CheckConfig{
+		Name:         "check3",
+		Organization: "default",
+		Environment:  "dev",
+	}}
+	close(loading)
+	<-done
+
+	// The updates made before the checks were listed are not replayed
+	checks, err := s.GetCheckConfigs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.CheckConfig{loaded, created}, checks)
+
+	// Nor applied once they are loaded
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchDelete, CheckConfig: stale, Revision: 8}
+	w.checks <- store.WatchEventCheckConfig{Action: store.WatchUpdate, CheckConfig: stale, Revision: 13}
+	eventually(t, func() bool {
+		check, err := s.GetCheckConfigByName(ctx, "check1")
+		require.NoError(t, err)
+		return check != nil && check.Interval == stale.Interval
+	})
+}
+
+func TestCheckConfigRevision(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	// Reads requesting the revision of the check are left to the wrapped store
+	var revision int64
+	ctx := store.ContextWithRevision(namespaceContext("default", "default"), &revision)
+	st.On("GetCheckConfigByName", ctx, "check1").Return(types.FixtureCheckConfig("check1"), nil)
+	check, err := s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.NotNil(t, check)
+	st.AssertNotCalled(t, "GetCheckConfigs", mock.Anything)
+}
+
+func TestWildcardNamespaces(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	// Requests spanning several namespaces are never cached
+	ctx := namespaceContext(types.OrganizationTypeAll, types.EnvironmentTypeAll)
+	st.On("GetEntities", ctx).Return([]*types.Entity{types.FixtureEntity("entity1")}, nil)
+	for i := 0; i < 2; i++ {
+		entities, err := s.GetEntities(ctx)
+		require.NoError(t, err)
+		assert.Len(t, entities, 1)
+	}
+	st.AssertNumberOfCalls(t, "GetEntities", 2)
+}
+
+func TestEntities(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	ctx := namespaceContext("default", "default")
+	entity1 := types.FixtureEntity("entity1")
+	st.On("GetEntities", loadContext(ctx)).Return([]*types.Entity{entity1}, nil).Once()
+
+	entity, err := s.GetEntityByID(ctx, "entity1")
+	require.NoError(t, err)
+	assert.Equal(t, entity1, entity)
+
+	entity2 := types.FixtureEntity("entity2")
+	w.entities <- store.WatchEventEntity{Action: store.WatchCreate, Entity: entity2}
+	eventually(t, func() bool {
+		entity, err := s.GetEntityByID(ctx, "entity2")
+		require.NoError(t, err)
+		return entity != nil
+	})
+	entities, err := s.GetEntities(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Entity{entity1, entity2}, entities)
+	st.AssertNumberOfCalls(t, "GetEntities", 1)
+}
+
+func TestEnvironments(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	env := types.FixtureEnvironment("default")
+	st.On("GetEnvironment", mock.Anything, "default", "default").Return(env, nil).Once()
+	st.On("GetEnvironment", mock.Anything, "default", "dev").Return((*types.Environment)(nil), nil).Once()
+
+	// The environments are loaded once, whatever the namespace of the context
+	for i := 0; i < 2; i++ {
+		environment, err := s.GetEnvironment(context.Background(), "default", "default")
+		require.NoError(t, err)
+		assert.Equal(t, env, environment)
+		environment, err = s.GetEnvironment(context.Background(), "default", "dev")
+		require.NoError(t, err)
+		assert.Nil(t, environment)
+	}
+	st.AssertNumberOfCalls(t, "GetEnvironment", 2)
+
+	// And are kept up to date by the watcher
+	updated := types.FixtureEnvironment("default")
+	updated.Redact = []string{"password"}
+	w.environments <- store.WatchEventEnvironment{Action: store.WatchUpdate, Environment: updated}
+	dev := types.FixtureEnvironment("dev")
+	w.environments <- store.WatchEventEnvironment{Action: store.WatchCreate, Environment: dev}
+	eventually(t, func() bool {
+		environment, err := s.GetEnvironment(context.Background(), "default", "default")
+		require.NoError(t, err)
+		return assert.ObjectsAreEqual(updated, environment)
+	})
+	eventually(t, func() bool {
+		environment, err := s.GetEnvironment(context.Background(), "default", "dev")
+		require.NoError(t, err)
+		return environment != nil
+	})
+
+	w.environments <- store.WatchEventEnvironment{Action: store.WatchDelete, Environment: &types.Environment{
+		Name:         "dev",
+		Organization: "default",
+	}}
+	eventually(t, func() bool {
+		environment, err := s.GetEnvironment(context.Background(), "default", "dev")
+		require.NoError(t, err)
+		return environment == nil
+	})
+	st.AssertNumberOfCalls(t, "GetEnvironment", 2)
+}
+
+func TestSilencedEntries(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	defer s.Stop()
+	defer w.close()
+
+	ctx := namespaceContext("default", "default")
+	entity := types.FixtureSilenced("entity:entity1:*")
+	linux := types.FixtureSilenced("linux:check1")
+	all := types.FixtureSilenced("*:check1")
+	st.On("GetSilencedEntries", loadContext(ctx)).Return([]*types.Silenced{entity, linux, all}, nil).Once()
+
+	entries, err := s.GetSilencedEntriesBySubscription(ctx, "linux")
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Silenced{linux}, entries)
+
+	entries, err = s.GetSilencedEntriesByCheckName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Silenced{all, linux}, entries)
+
+	entry, err := s.GetSilencedEntryByID(ctx, "entity:entity1:*")
+	require.NoError(t, err)
+	assert.Equal(t, entity, entry)
+
+	// Expired entries are deleted by the watcher
+	w.silenced <- store.WatchEventSilenced{Action: store.WatchDelete, Silenced: &types.Silenced{
+		ID:           "linux:check1",
+		Organization: "default",
+		Environment:  "default",
+	}}
+	eventually(t, func() bool {
+		entry, err := s.GetSilencedEntryByID(ctx, "linux:check1")
+		require.NoError(t, err)
+		return entry == nil
+	})
+	st.AssertNumberOfCalls(t, "GetSilencedEntries", 1)
+
+	// Invalid requests are left to the wrapped store
+	st.On("GetSilencedEntriesBySubscription", ctx).Return([]*types.Silenced(nil), errors.New("must specify subscription"))
+	_, err = s.GetSilencedEntriesBySubscription(ctx, "")
+	assert.Error(t, err)
+}
+
+func TestStopped(t *testing.T) {
+	s, st, w := newStoreTest(t)
+	w.close()
+	require.NoError(t, s.Stop())
+
+	// Requests are not cached while the watchers are stopped
+	ctx := namespaceContext("default", "default")
+	st.On("GetCheckConfigByName", ctx, "check1").Return(types.FixtureCheckConfig("check1"), nil)
+	check, err := s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.NotNil(t, check)
+	st.AssertNotCalled(t, "GetCheckConfigs", mock.Anything)
+}
@@ -29,13 +29,26 @@ func getAssetsPath(ctx context.Context, name string) string {
 	return assetKeyBuilder.WithOrg(org).Build(name)
 }
 
+// unmarshalAsset decodes the given stored asset, migrating its legacy metadata
+func unmarshalAsset(b []byte) (*types.Asset, error) {
+	asset := &types.Asset{}
+	if err := json.Unmarshal(b, asset); err != nil {
+		return nil, err
+	}
+	if err := types.MigrateAssetMetadata(asset, b); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
 // DeleteAssetByName deletes an asset by name.
 func (s *Store) DeleteAssetByName(ctx context.Context, name string) error {
 	if name == "" {
 		return errors.New("must specify name")
 	}
 
-	_, err := s.client.Delete(ctx, getAssetsPath(ctx, name))
+	key := getAssetsPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -51,8 +64,7 @@ func (s *Store) GetAssets(ctx context.Context) ([]*types.Asset, error) {
 
 	assetArray := make([]*types.Asset, len(resp.Kvs))
 	for i, kv := range resp.Kvs {
-		asset := &types.Asset{}
-		err = json.Unmarshal(kv.Value, asset)
+		asset, err := unmarshalAsset(kv.Value)
 		if err != nil {
 			return nil, err
 		}
@@ -72,17 +84,12 @@ func (s *Store) GetAssetByName(ctx context.Context, name string) (*types.Asset,
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, nil
 	}
 
-	assetBytes := resp.Kvs[0].Value
-	asset := &types.Asset{}
-	if err := json.Unmarshal(assetBytes, asset); err != nil {
-		return nil, err
-	}
-
-	return asset, nil
+	return unmarshalAsset(resp.Kvs[0].Value)
 }
 
 // UpdateAsset updates an asset.
@@ -98,7 +105,7 @@ func (s *Store) UpdateAsset(ctx context.Context, asset *types.Asset) error {
 
 	cmp := clientv3.Compare(clientv3.Version(getOrganizationsPath(asset.Organization)), ">", 0)
 	req := clientv3.OpPut(getAssetPath(asset), string(assetBytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getAssetPath(asset), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,73 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	auditPathPrefix = "audit"
+)
+
+var (
+	auditKeyBuilder = store.NewKeyBuilder(auditPathPrefix)
+)
+
+// getAuditPath returns the key of the given entry, ordered by timestamp.
+func getAuditPath(entry *types.AuditEntry) string {
+	ns := store.Namespace{Org: entry.Organization, Env: entry.Environment}
+	return auditKeyBuilder.WithNamespace(ns).Build(fmt.Sprintf("%019d", entry.Timestamp), entry.ID)
+}
+
+func getAuditsPath(ctx context.Context, name string) string {
+	return auditKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// CreateAuditEntry records the given entry, within its organization and
+// environment, for the given number of seconds.
+func (s *Store) CreateAuditEntry(ctx context.Context, entry *types.AuditEntry, ttl int64) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	// Obtain new lease, the entry is deleted once it expires
+	lease, err := s.client.Grant(ctx, ttl)
+	if err != nil {
+		return err
+	}
+
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Put(ctx, getAuditPath(entry), string(entryBytes), clientv3.WithLease(lease.ID))
+	return err
+}
+
+// GetAuditEntries returns the entries recorded within the organization and
+// environment stored in ctx, oldest first.
+func (s *Store) GetAuditEntries(ctx context.Context) ([]*types.AuditEntry, error) {
+	resp, err := query(ctx, s, getAuditsPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.AuditEntry{}, nil
+	}
+
+	entries := make([]*types.AuditEntry, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		entry := &types.AuditEntry{}
+		if err := json.Unmarshal(kv.Value, entry); err != nil {
+			return nil, err
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
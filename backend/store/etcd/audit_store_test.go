@@ -0,0 +1,51 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+		// We should receive an empty slice if no results were found
+		entries, err := store.GetAuditEntries(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, entries)
+		assert.Empty(t, entries)
+
+		newer := types.FixtureAuditEntry("b")
+		newer.Timestamp = 1600000000
+		older := types.FixtureAuditEntry("a")
+		other := types.FixtureAuditEntry("c")
+		other.Environment = "dev"
+		for _, entry := range []*types.AuditEntry{newer, older, other} {
+			require.NoError(t, store.CreateAuditEntry(ctx, entry, 60))
+		}
+
+		// Entries are listed oldest first
+		entries, err = store.GetAuditEntries(ctx)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, older, entries[0])
+		assert.Equal(t, newer, entries[1])
+
+		// Entries of all environments
+		wildcardCtx := context.WithValue(ctx, types.EnvironmentKey, types.EnvironmentTypeAll)
+		entries, err = store.GetAuditEntries(wildcardCtx)
+		require.NoError(t, err)
+		assert.Len(t, entries, 3)
+
+		// Invalid entries are not recorded
+		assert.Error(t, store.CreateAuditEntry(ctx, &types.AuditEntry{}, 60))
+	})
+}
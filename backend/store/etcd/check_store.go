@@ -33,7 +33,8 @@ func (s *Store) DeleteCheckConfigByName(ctx context.Context, name string) error
 		return errors.New("must specify name")
 	}
 
-	_, err := s.client.Delete(ctx, getCheckConfigsPath(ctx, name))
+	key := getCheckConfigsPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -71,6 +72,7 @@ func (s *Store) GetCheckConfigByName(ctx context.Context, name string) (*types.C
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, nil
 	}
@@ -97,7 +99,7 @@ func (s *Store) UpdateCheckConfig(ctx context.Context, check *types.CheckConfig)
 
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(check.Organization, check.Environment)), ">", 0)
 	req := clientv3.OpPut(getCheckConfigPath(check), string(checkBytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getCheckConfigPath(check), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,10 @@
 package etcd
 
 import (
+	"context"
+
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -9,3 +12,56 @@ func environmentExistsForResource(r types.MultitenantResource) clientv3.Cmp {
 	key := getEnvironmentsPath(r.GetOrganization(), r.GetEnvironment())
 	return clientv3.Compare(clientv3.Version(key), ">", 0)
 }
+
+// setRevision sets the revision of the given context, if any, to the revision
+// of the object read with the given response.
+func setRevision(ctx context.Context, resp *clientv3.GetResponse) {
+	if revision := store.RevisionFromContext(ctx); revision != nil && len(resp.Kvs) > 0 {
+		*revision = resp.Kvs[0].ModRevision
+	}
+}
+
+// setListRevision sets the revision of the given context, if any, to the
+// revision of the store the objects of the given response were listed at.
+func setListRevision(ctx context.Context, resp *clientv3.GetResponse) {
+	if revision := store.RevisionFromContext(ctx); revision != nil && resp.Header != nil {
+		*revision = resp.Header.Revision
+	}
+}
+
+// modify commits a transaction running the given operations on the object at
+// the given key, if the given comparisons succeed and the object satisfies the
+// preconditions of the given context. It returns ErrPreconditionFailed if the
+// object does not satisfy them, and the response of the transaction otherwise.
+func modify(ctx context.Context, s *Store, key string, cmps []clientv3.Cmp, ops ...clientv3.Op) (*clientv3.TxnResponse, error) {
+	preconditions := store.PreconditionsFromContext(ctx)
+	for _, p := range preconditions {
+		result := "="
+		if !p.Match {
+			result = "!="
+		}
+		cmps = append(cmps, clientv3.Compare(clientv3.ModRevision(key), result, p.Revision))
+	}
+
+	txn := s.client.Txn(ctx).If(cmps...).Then(ops...)
+	if len(preconditions) > 0 {
+		// Read the object on failure, to tell whether it failed the
+		// preconditions or the other comparisons
+		txn = txn.Else(clientv3.OpGet(key))
+	}
+	resp, err := txn.Commit()
+	if err != nil || resp.Succeeded || len(preconditions) == 0 {
+		return resp, err
+	}
+
+	var revision int64
+	if kvs := resp.Responses[0].GetResponseRange().Kvs; len(kvs) > 0 {
+		revision = kvs[0].ModRevision
+	}
+	for _, p := range preconditions {
+		if !p.Satisfied(revision) {
+			return resp, store.ErrPreconditionFailed
+		}
+	}
+	return resp, nil
+}
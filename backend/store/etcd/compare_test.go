@@ -0,0 +1,65 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreconditions(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		check := types.FixtureCheckConfig("check1")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, check.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, check.Environment)
+
+		// Creating the check only if it does not exist
+		missing := store.ContextWithPrecondition(ctx, store.Precondition{Revision: 0, Match: true})
+		require.NoError(t, s.UpdateCheckConfig(missing, check))
+		assert.Equal(t, store.ErrPreconditionFailed, s.UpdateCheckConfig(missing, check))
+
+		var revision int64
+		_, err := s.GetCheckConfigByName(store.ContextWithRevision(ctx, &revision), check.Name)
+		require.NoError(t, err)
+		require.NotZero(t, revision)
+
+		// Updating the check at the revision read
+		current := store.ContextWithPrecondition(ctx, store.Precondition{Revision: revision, Match: true})
+		check.Interval++
+		require.NoError(t, s.UpdateCheckConfig(current, check))
+
+		// The check was modified since read
+		assert.Equal(t, store.ErrPreconditionFailed, s.UpdateCheckConfig(current, check))
+		assert.Equal(t, store.ErrPreconditionFailed, s.DeleteCheckConfigByName(current, check.Name))
+
+		// The other comparisons still fail on their own
+		other := *check
+		other.Environment = "missing"
+		otherCtx := store.ContextWithPrecondition(ctx, store.Precondition{Revision: 0, Match: true})
+		err = s.UpdateCheckConfig(otherCtx, &other)
+		assert.Error(t, err)
+		assert.NotEqual(t, store.ErrPreconditionFailed, err)
+
+		var latest int64
+		_, err = s.GetCheckConfigByName(store.ContextWithRevision(ctx, &latest), check.Name)
+		require.NoError(t, err)
+		assert.True(t, latest > revision)
+
+		// Listing the checks gives the revision of the store they were listed at
+		var listed int64
+		_, err = s.GetCheckConfigs(store.ContextWithRevision(ctx, &listed))
+		require.NoError(t, err)
+		assert.True(t, listed >= latest)
+
+		existing := store.ContextWithPrecondition(ctx, store.Precondition{Revision: latest, Match: true})
+		require.NoError(t, s.DeleteCheckConfigByName(existing, check.Name))
+		retrieved, err := s.GetCheckConfigByName(ctx, check.Name)
+		require.NoError(t, err)
+		assert.Nil(t, retrieved)
+	})
+}
@@ -32,7 +32,8 @@ func (s *Store) DeleteEntity(ctx context.Context, e *types.Entity) error {
 	if err := e.Validate(); err != nil {
 		return err
 	}
-	_, err := s.client.Delete(ctx, getEntityPath(e))
+	key := getEntityPath(e)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -42,7 +43,8 @@ func (s *Store) DeleteEntityByID(ctx context.Context, id string) error {
 		return errors.New("must specify id")
 	}
 
-	_, err := s.client.Delete(ctx, getEntitiesPath(ctx, id))
+	key := getEntitiesPath(ctx, id)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -56,6 +58,7 @@ func (s *Store) GetEntityByID(ctx context.Context, id string) (*types.Entity, er
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) != 1 {
 		return nil, nil
 	}
@@ -104,7 +107,7 @@ func (s *Store) UpdateEntity(ctx context.Context, e *types.Entity) error {
 
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(e.Organization, e.Environment)), ">", 0)
 	req := clientv3.OpPut(getEntityPath(e), string(eStr))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getEntityPath(e), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
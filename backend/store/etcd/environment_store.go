@@ -38,6 +38,9 @@ func (s *Store) DeleteEnvironment(ctx context.Context, env *types.Environment) e
 		v3.OpGet(entityKeyBuilder.WithContext(ctx).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 		v3.OpGet(handlerKeyBuilder.WithContext(ctx).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 		v3.OpGet(mutatorKeyBuilder.WithContext(ctx).Build(), v3.WithPrefix(), v3.WithCountOnly()),
+		v3.OpGet(secretKeyBuilder.WithContext(ctx).Build(), v3.WithPrefix(), v3.WithCountOnly()),
+		v3.OpGet(serviceKeyBuilder.WithContext(ctx).Build(), v3.WithPrefix(), v3.WithCountOnly()),
+		v3.OpGet(serviceComponentKeyBuilder.WithContext(ctx).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 	).Commit()
 	if err != nil {
 		return err
@@ -61,7 +64,8 @@ func (s *Store) DeleteEnvironment(ctx context.Context, env *types.Environment) e
 		}
 	}
 
-	_, err = s.client.Delete(ctx, getEnvironmentsPath(org, env.Name), v3.WithPrefix())
+	key := getEnvironmentsPath(org, env.Name)
+	_, err = modify(ctx, s, key, nil, v3.OpDelete(key, v3.WithPrefix()))
 	return err
 }
 
@@ -75,6 +79,7 @@ func (s *Store) GetEnvironment(ctx context.Context, org, env string) (*types.Env
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 
 	if len(resp.Kvs) != 1 {
 		// DNE, but not an error
@@ -121,8 +126,9 @@ func (s *Store) UpdateEnvironment(ctx context.Context, env *types.Environment) e
 	// We need to prepare a transaction to verify that the organization under
 	// which we are creating this environment exists
 	cmp := v3.Compare(v3.Version(getOrganizationsPath(org)), ">", 0)
-	req := v3.OpPut(getEnvironmentsPath(org, env.Name), string(bytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	key := getEnvironmentsPath(org, env.Name)
+	req := v3.OpPut(key, string(bytes))
+	res, err := modify(ctx, s, key, []v3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/mvcc/mvccpb"
@@ -198,7 +199,7 @@ func (s *Store) CreateError(ctx context.Context, perr *types.Error) error {
 		perr.Event.Entity.ID,
 		"check", // Eventually will need a conditional when metrics are implemented
 		perr.Event.Check.Name,
-		string(perr.Timestamp),
+		strconv.FormatInt(perr.Timestamp, 10),
 	)
 
 	// Configure transaction
@@ -4,6 +4,7 @@ package etcd
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/sensu/sensu-go/backend/store"
@@ -42,14 +43,14 @@ func TestErrorStorage(t *testing.T) {
 		assert.EqualValues(t, []*types.Error{perr}, checkErrors)
 
 		// GetError
-		perrRes, err := store.GetError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, string(perr.Timestamp))
+		perrRes, err := store.GetError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, strconv.FormatInt(perr.Timestamp, 10))
 		require.NoError(t, err)
 		assert.EqualValues(t, perr, perrRes)
 
 		// Delete error
 		cerr = store.CreateError(ctx, perr) // Ensure error is present
 		require.NoError(t, cerr)
-		err = store.DeleteError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, string(perr.Timestamp))
+		err = store.DeleteError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, strconv.FormatInt(perr.Timestamp, 10))
 		require.NoError(t, err)
 		allErrors, err = store.GetErrors(ctx) // Check that error is gone
 		require.NoError(t, err)
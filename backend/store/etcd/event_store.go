@@ -26,7 +26,7 @@ func getEventPath(event *types.Event) string {
 		eventsPathPrefix,
 		event.Entity.Organization,
 		event.Entity.Environment,
-		event.Entity.ID,
+		event.StreamKey(),
 		event.Check.Name,
 	)
 }
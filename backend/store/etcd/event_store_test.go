@@ -92,3 +92,23 @@ func TestEventStorage(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestDeduplicatedEventStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		event := types.FixtureEvent("poller1", "check1")
+		event.DedupKey = "service1"
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+		require.NoError(t, store.UpdateEvent(ctx, event))
+
+		// The event is stored under its deduplication key
+		newEv, err := store.GetEventByEntityCheck(ctx, "service1", "check1")
+		require.NoError(t, err)
+		assert.EqualValues(t, event, newEv)
+
+		newEv, err = store.GetEventByEntityCheck(ctx, "poller1", "check1")
+		assert.NoError(t, err)
+		assert.Nil(t, newEv)
+	})
+}
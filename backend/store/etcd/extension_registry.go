@@ -37,8 +37,9 @@ func (s *Store) RegisterExtension(ctx context.Context, ext *types.Extension) err
 	}
 
 	cmp := clientv3.Compare(clientv3.Version(getOrganizationsPath(ext.Organization)), ">", 0)
-	req := clientv3.OpPut(getExtensionPath(ctx, ext.Name), string(b))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	key := getExtensionPath(ctx, ext.Name)
+	req := clientv3.OpPut(key, string(b))
+	res, err := modify(ctx, s, key, []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
@@ -58,7 +59,8 @@ func (s *Store) DeregisterExtension(ctx context.Context, name string) error {
 		return errors.New("no extension name specified")
 	}
 
-	_, err := s.client.Delete(ctx, getExtensionPath(ctx, name))
+	key := getExtensionPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -72,6 +74,7 @@ func (s *Store) GetExtension(ctx context.Context, name string) (*types.Extension
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, store.ErrNoExtension
 	}
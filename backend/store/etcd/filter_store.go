@@ -30,12 +30,13 @@ func (s *Store) DeleteEventFilterByName(ctx context.Context, name string) error
 		return errors.New("must specify name of filter")
 	}
 
-	resp, err := s.client.Delete(ctx, getEventFiltersPath(ctx, name))
+	key := getEventFiltersPath(ctx, name)
+	resp, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	if err != nil {
 		return err
 	}
 
-	if resp.Deleted != 1 {
+	if resp.Responses[0].GetResponseDeleteRange().Deleted != 1 {
 		return fmt.Errorf("filter %s does not exist", name)
 	}
 
@@ -76,6 +77,7 @@ func (s *Store) GetEventFilterByName(ctx context.Context, name string) (*types.E
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, nil
 	}
@@ -102,7 +104,7 @@ func (s *Store) UpdateEventFilter(ctx context.Context, filter *types.EventFilter
 
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(filter.Organization, filter.Environment)), ">", 0)
 	req := clientv3.OpPut(getEventFilterPath(filter), string(filterBytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getEventFilterPath(filter), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
@@ -30,7 +30,8 @@ func (s *Store) DeleteHandlerByName(ctx context.Context, name string) error {
 		return errors.New("must specify name of handler")
 	}
 
-	_, err := s.client.Delete(ctx, getHandlersPath(ctx, name))
+	key := getHandlersPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -68,6 +69,7 @@ func (s *Store) GetHandlerByName(ctx context.Context, name string) (*types.Handl
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, nil
 	}
@@ -94,7 +96,7 @@ func (s *Store) UpdateHandler(ctx context.Context, handler *types.Handler) error
 
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(handler.Organization, handler.Environment)), ">", 0)
 	req := clientv3.OpPut(getHandlerPath(handler), string(handlerBytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getHandlerPath(handler), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
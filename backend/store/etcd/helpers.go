@@ -2,9 +2,13 @@ package etcd
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"strings"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -17,7 +21,13 @@ type getObjectsPath func(context.Context, string) string
 // N.B. Even if we only query across organizations, we still need to filter the
 // values returned based on their environment afterwards if the objects type
 // doesn't contain the environment at the top level of the object
-func query(ctx context.Context, store *Store, fn getObjectsPath) (*clientv3.GetResponse, error) {
+//
+// When the context carries a selection predicate, only the page of elements
+// it describes is returned, and the predicate is updated with the continue
+// token of the next page. Since elements are filtered once read, pages are
+// read until the limit of the predicate is reached. Otherwise, the revision
+// of the context, if any, is set to the revision they were listed at.
+func query(ctx context.Context, s *Store, fn getObjectsPath) (*clientv3.GetResponse, error) {
 	// Support "*" as a wildcard
 	var org, env string
 	if org = types.ContextOrganization(ctx); org == types.OrganizationTypeAll {
@@ -35,39 +45,110 @@ func query(ctx context.Context, store *Store, fn getObjectsPath) (*clientv3.GetR
 		ctx = context.WithValue(ctx, types.EnvironmentKey, "")
 	}
 
-	resp, err := store.client.Get(ctx, fn(ctx, ""), clientv3.WithPrefix())
+	key := fn(ctx, "")
+	pred := store.SelectionPredicateFromContext(ctx)
+	resp, err := getPage(ctx, s, key, pred)
 	if err != nil {
 		return resp, err
 	}
+	if pred == nil {
+		setListRevision(ctx, resp)
+	}
 
 	// Return all elements if all environments or assets were requested
 	if env == "" {
 		return resp, nil
 	}
 
-	// Filter elements based on their environment
+	kvs, filtered := filterEnvironment(resp.Kvs, env)
+	if !filtered {
+		return resp, nil
+	}
+	for pred != nil && pred.Limit > 0 && pred.Continue != "" && int64(len(kvs)) < pred.Limit {
+		next := &store.SelectionPredicate{Continue: pred.Continue, Limit: pred.Limit - int64(len(kvs))}
+		page, err := getPage(ctx, s, key, next)
+		if err != nil {
+			return page, err
+		}
+		pageKvs, _ := filterEnvironment(page.Kvs, env)
+		kvs = append(kvs, pageKvs...)
+		pred.Continue = next.Continue
+	}
+	resp.Kvs = kvs
+	return resp, nil
+}
+
+// filterEnvironment returns the given elements which are members of the given
+// environment, and false if they cannot be filtered since they are not
+// conventional objects.
+func filterEnvironment(kvs []*mvccpb.KeyValue, env string) ([]*mvccpb.KeyValue, bool) {
 	var value struct {
 		Environment *string `json:"environment"`
 	}
 
-	for i, kv := range resp.Kvs {
+	filtered := make([]*mvccpb.KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		value.Environment = nil
 		if err := json.Unmarshal(kv.Value, &value); err != nil {
 			// We are dealing with unexpected data, just return the raw data
-			return resp, nil
+			return kvs, false
 		}
 
 		// Check for the existence of the environment key
 		if value.Environment == nil {
 			// We are dealing with an unconvential type of objects (e.g. events)
 			// so just return all elements
-			return resp, nil
+			return kvs, false
 		}
 
 		// Make sure we only keep the elements that are member of the specified env
-		if *value.Environment != env {
-			resp.Kvs = append(resp.Kvs[:i], resp.Kvs[i+1:]...)
+		if *value.Environment == env {
+			filtered = append(filtered, kv)
 		}
 	}
+	return filtered, true
+}
+
+// getPage gets the elements with the given key prefix, only selecting the page
+// described by the given predicate, if any. The predicate is updated with the
+// continue token of the next page.
+func getPage(ctx context.Context, s *Store, prefix string, pred *store.SelectionPredicate) (*clientv3.GetResponse, error) {
+	if pred == nil {
+		return s.client.Get(ctx, prefix, clientv3.WithPrefix())
+	}
+
+	start := prefix
+	if pred.Continue != "" {
+		key, err := decodeContinueToken(pred.Continue)
+		if err != nil || !strings.HasPrefix(key, prefix) {
+			return nil, store.ErrInvalidContinueToken
+		}
+		start = key
+	}
+
+	opts := []clientv3.OpOption{clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix))}
+	if pred.Limit > 0 {
+		opts = append(opts, clientv3.WithLimit(pred.Limit))
+	}
+	resp, err := s.client.Get(ctx, start, opts...)
+	if err != nil {
+		return resp, err
+	}
+
+	pred.Continue = ""
+	if resp.More && len(resp.Kvs) > 0 {
+		// Continue from the key following the last element of the page
+		lastKey := string(resp.Kvs[len(resp.Kvs)-1].Key)
+		pred.Continue = encodeContinueToken(lastKey + "\x00")
+	}
+	return resp, nil
+}
+
+func encodeContinueToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
 
-	return resp, err
+func decodeContinueToken(token string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	return string(key), err
 }
@@ -82,3 +82,56 @@ func TestQuery(t *testing.T) {
 		assert.Len(t, resp.Kvs, 3)
 	})
 }
+
+func TestQueryPagination(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		etcd := s.(*Store)
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+		for _, name := range []string{"check1", "check2", "check3"} {
+			require.NoError(t, s.UpdateCheckConfig(ctx, types.FixtureCheckConfig(name)))
+		}
+
+		// First page
+		pred := &store.SelectionPredicate{Limit: 2}
+		pageCtx := store.ContextWithSelectionPredicate(ctx, pred)
+		resp, err := query(pageCtx, etcd, getCheckConfigsPath)
+		require.NoError(t, err)
+		assert.Len(t, resp.Kvs, 2)
+		assert.NotEmpty(t, pred.Continue)
+
+		// Last page
+		resp, err = query(pageCtx, etcd, getCheckConfigsPath)
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.Contains(t, string(resp.Kvs[0].Key), "check3")
+		assert.Empty(t, pred.Continue)
+
+		// The elements of other environments sharing the prefix of the keys
+		// of the environment are filtered out, without shortening the page
+		for _, env := range []string{"de", "de-x"} {
+			e := types.FixtureEnvironment(env)
+			require.NoError(t, s.UpdateEnvironment(ctx, e))
+			for _, name := range []string{"check1", "check2"} {
+				check := types.FixtureCheckConfig(name)
+				check.Environment = env
+				require.NoError(t, s.UpdateCheckConfig(ctx, check))
+			}
+		}
+		pred = &store.SelectionPredicate{Limit: 2}
+		pageCtx = store.ContextWithSelectionPredicate(context.WithValue(ctx, types.EnvironmentKey, "de"), pred)
+		resp, err = query(pageCtx, etcd, getCheckConfigsPath)
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 2)
+		for _, kv := range resp.Kvs {
+			assert.Contains(t, string(kv.Key), "/de/")
+		}
+
+		// Tokens of other resources are rejected
+		pred = &store.SelectionPredicate{Continue: encodeContinueToken("/sensu.io/handlers/default")}
+		pageCtx = store.ContextWithSelectionPredicate(ctx, pred)
+		_, err = query(pageCtx, etcd, getCheckConfigsPath)
+		assert.Equal(t, store.ErrInvalidContinueToken, err)
+	})
+}
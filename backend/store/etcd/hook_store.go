@@ -33,7 +33,8 @@ func (s *Store) DeleteHookConfigByName(ctx context.Context, name string) error {
 		return errors.New("must specify name")
 	}
 
-	_, err := s.client.Delete(ctx, getHookConfigsPath(ctx, name))
+	key := getHookConfigsPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -71,6 +72,7 @@ func (s *Store) GetHookConfigByName(ctx context.Context, name string) (*types.Ho
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, nil
 	}
@@ -97,7 +99,7 @@ func (s *Store) UpdateHookConfig(ctx context.Context, hook *types.HookConfig) er
 
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(hook.Organization, hook.Environment)), ">", 0)
 	req := clientv3.OpPut(getHookConfigPath(hook), string(hookBytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getHookConfigPath(hook), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
@@ -30,7 +30,8 @@ func (s *Store) DeleteMutatorByName(ctx context.Context, name string) error {
 		return errors.New("must specify name of mutator")
 	}
 
-	_, err := s.client.Delete(ctx, getMutatorsPath(ctx, name))
+	key := getMutatorsPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -68,6 +69,7 @@ func (s *Store) GetMutatorByName(ctx context.Context, name string) (*types.Mutat
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) == 0 {
 		return nil, nil
 	}
@@ -94,7 +96,7 @@ func (s *Store) UpdateMutator(ctx context.Context, mutator *types.Mutator) error
 
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(mutator.Organization, mutator.Environment)), ">", 0)
 	req := clientv3.OpPut(getMutatorPath(mutator), string(mutatorBytes))
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, getMutatorPath(mutator), []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
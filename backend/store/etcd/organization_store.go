@@ -46,15 +46,13 @@ func (s *Store) CreateOrganization(ctx context.Context, org *types.Organization)
 
 	orgKey := getOrganizationsPath(org.Name)
 
-	res, err := s.client.Txn(ctx).
-		If(
-			// Ensure the organization does not already exist
-			v3.Compare(v3.Version(orgKey), "=", 0)).
-		Then(
-			// Create both the organization and a default environment
-			v3.OpPut(orgKey, string(orgBytes)),
-			v3.OpPut(getEnvironmentsPath(org.Name, env.Name), string(envBytes)),
-		).Commit()
+	res, err := modify(ctx, s, orgKey,
+		// Ensure the organization does not already exist
+		[]v3.Cmp{v3.Compare(v3.Version(orgKey), "=", 0)},
+		// Create both the organization and a default environment
+		v3.OpPut(orgKey, string(orgBytes)),
+		v3.OpPut(getEnvironmentsPath(org.Name, env.Name), string(envBytes)),
+	)
 	if err != nil {
 		return err
 	}
@@ -82,6 +80,9 @@ func (s *Store) DeleteOrganizationByName(ctx context.Context, name string) error
 		v3.OpGet(assetKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 		v3.OpGet(handlerKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 		v3.OpGet(mutatorKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
+		v3.OpGet(secretKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
+		v3.OpGet(serviceKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
+		v3.OpGet(serviceComponentKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 		v3.OpGet(environmentKeyBuilder.WithOrg(name).Build(), v3.WithPrefix(), v3.WithCountOnly()),
 	).Commit()
 	if err != nil {
@@ -107,12 +108,13 @@ func (s *Store) DeleteOrganizationByName(ctx context.Context, name string) error
 	}
 
 	// Delete the resource
-	resp, err := s.client.Delete(ctx, getOrganizationsPath(name), v3.WithPrefix())
+	key := getOrganizationsPath(name)
+	resp, err := modify(ctx, s, key, nil, v3.OpDelete(key, v3.WithPrefix()))
 	if err != nil {
 		return err
 	}
 
-	if resp.Deleted != 1 {
+	if resp.Responses[0].GetResponseDeleteRange().Deleted != 1 {
 		return fmt.Errorf("organization %s does not exist", name)
 	}
 
@@ -129,6 +131,7 @@ func (s *Store) GetOrganizationByName(ctx context.Context, name string) (*types.
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 
 	if len(resp.Kvs) == 0 {
 		return nil, nil
@@ -168,7 +171,8 @@ func (s *Store) UpdateOrganization(ctx context.Context, org *types.Organization)
 		return err
 	}
 
-	_, err = s.client.Put(ctx, getOrganizationsPath(org.Name), string(bytes))
+	key := getOrganizationsPath(org.Name)
+	_, err = modify(ctx, s, key, nil, v3.OpPut(key, string(bytes)))
 
 	return err
 }
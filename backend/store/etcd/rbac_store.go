@@ -34,6 +34,7 @@ func (s *Store) GetRoleByName(ctx context.Context, name string) (*types.Role, er
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 
 	if len(resp.Kvs) == 0 {
 		return nil, nil
@@ -58,13 +59,15 @@ func (s *Store) UpdateRole(ctx context.Context, role *types.Role) error {
 		return err
 	}
 
-	_, err = s.client.Put(ctx, getRolePath(role.Name), string(roleBytes))
+	key := getRolePath(role.Name)
+	_, err = modify(ctx, s, key, nil, clientv3.OpPut(key, string(roleBytes)))
 	return err
 }
 
 // DeleteRoleByName ...
 func (s *Store) DeleteRoleByName(ctx context.Context, name string) error {
-	_, err := s.client.Delete(ctx, getRolePath(name))
+	key := getRolePath(name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
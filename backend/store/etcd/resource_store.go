@@ -0,0 +1,73 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/types"
+)
+
+// UpdateResources creates or updates the given resources in a single
+// transaction, as long as the organizations and environments they belong to
+// exist.
+func (s *Store) UpdateResources(ctx context.Context, resources []types.Resource) error {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	var cmps []clientv3.Cmp
+	var reqs []clientv3.Op
+	namespaces := map[string]bool{}
+	for _, resource := range resources {
+		if err := resource.Validate(); err != nil {
+			return err
+		}
+		key, namespace, err := resourcePaths(resource)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(resource)
+		if err != nil {
+			return err
+		}
+
+		if !namespaces[namespace] {
+			namespaces[namespace] = true
+			cmps = append(cmps, clientv3.Compare(clientv3.Version(namespace), ">", 0))
+		}
+		reqs = append(reqs, clientv3.OpPut(key, string(value)))
+	}
+
+	res, err := s.client.Txn(ctx).If(cmps...).Then(reqs...).Commit()
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return errors.New("could not create the resources, their organizations or environments do not exist")
+	}
+
+	return nil
+}
+
+// resourcePaths returns the key of the given resource, and the key of the
+// organization or environment it belongs to.
+func resourcePaths(resource types.Resource) (key, namespace string, err error) {
+	switch r := resource.(type) {
+	case *types.Asset:
+		return getAssetPath(r), getOrganizationsPath(r.Organization), nil
+	case *types.CheckConfig:
+		return getCheckConfigPath(r), getEnvironmentsPath(r.Organization, r.Environment), nil
+	case *types.EventFilter:
+		return getEventFilterPath(r), getEnvironmentsPath(r.Organization, r.Environment), nil
+	case *types.Handler:
+		return getHandlerPath(r), getEnvironmentsPath(r.Organization, r.Environment), nil
+	case *types.HookConfig:
+		return getHookConfigPath(r), getEnvironmentsPath(r.Organization, r.Environment), nil
+	case *types.Mutator:
+		return getMutatorPath(r), getEnvironmentsPath(r.Organization, r.Environment), nil
+	}
+	return "", "", fmt.Errorf("resources of type %T can not be stored", resource)
+}
@@ -0,0 +1,46 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateResources(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+		check := types.FixtureCheckConfig("check")
+		handler := types.FixtureHandler("handler")
+		asset := types.FixtureAsset("asset")
+		require.NoError(t, s.UpdateResources(ctx, []types.Resource{check, handler, asset}))
+
+		storedCheck, err := s.GetCheckConfigByName(ctx, "check")
+		require.NoError(t, err)
+		assert.NotNil(t, storedCheck)
+		storedHandler, err := s.GetHandlerByName(ctx, "handler")
+		require.NoError(t, err)
+		assert.NotNil(t, storedHandler)
+		storedAsset, err := s.GetAssetByName(ctx, "asset")
+		require.NoError(t, err)
+		assert.NotNil(t, storedAsset)
+
+		// Nothing is stored if any resource can not be
+		filter := types.FixtureEventFilter("filter")
+		orphan := types.FixtureMutator("mutator")
+		orphan.Environment = "missing"
+		assert.Error(t, s.UpdateResources(ctx, []types.Resource{filter, orphan}))
+		storedFilter, err := s.GetEventFilterByName(ctx, "filter")
+		require.NoError(t, err)
+		assert.Nil(t, storedFilter)
+
+		assert.Error(t, s.UpdateResources(ctx, []types.Resource{types.FixtureEntity("entity")}))
+	})
+}
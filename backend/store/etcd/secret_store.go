@@ -0,0 +1,113 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	secretsPathPrefix = "secrets"
+	secretKeyBuilder  = store.NewKeyBuilder(secretsPathPrefix)
+)
+
+func getSecretPath(secret *types.Secret) string {
+	return secretKeyBuilder.WithResource(secret).Build(secret.Name)
+}
+
+func getSecretsPath(ctx context.Context, name string) string {
+	return secretKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteSecretByName deletes a Secret by name.
+func (s *Store) DeleteSecretByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of secret")
+	}
+
+	key := getSecretsPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
+	return err
+}
+
+// GetSecrets gets the list of secrets for an (optional) organization. If org is
+// the empty string, GetSecrets returns all secrets for all orgs.
+func (s *Store) GetSecrets(ctx context.Context) ([]*types.Secret, error) {
+	resp, err := query(ctx, s, getSecretsPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.Secret{}, nil
+	}
+
+	secretsArray := make([]*types.Secret, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		secret := &types.Secret{}
+		err = json.Unmarshal(kv.Value, secret)
+		if err != nil {
+			return nil, err
+		}
+		secretsArray[i] = secret
+	}
+
+	return secretsArray, nil
+}
+
+// GetSecretByName gets a Secret by name.
+func (s *Store) GetSecretByName(ctx context.Context, name string) (*types.Secret, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of secret")
+	}
+
+	resp, err := s.client.Get(ctx, getSecretsPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	setRevision(ctx, resp)
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	secretBytes := resp.Kvs[0].Value
+	secret := &types.Secret{}
+	if err := json.Unmarshal(secretBytes, secret); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// UpdateSecret updates a Secret.
+func (s *Store) UpdateSecret(ctx context.Context, secret *types.Secret) error {
+	if err := secret.Validate(); err != nil {
+		return err
+	}
+
+	secretBytes, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(secret.Organization, secret.Environment)), ">", 0)
+	req := clientv3.OpPut(getSecretPath(secret), string(secretBytes))
+	res, err := modify(ctx, s, getSecretPath(secret), []clientv3.Cmp{cmp}, req)
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf(
+			"could not create the secret %s in environment %s/%s",
+			secret.Name,
+			secret.Organization,
+			secret.Environment,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		secret := types.FixtureSecret("secret1")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, secret.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, secret.Environment)
+
+		// We should receive an empty slice if no results were found
+		secrets, err := store.GetSecrets(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, secrets)
+
+		err = store.UpdateSecret(ctx, secret)
+		assert.NoError(t, err)
+
+		retrieved, err := store.GetSecretByName(ctx, "secret1")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+
+		assert.Equal(t, secret.Name, retrieved.Name)
+		assert.Equal(t, secret.Provider, retrieved.Provider)
+		assert.Equal(t, secret.ID, retrieved.ID)
+
+		secrets, err = store.GetSecrets(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(secrets))
+
+		// The environment holding a secret can't be deleted
+		err = store.DeleteEnvironment(ctx, types.FixtureEnvironment("default"))
+		assert.Error(t, err)
+
+		err = store.DeleteSecretByName(ctx, "secret1")
+		assert.NoError(t, err)
+		retrieved, err = store.GetSecretByName(ctx, "secret1")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating a secret in a nonexistent org and env should not work
+		secret.Organization = "missing"
+		secret.Environment = "missing"
+		err = store.UpdateSecret(ctx, secret)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,212 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	servicesPathPrefix          = "services"
+	serviceKeyBuilder           = store.NewKeyBuilder(servicesPathPrefix)
+	serviceComponentsPathPrefix = "service-components"
+	serviceComponentKeyBuilder  = store.NewKeyBuilder(serviceComponentsPathPrefix)
+)
+
+func getServicePath(service *types.Service) string {
+	return serviceKeyBuilder.WithResource(service).Build(service.Name)
+}
+
+func getServicesPath(ctx context.Context, name string) string {
+	return serviceKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteServiceByName deletes a Service by name.
+func (s *Store) DeleteServiceByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of service")
+	}
+
+	key := getServicesPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
+	return err
+}
+
+// GetServices gets the list of services for an (optional) organization. If org
+// is the empty string, GetServices returns all services for all orgs.
+func (s *Store) GetServices(ctx context.Context) ([]*types.Service, error) {
+	resp, err := query(ctx, s, getServicesPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.Service{}, nil
+	}
+
+	servicesArray := make([]*types.Service, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		service := &types.Service{}
+		err = json.Unmarshal(kv.Value, service)
+		if err != nil {
+			return nil, err
+		}
+		servicesArray[i] = service
+	}
+
+	return servicesArray, nil
+}
+
+// GetServiceByName gets a Service by name.
+func (s *Store) GetServiceByName(ctx context.Context, name string) (*types.Service, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of service")
+	}
+
+	resp, err := s.client.Get(ctx, getServicesPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	setRevision(ctx, resp)
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	serviceBytes := resp.Kvs[0].Value
+	service := &types.Service{}
+	if err := json.Unmarshal(serviceBytes, service); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// UpdateService updates a Service.
+func (s *Store) UpdateService(ctx context.Context, service *types.Service) error {
+	if err := service.Validate(); err != nil {
+		return err
+	}
+
+	serviceBytes, err := json.Marshal(service)
+	if err != nil {
+		return err
+	}
+
+	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(service.Organization, service.Environment)), ">", 0)
+	req := clientv3.OpPut(getServicePath(service), string(serviceBytes))
+	res, err := modify(ctx, s, getServicePath(service), []clientv3.Cmp{cmp}, req)
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf(
+			"could not create the service %s in environment %s/%s",
+			service.Name,
+			service.Organization,
+			service.Environment,
+		)
+	}
+
+	return nil
+}
+
+func getServiceComponentPath(component *types.ServiceComponent) string {
+	return serviceComponentKeyBuilder.WithResource(component).Build(component.Name)
+}
+
+func getServiceComponentsPath(ctx context.Context, name string) string {
+	return serviceComponentKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteServiceComponentByName deletes a ServiceComponent by name.
+func (s *Store) DeleteServiceComponentByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of service component")
+	}
+
+	key := getServiceComponentsPath(ctx, name)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
+	return err
+}
+
+// GetServiceComponents gets the list of service components for an (optional)
+// organization. If org is the empty string, GetServiceComponents returns all
+// service components for all orgs.
+func (s *Store) GetServiceComponents(ctx context.Context) ([]*types.ServiceComponent, error) {
+	resp, err := query(ctx, s, getServiceComponentsPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.ServiceComponent{}, nil
+	}
+
+	serviceComponentsArray := make([]*types.ServiceComponent, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		component := &types.ServiceComponent{}
+		err = json.Unmarshal(kv.Value, component)
+		if err != nil {
+			return nil, err
+		}
+		serviceComponentsArray[i] = component
+	}
+
+	return serviceComponentsArray, nil
+}
+
+// GetServiceComponentByName gets a ServiceComponent by name.
+func (s *Store) GetServiceComponentByName(ctx context.Context, name string) (*types.ServiceComponent, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of service component")
+	}
+
+	resp, err := s.client.Get(ctx, getServiceComponentsPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	setRevision(ctx, resp)
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	componentBytes := resp.Kvs[0].Value
+	component := &types.ServiceComponent{}
+	if err := json.Unmarshal(componentBytes, component); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+// UpdateServiceComponent updates a ServiceComponent.
+func (s *Store) UpdateServiceComponent(ctx context.Context, component *types.ServiceComponent) error {
+	if err := component.Validate(); err != nil {
+		return err
+	}
+
+	componentBytes, err := json.Marshal(component)
+	if err != nil {
+		return err
+	}
+
+	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(component.Organization, component.Environment)), ">", 0)
+	req := clientv3.OpPut(getServiceComponentPath(component), string(componentBytes))
+	res, err := modify(ctx, s, getServiceComponentPath(component), []clientv3.Cmp{cmp}, req)
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf(
+			"could not create the service component %s in environment %s/%s",
+			component.Name,
+			component.Organization,
+			component.Environment,
+		)
+	}
+
+	return nil
+}
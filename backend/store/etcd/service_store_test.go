@@ -0,0 +1,102 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		service := types.FixtureService("webstore")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, service.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, service.Environment)
+
+		// We should receive an empty slice if no results were found
+		services, err := store.GetServices(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, services)
+
+		err = store.UpdateService(ctx, service)
+		assert.NoError(t, err)
+
+		retrieved, err := store.GetServiceByName(ctx, "webstore")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, service.Name, retrieved.Name)
+
+		services, err = store.GetServices(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(services))
+
+		// The components of services are stored apart from them
+		components, err := store.GetServiceComponents(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(components))
+
+		// The environment holding a service can't be deleted
+		err = store.DeleteEnvironment(ctx, types.FixtureEnvironment("default"))
+		assert.Error(t, err)
+
+		err = store.DeleteServiceByName(ctx, "webstore")
+		assert.NoError(t, err)
+		retrieved, err = store.GetServiceByName(ctx, "webstore")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating a service in a nonexistent org and env should not work
+		service.Organization = "missing"
+		service.Environment = "missing"
+		err = store.UpdateService(ctx, service)
+		assert.Error(t, err)
+	})
+}
+
+func TestServiceComponentStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		component := types.FixtureServiceComponent("web", "webstore")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, component.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, component.Environment)
+
+		// We should receive an empty slice if no results were found
+		components, err := store.GetServiceComponents(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, components)
+
+		err = store.UpdateServiceComponent(ctx, component)
+		assert.NoError(t, err)
+
+		retrieved, err := store.GetServiceComponentByName(ctx, "web")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, component.Name, retrieved.Name)
+		assert.Equal(t, component.Services, retrieved.Services)
+		assert.Equal(t, component.Rules, retrieved.Rules)
+
+		components, err = store.GetServiceComponents(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(components))
+
+		// The environment holding a component can't be deleted
+		err = store.DeleteEnvironment(ctx, types.FixtureEnvironment("default"))
+		assert.Error(t, err)
+
+		err = store.DeleteServiceComponentByName(ctx, "web")
+		assert.NoError(t, err)
+		retrieved, err = store.GetServiceComponentByName(ctx, "web")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating a component in a nonexistent org and env should not work
+		component.Organization = "missing"
+		component.Environment = "missing"
+		err = store.UpdateServiceComponent(ctx, component)
+		assert.Error(t, err)
+	})
+}
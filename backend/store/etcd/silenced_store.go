@@ -35,7 +35,8 @@ func (s *Store) DeleteSilencedEntryByID(ctx context.Context, silencedID string)
 		return errors.New("must specify id")
 	}
 
-	_, err := s.client.Delete(ctx, getSilencedPath(ctx, silencedID))
+	key := getSilencedPath(ctx, silencedID)
+	_, err := modify(ctx, s, key, nil, clientv3.OpDelete(key))
 	return err
 }
 
@@ -106,6 +107,7 @@ func (s *Store) GetSilencedEntryByID(ctx context.Context, id string) (*types.Sil
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	silencedArray, err := s.arraySilencedEntries(resp)
 	if err != nil {
 		return nil, err
@@ -128,6 +130,7 @@ func (s *Store) UpdateSilencedEntry(ctx context.Context, silenced *types.Silence
 		return err
 	}
 	var req clientv3.Op
+	key := getSilencedPath(ctx, silenced.ID)
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(silenced.Organization, silenced.Environment)), ">", 0)
 	if silenced.Expire > 0 {
 		// add expire time to begin time, that is the ttl for the lease
@@ -148,11 +151,11 @@ func (s *Store) UpdateSilencedEntry(ctx context.Context, silenced *types.Silence
 			return err
 		}
 
-		req = clientv3.OpPut(getSilencedPath(ctx, silenced.ID), string(silencedBytes), clientv3.WithLease(lease.ID))
+		req = clientv3.OpPut(key, string(silencedBytes), clientv3.WithLease(lease.ID))
 	} else {
-		req = clientv3.OpPut(getSilencedPath(ctx, silenced.ID), string(silencedBytes))
+		req = clientv3.OpPut(key, string(silencedBytes))
 	}
-	res, err := s.client.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := modify(ctx, s, key, []clientv3.Cmp{cmp}, req)
 	if err != nil {
 		return err
 	}
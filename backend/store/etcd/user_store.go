@@ -82,20 +82,17 @@ func (s *Store) DeleteUser(ctx context.Context, user *types.User) error {
 
 	// Construct the list of operations to make in the transaction
 	userKey := getUserPath(user.Username)
-	txn := s.client.Txn(ctx).
+	res, err := modify(ctx, s, userKey,
 		// Ensure that the key exists
-		If(clientv3.Compare(clientv3.CreateRevision(userKey), ">", 0)).
+		[]clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(userKey), ">", 0)},
 		// If key exists, delete user & any access token from allow list
-		Then(
-			clientv3.OpPut(userKey, string(userBytes)),
-			clientv3.OpDelete(
-				getTokenPath(user.Username, ""),
-				clientv3.WithPrefix(),
-			),
-		)
-
-	res, serr := txn.Commit()
-	if serr != nil {
+		clientv3.OpPut(userKey, string(userBytes)),
+		clientv3.OpDelete(
+			getTokenPath(user.Username, ""),
+			clientv3.WithPrefix(),
+		),
+	)
+	if err != nil {
 		return err
 	}
 
@@ -114,6 +111,7 @@ func (s *Store) GetUser(ctx context.Context, username string) (*types.User, erro
 	if err != nil {
 		return nil, err
 	}
+	setRevision(ctx, resp)
 	if len(resp.Kvs) != 1 {
 		return nil, nil
 	}
@@ -170,7 +168,7 @@ func (s *Store) GetAllUsers() ([]*types.User, error) {
 }
 
 // UpdateUser updates a User.
-func (s *Store) UpdateUser(u *types.User) error {
+func (s *Store) UpdateUser(ctx context.Context, u *types.User) error {
 	// Hash the password
 	hash, err := hashPassword(u.Password)
 	if err != nil {
@@ -183,7 +181,8 @@ func (s *Store) UpdateUser(u *types.User) error {
 		return err
 	}
 
-	_, err = s.client.Put(context.TODO(), getUserPath(u.Username), string(bytes))
+	key := getUserPath(u.Username)
+	_, err = modify(ctx, s, key, nil, clientv3.OpPut(key, string(bytes)))
 	return err
 }
 
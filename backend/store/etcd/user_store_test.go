@@ -51,7 +51,7 @@ func TestUserStorage(t *testing.T) {
 
 		mockedUser := types.FixtureUser("bar")
 		mockedUser.Password = password
-		err = store.UpdateUser(mockedUser)
+		err = store.UpdateUser(ctx, mockedUser)
 		assert.NoError(t, err)
 
 		result, err = store.GetUser(ctx, mockedUser.Username)
@@ -0,0 +1,229 @@
+package etcd
+
+import (
+	"context"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// DefaultWatchRetryInterval is the default interval at which a Watcher tries
+// to resume watching after an interruption.
+const DefaultWatchRetryInterval = time.Second
+
+// WatchDecoder decodes the value of a key watched into the object of its
+// watch events. The value is nil for a deleted key whose previous value is
+// unknown, in which case the object must be decoded from the key itself.
+type WatchDecoder func(key string, value []byte) (interface{}, error)
+
+// WatchEvent is an event of a Watcher: the action performed on a key, along
+// with its decoded object and its revision.
+type WatchEvent struct {
+	Action   store.WatchActionType
+	Key      string
+	Object   interface{}
+	Revision int64
+}
+
+// Watcher watches the keys under a prefix, decoding their values. Unlike the
+// channels of the etcd client, its channels are only closed once their context
+// is cancelled: when watching is interrupted, e.g. by a disconnection or a
+// leader election, it resumes from the revision following the last one it
+// saw. If that revision was compacted meanwhile, the keys are listed again,
+// and the changes missed are emitted before resuming, so that no watch event
+// is ever lost. Deletions detected this way have no previous value.
+type Watcher struct {
+	client        *clientv3.Client
+	prefix        string
+	decode        WatchDecoder
+	retryInterval time.Duration
+}
+
+// NewWatcher returns a Watcher of the keys under the given prefix, decoding
+// their values with the given decoder.
+func NewWatcher(client *clientv3.Client, prefix string, decode WatchDecoder) *Watcher {
+	return &Watcher{
+		client:        client,
+		prefix:        prefix,
+		decode:        decode,
+		retryInterval: DefaultWatchRetryInterval,
+	}
+}
+
+// watchState is the state of a watch: the revision it resumes after, and the
+// revisions of the keys it knows of, to detect the changes missed when that
+// revision is compacted
+type watchState struct {
+	revision int64
+	keys     map[string]int64
+}
+
+// Watch returns a channel emitting the watch events of the keys changed from
+// now on. The channel is closed once the given context is cancelled.
+func (w *Watcher) Watch(ctx context.Context) <-chan WatchEvent {
+	ch := make(chan WatchEvent)
+	state := &watchState{keys: map[string]int64{}}
+	err := w.list(ctx, state)
+
+	go func() {
+		defer close(ch)
+		for err != nil {
+			logger.WithError(err).WithField("prefix", w.prefix).Error("error listing the keys to watch")
+			if !w.wait(ctx) {
+				return
+			}
+			err = w.list(ctx, state)
+		}
+		w.watch(ctx, state, ch)
+	}()
+	return ch
+}
+
+// list records in the given state the keys to watch, and the revision from
+// which they are watched
+func (w *Watcher) list(ctx context.Context, state *watchState) error {
+	resp, err := w.client.Get(ctx, w.prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return err
+	}
+	for _, kv := range resp.Kvs {
+		state.keys[string(kv.Key)] = kv.ModRevision
+	}
+	state.revision = resp.Header.Revision
+	return nil
+}
+
+// watch emits the watch events of the keys changed after the revision of the
+// given state, until the given context is cancelled
+func (w *Watcher) watch(ctx context.Context, state *watchState, ch chan<- WatchEvent) {
+	for {
+		if !w.watchOnce(ctx, state, ch) || !w.wait(ctx) {
+			return
+		}
+	}
+}
+
+// watchOnce emits the watch events of the keys changed after the revision of
+// the given state until watching is interrupted, and returns whether it may
+// be resumed, i.e. whether the given context is not cancelled
+func (w *Watcher) watchOnce(ctx context.Context, state *watchState, ch chan<- WatchEvent) bool {
+	watchCtx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+
+	watchChan := w.client.Watch(
+		watchCtx,
+		w.prefix,
+		clientv3.WithPrefix(),
+		clientv3.WithRev(state.revision+1),
+		clientv3.WithPrevKV(),
+	)
+	for resp := range watchChan {
+		if resp.CompactRevision != 0 {
+			logger.WithField("prefix", w.prefix).WithField("revision", state.revision).
+				Warning("watched revision compacted, listing the keys again")
+			return w.resync(ctx, state, ch)
+		}
+		if err := resp.Err(); err != nil {
+			logger.WithError(err).WithField("prefix", w.prefix).Warning("watch interrupted, resuming")
+			return ctx.Err() == nil
+		}
+
+		for _, event := range resp.Events {
+			key := string(event.Kv.Key)
+			value := event.Kv.Value
+			if event.Type == mvccpb.DELETE {
+				delete(state.keys, key)
+				value = nil
+				if event.PrevKv != nil {
+					value = event.PrevKv.Value
+				}
+			} else {
+				state.keys[key] = event.Kv.ModRevision
+			}
+			state.revision = event.Kv.ModRevision
+
+			if !w.emit(ctx, ch, GetWatcherAction(event), key, value, event.Kv.ModRevision) {
+				return false
+			}
+		}
+	}
+	return ctx.Err() == nil
+}
+
+// resync lists the keys watched, emits the changes missed since the revision
+// of the given state, and returns whether watching may be resumed
+func (w *Watcher) resync(ctx context.Context, state *watchState, ch chan<- WatchEvent) bool {
+	resp, err := w.client.Get(ctx, w.prefix, clientv3.WithPrefix())
+	if err != nil {
+		logger.WithError(err).WithField("prefix", w.prefix).Error("error listing the keys watched")
+		return ctx.Err() == nil
+	}
+	revision := resp.Header.Revision
+
+	listed := make(map[string]bool, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		key := string(kv.Key)
+		listed[key] = true
+
+		action := store.WatchUpdate
+		if known, ok := state.keys[key]; !ok {
+			action = store.WatchCreate
+		} else if known == kv.ModRevision {
+			continue
+		}
+		state.keys[key] = kv.ModRevision
+		if !w.emit(ctx, ch, action, key, kv.Value, kv.ModRevision) {
+			return false
+		}
+	}
+
+	for key := range state.keys {
+		if listed[key] {
+			continue
+		}
+		delete(state.keys, key)
+		if !w.emit(ctx, ch, store.WatchDelete, key, nil, revision) {
+			return false
+		}
+	}
+
+	state.revision = revision
+	return true
+}
+
+// emit decodes and sends a watch event, and returns false if the given
+// context was cancelled before it could be sent. Events whose value cannot be
+// decoded are dropped.
+func (w *Watcher) emit(ctx context.Context, ch chan<- WatchEvent, action store.WatchActionType, key string, value []byte, revision int64) bool {
+	if action == store.WatchUnknown {
+		logger.WithField("key", key).Error("unknown etcd watch action")
+	}
+
+	object, err := w.decode(key, value)
+	if err != nil {
+		logger.WithField("key", key).WithError(err).Error("unable to decode the value of a key watched")
+		return true
+	}
+
+	select {
+	case ch <- WatchEvent{Action: action, Key: key, Object: object, Revision: revision}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// wait waits for the retry interval, and returns false if the given context
+// was cancelled meanwhile
+func (w *Watcher) wait(ctx context.Context) bool {
+	timer := time.NewTimer(w.retryInterval)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
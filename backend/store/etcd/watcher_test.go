@@ -0,0 +1,214 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeString(key string, value []byte) (interface{}, error) {
+	return string(value), nil
+}
+
+func receiveWatchEvent(t *testing.T, ch <-chan WatchEvent) WatchEvent {
+	select {
+	case event, ok := <-ch:
+		require.True(t, ok, "watch channel closed")
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch event received")
+	}
+	return WatchEvent{}
+}
+
+func TestWatcher(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		client := s.(*Store).client
+		ctx, cancel := context.WithCancel(context.Background())
+
+		_, err := client.Put(ctx, "/watcher/existing", "0")
+		require.NoError(t, err)
+
+		ch := NewWatcher(client, "/watcher/", decodeString).Watch(ctx)
+
+		_, err = client.Put(ctx, "/watcher/a", "1")
+		require.NoError(t, err)
+		event := receiveWatchEvent(t, ch)
+		assert.Equal(t, store.WatchCreate, event.Action)
+		assert.Equal(t, "/watcher/a", event.Key)
+		assert.Equal(t, "1", event.Object)
+
+		_, err = client.Put(ctx, "/watcher/a", "2")
+		require.NoError(t, err)
+		event = receiveWatchEvent(t, ch)
+		assert.Equal(t, store.WatchUpdate, event.Action)
+		assert.Equal(t, "2", event.Object)
+
+		// Deleted keys are decoded from their previous value
+		_, err = client.Delete(ctx, "/watcher/a")
+		require.NoError(t, err)
+		event = receiveWatchEvent(t, ch)
+		assert.Equal(t, store.WatchDelete, event.Action)
+		assert.Equal(t, "2", event.Object)
+
+		// The channel is closed once the context is cancelled
+		cancel()
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok)
+		case <-time.After(5 * time.Second):
+			t.Fatal("watch channel not closed")
+		}
+	})
+}
+
+func TestWatcherCompaction(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		client := s.(*Store).client
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var resp *clientv3.PutResponse
+		state := &watchState{keys: map[string]int64{}}
+		for _, key := range []string{"/watcher/a", "/watcher/b", "/watcher/c"} {
+			var err error
+			resp, err = client.Put(ctx, key, "1")
+			require.NoError(t, err)
+			state.keys[key] = resp.Header.Revision
+		}
+		state.revision = resp.Header.Revision
+
+		// Change the keys after the revision of the watch, then compact it
+		_, err := client.Put(ctx, "/watcher/a", "2")
+		require.NoError(t, err)
+		_, err = client.Delete(ctx, "/watcher/b")
+		require.NoError(t, err)
+		resp, err = client.Put(ctx, "/watcher/d", "1")
+		require.NoError(t, err)
+		_, err = client.Compact(ctx, resp.Header.Revision)
+		require.NoError(t, err)
+
+		// The changes missed are emitted once the keys are listed again
+		w := NewWatcher(client, "/watcher/", decodeString)
+		ch := make(chan WatchEvent)
+		go w.watch(ctx, state, ch)
+
+		event := receiveWatchEvent(t, ch)
+		assert.Equal(t, WatchEvent{Action: store.WatchUpdate, Key: "/watcher/a", Object: "2", Revision: event.Revision}, event)
+		event = receiveWatchEvent(t, ch)
+		assert.Equal(t, WatchEvent{Action: store.WatchCreate, Key: "/watcher/d", Object: "1", Revision: resp.Header.Revision}, event)
+		event = receiveWatchEvent(t, ch)
+		assert.Equal(t, store.WatchDelete, event.Action)
+		assert.Equal(t, "/watcher/b", event.Key)
+		assert.Equal(t, "", event.Object)
+
+		// Watching then resumes
+		_, err = client.Put(ctx, "/watcher/c", "2")
+		require.NoError(t, err)
+		event = receiveWatchEvent(t, ch)
+		assert.Equal(t, store.WatchUpdate, event.Action)
+		assert.Equal(t, "/watcher/c", event.Key)
+	})
+}
+
+func TestCheckConfigWatcher(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		check := types.FixtureCheckConfig("check")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, check.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, check.Environment)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ch := s.GetCheckConfigWatcher(ctx)
+
+		require.NoError(t, s.UpdateCheckConfig(ctx, check))
+		select {
+		case event := <-ch:
+			assert.Equal(t, store.WatchCreate, event.Action)
+			assert.Equal(t, "check", event.CheckConfig.Name)
+			assert.Equal(t, check.Command, event.CheckConfig.Command)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no watch event received")
+		}
+
+		require.NoError(t, s.DeleteCheckConfigByName(ctx, "check"))
+		select {
+		case event := <-ch:
+			assert.Equal(t, store.WatchDelete, event.Action)
+			assert.Equal(t, "check", event.CheckConfig.Name)
+			assert.Equal(t, check.Organization, event.CheckConfig.Organization)
+			assert.Equal(t, check.Environment, event.CheckConfig.Environment)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no watch event received")
+		}
+	})
+}
+
+func TestEnvironmentWatcher(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch := s.GetEnvironmentWatcher(ctx)
+
+		env := types.FixtureEnvironment("dev")
+		env.Redact = []string{"password"}
+		require.NoError(t, s.UpdateEnvironment(ctx, env))
+		select {
+		case event := <-ch:
+			assert.Equal(t, store.WatchCreate, event.Action)
+			assert.Equal(t, env, event.Environment)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no watch event received")
+		}
+
+		require.NoError(t, s.DeleteEnvironment(ctx, env))
+		select {
+		case event := <-ch:
+			assert.Equal(t, store.WatchDelete, event.Action)
+			assert.Equal(t, "dev", event.Environment.Name)
+			assert.Equal(t, env.Organization, event.Environment.Organization)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no watch event received")
+		}
+	})
+}
+
+func TestWatchDecoders(t *testing.T) {
+	// Deleted resources without a previous value are decoded from their key
+	asset := types.FixtureAsset("asset")
+	object, err := decodeAsset(getAssetPath(asset), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Asset{Name: "asset", Organization: asset.Organization}, object)
+
+	check := types.FixtureCheckConfig("check")
+	object, err = decodeCheckConfig(getCheckConfigPath(check), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &types.CheckConfig{Name: "check", Organization: check.Organization, Environment: check.Environment}, object)
+
+	entity := types.FixtureEntity("entity")
+	object, err = decodeEntity(getEntityPath(entity), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Entity{ID: "entity", Organization: entity.Organization, Environment: entity.Environment}, object)
+
+	env := types.FixtureEnvironment("dev")
+	object, err = decodeEnvironment(getEnvironmentsPath(env.Organization, env.Name), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Environment{Name: "dev", Organization: env.Organization}, object)
+
+	silenced := types.FixtureSilenced("linux:check")
+	object, err = decodeSilenced(silencedKeyBuilder.WithResource(silenced).Build(silenced.ID), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Silenced{ID: "linux:check", Organization: silenced.Organization, Environment: silenced.Environment}, object)
+
+	_, err = decodeHookConfig(hookKeyBuilder.Build("hook"), []byte("{"))
+	assert.Error(t, err)
+}
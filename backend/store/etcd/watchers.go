@@ -3,6 +3,7 @@ package etcd
 import (
 	"context"
 	"encoding/json"
+	"path"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/mvcc/mvccpb"
@@ -27,50 +28,20 @@ func GetWatcherAction(event *clientv3.Event) store.WatchActionType {
 }
 
 // GetCheckConfigWatcher returns a channel that emits WatchEventCheckConfig structs notifying
-// the caller that a CheckConfig was updated. If the watcher runs into a terminal error
-// or the context passed is cancelled, then the channel will be closed. The caller must
-// restart the watcher, if needed.
+// the caller that a CheckConfig was updated. The channel is closed once the context passed
+// is cancelled: the watcher resumes by itself after interruptions.
 func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEventCheckConfig {
 	ch := make(chan store.WatchEventCheckConfig)
+	watcher := NewWatcher(s.client, checkKeyBuilder.Build(""), decodeCheckConfig)
+	watchChan := watcher.Watch(ctx)
 
 	go func() {
-		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, checkKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 		defer close(ch)
-
-		for watchResponse := range watcherChan {
-			for _, event := range watchResponse.Events {
-				var (
-					watchEvent  store.WatchEventCheckConfig
-					action      store.WatchActionType
-					checkConfig *types.CheckConfig
-				)
-
-				action = GetWatcherAction(event)
-				if action == store.WatchUnknown {
-					logger.Error("unknown etcd watch action: ", event.Type.String())
-				}
-
-				if action == store.WatchDelete {
-					key := store.ParseResourceKey(string(event.Kv.Key))
-					checkConfig = &types.CheckConfig{
-						Organization: key.Organization,
-						Environment:  key.Environment,
-						Name:         key.ResourceName,
-					}
-				} else {
-					checkConfig = &types.CheckConfig{}
-					if err := json.Unmarshal(event.Kv.Value, checkConfig); err != nil {
-						logger.WithField("key", event.Kv.Key).WithError(err).Error("unable to unmarshal check config from key")
-					}
-				}
-
-				watchEvent = store.WatchEventCheckConfig{
-					Action:      action,
-					CheckConfig: checkConfig,
-				}
-
-				ch <- watchEvent
+		for event := range watchChan {
+			select {
+			case ch <- store.WatchEventCheckConfig{Action: event.Action, CheckConfig: event.Object.(*types.CheckConfig), Revision: event.Revision}:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
@@ -79,41 +50,20 @@ func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEve
 }
 
 // GetAssetWatcher returns a channel that emits WatchEventAsset structs notifying
-// the caller that an Asset was updated. If the watcher runs into a terminal error
-// or the context passed is cancelled, then the channel will be closed. The caller must
-// restart the watcher, if needed.
+// the caller that an Asset was updated. The channel is closed once the context passed
+// is cancelled: the watcher resumes by itself after interruptions.
 func (s *Store) GetAssetWatcher(ctx context.Context) <-chan store.WatchEventAsset {
 	ch := make(chan store.WatchEventAsset)
+	watcher := NewWatcher(s.client, assetKeyBuilder.Build(""), decodeAsset)
+	watchChan := watcher.Watch(ctx)
 
 	go func() {
-		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, assetKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 		defer close(ch)
-
-		var (
-			watchEvent store.WatchEventAsset
-			action     store.WatchActionType
-			asset      *types.Asset
-		)
-
-		for watchResponse := range watcherChan {
-			for _, event := range watchResponse.Events {
-				action = GetWatcherAction(event)
-				if action == store.WatchUnknown {
-					logger.Error("unknown etcd watch action: ", event.Type.String())
-				}
-
-				asset = &types.Asset{}
-				if err := json.Unmarshal(event.Kv.Value, asset); err != nil {
-					logger.WithField("key", event.Kv.Key).WithError(err).Error("unable to unmarshal check config from key")
-				}
-
-				watchEvent = store.WatchEventAsset{
-					Action: action,
-					Asset:  asset,
-				}
-
-				ch <- watchEvent
+		for event := range watchChan {
+			select {
+			case ch <- store.WatchEventAsset{Action: event.Action, Asset: event.Object.(*types.Asset), Revision: event.Revision}:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
@@ -122,44 +72,169 @@ func (s *Store) GetAssetWatcher(ctx context.Context) <-chan store.WatchEventAsse
 }
 
 // GetHookConfigWatcher returns a channel that emits WatchEventHookConfig structs notifying
-// the caller that a HookConfig was updated. If the watcher runs into a terminal error
-// or the context passed is cancelled, then the channel will be closed. The caller must
-// restart the watcher, if needed.
+// the caller that a HookConfig was updated. The channel is closed once the context passed
+// is cancelled: the watcher resumes by itself after interruptions.
 func (s *Store) GetHookConfigWatcher(ctx context.Context) <-chan store.WatchEventHookConfig {
 	ch := make(chan store.WatchEventHookConfig)
+	watcher := NewWatcher(s.client, hookKeyBuilder.Build(""), decodeHookConfig)
+	watchChan := watcher.Watch(ctx)
 
 	go func() {
-		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, hookKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 		defer close(ch)
+		for event := range watchChan {
+			select {
+			case ch <- store.WatchEventHookConfig{Action: event.Action, HookConfig: event.Object.(*types.HookConfig), Revision: event.Revision}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
 
-		var (
-			watchEvent store.WatchEventHookConfig
-			action     store.WatchActionType
-			hookCfg    *types.HookConfig
-		)
-
-		for watchResponse := range watcherChan {
-			for _, event := range watchResponse.Events {
-				action = GetWatcherAction(event)
-				if action == store.WatchUnknown {
-					logger.Error("unknown etcd watch action: ", event.Type.String())
-				}
-
-				hookCfg = &types.HookConfig{}
-				if err := json.Unmarshal(event.Kv.Value, hookCfg); err != nil {
-					logger.WithField("key", event.Kv.Key).WithError(err).Error("unable to unmarshal check config from key")
-				}
-
-				watchEvent = store.WatchEventHookConfig{
-					Action:     action,
-					HookConfig: hookCfg,
-				}
-
-				ch <- watchEvent
+	return ch
+}
+
+// GetEntityWatcher returns a channel that emits WatchEventEntity structs notifying
+// the caller that an Entity was updated. The channel is closed once the context passed
+// is cancelled: the watcher resumes by itself after interruptions.
+func (s *Store) GetEntityWatcher(ctx context.Context) <-chan store.WatchEventEntity {
+	ch := make(chan store.WatchEventEntity)
+	watchChan := NewWatcher(s.client, entityKeyBuilder.Build(""), decodeEntity).Watch(ctx)
+
+	go func() {
+		defer close(ch)
+		for event := range watchChan {
+			select {
+			case ch <- store.WatchEventEntity{Action: event.Action, Entity: event.Object.(*types.Entity), Revision: event.Revision}:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
 
 	return ch
 }
+
+// GetSilencedWatcher returns a channel that emits WatchEventSilenced structs notifying
+// the caller that a silenced entry was updated. The channel is closed once the context
+// passed is cancelled: the watcher resumes by itself after interruptions.
+func (s *Store) GetSilencedWatcher(ctx context.Context) <-chan store.WatchEventSilenced {
+	ch := make(chan store.WatchEventSilenced)
+	watchChan := NewWatcher(s.client, silencedKeyBuilder.Build(""), decodeSilenced).Watch(ctx)
+
+	go func() {
+		defer close(ch)
+		for event := range watchChan {
+			select {
+			case ch <- store.WatchEventSilenced{Action: event.Action, Silenced: event.Object.(*types.Silenced), Revision: event.Revision}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// GetEnvironmentWatcher returns a channel that emits WatchEventEnvironment structs
+// notifying the caller that an environment was updated. The channel is closed once
+// the context passed is cancelled: the watcher resumes by itself after interruptions.
+func (s *Store) GetEnvironmentWatcher(ctx context.Context) <-chan store.WatchEventEnvironment {
+	ch := make(chan store.WatchEventEnvironment)
+	watchChan := NewWatcher(s.client, environmentKeyBuilder.Build(""), decodeEnvironment).Watch(ctx)
+
+	go func() {
+		defer close(ch)
+		for event := range watchChan {
+			select {
+			case ch <- store.WatchEventEnvironment{Action: event.Action, Environment: event.Object.(*types.Environment), Revision: event.Revision}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// decodeCheckConfig is the WatchDecoder of check configs. The check configs
+// deleted without a previous value are named and namespaced after their key.
+func decodeCheckConfig(key string, value []byte) (interface{}, error) {
+	check := &types.CheckConfig{}
+	if value == nil {
+		k := store.ParseResourceKey(key)
+		check.Organization = k.Organization
+		check.Environment = k.Environment
+		check.Name = k.ResourceName
+		return check, nil
+	}
+	return check, json.Unmarshal(value, check)
+}
+
+// decodeAsset is the WatchDecoder of assets, which are only scoped to an
+// organization. The assets deleted without a previous value are named and
+// namespaced after their key.
+func decodeAsset(key string, value []byte) (interface{}, error) {
+	if value == nil {
+		return &types.Asset{
+			Organization: path.Base(path.Dir(key)),
+			Name:         path.Base(key),
+		}, nil
+	}
+	return unmarshalAsset(value)
+}
+
+// decodeHookConfig is the WatchDecoder of hook configs. The hook configs
+// deleted without a previous value are named and namespaced after their key.
+func decodeHookConfig(key string, value []byte) (interface{}, error) {
+	hook := &types.HookConfig{}
+	if value == nil {
+		k := store.ParseResourceKey(key)
+		hook.Organization = k.Organization
+		hook.Environment = k.Environment
+		hook.Name = k.ResourceName
+		return hook, nil
+	}
+	return hook, json.Unmarshal(value, hook)
+}
+
+// decodeEntity is the WatchDecoder of entities. The entities deleted without a
+// previous value are identified and namespaced after their key.
+func decodeEntity(key string, value []byte) (interface{}, error) {
+	entity := &types.Entity{}
+	if value == nil {
+		k := store.ParseResourceKey(key)
+		entity.Organization = k.Organization
+		entity.Environment = k.Environment
+		entity.ID = k.ResourceName
+		return entity, nil
+	}
+	return entity, json.Unmarshal(value, entity)
+}
+
+// decodeEnvironment is the WatchDecoder of environments, which are only scoped
+// to an organization. The environments deleted without a previous value are
+// named and namespaced after their key.
+func decodeEnvironment(key string, value []byte) (interface{}, error) {
+	if value == nil {
+		return &types.Environment{
+			Organization: path.Base(path.Dir(key)),
+			Name:         path.Base(key),
+		}, nil
+	}
+	env := &types.Environment{}
+	return env, json.Unmarshal(value, env)
+}
+
+// decodeSilenced is the WatchDecoder of silenced entries. The entries deleted
+// without a previous value are identified and namespaced after their key.
+func decodeSilenced(key string, value []byte) (interface{}, error) {
+	silenced := &types.Silenced{}
+	if value == nil {
+		k := store.ParseResourceKey(key)
+		silenced.Organization = k.Organization
+		silenced.Environment = k.Environment
+		silenced.ID = k.ResourceName
+		return silenced, nil
+	}
+	return silenced, json.Unmarshal(value, silenced)
+}
@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	deleteEventQuery = `DELETE FROM events
+		WHERE organization = $1 AND environment = $2 AND entity = $3 AND check_name = $4`
+
+	// The organization and environment of the events queried may be "*" to
+	// match all of them
+	getEventsQuery = `SELECT serialized FROM events
+		WHERE ($1 = '*' OR organization = $1) AND ($2 = '*' OR environment = $2)
+		ORDER BY organization, environment, entity, check_name`
+
+	// A page of events starts after the key of the last event of the previous
+	// page; a null limit selects all of them
+	getEventsPageQuery = `SELECT organization, environment, entity, check_name, serialized FROM events
+		WHERE ($1 = '*' OR organization = $1) AND ($2 = '*' OR environment = $2)
+			AND (organization, environment, entity, check_name) > ($3, $4, $5, $6)
+		ORDER BY organization, environment, entity, check_name
+		LIMIT $7`
+
+	getEventsByEntityQuery = `SELECT serialized FROM events
+		WHERE ($1 = '*' OR organization = $1) AND ($2 = '*' OR environment = $2) AND entity = $3
+		ORDER BY organization, environment, check_name`
+
+	getEventQuery = `SELECT serialized FROM events
+		WHERE organization = $1 AND environment = $2 AND entity = $3 AND check_name = $4`
+
+	updateEventQuery = `INSERT INTO events (organization, environment, entity, check_name, serialized)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (organization, environment, entity, check_name)
+		DO UPDATE SET serialized = excluded.serialized`
+)
+
+// EventStore is a store.EventStore keeping events in the events table of a
+// PostgreSQL database, whose schema is created by Migrate. Events are keyed
+// by the organization and environment of their entity, their stream key and
+// the name of their check, like in etcd.
+type EventStore struct {
+	db *sql.DB
+}
+
+// NewEventStore returns an EventStore using the given database.
+func NewEventStore(db *sql.DB) *EventStore {
+	return &EventStore{db: db}
+}
+
+// DeleteEventByEntityCheck deletes an event by entity ID and check ID.
+func (s *EventStore) DeleteEventByEntityCheck(ctx context.Context, entityID, checkID string) error {
+	if entityID == "" || checkID == "" {
+		return errors.New("must specify entity and check id")
+	}
+
+	org, env := types.ContextOrganization(ctx), types.ContextEnvironment(ctx)
+	_, err := s.db.ExecContext(ctx, deleteEventQuery, org, env, entityID, checkID)
+	return err
+}
+
+// GetEvents returns the events of the organization and environment of the
+// context, only selecting the page described by its selection predicate, if
+// any.
+func (s *EventStore) GetEvents(ctx context.Context) ([]*types.Event, error) {
+	org, env := types.ContextOrganization(ctx), types.ContextEnvironment(ctx)
+	if pred := store.SelectionPredicateFromContext(ctx); pred != nil {
+		return s.getEventsPage(ctx, org, env, pred)
+	}
+	events, err := s.query(ctx, getEventsQuery, org, env)
+	if err != nil {
+		return nil, err
+	}
+	if events == nil {
+		return []*types.Event{}, nil
+	}
+	return events, nil
+}
+
+// getEventsPage returns the page of events described by the given predicate,
+// which is updated with the continue token of the next page.
+func (s *EventStore) getEventsPage(ctx context.Context, org, env string, pred *store.SelectionPredicate) ([]*types.Event, error) {
+	var after eventKey
+	if pred.Continue != "" {
+		if err := after.decode(pred.Continue); err != nil {
+			return nil, store.ErrInvalidContinueToken
+		}
+	}
+	// One more event is read to know if there is a next page
+	var limit interface{}
+	if pred.Limit > 0 {
+		limit = pred.Limit + 1
+	}
+
+	rows, err := s.db.QueryContext(ctx, getEventsPageQuery, org, env, after.Organization, after.Environment, after.Entity, after.Check, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*types.Event{}
+	var last eventKey
+	pred.Continue = ""
+	for rows.Next() {
+		if pred.Limit > 0 && int64(len(events)) == pred.Limit {
+			pred.Continue = last.encode()
+			break
+		}
+		var serialized []byte
+		if err := rows.Scan(&last.Organization, &last.Environment, &last.Entity, &last.Check, &serialized); err != nil {
+			return nil, err
+		}
+		event := &types.Event{}
+		if err := json.Unmarshal(serialized, event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// eventKey is the primary key of an event, encoded in the continue tokens of
+// the pages of events.
+type eventKey struct {
+	Organization string `json:"o"`
+	Environment  string `json:"e"`
+	Entity       string `json:"n"`
+	Check        string `json:"c"`
+}
+
+func (k eventKey) encode() string {
+	b, _ := json.Marshal(k)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (k *eventKey) decode(token string) error {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, k)
+}
+
+// GetEventsByEntity gets all events matching a given entity ID.
+func (s *EventStore) GetEventsByEntity(ctx context.Context, entityID string) ([]*types.Event, error) {
+	if entityID == "" {
+		return nil, errors.New("must specify entity id")
+	}
+
+	org, env := types.ContextOrganization(ctx), types.ContextEnvironment(ctx)
+	return s.query(ctx, getEventsByEntityQuery, org, env, entityID)
+}
+
+// GetEventByEntityCheck gets an event by entity and check ID.
+func (s *EventStore) GetEventByEntityCheck(ctx context.Context, entityID, checkID string) (*types.Event, error) {
+	if entityID == "" || checkID == "" {
+		return nil, errors.New("must specify entity and check id")
+	}
+
+	org, env := types.ContextOrganization(ctx), types.ContextEnvironment(ctx)
+	var serialized []byte
+	err := s.db.QueryRowContext(ctx, getEventQuery, org, env, entityID, checkID).Scan(&serialized)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	event := &types.Event{}
+	if err := json.Unmarshal(serialized, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// UpdateEvent creates or updates an event. Unlike in etcd, the existence of
+// the environment of the event is not verified.
+func (s *EventStore) UpdateEvent(ctx context.Context, event *types.Event) error {
+	if event.Check == nil {
+		return errors.New("event has no check")
+	}
+
+	if err := event.Check.Validate(); err != nil {
+		return err
+	}
+
+	if err := event.Entity.Validate(); err != nil {
+		return err
+	}
+
+	serialized, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(
+		ctx,
+		updateEventQuery,
+		event.Entity.Organization,
+		event.Entity.Environment,
+		event.StreamKey(),
+		event.Check.Name,
+		serialized,
+	)
+	return err
+}
+
+// query returns the events selected by the given query, or nil if there are
+// none
+func (s *EventStore) query(ctx context.Context, query string, args ...interface{}) ([]*types.Event, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*types.Event
+	for rows.Next() {
+		var serialized []byte
+		if err := rows.Scan(&serialized); err != nil {
+			return nil, err
+		}
+		event := &types.Event{}
+		if err := json.Unmarshal(serialized, event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,129 @@
+// +build integration,!race
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWithPostgres runs the given test against the PostgreSQL database of the
+// SENSU_POSTGRES_URL environment variable, skipping it if it is not set. The
+// events table is emptied beforehand.
+func testWithPostgres(t *testing.T, f func(*EventStore)) {
+	url := os.Getenv("SENSU_POSTGRES_URL")
+	if url == "" {
+		t.Skip("SENSU_POSTGRES_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", url)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, Migrate(context.Background(), db))
+	// Migrating again is a no-op
+	require.NoError(t, Migrate(context.Background(), db))
+	_, err = db.Exec("DELETE FROM events")
+	require.NoError(t, err)
+
+	f(NewEventStore(db))
+}
+
+func TestEventStorage(t *testing.T) {
+	testWithPostgres(t, func(store *EventStore) {
+		event := types.FixtureEvent("entity1", "check1")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+		// Set these to nil in order to avoid comparison issues between {} and nil
+		event.Check.Labels = nil
+		event.Check.Annotations = nil
+
+		require.NoError(t, store.UpdateEvent(ctx, event))
+
+		newEv, err := store.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Equal(t, event, newEv)
+
+		// Updating replaces the event
+		event.Check.Output = "updated"
+		require.NoError(t, store.UpdateEvent(ctx, event))
+		newEv, err = store.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Equal(t, "updated", newEv.Check.Output)
+
+		events, err := store.GetEvents(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, event, events[0])
+
+		// Get all events with wildcards
+		ctx = context.WithValue(ctx, types.OrganizationKey, types.OrganizationTypeAll)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, types.EnvironmentTypeAll)
+		events, err = store.GetEvents(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(events))
+
+		// Get events of another environment
+		ctx = context.WithValue(ctx, types.OrganizationKey, event.Entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "dev")
+		events, err = store.GetEvents(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []*types.Event{}, events)
+
+		ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+		events, err = store.GetEventsByEntity(ctx, "entity1")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, event, events[0])
+
+		require.NoError(t, store.DeleteEventByEntityCheck(ctx, "entity1", "check1"))
+		newEv, err = store.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Nil(t, newEv)
+
+		events, err = store.GetEventsByEntity(ctx, "entity1")
+		require.NoError(t, err)
+		assert.Nil(t, events)
+
+		// Updating an event without a check fails
+		event.Check = nil
+		assert.Error(t, store.UpdateEvent(ctx, event))
+	})
+}
+
+func TestEventStoragePagination(t *testing.T) {
+	testWithPostgres(t, func(s *EventStore) {
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+		for _, entity := range []string{"entity1", "entity2", "entity3"} {
+			require.NoError(t, s.UpdateEvent(ctx, types.FixtureEvent(entity, "check1")))
+		}
+
+		pred := &store.SelectionPredicate{Limit: 2}
+		pageCtx := store.ContextWithSelectionPredicate(ctx, pred)
+		events, err := s.GetEvents(pageCtx)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "entity1", events[0].Entity.ID)
+		assert.Equal(t, "entity2", events[1].Entity.ID)
+		require.NotEmpty(t, pred.Continue)
+
+		events, err = s.GetEvents(pageCtx)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "entity3", events[0].Entity.ID)
+		assert.Empty(t, pred.Continue)
+
+		pred.Continue = "invalid"
+		_, err = s.GetEvents(pageCtx)
+		assert.Equal(t, store.ErrInvalidContinueToken, err)
+	})
+}
@@ -0,0 +1,7 @@
+package postgres
+
+import "github.com/sirupsen/logrus"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "store",
+})
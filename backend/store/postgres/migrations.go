@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migrationLockID is the key of the advisory lock held while migrating the
+// schema, so that backends starting at once do not migrate it concurrently
+const migrationLockID = 0x73656e7375
+
+// migrations are the statements creating and updating the schema of the event
+// store, applied in order. A released migration must never be modified:
+// changes to the schema are new migrations.
+var migrations = []string{
+	`CREATE TABLE events (
+		organization text NOT NULL,
+		environment  text NOT NULL,
+		entity       text NOT NULL,
+		check_name   text NOT NULL,
+		serialized   bytea NOT NULL,
+		PRIMARY KEY (organization, environment, entity, check_name)
+	)`,
+}
+
+// Migrate applies to the given database the migrations of the event store
+// schema it is missing, recording the number of migrations applied in the
+// sensu_schema_version table. The migrations are applied in a single
+// transaction, and none of them is applied if one fails.
+func Migrate(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLockID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS sensu_schema_version (version integer NOT NULL)"); err != nil {
+		return err
+	}
+
+	var version int
+	err = tx.QueryRowContext(ctx, "SELECT version FROM sensu_schema_version").Scan(&version)
+	if err == sql.ErrNoRows {
+		_, err = tx.ExecContext(ctx, "INSERT INTO sensu_schema_version (version) VALUES (0)")
+	}
+	if err != nil {
+		return err
+	}
+
+	if version > len(migrations) {
+		return fmt.Errorf("schema version %d is more recent than supported (%d)", version, len(migrations))
+	}
+	if version == len(migrations) {
+		return nil
+	}
+
+	for i := version; i < len(migrations); i++ {
+		if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+			return fmt.Errorf("error applying migration %d: %s", i+1, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE sensu_schema_version SET version = $1", len(migrations)); err != nil {
+		return err
+	}
+
+	logger.WithField("version", len(migrations)).Info("migrated the postgresql event store")
+	return tx.Commit()
+}
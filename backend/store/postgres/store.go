@@ -0,0 +1,349 @@
+// Package postgres provides an event store backed by PostgreSQL, for event
+// volumes exceeding what etcd can handle.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// DefaultHealthInterval is the default interval at which the availability of
+// PostgreSQL is checked.
+const DefaultHealthInterval = 10 * time.Second
+
+// errUnavailable is the status of the store while PostgreSQL is unavailable
+var errUnavailable = errors.New("the postgresql event store is unavailable, events are stored in etcd")
+
+// Store is a store.Store keeping events in PostgreSQL, and everything else in
+// the store it wraps, usually etcd. It is also a daemon checking periodically
+// the availability of PostgreSQL, and migrating its schema once available.
+//
+// While PostgreSQL is unavailable, events are kept in the wrapped store
+// instead. Once it is available again, the events stored meanwhile are moved to
+// PostgreSQL, and those deleted meanwhile are deleted from it, before it is
+// used again.
+type Store struct {
+	store.Store
+
+	events         store.EventStore
+	check          func(context.Context) error
+	close          func() error
+	healthInterval time.Duration
+	available      int32
+	checked        bool
+
+	// mu is held for reading by the event writes, and for writing while the
+	// events of the wrapped store are moved to PostgreSQL
+	mu sync.RWMutex
+
+	// deleted are the events deleted while PostgreSQL was unavailable
+	deletedMu sync.Mutex
+	deleted   map[eventKey]struct{}
+
+	stopping chan struct{}
+	wg       sync.WaitGroup
+	errChan  chan error
+}
+
+// Option is a functional option.
+type Option func(*Store) error
+
+// HealthInterval sets the interval at which the availability of PostgreSQL
+// is checked.
+func HealthInterval(interval time.Duration) Option {
+	return func(s *Store) error {
+		s.healthInterval = interval
+		return nil
+	}
+}
+
+// Open returns a Store keeping events in the PostgreSQL database of the given
+// connection URL, and everything else in the given store. No connection is
+// established until the store is started.
+func Open(fallback store.Store, url string, opts ...Option) (*Store, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated := false
+	check := func(ctx context.Context) error {
+		if !migrated {
+			if err := Migrate(ctx, db); err != nil {
+				return err
+			}
+			migrated = true
+		}
+		return db.PingContext(ctx)
+	}
+
+	s, err := newStore(fallback, NewEventStore(db), check, opts...)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	s.close = db.Close
+	return s, nil
+}
+
+func newStore(fallback store.Store, events store.EventStore, check func(context.Context) error, opts ...Option) (*Store, error) {
+	s := &Store{
+		Store:          fallback,
+		events:         events,
+		check:          check,
+		close:          func() error { return nil },
+		healthInterval: DefaultHealthInterval,
+		deleted:        map[eventKey]struct{}{},
+		stopping:       make(chan struct{}),
+		errChan:        make(chan error, 1),
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start starts the daemon, checking the availability of PostgreSQL before
+// returning. It never fails: events are stored in the wrapped store until
+// PostgreSQL is available.
+func (s *Store) Start() error {
+	s.healthCheck()
+	s.wg.Add(1)
+	go s.run()
+	return nil
+}
+
+// Stop stops the daemon and closes the connections to PostgreSQL.
+func (s *Store) Stop() error {
+	close(s.stopping)
+	s.wg.Wait()
+	close(s.errChan)
+	return s.close()
+}
+
+// Status returns an error while PostgreSQL is unavailable.
+func (s *Store) Status() error {
+	if !s.isAvailable() {
+		return errUnavailable
+	}
+	return nil
+}
+
+// Err returns a channel that the caller can use to listen for terminal errors
+// indicating a premature shutdown of the Daemon.
+func (s *Store) Err() <-chan error {
+	return s.errChan
+}
+
+// Name returns the daemon name
+func (s *Store) Name() string {
+	return "postgres"
+}
+
+func (s *Store) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.healthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-ticker.C:
+			s.healthCheck()
+		}
+	}
+}
+
+// healthCheck updates the availability of PostgreSQL. It is only called by
+// Start and then by the health check loop, never concurrently.
+func (s *Store) healthCheck() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.healthInterval)
+	defer cancel()
+
+	err := s.check(ctx)
+	if err == nil && !s.isAvailable() {
+		if err = s.recover(); err == nil {
+			logger.Info("postgresql event store available")
+		}
+	}
+	if err != nil {
+		if atomic.SwapInt32(&s.available, 0) == 1 || !s.checked {
+			logger.WithError(err).Error("postgresql event store unavailable, storing events in etcd")
+		}
+	}
+	s.checked = true
+}
+
+// recover moves the events stored in the wrapped store to PostgreSQL, deletes
+// from it those deleted while it was unavailable, and then marks it available.
+// The event writes wait meanwhile, so that none is left in the wrapped store.
+// Events rejected by PostgreSQL are left in the wrapped store.
+func (s *Store) recover() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events, err := s.Store.GetEvents(namespaceContext(types.OrganizationTypeAll, types.EnvironmentTypeAll))
+	if err != nil {
+		return err
+	}
+
+	// The events deleted are deleted first, since they may have been stored
+	// again afterwards
+	for key := range s.deleted {
+		ctx := namespaceContext(key.Organization, key.Environment)
+		if err := s.events.DeleteEventByEntityCheck(ctx, key.Entity, key.Check); err != nil {
+			return err
+		}
+	}
+
+	// The events are only deleted from the wrapped store once they are all
+	// stored in PostgreSQL, to still be found there if it fails meanwhile
+	moved := make([]*types.Event, 0, len(events))
+	for _, event := range events {
+		ctx := namespaceContext(event.Entity.Organization, event.Entity.Environment)
+		if err := s.events.UpdateEvent(ctx, event); err != nil {
+			if unavailable(err) {
+				return err
+			}
+			logger.WithError(err).Error("unable to move an event stored in etcd to postgresql")
+			continue
+		}
+		moved = append(moved, event)
+	}
+	for _, event := range moved {
+		ctx := namespaceContext(event.Entity.Organization, event.Entity.Environment)
+		if err := s.Store.DeleteEventByEntityCheck(ctx, event.StreamKey(), event.Check.Name); err != nil {
+			return err
+		}
+	}
+
+	s.deleted = map[eventKey]struct{}{}
+	atomic.StoreInt32(&s.available, 1)
+	return nil
+}
+
+// namespaceContext returns a context of the given organization and
+// environment
+func namespaceContext(org, env string) context.Context {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, org)
+	return context.WithValue(ctx, types.EnvironmentKey, env)
+}
+
+func (s *Store) isAvailable() bool {
+	return atomic.LoadInt32(&s.available) == 1
+}
+
+// failed returns whether the given error is due to PostgreSQL being
+// unavailable, in which case the store falls back to the wrapped store until
+// the next successful health check.
+func (s *Store) failed(err error) bool {
+	if err == nil || !unavailable(err) {
+		return false
+	}
+	if atomic.SwapInt32(&s.available, 0) == 1 {
+		logger.WithError(err).Error("postgresql event store unavailable, storing events in etcd")
+	}
+	return true
+}
+
+// DeleteEventByEntityCheck deletes an event by entity ID and check ID.
+func (s *Store) DeleteEventByEntityCheck(ctx context.Context, entityID, checkID string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.isAvailable() {
+		if err := s.events.DeleteEventByEntityCheck(ctx, entityID, checkID); !s.failed(err) {
+			return err
+		}
+	}
+	if err := s.Store.DeleteEventByEntityCheck(ctx, entityID, checkID); err != nil {
+		return err
+	}
+
+	// The event is deleted from PostgreSQL once available again
+	key := eventKey{
+		Organization: types.ContextOrganization(ctx),
+		Environment:  types.ContextEnvironment(ctx),
+		Entity:       entityID,
+		Check:        checkID,
+	}
+	s.deletedMu.Lock()
+	s.deleted[key] = struct{}{}
+	s.deletedMu.Unlock()
+	return nil
+}
+
+// GetEvents returns the events of the organization and environment of the
+// context.
+func (s *Store) GetEvents(ctx context.Context) ([]*types.Event, error) {
+	if s.isAvailable() {
+		if events, err := s.events.GetEvents(ctx); !s.failed(err) {
+			return events, err
+		}
+	}
+	return s.Store.GetEvents(ctx)
+}
+
+// GetEventsByEntity gets all events matching a given entity ID.
+func (s *Store) GetEventsByEntity(ctx context.Context, entityID string) ([]*types.Event, error) {
+	if s.isAvailable() {
+		if events, err := s.events.GetEventsByEntity(ctx, entityID); !s.failed(err) {
+			return events, err
+		}
+	}
+	return s.Store.GetEventsByEntity(ctx, entityID)
+}
+
+// GetEventByEntityCheck gets an event by entity and check ID.
+func (s *Store) GetEventByEntityCheck(ctx context.Context, entityID, checkID string) (*types.Event, error) {
+	if s.isAvailable() {
+		if event, err := s.events.GetEventByEntityCheck(ctx, entityID, checkID); !s.failed(err) {
+			return event, err
+		}
+	}
+	return s.Store.GetEventByEntityCheck(ctx, entityID, checkID)
+}
+
+// UpdateEvent creates or updates an event.
+func (s *Store) UpdateEvent(ctx context.Context, event *types.Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.isAvailable() {
+		if err := s.events.UpdateEvent(ctx, event); !s.failed(err) {
+			return err
+		}
+	}
+	return s.Store.UpdateEvent(ctx, event)
+}
+
+// unavailable returns whether the given error is due to PostgreSQL being
+// unavailable, rather than to the query
+func unavailable(err error) bool {
+	switch err := err.(type) {
+	case *pq.Error:
+		switch err.Code.Class() {
+		// Connection exception, insufficient resources and operator intervention
+		case "08", "53", "57":
+			return true
+		}
+		return false
+	case net.Error:
+		return true
+	}
+	return err == driver.ErrBadConn || err == io.EOF || err == io.ErrUnexpectedEOF
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// healthChecker is a health check whose result is set by the tests, which run
+// it themselves rather than waiting for the health check loop
+type healthChecker struct {
+	err error
+}
+
+func (h *healthChecker) set(err error) {
+	h.err = err
+}
+
+func (h *healthChecker) check(context.Context) error {
+	return h.err
+}
+
+// allNamespaces matches the contexts spanning all the namespaces
+var allNamespaces = mock.MatchedBy(func(ctx context.Context) bool {
+	return types.ContextOrganization(ctx) == types.OrganizationTypeAll &&
+		types.ContextEnvironment(ctx) == types.EnvironmentTypeAll
+})
+
+func newStoreTest(t *testing.T, health *healthChecker) (*Store, *mockstore.MockStore, *mockstore.MockStore) {
+	fallback := &mockstore.MockStore{}
+	events := &mockstore.MockStore{}
+	if health.err == nil {
+		// No event is left in the wrapped store when PostgreSQL is available
+		fallback.On("GetEvents", allNamespaces).Return([]*types.Event{}, nil).Once()
+	}
+	s, err := newStore(fallback, events, health.check, HealthInterval(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	return s, fallback, events
+}
+
+func TestStoreAvailable(t *testing.T) {
+	health := &healthChecker{}
+	health.set(nil)
+	s, fallback, events := newStoreTest(t, health)
+	defer s.Stop()
+
+	event := types.FixtureEvent("entity", "check")
+	events.On("GetEventByEntityCheck", mock.Anything, "entity", "check").Return(event, nil)
+	events.On("UpdateEvent", mock.Anything, mock.Anything).Return(nil)
+	fallback.On("GetCheckConfigByName", mock.Anything, "check").Return(types.FixtureCheckConfig("check"), nil)
+
+	assert.NoError(t, s.Status())
+
+	// Events are stored in PostgreSQL, everything else in the wrapped store
+	got, err := s.GetEventByEntityCheck(context.Background(), "entity", "check")
+	require.NoError(t, err)
+	assert.Equal(t, event, got)
+	assert.NoError(t, s.UpdateEvent(context.Background(), event))
+	_, err = s.GetCheckConfigByName(context.Background(), "check")
+	require.NoError(t, err)
+
+	events.AssertExpectations(t)
+	fallback.AssertExpectations(t)
+	fallback.AssertNotCalled(t, "UpdateEvent", mock.Anything, mock.Anything)
+}
+
+func TestStoreUnavailable(t *testing.T) {
+	health := &healthChecker{}
+	health.set(errors.New("connection refused"))
+	s, fallback, events := newStoreTest(t, health)
+	defer s.Stop()
+
+	fallback.On("GetEvents", mock.Anything).Return([]*types.Event{}, nil)
+
+	assert.Equal(t, errUnavailable, s.Status())
+
+	_, err := s.GetEvents(context.Background())
+	require.NoError(t, err)
+	fallback.AssertExpectations(t)
+	events.AssertNotCalled(t, "GetEvents", mock.Anything)
+}
+
+func TestStoreFailover(t *testing.T) {
+	health := &healthChecker{}
+	health.set(nil)
+	s, fallback, events := newStoreTest(t, health)
+	defer s.Stop()
+
+	event := types.FixtureEvent("entity", "check")
+	events.On("UpdateEvent", mock.Anything, mock.Anything).Return(driver.ErrBadConn).Once()
+	fallback.On("UpdateEvent", mock.Anything, mock.Anything).Return(nil)
+
+	// The event is stored in the wrapped store when PostgreSQL fails
+	health.set(driver.ErrBadConn)
+	require.NoError(t, s.UpdateEvent(context.Background(), event))
+	fallback.AssertNumberOfCalls(t, "UpdateEvent", 1)
+	assert.Error(t, s.Status())
+
+	// And until it is available again
+	require.NoError(t, s.UpdateEvent(context.Background(), event))
+	events.AssertNumberOfCalls(t, "UpdateEvent", 1)
+	fallback.AssertNumberOfCalls(t, "UpdateEvent", 2)
+
+	// The event is then moved to PostgreSQL
+	fallback.On("GetEvents", allNamespaces).Return([]*types.Event{event}, nil).Once()
+	fallback.On("DeleteEventByEntityCheck", mock.Anything, "entity", "check").Return(nil).Once()
+	events.On("UpdateEvent", mock.Anything, mock.Anything).Return(nil)
+	health.set(nil)
+	s.healthCheck()
+	assert.NoError(t, s.Status())
+	events.AssertNumberOfCalls(t, "UpdateEvent", 2)
+	fallback.AssertExpectations(t)
+
+	require.NoError(t, s.UpdateEvent(context.Background(), event))
+	events.AssertNumberOfCalls(t, "UpdateEvent", 3)
+	fallback.AssertNumberOfCalls(t, "UpdateEvent", 2)
+}
+
+func TestStoreRecovery(t *testing.T) {
+	health := &healthChecker{}
+	health.set(errors.New("connection refused"))
+	s, fallback, events := newStoreTest(t, health)
+	defer s.Stop()
+
+	// An event is updated and another deleted while PostgreSQL is unavailable
+	updated := types.FixtureEvent("entity1", "check1")
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+	fallback.On("UpdateEvent", updated).Return(nil)
+	fallback.On("DeleteEventByEntityCheck", mock.Anything, "entity2", "check2").Return(nil)
+	require.NoError(t, s.UpdateEvent(ctx, updated))
+	require.NoError(t, s.DeleteEventByEntityCheck(ctx, "entity2", "check2"))
+
+	// PostgreSQL fails while the events are moved
+	health.set(nil)
+	fallback.On("GetEvents", allNamespaces).Return([]*types.Event{updated}, nil).Twice()
+	events.On("DeleteEventByEntityCheck", mock.Anything, "entity2", "check2").Return(nil)
+	events.On("UpdateEvent", updated).Return(driver.ErrBadConn).Once()
+	s.healthCheck()
+	assert.Error(t, s.Status())
+	fallback.AssertNotCalled(t, "DeleteEventByEntityCheck", mock.Anything, "entity1", "check1")
+
+	// The events are moved on the next health check
+	events.On("UpdateEvent", updated).Return(nil).Once()
+	fallback.On("DeleteEventByEntityCheck", mock.Anything, "entity1", "check1").Return(nil).Once()
+	s.healthCheck()
+	assert.NoError(t, s.Status())
+	events.AssertExpectations(t)
+	fallback.AssertExpectations(t)
+	events.AssertCalled(t, "DeleteEventByEntityCheck", mock.MatchedBy(func(ctx context.Context) bool {
+		return types.ContextOrganization(ctx) == "default" && types.ContextEnvironment(ctx) == "default"
+	}), "entity2", "check2")
+
+	// And the deleted events are only deleted once
+	fallback.On("GetEvents", allNamespaces).Return([]*types.Event{}, nil)
+	s.available = 0
+	s.healthCheck()
+	events.AssertNumberOfCalls(t, "DeleteEventByEntityCheck", 2)
+}
+
+func TestStoreQueryErrors(t *testing.T) {
+	health := &healthChecker{}
+	health.set(nil)
+	s, fallback, events := newStoreTest(t, health)
+	defer s.Stop()
+
+	// Errors of the queries themselves are returned as is
+	events.On("GetEventsByEntity", mock.Anything, "").Return([]*types.Event(nil), errors.New("must specify entity id"))
+	_, err := s.GetEventsByEntity(context.Background(), "")
+	assert.EqualError(t, err, "must specify entity id")
+	assert.NoError(t, s.Status())
+	fallback.AssertNotCalled(t, "GetEventsByEntity", mock.Anything, mock.Anything)
+}
+
+func TestUnavailable(t *testing.T) {
+	assert.True(t, unavailable(driver.ErrBadConn))
+	assert.True(t, unavailable(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, unavailable(&pq.Error{Code: "57P01"}))  // admin_shutdown
+	assert.True(t, unavailable(&pq.Error{Code: "08006"}))  // connection_failure
+	assert.False(t, unavailable(&pq.Error{Code: "23505"})) // unique_violation
+	assert.False(t, unavailable(errors.New("event has no check")))
+}
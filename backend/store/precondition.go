@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPreconditionFailed is returned when an object modified with a context
+// carrying preconditions does not satisfy them.
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+// Precondition is a condition on the revision of an object, checked
+// atomically with its modification. The revision of an object is the revision
+// of the store at which it was last modified, zero if it does not exist.
+type Precondition struct {
+	// Revision is the revision the object is compared to.
+	Revision int64
+
+	// Match is true if the object must be at Revision, false if it must not.
+	Match bool
+}
+
+// Satisfied returns whether an object at the given revision satisfies the
+// precondition.
+func (p Precondition) Satisfied(revision int64) bool {
+	return (revision == p.Revision) == p.Match
+}
+
+type preconditionsKey struct{}
+
+// ContextWithPrecondition returns a copy of the given context, modifying an
+// object with it fails with ErrPreconditionFailed unless the object satisfies
+// the given precondition along with those already carried by the context.
+// Reading objects is not affected.
+func ContextWithPrecondition(ctx context.Context, p Precondition) context.Context {
+	preconditions := PreconditionsFromContext(ctx)
+	preconditions = append(preconditions[:len(preconditions):len(preconditions)], p)
+	return context.WithValue(ctx, preconditionsKey{}, preconditions)
+}
+
+// PreconditionsFromContext returns the preconditions of the given context, if
+// any.
+func PreconditionsFromContext(ctx context.Context) []Precondition {
+	preconditions, _ := ctx.Value(preconditionsKey{}).([]Precondition)
+	return preconditions
+}
+
+type revisionKey struct{}
+
+// ContextWithRevision returns a copy of the given context, reading an object
+// with it sets the given revision to the revision of the object, and listing
+// objects in a single request sets it to the revision of the store they were
+// listed at. Only the stores supporting preconditions set it.
+func ContextWithRevision(ctx context.Context, revision *int64) context.Context {
+	return context.WithValue(ctx, revisionKey{}, revision)
+}
+
+// RevisionFromContext returns the revision of the given context, if any.
+func RevisionFromContext(ctx context.Context) *int64 {
+	revision, _ := ctx.Value(revisionKey{}).(*int64)
+	return revision
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreconditionSatisfied(t *testing.T) {
+	assert.True(t, Precondition{Revision: 42, Match: true}.Satisfied(42))
+	assert.False(t, Precondition{Revision: 42, Match: true}.Satisfied(43))
+	assert.True(t, Precondition{Revision: 0, Match: false}.Satisfied(42))
+	assert.False(t, Precondition{Revision: 0, Match: false}.Satisfied(0))
+}
+
+func TestContextWithPrecondition(t *testing.T) {
+	ctx := ContextWithPrecondition(context.Background(), Precondition{Revision: 1, Match: true})
+	a := ContextWithPrecondition(ctx, Precondition{Revision: 2})
+	b := ContextWithPrecondition(ctx, Precondition{Revision: 3})
+
+	assert.Equal(t, []Precondition{{Revision: 1, Match: true}}, PreconditionsFromContext(ctx))
+	assert.Equal(t, []Precondition{{Revision: 1, Match: true}, {Revision: 2}}, PreconditionsFromContext(a))
+	assert.Equal(t, []Precondition{{Revision: 1, Match: true}, {Revision: 3}}, PreconditionsFromContext(b))
+}
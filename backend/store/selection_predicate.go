@@ -0,0 +1,37 @@
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidContinueToken is returned when the continue token of a selection
+// predicate does not reference the objects being listed.
+var ErrInvalidContinueToken = errors.New("invalid continue token")
+
+// SelectionPredicate describes the page of objects to select when listing
+// objects from the store.
+type SelectionPredicate struct {
+	// Continue is the token of the page to select, empty for the first page.
+	// Once the objects are selected it holds the token of the next page, or is
+	// empty when there are no more objects.
+	Continue string
+
+	// Limit is the maximum number of objects to select, zero for no limit.
+	Limit int64
+}
+
+type selectionPredicateKey struct{}
+
+// ContextWithSelectionPredicate returns a copy of the given context, listing
+// objects with it selects the page described by the given predicate.
+func ContextWithSelectionPredicate(ctx context.Context, pred *SelectionPredicate) context.Context {
+	return context.WithValue(ctx, selectionPredicateKey{}, pred)
+}
+
+// SelectionPredicateFromContext returns the selection predicate of the given
+// context, if any.
+func SelectionPredicateFromContext(ctx context.Context) *SelectionPredicate {
+	pred, _ := ctx.Value(selectionPredicateKey{}).(*SelectionPredicate)
+	return pred
+}
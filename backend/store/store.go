@@ -38,24 +38,51 @@ func (t WatchActionType) String() string {
 }
 
 // A WatchEventCheckConfig contains the modified store object and the action that occured
-// during the modification.
+// during the modification, and the revision of the store it occurred at.
 type WatchEventCheckConfig struct {
 	CheckConfig *types.CheckConfig
 	Action      WatchActionType
+	Revision    int64
 }
 
 // A WatchEventAsset contains the modified asset object and the action that occurred
-// during the modification.
+// during the modification, and the revision of the store it occurred at.
 type WatchEventAsset struct {
-	Asset  *types.Asset
-	Action WatchActionType
+	Asset    *types.Asset
+	Action   WatchActionType
+	Revision int64
 }
 
 // A WatchEventHookConfig contains the modified asset object and the action that occurred
-// during the modification.
+// during the modification, and the revision of the store it occurred at.
 type WatchEventHookConfig struct {
 	HookConfig *types.HookConfig
 	Action     WatchActionType
+	Revision   int64
+}
+
+// A WatchEventEntity contains the modified entity object and the action that occurred
+// during the modification, and the revision of the store it occurred at.
+type WatchEventEntity struct {
+	Entity   *types.Entity
+	Action   WatchActionType
+	Revision int64
+}
+
+// A WatchEventEnvironment contains the modified environment and the action that
+// occurred during the modification, and the revision of the store it occurred at.
+type WatchEventEnvironment struct {
+	Environment *types.Environment
+	Action      WatchActionType
+	Revision    int64
+}
+
+// A WatchEventSilenced contains the modified silenced entry and the action that occurred
+// during the modification, and the revision of the store it occurred at.
+type WatchEventSilenced struct {
+	Silenced *types.Silenced
+	Action   WatchActionType
+	Revision int64
 }
 
 // Store is used to abstract the durable storage used by the Sensu backend
@@ -65,6 +92,9 @@ type Store interface {
 	// AssetStore provides an interface for managing checks assets
 	AssetStore
 
+	// AuditStore provides an interface for managing the audit log
+	AuditStore
+
 	// AuthenticationStore provides an interface for managing the JWT secret
 	AuthenticationStore
 
@@ -107,6 +137,16 @@ type Store interface {
 	// RBACStore provides an interface for managing RBAC roles and rules
 	RBACStore
 
+	// ResourceStore provides an interface for managing heterogeneous resources
+	ResourceStore
+
+	// SecretStore provides an interface for managing secrets
+	SecretStore
+
+	// ServiceStore provides an interface for managing business services and
+	// their components
+	ServiceStore
+
 	// SilencedStore provides an interface for managing silenced entries,
 	// consisting of entities, subscriptions and/or checks
 	SilencedStore
@@ -125,6 +165,18 @@ type Store interface {
 	NewInitializer() (Initializer, error)
 }
 
+// AuditStore provides methods for managing the audit log of the API
+type AuditStore interface {
+	// CreateAuditEntry records the given entry, within its organization and
+	// environment, for the given number of seconds.
+	CreateAuditEntry(ctx context.Context, entry *types.AuditEntry, ttl int64) error
+
+	// GetAuditEntries returns the entries recorded within the organization and
+	// environment stored in ctx, oldest first. A nil slice with no error is
+	// returned if none were found.
+	GetAuditEntries(ctx context.Context) ([]*types.AuditEntry, error)
+}
+
 // AssetStore provides methods for managing checks assets
 type AssetStore interface {
 	// DeleteAssetByName deletes an asset using the given name and the
@@ -143,9 +195,9 @@ type AssetStore interface {
 	UpdateAsset(ctx context.Context, asset *types.Asset) error
 
 	// GetAssetWatcher returns a channel that emits WatchEventAsset structs notifying
-	// the caller that an Asset was updated. If the watcher runs into a terminal error
-	// or the context passed is cancelled, then the channel will be closed. The caller must
-	// restart the watcher, if needed.
+	// the caller that an Asset was updated. The channel is closed once the context
+	// passed is cancelled: the watcher resumes by itself after interruptions, without
+	// missing any change.
 	GetAssetWatcher(ctx context.Context) <-chan WatchEventAsset
 }
 
@@ -182,9 +234,9 @@ type CheckConfigStore interface {
 	UpdateCheckConfig(ctx context.Context, check *types.CheckConfig) error
 
 	// GetCheckConfigWatcher returns a channel that emits CheckConfigWatchEvents notifying
-	// the caller that a CheckConfig was updated. If the watcher runs into a terminal error
-	// or the context passed is cancelled, then the channel will be closed. The caller must
-	// restart the watcher, if needed.
+	// the caller that a CheckConfig was updated. The channel is closed once the context
+	// passed is cancelled: the watcher resumes by itself after interruptions, without
+	// missing any change.
 	GetCheckConfigWatcher(ctx context.Context) <-chan WatchEventCheckConfig
 }
 
@@ -208,9 +260,9 @@ type HookConfigStore interface {
 	UpdateHookConfig(ctx context.Context, check *types.HookConfig) error
 
 	// GetHookConfigWatcher returns a channel that emits WatchEventHookConfig structs notifying
-	// the caller that a HookConfig was updated. If the watcher runs into a terminal error
-	// or the context passed is cancelled, then the channel will be closed. The caller must
-	// restart the watcher, if needed.
+	// the caller that a HookConfig was updated. The channel is closed once the context
+	// passed is cancelled: the watcher resumes by itself after interruptions, without
+	// missing any change.
 	GetHookConfigWatcher(ctx context.Context) <-chan WatchEventHookConfig
 }
 
@@ -234,6 +286,12 @@ type EntityStore interface {
 
 	// UpdateEntity creates or updates a given entity.
 	UpdateEntity(ctx context.Context, entity *types.Entity) error
+
+	// GetEntityWatcher returns a channel that emits WatchEventEntity structs notifying
+	// the caller that an Entity was updated. The channel is closed once the context
+	// passed is cancelled: the watcher resumes by itself after interruptions, without
+	// missing any change.
+	GetEntityWatcher(ctx context.Context) <-chan WatchEventEntity
 }
 
 // EnvironmentStore provides methods for managing environments
@@ -251,6 +309,12 @@ type EnvironmentStore interface {
 
 	// UpdateEnvironment creates or updates a given env.
 	UpdateEnvironment(ctx context.Context, env *types.Environment) error
+
+	// GetEnvironmentWatcher returns a channel that emits WatchEventEnvironment
+	// structs notifying the caller that an environment was updated. The channel
+	// is closed once the context passed is cancelled: the watcher resumes by
+	// itself after interruptions, without missing any change.
+	GetEnvironmentWatcher(ctx context.Context) <-chan WatchEventEnvironment
 }
 
 // ErrorStore provides methods for managing pipeline errors
@@ -426,6 +490,70 @@ type RBACStore interface {
 	UpdateRole(ctx context.Context, role *types.Role) error
 }
 
+// ResourceStore provides methods for managing heterogeneous resources
+type ResourceStore interface {
+	// UpdateResources creates or updates the given checks, hooks, filters,
+	// mutators, handlers and assets atomically: either all of them are stored,
+	// or none is.
+	UpdateResources(ctx context.Context, resources []types.Resource) error
+}
+
+// SecretStore provides methods for managing secrets
+type SecretStore interface {
+	// DeleteSecretByName deletes a secret using the given name and the
+	// organization and environment stored in ctx.
+	DeleteSecretByName(ctx context.Context, name string) error
+
+	// GetSecrets returns all secrets in the given ctx's organization and
+	// environment. A nil slice with no error is returned if none were found.
+	GetSecrets(ctx context.Context) ([]*types.Secret, error)
+
+	// GetSecretByName returns a secret using the given name and the
+	// organization and environment stored in ctx. The resulting secret is nil if
+	// none was found.
+	GetSecretByName(ctx context.Context, name string) (*types.Secret, error)
+
+	// UpdateSecret creates or updates a given secret.
+	UpdateSecret(ctx context.Context, secret *types.Secret) error
+}
+
+// ServiceStore provides methods for managing business services and their
+// components
+type ServiceStore interface {
+	// DeleteServiceByName deletes a service using the given name and the
+	// organization and environment stored in ctx.
+	DeleteServiceByName(ctx context.Context, name string) error
+
+	// GetServices returns all services in the given ctx's organization and
+	// environment. A nil slice with no error is returned if none were found.
+	GetServices(ctx context.Context) ([]*types.Service, error)
+
+	// GetServiceByName returns a service using the given name and the
+	// organization and environment stored in ctx. The resulting service is nil
+	// if none was found.
+	GetServiceByName(ctx context.Context, name string) (*types.Service, error)
+
+	// UpdateService creates or updates a given service.
+	UpdateService(ctx context.Context, service *types.Service) error
+
+	// DeleteServiceComponentByName deletes a service component using the given
+	// name and the organization and environment stored in ctx.
+	DeleteServiceComponentByName(ctx context.Context, name string) error
+
+	// GetServiceComponents returns all service components in the given ctx's
+	// organization and environment. A nil slice with no error is returned if
+	// none were found.
+	GetServiceComponents(ctx context.Context) ([]*types.ServiceComponent, error)
+
+	// GetServiceComponentByName returns a service component using the given
+	// name and the organization and environment stored in ctx. The resulting
+	// component is nil if none was found.
+	GetServiceComponentByName(ctx context.Context, name string) (*types.ServiceComponent, error)
+
+	// UpdateServiceComponent creates or updates a given service component.
+	UpdateServiceComponent(ctx context.Context, component *types.ServiceComponent) error
+}
+
 // SilencedStore provides methods for managing silenced entries,
 // consisting of entities, subscriptions and/or checks
 type SilencedStore interface {
@@ -453,6 +581,12 @@ type SilencedStore interface {
 
 	// UpdateHandler creates or updates a given entry.
 	UpdateSilencedEntry(ctx context.Context, entry *types.Silenced) error
+
+	// GetSilencedWatcher returns a channel that emits WatchEventSilenced structs notifying
+	// the caller that a silenced entry was updated. The channel is closed once the context
+	// passed is cancelled: the watcher resumes by itself after interruptions, without
+	// missing any change. The Expire of the entries is their initial time to live.
+	GetSilencedWatcher(ctx context.Context) <-chan WatchEventSilenced
 }
 
 // TokenStore provides methods for managing the JWT access list
@@ -494,7 +628,7 @@ type UserStore interface {
 	GetAllUsers() ([]*types.User, error)
 
 	// UpdateHandler updates a given user.
-	UpdateUser(user *types.User) error
+	UpdateUser(ctx context.Context, user *types.User) error
 }
 
 // Initializer provides methods to verify if a store is initialized
@@ -21,7 +21,7 @@ func (client *RestClient) ListAssets(org string) ([]types.Asset, error) {
 		return assets, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &assets)
+	err = unmarshalBody(res, &assets)
 	return assets, err
 }
 
@@ -39,7 +39,7 @@ func (client *RestClient) FetchAsset(name string) (*types.Asset, error) {
 		return &asset, fmt.Errorf("GET %q: %s", assetPath, res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &asset)
+	err = unmarshalBody(res, &asset)
 	return &asset, err
 }
 
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -28,7 +27,7 @@ func (client *RestClient) CreateAccessToken(url, userid, password string) (*type
 	}
 
 	var tokens types.Tokens
-	if err = json.Unmarshal(res.Body(), &tokens); err != nil {
+	if err = unmarshalBody(res, &tokens); err != nil {
 		return nil, errors.New("Unable to unmarshal response from server")
 	}
 
@@ -112,7 +112,7 @@ func (client *RestClient) FetchCheck(name string) (*types.CheckConfig, error) {
 		return nil, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &check)
+	err = unmarshalBody(res, &check)
 	return check, err
 }
 
@@ -128,7 +128,7 @@ func (client *RestClient) ListChecks(org string) ([]types.CheckConfig, error) {
 		return checks, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &checks)
+	err = unmarshalBody(res, &checks)
 	return checks, err
 }
 
@@ -41,8 +41,8 @@ func New(config config.Config) *RestClient {
 	// Standardize redirect policy
 	restyInst.SetRedirectPolicy(resty.FlexibleRedirectPolicy(10))
 
-	// JSON
-	restyInst.SetHeader("Accept", "application/json")
+	// Resources are sent as JSON, and received as protobuf when available
+	restyInst.SetHeader("Accept", acceptHeader)
 	restyInst.SetHeader("Content-Type", "application/json")
 
 	// Check that Access-Token has not expired
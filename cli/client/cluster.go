@@ -1,7 +1,6 @@
 package client
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -20,7 +19,7 @@ func (c *RestClient) MemberList() (*clientv3.MemberListResponse, error) {
 		return nil, UnmarshalError(res)
 	}
 	var result clientv3.MemberListResponse
-	return &result, json.Unmarshal(res.Body(), &result)
+	return &result, unmarshalBody(res, &result)
 }
 
 func (c *RestClient) MemberAdd(peerAddrs []string) (*clientv3.MemberAddResponse, error) {
@@ -34,7 +33,7 @@ func (c *RestClient) MemberAdd(peerAddrs []string) (*clientv3.MemberAddResponse,
 		return nil, UnmarshalError(res)
 	}
 	var result clientv3.MemberAddResponse
-	return &result, json.Unmarshal(res.Body(), &result)
+	return &result, unmarshalBody(res, &result)
 }
 
 func (c *RestClient) MemberUpdate(id uint64, peerAddrs []string) (*clientv3.MemberUpdateResponse, error) {
@@ -48,7 +47,7 @@ func (c *RestClient) MemberUpdate(id uint64, peerAddrs []string) (*clientv3.Memb
 		return nil, UnmarshalError(res)
 	}
 	var result clientv3.MemberUpdateResponse
-	return &result, json.Unmarshal(res.Body(), &result)
+	return &result, unmarshalBody(res, &result)
 }
 
 func (c *RestClient) MemberRemove(id uint64) (*clientv3.MemberRemoveResponse, error) {
@@ -61,5 +60,5 @@ func (c *RestClient) MemberRemove(id uint64) (*clientv3.MemberRemoveResponse, er
 		return nil, UnmarshalError(res)
 	}
 	var result clientv3.MemberRemoveResponse
-	return &result, json.Unmarshal(res.Body(), &result)
+	return &result, unmarshalBody(res, &result)
 }
@@ -81,6 +81,17 @@ func Load(flags *pflag.FlagSet) *Config {
 		if value := helpers.GetChangedStringValueFlag("organization", flags); value != "" {
 			conf.Profile.Organization = value
 		}
+
+		// Override both with the namespace
+		if value := helpers.GetChangedStringValueFlag("namespace", flags); value != "" {
+			ns := types.Namespace{Name: value}
+			if err := ns.Validate(); err != nil {
+				logger.Warn(err)
+			} else {
+				conf.Profile.Organization = ns.GetOrganization()
+				conf.Profile.Environment = ns.GetEnvironment()
+			}
+		}
 	}
 
 	// Load the flags config
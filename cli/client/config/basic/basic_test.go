@@ -22,6 +22,19 @@ func TestFlags(t *testing.T) {
 	assert.Equal(t, "foo", config.APIUrl())
 }
 
+func TestNamespaceFlag(t *testing.T) {
+	flags := pflag.NewFlagSet("namespace", pflag.ContinueOnError)
+	flags.String("config-dir", "/tmp/sensu", "")
+	flags.String("organization", "", "")
+	flags.String("namespace", "", "")
+	require.NoError(t, flags.Set("organization", "foo"))
+	require.NoError(t, flags.Set("namespace", "acme/production"))
+
+	config := Load(flags)
+	assert.Equal(t, "acme", config.Organization())
+	assert.Equal(t, "production", config.Environment())
+}
+
 func TestLoad(t *testing.T) {
 	// Create a dummy directory for testing
 	dir, _ := ioutil.TempDir("", "sensu")
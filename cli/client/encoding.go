@@ -0,0 +1,85 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"reflect"
+
+	"github.com/go-resty/resty"
+	"github.com/gogo/protobuf/proto"
+	"github.com/sensu/sensu-go/types"
+)
+
+// acceptHeader prefers resources serialized with their protobuf definitions,
+// which are smaller and faster to decode, and falls back to JSON for the
+// responses that have none.
+const acceptHeader = types.ContentTypeProtobuf + ", application/json;q=0.9"
+
+var unmarshalerType = reflect.TypeOf((*proto.Unmarshaler)(nil)).Elem()
+
+// unmarshalBody decodes the body of the given response into v, from protobuf
+// if the response was serialized with it, or from JSON otherwise.
+func unmarshalBody(res *resty.Response, v interface{}) error {
+	mediaType, _, err := mime.ParseMediaType(res.Header().Get("Content-Type"))
+	if err != nil || mediaType != types.ContentTypeProtobuf {
+		return json.Unmarshal(res.Body(), v)
+	}
+	return unmarshalProtobuf(res.Body(), v)
+}
+
+// unmarshalProtobuf decodes the given protobuf message into v, which must point
+// to a message, or to a slice of messages when b holds a list.
+func unmarshalProtobuf(b []byte, v interface{}) error {
+	if msg, ok := v.(proto.Unmarshaler); ok {
+		return msg.Unmarshal(b)
+	}
+
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return fmt.Errorf("cannot decode protobuf into %T", v)
+	}
+	value = value.Elem()
+	switch value.Kind() {
+	case reflect.Ptr:
+		// Allocate the message pointed to
+		msg := reflect.New(value.Type().Elem())
+		if err := unmarshalProtobuf(b, msg.Interface()); err != nil {
+			return err
+		}
+		value.Set(msg)
+		return nil
+	case reflect.Slice:
+	default:
+		return fmt.Errorf("cannot decode protobuf into %T", v)
+	}
+
+	// Lists are serialized as a sequence of length-delimited messages
+	elem := value.Type().Elem()
+	isPtr := elem.Kind() == reflect.Ptr
+	if isPtr {
+		elem = elem.Elem()
+	}
+	if !reflect.PtrTo(elem).Implements(unmarshalerType) {
+		return fmt.Errorf("cannot decode protobuf into %T", v)
+	}
+	list := reflect.MakeSlice(value.Type(), 0, 0)
+	for len(b) > 0 {
+		size, n := proto.DecodeVarint(b)
+		if n == 0 || size > uint64(len(b)-n) {
+			return errors.New("invalid length of protobuf message")
+		}
+		msg := reflect.New(elem)
+		if err := msg.Interface().(proto.Unmarshaler).Unmarshal(b[n : n+int(size)]); err != nil {
+			return err
+		}
+		if !isPtr {
+			msg = msg.Elem()
+		}
+		list = reflect.Append(list, msg)
+		b = b[n+int(size):]
+	}
+	value.Set(list)
+	return nil
+}
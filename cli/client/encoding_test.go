@@ -0,0 +1,87 @@
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty"
+	"github.com/gogo/protobuf/proto"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalBody(t *testing.T) {
+	check := types.FixtureCheckConfig("check1")
+	other := types.FixtureCheckConfig("check2")
+	for _, c := range []*types.CheckConfig{check, other} {
+		// Empty lists are decoded as nil by protobuf
+		c.Handlers, c.OutputMetricHandlers = nil, nil
+	}
+
+	var list bytes.Buffer
+	for _, c := range []*types.CheckConfig{check, other} {
+		b, err := c.Marshal()
+		require.NoError(t, err)
+		_, _ = list.Write(proto.EncodeVarint(uint64(len(b))))
+		_, _ = list.Write(b)
+	}
+	single, err := check.Marshal()
+	require.NoError(t, err)
+
+	testHandler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != acceptHeader {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name": "check1"}`))
+			return
+		}
+		w.Header().Set("Content-Type", types.ContentTypeProtobuf)
+		switch r.URL.Path {
+		case "/list":
+			_, _ = w.Write(list.Bytes())
+		case "/invalid":
+			_, _ = w.Write(list.Bytes()[:list.Len()-1])
+		default:
+			_, _ = w.Write(single)
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(testHandler))
+	defer server.Close()
+	client := resty.New().SetHeader("Accept", acceptHeader)
+
+	res, err := client.R().Get(server.URL + "/check")
+	require.NoError(t, err)
+	var result types.CheckConfig
+	require.NoError(t, unmarshalBody(res, &result))
+	assert.Equal(t, check, &result)
+
+	var ptr *types.CheckConfig
+	require.NoError(t, unmarshalBody(res, &ptr))
+	assert.Equal(t, check, ptr)
+
+	res, err = client.R().Get(server.URL + "/list")
+	require.NoError(t, err)
+	var values []types.CheckConfig
+	require.NoError(t, unmarshalBody(res, &values))
+	assert.Equal(t, []types.CheckConfig{*check, *other}, values)
+
+	var pointers []*types.CheckConfig
+	require.NoError(t, unmarshalBody(res, &pointers))
+	assert.Equal(t, []*types.CheckConfig{check, other}, pointers)
+
+	var names []string
+	assert.Error(t, unmarshalBody(res, &names))
+
+	res, err = client.R().Get(server.URL + "/invalid")
+	require.NoError(t, err)
+	assert.Error(t, unmarshalBody(res, &values))
+
+	// Responses without protobuf are decoded from JSON
+	res, err = resty.New().R().Get(server.URL + "/check")
+	require.NoError(t, err)
+	result = types.CheckConfig{}
+	require.NoError(t, unmarshalBody(res, &result))
+	assert.Equal(t, "check1", result.Name)
+}
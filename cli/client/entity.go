@@ -3,20 +3,29 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	"github.com/sensu/sensu-go/types"
 )
 
 // DeleteEntity deletes given entitiy from the configured sensu instance
-func (client *RestClient) DeleteEntity(entity *types.Entity) (err error) {
-	_, err = client.R().Delete("/entities/" + entity.ID)
-	return err
+func (client *RestClient) DeleteEntity(entity *types.Entity) error {
+	res, err := client.R().Delete("/entities/" + url.PathEscape(entity.ID))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return UnmarshalError(res)
+	}
+
+	return nil
 }
 
 // FetchEntity fetches a specific entity
 func (client *RestClient) FetchEntity(ID string) (*types.Entity, error) {
 	var entity *types.Entity
-	res, err := client.R().Get("/entities/" + ID)
+	res, err := client.R().Get("/entities/" + url.PathEscape(ID))
 	if err != nil {
 		return entity, err
 	}
@@ -25,7 +34,7 @@ func (client *RestClient) FetchEntity(ID string) (*types.Entity, error) {
 		return entity, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &entity)
+	err = unmarshalBody(res, &entity)
 	return entity, err
 }
 
@@ -42,7 +51,7 @@ func (client *RestClient) ListEntities(org string) ([]types.Entity, error) {
 		return entities, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &entities)
+	err = unmarshalBody(res, &entities)
 	return entities, err
 }
 
@@ -53,7 +62,7 @@ func (client *RestClient) UpdateEntity(entity *types.Entity) (err error) {
 		return err
 	}
 
-	res, err := client.R().SetBody(bytes).Put("/entities/" + entity.ID)
+	res, err := client.R().SetBody(bytes).Put("/entities/" + url.PathEscape(entity.ID))
 	if err != nil {
 		return err
 	}
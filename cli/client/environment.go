@@ -64,7 +64,7 @@ func (client *RestClient) ListEnvironments(org string) ([]types.Environment, err
 		return envs, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &envs)
+	err = unmarshalBody(res, &envs)
 	return envs, err
 }
 
@@ -86,7 +86,7 @@ func (client *RestClient) FetchEnvironment(envName string) (*types.Environment,
 		return env, fmt.Errorf("error getting environment: %v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &env)
+	err = unmarshalBody(res, &env)
 	return env, err
 }
 
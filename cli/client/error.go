@@ -2,17 +2,29 @@ package client
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/go-resty/resty"
+	"github.com/sensu/sensu-go/types"
 )
 
 type apiError struct {
-	Message string `json:"error"`
-	Code    uint32 `json:"code,omitempty"`
+	Message string            `json:"error"`
+	Code    uint32            `json:"code,omitempty"`
+	Details types.FieldErrors `json:"details,omitempty"`
 }
 
+// Error returns the message of the error, or the invalid fields of the
+// resource, one per line, when detailed.
 func (a apiError) Error() string {
-	return a.Message
+	if len(a.Details) == 0 {
+		return a.Message
+	}
+	lines := []string{"invalid resource:"}
+	for _, detail := range a.Details {
+		lines = append(lines, "  "+detail.Error())
+	}
+	return strings.Join(lines, "\n")
 }
 
 // UnmarshalError decode the API error
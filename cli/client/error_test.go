@@ -0,0 +1,34 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalError(t *testing.T) {
+	testHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/invalid" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error": "name: must not be empty; interval: must be greater than 0", "code": 1, "details": [{"field": "name", "message": "must not be empty"}, {"field": "interval", "message": "must be greater than 0"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": "invalid limit", "code": 1}`))
+	}
+	server := httptest.NewServer(http.HandlerFunc(testHandler))
+	defer server.Close()
+
+	res, err := resty.New().R().Get(server.URL + "/invalid")
+	require.NoError(t, err)
+	assert.Equal(t, "invalid resource:\n  name: must not be empty\n  interval: must be greater than 0", UnmarshalError(res).Error())
+
+	res, err = resty.New().R().Get(server.URL + "/limit")
+	require.NoError(t, err)
+	assert.Equal(t, "invalid limit", UnmarshalError(res).Error())
+}
@@ -26,7 +26,7 @@ func (client *RestClient) FetchEvent(entity, check string) (*types.Event, error)
 		return nil, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &event)
+	err = unmarshalBody(res, &event)
 	return event, err
 }
 
@@ -43,7 +43,7 @@ func (client *RestClient) ListEvents(org string) ([]types.Event, error) {
 		return nil, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &events)
+	err = unmarshalBody(res, &events)
 	return events, err
 }
 
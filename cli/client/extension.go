@@ -21,7 +21,7 @@ func (client *RestClient) ListExtensions(org string) ([]types.Extension, error)
 		return extensions, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &extensions)
+	err = unmarshalBody(res, &extensions)
 	return extensions, err
 }
 
@@ -59,7 +59,7 @@ func (client *RestClient) FetchFilter(name string) (*types.EventFilter, error) {
 		return nil, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &filter)
+	err = unmarshalBody(res, &filter)
 	return filter, err
 }
 
@@ -75,7 +75,7 @@ func (client *RestClient) ListFilters(org string) ([]types.EventFilter, error) {
 		return filters, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &filters)
+	err = unmarshalBody(res, &filters)
 	return filters, err
 }
 
@@ -21,7 +21,7 @@ func (client *RestClient) ListHandlers(org string) ([]types.Handler, error) {
 		return handlers, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &handlers)
+	err = unmarshalBody(res, &handlers)
 	return handlers, err
 }
 
@@ -70,7 +70,7 @@ func (client *RestClient) FetchHandler(name string) (*types.Handler, error) {
 		return nil, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &handler)
+	err = unmarshalBody(res, &handler)
 	return handler, err
 }
 
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/sensu/sensu-go/types"
@@ -15,5 +14,5 @@ func (c *RestClient) Health() ([]*types.ClusterHealth, error) {
 		return nil, fmt.Errorf("GET %q: %s", healthPath, err)
 	}
 	var healthResponse []*types.ClusterHealth
-	return healthResponse, json.Unmarshal(res.Body(), &healthResponse)
+	return healthResponse, unmarshalBody(res, &healthResponse)
 }
@@ -73,7 +73,7 @@ func (client *RestClient) FetchHook(name string) (*types.HookConfig, error) {
 		return nil, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &hook)
+	err = unmarshalBody(res, &hook)
 	return hook, err
 }
 
@@ -89,6 +89,6 @@ func (client *RestClient) ListHooks(org string) ([]types.HookConfig, error) {
 		return hooks, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &hooks)
+	err = unmarshalBody(res, &hooks)
 	return hooks, err
 }
@@ -19,8 +19,10 @@ type APIClient interface {
 	HealthAPIClient
 	HookAPIClient
 	MutatorAPIClient
+	NamespaceAPIClient
 	OrganizationAPIClient
 	RoleAPIClient
+	SecretAPIClient
 	UserAPIClient
 	SilencedAPIClient
 	GenericClient
@@ -138,6 +140,13 @@ type MutatorAPIClient interface {
 	UpdateMutator(*types.Mutator) error
 }
 
+// NamespaceAPIClient client methods for namespaces
+type NamespaceAPIClient interface {
+	CreateNamespace(*types.Namespace) error
+	DeleteNamespace(string) error
+	ListNamespaces() ([]types.Namespace, error)
+}
+
 // OrganizationAPIClient client methods for organizations
 type OrganizationAPIClient interface {
 	CreateOrganization(*types.Organization) error
@@ -169,6 +178,15 @@ type RoleAPIClient interface {
 	RemoveRule(role string, ruleType string) error
 }
 
+// SecretAPIClient client methods for secrets
+type SecretAPIClient interface {
+	CreateSecret(*types.Secret) error
+	ListSecrets(string) ([]types.Secret, error)
+	DeleteSecret(*types.Secret) error
+	FetchSecret(string) (*types.Secret, error)
+	UpdateSecret(*types.Secret) error
+}
+
 // SilencedAPIClient client methods for silenced
 type SilencedAPIClient interface {
 	// CreateSilenced creates a new silenced entry from its input.
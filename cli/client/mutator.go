@@ -21,7 +21,7 @@ func (client *RestClient) ListMutators(org string) ([]types.Mutator, error) {
 		return mutators, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &mutators)
+	err = unmarshalBody(res, &mutators)
 	return mutators, err
 }
 
@@ -71,7 +71,7 @@ func (client *RestClient) FetchMutator(name string) (*types.Mutator, error) {
 		return mutator, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &mutator)
+	err = unmarshalBody(res, &mutator)
 	return mutator, err
 }
 
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CreateNamespace creates new namespace on configured Sensu instance
+func (client *RestClient) CreateNamespace(ns *types.Namespace) error {
+	bytes, err := json.Marshal(ns)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Post("/namespaces")
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return fmt.Errorf("%v", res.String())
+	}
+
+	return nil
+}
+
+// DeleteNamespace deletes a namespace on configured Sensu instance
+func (client *RestClient) DeleteNamespace(name string) error {
+	ns := types.Namespace{Name: name}
+	if err := ns.Validate(); err != nil {
+		return err
+	}
+
+	res, err := client.R().Delete(ns.URIPath())
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return fmt.Errorf("%v", res.String())
+	}
+
+	return nil
+}
+
+// ListNamespaces fetches all namespaces from configured Sensu instance
+func (client *RestClient) ListNamespaces() ([]types.Namespace, error) {
+	var namespaces []types.Namespace
+
+	res, err := client.R().Get("/namespaces")
+	if err != nil {
+		return namespaces, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return namespaces, fmt.Errorf("%v", res.String())
+	}
+
+	err = unmarshalBody(res, &namespaces)
+	return namespaces, err
+}
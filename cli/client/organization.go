@@ -77,7 +77,7 @@ func (client *RestClient) ListOrganizations() ([]types.Organization, error) {
 		return orgs, fmt.Errorf("%v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &orgs)
+	err = unmarshalBody(res, &orgs)
 	return orgs, err
 }
 
@@ -94,6 +94,6 @@ func (client *RestClient) FetchOrganization(orgName string) (*types.Organization
 		return org, fmt.Errorf("error getting organization: %v", res.String())
 	}
 
-	err = json.Unmarshal(res.Body(), &org)
+	err = unmarshalBody(res, &org)
 	return org, err
 }
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"encoding/json"
 	"net/url"
 	"path"
 
@@ -50,16 +49,17 @@ func (client *RestClient) DeleteRole(name string) error {
 func (client *RestClient) FetchRole(name string) (*types.Role, error) {
 	var role types.Role
 
-	res, cerr := client.R().SetResult(&role).Get(rolesPath(name))
-	if cerr != nil {
-		return nil, cerr
+	res, err := client.R().Get(rolesPath(name))
+	if err != nil {
+		return nil, err
 	}
 
 	if res.StatusCode() >= 400 {
 		return nil, UnmarshalError(res)
 	}
 
-	return &role, nil
+	err = unmarshalBody(res, &role)
+	return &role, err
 }
 
 // ListRoles fetches all roles from configured Sensu instance
@@ -75,7 +75,7 @@ func (client *RestClient) ListRoles() ([]types.Role, error) {
 		return roles, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &roles)
+	err = unmarshalBody(res, &roles)
 	return roles, err
 }
 
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// ListSecrets fetches all secrets from the configured Sensu instance
+func (client *RestClient) ListSecrets(org string) ([]types.Secret, error) {
+	var secrets []types.Secret
+
+	res, err := client.R().Get("/secrets?org=" + url.QueryEscape(org))
+	if err != nil {
+		return secrets, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return secrets, fmt.Errorf("%v", res.String())
+	}
+
+	err = unmarshalBody(res, &secrets)
+	return secrets, err
+}
+
+// CreateSecret creates new secret on the configured Sensu instance
+func (client *RestClient) CreateSecret(secret *types.Secret) (err error) {
+	bytes, err := json.Marshal(secret)
+	if err != nil {
+		return err
+
+	}
+	res, err := client.R().SetBody(bytes).Post("/secrets")
+	if err != nil {
+		return err
+
+	}
+
+	if res.StatusCode() >= 400 {
+		return UnmarshalError(res)
+	}
+	return nil
+}
+
+// DeleteSecret deletes the given secret from the configured Sensu instance
+func (client *RestClient) DeleteSecret(secret *types.Secret) (err error) {
+	res, err := client.R().Delete("/secrets/" + url.PathEscape(secret.Name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return fmt.Errorf("%v", res.String())
+	}
+
+	return nil
+}
+
+// FetchSecret fetches a specific secret from the configured Sensu instance
+func (client *RestClient) FetchSecret(name string) (*types.Secret, error) {
+	var secret *types.Secret
+
+	res, err := client.R().Get("/secrets/" + url.PathEscape(name))
+	if err != nil {
+		return secret, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return secret, fmt.Errorf("%v", res.String())
+	}
+
+	err = unmarshalBody(res, &secret)
+	return secret, err
+}
+
+// UpdateSecret updates a given secret on a configured Sensu instance
+func (client *RestClient) UpdateSecret(secret *types.Secret) (err error) {
+	bytes, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Put("/secrets/" + url.PathEscape(secret.Name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return UnmarshalError(res)
+	}
+
+	return nil
+}
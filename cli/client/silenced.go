@@ -68,7 +68,7 @@ func (client *RestClient) ListSilenceds(org, sub, check string) ([]types.Silence
 	}
 
 	var result []types.Silenced
-	err = json.Unmarshal(resp.Body(), &result)
+	err = unmarshalBody(resp, &result)
 	return result, err
 }
 
@@ -82,7 +82,7 @@ func (client *RestClient) FetchSilenced(id string) (*types.Silenced, error) {
 		return nil, UnmarshalError(resp)
 	}
 	var result types.Silenced
-	return &result, json.Unmarshal(resp.Body(), &result)
+	return &result, unmarshalBody(resp, &result)
 }
 
 // UpdateSilenced updates a silenced entry from configured Sensu instance
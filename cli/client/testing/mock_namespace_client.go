@@ -0,0 +1,21 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// CreateNamespace for use with mock lib
+func (c *MockClient) CreateNamespace(ns *types.Namespace) error {
+	args := c.Called(ns)
+	return args.Error(0)
+}
+
+// DeleteNamespace for use with mock lib
+func (c *MockClient) DeleteNamespace(name string) error {
+	args := c.Called(name)
+	return args.Error(0)
+}
+
+// ListNamespaces for use with mock lib
+func (c *MockClient) ListNamespaces() ([]types.Namespace, error) {
+	args := c.Called()
+	return args.Get(0).([]types.Namespace), args.Error(1)
+}
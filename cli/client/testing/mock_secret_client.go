@@ -0,0 +1,33 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// CreateSecret for use with mock package
+func (c *MockClient) CreateSecret(m *types.Secret) error {
+	args := c.Called(m)
+	return args.Error(0)
+}
+
+// DeleteSecret for use with mock package
+func (c *MockClient) DeleteSecret(m *types.Secret) error {
+	args := c.Called(m)
+	return args.Error(0)
+}
+
+// FetchSecret for use with mock package
+func (c *MockClient) FetchSecret(name string) (*types.Secret, error) {
+	args := c.Called(name)
+	return args.Get(0).(*types.Secret), args.Error(1)
+}
+
+// UpdateSecret for use with mock package
+func (c *MockClient) UpdateSecret(m *types.Secret) error {
+	args := c.Called(m)
+	return args.Error(0)
+}
+
+// ListSecrets for use with mock lib
+func (c *MockClient) ListSecrets(org string) ([]types.Secret, error) {
+	args := c.Called(org)
+	return args.Get(0).([]types.Secret), args.Error(1)
+}
@@ -64,7 +64,7 @@ func (client *RestClient) ListUsers() ([]types.User, error) {
 		return users, UnmarshalError(res)
 	}
 
-	err = json.Unmarshal(res.Body(), &users)
+	err = unmarshalBody(res, &users)
 	return users, err
 }
 
@@ -37,7 +37,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 
 	_ = cmd.Flags().StringP("sha512", "", "", "SHA-512 checksum of the asset's archive")
 	_ = cmd.Flags().StringP("url", "u", "", "the URL of the asset")
-	_ = cmd.Flags().StringSliceP("metadata", "m", []string{}, "metadata associated with asset")
+	_ = cmd.Flags().StringSliceP("metadata", "m", []string{}, "metadata associated with asset, kept as its annotations")
 	_ = cmd.Flags().StringSlice("filter", []string{}, "queries used by an entity to determine if it should include the asset")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
@@ -256,6 +256,6 @@ func (cfgPtr *Config) Copy(asset *types.Asset) {
 	asset.Organization = cfgPtr.Org
 	asset.Sha512 = cfgPtr.Sha512
 	asset.URL = cfgPtr.URL
-	asset.Metadata = cfgPtr.Meta
+	asset.Annotations = cfgPtr.Meta
 	asset.Filters = helpers.SafeSplitCSV(cfgPtr.Filters)
 }
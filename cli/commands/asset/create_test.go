@@ -108,8 +108,8 @@ func TestConfigureAsset(t *testing.T) {
 	asset, errs = cfg.Configure()
 	assert.Empty(errs)
 	assert.Equal("ruby22", asset.Name)
-	assert.NotEmpty(asset.Metadata)
-	assert.Equal("Two", asset.Metadata["One"])
+	assert.NotEmpty(asset.Annotations)
+	assert.Equal("Two", asset.Annotations["One"])
 
 	// Bad Metadata
 	require.NoError(t, flags.Set("metadata", "Five- Six"))
@@ -49,9 +49,12 @@ func printToList(v interface{}, writer io.Writer) error {
 	if !ok {
 		return fmt.Errorf("%t is not an Asset", v)
 	}
-	var metadata []string
-	for k, v := range r.Metadata {
-		metadata = append(metadata, k+"="+v)
+	var labels, annotations []string
+	for k, v := range r.Labels {
+		labels = append(labels, k+"="+v)
+	}
+	for k, v := range r.Annotations {
+		annotations = append(annotations, k+"="+v)
 	}
 
 	cfg := &list.Config{
@@ -78,8 +81,12 @@ func printToList(v interface{}, writer io.Writer) error {
 				Value: strings.Join(r.Filters, ", "),
 			},
 			{
-				Label: "Metadata",
-				Value: strings.Join(metadata, ", "),
+				Label: "Labels",
+				Value: strings.Join(labels, ", "),
+			},
+			{
+				Label: "Annotations",
+				Value: strings.Join(annotations, ", "),
 			},
 		},
 	}
@@ -65,7 +65,7 @@ func TestInfoCommandRunEClosureWithTable(t *testing.T) {
 	assert.NotEmpty(out)
 	assert.Contains(out, "Name")
 	assert.Contains(out, "Filters")
-	assert.Contains(out, "Metadata")
+	assert.Contains(out, "Annotations")
 	assert.Nil(err)
 }
 
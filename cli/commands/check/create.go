@@ -20,8 +20,11 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 		PreRun: func(cmd *cobra.Command, args []string) {
 			isInteractive, _ := cmd.Flags().GetBool(flags.Interactive)
 			if !isInteractive {
-				// Mark flags are required for bash-completions
-				_ = cmd.MarkFlagRequired("command")
+				// Mark flags are required for bash-completions. Checks
+				// scraping a Prometheus URL have no command.
+				if prometheusURL, _ := cmd.Flags().GetString("prometheus-url"); prometheusURL == "" {
+					_ = cmd.MarkFlagRequired("command")
+				}
 				_ = cmd.MarkFlagRequired("subscriptions")
 			}
 		},
@@ -59,6 +62,10 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 			check := types.CheckConfig{}
 			opts.Copy(&check)
 
+			if check.Command == "" && check.PrometheusURL == "" {
+				return errors.New("must specify a command or a prometheus url")
+			}
+
 			if err := check.Validate(); err != nil {
 				if !isInteractive {
 					cmd.SilenceUsage = false
@@ -83,21 +90,30 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	}
 
 	cmd.Flags().StringP("command", "c", "", "the command the check should run")
-	cmd.Flags().String("cron", "", "the cron schedule at which the check is run")
+	cmd.Flags().String("prometheus-url", "", "URL of the Prometheus metrics endpoint the agents scrape, instead of running a command")
+	cmd.Flags().String("cron", "", "the cron schedule at which the check is run, optionally prefixed by its time zone with CRON_TZ=")
 	cmd.Flags().String("handlers", "", "comma separated list of handlers to invoke when check fails")
 	cmd.Flags().StringP("interval", "i", "", "interval, in seconds, at which the check is run")
 	cmd.Flags().StringP("runtime-assets", "r", "", "comma separated list of assets this check depends on")
 	cmd.Flags().String("proxy-entity-id", "", "the check proxy entity, used to create a proxy entity for an external resource")
 	cmd.Flags().BoolP("publish", "p", true, "publish check requests")
 	cmd.Flags().BoolP("stdin", "", false, "accept event data via STDIN")
+	cmd.Flags().String("max-output-size", "", "maximum size in bytes of the check output kept by the agents, which truncate longer outputs")
+	cmd.Flags().Bool("discard-output", false, "discard the check output once its metrics are extracted")
 	cmd.Flags().StringP("subscriptions", "s", "", "comma separated list of topics check requests will be sent to")
 	cmd.Flags().StringP("timeout", "t", "", "timeout, in seconds, at which the check has to run")
 	cmd.Flags().String("ttl", "", "time to live in seconds for which a check result is valid")
+	cmd.Flags().String("depends-on", "", "comma separated list of checks, or entity/check pairs, this check depends on")
+	cmd.Flags().String("dedup-key", "", "template of the key collapsing the events of the check into a single event stream")
+	cmd.Flags().String("splay", "", "maximum delay, in seconds, of the executions, spread across the subscribed agents")
 	cmd.Flags().String("high-flap-threshold", "", "flap detection high threshold (percent state change) for the check")
 	cmd.Flags().String("low-flap-threshold", "", "flap detection low threshold (percent state change) for the check")
 	cmd.Flags().String("output-metric-handlers", "", "comma separated list of handlers to set on output check metrics")
 	cmd.Flags().String("output-metric-format", "", "the output metric format to be used to parse check output for metric extraction")
 	cmd.Flags().Bool("round-robin", false, "enable round-robin scheduling")
+	cmd.Flags().Bool("output-metric-tolerant", false, "skip the metrics that can't be parsed instead of failing the whole metric extraction")
+	cmd.Flags().String("output-metric-tags", "", "comma separated list of name=value tags to add to the extracted metrics")
+	cmd.Flags().String("severities", "", "comma separated list of status=severity pairs overriding the default severities of exit statuses")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,92 @@ func TestCreateCommandRunEClosureWithAllFlags(t *testing.T) {
 	assert.Regexp("OK", out)
 }
 
+func TestCreateCommandRunEClosureWithOutputMetricTags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(check *types.CheckConfig) bool {
+		return assert.Equal([]*types.MetricTag{
+			{Name: "entity", Value: "{{ .ID }}"},
+			{Name: "team", Value: "devops"},
+		}, check.OutputMetricTags)
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "echo 'heyhey'"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("output-metric-tags", "entity={{ .ID }}, team=devops"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithSeverities(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(check *types.CheckConfig) bool {
+		return assert.Equal(map[string]string{
+			"1": "ok",
+			"3": "disaster",
+		}, check.Severities)
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "echo 'heyhey'"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("severities", "1=ok, 3=disaster"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithPrometheusURL(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(check *types.CheckConfig) bool {
+		return assert.Equal("http://localhost:9100/metrics", check.PrometheusURL) && assert.Empty(check.Command)
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("prometheus-url", "http://localhost:9100/metrics"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	out, err := test.RunCmd(cmd, []string{"node-exporter"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithOutputSize(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(check *types.CheckConfig) bool {
+		return assert.Equal(int64(1024), check.MaxOutputSize) && assert.True(check.DiscardOutput)
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "echo 'heyhey'"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("max-output-size", "1024"))
+	require.NoError(t, cmd.Flags().Set("discard-output", "true"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
 func TestCreateCommandRunEClosureWithDeps(t *testing.T) {
 	assert := assert.New(t)
 
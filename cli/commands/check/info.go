@@ -66,6 +66,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Command",
 				Value: r.Command,
 			},
+			{
+				Label: "Prometheus URL",
+				Value: r.PrometheusURL,
+			},
 			{
 				Label: "Cron",
 				Value: r.Cron,
@@ -78,6 +82,26 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "TTL",
 				Value: strconv.FormatInt(int64(r.Ttl), 10),
 			},
+			{
+				Label: "Splay",
+				Value: strconv.FormatInt(int64(r.Splay), 10),
+			},
+			{
+				Label: "Depends On",
+				Value: strings.Join(r.DependsOn, ", "),
+			},
+			{
+				Label: "Dedup Key",
+				Value: r.DedupKey,
+			},
+			{
+				Label: "Max Output Size",
+				Value: strconv.FormatInt(r.MaxOutputSize, 10),
+			},
+			{
+				Label: "Discard Output",
+				Value: strconv.FormatBool(r.DiscardOutput),
+			},
 			{
 				Label: "Subscriptions",
 				Value: strings.Join(r.Subscriptions, ", "),
@@ -122,6 +146,14 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Metric Handlers",
 				Value: strings.Join(r.OutputMetricHandlers, ", "),
 			},
+			{
+				Label: "Metric Tags",
+				Value: formatMetricTags(r.OutputMetricTags),
+			},
+			{
+				Label: "Severities",
+				Value: formatSeverities(r.Severities),
+			},
 		},
 	}
 
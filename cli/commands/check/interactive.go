@@ -1,24 +1,26 @@
 package check
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey"
-	"github.com/robfig/cron"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/types"
 	"github.com/spf13/pflag"
 )
 
 const (
-	stdinDefault      = "false"
-	roundRobinDefault = "false"
+	stdinDefault                = "false"
+	roundRobinDefault           = "false"
+	outputMetricTolerantDefault = "false"
 )
 
 type checkOpts struct {
 	Name                 string `survey:"name"`
 	Command              string `survey:"command"`
+	PrometheusURL        string `survey:"prometheus-url"`
 	Interval             string `survey:"interval"`
 	Cron                 string `survey:"cron"`
 	Subscriptions        string `survey:"subscriptions"`
@@ -29,13 +31,21 @@ type checkOpts struct {
 	Publish              string `survey:"publish"`
 	ProxyEntityID        string `survey:"proxy-entity-id"`
 	Stdin                string `survey:"stdin"`
+	MaxOutputSize        string `survey:"max-output-size"`
+	DiscardOutput        string `survey:"discard-output"`
 	Timeout              string `survey:"timeout"`
 	TTL                  string `survey:"ttl"`
+	Splay                string `survey:"splay"`
+	DependsOn            string `survey:"depends-on"`
+	DedupKey             string `survey:"dedup-key"`
 	HighFlapThreshold    string `survey:"high-flap-threshold"`
 	LowFlapThreshold     string `survey:"low-flap-threshold"`
 	OutputMetricFormat   string `survey:"output-metric-format"`
 	OutputMetricHandlers string `survey:"output-metric-handlers"`
 	RoundRobin           string `survey:"round-robin"`
+	OutputMetricTolerant string `survey:"output-metric-tolerant"`
+	OutputMetricTags     string `survey:"output-metric-tags"`
+	Severities           string `survey:"severities"`
 }
 
 func newCheckOpts() *checkOpts {
@@ -48,6 +58,7 @@ func (opts *checkOpts) withCheck(check *types.CheckConfig) {
 	opts.Org = check.Organization
 	opts.Env = check.Environment
 	opts.Command = check.Command
+	opts.PrometheusURL = check.PrometheusURL
 	opts.Interval = strconv.Itoa(int(check.Interval))
 	opts.Cron = check.Cron
 	opts.Subscriptions = strings.Join(check.Subscriptions, ",")
@@ -55,16 +66,25 @@ func (opts *checkOpts) withCheck(check *types.CheckConfig) {
 	opts.RuntimeAssets = strings.Join(check.RuntimeAssets, ",")
 	opts.ProxyEntityID = check.ProxyEntityID
 	opts.Stdin = stdinDefault
+	opts.MaxOutputSize = strconv.FormatInt(check.MaxOutputSize, 10)
+	opts.DiscardOutput = strconv.FormatBool(check.DiscardOutput)
 	opts.Timeout = strconv.Itoa(int(check.Timeout))
+	opts.Splay = strconv.Itoa(int(check.Splay))
+	opts.DependsOn = strings.Join(check.DependsOn, ",")
+	opts.DedupKey = check.DedupKey
 	opts.HighFlapThreshold = strconv.Itoa(int(check.HighFlapThreshold))
 	opts.LowFlapThreshold = strconv.Itoa(int(check.LowFlapThreshold))
 	opts.OutputMetricFormat = check.OutputMetricFormat
 	opts.OutputMetricHandlers = strings.Join(check.OutputMetricHandlers, ",")
 	opts.RoundRobin = roundRobinDefault
+	opts.OutputMetricTolerant = strconv.FormatBool(check.OutputMetricTolerant)
+	opts.OutputMetricTags = formatMetricTags(check.OutputMetricTags)
+	opts.Severities = formatSeverities(check.Severities)
 }
 
 func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Command, _ = flags.GetString("command")
+	opts.PrometheusURL, _ = flags.GetString("prometheus-url")
 	opts.Interval, _ = flags.GetString("interval")
 	opts.Cron, _ = flags.GetString("cron")
 	opts.Subscriptions, _ = flags.GetString("subscriptions")
@@ -74,14 +94,24 @@ func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Publish = strconv.FormatBool(publishBool)
 	opts.ProxyEntityID, _ = flags.GetString("proxy-entity-id")
 	opts.Stdin, _ = flags.GetString("stdin")
+	opts.MaxOutputSize, _ = flags.GetString("max-output-size")
+	discardOutputBool, _ := flags.GetBool("discard-output")
+	opts.DiscardOutput = strconv.FormatBool(discardOutputBool)
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.TTL, _ = flags.GetString("ttl")
+	opts.Splay, _ = flags.GetString("splay")
+	opts.DependsOn, _ = flags.GetString("depends-on")
+	opts.DedupKey, _ = flags.GetString("dedup-key")
 	opts.HighFlapThreshold, _ = flags.GetString("high-flap-threshold")
 	opts.LowFlapThreshold, _ = flags.GetString("low-flap-threshold")
 	opts.OutputMetricFormat, _ = flags.GetString("output-metric-format")
 	opts.OutputMetricHandlers, _ = flags.GetString("output-metric-handlers")
 	roundRobinBool, _ := flags.GetBool("round-robin")
 	opts.RoundRobin = strconv.FormatBool(roundRobinBool)
+	outputMetricTolerantBool, _ := flags.GetBool("output-metric-tolerant")
+	opts.OutputMetricTolerant = strconv.FormatBool(outputMetricTolerantBool)
+	opts.OutputMetricTags, _ = flags.GetString("output-metric-tags")
+	opts.Severities, _ = flags.GetString("severities")
 
 	if org := helpers.GetChangedStringValueFlag("organization", flags); org != "" {
 		opts.Org = org
@@ -128,9 +158,17 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 			Name: "command",
 			Prompt: &survey.Input{
 				Message: "Command:",
+				Help:    "Leave empty to scrape a Prometheus metrics endpoint instead",
 				Default: opts.Command,
 			},
-			Validate: survey.Required,
+		},
+		{
+			Name: "prometheus-url",
+			Prompt: &survey.Input{
+				Message: "Prometheus URL:",
+				Help:    "URL of the Prometheus metrics endpoint scraped by the agents, instead of executing a command",
+				Default: opts.PrometheusURL,
+			},
 		},
 		{
 			Name: "interval",
@@ -148,7 +186,7 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 			},
 			Validate: func(val interface{}) error {
 				if val.(string) != "" {
-					if _, err := cron.ParseStandard(val.(string)); err != nil {
+					if _, err := types.ParseCron(val.(string)); err != nil {
 						return err
 					}
 				}
@@ -170,6 +208,30 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.TTL,
 			},
 		},
+		{
+			Name: "splay",
+			Prompt: &survey.Input{
+				Message: "Splay:",
+				Help:    "Maximum delay in seconds of the executions, spread across the subscribed agents",
+				Default: opts.Splay,
+			},
+		},
+		{
+			Name: "depends-on",
+			Prompt: &survey.Input{
+				Message: "Depends On:",
+				Help:    "Comma separated list of checks, or entity/check pairs, this check depends on",
+				Default: opts.DependsOn,
+			},
+		},
+		{
+			Name: "dedup-key",
+			Prompt: &survey.Input{
+				Message: "Dedup Key:",
+				Help:    "Template of the key collapsing the events of the check, as {{ .Check.ProxyEntityID }}, into a single event stream",
+				Default: opts.DedupKey,
+			},
+		},
 		{
 			Name: "subscriptions",
 			Prompt: &survey.Input{
@@ -220,6 +282,33 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 				Help:    "If check accepts JSON event data to the check command's stdin. Defaults to false.",
 			},
 		},
+		{
+			Name: "max-output-size",
+			Prompt: &survey.Input{
+				Message: "Max Output Size:",
+				Default: opts.MaxOutputSize,
+				Help:    "maximum size in bytes of the check output kept by the agents, which truncate longer outputs (unlimited if 0)",
+			},
+			Validate: func(val interface{}) error {
+				if val.(string) == "" {
+					return nil
+				}
+				_, err := strconv.ParseUint(val.(string), 10, 63)
+				return err
+			},
+		},
+		{
+			Name: "discard-output",
+			Prompt: &survey.Input{
+				Message: "Discard Output:",
+				Default: opts.DiscardOutput,
+				Help:    "if true, the agents discard the check output once its metrics are extracted",
+			},
+			Validate: func(val interface{}) error {
+				_, err := strconv.ParseBool(val.(string))
+				return err
+			},
+		},
 		{
 			Name: "high-flap-threshold",
 			Prompt: &survey.Input{
@@ -238,7 +327,7 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 			Name: "output-metric-format",
 			Prompt: &survey.Input{
 				Message: "Metric Format:",
-				Help:    "Optional output metric format used to parse check output for metric extraction. Valid formats include: nagios_perfdata, graphite_plaintext, opentsdb_line, and influxdb_line",
+				Help:    "Optional output metric format used to parse check output for metric extraction. Valid formats include: nagios_perfdata, graphite_plaintext, opentsdb_line, influxdb_line, statsd_line, json, prometheus_text, and auto",
 				Default: opts.OutputMetricFormat,
 			},
 			Validate: func(val interface{}) error {
@@ -269,6 +358,40 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 				return err
 			},
 		},
+		{
+			Name: "output-metric-tolerant",
+			Prompt: &survey.Input{
+				Message: "Tolerant Metric Extraction:",
+				Default: outputMetricTolerantDefault,
+				Help:    "if true, skip the metrics that can't be parsed instead of failing the whole metric extraction",
+			},
+			Validate: func(val interface{}) error {
+				_, err := strconv.ParseBool(val.(string))
+				return err
+			},
+		},
+		{
+			Name: "output-metric-tags",
+			Prompt: &survey.Input{
+				Message: "Metric Tags:",
+				Default: opts.OutputMetricTags,
+				Help:    "comma separated list of name=value tags to add to the extracted metrics, e.g. entity={{ .ID }}",
+			},
+			Validate: func(val interface{}) error {
+				return types.ValidateMetricTags(parseMetricTags(val.(string)))
+			},
+		},
+		{
+			Name: "severities",
+			Prompt: &survey.Input{
+				Message: "Severities:",
+				Default: opts.Severities,
+				Help:    "comma separated list of status=severity pairs overriding the default severities of exit statuses, e.g. 1=ok,3=critical",
+			},
+			Validate: func(val interface{}) error {
+				return types.ValidateSeverities(parseSeverities(val.(string)))
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -279,6 +402,8 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	stdin, _ := strconv.ParseBool(opts.Stdin)
 	timeout, _ := strconv.ParseUint(opts.Timeout, 10, 32)
 	ttl, _ := strconv.ParseInt(opts.TTL, 10, 64)
+	splay, _ := strconv.ParseUint(opts.Splay, 10, 32)
+	maxOutputSize, _ := strconv.ParseInt(opts.MaxOutputSize, 10, 64)
 	highFlap, _ := strconv.ParseUint(opts.HighFlapThreshold, 10, 32)
 	lowFlap, _ := strconv.ParseUint(opts.LowFlapThreshold, 10, 32)
 
@@ -287,6 +412,7 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	check.Organization = opts.Org
 	check.Interval = uint32(interval)
 	check.Command = opts.Command
+	check.PrometheusURL = opts.PrometheusURL
 	check.Cron = opts.Cron
 	check.Subscriptions = helpers.SafeSplitCSV(opts.Subscriptions)
 	check.Handlers = helpers.SafeSplitCSV(opts.Handlers)
@@ -294,11 +420,72 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	check.Publish, _ = strconv.ParseBool(opts.Publish)
 	check.ProxyEntityID = opts.ProxyEntityID
 	check.Stdin = stdin
+	check.MaxOutputSize = maxOutputSize
+	check.DiscardOutput, _ = strconv.ParseBool(opts.DiscardOutput)
 	check.Timeout = uint32(timeout)
 	check.Ttl = int64(ttl)
+	check.Splay = uint32(splay)
+	check.DependsOn = helpers.SafeSplitCSV(opts.DependsOn)
+	check.DedupKey = opts.DedupKey
 	check.HighFlapThreshold = uint32(highFlap)
 	check.LowFlapThreshold = uint32(lowFlap)
 	check.OutputMetricFormat = opts.OutputMetricFormat
 	check.OutputMetricHandlers = helpers.SafeSplitCSV(opts.OutputMetricHandlers)
 	check.RoundRobin, _ = strconv.ParseBool(opts.RoundRobin)
+	check.OutputMetricTolerant, _ = strconv.ParseBool(opts.OutputMetricTolerant)
+	check.OutputMetricTags = parseMetricTags(opts.OutputMetricTags)
+	check.Severities = parseSeverities(opts.Severities)
+}
+
+// parseMetricTags parses a comma separated list of name=value metric tags
+func parseMetricTags(s string) []*types.MetricTag {
+	var tags []*types.MetricTag
+	for _, tag := range helpers.SafeSplitCSV(s) {
+		parts := strings.SplitN(tag, "=", 2)
+		metricTag := &types.MetricTag{Name: strings.TrimSpace(parts[0])}
+		if len(parts) == 2 {
+			metricTag.Value = strings.TrimSpace(parts[1])
+		}
+		tags = append(tags, metricTag)
+	}
+	return tags
+}
+
+// formatMetricTags formats metric tags as a comma separated list of name=value
+// pairs
+func formatMetricTags(tags []*types.MetricTag) string {
+	pairs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		pairs = append(pairs, tag.Name+"="+tag.Value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+// parseSeverities parses a comma separated list of status=severity pairs
+func parseSeverities(s string) map[string]string {
+	pairs := helpers.SafeSplitCSV(s)
+	if len(pairs) == 0 {
+		return nil
+	}
+	severities := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		var severity string
+		if len(parts) == 2 {
+			severity = strings.TrimSpace(parts[1])
+		}
+		severities[strings.TrimSpace(parts[0])] = severity
+	}
+	return severities
+}
+
+// formatSeverities formats severities as a comma separated list of
+// status=severity pairs, ordered by status
+func formatSeverities(severities map[string]string) string {
+	pairs := make([]string, 0, len(severities))
+	for status, severity := range severities {
+		pairs = append(pairs, status+"="+severity)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
 }
@@ -47,10 +47,13 @@ func SetSubdueCommand(cli *cli.SensuCli) *cobra.Command {
 			if err := json.NewDecoder(in).Decode(&timeWindows); err != nil {
 				return err
 			}
-			for _, windows := range timeWindows.MapTimeWindows() {
-				for _, window := range windows {
-					if err := timeutil.ConvertToUTC(window); err != nil {
-						return err
+			// Time windows without time zone are given in local time
+			if timeWindows.Timezone == "" {
+				for _, windows := range timeWindows.MapTimeWindows() {
+					for _, window := range windows {
+						if err := timeutil.ConvertToUTC(window); err != nil {
+							return err
+						}
 					}
 				}
 			}
@@ -36,6 +36,7 @@ func fileFromString(t *testing.T, s string) (string, *os.File, func()) {
 
 func TestSetSubdueCommand(t *testing.T) {
 	const subdueJSON = `{"days":{"all":[{"begin":"3:00 PM","end":"4:00 PM"}]}}`
+	const zonedSubdueJSON = `{"days":{"all":[{"begin":"3:00PM","end":"4:00PM"}]},"timezone":"America/Montreal"}`
 	tests := []struct {
 		args           []string
 		useflag        bool
@@ -52,6 +53,8 @@ func TestSetSubdueCommand(t *testing.T) {
 		{[]string{"check1"}, false, subdueJSON, nil, nil, "OK", false},
 		{[]string{"check1"}, false, "invalidjson", nil, nil, "", true},
 		{[]string{"check1"}, true, subdueJSON, nil, nil, "", false},
+		{[]string{"check1"}, false, zonedSubdueJSON, nil, nil, "OK", false},
+		{[]string{"check1"}, false, `{"timezone":"Nowhere/Special"}`, nil, nil, "", true},
 	}
 
 	for i, test := range tests {
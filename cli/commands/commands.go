@@ -18,8 +18,10 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/hook"
 	"github.com/sensu/sensu-go/cli/commands/logout"
 	"github.com/sensu/sensu-go/cli/commands/mutator"
+	"github.com/sensu/sensu-go/cli/commands/namespace"
 	"github.com/sensu/sensu-go/cli/commands/organization"
 	"github.com/sensu/sensu-go/cli/commands/role"
+	"github.com/sensu/sensu-go/cli/commands/secret"
 	"github.com/sensu/sensu-go/cli/commands/silenced"
 	"github.com/sensu/sensu-go/cli/commands/user"
 	"github.com/spf13/cobra"
@@ -43,8 +45,10 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		handler.HelpCommand(cli),
 		hook.HelpCommand(cli),
 		mutator.HelpCommand(cli),
+		namespace.HelpCommand(cli),
 		organization.HelpCommand(cli),
 		role.HelpCommand(cli),
+		secret.HelpCommand(cli),
 		user.HelpCommand(cli),
 		silenced.HelpCommand(cli),
 		create.CreateCommand(cli),
@@ -16,6 +16,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.AddCommand(
 		SetEnvCommand(cli),
 		SetFormatCommand(cli),
+		SetNamespaceCommand(cli),
 		SetOrgCommand(cli),
 		ViewCommand(cli),
 	)
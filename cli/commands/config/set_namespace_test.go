@@ -0,0 +1,70 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetNamespaceCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := &cli.SensuCli{}
+	cmd := SetNamespaceCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("set-namespace", cmd.Use)
+	assert.Regexp("Set namespace", cmd.Short)
+}
+
+func TestSetNamespaceBadArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := &cli.SensuCli{}
+	cmd := SetNamespaceCommand(cli)
+
+	// No args...
+	out, err := test.RunCmd(cmd, []string{})
+	assert.NotEmpty(out, "output should display help usage")
+	assert.Error(err, "error should be returned")
+
+	// Invalid namespace...
+	_, err = test.RunCmd(cmd, []string{"acme"})
+	assert.Error(err, "error should be returned")
+}
+
+func TestSetNamespaceExec(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := SetNamespaceCommand(cli)
+
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("SaveOrganization", "acme").Return(nil)
+	config.On("SaveEnvironment", "production").Return(nil)
+
+	out, err := test.RunCmd(cmd, []string{"acme/production"})
+	assert.Equal(out, "OK\n")
+	assert.Nil(err, "Should not produce any errors")
+	config.AssertCalled(t, "SaveOrganization", "acme")
+	config.AssertCalled(t, "SaveEnvironment", "production")
+}
+
+func TestSetNamespaceWithWriteErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := SetNamespaceCommand(cli)
+
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("SaveOrganization", "acme").Return(errors.New("blah"))
+
+	out, err := test.RunCmd(cmd, []string{"acme/production"})
+	assert.Contains(out, "Unable to write")
+	assert.Nil(err, "Should not return an error")
+}
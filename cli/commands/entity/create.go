@@ -47,6 +47,9 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 				}
 			} else {
 				opts.withFlags(cmd.Flags())
+				if err := validateCustomAttributes(opts.CustomAttributes); err != nil {
+					return err
+				}
 			}
 
 			// Apply given arguments to entity
@@ -69,8 +72,9 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 		},
 	}
 
-	_ = cmd.Flags().StringP("class", "c", "", "entity class, either proxy or agent")
+	_ = cmd.Flags().StringP("class", "c", "", "entity class, either agent, proxy or service")
 	_ = cmd.Flags().StringP("subscriptions", "s", "", "comma separated list of subscriptions")
+	_ = cmd.Flags().String("custom-attributes", "", "JSON object of the custom attributes of the entity")
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
 
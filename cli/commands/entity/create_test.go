@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,30 @@ func TestCreateCommandRunEClosureWithFlags(t *testing.T) {
 
 }
 
+func TestCreateCommandRunEClosureWithCustomAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateEntity", mock.MatchedBy(func(entity *types.Entity) bool {
+		rack, err := entity.Get("rack")
+		return assert.NoError(err) && assert.Equal("r12", rack)
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("class", "proxy"))
+	require.NoError(t, cmd.Flags().Set("custom-attributes", `{"rack": "r12"}`))
+	out, err := test.RunCmd(cmd, []string{"switch-1"})
+
+	assert.Regexp("OK", out)
+	assert.NoError(err)
+
+	// The custom attributes must be a JSON object
+	require.NoError(t, cmd.Flags().Set("custom-attributes", `["r12"]`))
+	_, err = test.RunCmd(cmd, []string{"switch-1"})
+	assert.Error(err)
+}
+
 func TestCreateCommandRunEClosureWithAPIErr(t *testing.T) {
 	assert := assert.New(t)
 
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/entity/subcommands"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +20,8 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		ListCommand(cli),
 		InfoCommand(cli),
 		UpdateCommand(cli),
+
+		subcommands.SetSubscriptionsCommand(cli),
 	)
 
 	return cmd
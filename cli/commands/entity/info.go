@@ -66,6 +66,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Subscriptions",
 				Value: strings.Join(r.Subscriptions, ", "),
 			},
+			{
+				Label: "Custom Attributes",
+				Value: string(r.ExtendedAttributes),
+			},
 			{
 				Label: "Last Seen",
 				Value: timeutil.HumanTimestamp(r.LastSeen),
@@ -90,6 +94,26 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Platform Version",
 				Value: r.System.PlatformVersion,
 			},
+			{
+				Label: "Cloud Provider",
+				Value: r.System.Cloud.GetProvider(),
+			},
+			{
+				Label: "Cloud Instance ID",
+				Value: r.System.Cloud.GetInstanceID(),
+			},
+			{
+				Label: "Cloud Instance Type",
+				Value: r.System.Cloud.GetInstanceType(),
+			},
+			{
+				Label: "Cloud Region",
+				Value: r.System.Cloud.GetRegion(),
+			},
+			{
+				Label: "Cloud Availability Zone",
+				Value: r.System.Cloud.GetAvailabilityZone(),
+			},
 			// TODO: Network interfaces
 			{
 				Label: "Auto-Deregistration",
@@ -68,6 +68,30 @@ func TestInfoCommandRunEClosureWithTable(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestInfoCommandRunEClosureWithCloud(t *testing.T) {
+	assert := assert.New(t)
+
+	entity := types.FixtureEntity("name-one")
+	entity.System.Cloud = &types.Cloud{
+		Provider:   "ec2",
+		InstanceID: "i-0123456789",
+		Region:     "us-east-1",
+	}
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchEntity", "in").Return(entity, nil)
+
+	cmd := InfoCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+
+	out, err := test.RunCmd(cmd, []string{"in"})
+
+	assert.Contains(out, "Cloud Provider")
+	assert.Contains(out, "i-0123456789")
+	assert.Contains(out, "us-east-1")
+	assert.Nil(err)
+}
+
 func TestInfoCommandRunEClosureWithErr(t *testing.T) {
 	assert := assert.New(t)
 
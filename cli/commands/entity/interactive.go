@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/AlecAivazis/survey"
@@ -10,11 +12,12 @@ import (
 )
 
 type entityOpts struct {
-	ID            string `survey:"id"`
-	Class         string `survey:"class"`
-	Subscriptions string `survey:"subscriptions"`
-	Org           string
-	Env           string
+	ID               string `survey:"id"`
+	Class            string `survey:"class"`
+	Subscriptions    string `survey:"subscriptions"`
+	CustomAttributes string `survey:"custom-attributes"`
+	Org              string
+	Env              string
 }
 
 func newEntityOpts() *entityOpts {
@@ -24,6 +27,7 @@ func newEntityOpts() *entityOpts {
 func (opts *entityOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Class, _ = flags.GetString("class")
 	opts.Subscriptions, _ = flags.GetString("subscriptions")
+	opts.CustomAttributes, _ = flags.GetString("custom-attributes")
 
 	if org := helpers.GetChangedStringValueFlag("organization", flags); org != "" {
 		opts.Org = org
@@ -40,7 +44,7 @@ func (opts *entityOpts) administerQuestionnaire() error {
 			Prompt: &survey.Input{
 				Message: "Class:",
 				Default: opts.Class,
-				Help:    "entity class, either proxy or agent",
+				Help:    "entity class, either agent, proxy or service",
 			},
 			Validate: survey.Required,
 		},
@@ -52,6 +56,17 @@ func (opts *entityOpts) administerQuestionnaire() error {
 				Help:    "comma separated list of subscriptions",
 			},
 		},
+		{
+			Name: "custom-attributes",
+			Prompt: &survey.Input{
+				Message: "Custom Attributes:",
+				Default: opts.CustomAttributes,
+				Help:    "JSON object of the custom attributes of the entity, e.g. {\"rack\": \"r12\"}",
+			},
+			Validate: func(val interface{}) error {
+				return validateCustomAttributes(val.(string))
+			},
+		},
 		{
 			Name: "org",
 			Prompt: &survey.Input{
@@ -77,6 +92,7 @@ func (opts *entityOpts) copy(entity *types.Entity) {
 	entity.ID = opts.ID
 	entity.Class = opts.Class
 	entity.Subscriptions = helpers.SafeSplitCSV(opts.Subscriptions)
+	entity.SetExtendedAttributes([]byte(opts.CustomAttributes))
 	entity.Environment = opts.Env
 	entity.Organization = opts.Org
 }
@@ -85,6 +101,20 @@ func (opts *entityOpts) withEntity(entity *types.Entity) {
 	opts.ID = entity.ID
 	opts.Class = entity.Class
 	opts.Subscriptions = strings.Join(entity.Subscriptions, ",")
+	opts.CustomAttributes = string(entity.ExtendedAttributes)
 	opts.Env = entity.Environment
 	opts.Org = entity.Organization
 }
+
+// validateCustomAttributes returns an error if the given custom attributes are
+// not empty nor a JSON object
+func validateCustomAttributes(attributes string) error {
+	if attributes == "" {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(attributes), &m); err != nil {
+		return errors.New("custom attributes must be a JSON object")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package subcommands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+// SetSubscriptionsCommand updates the subscriptions of an entity
+func SetSubscriptionsCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "set-subscriptions [ID] [VALUE]",
+		Short:        "set subscriptions of an entity",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			entityID := args[0]
+			value := args[1]
+
+			entity, err := cli.Client.FetchEntity(entityID)
+			if err != nil {
+				return err
+			}
+			entity.Subscriptions = helpers.SafeSplitCSV(value)
+
+			if err := entity.Validate(); err != nil {
+				return err
+			}
+			if err := cli.Client.UpdateEntity(entity); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "Updated")
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package subcommands
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetSubscriptionsCommand(t *testing.T) {
+	testCases := []struct {
+		testName       string
+		args           []string
+		fetchResponse  error
+		updateResponse error
+		expectedOutput string
+		expectError    bool
+	}{
+		{"no args", []string{}, nil, nil, "Usage", true},
+		{"fetch error", []string{"entity1", "foo"}, fmt.Errorf("error"), nil, "", true},
+		{"update error", []string{"entity1", "bar"}, nil, fmt.Errorf("error"), "", true},
+		{"invalid input", []string{"entity1"}, nil, nil, "", true},
+		{"valid input", []string{"entity1", "sub1,sub2"}, nil, nil, "Updated", false},
+	}
+
+	for _, tc := range testCases {
+		var name string
+		if len(tc.args) > 0 {
+			name = tc.args[0]
+		}
+
+		t.Run(tc.testName, func(t *testing.T) {
+			entity := types.FixtureEntity("entity1")
+			cli := test.NewMockCLI()
+
+			client := cli.Client.(*client.MockClient)
+			client.On(
+				"FetchEntity",
+				name,
+			).Return(entity, tc.fetchResponse)
+
+			client.On(
+				"UpdateEntity",
+				mock.Anything,
+			).Return(tc.updateResponse)
+
+			cmd := SetSubscriptionsCommand(cli)
+			out, err := test.RunCmd(cmd, tc.args)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Regexp(t, tc.expectedOutput, out)
+		})
+	}
+}
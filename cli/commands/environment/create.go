@@ -64,6 +64,8 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	}
 
 	_ = cmd.Flags().StringP("description", "", "", "Description of environment")
+	_ = cmd.Flags().StringP("event-retention", "", "", "Number of days resolved events are kept, indefinitely if 0")
+	_ = cmd.Flags().StringP("redact", "", "", "Comma separated list of fields redacted from the events, in addition to those of their entity")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
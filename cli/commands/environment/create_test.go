@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -54,3 +55,47 @@ func TestCreateCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCommandEventRetention(t *testing.T) {
+	cli := test.NewMockCLI()
+
+	config := cli.Config.(*client.MockConfig)
+	config.On("Organization").Return("default")
+
+	client := cli.Client.(*client.MockClient)
+	client.On(
+		"CreateEnvironment",
+		"default",
+		mock.MatchedBy(func(env *types.Environment) bool {
+			return env.EventRetention == 30
+		}),
+	).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("event-retention", "30"))
+	out, err := test.RunCmd(cmd, []string{"foo"})
+	require.NoError(t, err)
+	assert.Regexp(t, "Created", out)
+}
+
+func TestCreateCommandRedact(t *testing.T) {
+	cli := test.NewMockCLI()
+
+	config := cli.Config.(*client.MockConfig)
+	config.On("Organization").Return("default")
+
+	client := cli.Client.(*client.MockClient)
+	client.On(
+		"CreateEnvironment",
+		"default",
+		mock.MatchedBy(func(env *types.Environment) bool {
+			return assert.Equal(t, []string{"db_password", "token"}, env.Redact)
+		}),
+	).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("redact", "db_password, token"))
+	out, err := test.RunCmd(cmd, []string{"foo"})
+	require.NoError(t, err)
+	assert.Regexp(t, "Created", out)
+}
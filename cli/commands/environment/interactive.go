@@ -1,6 +1,9 @@
 package environment
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/AlecAivazis/survey"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/types"
@@ -8,19 +11,25 @@ import (
 )
 
 type envOpts struct {
-	Description string `survey:"description"`
-	Name        string `survey:"name"`
-	Org         string `survey:"organization"`
+	Description    string `survey:"description"`
+	EventRetention string `survey:"event-retention"`
+	Name           string `survey:"name"`
+	Org            string `survey:"organization"`
+	Redact         string `survey:"redact"`
 }
 
 func (opts *envOpts) withEnv(env *types.Environment) {
 	opts.Name = env.Name
 	opts.Description = env.Description
+	opts.EventRetention = strconv.FormatUint(uint64(env.EventRetention), 10)
 	opts.Org = env.Organization
+	opts.Redact = strings.Join(env.Redact, ",")
 }
 
 func (opts *envOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Description, _ = flags.GetString("description")
+	opts.EventRetention, _ = flags.GetString("event-retention")
+	opts.Redact, _ = flags.GetString("redact")
 
 	if org := helpers.GetChangedStringValueFlag("organization", flags); org != "" {
 		opts.Org = org
@@ -59,6 +68,22 @@ func (opts *envOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.Description,
 			},
 		},
+		{
+			Name: "event-retention",
+			Prompt: &survey.Input{
+				Message: "Event Retention:",
+				Help:    "Number of days resolved events are kept. Resolved events are kept indefinitely if 0.",
+				Default: opts.EventRetention,
+			},
+		},
+		{
+			Name: "redact",
+			Prompt: &survey.Input{
+				Message: "Redact:",
+				Help:    "Comma separated list of fields redacted from the events, in addition to those of their entity.",
+				Default: opts.Redact,
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -66,6 +91,13 @@ func (opts *envOpts) administerQuestionnaire(editing bool) error {
 
 func (opts *envOpts) Copy(env *types.Environment) {
 	env.Description = opts.Description
+	if len(opts.EventRetention) > 0 {
+		r, _ := strconv.ParseUint(opts.EventRetention, 10, 32)
+		env.EventRetention = uint32(r)
+	} else {
+		env.EventRetention = 0
+	}
+	env.Redact = helpers.SafeSplitCSV(opts.Redact)
 	env.Name = opts.Name
 	env.Organization = opts.Org
 }
@@ -78,6 +78,13 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 		"comma separated list of boolean expressions that are evaluated to "+
 			"determine if the event matches this filter",
 	)
+	cmd.Flags().StringP("type", "t", "",
+		"language of the statements of the filter. Allowed values: "+
+			strings.Join(types.EventFilterAllTypes, ", ")+" (default "+types.EventFilterTypeGovaluate+")",
+	)
+	cmd.Flags().StringP("runtime-assets", "r", "",
+		"comma separated list of assets providing libraries to the statements of a javascript filter",
+	)
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,27 @@ func TestCreateCommandRunEClosureWithAllFlags(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestCreateCommandRunEClosureWithJavaScript(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateFilter", mock.MatchedBy(func(filter *types.EventFilter) bool {
+		return filter.Type == types.EventFilterTypeJavaScript &&
+			len(filter.RuntimeAssets) == 1 && filter.RuntimeAssets[0] == "lodash"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("action", "allow"))
+	require.NoError(t, cmd.Flags().Set("statements", "_.isString(event.check.output)"))
+	require.NoError(t, cmd.Flags().Set("type", "javascript"))
+	require.NoError(t, cmd.Flags().Set("runtime-assets", "lodash"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
 func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
 	assert := assert.New(t)
 
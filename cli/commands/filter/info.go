@@ -60,10 +60,18 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Action",
 				Value: filter.Action,
 			},
+			{
+				Label: "Type",
+				Value: filterTypeOrDefault(filter.Type),
+			},
 			{
 				Label: "Statements",
 				Value: strings.Join(filter.Statements, " && "),
 			},
+			{
+				Label: "Runtime Assets",
+				Value: strings.Join(filter.RuntimeAssets, ", "),
+			},
 			{
 				Label: "Organization",
 				Value: filter.Organization,
@@ -10,11 +10,13 @@ import (
 )
 
 type filterOpts struct {
-	Action     string `survey:"action"`
-	Env        string
-	Name       string `survey:"name"`
-	Org        string
-	Statements string `survey:"statements"`
+	Action        string `survey:"action"`
+	Env           string
+	Name          string `survey:"name"`
+	Org           string
+	Statements    string `survey:"statements"`
+	Type          string `survey:"type"`
+	RuntimeAssets string `survey:"assets"`
 }
 
 func newFilterOpts() *filterOpts {
@@ -63,6 +65,15 @@ func (opts *filterOpts) administerQuestionnaire(editing bool) error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "type",
+			Prompt: &survey.Select{
+				Message: "Type:",
+				Options: types.EventFilterAllTypes,
+				Default: filterTypeOrDefault(opts.Type),
+			},
+			Validate: survey.Required,
+		},
 		{
 			Name: "statements",
 			Prompt: &survey.Input{
@@ -71,6 +82,13 @@ func (opts *filterOpts) administerQuestionnaire(editing bool) error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "assets",
+			Prompt: &survey.Input{
+				Message: "Runtime Assets (javascript filters only):",
+				Default: opts.RuntimeAssets,
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -82,6 +100,8 @@ func (opts *filterOpts) Copy(filter *types.EventFilter) {
 	filter.Name = opts.Name
 	filter.Organization = opts.Org
 	filter.Statements = helpers.SafeSplitCSV(opts.Statements)
+	filter.Type = opts.Type
+	filter.RuntimeAssets = helpers.SafeSplitCSV(opts.RuntimeAssets)
 }
 
 func (opts *filterOpts) withFilter(filter *types.EventFilter) {
@@ -90,11 +110,15 @@ func (opts *filterOpts) withFilter(filter *types.EventFilter) {
 	opts.Env = filter.Environment
 	opts.Action = filter.Action
 	opts.Statements = strings.Join(filter.Statements, ",")
+	opts.Type = filter.Type
+	opts.RuntimeAssets = strings.Join(filter.RuntimeAssets, ",")
 }
 
 func (opts *filterOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Action, _ = flags.GetString("action")
 	opts.Statements, _ = flags.GetString("statements")
+	opts.Type, _ = flags.GetString("type")
+	opts.RuntimeAssets, _ = flags.GetString("runtime-assets")
 
 	if org := helpers.GetChangedStringValueFlag("organization", flags); org != "" {
 		opts.Org = org
@@ -103,3 +127,12 @@ func (opts *filterOpts) withFlags(flags *pflag.FlagSet) {
 		opts.Env = env
 	}
 }
+
+// filterTypeOrDefault returns the given filter type, or the default type of
+// filters if none is given.
+func filterTypeOrDefault(filterType string) string {
+	if filterType == "" {
+		return types.EventFilterTypeGovaluate
+	}
+	return filterType
+}
@@ -63,14 +63,35 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	}
 
 	cmd.Flags().String("command", "", "command to be executed. The event data is passed to the process via STDIN")
-	cmd.Flags().String("env-vars", "", "comma separated list of key=value environment variables for the mutator command")
+	cmd.Flags().String("email-body-template", "", "Go template of the body of the emails of the email handler, executed with the event")
+	cmd.Flags().String("email-from", "", "sender address of the emails of the email handler")
+	cmd.Flags().String("email-host", "", "host of the SMTP server of the email handler")
+	cmd.Flags().Bool("email-insecure-skip-verify", false, "skip the verification of the certificate of the SMTP server")
+	cmd.Flags().String("email-password", "", "password used to authenticate with the SMTP server")
+	cmd.Flags().String("email-port", "", "port of the SMTP server of the email handler (default 25)")
+	cmd.Flags().String("email-subject-template", "", "Go template of the subject of the emails of the email handler, executed with the event")
+	cmd.Flags().Bool("email-tls", false, "connect to the SMTP server over TLS, instead of with STARTTLS")
+	cmd.Flags().String("email-to", "", "comma separated list of the recipient addresses of the emails of the email handler")
+	cmd.Flags().String("email-username", "", "name used to authenticate with the SMTP server")
+	cmd.Flags().String("env-vars", "", "comma separated list of key=value environment variables for the handler command")
 	cmd.Flags().String("filters", "", "comma separated list of filters to use when filtering events for the handler")
 	cmd.Flags().String("handlers", "", "comma separated list of handlers to call using the handler set")
+	cmd.Flags().String("max-attempts", "", "number of times to execute the handler until it succeeds")
 	cmd.Flags().StringP("mutator", "m", "", "Sensu event mutator (name) to use to mutate event data for the handler")
+	cmd.Flags().String("pagerduty-routing-key", "", "integration key of the PagerDuty service of the handler")
+	cmd.Flags().String("pagerduty-summary-template", "", "Go template of the summary of the incidents of the PagerDuty handler, executed with the event")
+	cmd.Flags().String("retry-backoff", "", "time in seconds to wait before executing the handler again, doubled after each failure")
+	cmd.Flags().StringP("runtime-assets", "r", "", "comma separated list of assets the handler command depends on")
+	cmd.Flags().String("slack-channel", "", "channel of the Slack handler, instead of the channel of its webhook")
+	cmd.Flags().String("slack-template", "", "Go template of the messages of the Slack handler, executed with the event")
+	cmd.Flags().String("slack-username", "", "username of the Slack handler, instead of the username of its webhook")
+	cmd.Flags().String("slack-webhook-url", "", "URL of the Slack incoming webhook of the handler")
 	cmd.Flags().String("socket-host", "", "host of handler socket")
 	cmd.Flags().String("socket-port", "", "port of handler socket")
+	cmd.Flags().String("socket-reconnect-attempts", "", "number of times to reconnect to the handler socket when sending an event fails")
+	cmd.Flags().String("socket-reconnect-interval", "", "time in seconds to wait before reconnecting to the handler socket")
 	cmd.Flags().StringP("timeout", "i", "", "execution duration timeout in seconds (hard stop)")
-	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, tcp, udp, or set)")
+	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, tcp, udp, set, slack, pagerduty, or email)")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -53,6 +54,69 @@ func TestCreateCommandRunEClosureWithFlags(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestCreateCommandRunEClosureWithSlackFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(handler *types.Handler) bool {
+		return handler.Slack != nil &&
+			handler.Slack.WebhookURL == "https://hooks.slack.com/services/T000/B000/XXX" &&
+			handler.Slack.Channel == "#alerts"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("type", "slack"))
+	require.NoError(t, cmd.Flags().Set("slack-webhook-url", "https://hooks.slack.com/services/T000/B000/XXX"))
+	require.NoError(t, cmd.Flags().Set("slack-channel", "#alerts"))
+	out, err := test.RunCmd(cmd, []string{"slack"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
+func TestCreateCommandRunEClosureWithPagerDutyFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(handler *types.Handler) bool {
+		return handler.PagerDuty != nil && handler.PagerDuty.RoutingKey == "abc"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("type", "pagerduty"))
+	require.NoError(t, cmd.Flags().Set("pagerduty-routing-key", "abc"))
+	out, err := test.RunCmd(cmd, []string{"pagerduty"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
+func TestCreateCommandRunEClosureWithEmailFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(handler *types.Handler) bool {
+		return handler.Email != nil && handler.Email.Host == "smtp.example.com" &&
+			handler.Email.Port == 465 && handler.Email.TLS &&
+			len(handler.Email.To) == 2 && handler.Email.To[1] == "dev@example.com"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("type", "email"))
+	require.NoError(t, cmd.Flags().Set("email-host", "smtp.example.com"))
+	require.NoError(t, cmd.Flags().Set("email-port", "465"))
+	require.NoError(t, cmd.Flags().Set("email-tls", "true"))
+	require.NoError(t, cmd.Flags().Set("email-from", "sensu@example.com"))
+	require.NoError(t, cmd.Flags().Set("email-to", "ops@example.com, dev@example.com"))
+	out, err := test.RunCmd(cmd, []string{"email"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
 func TestCreateCommandRunEClosureWithAPIErr(t *testing.T) {
 	assert := assert.New(t)
 
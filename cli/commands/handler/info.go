@@ -76,6 +76,23 @@ func printToList(v interface{}, writer io.Writer) error {
 			table.TitleStyle("CALL:"),
 			strings.Join(handler.Handlers, ","),
 		)
+	case types.HandlerSlackType:
+		execute = fmt.Sprintf(
+			"%s %s",
+			table.TitleStyle("POST:"),
+			handler.Slack.Channel,
+		)
+	case types.HandlerPagerDutyType:
+		execute = fmt.Sprintf(
+			"%s PagerDuty",
+			table.TitleStyle("PAGE:"),
+		)
+	case types.HandlerEmailType:
+		execute = fmt.Sprintf(
+			"%s %s",
+			table.TitleStyle("MAIL:"),
+			strings.Join(handler.Email.To, ","),
+		)
 	default:
 		execute = "UNKNOWN"
 	}
@@ -111,6 +128,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Environment Variables",
 				Value: strings.Join(handler.EnvVars, ", "),
 			},
+			{
+				Label: "Runtime Assets",
+				Value: strings.Join(handler.RuntimeAssets, ", "),
+			},
 		},
 	}
 
@@ -23,6 +23,33 @@ type handlerOpts struct {
 	Type       string `survey:"type"`
 	Env        string
 	Org        string
+
+	RuntimeAssets string `survey:"runtimeAssets"`
+
+	MaxAttempts  string `survey:"maxAttempts"`
+	RetryBackoff string `survey:"retryBackoff"`
+
+	SlackWebhookURL string `survey:"slackWebhookURL"`
+	SlackChannel    string `survey:"slackChannel"`
+	SlackUsername   string `survey:"slackUsername"`
+	SlackTemplate   string `survey:"slackTemplate"`
+
+	PagerDutyRoutingKey      string `survey:"pagerDutyRoutingKey"`
+	PagerDutySummaryTemplate string `survey:"pagerDutySummaryTemplate"`
+
+	EmailHost               string `survey:"emailHost"`
+	EmailPort               string `survey:"emailPort"`
+	EmailUsername           string `survey:"emailUsername"`
+	EmailPassword           string `survey:"emailPassword"`
+	EmailFrom               string `survey:"emailFrom"`
+	EmailTo                 string `survey:"emailTo"`
+	EmailSubjectTemplate    string `survey:"emailSubjectTemplate"`
+	EmailBodyTemplate       string `survey:"emailBodyTemplate"`
+	EmailTLS                string `survey:"emailTLS"`
+	EmailInsecureSkipVerify string `survey:"emailInsecureSkipVerify"`
+
+	SocketReconnectAttempts string `survey:"socketReconnectAttempts"`
+	SocketReconnectInterval string `survey:"socketReconnectInterval"`
 }
 
 const (
@@ -45,23 +72,86 @@ func (opts *handlerOpts) withHandler(handler *types.Handler) {
 	opts.Filters = strings.Join(handler.Filters, ",")
 	opts.Handlers = strings.Join(handler.Handlers, ",")
 	opts.Mutator = handler.Mutator
+	opts.RuntimeAssets = strings.Join(handler.RuntimeAssets, ",")
 	opts.Timeout = strconv.FormatUint(uint64(handler.Timeout), 10)
 	opts.Type = handler.Type
+	if handler.MaxAttempts > 0 {
+		opts.MaxAttempts = strconv.FormatUint(uint64(handler.MaxAttempts), 10)
+	}
+	if handler.RetryBackoff > 0 {
+		opts.RetryBackoff = strconv.FormatUint(uint64(handler.RetryBackoff), 10)
+	}
 
 	if handler.Socket != nil {
 		opts.SocketHost = handler.Socket.Host
 		opts.SocketPort = strconv.FormatUint(uint64(handler.Socket.Port), 10)
+		if handler.Socket.ReconnectAttempts > 0 {
+			opts.SocketReconnectAttempts = strconv.FormatUint(uint64(handler.Socket.ReconnectAttempts), 10)
+		}
+		if handler.Socket.ReconnectInterval > 0 {
+			opts.SocketReconnectInterval = strconv.FormatUint(uint64(handler.Socket.ReconnectInterval), 10)
+		}
+	}
+
+	if handler.Slack != nil {
+		opts.SlackWebhookURL = handler.Slack.WebhookURL
+		opts.SlackChannel = handler.Slack.Channel
+		opts.SlackUsername = handler.Slack.Username
+		opts.SlackTemplate = handler.Slack.Template
+	}
+
+	if handler.PagerDuty != nil {
+		opts.PagerDutyRoutingKey = handler.PagerDuty.RoutingKey
+		opts.PagerDutySummaryTemplate = handler.PagerDuty.SummaryTemplate
+	}
+
+	if handler.Email != nil {
+		opts.EmailHost = handler.Email.Host
+		if handler.Email.Port > 0 {
+			opts.EmailPort = strconv.FormatUint(uint64(handler.Email.Port), 10)
+		}
+		opts.EmailUsername = handler.Email.Username
+		opts.EmailPassword = handler.Email.Password
+		opts.EmailFrom = handler.Email.From
+		opts.EmailTo = strings.Join(handler.Email.To, ",")
+		opts.EmailSubjectTemplate = handler.Email.SubjectTemplate
+		opts.EmailBodyTemplate = handler.Email.BodyTemplate
+		opts.EmailTLS = strconv.FormatBool(handler.Email.TLS)
+		opts.EmailInsecureSkipVerify = strconv.FormatBool(handler.Email.InsecureSkipVerify)
 	}
 }
 
 func (opts *handlerOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Command, _ = flags.GetString("command")
+	opts.EmailBodyTemplate, _ = flags.GetString("email-body-template")
+	opts.EmailFrom, _ = flags.GetString("email-from")
+	opts.EmailHost, _ = flags.GetString("email-host")
+	emailInsecureSkipVerify, _ := flags.GetBool("email-insecure-skip-verify")
+	opts.EmailInsecureSkipVerify = strconv.FormatBool(emailInsecureSkipVerify)
+	opts.EmailPassword, _ = flags.GetString("email-password")
+	opts.EmailPort, _ = flags.GetString("email-port")
+	opts.EmailSubjectTemplate, _ = flags.GetString("email-subject-template")
+	emailTLS, _ := flags.GetBool("email-tls")
+	opts.EmailTLS = strconv.FormatBool(emailTLS)
+	opts.EmailTo, _ = flags.GetString("email-to")
+	opts.EmailUsername, _ = flags.GetString("email-username")
 	opts.EnvVars, _ = flags.GetString("env-vars")
 	opts.Filters, _ = flags.GetString("filters")
 	opts.Handlers, _ = flags.GetString("handlers")
+	opts.MaxAttempts, _ = flags.GetString("max-attempts")
 	opts.Mutator, _ = flags.GetString("mutator")
+	opts.PagerDutyRoutingKey, _ = flags.GetString("pagerduty-routing-key")
+	opts.RuntimeAssets, _ = flags.GetString("runtime-assets")
+	opts.PagerDutySummaryTemplate, _ = flags.GetString("pagerduty-summary-template")
+	opts.RetryBackoff, _ = flags.GetString("retry-backoff")
+	opts.SlackChannel, _ = flags.GetString("slack-channel")
+	opts.SlackTemplate, _ = flags.GetString("slack-template")
+	opts.SlackUsername, _ = flags.GetString("slack-username")
+	opts.SlackWebhookURL, _ = flags.GetString("slack-webhook-url")
 	opts.SocketHost, _ = flags.GetString("socket-host")
 	opts.SocketPort, _ = flags.GetString("socket-port")
+	opts.SocketReconnectAttempts, _ = flags.GetString("socket-reconnect-attempts")
+	opts.SocketReconnectInterval, _ = flags.GetString("socket-reconnect-interval")
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.Type, _ = flags.GetString("type")
 
@@ -88,6 +178,12 @@ func (opts *handlerOpts) administerQuestionnaire(editing bool) error {
 		return opts.queryForSocket()
 	case types.HandlerSetType:
 		return opts.queryForHandlers()
+	case types.HandlerSlackType:
+		return opts.queryForSlack()
+	case types.HandlerPagerDutyType:
+		return opts.queryForPagerDuty()
+	case types.HandlerEmailType:
+		return opts.queryForEmail()
 	}
 
 	return nil
@@ -155,11 +251,27 @@ func (opts *handlerOpts) queryForBaseParameters(editing bool) error {
 				Default: opts.Timeout,
 			},
 		},
+		{
+			Name: "maxAttempts",
+			Prompt: &survey.Input{
+				Message: "Max Attempts:",
+				Default: opts.MaxAttempts,
+				Help:    "number of times to execute the handler until it succeeds",
+			},
+		},
+		{
+			Name: "retryBackoff",
+			Prompt: &survey.Input{
+				Message: "Retry Backoff:",
+				Default: opts.RetryBackoff,
+				Help:    "time in seconds to wait before executing the handler again, doubled after each failure",
+			},
+		},
 		{
 			Name: "type",
 			Prompt: &survey.Select{
 				Message: "Type:",
-				Options: []string{"pipe", "tcp", "udp", "set"},
+				Options: []string{"pipe", "tcp", "udp", "set", "slack", "pagerduty", "email"},
 				Default: opts.Type,
 			},
 			Validate: survey.Required,
@@ -179,6 +291,14 @@ func (opts *handlerOpts) queryForCommand() error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "runtimeAssets",
+			Prompt: &survey.Input{
+				Message: "Runtime Assets:",
+				Default: opts.RuntimeAssets,
+				Help:    "comma separated list of assets the handler command depends on",
+			},
+		},
 	}
 
 	return survey.Ask(qs, opts)
@@ -218,6 +338,167 @@ func (opts *handlerOpts) queryForSocket() error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "socketReconnectAttempts",
+			Prompt: &survey.Input{
+				Message: "Socket Reconnect Attempts:",
+				Default: opts.SocketReconnectAttempts,
+			},
+		},
+		{
+			Name: "socketReconnectInterval",
+			Prompt: &survey.Input{
+				Message: "Socket Reconnect Interval:",
+				Default: opts.SocketReconnectInterval,
+			},
+		},
+	}
+
+	return survey.Ask(qs, opts)
+}
+
+func (opts *handlerOpts) queryForSlack() error {
+	var qs = []*survey.Question{
+		{
+			Name: "slackWebhookURL",
+			Prompt: &survey.Input{
+				Message: "Slack Webhook URL:",
+				Default: opts.SlackWebhookURL,
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "slackChannel",
+			Prompt: &survey.Input{
+				Message: "Slack Channel:",
+				Default: opts.SlackChannel,
+				Help:    "channel to post to instead of the channel of the webhook, e.g. #alerts",
+			},
+		},
+		{
+			Name: "slackUsername",
+			Prompt: &survey.Input{
+				Message: "Slack Username:",
+				Default: opts.SlackUsername,
+			},
+		},
+		{
+			Name: "slackTemplate",
+			Prompt: &survey.Input{
+				Message: "Slack Message Template:",
+				Default: opts.SlackTemplate,
+				Help:    "Go template of the message, executed with the event, e.g. {{ .Check.Output }}",
+			},
+		},
+	}
+
+	return survey.Ask(qs, opts)
+}
+
+func (opts *handlerOpts) queryForPagerDuty() error {
+	var qs = []*survey.Question{
+		{
+			Name: "pagerDutyRoutingKey",
+			Prompt: &survey.Input{
+				Message: "PagerDuty Routing Key:",
+				Default: opts.PagerDutyRoutingKey,
+				Help:    "integration key of the PagerDuty service incidents are triggered for",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "pagerDutySummaryTemplate",
+			Prompt: &survey.Input{
+				Message: "PagerDuty Summary Template:",
+				Default: opts.PagerDutySummaryTemplate,
+				Help:    "Go template of the summary of incidents, executed with the event, e.g. {{ .Check.Output }}",
+			},
+		},
+	}
+
+	return survey.Ask(qs, opts)
+}
+
+func (opts *handlerOpts) queryForEmail() error {
+	var qs = []*survey.Question{
+		{
+			Name: "emailHost",
+			Prompt: &survey.Input{
+				Message: "SMTP Host:",
+				Default: opts.EmailHost,
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "emailPort",
+			Prompt: &survey.Input{
+				Message: "SMTP Port:",
+				Default: opts.EmailPort,
+				Help:    "port of the SMTP server. Defaults to 25.",
+			},
+		},
+		{
+			Name: "emailTLS",
+			Prompt: &survey.Input{
+				Message: "SMTP TLS:",
+				Default: opts.EmailTLS,
+				Help:    "If the SMTP server is connected to over TLS, instead of with STARTTLS. Value must be true or false.",
+			},
+		},
+		{
+			Name: "emailInsecureSkipVerify",
+			Prompt: &survey.Input{
+				Message: "SMTP Insecure Skip Verify:",
+				Default: opts.EmailInsecureSkipVerify,
+				Help:    "If the certificate of the SMTP server is not verified. Value must be true or false.",
+			},
+		},
+		{
+			Name: "emailUsername",
+			Prompt: &survey.Input{
+				Message: "SMTP Username:",
+				Default: opts.EmailUsername,
+			},
+		},
+		{
+			Name: "emailPassword",
+			Prompt: &survey.Password{
+				Message: "SMTP Password:",
+			},
+		},
+		{
+			Name: "emailFrom",
+			Prompt: &survey.Input{
+				Message: "Email Sender:",
+				Default: opts.EmailFrom,
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "emailTo",
+			Prompt: &survey.Input{
+				Message: "Email Recipients:",
+				Default: opts.EmailTo,
+				Help:    "comma separated list of the recipient addresses of the emails",
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "emailSubjectTemplate",
+			Prompt: &survey.Input{
+				Message: "Email Subject Template:",
+				Default: opts.EmailSubjectTemplate,
+				Help:    "Go template of the subject of the emails, executed with the event, e.g. {{ .Check.Name }}",
+			},
+		},
+		{
+			Name: "emailBodyTemplate",
+			Prompt: &survey.Input{
+				Message: "Email Body Template:",
+				Default: opts.EmailBodyTemplate,
+				Help:    "Go template of the body of the emails, executed with the event, e.g. {{ .Check.Output }}",
+			},
+		},
 	}
 
 	return survey.Ask(qs, opts)
@@ -231,6 +512,7 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 	handler.Command = opts.Command
 	handler.EnvVars = helpers.SafeSplitCSV(opts.EnvVars)
 	handler.Mutator = opts.Mutator
+	handler.RuntimeAssets = helpers.SafeSplitCSV(opts.RuntimeAssets)
 	handler.Type = strings.ToLower(opts.Type)
 
 	if len(opts.Timeout) > 0 {
@@ -240,12 +522,70 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 		handler.Timeout = 0
 	}
 
+	if len(opts.MaxAttempts) > 0 {
+		a, _ := strconv.ParseUint(opts.MaxAttempts, 10, 32)
+		handler.MaxAttempts = uint32(a)
+	} else {
+		handler.MaxAttempts = 0
+	}
+
+	if len(opts.RetryBackoff) > 0 {
+		b, _ := strconv.ParseUint(opts.RetryBackoff, 10, 32)
+		handler.RetryBackoff = uint32(b)
+	} else {
+		handler.RetryBackoff = 0
+	}
+
 	if len(opts.SocketHost) > 0 && len(opts.SocketPort) > 0 {
 		p, _ := strconv.ParseUint(opts.SocketPort, 10, 32)
 		handler.Socket = &types.HandlerSocket{
 			Host: opts.SocketHost,
 			Port: uint32(p),
 		}
+		if len(opts.SocketReconnectAttempts) > 0 {
+			a, _ := strconv.ParseUint(opts.SocketReconnectAttempts, 10, 32)
+			handler.Socket.ReconnectAttempts = uint32(a)
+		}
+		if len(opts.SocketReconnectInterval) > 0 {
+			i, _ := strconv.ParseUint(opts.SocketReconnectInterval, 10, 32)
+			handler.Socket.ReconnectInterval = uint32(i)
+		}
+	}
+
+	if len(opts.SlackWebhookURL) > 0 {
+		handler.Slack = &types.HandlerSlack{
+			WebhookURL: opts.SlackWebhookURL,
+			Channel:    opts.SlackChannel,
+			Username:   opts.SlackUsername,
+			Template:   opts.SlackTemplate,
+		}
+	}
+
+	if len(opts.PagerDutyRoutingKey) > 0 {
+		handler.PagerDuty = &types.HandlerPagerDuty{
+			RoutingKey:      opts.PagerDutyRoutingKey,
+			SummaryTemplate: opts.PagerDutySummaryTemplate,
+		}
+	}
+
+	if len(opts.EmailHost) > 0 {
+		port, _ := strconv.ParseUint(opts.EmailPort, 10, 32)
+		tls, _ := strconv.ParseBool(opts.EmailTLS)
+		insecureSkipVerify, _ := strconv.ParseBool(opts.EmailInsecureSkipVerify)
+		handler.Email = &types.HandlerEmail{
+			Host:               opts.EmailHost,
+			Port:               uint32(port),
+			Username:           opts.EmailUsername,
+			Password:           opts.EmailPassword,
+			From:               opts.EmailFrom,
+			SubjectTemplate:    opts.EmailSubjectTemplate,
+			BodyTemplate:       opts.EmailBodyTemplate,
+			TLS:                tls,
+			InsecureSkipVerify: insecureSkipVerify,
+		}
+		for _, to := range helpers.SafeSplitCSV(opts.EmailTo) {
+			handler.Email.To = append(handler.Email.To, strings.TrimSpace(to))
+		}
 	}
 
 	filters := helpers.SafeSplitCSV(opts.Filters)
@@ -135,6 +135,23 @@ func printToTable(results interface{}, writer io.Writer) {
 						table.TitleStyle("CALL:"),
 						strings.Join(handler.Handlers, ","),
 					)
+				case types.HandlerSlackType:
+					return fmt.Sprintf(
+						"%s %s",
+						table.TitleStyle("POST:"),
+						handler.Slack.Channel,
+					)
+				case types.HandlerPagerDutyType:
+					return fmt.Sprintf(
+						"%s PagerDuty",
+						table.TitleStyle("PAGE:"),
+					)
+				case types.HandlerEmailType:
+					return fmt.Sprintf(
+						"%s %s",
+						table.TitleStyle("MAIL:"),
+						strings.Join(handler.Email.To, ","),
+					)
 				default:
 					return "UNKNOWN"
 				}
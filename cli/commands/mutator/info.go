@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
@@ -64,6 +65,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Timeout",
 				Value: strconv.FormatUint(uint64(mutator.Timeout), 10),
 			},
+			{
+				Label: "Runtime Assets",
+				Value: strings.Join(mutator.RuntimeAssets, ", "),
+			},
 			{
 				Label: "Organization",
 				Value: mutator.Organization,
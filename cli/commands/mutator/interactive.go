@@ -11,12 +11,13 @@ import (
 )
 
 type mutatorOpts struct {
-	Name    string `survey:"name"`
-	Command string `survey:"command"`
-	Timeout string `survey:"timeout"`
-	EnvVars string `survey:"env-vars"`
-	Env     string
-	Org     string
+	Name          string `survey:"name"`
+	Command       string `survey:"command"`
+	Timeout       string `survey:"timeout"`
+	EnvVars       string `survey:"env-vars"`
+	RuntimeAssets string `survey:"runtime-assets"`
+	Env           string
+	Org           string
 }
 
 func newMutatorOpts() *mutatorOpts {
@@ -32,12 +33,14 @@ func (opts *mutatorOpts) withMutator(mutator *types.Mutator) {
 	opts.Command = mutator.Command
 	opts.Timeout = strconv.FormatUint(uint64(mutator.Timeout), 10)
 	opts.EnvVars = strings.Join(mutator.EnvVars, ",")
+	opts.RuntimeAssets = strings.Join(mutator.RuntimeAssets, ",")
 }
 
 func (opts *mutatorOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Command, _ = flags.GetString("command")
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.EnvVars, _ = flags.GetString("env-vars")
+	opts.RuntimeAssets, _ = flags.GetString("runtime-assets")
 
 	if org := helpers.GetChangedStringValueFlag("organization", flags); org != "" {
 		opts.Org = org
@@ -98,6 +101,14 @@ func (opts *mutatorOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.EnvVars,
 			},
 		},
+		{
+			Name: "runtime-assets",
+			Prompt: &survey.Input{
+				Message: "Runtime Assets:",
+				Help:    "A list of comma-separated assets the mutator command depends on.",
+				Default: opts.RuntimeAssets,
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -110,6 +121,7 @@ func (opts *mutatorOpts) Copy(mutator *types.Mutator) {
 
 	mutator.Command = opts.Command
 	mutator.EnvVars = helpers.SafeSplitCSV(opts.EnvVars)
+	mutator.RuntimeAssets = helpers.SafeSplitCSV(opts.RuntimeAssets)
 
 	if len(opts.Timeout) > 0 {
 		t, _ := strconv.ParseUint(opts.Timeout, 10, 32)
@@ -0,0 +1,49 @@
+package namespace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// CreateCommand adds command that allows users to create new namespaces
+func CreateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create [ORGANIZATION/ENVIRONMENT]",
+		Short:        "create new namespace, along with its organization if needed",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			ns := types.Namespace{Name: args[0]}
+			ns.Description, _ = cmd.Flags().GetString("description")
+			if redact, _ := cmd.Flags().GetString("redact"); redact != "" {
+				ns.Redact = helpers.SafeSplitCSV(redact)
+			}
+
+			if err := ns.Validate(); err != nil {
+				cmd.SilenceUsage = false
+				return err
+			}
+
+			if err := cli.Client.CreateNamespace(&ns); err != nil {
+				return err
+			}
+
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Created")
+			return err
+		},
+	}
+
+	_ = cmd.Flags().StringP("description", "", "", "Description of namespace")
+	_ = cmd.Flags().StringP("redact", "", "", "Comma separated list of fields redacted from the events, in addition to those of their entity")
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package namespace
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	testCases := []struct {
+		args           []string
+		storeResponse  error
+		expectedOutput string
+		expectError    bool
+	}{
+		{[]string{}, nil, "Usage", true},
+		{[]string{"production"}, nil, "", true},
+		{[]string{"acme/production"}, fmt.Errorf("error"), "", true},
+		{[]string{"acme/production"}, nil, "Created", false},
+	}
+
+	for i, tc := range testCases {
+		testName := fmt.Sprintf("create namespace, test case #%d", i+1)
+		t.Run(testName, func(t *testing.T) {
+			cli := test.NewMockCLI()
+
+			client := cli.Client.(*client.MockClient)
+			client.On(
+				"CreateNamespace",
+				&types.Namespace{Name: "acme/production", Description: "Production"},
+			).Return(tc.storeResponse)
+
+			cmd := CreateCommand(cli)
+			require.NoError(t, cmd.Flags().Set("description", "Production"))
+			out, err := test.RunCmd(cmd, tc.args)
+
+			assert.Regexp(t, tc.expectedOutput, out)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateCommandRedact(t *testing.T) {
+	cli := test.NewMockCLI()
+
+	client := cli.Client.(*client.MockClient)
+	client.On(
+		"CreateNamespace",
+		&types.Namespace{Name: "acme/production", Redact: []string{"db_password", "token"}},
+	).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("redact", "db_password,token"))
+	out, err := test.RunCmd(cmd, []string{"acme/production"})
+	require.NoError(t, err)
+	assert.Regexp(t, "Created", out)
+}
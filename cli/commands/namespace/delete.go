@@ -0,0 +1,45 @@
+package namespace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCommand adds a command that allows user to delete namespaces
+func DeleteCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "delete [ORGANIZATION/ENVIRONMENT]",
+		Short:        "delete specified namespace, keeping its organization",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// If no name is present print out usage
+			if len(args) != 1 || args[0] == "" {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			name := args[0]
+			if skipConfirm, _ := cmd.Flags().GetBool("skip-confirm"); !skipConfirm {
+				if confirmed := helpers.ConfirmDelete(name); !confirmed {
+					_, err := fmt.Fprintln(cmd.OutOrStdout(), "Canceled")
+					return err
+				}
+			}
+
+			if err := cli.Client.DeleteNamespace(name); err != nil {
+				return err
+			}
+
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Deleted")
+			return err
+		},
+	}
+
+	_ = cmd.Flags().Bool("skip-confirm", false, "skip interactive confirmation prompt")
+
+	return &cmd
+}
@@ -0,0 +1,50 @@
+package namespace
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCommand(t *testing.T) {
+	testCases := []struct {
+		name           string
+		storeResponse  error
+		expectedOutput string
+		expectError    bool
+		skipConfirm    bool
+	}{
+		{"", nil, "Usage", true, true},
+		{"acme/production", fmt.Errorf("error"), "", true, true},
+		{"acme/production", nil, "Deleted", false, true},
+		{"acme/production", nil, "Canceled", false, false},
+	}
+
+	for _, tc := range testCases {
+		testName := fmt.Sprintf("delete the namespace %s", tc.name)
+		t.Run(testName, func(t *testing.T) {
+			cli := test.NewMockCLI()
+
+			client := cli.Client.(*client.MockClient)
+			client.On("DeleteNamespace", tc.name).Return(tc.storeResponse)
+
+			cmd := DeleteCommand(cli)
+			if tc.skipConfirm {
+				require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+			}
+
+			out, err := test.RunCmd(cmd, []string{tc.name})
+
+			assert.Regexp(t, tc.expectedOutput, out)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
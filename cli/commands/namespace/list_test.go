@@ -0,0 +1,55 @@
+package namespace
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCommand(t *testing.T) {
+	type storeResponse struct {
+		namespaces []types.Namespace
+		err        error
+	}
+	testCases := []struct {
+		storeResponse  storeResponse
+		format         string
+		expectedOutput string
+		expectError    bool
+	}{
+		{storeResponse{[]types.Namespace{}, fmt.Errorf("error")}, "", "", true},
+		{storeResponse{
+			[]types.Namespace{*types.FixtureNamespace("one"), *types.FixtureNamespace("two")},
+			nil,
+		}, "none", "default/two", false},
+		{storeResponse{
+			[]types.Namespace{*types.FixtureNamespace("one"), *types.FixtureNamespace("two")},
+			nil,
+		}, "json", `"name": "default/one"`, false},
+	}
+
+	for i, tc := range testCases {
+		testName := fmt.Sprintf("list namespaces, test case #%d", i+1)
+		t.Run(testName, func(t *testing.T) {
+			cli := test.NewMockCLI()
+			cli.Config.(*client.MockConfig).On("Format").Return(tc.format)
+
+			client := cli.Client.(*client.MockClient)
+			client.On("ListNamespaces").Return(tc.storeResponse.namespaces, tc.storeResponse.err)
+
+			cmd := ListCommand(cli)
+			out, err := test.RunCmd(cmd, []string{})
+
+			assert.Regexp(t, tc.expectedOutput, out)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ func Command() *cobra.Command {
 	cmd.PersistentFlags().String("cache-dir", path.UserCacheDir("sensuctl"), "path to directory containing cache & temporary files")
 	cmd.PersistentFlags().String("organization", config.DefaultOrganization, "organization in which we perform actions")
 	cmd.PersistentFlags().String("environment", config.DefaultEnvironment, "environment in which we perform actions")
+	cmd.PersistentFlags().String("namespace", "", "namespace in which we perform actions, as organization/environment, overriding the organization and environment")
 
 	return cmd
 }
@@ -0,0 +1,55 @@
+package secret
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// CreateCommand adds command that allows the user to create new secrets
+func CreateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create [NAME]",
+		Short:        "create new secrets",
+		SilenceUsage: true,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			// Mark flags are required for bash-completions
+			_ = cmd.MarkFlagRequired("id")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			provider, _ := cmd.Flags().GetString("provider")
+			id, _ := cmd.Flags().GetString("id")
+			secret := types.Secret{
+				Name:         args[0],
+				Provider:     provider,
+				ID:           id,
+				Organization: cli.Config.Organization(),
+				Environment:  cli.Config.Environment(),
+			}
+
+			if err := secret.Validate(); err != nil {
+				_ = cmd.Help()
+				return err
+			}
+
+			if err := cli.Client.CreateSecret(&secret); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("provider", "p", types.SecretProviderEnv, "provider of the secret, either env or vault")
+	cmd.Flags().String("id", "", "identifier of the secret in its provider, e.g. an environment variable of the backend or a vault path#key")
+	return cmd
+}
@@ -0,0 +1,70 @@
+package secret
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("create", cmd.Use)
+	assert.Regexp("secrets", cmd.Short)
+}
+
+func TestCreateCommandRunEClosureWithoutID(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"db-password"})
+
+	require.Error(t, err)
+	assert.Regexp("Usage", out) // usage should print out
+}
+
+func TestCreateCommandRunEClosureWithFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateSecret", mock.MatchedBy(func(secret *types.Secret) bool {
+		return secret.Name == "db-password" && secret.Provider == "vault" && secret.ID == "secret/db#password"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("provider", "vault"))
+	require.NoError(t, cmd.Flags().Set("id", "secret/db#password"))
+	out, err := test.RunCmd(cmd, []string{"db-password"})
+
+	assert.Regexp("OK", out)
+	assert.NoError(err)
+}
+
+func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateSecret", mock.AnythingOfType("*types.Secret")).Return(errors.New("whoops"))
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("id", "DB_PASSWORD"))
+	out, err := test.RunCmd(cmd, []string{"db-password"})
+
+	assert.Empty(out)
+	require.Error(t, err)
+	assert.Equal("whoops", err.Error())
+}
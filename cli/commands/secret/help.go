@@ -0,0 +1,23 @@
+package secret
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Manage secrets",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		CreateCommand(cli),
+		DeleteCommand(cli),
+		ListCommand(cli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package secret
+
+import (
+	"errors"
+	"io"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand defines the 'secret list' subcommand
+func ListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "list secrets",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			org := cli.Config.Organization()
+			if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+				org = types.OrganizationTypeAll
+			}
+
+			// Fetch secrets from the API
+			results, err := cli.Client.ListSecrets(org)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			resources := []types.Resource{}
+			for i := range results {
+				resources = append(resources, &results[i])
+			}
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, resources, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				secret, ok := data.(types.Secret)
+				if !ok {
+					return cli.TypeError
+				}
+				return secret.Name
+			},
+		},
+		{
+			Title:       "Provider",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				secret, ok := data.(types.Secret)
+				if !ok {
+					return cli.TypeError
+				}
+				return secret.Provider
+			},
+		},
+		{
+			Title:       "ID",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				secret, ok := data.(types.Secret)
+				if !ok {
+					return cli.TypeError
+				}
+				return secret.ID
+			},
+		},
+	})
+	table.Render(writer, results)
+}
@@ -0,0 +1,98 @@
+package secret
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := ListCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("list", cmd.Use)
+	assert.Regexp("secrets", cmd.Short)
+}
+
+func TestListCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListSecrets", mock.Anything).Return([]types.Secret{
+		*types.FixtureSecret("name-one"),
+		*types.FixtureSecret("name-two"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "json"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotEmpty(out)
+	assert.Contains(out, "name-one")
+	assert.Contains(out, "name-two")
+	assert.Nil(err)
+}
+
+func TestListCommandRunEClosureWithAll(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListSecrets", "*").Return([]types.Secret{
+		*types.FixtureSecret("name-one"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "json"))
+	require.NoError(t, cmd.Flags().Set(flags.AllOrgs, "t"))
+	out, err := test.RunCmd(cmd, []string{})
+	assert.NotEmpty(out)
+	assert.Nil(err)
+}
+
+func TestListCommandRunEClosureWithTable(t *testing.T) {
+	assert := assert.New(t)
+	cli := test.NewCLI()
+
+	secret := types.FixtureSecret("name-one")
+
+	client := cli.Client.(*client.MockClient)
+	client.On("ListSecrets", mock.Anything).Return([]types.Secret{*secret}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "none"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotEmpty(out)
+	assert.Contains(out, "Name")     // heading
+	assert.Contains(out, "Provider") // heading
+	assert.Contains(out, "SENSU_SECRET")
+	assert.Nil(err)
+}
+
+func TestListCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListSecrets", mock.Anything).Return([]types.Secret{}, errors.New("my-err"))
+
+	cmd := ListCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotNil(err)
+	assert.Equal("my-err", err.Error())
+	assert.Empty(out)
+}
@@ -43,8 +43,8 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 				}
 			} else {
 				opts.withFlags(cmd.Flags())
-				if opts.Check == "" && opts.Subscription == "" {
-					return fmt.Errorf("must specify --check or --subscription")
+				if opts.Check == "" && opts.Subscription == "" && opts.LabelSelector == "" {
+					return fmt.Errorf("must specify --check, --subscription or --label-selector")
 				}
 			}
 			var silenced types.Silenced
@@ -68,6 +68,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().StringP("expire", "e", expireDefault, "expiry in seconds")
 	_ = cmd.Flags().StringP("subscription", "s", "", "silence subscription")
 	_ = cmd.Flags().StringP("check", "c", "", "silence check")
+	_ = cmd.Flags().StringP("label-selector", "l", "", "silence events whose entity and check labels match the selector (e.g. team=payments)")
 	_ = cmd.Flags().StringP("begin", "b", beginDefault, "silence begin in human readable time (Format: Jan 02 2006 3:04PM MST)")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
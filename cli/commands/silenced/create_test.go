@@ -7,6 +7,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -57,6 +58,21 @@ func TestCreateCommandRunEClosureWithAllFlags(t *testing.T) {
 	assert.Regexp("OK", out)
 }
 
+func TestCreateCommandRunEClosureWithLabelSelector(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateSilenced", mock.MatchedBy(func(s *types.Silenced) bool {
+		return s.LabelSelector == "team=payments" && s.Subscription == "" && s.Check == ""
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("reason", "maintenance"))
+	require.NoError(t, cmd.Flags().Set("label-selector", "team=payments"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Regexp(t, "OK", out)
+}
+
 func TestCreateCommandRunEClosureWithDeps(t *testing.T) {
 	assert := assert.New(t)
 
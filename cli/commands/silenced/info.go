@@ -90,6 +90,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Subscription",
 				Value: r.Subscription,
 			},
+			{
+				Label: "Label Selector",
+				Value: r.LabelSelector,
+			},
 			{
 				Label: "Organization",
 				Value: r.Organization,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/AlecAivazis/survey"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
@@ -27,6 +28,7 @@ type silencedOpts struct {
 	Env             string
 	Org             string
 	Begin           string `survey:"begin"`
+	LabelSelector   string `survey:"label-selector"`
 }
 
 func newSilencedOpts() *silencedOpts {
@@ -44,6 +46,7 @@ func (o *silencedOpts) Apply(s *types.Silenced) (err error) {
 	s.Environment = o.Env
 	s.Organization = o.Org
 	s.ExpireOnResolve = o.ExpireOnResolve
+	s.LabelSelector = o.LabelSelector
 	s.Expire, err = strconv.ParseInt(o.Expire, 10, 64)
 	if err != nil {
 		return err
@@ -59,6 +62,7 @@ func (o *silencedOpts) withFlags(flags *pflag.FlagSet) {
 	o.Subscription, _ = flags.GetString("subscription")
 	o.Check, _ = flags.GetString("check")
 	o.Begin, _ = flags.GetString("begin")
+	o.LabelSelector, _ = flags.GetString("label-selector")
 
 	if org := helpers.GetChangedStringValueFlag("organization", flags); org != "" {
 		o.Org = org
@@ -94,7 +98,7 @@ func (o *silencedOpts) administerQuestionnaire(editing bool) error {
 				Prompt: &survey.Input{
 					Message: "Subscription:",
 					Default: o.Subscription,
-					Help:    "One of subscription or check is required.",
+					Help:    "One of subscription, check or label selector is required.",
 				},
 			},
 			{
@@ -102,7 +106,15 @@ func (o *silencedOpts) administerQuestionnaire(editing bool) error {
 				Prompt: &survey.Input{
 					Message: "Check:",
 					Default: o.Check,
-					Help:    "One of subscription or check is required.",
+					Help:    "One of subscription, check or label selector is required.",
+				},
+			},
+			{
+				Name: "label-selector",
+				Prompt: &survey.Input{
+					Message: "Label Selector:",
+					Default: o.LabelSelector,
+					Help:    "Only silence the events whose entity and check labels match this selector, e.g. team=payments.",
 				},
 			},
 		}
@@ -188,7 +200,16 @@ func toOpts(s *types.Silenced) *silencedOpts {
 	o.Env = s.Environment
 	o.Org = s.Organization
 	o.ExpireOnResolve = s.ExpireOnResolve
+	o.LabelSelector = s.LabelSelector
+	// The expiration of stored entries is the time left until they are
+	// deleted, including the time until they begin
 	o.Expire = fmt.Sprintf("%d", s.Expire)
-	o.Begin = fmt.Sprintf("%d", s.Begin)
+	if s.Expire > 0 {
+		o.Expire = fmt.Sprintf("%d", int64(expireTime(s.Begin, s.Expire).Seconds()))
+	}
+	o.Begin = beginDefault
+	if s.Begin > 0 {
+		o.Begin = time.Unix(s.Begin, 0).Format(time.RFC3339)
+	}
 	return &o
 }
@@ -3,12 +3,14 @@ package silenced
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateCommand(t *testing.T) {
@@ -61,3 +63,18 @@ func TestUpdateCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestToOptsScheduled(t *testing.T) {
+	// Scheduled entries are stored with the time left until they are deleted
+	begin := time.Now().Add(time.Hour).Truncate(time.Second)
+	silenced := types.FixtureSilenced("foo:bar")
+	silenced.Begin = begin.Unix()
+	silenced.Expire = 3600 + 600
+
+	opts := toOpts(silenced)
+	updated := &types.Silenced{}
+	require.NoError(t, opts.Apply(updated))
+
+	assert.Equal(t, begin.Unix(), updated.Begin)
+	assert.InDelta(t, 600, updated.Expire, 1)
+}
@@ -4,6 +4,7 @@ package command
 import (
 	"bytes"
 	"context"
+	"io"
 	"os/exec"
 	"strings"
 	"sync"
@@ -40,7 +41,12 @@ type Execution struct {
 	// Command is the command to be executed.
 	Command string
 
-	// Env ...
+	// Args are the executable to be executed and its arguments, executed
+	// without a shell instead of Command when given.
+	Args []string
+
+	// Env is the environment of the command, as a list of key=value pairs.
+	// The command inherits the environment of the current process if empty.
 	Env []string
 
 	// Input to provide the command via STDIN.
@@ -53,6 +59,14 @@ type Execution struct {
 	// Combined command execution STDOUT/ERR.
 	Output string
 
+	// MaxOutputSize is the maximum number of bytes of Output, the rest of the
+	// output of the command being discarded. Output is not limited if zero.
+	MaxOutputSize int64
+
+	// OutputWriter, when given, receives the whole combined output of the
+	// command as it is written, e.g. to process it without buffering it.
+	OutputWriter io.Writer
+
 	// Command execution exit status.
 	Status int
 
@@ -90,8 +104,15 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 	ctx, timeout := context.WithCancel(ctx)
 	defer timeout()
 
-	// Taken from Sensu-Spawn (Sensu 1.x.x).
-	cmd = Command(ctx, execution.Command)
+	if len(execution.Args) > 0 {
+		// The executable is looked up in the PATH of the command environment,
+		// which may include the directories of runtime assets.
+		name := lookPath(execution.Args[0], execution.Env)
+		cmd = exec.CommandContext(ctx, name, execution.Args[1:]...)
+	} else {
+		// Taken from Sensu-Spawn (Sensu 1.x.x).
+		cmd = Command(ctx, execution.Command)
+	}
 
 	// Set the ENV for the command if it is set
 	if len(execution.Env) > 0 {
@@ -100,10 +121,14 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 
 	// Share an output buffer between STDOUT/ERR, following the
 	// Nagios plugin spec.
-	var output bytes.Buffer
+	output := &limitedBuffer{max: execution.MaxOutputSize}
 
-	cmd.Stdout = &output
-	cmd.Stderr = &output
+	var w io.Writer = output
+	if execution.OutputWriter != nil {
+		w = io.MultiWriter(output, execution.OutputWriter)
+	}
+	cmd.Stdout = w
+	cmd.Stderr = w
 
 	// If Input is specified, write to STDIN.
 	if execution.Input != "" {
@@ -115,10 +140,23 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 		execution.Duration = time.Since(started).Seconds()
 	}()
 
+	// Run the process in its own group, so that all of its children can be
+	// killed along with it when the timeout has expired.
+	if execution.Timeout != 0 {
+		SetProcessGroup(cmd)
+	}
+
+	if err := cmd.Start(); err != nil {
+		// Something unexpected happended when attepting to
+		// fork/exec, e.g. the executable was not found, return
+		// immediately.
+		execution.Status = FallbackExitStatus
+		return execution, err
+	}
+
 	var timer *time.Timer
 	// Kill process and all of its children when the timeout has expired.
 	if execution.Timeout != 0 {
-		SetProcessGroup(cmd)
 		timer = time.AfterFunc(time.Duration(execution.Timeout)*time.Second, func() {
 			timeout()
 			if err := KillProcess(cmd); err != nil {
@@ -128,12 +166,6 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 		})
 	}
 
-	if err := cmd.Start(); err != nil {
-		// Something unexpected happended when attepting to
-		// fork/exec, return immediately.
-		return execution, err
-	}
-
 	err := cmd.Wait()
 	if timer != nil {
 		timer.Stop()
@@ -166,6 +198,27 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 	return execution, nil
 }
 
+// limitedBuffer is a buffer keeping at most max bytes, or all of them if max
+// is zero, and silently discarding the rest.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int64
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if b.max > 0 {
+		if room := b.max - int64(b.Len()); room < int64(n) {
+			if room <= 0 {
+				return n, nil
+			}
+			p = p[:room]
+		}
+	}
+	_, err := b.Buffer.Write(p)
+	return n, err
+}
+
 func escapeZombie(ex *Execution) {
 	logger := logrus.WithFields(logrus.Fields{"component": "command"})
 	if ex.InProgress != nil && ex.InProgressMu != nil && ex.Name != "" {
@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHelperProcess(t *testing.T) {
@@ -29,7 +32,15 @@ func TestHelperProcess(t *testing.T) {
 		argStr = strings.Join(os.Args[4:], " ")
 	}
 
+	if len(os.Args) > 3 && os.Args[3] == "args" {
+		command = "args"
+	}
+
 	switch command {
+	case "args":
+		for _, arg := range os.Args[4:] {
+			fmt.Fprintf(os.Stdout, "%q\n", arg)
+		}
 	case "cat":
 		fmt.Fprintf(os.Stdout, "%s", stdin)
 	case "echo foo":
@@ -102,3 +113,73 @@ func TestExecuteCommand(t *testing.T) {
 	assert.Equal(t, 2, sleepMultipleExec.Status)
 	assert.NotEqual(t, 0, sleepMultipleExec.Duration)
 }
+
+func TestExecuteCommandArgs(t *testing.T) {
+	// test that the arguments are passed as is, without a shell
+	args := &Execution{
+		Args: []string{os.Args[0], "-test.run=TestHelperProcess", "--", "args", "it's $HOME", "a\nb"},
+		Env:  []string{"GO_WANT_HELPER_PROCESS=1"},
+	}
+
+	argsExec, argsErr := ExecuteCommand(context.Background(), args)
+	assert.NoError(t, argsErr)
+	assert.Equal(t, "\"it's $HOME\"\n\"a\\nb\"\n", argsExec.Output)
+	assert.Equal(t, 0, argsExec.Status)
+
+	// test that input can be passed to the command through stdin
+	cat := &Execution{
+		Args:  []string{os.Args[0], "-test.run=TestHelperProcess", "--", "cat"},
+		Env:   []string{"GO_WANT_HELPER_PROCESS=1"},
+		Input: "bar",
+	}
+
+	catExec, catErr := ExecuteCommand(context.Background(), cat)
+	assert.NoError(t, catErr)
+	assert.Equal(t, "bar", catExec.Output)
+
+	// test that a missing executable fails the execution
+	missing := &Execution{Args: []string{"sensu-missing-executable"}}
+
+	missingExec, missingErr := ExecuteCommand(context.Background(), missing)
+	assert.Error(t, missingErr)
+	assert.Equal(t, FallbackExitStatus, missingExec.Status)
+}
+
+func TestExecuteCommandOutputSize(t *testing.T) {
+	// test that the output is streamed whole, but only kept up to its
+	// maximum size
+	input := strings.Repeat("0123456789", 1000)
+	var streamed strings.Builder
+	cat := &Execution{
+		Args:          []string{os.Args[0], "-test.run=TestHelperProcess", "--", "cat"},
+		Env:           []string{"GO_WANT_HELPER_PROCESS=1"},
+		Input:         input,
+		MaxOutputSize: 15,
+		OutputWriter:  &streamed,
+	}
+
+	catExec, catErr := ExecuteCommand(context.Background(), cat)
+	assert.NoError(t, catErr)
+	assert.Equal(t, "012345678901234", catExec.Output)
+	assert.Equal(t, input, streamed.String())
+	assert.Equal(t, 0, catExec.Status)
+}
+
+func TestLookPath(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable permissions are not supported on windows")
+	}
+
+	dir, err := ioutil.TempDir("", "sensu-command")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sensu-check")
+	require.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"), 0755))
+
+	env := []string{"PATH=/nonexistent", "PATH=/nonexistent" + string(filepath.ListSeparator) + dir}
+	assert.Equal(t, path, lookPath("sensu-check", env))
+	assert.Equal(t, "sensu-other", lookPath("sensu-other", env))
+	assert.Equal(t, "./sensu-check", lookPath("./sensu-check", env))
+	assert.Equal(t, "sensu-check", lookPath("sensu-check", nil))
+}
@@ -2,6 +2,8 @@ package command
 
 import (
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 )
 
@@ -21,3 +23,28 @@ func FakeCommand(command string, args ...string) *Execution {
 
 	return execution
 }
+
+// lookPath returns the path of the executable with the given name, searched
+// in the directories of the PATH of the given environment, if any, rather than
+// the PATH of the current process. The name is returned as is if it is a path
+// or is not found there, leaving the lookup to exec.Command.
+func lookPath(name string, env []string) string {
+	if strings.ContainsAny(name, `/\`) {
+		return name
+	}
+	for i := len(env) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(strings.ToUpper(env[i]), "PATH=") {
+			continue
+		}
+		for _, dir := range filepath.SplitList(env[i][len("PATH="):]) {
+			if dir == "" {
+				continue
+			}
+			if path, err := exec.LookPath(filepath.Join(dir, name)); err == nil {
+				return path
+			}
+		}
+		break
+	}
+	return name
+}
@@ -16,6 +16,9 @@ type ResolveParams = graphql.ResolveParams
 // ResolveInfo is a collection of information about the current execution state
 type ResolveInfo = graphql.ResolveInfo
 
+// FieldResolveFn resolves the value of a field
+type FieldResolveFn = graphql.FieldResolveFn
+
 // FieldHandler given implementation configures field resolver
 type FieldHandler func(impl interface{}) graphql.FieldResolveFn
 
@@ -0,0 +1,288 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Limits restrict the operations executed by the service, protecting it from
+// abusive or accidental deep and expensive queries. A zero value disables the
+// corresponding limit.
+type Limits struct {
+	// MaxDepth is the maximum number of nested selection sets of an operation.
+	MaxDepth int
+
+	// MaxComplexity is the maximum complexity score of an operation. Each field
+	// selected costs one, and the cost of the selections of a field taking a
+	// first, last or limit argument is multiplied by the value of the argument,
+	// or by its default value when it is omitted.
+	MaxComplexity int
+}
+
+// MaxListSize is the largest number of records a field taking a first, last or
+// limit argument is counted as returning when measuring the complexity of an
+// operation. It is also the number counted when the arguments are omitted and
+// have no default value.
+const MaxListSize = 1000
+
+// maxMeasure bounds the depths and complexities measured, so that they never
+// overflow.
+const maxMeasure = math.MaxInt32
+
+// listSizeArgs are the names of the arguments limiting the number of records
+// returned by a field.
+var listSizeArgs = []string{"first", "last", "limit"}
+
+// SetLimits sets the limits of the operations executed by the service.
+func (service *Service) SetLimits(limits Limits) {
+	service.limits = limits
+}
+
+// checkLimits returns an error for each operation of the document exceeding the
+// given limits. The schema provides the default values of the arguments
+// limiting the number of records returned by fields; without it, fields which
+// are not given such an argument are counted as returning one record.
+func checkLimits(schema *graphql.Schema, doc *ast.Document, vars map[string]interface{}, limits Limits) []gqlerrors.FormattedError {
+	if limits.MaxDepth <= 0 && limits.MaxComplexity <= 0 {
+		return nil
+	}
+
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if frag, ok := def.(*ast.FragmentDefinition); ok && frag.Name != nil {
+			fragments[frag.Name.Value] = frag
+		}
+	}
+
+	var errs []gqlerrors.FormattedError
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		m := measurer{schema: schema, fragments: fragments, vars: vars, maxComplexity: limits.MaxComplexity}
+		depth, complexity := m.measure(op.SelectionSet, 0, m.operationType(op))
+		if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+			msg := fmt.Sprintf(
+				"operation has a depth of %d, exceeding the maximum depth of %d",
+				depth, limits.MaxDepth,
+			)
+			errs = append(errs, gqlerrors.FormatError(gqlerrors.NewLocatedError(msg, []ast.Node{op})))
+		}
+		if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+			msg := fmt.Sprintf(
+				"operation has a complexity of %d, exceeding the maximum complexity of %d",
+				complexity, limits.MaxComplexity,
+			)
+			errs = append(errs, gqlerrors.FormatError(gqlerrors.NewLocatedError(msg, []ast.Node{op})))
+		}
+	}
+	return errs
+}
+
+// measurer computes the depth and complexity of selection sets.
+type measurer struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	vars      map[string]interface{}
+
+	// maxComplexity is the complexity past which measuring stops, zero if
+	// there is none.
+	maxComplexity int
+
+	// visiting holds the fragments being measured, guarding against cycles.
+	visiting map[string]bool
+}
+
+// measure returns the depth and complexity of the given selection set of the
+// given type, nil if unknown, nested in the given number of selection sets.
+// Measuring stops early once the complexity exceeds the maximum complexity,
+// since the operation is rejected either way.
+func (m *measurer) measure(set *ast.SelectionSet, depth int, parent graphql.Type) (int, int) {
+	if set == nil {
+		return depth, 0
+	}
+
+	maxDepth, complexity := depth, 0
+	for _, selection := range set.Selections {
+		var d, c int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			def := fieldDefinition(parent, selection)
+			var ttype graphql.Type
+			if def != nil {
+				ttype = def.Type
+			}
+			d, c = m.measure(selection.SelectionSet, depth+1, ttype)
+			c = saturatingAdd(1, saturatingMul(m.listSize(selection, def), c))
+		case *ast.InlineFragment:
+			d, c = m.measure(selection.SelectionSet, depth, m.typeCondition(selection.TypeCondition, parent))
+		case *ast.FragmentSpread:
+			if selection.Name == nil {
+				continue
+			}
+			name := selection.Name.Value
+			frag, ok := m.fragments[name]
+			if !ok || m.visiting[name] {
+				continue
+			}
+			if m.visiting == nil {
+				m.visiting = map[string]bool{}
+			}
+			m.visiting[name] = true
+			d, c = m.measure(frag.SelectionSet, depth, m.typeCondition(frag.TypeCondition, parent))
+			delete(m.visiting, name)
+		}
+		if d > maxDepth {
+			maxDepth = d
+		}
+		complexity = saturatingAdd(complexity, c)
+		if m.maxComplexity > 0 && complexity > m.maxComplexity {
+			break
+		}
+	}
+	return maxDepth, complexity
+}
+
+// listSize returns the number of records requested from the given field of
+// the given definition, nil if unknown, and at most MaxListSize. It is the
+// largest of its first, last or limit arguments given, or when none is, the
+// largest of their default values, or MaxListSize if none has one. Other
+// fields are counted as returning one record.
+func (m *measurer) listSize(field *ast.Field, def *graphql.FieldDefinition) int {
+	size, given := 0, false
+	for _, arg := range field.Arguments {
+		if arg.Name == nil || !isListSizeArg(arg.Name.Value) {
+			continue
+		}
+		given = true
+		if n := m.intValue(arg.Value); n > size {
+			size = n
+		}
+	}
+	if !given && def != nil {
+		defaults := false
+		for _, arg := range def.Args {
+			if !isListSizeArg(arg.Name()) {
+				continue
+			}
+			given = true
+			if n, ok := arg.DefaultValue.(int); ok && (!defaults || n > size) {
+				defaults, size = true, n
+			}
+		}
+		if given && !defaults {
+			size = MaxListSize
+		}
+	}
+	if !given || size < 1 {
+		return 1
+	}
+	if size > MaxListSize {
+		return MaxListSize
+	}
+	return size
+}
+
+// operationType returns the root type of the given operation, nil if unknown.
+func (m *measurer) operationType(op *ast.OperationDefinition) graphql.Type {
+	if m.schema == nil {
+		return nil
+	}
+	var root *graphql.Object
+	switch op.Operation {
+	case ast.OperationTypeQuery:
+		root = m.schema.QueryType()
+	case ast.OperationTypeMutation:
+		root = m.schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = m.schema.SubscriptionType()
+	}
+	if root == nil {
+		return nil
+	}
+	return root
+}
+
+// typeCondition returns the type of the given fragment condition, or the given
+// parent type if there is none.
+func (m *measurer) typeCondition(cond *ast.Named, parent graphql.Type) graphql.Type {
+	if cond == nil || cond.Name == nil {
+		return parent
+	}
+	if m.schema == nil {
+		return nil
+	}
+	return m.schema.Type(cond.Name.Value)
+}
+
+// fieldDefinition returns the definition of the given field of the given type,
+// nil if unknown.
+func fieldDefinition(parent graphql.Type, field *ast.Field) *graphql.FieldDefinition {
+	if parent == nil || field.Name == nil {
+		return nil
+	}
+	var fields graphql.FieldDefinitionMap
+	switch t := graphql.GetNamed(parent).(type) {
+	case *graphql.Object:
+		fields = t.Fields()
+	case *graphql.Interface:
+		fields = t.Fields()
+	}
+	return fields[field.Name.Value]
+}
+
+func (m *measurer) intValue(value ast.Value) int {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		// Out of range values are rounded to the largest int
+		n, _ := strconv.Atoi(value.Value)
+		return n
+	case *ast.Variable:
+		if value.Name == nil {
+			return 0
+		}
+		switch n := m.vars[value.Name.Value].(type) {
+		case int:
+			return n
+		case float64:
+			if n > MaxListSize {
+				return MaxListSize
+			}
+			return int(n)
+		}
+	}
+	return 0
+}
+
+// saturatingAdd returns the sum of the given non-negative numbers, or
+// maxMeasure if it is larger.
+func saturatingAdd(a, b int) int {
+	if a > maxMeasure-b {
+		return maxMeasure
+	}
+	return a + b
+}
+
+// saturatingMul returns the product of the given non-negative numbers, or
+// maxMeasure if it is larger.
+func saturatingMul(a, b int) int {
+	if a != 0 && b > maxMeasure/a {
+		return maxMeasure
+	}
+	return a * b
+}
+
+func isListSizeArg(name string) bool {
+	for _, arg := range listSizeArgs {
+		if arg == name {
+			return true
+		}
+	}
+	return false
+}
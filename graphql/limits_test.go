@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLimits(t *testing.T) {
+	query := `
+		query($limit: Int) {
+			viewer {
+				entities(limit: $limit) {
+					...entity
+				}
+			}
+		}
+
+		fragment entity on Entity {
+			id
+			events(first: 5) {
+				... on Event { id timestamp }
+			}
+		}
+	`
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	require.NoError(t, err)
+	vars := map[string]interface{}{"limit": float64(10)}
+
+	// depth: 4, complexity: 1 + (1 + 10 * (1 + (1 + 5 * 2)))
+	testCases := []struct {
+		name    string
+		limits  Limits
+		wantErr bool
+	}{
+		{"no limits", Limits{}, false},
+		{"within limits", Limits{MaxDepth: 4, MaxComplexity: 122}, false},
+		{"too deep", Limits{MaxDepth: 3}, true},
+		{"too complex", Limits{MaxComplexity: 121}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := checkLimits(nil, doc, vars, tc.limits)
+			if tc.wantErr {
+				require.Len(t, errs, 1)
+				assert.NotEmpty(t, errs[0].Locations)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestCheckLimitsFragmentCycle(t *testing.T) {
+	query := `
+		query { ...a }
+		fragment a on Query { viewer { ...b } }
+		fragment b on Viewer { user { ...a } }
+	`
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	require.NoError(t, err)
+	assert.Empty(t, checkLimits(nil, doc, nil, Limits{MaxDepth: 2, MaxComplexity: 2}))
+	assert.Len(t, checkLimits(nil, doc, nil, Limits{MaxDepth: 1}), 1)
+}
+
+func TestCheckLimitsOverflow(t *testing.T) {
+	query := `
+		query($first: Int) {
+			a(first: 99999999999999999999) {
+				b(last: $first) {
+					c(limit: 9223372036854775807) {
+						d(first: 4294967296) {
+							e(first: 4294967296) {
+								f(first: 4294967296) { id }
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	require.NoError(t, err)
+	vars := map[string]interface{}{"first": float64(1e300)}
+
+	// The complexity saturates instead of wrapping around
+	op := doc.Definitions[0].(*ast.OperationDefinition)
+	m := measurer{vars: vars}
+	_, complexity := m.measure(op.SelectionSet, 0, nil)
+	assert.Equal(t, maxMeasure, complexity)
+
+	errs := checkLimits(nil, doc, vars, Limits{MaxComplexity: 1000})
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "exceeding the maximum complexity")
+}
+
+func TestCheckLimitsDefaultListSize(t *testing.T) {
+	check := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Check",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.ID},
+		},
+	})
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"checks": &graphql.Field{
+				Type: graphql.NewList(check),
+				Args: graphql.FieldConfigArgument{
+					"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10},
+					"first": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+			},
+			"history": &graphql.Field{
+				Type: graphql.NewList(check),
+				Args: graphql.FieldConfigArgument{
+					"first": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+			},
+			"check": &graphql.Field{Type: check},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		query          string
+		wantComplexity int
+	}{
+		// The default limit applies when no size is given
+		{`{ checks { id } }`, 1 + 10},
+		{`{ ... on Query { checks { id } } }`, 1 + 10},
+		{`{ checks(first: 2) { id } }`, 1 + 2},
+		// Sizes without default count as the largest list
+		{`{ history { id } }`, 1 + MaxListSize},
+		{`{ history(first: 5) { id } }`, 1 + 5},
+		// Fields without size arguments return one record
+		{`{ check { id } }`, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tc.query})
+			require.NoError(t, err)
+			limits := Limits{MaxComplexity: tc.wantComplexity}
+			assert.Empty(t, checkLimits(&schema, doc, nil, limits))
+			limits.MaxComplexity--
+			assert.Len(t, checkLimits(&schema, doc, nil, limits), 1)
+		})
+	}
+}
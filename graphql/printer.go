@@ -0,0 +1,238 @@
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// specifiedScalars are the scalars defined by the GraphQL specification, which
+// are not included in printed schemas.
+var specifiedScalars = map[string]bool{
+	"String":  true,
+	"Int":     true,
+	"Float":   true,
+	"Boolean": true,
+	"ID":      true,
+}
+
+// SDL returns the schema of the service in the GraphQL schema definition
+// language.
+func (service *Service) SDL() string {
+	return PrintSchema(service.schema)
+}
+
+// PrintSchema returns the given schema in the GraphQL schema definition
+// language. Types, fields, arguments and enum values are sorted by name so that
+// the output is stable.
+func PrintSchema(schema graphql.Schema) string {
+	var blocks []string
+	blocks = append(blocks, printSchemaDefinition(schema))
+
+	typeMap := schema.TypeMap()
+	names := make([]string, 0, len(typeMap))
+	for name := range typeMap {
+		if strings.HasPrefix(name, "__") || specifiedScalars[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if block := printType(typeMap[name]); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+func printSchemaDefinition(schema graphql.Schema) string {
+	var b strings.Builder
+	b.WriteString("schema {\n")
+	if t := schema.QueryType(); t != nil {
+		fmt.Fprintf(&b, "  query: %s\n", t.Name())
+	}
+	if t := schema.MutationType(); t != nil {
+		fmt.Fprintf(&b, "  mutation: %s\n", t.Name())
+	}
+	if t := schema.SubscriptionType(); t != nil {
+		fmt.Fprintf(&b, "  subscription: %s\n", t.Name())
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func printType(t graphql.Type) string {
+	var b strings.Builder
+	b.WriteString(printDescription(typeDescription(t), ""))
+
+	switch t := t.(type) {
+	case *graphql.Scalar:
+		fmt.Fprintf(&b, "scalar %s", t.Name())
+	case *graphql.Enum:
+		fmt.Fprintf(&b, "enum %s {\n", t.Name())
+		values := t.Values()
+		sort.Slice(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+		for _, value := range values {
+			b.WriteString(printDescription(value.Description, "  "))
+			fmt.Fprintf(&b, "  %s%s\n", value.Name, printDeprecated(value.DeprecationReason))
+		}
+		b.WriteString("}")
+	case *graphql.InputObject:
+		fmt.Fprintf(&b, "input %s {\n", t.Name())
+		fields := t.Fields()
+		for _, name := range sortedKeys(fields) {
+			field := fields[name]
+			b.WriteString(printDescription(field.Description(), "  "))
+			fmt.Fprintf(&b, "  %s: %s%s\n", name, field.Type, printDefault(field.Type, field.DefaultValue))
+		}
+		b.WriteString("}")
+	case *graphql.Interface:
+		fmt.Fprintf(&b, "interface %s {\n", t.Name())
+		b.WriteString(printFields(t.Fields()))
+		b.WriteString("}")
+	case *graphql.Object:
+		fmt.Fprintf(&b, "type %s", t.Name())
+		if ifaces := t.Interfaces(); len(ifaces) > 0 {
+			names := make([]string, len(ifaces))
+			for i, iface := range ifaces {
+				names[i] = iface.Name()
+			}
+			fmt.Fprintf(&b, " implements %s", strings.Join(names, " & "))
+		}
+		b.WriteString(" {\n")
+		b.WriteString(printFields(t.Fields()))
+		b.WriteString("}")
+	case *graphql.Union:
+		names := []string{}
+		for _, member := range t.Types() {
+			if member != nil {
+				names = append(names, member.Name())
+			}
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&b, "union %s = %s", t.Name(), strings.Join(names, " | "))
+	default:
+		return ""
+	}
+	return b.String()
+}
+
+// typeDescription returns the description of the given type; the description of
+// objects is not returned by their Description method.
+func typeDescription(t graphql.Type) string {
+	if obj, ok := t.(*graphql.Object); ok {
+		return obj.PrivateDescription
+	}
+	return t.Description()
+}
+
+func printFields(fields graphql.FieldDefinitionMap) string {
+	var b strings.Builder
+	for _, name := range sortedKeys(fields) {
+		field := fields[name]
+		b.WriteString(printDescription(field.Description, "  "))
+		fmt.Fprintf(&b, "  %s%s: %s%s\n", name, printArgs(field.Args), field.Type, printDeprecated(field.DeprecationReason))
+	}
+	return b.String()
+}
+
+func printArgs(args []*graphql.Argument) string {
+	if len(args) == 0 {
+		return ""
+	}
+	args = append([]*graphql.Argument(nil), args...)
+	sort.Slice(args, func(i, j int) bool { return args[i].Name() < args[j].Name() })
+
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = fmt.Sprintf("%s: %s%s", arg.Name(), arg.Type, printDefault(arg.Type, arg.DefaultValue))
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func printDefault(t graphql.Type, value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return " = " + printValue(t, value)
+}
+
+// printValue returns the given value as a GraphQL literal of the given type.
+func printValue(t graphql.Type, value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+	switch ttype := t.(type) {
+	case *graphql.NonNull:
+		return printValue(ttype.OfType, value)
+	case *graphql.List:
+		val := reflect.ValueOf(value)
+		if val.Kind() != reflect.Slice {
+			return printValue(ttype.OfType, value)
+		}
+		items := make([]string, val.Len())
+		for i := range items {
+			items[i] = printValue(ttype.OfType, val.Index(i).Interface())
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case *graphql.Enum:
+		return fmt.Sprint(value)
+	}
+
+	switch value := value.(type) {
+	case string:
+		return strconv.Quote(value)
+	case map[string]interface{}:
+		fields := make([]string, 0, len(value))
+		for _, name := range sortedKeys(value) {
+			fields = append(fields, fmt.Sprintf("%s: %s", name, printValue(nil, value[name])))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return fmt.Sprint(value)
+}
+
+func printDescription(desc, indent string) string {
+	if desc == "" {
+		return ""
+	}
+	if !strings.ContainsAny(desc, "\n\"\\") {
+		return fmt.Sprintf("%s\"%s\"\n", indent, desc)
+	}
+	lines := strings.Split(strings.Replace(desc, `"""`, `\"""`, -1), "\n")
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\"\"\"\n", indent)
+	for _, line := range lines {
+		if line == "" {
+			b.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(&b, "%s%s\n", indent, line)
+	}
+	fmt.Fprintf(&b, "%s\"\"\"\n", indent)
+	return b.String()
+}
+
+func printDeprecated(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	return fmt.Sprintf(" @deprecated(reason: %s)", strconv.Quote(reason))
+}
+
+// sortedKeys returns the keys of the given map, sorted.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.String()
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,84 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintSchema(t *testing.T) {
+	status := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Status",
+		Values: graphql.EnumValueConfigMap{
+			"OK":      &graphql.EnumValueConfig{Value: 0},
+			"WARNING": &graphql.EnumValueConfig{Value: 1, Description: "Something is off"},
+			"UNKNOWN": &graphql.EnumValueConfig{Value: 3, DeprecationReason: "Use WARNING"},
+		},
+	})
+	node := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	check := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Check",
+		Description: "A \"check\"\nruns a command.",
+		Interfaces:  []*graphql.Interface{node},
+		Fields: graphql.Fields{
+			"id":     &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"status": &graphql.Field{Type: status, Description: "Status of the check"},
+			"output": &graphql.Field{Type: graphql.String, DeprecationReason: "Use events"},
+		},
+	})
+	node.ResolveType = func(p graphql.ResolveTypeParams) *graphql.Object { return check }
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"checks": &graphql.Field{
+				Type: graphql.NewList(check),
+				Args: graphql.FieldConfigArgument{
+					"status": &graphql.ArgumentConfig{Type: status, DefaultValue: "OK"},
+					"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10},
+					"filter": &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "a"},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	require.NoError(t, err)
+
+	want := `schema {
+  query: Query
+}
+
+"""
+A "check"
+runs a command.
+"""
+type Check implements Node {
+  id: ID!
+  output: String @deprecated(reason: "Use events")
+  "Status of the check"
+  status: Status
+}
+
+interface Node {
+  id: ID!
+}
+
+type Query {
+  checks(filter: String = "a", first: Int = 10, status: Status = OK): [Check]
+}
+
+enum Status {
+  OK
+  UNKNOWN @deprecated(reason: "Use WARNING")
+  "Something is off"
+  WARNING
+}
+`
+	assert.Equal(t, want, PrintSchema(schema))
+}
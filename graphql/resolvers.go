@@ -1,8 +1,11 @@
 package graphql
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/language/ast"
@@ -134,6 +137,20 @@ type UnionTypeResolver interface {
 // DefaultResolver uses reflection to attempt to resolve the result of a given
 // field.
 //
+// Fields of structs are matched by name, or by the name given in their json or
+// graphql tags. The graphql tag also accepts options following the name:
+//
+//   // unix converts a unix timestamp into a time.Time
+//   CreatedAt int64 `graphql:"createdAt,unix"`
+//
+//   // omitempty resolves zero values to nil
+//   Description string `graphql:",omitempty"`
+//
+// Fields tagged with `graphql:"-"` are never resolved. Failing a matching field,
+// a method of the struct named after the field, taking no arguments and
+// returning a value and optionally an error, is called; eg. 'IsSilenced' for
+// the field 'isSilenced'.
+//
 // Heavily borrows from: https://github.com/graphql-go/graphql/blob/9b68c99d07d901738c15564ec1a0f57d07d884a7/executor.go#L823-L881
 func DefaultResolver(source interface{}, fieldName string) (interface{}, error) {
 	sourceVal := reflect.ValueOf(source)
@@ -146,35 +163,19 @@ func DefaultResolver(source interface{}, fieldName string) (interface{}, error)
 
 	// Struct
 	if sourceVal.Type().Kind() == reflect.Struct {
-		fieldName = strings.Title(fieldName)
-		for i := 0; i < sourceVal.NumField(); i++ {
-			valueField := sourceVal.Field(i)
-			typeField := sourceVal.Type().Field(i)
-			if typeField.Name == fieldName {
-				// If ptr and value is nil return nil
-				if valueField.Type().Kind() == reflect.Ptr && valueField.IsNil() {
-					return nil, nil
-				}
-				return valueField.Interface(), nil
-			}
-			tag := typeField.Tag
-			checkTag := func(tagName string) bool {
-				t := tag.Get(tagName)
-				tOptions := strings.Split(t, ",")
-				if len(tOptions) == 0 {
-					return false
-				}
-				if tOptions[0] != fieldName {
-					return false
-				}
-				return true
-			}
-			if checkTag("json") || checkTag("graphql") {
-				return valueField.Interface(), nil
-			}
-			continue
+		fields := cachedStructFields(sourceVal.Type())
+		title := strings.Title(fieldName)
+		field, ok := fields.lookup(fieldName, title)
+		if !ok {
+			return resolveMethod(reflect.ValueOf(source), title)
 		}
-		return nil, nil
+
+		valueField := sourceVal.Field(field.index)
+		// If ptr and value is nil return nil
+		if valueField.Type().Kind() == reflect.Ptr && valueField.IsNil() {
+			return nil, nil
+		}
+		return applyTagOptions(valueField, field.opts)
 	}
 
 	// map[string]interface
@@ -195,6 +196,140 @@ func DefaultResolver(source interface{}, fieldName string) (interface{}, error)
 	return nil, nil
 }
 
+// structFields holds the fields of a struct type resolvable by DefaultResolver,
+// indexed by struct field name and by the names given in their tags, so that
+// struct types are walked once rather than on every resolution.
+type structFields struct {
+	byName    map[string]structField
+	byTagName map[string]structField
+}
+
+type structField struct {
+	index int
+	opts  tagOptions
+}
+
+// structFieldsCache holds the structFields of each struct type resolved.
+var structFieldsCache sync.Map // map[reflect.Type]*structFields
+
+func cachedStructFields(t reflect.Type) *structFields {
+	if fields, ok := structFieldsCache.Load(t); ok {
+		return fields.(*structFields)
+	}
+	fields, _ := structFieldsCache.LoadOrStore(t, newStructFields(t))
+	return fields.(*structFields)
+}
+
+func newStructFields(t reflect.Type) *structFields {
+	fields := &structFields{
+		byName:    make(map[string]structField, t.NumField()),
+		byTagName: make(map[string]structField),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		typeField := t.Field(i)
+		name, opts := parseTag(typeField.Tag.Get("graphql"))
+		if name == "-" {
+			continue
+		}
+		field := structField{index: i, opts: opts}
+		fields.byName[typeField.Name] = field
+
+		jsonName, _ := parseTag(typeField.Tag.Get("json"))
+		for _, tagName := range []string{name, jsonName} {
+			if _, ok := fields.byTagName[tagName]; !ok && tagName != "" {
+				fields.byTagName[tagName] = field
+			}
+		}
+	}
+	return fields
+}
+
+// lookup returns the first field either named after the title-cased name of
+// the GraphQL field, or tagged with its name.
+func (f *structFields) lookup(fieldName, title string) (structField, bool) {
+	byName, nameOK := f.byName[title]
+	byTag, tagOK := f.byTagName[fieldName]
+	switch {
+	case nameOK && tagOK && byTag.index < byName.index:
+		return byTag, true
+	case nameOK:
+		return byName, true
+	}
+	return byTag, tagOK
+}
+
+// tagOptions are the options of a struct tag following the name.
+type tagOptions []string
+
+func (o tagOptions) contains(name string) bool {
+	for _, opt := range o {
+		if opt == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTag splits a struct tag into its name and options.
+func parseTag(tag string) (string, tagOptions) {
+	opts := strings.Split(tag, ",")
+	return opts[0], tagOptions(opts[1:])
+}
+
+// applyTagOptions returns the value of the given field, applying the given
+// graphql tag options.
+func applyTagOptions(field reflect.Value, opts tagOptions) (interface{}, error) {
+	if opts.contains("omitempty") && isZero(field) {
+		return nil, nil
+	}
+	if opts.contains("unix") {
+		switch field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return time.Unix(field.Int(), 0), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return time.Unix(int64(field.Uint()), 0), nil
+		}
+		return nil, fmt.Errorf("unix option given to non-integer field of type %s", field.Type())
+	}
+	return field.Interface(), nil
+}
+
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// resolveMethod calls the method of the given name of the source, if it takes
+// no arguments and returns a value, and optionally an error.
+func resolveMethod(source reflect.Value, name string) (interface{}, error) {
+	method := source.MethodByName(name)
+	if !method.IsValid() {
+		return nil, nil
+	}
+
+	methodType := method.Type()
+	if methodType.NumIn() != 0 {
+		return nil, nil
+	}
+	switch methodType.NumOut() {
+	case 1:
+		return method.Call(nil)[0].Interface(), nil
+	case 2:
+		if !methodType.Out(1).Implements(errorType) {
+			return nil, nil
+		}
+		out := method.Call(nil)
+		err, _ := out[1].Interface().(error)
+		return out[0].Interface(), err
+	}
+	return nil, nil
+}
+
 type typeResolver interface {
 	ResolveType(interface{}, ResolveTypeParams) *Type
 }
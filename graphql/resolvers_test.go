@@ -0,0 +1,114 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resolverFixture struct {
+	Name        string
+	Ptr         *string
+	LastSeen    int64    `json:"last_seen"`
+	CreatedAt   int64    `graphql:"createdAt,unix"`
+	Description string   `graphql:",omitempty"`
+	Tags        []string `graphql:"labels,omitempty"`
+	Secret      string   `graphql:"-"`
+	Invalid     string   `graphql:"invalid,unix"`
+}
+
+func (r *resolverFixture) URIPath() string {
+	return "/fixtures/" + r.Name
+}
+
+func (r resolverFixture) Check() (bool, error) {
+	return false, errors.New("check failed")
+}
+
+func (r resolverFixture) Reset() {
+	panic("should not be called")
+}
+
+func TestDefaultResolver(t *testing.T) {
+	source := &resolverFixture{
+		Name:      "fixture",
+		LastSeen:  10,
+		CreatedAt: 1500000000,
+		Secret:    "shh",
+	}
+
+	testCases := []struct {
+		field string
+		want  interface{}
+	}{
+		{"name", "fixture"},
+		{"ptr", nil},
+		{"last_seen", int64(10)},
+		{"lastSeen", int64(10)},
+		{"createdAt", time.Unix(1500000000, 0)},
+		{"description", nil},
+		{"labels", nil},
+		{"secret", nil},
+		{"uriPath", nil},
+		{"URIPath", "/fixtures/fixture"},
+		{"reset", nil},
+		{"unknown", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.field, func(t *testing.T) {
+			res, err := DefaultResolver(source, tc.field)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	source.Description = "desc"
+	res, err := DefaultResolver(source, "description")
+	require.NoError(t, err)
+	assert.Equal(t, "desc", res)
+
+	_, err = DefaultResolver(source, "check")
+	assert.EqualError(t, err, "check failed")
+
+	_, err = DefaultResolver(source, "invalid")
+	assert.Error(t, err)
+
+	// Methods with pointer receivers can't be called on values
+	res, err = DefaultResolver(*source, "URIPath")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestDefaultResolverMap(t *testing.T) {
+	source := map[string]interface{}{
+		"name": "fixture",
+		"fn":   func() interface{} { return 1 },
+	}
+
+	res, err := DefaultResolver(source, "name")
+	require.NoError(t, err)
+	assert.Equal(t, "fixture", res)
+
+	res, err = DefaultResolver(source, "fn")
+	require.NoError(t, err)
+	assert.Equal(t, 1, res)
+}
+
+func BenchmarkDefaultResolver(b *testing.B) {
+	source := &resolverFixture{Name: "fixture", CreatedAt: 1500000000}
+	fields := []string{"name", "lastSeen", "createdAt", "description", "labels", "URIPath"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, field := range fields {
+			if _, err := DefaultResolver(source, field); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
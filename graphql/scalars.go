@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var _ ScalarResolver = JSONScalar{}
+var _ ScalarResolver = DurationScalar{}
+
+//
+// JSONScalar implements ScalarResolver for scalars describing arbitrary JSON
+// compatible data; literals may be of any kind, including objects and lists.
+//
+// == Example input SDL
+//
+//   scalar JSON
+//
+type JSONScalar struct{}
+
+// Serialize returns the given value as is, it is expected to be marshallable.
+func (JSONScalar) Serialize(val interface{}) interface{} {
+	return val
+}
+
+// ParseValue returns the given value as is, having already been unmarshalled.
+func (JSONScalar) ParseValue(val interface{}) interface{} {
+	return val
+}
+
+// ParseLiteral returns the Go representation of the given literal, as it would
+// have been unmarshalled from JSON. Variables nested in the literal are not
+// supported and parsed as null.
+func (s JSONScalar) ParseLiteral(val ast.Value) interface{} {
+	switch val := val.(type) {
+	case *ast.IntValue:
+		return graphql.Int.ParseLiteral(val)
+	case *ast.FloatValue:
+		return graphql.Float.ParseLiteral(val)
+	case *ast.StringValue:
+		return graphql.String.ParseLiteral(val)
+	case *ast.BooleanValue:
+		return graphql.Boolean.ParseLiteral(val)
+	case *ast.EnumValue:
+		return val.Value
+	case *ast.ListValue:
+		list := make([]interface{}, 0, len(val.Values))
+		for _, v := range val.Values {
+			list = append(list, s.ParseLiteral(v))
+		}
+		return list
+	case *ast.ObjectValue:
+		obj := make(map[string]interface{}, len(val.Fields))
+		for _, field := range val.Fields {
+			obj[field.Name.Value] = s.ParseLiteral(field.Value)
+		}
+		return obj
+	}
+	return nil
+}
+
+//
+// DurationScalar implements ScalarResolver for scalars describing durations.
+// Durations are serialized as strings, eg. "1m30s", and may be given either as
+// strings, eg. "90s" or "5m", or as a number of seconds. Inputs are parsed into
+// time.Duration values.
+//
+// == Example input SDL
+//
+//   scalar Duration
+//
+type DurationScalar struct{}
+
+// Serialize returns the string representation of the given duration; integers
+// are interpreted as a number of seconds.
+func (DurationScalar) Serialize(val interface{}) interface{} {
+	switch val := val.(type) {
+	case time.Duration:
+		return val.String()
+	case *time.Duration:
+		if val == nil {
+			return nil
+		}
+		return val.String()
+	case string:
+		if d, err := time.ParseDuration(val); err == nil {
+			return d.String()
+		}
+		return nil
+	}
+	if secs, ok := toSeconds(val); ok {
+		return secs.String()
+	}
+	return nil
+}
+
+// ParseValue parses the given string or number of seconds into a duration.
+func (DurationScalar) ParseValue(val interface{}) interface{} {
+	if str, ok := val.(string); ok {
+		return parseDuration(str)
+	}
+	if secs, ok := toSeconds(val); ok {
+		return secs
+	}
+	return nil
+}
+
+// ParseLiteral parses the given string or number of seconds into a duration.
+func (DurationScalar) ParseLiteral(val ast.Value) interface{} {
+	switch val := val.(type) {
+	case *ast.StringValue:
+		return parseDuration(val.Value)
+	case *ast.IntValue:
+		if n, err := strconv.ParseInt(val.Value, 10, 64); err == nil {
+			return time.Duration(n) * time.Second
+		}
+	case *ast.FloatValue:
+		if f, err := strconv.ParseFloat(val.Value, 64); err == nil {
+			return floatSeconds(f)
+		}
+	}
+	return nil
+}
+
+func parseDuration(str string) interface{} {
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return nil
+	}
+	return d
+}
+
+func toSeconds(val interface{}) (time.Duration, bool) {
+	switch val := val.(type) {
+	case int:
+		return time.Duration(val) * time.Second, true
+	case int32:
+		return time.Duration(val) * time.Second, true
+	case int64:
+		return time.Duration(val) * time.Second, true
+	case uint32:
+		return time.Duration(val) * time.Second, true
+	case float64:
+		return floatSeconds(val), true
+	}
+	return 0, false
+}
+
+func floatSeconds(f float64) time.Duration {
+	return time.Duration(math.Round(f * float64(time.Second)))
+}
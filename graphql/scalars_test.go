@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseLiteral(t *testing.T, literal string) ast.Value {
+	doc, err := parser.Parse(parser.ParseParams{Source: "{ field(arg: " + literal + ") }"})
+	require.NoError(t, err)
+	op := doc.Definitions[0].(*ast.OperationDefinition)
+	return op.SelectionSet.Selections[0].(*ast.Field).Arguments[0].Value
+}
+
+func TestJSONScalarParseLiteral(t *testing.T) {
+	scalar := JSONScalar{}
+	value := scalar.ParseLiteral(parseLiteral(t, `{team: "ops", weight: 1.5, tags: ["a", 2, true], nested: {on: false}}`))
+	assert.Equal(t, map[string]interface{}{
+		"team":   "ops",
+		"weight": 1.5,
+		"tags":   []interface{}{"a", 2, true},
+		"nested": map[string]interface{}{"on": false},
+	}, value)
+}
+
+func TestDurationScalar(t *testing.T) {
+	scalar := DurationScalar{}
+
+	// Literals
+	assert.Equal(t, 90*time.Second, scalar.ParseLiteral(parseLiteral(t, `"90s"`)))
+	assert.Equal(t, 5*time.Minute, scalar.ParseLiteral(parseLiteral(t, `"5m"`)))
+	assert.Equal(t, 30*time.Second, scalar.ParseLiteral(parseLiteral(t, `30`)))
+	assert.Equal(t, 1500*time.Millisecond, scalar.ParseLiteral(parseLiteral(t, `1.5`)))
+	assert.Nil(t, scalar.ParseLiteral(parseLiteral(t, `"soon"`)))
+	assert.Nil(t, scalar.ParseLiteral(parseLiteral(t, `true`)))
+
+	// Values
+	assert.Equal(t, 2*time.Hour, scalar.ParseValue("2h"))
+	assert.Equal(t, 60*time.Second, scalar.ParseValue(float64(60)))
+	assert.Nil(t, scalar.ParseValue("soon"))
+	assert.Nil(t, scalar.ParseValue(false))
+
+	// Serialization
+	assert.Equal(t, "1m30s", scalar.Serialize(90*time.Second))
+	assert.Equal(t, "1m0s", scalar.Serialize(uint32(60)))
+	assert.Equal(t, "5m0s", scalar.Serialize("300s"))
+	assert.Nil(t, scalar.Serialize("soon"))
+}
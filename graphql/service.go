@@ -5,12 +5,17 @@ import (
 	"fmt"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
 )
 
 // Service ...TODO...
 type Service struct {
-	types  *typeRegister
-	schema graphql.Schema
+	types      *typeRegister
+	schema     graphql.Schema
+	limits     Limits
+	middleware []FieldMiddleware
 }
 
 // NewService returns new instance of Service
@@ -69,7 +74,7 @@ func (service *Service) RegisterObject(t ObjectDesc, impl interface{}) {
 	registrar := func(m graphql.TypeMap) graphql.Type {
 		fields := cfg.Fields.(graphql.Fields)
 		for fieldName, handler := range t.FieldHandlers {
-			fields[fieldName].Resolve = handler(impl)
+			fields[fieldName].Resolve = service.wrapResolver(cfg.Name, fieldName, handler(impl))
 		}
 
 		cfg.IsTypeOf = nil
@@ -84,11 +89,29 @@ func (service *Service) RegisterObject(t ObjectDesc, impl interface{}) {
 	service.types.addType(cfg.Name, ObjectKind, registrar)
 }
 
+// FieldMiddleware wraps the resolver of the given field of the given object
+// type, e.g. to authorize access to the field.
+type FieldMiddleware func(typeName, fieldName string, next graphql.FieldResolveFn) graphql.FieldResolveFn
+
+// UseMiddleware adds a middleware wrapping the resolvers of the fields of every
+// object type. Middleware is applied when the schema is regenerated, in the
+// order it was added, the first one being the outermost.
+func (service *Service) UseMiddleware(m FieldMiddleware) {
+	service.middleware = append(service.middleware, m)
+}
+
+func (service *Service) wrapResolver(typeName, fieldName string, fn graphql.FieldResolveFn) graphql.FieldResolveFn {
+	for i := len(service.middleware) - 1; i >= 0; i-- {
+		fn = service.middleware[i](typeName, fieldName, fn)
+	}
+	return traceResolver(typeName, fieldName, fn)
+}
+
 // RegisterUnion registers a GraphQL type with the service.
 func (service *Service) RegisterUnion(t UnionDesc, impl UnionTypeResolver) {
 	cfg := t.Config()
 	registrar := func(m graphql.TypeMap) graphql.Type {
-		newTypes := make([]*graphql.Object, len(cfg.Types))
+		newTypes := make([]*graphql.Object, 0, len(cfg.Types))
 		for _, t := range cfg.Types {
 			objType := m[t.PrivateName].(*graphql.Object)
 			newTypes = append(newTypes, objType)
@@ -124,13 +147,53 @@ func (service *Service) Do(
 	q string,
 	vars map[string]interface{},
 ) *graphql.Result {
-	params := graphql.Params{
-		Schema:         service.schema,
-		VariableValues: vars,
-		Context:        ctx,
-		RequestString:  q,
+	return service.DoWithRoot(ctx, q, vars, nil)
+}
+
+// DoWithRoot executes request given query string and the root object given to
+// the resolvers of the fields of the operation's root type.
+func (service *Service) DoWithRoot(
+	ctx context.Context,
+	q string,
+	vars map[string]interface{},
+	root map[string]interface{},
+) *graphql.Result {
+	tracer := tracerFromContext(ctx)
+	if tracer != nil {
+		defer tracer.Finish()
 	}
-	return graphql.Do(params)
+
+	src := source.NewSource(&source.Source{
+		Body: []byte(q),
+		Name: "GraphQL request",
+	})
+	finishParsing := tracer.startParsing()
+	doc, err := parser.Parse(parser.ParseParams{Source: src})
+	finishParsing()
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	finishValidation := tracer.startValidation()
+	validationResult := graphql.ValidateDocument(&service.schema, doc, nil)
+	finishValidation()
+	if !validationResult.IsValid {
+		return &graphql.Result{Errors: validationResult.Errors}
+	}
+
+	// Reject operations exceeding the limits of the service before executing
+	// any of their resolvers.
+	if errs := checkLimits(&service.schema, doc, vars, service.limits); len(errs) > 0 {
+		return &graphql.Result{Errors: errs}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:  service.schema,
+		Root:    root,
+		AST:     doc,
+		Args:    vars,
+		Context: ctx,
+	})
 }
 
 type typeRegister struct {
@@ -0,0 +1,169 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+)
+
+type tracerKey struct{}
+
+// Tracer records the timings of the phases of an operation and of the field
+// resolvers it executes, see https://github.com/apollographql/apollo-tracing.
+// Operations are traced when executed with a context carrying a tracer.
+type Tracer struct {
+	start      time.Time
+	end        time.Time
+	parsing    tracePhase
+	validation tracePhase
+
+	mu        sync.Mutex
+	resolvers []resolverTrace
+}
+
+type tracePhase struct {
+	start    time.Time
+	duration time.Duration
+}
+
+type resolverTrace struct {
+	path       []interface{}
+	parentType string
+	fieldName  string
+	returnType string
+	start      time.Time
+	duration   time.Duration
+}
+
+// NewTracer returns a new tracer, the operation is considered started.
+func NewTracer() *Tracer {
+	return &Tracer{start: time.Now()}
+}
+
+// ContextWithTracer returns a copy of the given context, operations executed
+// with it are traced by the given tracer.
+func ContextWithTracer(ctx context.Context, tracer *Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, tracer)
+}
+
+func tracerFromContext(ctx context.Context) *Tracer {
+	if ctx == nil {
+		return nil
+	}
+	tracer, _ := ctx.Value(tracerKey{}).(*Tracer)
+	return tracer
+}
+
+// begin records the start of the phase and returns a func recording its
+// duration when called.
+func (p *tracePhase) begin() func() {
+	p.start = time.Now()
+	return func() { p.duration = time.Since(p.start) }
+}
+
+// startParsing records the start of the parsing phase, the returned func
+// records its end. Tracers may be nil.
+func (t *Tracer) startParsing() func() {
+	if t == nil {
+		return func() {}
+	}
+	return t.parsing.begin()
+}
+
+// startValidation records the start of the validation phase, the returned
+// func records its end. Tracers may be nil.
+func (t *Tracer) startValidation() func() {
+	if t == nil {
+		return func() {}
+	}
+	return t.validation.begin()
+}
+
+func (t *Tracer) addResolver(trace resolverTrace) {
+	t.mu.Lock()
+	t.resolvers = append(t.resolvers, trace)
+	t.mu.Unlock()
+}
+
+// Finish records the end of the operation.
+func (t *Tracer) Finish() {
+	t.end = time.Now()
+}
+
+// Extension returns the trace in the format of the tracing extension of
+// responses. The executor does not expose the path of resolved fields; paths
+// only contain the response name of the field.
+func (t *Tracer) Extension() map[string]interface{} {
+	end := t.end
+	if end.IsZero() {
+		end = time.Now()
+	}
+
+	t.mu.Lock()
+	resolvers := make([]map[string]interface{}, len(t.resolvers))
+	for i, r := range t.resolvers {
+		resolvers[i] = map[string]interface{}{
+			"path":        r.path,
+			"parentType":  r.parentType,
+			"fieldName":   r.fieldName,
+			"returnType":  r.returnType,
+			"startOffset": r.start.Sub(t.start).Nanoseconds(),
+			"duration":    r.duration.Nanoseconds(),
+		}
+	}
+	t.mu.Unlock()
+
+	return map[string]interface{}{
+		"version":    1,
+		"startTime":  t.start.UTC().Format(time.RFC3339Nano),
+		"endTime":    end.UTC().Format(time.RFC3339Nano),
+		"duration":   end.Sub(t.start).Nanoseconds(),
+		"parsing":    t.phaseExtension(t.parsing),
+		"validation": t.phaseExtension(t.validation),
+		"execution": map[string]interface{}{
+			"resolvers": resolvers,
+		},
+	}
+}
+
+func (t *Tracer) phaseExtension(phase tracePhase) map[string]interface{} {
+	var offset int64
+	if !phase.start.IsZero() {
+		offset = phase.start.Sub(t.start).Nanoseconds()
+	}
+	return map[string]interface{}{
+		"startOffset": offset,
+		"duration":    phase.duration.Nanoseconds(),
+	}
+}
+
+// traceResolver wraps the given resolver, recording its timing when the
+// operation is traced.
+func traceResolver(typeName, fieldName string, next graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		tracer := tracerFromContext(p.Context)
+		if tracer == nil {
+			return next(p)
+		}
+
+		trace := resolverTrace{
+			path:       []interface{}{fieldName},
+			parentType: typeName,
+			fieldName:  fieldName,
+			start:      time.Now(),
+		}
+		if len(p.Info.FieldASTs) > 0 && p.Info.FieldASTs[0].Alias != nil {
+			trace.path = []interface{}{p.Info.FieldASTs[0].Alias.Value}
+		}
+		if p.Info.ReturnType != nil {
+			trace.returnType = p.Info.ReturnType.String()
+		}
+
+		res, err := next(p)
+		trace.duration = time.Since(trace.start)
+		tracer.addResolver(trace)
+		return res, err
+	}
+}
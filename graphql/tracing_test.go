@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceResolver(t *testing.T) {
+	resolve := func(p graphql.ResolveParams) (interface{}, error) {
+		return "check-cpu", nil
+	}
+	fn := traceResolver("Check", "name", resolve)
+	info := graphql.ResolveInfo{
+		FieldASTs:  []*ast.Field{{Alias: &ast.Name{Value: "checkName"}}},
+		ReturnType: graphql.NewNonNull(graphql.String),
+	}
+
+	// Not traced
+	res, err := fn(graphql.ResolveParams{Context: context.Background(), Info: info})
+	require.NoError(t, err)
+	assert.Equal(t, "check-cpu", res)
+
+	// Traced
+	tracer := NewTracer()
+	ctx := ContextWithTracer(context.Background(), tracer)
+	res, err = fn(graphql.ResolveParams{Context: ctx, Info: info})
+	require.NoError(t, err)
+	assert.Equal(t, "check-cpu", res)
+	tracer.Finish()
+
+	ext := tracer.Extension()
+	assert.Equal(t, 1, ext["version"])
+	assert.NotEmpty(t, ext["startTime"])
+	assert.NotEmpty(t, ext["endTime"])
+
+	resolvers := ext["execution"].(map[string]interface{})["resolvers"].([]map[string]interface{})
+	require.Len(t, resolvers, 1)
+	assert.Equal(t, []interface{}{"checkName"}, resolvers[0]["path"])
+	assert.Equal(t, "Check", resolvers[0]["parentType"])
+	assert.Equal(t, "name", resolvers[0]["fieldName"])
+	assert.Equal(t, "String!", resolvers[0]["returnType"])
+}
+
+func TestTracerNil(t *testing.T) {
+	var tracer *Tracer
+	tracer.startParsing()()
+	tracer.startValidation()()
+	assert.Nil(t, tracerFromContext(context.Background()))
+}
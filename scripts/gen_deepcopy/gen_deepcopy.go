@@ -0,0 +1,296 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+var (
+	output = flag.String("o", "", "Path to output file")
+	extra  = flag.String("t", "", "Comma-separated types, besides the protobuf messages, to generate DeepCopy and Equal for")
+)
+
+// structType is a struct type of the package to generate methods for
+type structType struct {
+	name   string
+	fields []field
+	equal  bool
+}
+
+// customTypes are the types of the package, such as the protobuf custom types,
+// which are not generated but have their own DeepCopy and Equal methods.
+var customTypes = map[string]bool{}
+
+// field is a field of a struct type, named after its type if embedded
+type field struct {
+	name string
+	typ  ast.Expr
+}
+
+func main() {
+	flag.Parse()
+	if *output == "" {
+		log.Fatal("fatal error: missing output file")
+	}
+
+	pkg, structs, err := discoverStructs(".")
+	if err != nil {
+		log.Fatalf("fatal error discovering types: %s", err)
+	}
+
+	var names []string
+	for name := range structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "package %s\n\n// automatically generated file, do not edit!\n", pkg)
+	for _, name := range names {
+		s := structs[name]
+		if err := writeDeepCopy(buf, s, structs); err != nil {
+			log.Fatalf("fatal error generating %s.DeepCopy: %s", s.name, err)
+		}
+		if !s.equal {
+			continue
+		}
+		if err := writeEqual(buf, s, structs); err != nil {
+			log.Fatalf("fatal error generating %s.Equal: %s", s.name, err)
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("fatal error formatting %s: %s", *output, err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatalf("fatal error writing %s: %s", *output, err)
+	}
+}
+
+// discoverStructs returns the name of the package in the given directory and
+// the struct types to generate methods for, keyed by name: the protobuf
+// messages, declared in the .pb.go files, which already have an Equal method,
+// and the extra types.
+func discoverStructs(dir string) (string, map[string]*structType, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != *output
+	}, 0)
+	if err != nil {
+		return "", nil, err
+	}
+
+	extras := map[string]bool{}
+	for _, name := range strings.Split(*extra, ",") {
+		if name != "" {
+			extras[name] = true
+		}
+	}
+
+	var pkgName string
+	structs := map[string]*structType{}
+	for _, pkg := range pkgs {
+		pkgName = pkg.Name
+		for filename, file := range pkg.Files {
+			isProto := strings.HasSuffix(filename, ".pb.go")
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "DeepCopy" && fn.Recv != nil {
+					customTypes[typeName(fn.Recv.List[0].Type)] = true
+					continue
+				}
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || !ts.Name.IsExported() {
+						continue
+					}
+					s := &structType{name: ts.Name.Name, fields: structFields(st)}
+					if isProto {
+						structs[s.name] = s
+					} else if extras[s.name] {
+						s.equal = true
+						structs[s.name] = s
+						delete(extras, s.name)
+					}
+				}
+			}
+		}
+	}
+
+	for name := range extras {
+		return "", nil, fmt.Errorf("type %s not found", name)
+	}
+	return pkgName, structs, nil
+}
+
+func structFields(st *ast.StructType) []field {
+	var fields []field
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			fields = append(fields, field{name: typeName(f.Type), typ: f.Type})
+			continue
+		}
+		for _, name := range f.Names {
+			fields = append(fields, field{name: name.Name, typ: f.Type})
+		}
+	}
+	return fields
+}
+
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return ""
+}
+
+// isStruct returns whether the given type is a struct type of the package
+func isStruct(expr ast.Expr, structs map[string]*structType) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = structs[ident.Name]
+	return ok
+}
+
+// isBasic returns whether the given type is copied and compared by value
+func isBasic(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	switch ident.Name {
+	case "bool", "string", "byte", "int", "int32", "int64", "uint", "uint32", "uint64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+func writeDeepCopy(buf *bytes.Buffer, s *structType, structs map[string]*structType) error {
+	fmt.Fprintf(buf, "\n// DeepCopy returns a deep copy of the %s, sharing no memory with it.\n", s.name)
+	fmt.Fprintf(buf, "func (in *%s) DeepCopy() *%s {\n", s.name, s.name)
+	fmt.Fprintf(buf, "if in == nil {\nreturn nil\n}\nout := new(%s)\n*out = *in\n", s.name)
+
+	for _, f := range s.fields {
+		switch t := f.typ.(type) {
+		case *ast.Ident:
+			if isStruct(t, structs) {
+				fmt.Fprintf(buf, "out.%s = *in.%s.DeepCopy()\n", f.name, f.name)
+			} else if customTypes[t.Name] {
+				fmt.Fprintf(buf, "out.%s = in.%s.DeepCopy()\n", f.name, f.name)
+			} else if !isBasic(t) {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+		case *ast.StarExpr:
+			if !isStruct(t.X, structs) {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+			fmt.Fprintf(buf, "out.%s = in.%s.DeepCopy()\n", f.name, f.name)
+		case *ast.ArrayType:
+			elem := exprString(t.Elt)
+			fmt.Fprintf(buf, "if in.%s != nil {\nout.%s = make([]%s, len(in.%s))\n", f.name, f.name, elem, f.name)
+			if isBasic(t.Elt) {
+				fmt.Fprintf(buf, "copy(out.%s, in.%s)\n", f.name, f.name)
+			} else if star, ok := t.Elt.(*ast.StarExpr); ok && isStruct(star.X, structs) {
+				fmt.Fprintf(buf, "for i := range in.%s {\nout.%s[i] = in.%s[i].DeepCopy()\n}\n", f.name, f.name, f.name)
+			} else if isStruct(t.Elt, structs) {
+				fmt.Fprintf(buf, "for i := range in.%s {\nout.%s[i] = *in.%s[i].DeepCopy()\n}\n", f.name, f.name, f.name)
+			} else {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+			fmt.Fprintf(buf, "}\n")
+		case *ast.MapType:
+			if !isBasic(t.Key) || !isBasic(t.Value) {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+			fmt.Fprintf(buf, "if in.%s != nil {\nout.%s = make(%s, len(in.%s))\n", f.name, f.name, exprString(t), f.name)
+			fmt.Fprintf(buf, "for k, v := range in.%s {\nout.%s[k] = v\n}\n}\n", f.name, f.name)
+		default:
+			return fmt.Errorf("unsupported field %s", f.name)
+		}
+	}
+
+	fmt.Fprintf(buf, "return out\n}\n")
+	return nil
+}
+
+func writeEqual(buf *bytes.Buffer, s *structType, structs map[string]*structType) error {
+	fmt.Fprintf(buf, "\n// Equal returns whether the given value is a %s equal to this one.\n", s.name)
+	fmt.Fprintf(buf, "func (this *%s) Equal(that interface{}) bool {\n", s.name)
+	fmt.Fprintf(buf, "if that == nil {\nreturn this == nil\n}\n\n")
+	fmt.Fprintf(buf, "that1, ok := that.(*%s)\nif !ok {\nthat2, ok := that.(%s)\nif ok {\nthat1 = &that2\n} else {\nreturn false\n}\n}\n", s.name, s.name)
+	fmt.Fprintf(buf, "if that1 == nil {\nreturn this == nil\n} else if this == nil {\nreturn false\n}\n")
+
+	for _, f := range s.fields {
+		switch t := f.typ.(type) {
+		case *ast.Ident:
+			if isStruct(t, structs) {
+				fmt.Fprintf(buf, "if !this.%s.Equal(&that1.%s) {\nreturn false\n}\n", f.name, f.name)
+			} else if customTypes[t.Name] {
+				fmt.Fprintf(buf, "if !this.%s.Equal(that1.%s) {\nreturn false\n}\n", f.name, f.name)
+			} else if isBasic(t) {
+				fmt.Fprintf(buf, "if this.%s != that1.%s {\nreturn false\n}\n", f.name, f.name)
+			} else {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+		case *ast.StarExpr:
+			if !isStruct(t.X, structs) {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+			fmt.Fprintf(buf, "if !this.%s.Equal(that1.%s) {\nreturn false\n}\n", f.name, f.name)
+		case *ast.ArrayType:
+			fmt.Fprintf(buf, "if len(this.%s) != len(that1.%s) {\nreturn false\n}\n", f.name, f.name)
+			fmt.Fprintf(buf, "for i := range this.%s {\n", f.name)
+			if isBasic(t.Elt) {
+				fmt.Fprintf(buf, "if this.%s[i] != that1.%s[i] {\nreturn false\n}\n", f.name, f.name)
+			} else if star, ok := t.Elt.(*ast.StarExpr); ok && isStruct(star.X, structs) {
+				fmt.Fprintf(buf, "if !this.%s[i].Equal(that1.%s[i]) {\nreturn false\n}\n", f.name, f.name)
+			} else if isStruct(t.Elt, structs) {
+				fmt.Fprintf(buf, "if !this.%s[i].Equal(&that1.%s[i]) {\nreturn false\n}\n", f.name, f.name)
+			} else {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+			fmt.Fprintf(buf, "}\n")
+		case *ast.MapType:
+			if !isBasic(t.Key) || !isBasic(t.Value) {
+				return fmt.Errorf("unsupported field %s", f.name)
+			}
+			fmt.Fprintf(buf, "if len(this.%s) != len(that1.%s) {\nreturn false\n}\n", f.name, f.name)
+			fmt.Fprintf(buf, "for k, v := range this.%s {\nif v2, ok := that1.%s[k]; !ok || v != v2 {\nreturn false\n}\n}\n", f.name, f.name)
+		default:
+			return fmt.Errorf("unsupported field %s", f.name)
+		}
+	}
+
+	fmt.Fprintf(buf, "return true\n}\n")
+	return nil
+}
+
+func exprString(expr ast.Expr) string {
+	buf := new(bytes.Buffer)
+	if err := format.Node(buf, token.NewFileSet(), expr); err != nil {
+		log.Fatalf("fatal error formatting %T: %s", expr, err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,224 @@
+package system
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// The cloud providers whose instance metadata is discovered
+const (
+	CloudProviderEC2   = "ec2"
+	CloudProviderGCE   = "gce"
+	CloudProviderAzure = "azure"
+)
+
+// CloudDiscoveryTimeout is the maximum time spent discovering the metadata of
+// the cloud instance, which is mostly spent waiting for the metadata services
+// when not running on a cloud instance.
+const CloudDiscoveryTimeout = 2 * time.Second
+
+// The metadata service endpoints of the cloud providers
+var (
+	ec2MetadataURL   = "http://169.254.169.254/latest"
+	gceMetadataURL   = "http://169.254.169.254/computeMetadata/v1"
+	azureMetadataURL = "http://169.254.169.254/metadata"
+)
+
+// errNotFound is returned when a metadata service does not have the requested
+// metadata.
+var errNotFound = errors.New("metadata not found")
+
+// cloudDiscoverer discovers the metadata of the cloud instance from the
+// metadata service of a cloud provider.
+type cloudDiscoverer func(client *http.Client) (*types.Cloud, error)
+
+// CloudInfo discovers the metadata of the cloud instance the current process
+// is running on, from the metadata services of EC2, GCE and Azure. An error is
+// returned if it is not running on a cloud instance of these providers.
+func CloudInfo() (*types.Cloud, error) {
+	client := &http.Client{Timeout: CloudDiscoveryTimeout}
+	discoverers := []cloudDiscoverer{ec2Info, gceInfo, azureInfo}
+
+	results := make(chan *types.Cloud, len(discoverers))
+	for _, discover := range discoverers {
+		go func(discover cloudDiscoverer) {
+			cloud, err := discover(client)
+			if err != nil {
+				results <- nil
+				return
+			}
+			results <- cloud
+		}(discover)
+	}
+
+	for range discoverers {
+		if cloud := <-results; cloud != nil {
+			return cloud, nil
+		}
+	}
+	return nil, errors.New("not running on a cloud instance")
+}
+
+// ec2Info discovers the metadata of an EC2 instance. Its tags are only
+// available if their access is allowed in the instance metadata options.
+func ec2Info(client *http.Client) (*types.Cloud, error) {
+	header := http.Header{}
+
+	// Use a session token (IMDSv2) if the metadata service supports it
+	req, err := http.NewRequest(http.MethodPut, ec2MetadataURL+"/api/token", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", "60")
+	if token, err := doMetadataRequest(client, req); err == nil {
+		header.Set("X-aws-ec2-metadata-token", string(token))
+	}
+
+	get := func(p string) (string, error) {
+		body, err := getMetadata(client, ec2MetadataURL+"/meta-data/"+p, header)
+		return string(body), err
+	}
+
+	cloud := &types.Cloud{Provider: CloudProviderEC2}
+	if cloud.InstanceID, err = get("instance-id"); err != nil {
+		return nil, err
+	}
+	if cloud.InstanceType, err = get("instance-type"); err != nil {
+		return nil, err
+	}
+	if cloud.AvailabilityZone, err = get("placement/availability-zone"); err != nil {
+		return nil, err
+	}
+	if cloud.Region, err = get("placement/region"); err != nil {
+		// The region is the availability zone without its letter
+		cloud.Region = strings.TrimRight(cloud.AvailabilityZone, "abcdefghijklmnopqrstuvwxyz")
+	}
+
+	if keys, err := get("tags/instance"); err == nil {
+		cloud.Tags = make(map[string]string)
+		for _, key := range strings.Fields(keys) {
+			if cloud.Tags[key], err = get("tags/instance/" + key); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return cloud, nil
+}
+
+// gceInfo discovers the metadata of a GCE instance. Its labels are not
+// available from the metadata service, so it has no tags.
+func gceInfo(client *http.Client) (*types.Cloud, error) {
+	header := http.Header{}
+	header.Set("Metadata-Flavor", "Google")
+
+	body, err := getMetadata(client, gceMetadataURL+"/instance/?recursive=true", header)
+	if err != nil {
+		return nil, err
+	}
+
+	var instance struct {
+		ID          json.Number `json:"id"`
+		MachineType string      `json:"machineType"`
+		Zone        string      `json:"zone"`
+	}
+	if err := json.Unmarshal(body, &instance); err != nil {
+		return nil, err
+	}
+
+	// The machine type and the zone are given as projects/<project>/zones/<zone>
+	zone := path.Base(instance.Zone)
+	cloud := &types.Cloud{
+		Provider:         CloudProviderGCE,
+		InstanceID:       instance.ID.String(),
+		InstanceType:     path.Base(instance.MachineType),
+		AvailabilityZone: zone,
+	}
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		cloud.Region = zone[:i]
+	}
+
+	return cloud, nil
+}
+
+// azureInfo discovers the metadata of an Azure virtual machine.
+func azureInfo(client *http.Client) (*types.Cloud, error) {
+	header := http.Header{}
+	header.Set("Metadata", "true")
+
+	body, err := getMetadata(client, azureMetadataURL+"/instance/compute?api-version=2019-06-01", header)
+	if err != nil {
+		return nil, err
+	}
+
+	var compute struct {
+		VMID     string `json:"vmId"`
+		VMSize   string `json:"vmSize"`
+		Location string `json:"location"`
+		Zone     string `json:"zone"`
+		TagsList []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"tagsList"`
+	}
+	if err := json.Unmarshal(body, &compute); err != nil {
+		return nil, err
+	}
+	if compute.VMID == "" {
+		return nil, errNotFound
+	}
+
+	cloud := &types.Cloud{
+		Provider:         CloudProviderAzure,
+		InstanceID:       compute.VMID,
+		InstanceType:     compute.VMSize,
+		Region:           compute.Location,
+		AvailabilityZone: compute.Zone,
+	}
+	if len(compute.TagsList) > 0 {
+		cloud.Tags = make(map[string]string, len(compute.TagsList))
+		for _, tag := range compute.TagsList {
+			cloud.Tags[tag.Name] = tag.Value
+		}
+	}
+
+	return cloud, nil
+}
+
+// getMetadata returns the metadata at the given URL of a metadata service.
+func getMetadata(client *http.Client, url string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key := range header {
+		req.Header.Set(key, header.Get(key))
+	}
+	return doMetadataRequest(client, req)
+}
+
+// doMetadataRequest sends the given request to a metadata service and returns
+// the body of its response.
+func doMetadataRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata service returned status %d", resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
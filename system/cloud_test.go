@@ -0,0 +1,164 @@
+package system
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// metadataService serves the given metadata, by path, if the request has the
+// given header.
+func metadataService(header, value string, metadata map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(header) != value {
+			http.Error(w, "missing header", http.StatusBadRequest)
+			return
+		}
+		body, ok := metadata[r.URL.RequestURI()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+// useMetadataServices sets the metadata service endpoints of the cloud
+// providers, which default to a service without metadata, and returns a
+// function restoring them.
+func useMetadataServices(urls map[string]string) func() {
+	empty := httptest.NewServer(http.NotFoundHandler())
+	ec2, gce, azure := ec2MetadataURL, gceMetadataURL, azureMetadataURL
+	ec2MetadataURL, gceMetadataURL, azureMetadataURL = empty.URL, empty.URL, empty.URL
+
+	if url, ok := urls[CloudProviderEC2]; ok {
+		ec2MetadataURL = url
+	}
+	if url, ok := urls[CloudProviderGCE]; ok {
+		gceMetadataURL = url
+	}
+	if url, ok := urls[CloudProviderAzure]; ok {
+		azureMetadataURL = url
+	}
+
+	return func() {
+		empty.Close()
+		ec2MetadataURL, gceMetadataURL, azureMetadataURL = ec2, gce, azure
+	}
+}
+
+func TestCloudInfoEC2(t *testing.T) {
+	server := metadataService("X-aws-ec2-metadata-token", "", map[string]string{
+		"/meta-data/instance-id":                 "i-0123456789",
+		"/meta-data/instance-type":               "t2.micro",
+		"/meta-data/placement/availability-zone": "us-east-1a",
+		"/meta-data/tags/instance":               "Name\nteam",
+		"/meta-data/tags/instance/Name":          "web-1",
+		"/meta-data/tags/instance/team":          "ops",
+	})
+	defer server.Close()
+	defer useMetadataServices(map[string]string{CloudProviderEC2: server.URL})()
+
+	cloud, err := CloudInfo()
+	require.NoError(t, err)
+	assert.Equal(t, &types.Cloud{
+		Provider:         CloudProviderEC2,
+		InstanceID:       "i-0123456789",
+		InstanceType:     "t2.micro",
+		Region:           "us-east-1",
+		AvailabilityZone: "us-east-1a",
+		Tags:             map[string]string{"Name": "web-1", "team": "ops"},
+	}, cloud)
+}
+
+func TestCloudInfoEC2Token(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Path == "/api/token" {
+			_, _ = w.Write([]byte("token"))
+			return
+		}
+		if r.Header.Get("X-aws-ec2-metadata-token") != "token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/meta-data/instance-id":
+			_, _ = w.Write([]byte("i-0123456789"))
+		case "/meta-data/instance-type":
+			_, _ = w.Write([]byte("t2.micro"))
+		case "/meta-data/placement/availability-zone":
+			_, _ = w.Write([]byte("eu-west-3b"))
+		case "/meta-data/placement/region":
+			_, _ = w.Write([]byte("eu-west-3"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer useMetadataServices(map[string]string{CloudProviderEC2: server.URL})()
+
+	cloud, err := CloudInfo()
+	require.NoError(t, err)
+	assert.Equal(t, "i-0123456789", cloud.InstanceID)
+	assert.Equal(t, "eu-west-3", cloud.Region)
+	assert.Equal(t, "eu-west-3b", cloud.AvailabilityZone)
+	assert.Nil(t, cloud.Tags)
+}
+
+func TestCloudInfoGCE(t *testing.T) {
+	server := metadataService("Metadata-Flavor", "Google", map[string]string{
+		"/instance/?recursive=true": `{
+			"id": 4520031799277581759,
+			"machineType": "projects/123/machineTypes/n1-standard-1",
+			"zone": "projects/123/zones/us-central1-f"
+		}`,
+	})
+	defer server.Close()
+	defer useMetadataServices(map[string]string{CloudProviderGCE: server.URL})()
+
+	cloud, err := CloudInfo()
+	require.NoError(t, err)
+	assert.Equal(t, &types.Cloud{
+		Provider:         CloudProviderGCE,
+		InstanceID:       "4520031799277581759",
+		InstanceType:     "n1-standard-1",
+		Region:           "us-central1",
+		AvailabilityZone: "us-central1-f",
+	}, cloud)
+}
+
+func TestCloudInfoAzure(t *testing.T) {
+	server := metadataService("Metadata", "true", map[string]string{
+		"/instance/compute?api-version=2019-06-01": `{
+			"vmId": "02aab8a4-74ef-476e-8182-f6d2ba4166a6",
+			"vmSize": "Standard_A3",
+			"location": "westeurope",
+			"zone": "1",
+			"tagsList": [{"name": "team", "value": "ops"}]
+		}`,
+	})
+	defer server.Close()
+	defer useMetadataServices(map[string]string{CloudProviderAzure: server.URL})()
+
+	cloud, err := CloudInfo()
+	require.NoError(t, err)
+	assert.Equal(t, &types.Cloud{
+		Provider:         CloudProviderAzure,
+		InstanceID:       "02aab8a4-74ef-476e-8182-f6d2ba4166a6",
+		InstanceType:     "Standard_A3",
+		Region:           "westeurope",
+		AvailabilityZone: "1",
+		Tags:             map[string]string{"team": "ops"},
+	}, cloud)
+}
+
+func TestCloudInfoNotOnCloud(t *testing.T) {
+	defer useMetadataServices(nil)()
+
+	_, err := CloudInfo()
+	assert.Error(t, err)
+}
@@ -14,7 +14,8 @@ import (
 const defaultHostname = "unidentified-hostname"
 
 // Info describes the local system, hostname, OS, platform, platform
-// family, platform version, and network interfaces.
+// family, platform version, and network interfaces, as well as the OS
+// edition, service pack and domain on Windows.
 func Info() (types.System, error) {
 	info, err := host.Info()
 
@@ -35,6 +36,9 @@ func Info() (types.System, error) {
 		system.Hostname = defaultHostname
 	}
 
+	// Platform specific facts are best effort
+	_ = platformInfo(&system)
+
 	network, err := NetworkInfo()
 
 	if err == nil {
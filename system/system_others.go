@@ -0,0 +1,11 @@
+// +build !windows
+
+package system
+
+import "github.com/sensu/sensu-go/types"
+
+// platformInfo adds the facts specific to the platform to the given system,
+// of which there are none outside of Windows.
+func platformInfo(system *types.System) error {
+	return nil
+}
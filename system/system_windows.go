@@ -0,0 +1,81 @@
+// +build windows
+
+package system
+
+import (
+	"strings"
+
+	"github.com/StackExchange/wmi"
+	"github.com/sensu/sensu-go/types"
+)
+
+// win32OperatingSystem is the subset of the Win32_OperatingSystem WMI class
+// describing the edition and service pack of Windows.
+type win32OperatingSystem struct {
+	Caption            string
+	CSDVersion         *string
+	OperatingSystemSKU *uint32
+}
+
+// win32ComputerSystem is the subset of the Win32_ComputerSystem WMI class
+// describing the domain of the computer.
+type win32ComputerSystem struct {
+	Domain       string
+	PartOfDomain bool
+}
+
+// windowsEditions are the names of the most common Windows editions, by their
+// stock keeping unit (SKU).
+var windowsEditions = map[uint32]string{
+	1:   "Ultimate",
+	2:   "Home Basic",
+	3:   "Home Premium",
+	4:   "Enterprise",
+	6:   "Business",
+	7:   "Server Standard",
+	8:   "Server Datacenter",
+	10:  "Server Enterprise",
+	12:  "Server Datacenter Core",
+	13:  "Server Standard Core",
+	14:  "Server Enterprise Core",
+	17:  "Web Server",
+	48:  "Professional",
+	98:  "Home N",
+	100: "Home Single Language",
+	101: "Home",
+	121: "Education",
+	125: "Enterprise LTSB",
+	161: "Pro for Workstations",
+}
+
+// platformInfo adds the edition, service pack and domain of Windows, queried
+// with WMI, to the given system.
+func platformInfo(system *types.System) error {
+	var operatingSystems []win32OperatingSystem
+	if err := wmi.Query(wmi.CreateQuery(&operatingSystems, ""), &operatingSystems); err != nil {
+		return err
+	}
+	if len(operatingSystems) > 0 {
+		operatingSystem := operatingSystems[0]
+		system.OSEdition = strings.TrimSpace(operatingSystem.Caption)
+		if operatingSystem.OperatingSystemSKU != nil {
+			if edition, ok := windowsEditions[*operatingSystem.OperatingSystemSKU]; ok {
+				system.OSEdition = edition
+			}
+		}
+		if operatingSystem.CSDVersion != nil {
+			system.ServicePack = *operatingSystem.CSDVersion
+		}
+	}
+
+	var computerSystems []win32ComputerSystem
+	if err := wmi.Query(wmi.CreateQuery(&computerSystems, ""), &computerSystems); err != nil {
+		return err
+	}
+	// The domain is the workgroup of computers not part of a domain
+	if len(computerSystems) > 0 && computerSystems[0].PartOfDomain {
+		system.Domain = computerSystems[0].Domain
+	}
+
+	return nil
+}
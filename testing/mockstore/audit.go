@@ -0,0 +1,19 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CreateAuditEntry ...
+func (s *MockStore) CreateAuditEntry(ctx context.Context, entry *types.AuditEntry, ttl int64) error {
+	args := s.Called(ctx, entry, ttl)
+	return args.Error(0)
+}
+
+// GetAuditEntries ...
+func (s *MockStore) GetAuditEntries(ctx context.Context) ([]*types.AuditEntry, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.AuditEntry), args.Error(1)
+}
@@ -49,6 +49,6 @@ func (s *MockStore) GetErrorsByEntityCheck(ctx context.Context, entityID, checkI
 
 // CreateError ...
 func (s *MockStore) CreateError(ctx context.Context, err *types.Error) error {
-	args := s.Called(err)
+	args := s.Called(ctx, err)
 	return args.Error(0)
 }
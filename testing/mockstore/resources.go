@@ -0,0 +1,13 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// UpdateResources ...
+func (s *MockStore) UpdateResources(ctx context.Context, resources []types.Resource) error {
+	args := s.Called(ctx, resources)
+	return args.Error(0)
+}
@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteSecretByName ...
+func (s *MockStore) DeleteSecretByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetSecrets ...
+func (s *MockStore) GetSecrets(ctx context.Context) ([]*types.Secret, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.Secret), args.Error(1)
+}
+
+// GetSecretByName ...
+func (s *MockStore) GetSecretByName(ctx context.Context, name string) (*types.Secret, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.Secret), args.Error(1)
+}
+
+// UpdateSecret ...
+func (s *MockStore) UpdateSecret(ctx context.Context, secret *types.Secret) error {
+	args := s.Called(secret)
+	return args.Error(0)
+}
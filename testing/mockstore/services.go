@@ -0,0 +1,55 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteServiceByName ...
+func (s *MockStore) DeleteServiceByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetServices ...
+func (s *MockStore) GetServices(ctx context.Context) ([]*types.Service, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.Service), args.Error(1)
+}
+
+// GetServiceByName ...
+func (s *MockStore) GetServiceByName(ctx context.Context, name string) (*types.Service, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.Service), args.Error(1)
+}
+
+// UpdateService ...
+func (s *MockStore) UpdateService(ctx context.Context, service *types.Service) error {
+	args := s.Called(service)
+	return args.Error(0)
+}
+
+// DeleteServiceComponentByName ...
+func (s *MockStore) DeleteServiceComponentByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetServiceComponents ...
+func (s *MockStore) GetServiceComponents(ctx context.Context) ([]*types.ServiceComponent, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.ServiceComponent), args.Error(1)
+}
+
+// GetServiceComponentByName ...
+func (s *MockStore) GetServiceComponentByName(ctx context.Context, name string) (*types.ServiceComponent, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.ServiceComponent), args.Error(1)
+}
+
+// UpdateServiceComponent ...
+func (s *MockStore) UpdateServiceComponent(ctx context.Context, component *types.ServiceComponent) error {
+	args := s.Called(component)
+	return args.Error(0)
+}
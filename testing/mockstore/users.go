@@ -46,7 +46,7 @@ func (s *MockStore) GetAllUsers() ([]*types.User, error) {
 }
 
 // UpdateUser ...
-func (s *MockStore) UpdateUser(user *types.User) error {
+func (s *MockStore) UpdateUser(ctx context.Context, user *types.User) error {
 	args := s.Called(user)
 	return args.Error(0)
 }
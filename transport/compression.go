@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+)
+
+// gzipMagic are the first bytes of gzip compressed data, which can't start a
+// JSON payload
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// CompressPayload compresses a message payload with gzip. Compressed payloads
+// are decompressed when the message is decoded, so they can be sent as any
+// other payload.
+func CompressPayload(payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// isCompressed returns true if the given payload was compressed with gzip
+func isCompressed(payload []byte) bool {
+	return bytes.HasPrefix(payload, gzipMagic)
+}
+
+// decompressPayload decompresses a payload compressed with gzip
+func decompressPayload(payload []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
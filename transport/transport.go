@@ -30,6 +30,11 @@ const (
 	// MessageTypeEvent is the message type string for events.
 	MessageTypeEvent = "event"
 
+	// MessageTypeDeregistration is the message type sent by agents shutting
+	// down to have their entity deregistered--which is, like a keepalive, an
+	// event without a Check or Metrics section.
+	MessageTypeDeregistration = "deregistration"
+
 	// HeaderKeyAgentID is the HTTP request header specifying the Agent ID
 	HeaderKeyAgentID = "Sensu-AgentID"
 
@@ -73,7 +78,8 @@ func Encode(msgType string, payload []byte) []byte {
 	return buf
 }
 
-// Decode a message received from a websocket channel.
+// Decode a message received from a websocket channel. Payloads compressed
+// with CompressPayload are decompressed.
 func Decode(payload []byte) (string, []byte, error) {
 	nl := bytes.Index(payload, sep)
 	if nl < 0 {
@@ -82,6 +88,12 @@ func Decode(payload []byte) (string, []byte, error) {
 
 	msgType := payload[0:nl]
 	msg := payload[nl+1:]
+	if isCompressed(msg) {
+		var err error
+		if msg, err = decompressPayload(msg); err != nil {
+			return "", nil, fmt.Errorf("invalid compressed message: %s", err)
+		}
+	}
 	return string(msgType), msg, nil
 }
 
@@ -45,6 +45,43 @@ func TestTransportSendReceive(t *testing.T) {
 	<-done
 }
 
+func TestTransportSendReceiveCompressed(t *testing.T) {
+	testMessage := &testMessageType{strings.Repeat("message", 100)}
+
+	done := make(chan struct{})
+	server := NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		transport, err := server.Serve(w, r)
+		assert.NoError(t, err)
+		msg, err := transport.Receive()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "testMessageType", msg.Type)
+		m := &testMessageType{}
+		assert.NoError(t, json.Unmarshal(msg.Payload, m))
+		assert.Equal(t, testMessage.Data, m.Data)
+		done <- struct{}{}
+	}))
+	defer ts.Close()
+
+	clientTransport, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil)
+	assert.NoError(t, err)
+	msgBytes, err := json.Marshal(testMessage)
+	assert.NoError(t, err)
+	compressed, err := CompressPayload(msgBytes)
+	require.NoError(t, err)
+	assert.True(t, len(compressed) < len(msgBytes))
+	err = clientTransport.Send(&Message{"testMessageType", compressed})
+	assert.NoError(t, err)
+
+	<-done
+}
+
+func TestDecodeInvalidCompressedPayload(t *testing.T) {
+	_, _, err := Decode(append([]byte("event\n"), gzipMagic...))
+	assert.Error(t, err)
+}
+
 func TestClosedWebsocket(t *testing.T) {
 	done := make(chan struct{}, 1)
 
@@ -1,17 +1,17 @@
 package types
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 )
 
 // Validate returns an error if the name is not provided.
 func (a *AdhocRequest) Validate() error {
+	var errs FieldErrors
 	if a.Name == "" {
-		return errors.New("must provide check name")
+		errs.Add("check", "must be set")
 	}
-	return nil
+	return errs.Err()
 }
 
 // FixtureAdhocRequest returns a testing fixture for an AdhocRequest struct.
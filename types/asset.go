@@ -9,6 +9,7 @@ import (
 	"path"
 	"regexp"
 
+	jsoniter "github.com/json-iterator/go"
 	"github.com/sensu/sensu-go/util/eval"
 )
 
@@ -20,37 +21,31 @@ var AssetNameRegex = regexp.MustCompile("^" + AssetNameRegexStr + "$")
 
 // Validate returns an error if the asset contains invalid values.
 func (a *Asset) Validate() error {
-	if err := ValidateAssetName(a.Name); err != nil {
-		return err
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateAssetName(a.Name))
 
 	if a.Organization == "" {
-		return errors.New("organization cannot be empty")
+		errs.Add("organization", "cannot be empty")
 	}
 
 	if a.Sha512 == "" {
-		return errors.New("SHA-512 checksum cannot be empty")
-	}
-
-	if len(a.Sha512) < 128 {
-		return errors.New("SHA-512 checksum must be at least 128 characters")
+		errs.Add("sha512", "cannot be empty")
+	} else if len(a.Sha512) < 128 {
+		errs.Add("sha512", "must be at least 128 characters")
 	}
 
 	if a.URL == "" {
-		return errors.New("URL cannot be empty")
-	}
-
-	u, err := url.Parse(a.URL)
-	if err != nil {
-		return errors.New("invalid URL provided")
-	}
-
-	if u.Scheme != "https" && u.Scheme != "http" {
-		return errors.New("URL must be HTTP or HTTPS")
+		errs.Add("url", "cannot be empty")
+	} else if u, err := url.Parse(a.URL); err != nil {
+		errs.Add("url", "is invalid")
+	} else if u.Scheme != "https" && u.Scheme != "http" {
+		errs.Add("url", "must be HTTP or HTTPS")
 	}
 
 	// Validate the statements and forbid govaluate's modifier tokens
-	return eval.ValidateStatements(a.Filters, true)
+	errs.AddError("filters", eval.ValidateStatements(a.Filters, true))
+
+	return errs.Err()
 }
 
 // GetEnvironment refers to the organization the check belongs to
@@ -61,12 +56,12 @@ func (a *Asset) GetEnvironment() string {
 // ValidateAssetName validates that asset's name is valid
 func ValidateAssetName(name string) error {
 	if name == "" {
-		return errors.New("name cannot be empty")
+		return errors.New("cannot be empty")
 	}
 
 	if !AssetNameRegex.MatchString(name) {
 		return errors.New(
-			"name must be lowercase and may only contain forward slashes, underscores, dashes and numbers",
+			"must be lowercase and may only contain forward slashes, underscores, dashes and numbers",
 		)
 	}
 
@@ -94,9 +89,11 @@ func FixtureAsset(name string) *Asset {
 		Name:   name,
 		Sha512: "25e01b962045f4f5b624c3e47e782bef65c6c82602524dc569a8431b76cc1f57639d267380a7ec49f70876339ae261704fc51ed2fc520513cf94bc45ed7f6e17",
 		URL:    "https://localhost/" + hash + ".zip",
-		Metadata: map[string]string{
-			"Content-Type":            "application/zip",
-			"X-Intended-Distribution": "trusty-14",
+		ObjectMeta: ObjectMeta{
+			Annotations: map[string]string{
+				"Content-Type":            "application/zip",
+				"X-Intended-Distribution": "trusty-14",
+			},
 		},
 		Organization: "default",
 	}
@@ -106,3 +103,36 @@ func FixtureAsset(name string) *Asset {
 func (a *Asset) URIPath() string {
 	return fmt.Sprintf("/assets/%s", url.PathEscape(a.Name))
 }
+
+// GetObjectMeta implements MetaResource.
+func (a *Asset) GetObjectMeta() ObjectMeta {
+	return objectMeta(a.ObjectMeta, a.Name, a.Organization, "")
+}
+
+// SetObjectMeta implements MetaResource.
+func (a *Asset) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&a.Name, &a.Organization, nil)
+	a.ObjectMeta = meta
+}
+
+// MigrateAssetMetadata keeps the flat metadata of the given JSON asset, stored
+// before assets had object metadata, as annotations of the asset.
+func MigrateAssetMetadata(a *Asset, b []byte) error {
+	legacy := struct {
+		Metadata map[string]interface{} `json:"metadata"`
+	}{}
+	if err := jsoniter.Unmarshal(b, &legacy); err != nil {
+		return err
+	}
+	for key, value := range legacy.Metadata {
+		s, ok := value.(string)
+		if !ok || objectMetaFields[key] {
+			continue
+		}
+		if a.Annotations == nil {
+			a.Annotations = map[string]string{}
+		}
+		a.Annotations[key] = s
+	}
+	return nil
+}
@@ -23,14 +23,14 @@ type Asset struct {
 	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	// Sha512 is the SHA-512 checksum of the asset
 	Sha512 string `protobuf:"bytes,3,opt,name=sha512,proto3" json:"sha512,omitempty"`
-	// Metadata is a set of key value pair associated with the asset
-	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata" json:"metadata" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Filters are a collection of sensu queries, used by the system to determine
 	// if the asset should be installed. If more than one filter is present the
 	// queries are joined by the "AND" operator.
 	Filters []string `protobuf:"bytes,5,rep,name=filters" json:"filters"`
 	// Organization indicates to which org an asset belongs to
 	Organization string `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the asset
+	ObjectMeta `protobuf:"bytes,7,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Asset) Reset()                    { *m = Asset{} }
@@ -59,13 +59,6 @@ func (m *Asset) GetSha512() string {
 	return ""
 }
 
-func (m *Asset) GetMetadata() map[string]string {
-	if m != nil {
-		return m.Metadata
-	}
-	return nil
-}
-
 func (m *Asset) GetFilters() []string {
 	if m != nil {
 		return m.Filters
@@ -111,14 +104,6 @@ func (this *Asset) Equal(that interface{}) bool {
 	if this.Sha512 != that1.Sha512 {
 		return false
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return false
-	}
-	for i := range this.Metadata {
-		if this.Metadata[i] != that1.Metadata[i] {
-			return false
-		}
-	}
 	if len(this.Filters) != len(that1.Filters) {
 		return false
 	}
@@ -130,6 +115,9 @@ func (this *Asset) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -165,23 +153,6 @@ func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintAsset(dAtA, i, uint64(len(m.Sha512)))
 		i += copy(dAtA[i:], m.Sha512)
 	}
-	if len(m.Metadata) > 0 {
-		for k, _ := range m.Metadata {
-			dAtA[i] = 0x22
-			i++
-			v := m.Metadata[k]
-			mapSize := 1 + len(k) + sovAsset(uint64(len(k))) + 1 + len(v) + sovAsset(uint64(len(v)))
-			i = encodeVarintAsset(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAsset(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintAsset(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
 	if len(m.Filters) > 0 {
 		for _, s := range m.Filters {
 			dAtA[i] = 0x2a
@@ -203,6 +174,14 @@ func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintAsset(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintAsset(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -220,19 +199,14 @@ func NewPopulatedAsset(r randyAsset, easy bool) *Asset {
 	this.Name = string(randStringAsset(r))
 	this.URL = string(randStringAsset(r))
 	this.Sha512 = string(randStringAsset(r))
-	if r.Intn(10) != 0 {
-		v1 := r.Intn(10)
-		this.Metadata = make(map[string]string)
-		for i := 0; i < v1; i++ {
-			this.Metadata[randStringAsset(r)] = randStringAsset(r)
-		}
-	}
-	v2 := r.Intn(10)
-	this.Filters = make([]string, v2)
-	for i := 0; i < v2; i++ {
+	v1 := r.Intn(10)
+	this.Filters = make([]string, v1)
+	for i := 0; i < v1; i++ {
 		this.Filters[i] = string(randStringAsset(r))
 	}
 	this.Organization = string(randStringAsset(r))
+	v4 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v4
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -257,9 +231,9 @@ func randUTF8RuneAsset(r randyAsset) rune {
 	return rune(ru + 61)
 }
 func randStringAsset(r randyAsset) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
 		tmps[i] = randUTF8RuneAsset(r)
 	}
 	return string(tmps)
@@ -281,11 +255,11 @@ func randFieldAsset(dAtA []byte, r randyAsset, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateAsset(dAtA, uint64(key))
-		v4 := r.Int63()
+		v3 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v3 *= -1
 		}
-		dAtA = encodeVarintPopulateAsset(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateAsset(dAtA, uint64(v3))
 	case 1:
 		dAtA = encodeVarintPopulateAsset(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -325,14 +299,6 @@ func (m *Asset) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAsset(uint64(l))
 	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAsset(uint64(len(k))) + 1 + len(v) + sovAsset(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAsset(uint64(mapEntrySize))
-		}
-	}
 	if len(m.Filters) > 0 {
 		for _, s := range m.Filters {
 			l = len(s)
@@ -343,6 +309,8 @@ func (m *Asset) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAsset(uint64(l))
 	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovAsset(uint64(l))
 	return n
 }
 
@@ -475,11 +443,11 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			}
 			m.Sha512 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAsset
@@ -489,113 +457,24 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAsset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAsset
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAsset
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAsset
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAsset
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthAsset
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAsset(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthAsset
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -620,13 +499,13 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAsset
@@ -636,20 +515,21 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAsset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -780,25 +660,24 @@ var (
 func init() { proto.RegisterFile("asset.proto", fileDescriptorAsset) }
 
 var fileDescriptorAsset = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x2c, 0x2e, 0x4e,
-	0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b,
-	0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30,
-	0x0b, 0xa2, 0x57, 0x69, 0x16, 0x13, 0x17, 0xab, 0x23, 0xc8, 0x2c, 0x21, 0x21, 0x2e, 0x96, 0xbc,
-	0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x48, 0x92, 0x8b, 0xb9,
-	0xb4, 0x28, 0x47, 0x82, 0x09, 0x24, 0xe4, 0xc4, 0xfe, 0xe8, 0x9e, 0x3c, 0x73, 0x68, 0x90, 0x4f,
-	0x10, 0x48, 0x4c, 0x48, 0x8c, 0x8b, 0xad, 0x38, 0x23, 0xd1, 0xd4, 0xd0, 0x48, 0x82, 0x19, 0xac,
-	0x01, 0xca, 0x13, 0x72, 0xe2, 0xe2, 0xc8, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0x94, 0x60,
-	0x51, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd0, 0x43, 0x72, 0x9f, 0x1e, 0xd8, 0x32, 0x3d, 0x5f, 0xa8,
-	0x12, 0xd7, 0xbc, 0x92, 0xa2, 0x4a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xe0, 0xfa, 0x84,
-	0x54, 0xb9, 0xd8, 0xd3, 0x32, 0x73, 0x4a, 0x52, 0x8b, 0x8a, 0x25, 0x58, 0x15, 0x98, 0x35, 0x38,
-	0x9d, 0xb8, 0x5f, 0xdd, 0x93, 0x87, 0x09, 0x05, 0xc1, 0x18, 0x42, 0x4a, 0x5c, 0x3c, 0xf9, 0x45,
-	0xe9, 0x89, 0x79, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x12, 0x6c, 0x60, 0x87, 0xa0, 0x88,
-	0x49, 0x59, 0x73, 0xf1, 0xa2, 0xd8, 0x25, 0x24, 0xc0, 0xc5, 0x9c, 0x9d, 0x5a, 0x09, 0xf5, 0x25,
-	0x88, 0x29, 0x24, 0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x0a, 0xf1, 0x66, 0x10, 0x84, 0x63,
-	0xc5, 0x64, 0xc1, 0xe8, 0xa4, 0xfc, 0xe3, 0xa1, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x3b, 0x1e,
-	0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33,
-	0x1e, 0xcb, 0x31, 0x44, 0xb1, 0x82, 0x3d, 0x94, 0xc4, 0x06, 0x0e, 0x48, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x54, 0x0d, 0xc6, 0xf2, 0x93, 0x01, 0x00, 0x00,
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x3f, 0x4e, 0xc3, 0x30,
+	0x1c, 0x85, 0xfb, 0x23, 0x4d, 0xff, 0x38, 0x0c, 0xc8, 0x42, 0x60, 0x3a, 0x38, 0x51, 0x11, 0x52,
+	0x16, 0x52, 0x51, 0xc4, 0x01, 0xc8, 0x06, 0x02, 0x21, 0x45, 0x62, 0x61, 0x73, 0x8a, 0x9b, 0x06,
+	0x35, 0x71, 0x15, 0x3b, 0x03, 0x9c, 0x84, 0x23, 0x70, 0x04, 0x8e, 0xd0, 0xb1, 0x27, 0x88, 0xc0,
+	0x6c, 0x39, 0x01, 0x23, 0x8a, 0x4b, 0x2b, 0xd8, 0xbe, 0xf7, 0xc9, 0xef, 0xc9, 0x36, 0x72, 0x98,
+	0x94, 0x5c, 0x05, 0x8b, 0x42, 0x28, 0x81, 0x1d, 0xc9, 0x73, 0x59, 0x06, 0xea, 0x79, 0xc1, 0xe5,
+	0xe0, 0x34, 0x49, 0xd5, 0xac, 0x8c, 0x83, 0x89, 0xc8, 0x46, 0x89, 0x48, 0xc4, 0xc8, 0x9c, 0x89,
+	0xcb, 0xa9, 0x49, 0x26, 0x18, 0x5a, 0x77, 0x07, 0x28, 0xe3, 0x8a, 0xad, 0x79, 0x58, 0x03, 0xb2,
+	0x2f, 0x9b, 0x5d, 0x8c, 0x51, 0x3b, 0x67, 0x19, 0x27, 0xe0, 0x81, 0xdf, 0x8f, 0x0c, 0xe3, 0x23,
+	0x64, 0x95, 0xc5, 0x9c, 0xec, 0x34, 0x2a, 0xec, 0xea, 0xca, 0xb5, 0xee, 0xa3, 0x9b, 0xa8, 0x71,
+	0xf8, 0x00, 0x75, 0xe4, 0x8c, 0x5d, 0x9c, 0x8d, 0x89, 0x65, 0x0a, 0xbf, 0x09, 0x9f, 0xa0, 0xee,
+	0x34, 0x9d, 0x2b, 0x5e, 0x48, 0x62, 0x7b, 0x96, 0xdf, 0x0f, 0x9d, 0xba, 0x72, 0x37, 0x2a, 0xda,
+	0x00, 0x1e, 0xa2, 0x5d, 0x51, 0x24, 0x2c, 0x4f, 0x5f, 0x98, 0x4a, 0x45, 0x4e, 0x3a, 0x66, 0xe4,
+	0x9f, 0xc3, 0x57, 0xa8, 0xd7, 0xdc, 0xf4, 0x91, 0x29, 0x46, 0xba, 0x1e, 0xf8, 0xce, 0xf8, 0x30,
+	0xf8, 0xf3, 0xec, 0xe0, 0x2e, 0x7e, 0xe2, 0x13, 0x75, 0xcb, 0x15, 0x0b, 0xf7, 0x97, 0x95, 0xdb,
+	0x5a, 0x55, 0x2e, 0xd4, 0x95, 0xbb, 0x2d, 0x45, 0x5b, 0xba, 0x6e, 0xf7, 0xda, 0x7b, 0x76, 0x78,
+	0xfc, 0xfd, 0x49, 0xe1, 0x4d, 0x53, 0x78, 0xd7, 0x14, 0x96, 0x9a, 0xc2, 0x4a, 0x53, 0xf8, 0xd0,
+	0x14, 0x5e, 0xbf, 0x68, 0xeb, 0xc1, 0x36, 0xab, 0x71, 0xc7, 0x7c, 0xcc, 0xf9, 0xcf, 0x00, 0xb7,
+	0x3d, 0xd7, 0x51, 0x6f, 0x01, 0x00, 0x00,
 }
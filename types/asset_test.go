@@ -69,3 +69,14 @@ func TestValidator(t *testing.T) {
 	asset.Sha512 = "nope"
 	assert.Error(asset.Validate())
 }
+
+func TestMigrateAssetMetadata(t *testing.T) {
+	b := []byte(`{"name":"one","metadata":{"Content-Type":"application/zip","name":"one","annotations":{"foo":"bar"}}}`)
+	a := &Asset{Name: "one"}
+	assert.NoError(t, MigrateAssetMetadata(a, b))
+	assert.Equal(t, map[string]string{"Content-Type": "application/zip"}, a.Annotations)
+
+	a = &Asset{Name: "one"}
+	assert.NoError(t, MigrateAssetMetadata(a, []byte(`{"name":"one"}`)))
+	assert.Nil(t, a.Annotations)
+}
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Attributes are the extended attributes of a resource: arbitrary JSON-encoded
+// data, whose values are addressed by dot-separated paths of object keys and
+// array indexes, e.g. "aws.region" or "disks.0.path".
+type Attributes []byte
+
+// Get returns the value at the given path, and whether it was found. Objects
+// are returned as map[string]interface{} and arrays as []interface{}.
+func (a Attributes) Get(path string) (interface{}, bool) {
+	any, ok := a.lookup(path)
+	if !ok {
+		return nil, false
+	}
+	return any.GetInterface(), true
+}
+
+// Has returns whether there is a value at the given path.
+func (a Attributes) Has(path string) bool {
+	_, ok := a.lookup(path)
+	return ok
+}
+
+// GetString returns the string at the given path, or an empty string if there
+// is none.
+func (a Attributes) GetString(path string) string {
+	any, ok := a.lookup(path)
+	if !ok || any.ValueType() != jsoniter.StringValue {
+		return ""
+	}
+	return any.ToString()
+}
+
+// GetInt returns the number at the given path as an integer, or zero if there
+// is none.
+func (a Attributes) GetInt(path string) int64 {
+	any, ok := a.lookup(path)
+	if !ok || any.ValueType() != jsoniter.NumberValue {
+		return 0
+	}
+	return any.ToInt64()
+}
+
+// GetFloat returns the number at the given path, or zero if there is none.
+func (a Attributes) GetFloat(path string) float64 {
+	any, ok := a.lookup(path)
+	if !ok || any.ValueType() != jsoniter.NumberValue {
+		return 0
+	}
+	return any.ToFloat64()
+}
+
+// GetBool returns the boolean at the given path, or false if there is none.
+func (a Attributes) GetBool(path string) bool {
+	any, ok := a.lookup(path)
+	if !ok || any.ValueType() != jsoniter.BoolValue {
+		return false
+	}
+	return any.ToBool()
+}
+
+// Set sets the value at the given path, creating the objects leading to it
+// if they do not exist. It returns an error if the path goes through a value
+// which is not an object.
+func (a *Attributes) Set(path string, value interface{}) error {
+	if path == "" {
+		return errors.New("empty attribute path")
+	}
+
+	attrs := map[string]interface{}{}
+	if len(*a) > 0 {
+		if err := json.Unmarshal(*a, &attrs); err != nil {
+			return err
+		}
+	}
+
+	parts := strings.Split(path, ".")
+	object := attrs
+	for i, part := range parts[:len(parts)-1] {
+		next, ok := object[part]
+		if !ok {
+			next = map[string]interface{}{}
+			object[part] = next
+		}
+		nested, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("attribute %q is not an object", strings.Join(parts[:i+1], "."))
+		}
+		object = nested
+	}
+	object[parts[len(parts)-1]] = value
+
+	b, err := json.Marshal(attrs)
+	if err != nil {
+		return err
+	}
+	*a = b
+	return nil
+}
+
+// lookup returns the value at the given path, and whether it was found
+func (a Attributes) lookup(path string) (jsoniter.Any, bool) {
+	if len(a) == 0 || path == "" {
+		return nil, false
+	}
+
+	any := jsoniter.Get(a)
+	for _, part := range strings.Split(path, ".") {
+		switch any.ValueType() {
+		case jsoniter.ObjectValue:
+			any = any.Get(part)
+		case jsoniter.ArrayValue:
+			index, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, false
+			}
+			any = any.Get(index)
+		default:
+			return nil, false
+		}
+		if any.ValueType() == jsoniter.InvalidValue {
+			return nil, false
+		}
+	}
+	return any, true
+}
+
+// Marshal implements the protobuf custom type interface.
+func (a Attributes) Marshal() ([]byte, error) {
+	return []byte(a), nil
+}
+
+// MarshalTo implements the protobuf custom type interface.
+func (a Attributes) MarshalTo(data []byte) (int, error) {
+	return copy(data, a), nil
+}
+
+// Unmarshal implements the protobuf custom type interface.
+func (a *Attributes) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*a = nil
+		return nil
+	}
+	*a = append((*a)[:0], data...)
+	return nil
+}
+
+// Size implements the protobuf custom type interface.
+func (a Attributes) Size() int {
+	return len(a)
+}
+
+// Equal returns whether the attributes are encoded the same.
+func (a Attributes) Equal(other Attributes) bool {
+	return bytes.Equal(a, other)
+}
+
+// DeepCopy returns a copy of the attributes, sharing no memory with them.
+func (a Attributes) DeepCopy() Attributes {
+	if a == nil {
+		return nil
+	}
+	return append(Attributes{}, a...)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a Attributes) MarshalJSON() ([]byte, error) {
+	if len(a) == 0 {
+		return []byte("null"), nil
+	}
+	return []byte(a), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *Attributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*a = nil
+		return nil
+	}
+	*a = append((*a)[:0], data...)
+	return nil
+}
+
+type randyAttributes interface {
+	Intn(n int) int
+}
+
+// NewPopulatedAttributes returns random attributes, for the generated tests
+// of the messages having them.
+func NewPopulatedAttributes(r randyAttributes) *Attributes {
+	a := Attributes(fmt.Sprintf(`{"attr%d":%d}`, r.Intn(10), r.Intn(1000)))
+	return &a
+}
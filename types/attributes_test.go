@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/sensu/sensu-go/util/eval"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributesGet(t *testing.T) {
+	attrs := Attributes(`{"aws":{"region":"us-west-2","zones":2,"spot":true,"price":0.5},"disks":[{"path":"/"}]}`)
+
+	assert.Equal(t, "us-west-2", attrs.GetString("aws.region"))
+	assert.Equal(t, int64(2), attrs.GetInt("aws.zones"))
+	assert.Equal(t, 0.5, attrs.GetFloat("aws.price"))
+	assert.True(t, attrs.GetBool("aws.spot"))
+	assert.Equal(t, "/", attrs.GetString("disks.0.path"))
+
+	value, ok := attrs.Get("aws")
+	require.True(t, ok)
+	assert.Equal(t, "us-west-2", value.(map[string]interface{})["region"])
+
+	// Missing values and values of another type are zero values
+	assert.Empty(t, attrs.GetString("aws.zones"))
+	assert.Zero(t, attrs.GetInt("aws.region"))
+	assert.False(t, attrs.Has("aws.region.name"))
+	assert.False(t, attrs.Has("disks.1.path"))
+	assert.False(t, attrs.Has("disks.first"))
+	assert.False(t, Attributes(nil).Has("aws"))
+}
+
+func TestAttributesSet(t *testing.T) {
+	var attrs Attributes
+	require.NoError(t, attrs.Set("aws.region", "us-west-2"))
+	require.NoError(t, attrs.Set("team", "ops"))
+	assert.JSONEq(t, `{"aws":{"region":"us-west-2"},"team":"ops"}`, string(attrs))
+
+	assert.Error(t, attrs.Set("team.name", "ops"))
+	assert.Error(t, attrs.Set("", "ops"))
+}
+
+func TestAttributesRoundTrip(t *testing.T) {
+	entity := FixtureEntity("entity")
+	require.NoError(t, entity.ExtendedAttributes.Set("aws.region", "us-west-2"))
+
+	// Protobuf
+	b, err := proto.Marshal(entity)
+	require.NoError(t, err)
+	var decoded Entity
+	require.NoError(t, proto.Unmarshal(b, &decoded))
+	assert.Equal(t, "us-west-2", decoded.ExtendedAttributes.GetString("aws.region"))
+
+	// JSON
+	b, err = entity.MarshalJSON()
+	require.NoError(t, err)
+	decoded = Entity{}
+	require.NoError(t, decoded.UnmarshalJSON(b))
+	assert.Equal(t, "us-west-2", decoded.ExtendedAttributes.GetString("aws.region"))
+}
+
+func TestAttributesFilter(t *testing.T) {
+	entity := FixtureEntity("entity")
+	require.NoError(t, entity.ExtendedAttributes.Set("aws.region", "us-west-2"))
+
+	match, err := eval.EvaluatePredicate(`entity.aws.region == "us-west-2"`, map[string]interface{}{
+		"entity": entity,
+	})
+	require.NoError(t, err)
+	assert.True(t, match)
+}
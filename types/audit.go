@@ -0,0 +1,107 @@
+package types
+
+import (
+	"net/http"
+)
+
+const (
+	// AuditOutcomeSuccess is the outcome of the requests that succeeded
+	AuditOutcomeSuccess = "success"
+
+	// AuditOutcomeFailure is the outcome of the requests that failed
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditEntry records a request modifying resources through the API.
+type AuditEntry struct {
+	// ID is the unique identifier of the entry.
+	ID string `json:"id"`
+	// User is the name of the user who made the request.
+	User string `json:"user"`
+	// Verb is the action requested, e.g. create or delete.
+	Verb string `json:"verb"`
+	// Resource is the path of the resource the action applies to, or
+	// /graphql/ followed by the name of a GraphQL mutation.
+	Resource string `json:"resource"`
+	// Organization the request was made in.
+	Organization string `json:"organization"`
+	// Environment the request was made in.
+	Environment string `json:"environment"`
+	// Outcome is either success or failure.
+	Outcome string `json:"outcome"`
+	// Status is the HTTP status code of the response, zero for GraphQL
+	// mutations.
+	Status int `json:"status"`
+	// RequestID is the identifier of the request, if any.
+	RequestID string `json:"request_id,omitempty"`
+	// Timestamp is the time the request was made at, as a Unix timestamp.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Validate returns an error if the entry is invalid.
+func (e *AuditEntry) Validate() error {
+	var errs FieldErrors
+	if e.ID == "" {
+		errs.Add("id", "must not be empty")
+	}
+	if e.Verb == "" {
+		errs.Add("verb", "must not be empty")
+	}
+	if e.Organization == "" {
+		errs.Add("organization", "must be set")
+	}
+	if e.Environment == "" {
+		errs.Add("environment", "must be set")
+	}
+	return errs.Err()
+}
+
+// GetOrganization returns the organization the request was made in.
+func (e *AuditEntry) GetOrganization() string {
+	return e.Organization
+}
+
+// GetEnvironment returns the environment the request was made in.
+func (e *AuditEntry) GetEnvironment() string {
+	return e.Environment
+}
+
+// AuditVerb returns the action requested with the given HTTP method, or an
+// empty string if the method does not modify resources.
+func AuditVerb(method string) string {
+	switch method {
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	}
+	return ""
+}
+
+// AuditOutcome returns the outcome of a request given the HTTP status code of
+// its response.
+func AuditOutcome(status int) string {
+	if status >= http.StatusBadRequest {
+		return AuditOutcomeFailure
+	}
+	return AuditOutcomeSuccess
+}
+
+// FixtureAuditEntry returns a testing fixture for an AuditEntry.
+func FixtureAuditEntry(id string) *AuditEntry {
+	return &AuditEntry{
+		ID:           id,
+		User:         "admin",
+		Verb:         "create",
+		Resource:     "/checks",
+		Organization: "default",
+		Environment:  "default",
+		Outcome:      AuditOutcomeSuccess,
+		Status:       http.StatusNoContent,
+		Timestamp:    1500000000,
+	}
+}
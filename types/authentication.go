@@ -1,25 +1,25 @@
 package types
 
 import (
-	"errors"
 	"time"
 )
 
 // Validate returns an error if the tokens contain invalid values.
 func (t *Tokens) Validate() error {
+	var errs FieldErrors
 	if t.Access == "" {
-		return errors.New("Access token cannot be empty")
+		errs.Add("access_token", "cannot be empty")
 	}
 
 	if t.ExpiresAt == 0 {
-		return errors.New("Expiration must be set")
+		errs.Add("expires_at", "must be set")
 	}
 
 	if t.Refresh == "" {
-		return errors.New("Refresh token cannot be empty")
+		errs.Add("refresh_token", "cannot be empty")
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // FixtureTokens given an access and refresh tokens returns valid tokens for use
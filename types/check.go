@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
+	"text/template"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
-	"github.com/robfig/cron"
 	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/sensu/sensu-go/util/eval"
 	utilstrings "github.com/sensu/sensu-go/util/strings"
@@ -36,8 +37,24 @@ const OpenTSDBOutputMetricFormat = "opentsdb_line"
 // InfluxDB Line
 const InfluxDBOutputMetricFormat = "influxdb_line"
 
+// StatsdOutputMetricFormat is the accepted string to represent the output metric format of
+// StatsD Line
+const StatsdOutputMetricFormat = "statsd_line"
+
+// JSONOutputMetricFormat is the accepted string to represent the output metric format of
+// a JSON array of objects
+const JSONOutputMetricFormat = "json"
+
+// PrometheusOutputMetricFormat is the accepted string to represent the output metric format of
+// the Prometheus text exposition format
+const PrometheusOutputMetricFormat = "prometheus_text"
+
+// AutoOutputMetricFormat is the accepted string to represent the automatic
+// detection of the output metric format
+const AutoOutputMetricFormat = "auto"
+
 // OutputMetricFormats represents all the accepted output_metric_format's a check can have
-var OutputMetricFormats = []string{NagiosOutputMetricFormat, GraphiteOutputMetricFormat, OpenTSDBOutputMetricFormat, InfluxDBOutputMetricFormat}
+var OutputMetricFormats = []string{NagiosOutputMetricFormat, GraphiteOutputMetricFormat, OpenTSDBOutputMetricFormat, InfluxDBOutputMetricFormat, StatsdOutputMetricFormat, JSONOutputMetricFormat, PrometheusOutputMetricFormat, AutoOutputMetricFormat}
 
 // NewCheck creates a new Check. It copies the fields from CheckConfig that
 // match with Check's fields.
@@ -48,6 +65,8 @@ var OutputMetricFormats = []string{NagiosOutputMetricFormat, GraphiteOutputMetri
 func NewCheck(c *CheckConfig) *Check {
 	check := &Check{
 		Command:              c.Command,
+		CommandArgs:          c.CommandArgs,
+		StdinPayload:         c.StdinPayload,
 		Environment:          c.Environment,
 		Handlers:             c.Handlers,
 		HighFlapThreshold:    c.HighFlapThreshold,
@@ -70,6 +89,18 @@ func NewCheck(c *CheckConfig) *Check {
 		OutputMetricFormat:   c.OutputMetricFormat,
 		OutputMetricHandlers: c.OutputMetricHandlers,
 		EnvVars:              c.EnvVars,
+		OutputMetricTolerant: c.OutputMetricTolerant,
+		Splay:                c.Splay,
+		DependsOn:            c.DependsOn,
+		DedupKey:             c.DedupKey,
+		PrometheusURL:        c.PrometheusURL,
+		MaxOutputSize:        c.MaxOutputSize,
+		DiscardOutput:        c.DiscardOutput,
+		Secrets:              c.Secrets,
+		OutputMetricTags:     c.OutputMetricTags,
+		OutputMetricMapping:  c.OutputMetricMapping,
+		Severities:           c.Severities,
+		ObjectMeta:           c.ObjectMeta,
 	}
 	// Unmarshal extended attributes into a different Check value, so that
 	// we don't accidentally corrupt any of the default values for Check.
@@ -80,64 +111,111 @@ func NewCheck(c *CheckConfig) *Check {
 	return check
 }
 
+// ParseCheckDependency returns the entity and the check of the given check
+// dependency, given as "check" for a check of the same entity, in which case
+// the entity returned is empty, or as "entity/check".
+func ParseCheckDependency(dependency string) (entity, check string, err error) {
+	parts := strings.Split(dependency, "/")
+	switch len(parts) {
+	case 1:
+		check = parts[0]
+	case 2:
+		entity, check = parts[0], parts[1]
+		if err := ValidateName(entity); err != nil {
+			return "", "", fmt.Errorf("dependency %q entity %s", dependency, err)
+		}
+	default:
+		return "", "", fmt.Errorf("dependency %q must be a check or entity/check", dependency)
+	}
+	if err := ValidateName(check); err != nil {
+		return "", "", fmt.Errorf("dependency %q check %s", dependency, err)
+	}
+	return entity, check, nil
+}
+
 // Validate returns an error if the check does not pass validation tests.
 func (c *Check) Validate() error {
-	if err := ValidateName(c.Name); err != nil {
-		return errors.New("check name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(c.Name))
+
 	if c.Cron != "" {
 		if c.Interval > 0 {
-			return errors.New("must only specify either an interval or a cron schedule")
+			errs.Add("cron", "cannot be combined with an interval")
 		}
 
-		if _, err := cron.ParseStandard(c.Cron); err != nil {
-			return errors.New("check cron string is invalid")
-		}
-	} else {
-		if c.Interval < 1 {
-			return errors.New("check interval must be greater than or equal to 1")
+		if _, err := ParseCron(c.Cron); err != nil {
+			errs.Add("cron", "is invalid")
 		}
+	} else if c.Interval < 1 {
+		errs.Add("interval", "must be greater than or equal to 1")
 	}
 
 	if c.Ttl > 0 && c.Ttl <= int64(c.Interval) {
-		return errors.New("ttl must be greater than check interval")
+		errs.Add("ttl", "must be greater than check interval")
 	}
 
-	for _, assetName := range c.RuntimeAssets {
-		if err := ValidateAssetName(assetName); err != nil {
-			return fmt.Errorf("asset's %s", err)
+	if c.Interval > 0 && c.Splay >= c.Interval {
+		errs.Add("splay", "must be lower than check interval")
+	}
+
+	for i, dependency := range c.DependsOn {
+		if _, _, err := ParseCheckDependency(dependency); err != nil {
+			errs.AddError(FieldPath("depends_on", i), err)
 		}
 	}
 
+	if c.DedupKey != "" {
+		if _, err := template.New("dedup_key").Parse(c.DedupKey); err != nil {
+			errs.Add("dedup_key", "is invalid: %s", err)
+		}
+	}
+
+	errs.AddError("command_args", validateCommandArgs(c.CommandArgs, c.Command))
+	if c.StdinPayload != "" && c.Stdin {
+		errs.Add("stdin_payload", "cannot be combined with stdin")
+	}
+
+	if c.PrometheusURL != "" {
+		hasCommand := c.Command != "" || len(c.CommandArgs) > 0
+		errs.AddError("prometheus_url", validatePrometheusURL(c.PrometheusURL, hasCommand))
+	}
+
+	if c.MaxOutputSize < 0 {
+		errs.Add("max_output_size", "must be greater than or equal to 0")
+	}
+
+	for i, assetName := range c.RuntimeAssets {
+		errs.AddError(FieldPath("runtime_assets", i), ValidateAssetName(assetName))
+	}
+
 	// The entity can be empty but can't contain invalid characters (only
 	// alphanumeric string)
 	if c.ProxyEntityID != "" {
-		if err := ValidateName(c.ProxyEntityID); err != nil {
-			return errors.New("proxy entity id " + err.Error())
-		}
+		errs.AddError("proxy_entity_id", ValidateName(c.ProxyEntityID))
 	}
 
 	if c.ProxyRequests != nil {
-		if err := c.ProxyRequests.Validate(); err != nil {
-			return err
-		}
+		errs.AddError("proxy_requests", c.ProxyRequests.Validate())
 	}
 
 	if c.OutputMetricFormat != "" {
 		if err := ValidateOutputMetricFormat(c.OutputMetricFormat); err != nil {
-			return err
+			errs.Add("output_metric_format", "%q is not valid", c.OutputMetricFormat)
 		}
 	}
 
+	errs.AddError("output_metric_tags", ValidateMetricTags(c.OutputMetricTags))
+	errs.AddError("severities", ValidateSeverities(c.Severities))
+
 	if c.LowFlapThreshold != 0 && c.HighFlapThreshold != 0 && c.LowFlapThreshold >= c.HighFlapThreshold {
-		return errors.New("invalid flap thresholds")
+		errs.Add("high_flap_threshold", "must be greater than the low flap threshold")
 	}
 
-	if err := ValidateEnvVars(c.EnvVars); err != nil {
-		return err
-	}
+	errs.AddError("env_vars", ValidateEnvVars(c.EnvVars))
+	errs.AddError("secrets", ValidateSecretReferences(c.Secrets))
+	errs.AddError("subdue", c.Subdue.Validate())
 
-	return c.Subdue.Validate()
+	return errs.Err()
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -170,6 +248,14 @@ func (c *Check) MarshalJSON() ([]byte, error) {
 	return jsoniter.Marshal(clone)
 }
 
+// GetExtendedAttributes gets the serialized ExtendedAttributes of c.
+func (c *Check) GetExtendedAttributes() []byte {
+	if c != nil {
+		return c.ExtendedAttributes
+	}
+	return nil
+}
+
 // SetExtendedAttributes sets the serialized ExtendedAttributes of c.
 func (c *Check) SetExtendedAttributes(e []byte) {
 	c.ExtendedAttributes = e
@@ -210,6 +296,14 @@ func (c *CheckConfig) MarshalJSON() ([]byte, error) {
 	return jsoniter.Marshal(clone)
 }
 
+// GetExtendedAttributes gets the serialized ExtendedAttributes of c.
+func (c *CheckConfig) GetExtendedAttributes() []byte {
+	if c != nil {
+		return c.ExtendedAttributes
+	}
+	return nil
+}
+
 // SetExtendedAttributes sets the serialized ExtendedAttributes of c.
 func (c *CheckConfig) SetExtendedAttributes(e []byte) {
 	c.ExtendedAttributes = e
@@ -222,84 +316,113 @@ func (c *CheckConfig) Get(name string) (interface{}, error) {
 
 // Validate returns an error if the check does not pass validation tests.
 func (c *CheckConfig) Validate() error {
-	if err := ValidateName(c.Name); err != nil {
-		return errors.New("check name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(c.Name))
 
 	if c.Cron != "" {
 		if c.Interval > 0 {
-			return errors.New("must only specify either an interval or a cron schedule")
+			errs.Add("cron", "cannot be combined with an interval")
 		}
 
-		if _, err := cron.ParseStandard(c.Cron); err != nil {
-			return errors.New("check cron string is invalid")
+		if _, err := ParseCron(c.Cron); err != nil {
+			errs.Add("cron", "is invalid")
 		}
 	}
 
 	if c.Interval == 0 && c.Cron == "" {
-		return errors.New("check interval must be greater than 0 or a valid cron schedule must be provided")
+		errs.Add("interval", "must be greater than 0 or a valid cron schedule must be provided")
 	}
 
 	if c.Environment == "" {
-		return errors.New("environment cannot be empty")
+		errs.Add("environment", "cannot be empty")
 	}
 
 	if c.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", "must be set")
 	}
 
 	if c.Ttl > 0 && c.Ttl <= int64(c.Interval) {
-		return errors.New("ttl must be greater than check interval")
+		errs.Add("ttl", "must be greater than check interval")
+	}
+
+	if c.Interval > 0 && c.Splay >= c.Interval {
+		errs.Add("splay", "must be lower than check interval")
 	}
 
-	for _, assetName := range c.RuntimeAssets {
-		if err := ValidateAssetName(assetName); err != nil {
-			return fmt.Errorf("asset's %s", err)
+	for i, dependency := range c.DependsOn {
+		if _, _, err := ParseCheckDependency(dependency); err != nil {
+			errs.AddError(FieldPath("depends_on", i), err)
 		}
 	}
 
+	if c.DedupKey != "" {
+		if _, err := template.New("dedup_key").Parse(c.DedupKey); err != nil {
+			errs.Add("dedup_key", "is invalid: %s", err)
+		}
+	}
+
+	errs.AddError("command_args", validateCommandArgs(c.CommandArgs, c.Command))
+	if c.StdinPayload != "" && c.Stdin {
+		errs.Add("stdin_payload", "cannot be combined with stdin")
+	}
+
+	if c.PrometheusURL != "" {
+		hasCommand := c.Command != "" || len(c.CommandArgs) > 0
+		errs.AddError("prometheus_url", validatePrometheusURL(c.PrometheusURL, hasCommand))
+	}
+
+	if c.MaxOutputSize < 0 {
+		errs.Add("max_output_size", "must be greater than or equal to 0")
+	}
+
+	for i, assetName := range c.RuntimeAssets {
+		errs.AddError(FieldPath("runtime_assets", i), ValidateAssetName(assetName))
+	}
+
 	// The entity can be empty but can't contain invalid characters (only
 	// alphanumeric string)
 	if c.ProxyEntityID != "" {
-		if err := ValidateName(c.ProxyEntityID); err != nil {
-			return errors.New("proxy entity id " + err.Error())
-		}
+		errs.AddError("proxy_entity_id", ValidateName(c.ProxyEntityID))
 	}
 
 	if c.ProxyRequests != nil {
-		if err := c.ProxyRequests.Validate(); err != nil {
-			return err
-		}
+		errs.AddError("proxy_requests", c.ProxyRequests.Validate())
 	}
 
 	if c.OutputMetricFormat != "" {
 		if err := ValidateOutputMetricFormat(c.OutputMetricFormat); err != nil {
-			return err
+			errs.Add("output_metric_format", "%q is not valid", c.OutputMetricFormat)
 		}
 	}
 
+	errs.AddError("output_metric_tags", ValidateMetricTags(c.OutputMetricTags))
+	errs.AddError("severities", ValidateSeverities(c.Severities))
+
 	if c.LowFlapThreshold != 0 && c.HighFlapThreshold != 0 && c.LowFlapThreshold >= c.HighFlapThreshold {
-		return errors.New("invalid flap thresholds")
+		errs.Add("high_flap_threshold", "must be greater than the low flap threshold")
 	}
 
-	if err := ValidateEnvVars(c.EnvVars); err != nil {
-		return err
-	}
+	errs.AddError("env_vars", ValidateEnvVars(c.EnvVars))
+	errs.AddError("secrets", ValidateSecretReferences(c.Secrets))
+	errs.AddError("subdue", c.Subdue.Validate())
 
-	return c.Subdue.Validate()
+	return errs.Err()
 }
 
 // Validate returns an error if the ProxyRequests does not pass validation tests
 func (p *ProxyRequests) Validate() error {
+	var errs FieldErrors
 	if p.SplayCoverage > 100 {
-		return errors.New("proxy request splay coverage must be between 0 and 100")
+		errs.Add("splay_coverage", "must be between 0 and 100")
 	}
 
 	if (p.Splay) && (p.SplayCoverage == 0) {
-		return errors.New("proxy request splay coverage must be greater than 0 if splay is enabled")
+		errs.Add("splay_coverage", "must be greater than 0 if splay is enabled")
 	}
 
-	return eval.ValidateStatements(p.EntityAttributes, false)
+	errs.AddError("entity_attributes", eval.ValidateStatements(p.EntityAttributes, false))
+
+	return errs.Err()
 }
 
 // ValidateOutputMetricFormat returns an error if the string is not a valid metric
@@ -311,6 +434,37 @@ func ValidateOutputMetricFormat(format string) error {
 	return errors.New("output metric format is not valid")
 }
 
+// validatePrometheusURL returns an error if the Prometheus metrics endpoint of
+// a check is not an HTTP URL or if the check also has a command.
+func validatePrometheusURL(prometheusURL string, hasCommand bool) error {
+	if hasCommand {
+		return errors.New("cannot be combined with a command")
+	}
+	u, err := url.Parse(prometheusURL)
+	if err != nil {
+		return fmt.Errorf("is invalid: %s", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("must be an http or https url")
+	}
+	return nil
+}
+
+// validateCommandArgs returns an error if the argument array of a check or hook
+// command is combined with a command, or does not start with an executable.
+func validateCommandArgs(args []string, command string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	if command != "" {
+		return errors.New("cannot be combined with a command")
+	}
+	if strings.TrimSpace(args[0]) == "" {
+		return &FieldError{Field: FieldPath(0), Message: "must be the executable to run"}
+	}
+	return nil
+}
+
 // ByExecuted implements the sort.Interface for []CheckHistory based on the
 // Executed field.
 //
@@ -421,11 +575,33 @@ func (c *Check) URIPath() string {
 	return fmt.Sprintf("/checks/%s", url.PathEscape(c.Name))
 }
 
+// GetObjectMeta implements MetaResource.
+func (c *Check) GetObjectMeta() ObjectMeta {
+	return objectMeta(c.ObjectMeta, c.Name, c.Organization, c.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (c *Check) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&c.Name, &c.Organization, &c.Environment)
+	c.ObjectMeta = meta
+}
+
 // URIPath returns the path component of a CheckConfig URI.
 func (c *CheckConfig) URIPath() string {
 	return fmt.Sprintf("/checks/%s", url.PathEscape(c.Name))
 }
 
+// GetObjectMeta implements MetaResource.
+func (c *CheckConfig) GetObjectMeta() ObjectMeta {
+	return objectMeta(c.ObjectMeta, c.Name, c.Organization, c.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (c *CheckConfig) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&c.Name, &c.Organization, &c.Environment)
+	c.ObjectMeta = meta
+}
+
 //
 // Sorting
 
@@ -8,8 +8,6 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
-import bytes "bytes"
-
 import binary "encoding/binary"
 
 import io "io"
@@ -29,6 +27,9 @@ type CheckRequest struct {
 	Hooks []HookConfig `protobuf:"bytes,3,rep,name=hooks" json:"hooks"`
 	// Issued describes the time in which the check request was issued
 	Issued int64 `protobuf:"varint,4,opt,name=Issued,proto3" json:"issued"`
+	// Secrets are the values of the secrets of the check, resolved by the
+	// backend, as NAME=value environment variables of its command.
+	Secrets []string `protobuf:"bytes,5,rep,name=secrets" json:"secrets,omitempty"`
 }
 
 func (m *CheckRequest) Reset()                    { *m = CheckRequest{} }
@@ -64,6 +65,13 @@ func (m *CheckRequest) GetIssued() int64 {
 	return 0
 }
 
+func (m *CheckRequest) GetSecrets() []string {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
 // A ProxyRequests represents a request to execute a proxy check
 type ProxyRequests struct {
 	// EntityAttributes store serialized arbitrary JSON-encoded data to match
@@ -130,7 +138,7 @@ type CheckConfig struct {
 	// Subscriptions is the list of subscribers for the check.
 	Subscriptions []string `protobuf:"bytes,11,rep,name=subscriptions" json:"subscriptions"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
-	ExtendedAttributes []byte `protobuf:"bytes,12,opt,name=ExtendedAttributes,proto3" json:"-"`
+	ExtendedAttributes Attributes `protobuf:"bytes,12,opt,name=ExtendedAttributes,proto3,customtype=Attributes" json:"-"`
 	// Sources indicates the name of the entity representing an external resource
 	ProxyEntityID string `protobuf:"bytes,13,opt,name=proxy_entity_id,json=proxyEntityId,proto3" json:"proxy_entity_id"`
 	// CheckHooks is the list of check hooks for the check
@@ -139,7 +147,8 @@ type CheckConfig struct {
 	Stdin bool `protobuf:"varint,15,opt,name=stdin,proto3" json:"stdin"`
 	// Subdue represents one or more time windows when the check should be subdued.
 	Subdue *TimeWindowWhen `protobuf:"bytes,16,opt,name=subdue" json:"subdue"`
-	// Cron is the cron string at which the check should be run.
+	// Cron is the cron string at which the check should be run, optionally
+	// prefixed by its time zone, e.g. "CRON_TZ=Europe/Paris 0 9 * * *".
 	Cron string `protobuf:"bytes,17,opt,name=cron,proto3" json:"cron,omitempty"`
 	// TTL represents the length of time in seconds for which a check result is valid.
 	Ttl int64 `protobuf:"varint,18,opt,name=ttl,proto3" json:"ttl"`
@@ -158,6 +167,54 @@ type CheckConfig struct {
 	// EnvVars is the list of environment variables to set for the check's
 	// execution environment.
 	EnvVars []string `protobuf:"bytes,24,rep,name=env_vars,json=envVars" json:"env_vars"`
+	// OutputMetricTolerant indicates if the metrics that can't be parsed should
+	// be skipped instead of failing the whole metric extraction.
+	OutputMetricTolerant bool `protobuf:"varint,25,opt,name=output_metric_tolerant,json=outputMetricTolerant,proto3" json:"output_metric_tolerant"`
+	// OutputMetricTags is the list of tags added to every metric point
+	// extracted from the check output. Their values support token substitution.
+	OutputMetricTags []*MetricTag `protobuf:"bytes,26,rep,name=output_metric_tags,json=outputMetricTags" json:"output_metric_tags,omitempty"`
+	// OutputMetricMapping describes how metric points are extracted from the
+	// check output when using the json output metric format.
+	OutputMetricMapping *MetricMapping `protobuf:"bytes,27,opt,name=output_metric_mapping,json=outputMetricMapping" json:"output_metric_mapping,omitempty"`
+	// Splay is the maximum number of seconds the agents delay the execution of
+	// the check by, each with an offset determined by its entity, to spread the
+	// executions of the subscribed agents over time.
+	Splay uint32 `protobuf:"varint,28,opt,name=splay,proto3" json:"splay,omitempty"`
+	// DependsOn lists the checks this check depends on, as "check" for a check
+	// of the same entity or "entity/check". The handling of the failures of the
+	// check is suppressed while one of its dependencies is failing.
+	DependsOn []string `protobuf:"bytes,29,rep,name=depends_on,json=dependsOn" json:"depends_on,omitempty"`
+	// DedupKey is a template rendered with each event of the check, as
+	// "{{ .Check.ProxyEntityID }}" for instance. The events with the same
+	// rendered key are collapsed into a single event stream, whichever their
+	// entity.
+	DedupKey string `protobuf:"bytes,30,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`
+	// PrometheusURL is the URL of a Prometheus metrics endpoint the agents
+	// scrape, instead of executing a command, to extract the metrics of the
+	// check.
+	PrometheusURL string `protobuf:"bytes,31,opt,name=prometheus_url,json=prometheusUrl,proto3" json:"prometheus_url,omitempty"`
+	// MaxOutputSize is the maximum size, in bytes, of the output of the check
+	// kept by the agents, which truncate longer outputs. The output is not
+	// truncated if 0.
+	MaxOutputSize int64 `protobuf:"varint,32,opt,name=max_output_size,json=maxOutputSize,proto3" json:"max_output_size,omitempty"`
+	// DiscardOutput indicates if the agents discard the output of the check,
+	// once its metrics are extracted.
+	DiscardOutput bool `protobuf:"varint,33,opt,name=discard_output,json=discardOutput,proto3" json:"discard_output,omitempty"`
+	// Secrets are the secrets exposed to the check command, as environment
+	// variables.
+	Secrets []SecretReference `protobuf:"bytes,35,rep,name=secrets" json:"secrets,omitempty"`
+	// Severities map the exit statuses of the check, as strings, to custom
+	// severities, overriding the default severities of their status.
+	Severities map[string]string `protobuf:"bytes,36,rep,name=severities" json:"severities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// CommandArgs are the executable to run and its arguments, executed without
+	// a shell instead of Command. Arguments may contain spaces, quotes or
+	// newlines without being escaped.
+	CommandArgs []string `protobuf:"bytes,37,rep,name=command_args,json=commandArgs" json:"command_args,omitempty"`
+	// StdinPayload is written to the standard input of the command. It cannot be
+	// combined with Stdin, which writes the event to it.
+	StdinPayload string `protobuf:"bytes,38,opt,name=stdin_payload,json=stdinPayload,proto3" json:"stdin_payload,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the check
+	ObjectMeta `protobuf:"bytes,34,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *CheckConfig) Reset()                    { *m = CheckConfig{} }
@@ -242,13 +299,6 @@ func (m *CheckConfig) GetSubscriptions() []string {
 	return nil
 }
 
-func (m *CheckConfig) GetExtendedAttributes() []byte {
-	if m != nil {
-		return m.ExtendedAttributes
-	}
-	return nil
-}
-
 func (m *CheckConfig) GetProxyEntityID() string {
 	if m != nil {
 		return m.ProxyEntityID
@@ -333,6 +383,97 @@ func (m *CheckConfig) GetEnvVars() []string {
 	return nil
 }
 
+func (m *CheckConfig) GetOutputMetricTolerant() bool {
+	if m != nil {
+		return m.OutputMetricTolerant
+	}
+	return false
+}
+
+func (m *CheckConfig) GetOutputMetricTags() []*MetricTag {
+	if m != nil {
+		return m.OutputMetricTags
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetOutputMetricMapping() *MetricMapping {
+	if m != nil {
+		return m.OutputMetricMapping
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetSplay() uint32 {
+	if m != nil {
+		return m.Splay
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetDedupKey() string {
+	if m != nil {
+		return m.DedupKey
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetPrometheusURL() string {
+	if m != nil {
+		return m.PrometheusURL
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetMaxOutputSize() int64 {
+	if m != nil {
+		return m.MaxOutputSize
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetDiscardOutput() bool {
+	if m != nil {
+		return m.DiscardOutput
+	}
+	return false
+}
+
+func (m *CheckConfig) GetSecrets() []SecretReference {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetSeverities() map[string]string {
+	if m != nil {
+		return m.Severities
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetCommandArgs() []string {
+	if m != nil {
+		return m.CommandArgs
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetStdinPayload() string {
+	if m != nil {
+		return m.StdinPayload
+	}
+	return ""
+}
+
 // A Check is a check specification and optionally the results of the check's
 // execution.
 type Check struct {
@@ -368,7 +509,8 @@ type Check struct {
 	Stdin bool `protobuf:"varint,15,opt,name=stdin,proto3" json:"stdin"`
 	// Subdue represents one or more time windows when the check should be subdued.
 	Subdue *TimeWindowWhen `protobuf:"bytes,16,opt,name=subdue" json:"subdue"`
-	// Cron is the cron string at which the check should be run.
+	// Cron is the cron string at which the check should be run, optionally
+	// prefixed by its time zone, e.g. "CRON_TZ=Europe/Paris 0 9 * * *".
 	Cron string `protobuf:"bytes,17,opt,name=cron,proto3" json:"cron,omitempty"`
 	// TTL represents the length of time in seconds for which a check result is valid.
 	Ttl int64 `protobuf:"varint,18,opt,name=ttl,proto3" json:"ttl"`
@@ -414,8 +556,58 @@ type Check struct {
 	// EnvVars is the list of environment variables to set for the check's
 	// execution environment.
 	EnvVars []string `protobuf:"bytes,37,rep,name=env_vars,json=envVars" json:"env_vars"`
+	// OutputMetricTolerant indicates if the metrics that can't be parsed should
+	// be skipped instead of failing the whole metric extraction.
+	OutputMetricTolerant bool `protobuf:"varint,38,opt,name=output_metric_tolerant,json=outputMetricTolerant,proto3" json:"output_metric_tolerant"`
+	// OutputMetricTags is the list of tags added to every metric point
+	// extracted from the check output. Their values support token substitution.
+	OutputMetricTags []*MetricTag `protobuf:"bytes,39,rep,name=output_metric_tags,json=outputMetricTags" json:"output_metric_tags,omitempty"`
+	// OutputMetricMapping describes how metric points are extracted from the
+	// check output when using the json output metric format.
+	OutputMetricMapping *MetricMapping `protobuf:"bytes,40,opt,name=output_metric_mapping,json=outputMetricMapping" json:"output_metric_mapping,omitempty"`
+	// PrometheusURL is the URL of a Prometheus metrics endpoint the agents
+	// scrape, instead of executing a command, to extract the metrics of the
+	// check.
+	PrometheusURL string `protobuf:"bytes,44,opt,name=prometheus_url,json=prometheusUrl,proto3" json:"prometheus_url,omitempty"`
+	// MaxOutputSize is the maximum size, in bytes, of the output of the check
+	// kept by the agents, which truncate longer outputs. The output is not
+	// truncated if 0.
+	MaxOutputSize int64 `protobuf:"varint,45,opt,name=max_output_size,json=maxOutputSize,proto3" json:"max_output_size,omitempty"`
+	// DiscardOutput indicates if the agents discard the output of the check,
+	// once its metrics are extracted.
+	DiscardOutput bool `protobuf:"varint,46,opt,name=discard_output,json=discardOutput,proto3" json:"discard_output,omitempty"`
+	// Secrets are the secrets exposed to the check command, as environment
+	// variables.
+	Secrets []SecretReference `protobuf:"bytes,48,rep,name=secrets" json:"secrets,omitempty"`
+	// Severities map the exit statuses of the check, as strings, to custom
+	// severities, overriding the default severities of their status.
+	Severities map[string]string `protobuf:"bytes,49,rep,name=severities" json:"severities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Severity is the severity of the status of the check result.
+	Severity string `protobuf:"bytes,50,opt,name=severity,proto3" json:"severity,omitempty"`
+	// CommandArgs are the executable to run and its arguments, executed without
+	// a shell instead of Command. Arguments may contain spaces, quotes or
+	// newlines without being escaped.
+	CommandArgs []string `protobuf:"bytes,51,rep,name=command_args,json=commandArgs" json:"command_args,omitempty"`
+	// StdinPayload is written to the standard input of the command. It cannot be
+	// combined with Stdin, which writes the event to it.
+	StdinPayload string `protobuf:"bytes,52,opt,name=stdin_payload,json=stdinPayload,proto3" json:"stdin_payload,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
-	ExtendedAttributes []byte `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3" json:"-"`
+	ExtendedAttributes Attributes `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3,customtype=Attributes" json:"-"`
+	// Splay is the maximum number of seconds the agents delay the execution of
+	// the check by, each with an offset determined by its entity, to spread the
+	// executions of the subscribed agents over time.
+	Splay uint32 `protobuf:"varint,41,opt,name=splay,proto3" json:"splay,omitempty"`
+	// DependsOn lists the checks this check depends on, as "check" for a check
+	// of the same entity or "entity/check". The handling of the failures of the
+	// check is suppressed while one of its dependencies is failing.
+	DependsOn []string `protobuf:"bytes,42,rep,name=depends_on,json=dependsOn" json:"depends_on,omitempty"`
+	// DedupKey is a template rendered with each event of the check, as
+	// "{{ .Check.ProxyEntityID }}" for instance. The events with the same
+	// rendered key are collapsed into a single event stream, whichever their
+	// entity.
+	DedupKey string `protobuf:"bytes,43,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the check
+	ObjectMeta `protobuf:"bytes,47,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Check) Reset()                    { *m = Check{} }
@@ -675,13 +867,104 @@ func (m *Check) GetEnvVars() []string {
 	return nil
 }
 
-func (m *Check) GetExtendedAttributes() []byte {
+func (m *Check) GetOutputMetricTolerant() bool {
+	if m != nil {
+		return m.OutputMetricTolerant
+	}
+	return false
+}
+
+func (m *Check) GetOutputMetricTags() []*MetricTag {
+	if m != nil {
+		return m.OutputMetricTags
+	}
+	return nil
+}
+
+func (m *Check) GetOutputMetricMapping() *MetricMapping {
+	if m != nil {
+		return m.OutputMetricMapping
+	}
+	return nil
+}
+
+func (m *Check) GetPrometheusURL() string {
+	if m != nil {
+		return m.PrometheusURL
+	}
+	return ""
+}
+
+func (m *Check) GetMaxOutputSize() int64 {
+	if m != nil {
+		return m.MaxOutputSize
+	}
+	return 0
+}
+
+func (m *Check) GetDiscardOutput() bool {
+	if m != nil {
+		return m.DiscardOutput
+	}
+	return false
+}
+
+func (m *Check) GetSplay() uint32 {
+	if m != nil {
+		return m.Splay
+	}
+	return 0
+}
+
+func (m *Check) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *Check) GetDedupKey() string {
+	if m != nil {
+		return m.DedupKey
+	}
+	return ""
+}
+
+func (m *Check) GetSecrets() []SecretReference {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+func (m *Check) GetSeverities() map[string]string {
 	if m != nil {
-		return m.ExtendedAttributes
+		return m.Severities
 	}
 	return nil
 }
 
+func (m *Check) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *Check) GetCommandArgs() []string {
+	if m != nil {
+		return m.CommandArgs
+	}
+	return nil
+}
+
+func (m *Check) GetStdinPayload() string {
+	if m != nil {
+		return m.StdinPayload
+	}
+	return ""
+}
+
 // CheckHistory is a record of a check execution and its status
 type CheckHistory struct {
 	// Status is the exit status code produced by the check.
@@ -709,12 +992,59 @@ func (m *CheckHistory) GetExecuted() int64 {
 	return 0
 }
 
+// A MetricMapping describes how metric points are extracted from the objects of
+// a JSON array.
+type MetricMapping struct {
+	// NameField is the field holding the metric point name.
+	NameField string `protobuf:"bytes,1,opt,name=name_field,json=nameField,proto3" json:"name_field,omitempty"`
+	// ValueField is the field holding the metric point value.
+	ValueField string `protobuf:"bytes,2,opt,name=value_field,json=valueField,proto3" json:"value_field,omitempty"`
+	// TimestampField is the field holding the metric point timestamp.
+	TimestampField string `protobuf:"bytes,3,opt,name=timestamp_field,json=timestampField,proto3" json:"timestamp_field,omitempty"`
+	// TagFields is the list of fields added as metric point tags.
+	TagFields []string `protobuf:"bytes,4,rep,name=tag_fields,json=tagFields" json:"tag_fields,omitempty"`
+}
+
+func (m *MetricMapping) Reset()                    { *m = MetricMapping{} }
+func (m *MetricMapping) String() string            { return proto.CompactTextString(m) }
+func (*MetricMapping) ProtoMessage()               {}
+func (*MetricMapping) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{5} }
+
+func (m *MetricMapping) GetNameField() string {
+	if m != nil {
+		return m.NameField
+	}
+	return ""
+}
+
+func (m *MetricMapping) GetValueField() string {
+	if m != nil {
+		return m.ValueField
+	}
+	return ""
+}
+
+func (m *MetricMapping) GetTimestampField() string {
+	if m != nil {
+		return m.TimestampField
+	}
+	return ""
+}
+
+func (m *MetricMapping) GetTagFields() []string {
+	if m != nil {
+		return m.TagFields
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CheckRequest)(nil), "sensu.types.CheckRequest")
 	proto.RegisterType((*ProxyRequests)(nil), "sensu.types.ProxyRequests")
 	proto.RegisterType((*CheckConfig)(nil), "sensu.types.CheckConfig")
 	proto.RegisterType((*Check)(nil), "sensu.types.Check")
 	proto.RegisterType((*CheckHistory)(nil), "sensu.types.CheckHistory")
+	proto.RegisterType((*MetricMapping)(nil), "sensu.types.MetricMapping")
 }
 func (this *CheckRequest) Equal(that interface{}) bool {
 	if that == nil {
@@ -757,6 +1087,14 @@ func (this *CheckRequest) Equal(that interface{}) bool {
 	if this.Issued != that1.Issued {
 		return false
 	}
+	if len(this.Secrets) != len(that1.Secrets) {
+		return false
+	}
+	for i := range this.Secrets {
+		if this.Secrets[i] != that1.Secrets[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ProxyRequests) Equal(that interface{}) bool {
@@ -861,7 +1199,7 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
+	if !this.ExtendedAttributes.Equal(that1.ExtendedAttributes) {
 		return false
 	}
 	if this.ProxyEntityID != that1.ProxyEntityID {
@@ -915,6 +1253,73 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.OutputMetricTolerant != that1.OutputMetricTolerant {
+		return false
+	}
+	if len(this.OutputMetricTags) != len(that1.OutputMetricTags) {
+		return false
+	}
+	for i := range this.OutputMetricTags {
+		if !this.OutputMetricTags[i].Equal(that1.OutputMetricTags[i]) {
+			return false
+		}
+	}
+	if !this.OutputMetricMapping.Equal(that1.OutputMetricMapping) {
+		return false
+	}
+	if this.Splay != that1.Splay {
+		return false
+	}
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
+	}
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
+	}
+	if this.DedupKey != that1.DedupKey {
+		return false
+	}
+	if this.PrometheusURL != that1.PrometheusURL {
+		return false
+	}
+	if this.MaxOutputSize != that1.MaxOutputSize {
+		return false
+	}
+	if this.DiscardOutput != that1.DiscardOutput {
+		return false
+	}
+	if len(this.Secrets) != len(that1.Secrets) {
+		return false
+	}
+	for i := range this.Secrets {
+		if !this.Secrets[i].Equal(&that1.Secrets[i]) {
+			return false
+		}
+	}
+	if len(this.Severities) != len(that1.Severities) {
+		return false
+	}
+	for i := range this.Severities {
+		if this.Severities[i] != that1.Severities[i] {
+			return false
+		}
+	}
+	if len(this.CommandArgs) != len(that1.CommandArgs) {
+		return false
+	}
+	for i := range this.CommandArgs {
+		if this.CommandArgs[i] != that1.CommandArgs[i] {
+			return false
+		}
+	}
+	if this.StdinPayload != that1.StdinPayload {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (this *Check) Equal(that interface{}) bool {
@@ -1089,59 +1494,167 @@ func (this *Check) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
+	if this.OutputMetricTolerant != that1.OutputMetricTolerant {
 		return false
 	}
-	return true
-}
-func (this *CheckHistory) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if len(this.OutputMetricTags) != len(that1.OutputMetricTags) {
+		return false
 	}
-
-	that1, ok := that.(*CheckHistory)
-	if !ok {
-		that2, ok := that.(CheckHistory)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.OutputMetricTags {
+		if !this.OutputMetricTags[i].Equal(that1.OutputMetricTags[i]) {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if !this.OutputMetricMapping.Equal(that1.OutputMetricMapping) {
 		return false
 	}
-	if this.Status != that1.Status {
+	if this.PrometheusURL != that1.PrometheusURL {
 		return false
 	}
-	if this.Executed != that1.Executed {
+	if this.MaxOutputSize != that1.MaxOutputSize {
 		return false
 	}
-	return true
-}
-func (m *CheckRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.DiscardOutput != that1.DiscardOutput {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CheckRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Config != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCheck(dAtA, i, uint64(m.Config.Size()))
-		n1, err := m.Config.MarshalTo(dAtA[i:])
-		if err != nil {
+	if len(this.Secrets) != len(that1.Secrets) {
+		return false
+	}
+	for i := range this.Secrets {
+		if !this.Secrets[i].Equal(&that1.Secrets[i]) {
+			return false
+		}
+	}
+	if len(this.Severities) != len(that1.Severities) {
+		return false
+	}
+	for i := range this.Severities {
+		if this.Severities[i] != that1.Severities[i] {
+			return false
+		}
+	}
+	if this.Severity != that1.Severity {
+		return false
+	}
+	if len(this.CommandArgs) != len(that1.CommandArgs) {
+		return false
+	}
+	for i := range this.CommandArgs {
+		if this.CommandArgs[i] != that1.CommandArgs[i] {
+			return false
+		}
+	}
+	if this.StdinPayload != that1.StdinPayload {
+		return false
+	}
+	if !this.ExtendedAttributes.Equal(that1.ExtendedAttributes) {
+		return false
+	}
+	if this.Splay != that1.Splay {
+		return false
+	}
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
+	}
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
+	}
+	if this.DedupKey != that1.DedupKey {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	return true
+}
+func (this *CheckHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckHistory)
+	if !ok {
+		that2, ok := that.(CheckHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Executed != that1.Executed {
+		return false
+	}
+	return true
+}
+func (this *MetricMapping) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MetricMapping)
+	if !ok {
+		that2, ok := that.(MetricMapping)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NameField != that1.NameField {
+		return false
+	}
+	if this.ValueField != that1.ValueField {
+		return false
+	}
+	if this.TimestampField != that1.TimestampField {
+		return false
+	}
+	if len(this.TagFields) != len(that1.TagFields) {
+		return false
+	}
+	for i := range this.TagFields {
+		if this.TagFields[i] != that1.TagFields[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *CheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Config.Size()))
+		n1, err := m.Config.MarshalTo(dAtA[i:])
+		if err != nil {
 			return 0, err
 		}
 		i += n1
@@ -1175,6 +1688,21 @@ func (m *CheckRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCheck(dAtA, i, uint64(m.Issued))
 	}
+	if len(m.Secrets) > 0 {
+		for _, s := range m.Secrets {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1335,12 +1863,14 @@ func (m *CheckConfig) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.ExtendedAttributes) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintCheck(dAtA, i, uint64(len(m.ExtendedAttributes)))
-		i += copy(dAtA[i:], m.ExtendedAttributes)
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintCheck(dAtA, i, uint64(m.ExtendedAttributes.Size()))
+	n10, err := m.ExtendedAttributes.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n10
 	if len(m.ProxyEntityID) > 0 {
 		dAtA[i] = 0x6a
 		i++
@@ -1469,6 +1999,171 @@ func (m *CheckConfig) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.OutputMetricTolerant {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.OutputMetricTolerant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.OutputMetricTags) > 0 {
+		for _, msg := range m.OutputMetricTags {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OutputMetricMapping != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.OutputMetricMapping.Size()))
+		n6, err := m.OutputMetricMapping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Splay != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Splay))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DedupKey) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.DedupKey)))
+		i += copy(dAtA[i:], m.DedupKey)
+	}
+	if len(m.PrometheusURL) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.PrometheusURL)))
+		i += copy(dAtA[i:], m.PrometheusURL)
+	}
+	if m.MaxOutputSize != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxOutputSize))
+	}
+	if m.DiscardOutput {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.DiscardOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Secrets) > 0 {
+		for _, msg := range m.Secrets {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Severities) > 0 {
+		for k, _ := range m.Severities {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x2
+			i++
+			v := m.Severities[k]
+			mapSize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			i = encodeVarintCheck(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.CommandArgs) > 0 {
+		for _, s := range m.CommandArgs {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.StdinPayload) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.StdinPayload)))
+		i += copy(dAtA[i:], m.StdinPayload)
+	}
+	dAtA[i] = 0x92
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintCheck(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n8, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
 	return i, nil
 }
 
@@ -1827,25 +2522,200 @@ func (m *Check) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.ExtendedAttributes) > 0 {
-		dAtA[i] = 0x9a
+	if m.OutputMetricTolerant {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x2
 		i++
-		dAtA[i] = 0x6
+		if m.OutputMetricTolerant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintCheck(dAtA, i, uint64(len(m.ExtendedAttributes)))
-		i += copy(dAtA[i:], m.ExtendedAttributes)
-	}
-	return i, nil
-}
-
-func (m *CheckHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
+	if len(m.OutputMetricTags) > 0 {
+		for _, msg := range m.OutputMetricTags {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OutputMetricMapping != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.OutputMetricMapping.Size()))
+		n7, err := m.OutputMetricMapping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.PrometheusURL) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.PrometheusURL)))
+		i += copy(dAtA[i:], m.PrometheusURL)
+	}
+	if m.MaxOutputSize != 0 {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxOutputSize))
+	}
+	if m.DiscardOutput {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.DiscardOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Secrets) > 0 {
+		for _, msg := range m.Secrets {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x3
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Severities) > 0 {
+		for k, _ := range m.Severities {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x3
+			i++
+			v := m.Severities[k]
+			mapSize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			i = encodeVarintCheck(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Severity) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Severity)))
+		i += copy(dAtA[i:], m.Severity)
+	}
+	if len(m.CommandArgs) > 0 {
+		for _, s := range m.CommandArgs {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.StdinPayload) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.StdinPayload)))
+		i += copy(dAtA[i:], m.StdinPayload)
+	}
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x6
+	i++
+	i = encodeVarintCheck(dAtA, i, uint64(m.ExtendedAttributes.Size()))
+	n11, err := m.ExtendedAttributes.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n11
+	if m.Splay != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Splay))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DedupKey) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.DedupKey)))
+		i += copy(dAtA[i:], m.DedupKey)
+	}
+	dAtA[i] = 0xfa
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintCheck(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n9, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	return i, nil
+}
+
+func (m *CheckHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *CheckHistory) MarshalTo(dAtA []byte) (int, error) {
@@ -1866,6 +2736,57 @@ func (m *CheckHistory) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MetricMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricMapping) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NameField) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.NameField)))
+		i += copy(dAtA[i:], m.NameField)
+	}
+	if len(m.ValueField) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.ValueField)))
+		i += copy(dAtA[i:], m.ValueField)
+	}
+	if len(m.TimestampField) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.TimestampField)))
+		i += copy(dAtA[i:], m.TimestampField)
+	}
+	if len(m.TagFields) > 0 {
+		for _, s := range m.TagFields {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintCheck(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1900,6 +2821,11 @@ func NewPopulatedCheckRequest(r randyCheck, easy bool) *CheckRequest {
 	if r.Intn(2) == 0 {
 		this.Issued *= -1
 	}
+	v39 := r.Intn(10)
+	this.Secrets = make([]string, v39)
+	for i := 0; i < v39; i++ {
+		this.Secrets[i] = string(randStringCheck(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1944,11 +2870,8 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	for i := 0; i < v8; i++ {
 		this.Subscriptions[i] = string(randStringCheck(r))
 	}
-	v9 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.ExtendedAttributes[i] = byte(r.Intn(256))
-	}
+	v9 := NewPopulatedAttributes(r)
+	this.ExtendedAttributes = *v9
 	this.ProxyEntityID = string(randStringCheck(r))
 	if r.Intn(10) != 0 {
 		v10 := r.Intn(5)
@@ -1983,6 +2906,53 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	for i := 0; i < v13; i++ {
 		this.EnvVars[i] = string(randStringCheck(r))
 	}
+	this.OutputMetricTolerant = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v28 := r.Intn(5)
+		this.OutputMetricTags = make([]*MetricTag, v28)
+		for i := 0; i < v28; i++ {
+			this.OutputMetricTags[i] = NewPopulatedMetricTag(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.OutputMetricMapping = NewPopulatedMetricMapping(r, easy)
+	}
+	this.Splay = uint32(r.Uint32())
+	v31 := r.Intn(10)
+	this.DependsOn = make([]string, v31)
+	for i := 0; i < v31; i++ {
+		this.DependsOn[i] = string(randStringCheck(r))
+	}
+	this.DedupKey = string(randStringCheck(r))
+	this.PrometheusURL = string(randStringCheck(r))
+	this.MaxOutputSize = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxOutputSize *= -1
+	}
+	this.DiscardOutput = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v35 := r.Intn(5)
+		this.Secrets = make([]SecretReference, v35)
+		for i := 0; i < v35; i++ {
+			v36 := NewPopulatedSecretReference(r, easy)
+			this.Secrets[i] = *v36
+		}
+	}
+	if r.Intn(10) != 0 {
+		v40 := r.Intn(10)
+		this.Severities = make(map[string]string)
+		for i := 0; i < v40; i++ {
+			this.Severities[randStringCheck(r)] = randStringCheck(r)
+		}
+	}
+	v42 := r.Intn(10)
+	this.CommandArgs = make([]string, v42)
+	for i := 0; i < v42; i++ {
+		this.CommandArgs[i] = string(randStringCheck(r))
+	}
+	this.StdinPayload = string(randStringCheck(r))
+	v33 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v33
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2095,11 +3065,56 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	for i := 0; i < v24; i++ {
 		this.EnvVars[i] = string(randStringCheck(r))
 	}
-	v25 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v25)
-	for i := 0; i < v25; i++ {
-		this.ExtendedAttributes[i] = byte(r.Intn(256))
+	this.OutputMetricTolerant = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v29 := r.Intn(5)
+		this.OutputMetricTags = make([]*MetricTag, v29)
+		for i := 0; i < v29; i++ {
+			this.OutputMetricTags[i] = NewPopulatedMetricTag(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.OutputMetricMapping = NewPopulatedMetricMapping(r, easy)
+	}
+	this.PrometheusURL = string(randStringCheck(r))
+	this.MaxOutputSize = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxOutputSize *= -1
+	}
+	this.DiscardOutput = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v37 := r.Intn(5)
+		this.Secrets = make([]SecretReference, v37)
+		for i := 0; i < v37; i++ {
+			v38 := NewPopulatedSecretReference(r, easy)
+			this.Secrets[i] = *v38
+		}
+	}
+	if r.Intn(10) != 0 {
+		v41 := r.Intn(10)
+		this.Severities = make(map[string]string)
+		for i := 0; i < v41; i++ {
+			this.Severities[randStringCheck(r)] = randStringCheck(r)
+		}
 	}
+	this.Severity = string(randStringCheck(r))
+	v43 := r.Intn(10)
+	this.CommandArgs = make([]string, v43)
+	for i := 0; i < v43; i++ {
+		this.CommandArgs[i] = string(randStringCheck(r))
+	}
+	this.StdinPayload = string(randStringCheck(r))
+	v25 := NewPopulatedAttributes(r)
+	this.ExtendedAttributes = *v25
+	this.Splay = uint32(r.Uint32())
+	v32 := r.Intn(10)
+	this.DependsOn = make([]string, v32)
+	for i := 0; i < v32; i++ {
+		this.DependsOn[i] = string(randStringCheck(r))
+	}
+	this.DedupKey = string(randStringCheck(r))
+	v34 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v34
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2117,6 +3132,21 @@ func NewPopulatedCheckHistory(r randyCheck, easy bool) *CheckHistory {
 	return this
 }
 
+func NewPopulatedMetricMapping(r randyCheck, easy bool) *MetricMapping {
+	this := &MetricMapping{}
+	this.NameField = string(randStringCheck(r))
+	this.ValueField = string(randStringCheck(r))
+	this.TimestampField = string(randStringCheck(r))
+	v30 := r.Intn(10)
+	this.TagFields = make([]string, v30)
+	for i := 0; i < v30; i++ {
+		this.TagFields[i] = string(randStringCheck(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyCheck interface {
 	Float32() float32
 	Float64() float64
@@ -2211,6 +3241,12 @@ func (m *CheckRequest) Size() (n int) {
 	if m.Issued != 0 {
 		n += 1 + sovCheck(uint64(m.Issued))
 	}
+	if len(m.Secrets) > 0 {
+		for _, s := range m.Secrets {
+			l = len(s)
+			n += 1 + l + sovCheck(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2281,10 +3317,8 @@ func (m *CheckConfig) Size() (n int) {
 			n += 1 + l + sovCheck(uint64(l))
 		}
 	}
-	l = len(m.ExtendedAttributes)
-	if l > 0 {
-		n += 1 + l + sovCheck(uint64(l))
-	}
+	l = m.ExtendedAttributes.Size()
+	n += 1 + l + sovCheck(uint64(l))
 	l = len(m.ProxyEntityID)
 	if l > 0 {
 		n += 1 + l + sovCheck(uint64(l))
@@ -2335,6 +3369,68 @@ func (m *CheckConfig) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.OutputMetricTolerant {
+		n += 3
+	}
+	if len(m.OutputMetricTags) > 0 {
+		for _, e := range m.OutputMetricTags {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.OutputMetricMapping != nil {
+		l = m.OutputMetricMapping.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.Splay != 0 {
+		n += 2 + sovCheck(uint64(m.Splay))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.DedupKey)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.PrometheusURL)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.MaxOutputSize != 0 {
+		n += 2 + sovCheck(uint64(m.MaxOutputSize))
+	}
+	if m.DiscardOutput {
+		n += 3
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if len(m.Severities) > 0 {
+		for k, v := range m.Severities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			n += mapEntrySize + 2 + sovCheck(uint64(mapEntrySize))
+		}
+	}
+	if len(m.CommandArgs) > 0 {
+		for _, s := range m.CommandArgs {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.StdinPayload)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = m.ObjectMeta.Size()
+	n += 2 + l + sovCheck(uint64(l))
 	return n
 }
 
@@ -2487,32 +3583,120 @@ func (m *Check) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
-	l = len(m.ExtendedAttributes)
+	if m.OutputMetricTolerant {
+		n += 3
+	}
+	if len(m.OutputMetricTags) > 0 {
+		for _, e := range m.OutputMetricTags {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.OutputMetricMapping != nil {
+		l = m.OutputMetricMapping.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.PrometheusURL)
 	if l > 0 {
 		n += 2 + l + sovCheck(uint64(l))
 	}
-	return n
-}
-
-func (m *CheckHistory) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovCheck(uint64(m.Status))
+	if m.MaxOutputSize != 0 {
+		n += 2 + sovCheck(uint64(m.MaxOutputSize))
 	}
-	if m.Executed != 0 {
-		n += 1 + sovCheck(uint64(m.Executed))
+	if m.DiscardOutput {
+		n += 3
 	}
-	return n
-}
-
-func sovCheck(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if len(m.Severities) > 0 {
+		for k, v := range m.Severities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			n += mapEntrySize + 2 + sovCheck(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Severity)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if len(m.CommandArgs) > 0 {
+		for _, s := range m.CommandArgs {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.StdinPayload)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = m.ExtendedAttributes.Size()
+	n += 2 + l + sovCheck(uint64(l))
+	if m.Splay != 0 {
+		n += 2 + sovCheck(uint64(m.Splay))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.DedupKey)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = m.ObjectMeta.Size()
+	n += 2 + l + sovCheck(uint64(l))
+	return n
+}
+
+func (m *CheckHistory) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovCheck(uint64(m.Status))
+	}
+	if m.Executed != 0 {
+		n += 1 + sovCheck(uint64(m.Executed))
+	}
+	return n
+}
+
+func (m *MetricMapping) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NameField)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	l = len(m.ValueField)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	l = len(m.TimestampField)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if len(m.TagFields) > 0 {
+		for _, s := range m.TagFields {
+			l = len(s)
+			n += 1 + l + sovCheck(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCheck(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
 	}
 	return n
 }
@@ -2662,6 +3846,35 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -3136,9 +4349,8 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedAttributes = append(m.ExtendedAttributes[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExtendedAttributes == nil {
-				m.ExtendedAttributes = []byte{}
+			if err := m.ExtendedAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 13:
@@ -3461,61 +4673,11 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCheck(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCheck
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Check) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCheck
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Check: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricTolerant", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3525,26 +4687,17 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Command = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.OutputMetricTolerant = bool(v != 0)
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricTags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3554,26 +4707,28 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Environment = string(dAtA[iNdEx:postIndex])
+			m.OutputMetricTags = append(m.OutputMetricTags, &MetricTag{})
+			if err := m.OutputMetricTags[len(m.OutputMetricTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricMapping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3583,26 +4738,30 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			if m.OutputMetricMapping == nil {
+				m.OutputMetricMapping = &MetricMapping{}
+			}
+			if err := m.OutputMetricMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighFlapThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
 			}
-			m.HighFlapThreshold = 0
+			m.Splay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3612,16 +4771,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighFlapThreshold |= (uint32(b) & 0x7F) << shift
+				m.Splay |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
-			m.Interval = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3631,33 +4790,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowFlapThreshold", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
 			}
-			m.LowFlapThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LowFlapThreshold |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3682,11 +4832,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DedupKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3711,13 +4861,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.PrometheusURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publish", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutputSize", wireType)
 			}
-			var v int
+			m.MaxOutputSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3727,17 +4877,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MaxOutputSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Publish = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscardOutput", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3747,26 +4896,1031 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.DiscardOutput = bool(v != 0)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, SecretReference{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Severities == nil {
+				m.Severities = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCheck
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCheck(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCheck
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Severities[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandArgs = append(m.CommandArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdinPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Check) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Check: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighFlapThreshold", wireType)
+			}
+			m.HighFlapThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighFlapThreshold |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowFlapThreshold", wireType)
+			}
+			m.LowFlapThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowFlapThreshold |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Publish = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEntityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyEntityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckHooks = append(m.CheckHooks, HookList{})
+			if err := m.CheckHooks[len(m.CheckHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdin = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subdue == nil {
+				m.Subdue = &TimeWindowWhen{}
+			}
+			if err := m.Subdue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyRequests == nil {
+				m.ProxyRequests = &ProxyRequests{}
+			}
+			if err := m.ProxyRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundRobin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoundRobin = bool(v != 0)
+		case 22:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Duration = float64(math.Float64frombits(v))
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			m.Executed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, CheckHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			m.Issued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Issued |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStateChange", wireType)
+			}
+			m.TotalStateChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalStateChange |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOK", wireType)
+			}
+			m.LastOK = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOK |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
+			}
+			m.Occurrences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Occurrences |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OccurrencesWatermark", wireType)
 			}
-			var stringLen uint64
+			m.OccurrencesWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3776,24 +5930,14 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.OccurrencesWatermark |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEntityID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Silenced", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3818,11 +5962,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyEntityID = string(dAtA[iNdEx:postIndex])
+			m.Silenced = append(m.Silenced, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckHooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3846,36 +5990,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CheckHooks = append(m.CheckHooks, HookList{})
-			if err := m.CheckHooks[len(m.CheckHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hooks = append(m.Hooks, &Hook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stdin = bool(v != 0)
-		case 16:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricFormat", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3885,28 +6009,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Subdue == nil {
-				m.Subdue = &TimeWindowWhen{}
-			}
-			if err := m.Subdue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputMetricFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricHandlers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3931,13 +6051,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cron = string(dAtA[iNdEx:postIndex])
+			m.OutputMetricHandlers = append(m.OutputMetricHandlers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
 			}
-			m.Ttl = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3947,16 +6067,26 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ttl |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricTolerant", wireType)
 			}
-			m.Timeout = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3966,14 +6096,15 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			m.OutputMetricTolerant = bool(v != 0)
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricTags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3997,18 +6128,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyRequests == nil {
-				m.ProxyRequests = &ProxyRequests{}
-			}
-			if err := m.ProxyRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutputMetricTags = append(m.OutputMetricTags, &MetricTag{})
+			if err := m.OutputMetricTags[len(m.OutputMetricTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundRobin", wireType)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricMapping", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4018,47 +6147,30 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RoundRobin = bool(v != 0)
-		case 22:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Duration = float64(math.Float64frombits(v))
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			if m.OutputMetricMapping == nil {
+				m.OutputMetricMapping = &MetricMapping{}
 			}
-			m.Executed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Executed |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.OutputMetricMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 24:
+			iNdEx = postIndex
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4068,28 +6180,26 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, CheckHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PrometheusURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 45:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutputSize", wireType)
 			}
-			m.Issued = 0
+			m.MaxOutputSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4099,16 +6209,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Issued |= (int64(b) & 0x7F) << shift
+				m.MaxOutputSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscardOutput", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4118,26 +6228,17 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Output = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
+			m.DiscardOutput = bool(v != 0)
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4147,26 +6248,28 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Secrets = append(m.Secrets, SecretReference{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severities", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4176,35 +6279,115 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStateChange", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
 			}
-			m.TotalStateChange = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Severities == nil {
+				m.Severities = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCheck
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalStateChange |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCheck(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCheck
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOK", wireType)
+			m.Severities[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
 			}
-			m.LastOK = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4214,35 +6397,26 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastOK |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
 			}
-			m.Occurrences = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Occurrences |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OccurrencesWatermark", wireType)
+			m.Severity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandArgs", wireType)
 			}
-			m.OccurrencesWatermark = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4252,14 +6426,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OccurrencesWatermark |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 33:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandArgs = append(m.CommandArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Silenced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinPayload", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4284,13 +6468,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Silenced = append(m.Silenced, string(dAtA[iNdEx:postIndex]))
+			m.StdinPayload = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 34:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4300,28 +6484,27 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hooks = append(m.Hooks, &Hook{})
-			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtendedAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricFormat", wireType)
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
 			}
-			var stringLen uint64
+			m.Splay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4331,24 +6514,14 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Splay |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputMetricFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 36:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricHandlers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4373,11 +6546,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputMetricHandlers = append(m.OutputMetricHandlers, string(dAtA[iNdEx:postIndex]))
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 37:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4402,13 +6575,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			m.DedupKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4418,21 +6591,20 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedAttributes = append(m.ExtendedAttributes[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExtendedAttributes == nil {
-				m.ExtendedAttributes = []byte{}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4544,6 +6716,172 @@ func (m *CheckHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MetricMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimestampField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagFields = append(m.TagFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCheck(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4652,83 +6990,124 @@ var (
 func init() { proto.RegisterFile("check.proto", fileDescriptorCheck) }
 
 var fileDescriptorCheck = []byte{
-	// 1235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xae, 0x9a, 0xc6, 0x8e, 0xd7, 0x71, 0x7e, 0xb6, 0xf9, 0xd9, 0xb8, 0x60, 0x19, 0xb7, 0x1d,
-	0x7c, 0x93, 0xb4, 0xb4, 0x03, 0x0c, 0x57, 0x4c, 0x94, 0xb6, 0xa4, 0xb4, 0xd0, 0xce, 0xd2, 0xa1,
-	0x33, 0x0c, 0x33, 0x1a, 0x59, 0xda, 0xd8, 0x9a, 0xc8, 0x5a, 0xb3, 0xbb, 0x4a, 0x1a, 0x5e, 0x81,
-	0x17, 0xe0, 0x11, 0xb8, 0xe3, 0x96, 0x17, 0x60, 0xa6, 0x97, 0xf0, 0x02, 0x1a, 0x30, 0x77, 0x7a,
-	0x02, 0x2e, 0x99, 0x3d, 0xbb, 0x76, 0xe4, 0x26, 0x29, 0x37, 0xbd, 0x82, 0xde, 0x58, 0xe7, 0x7c,
-	0xe7, 0x1c, 0xed, 0xee, 0xf9, 0xf9, 0xb4, 0x46, 0xf5, 0x70, 0xc0, 0xc2, 0xc3, 0x9d, 0x91, 0xe0,
-	0x8a, 0xe3, 0xba, 0x64, 0xa9, 0xcc, 0x76, 0xd4, 0xc9, 0x88, 0xc9, 0xe6, 0x76, 0x3f, 0x56, 0x83,
-	0xac, 0xb7, 0x13, 0xf2, 0xe1, 0xad, 0x3e, 0xef, 0xf3, 0x5b, 0xe0, 0xd3, 0xcb, 0x0e, 0x40, 0x03,
-	0x05, 0x24, 0x13, 0xdb, 0xac, 0x07, 0x52, 0x32, 0x65, 0x15, 0x34, 0xe0, 0xdc, 0xbe, 0xb4, 0xb9,
-	0xaa, 0xe2, 0x21, 0xf3, 0x8f, 0xe3, 0x34, 0xe2, 0xc7, 0x06, 0xea, 0xfc, 0xee, 0xa0, 0xc5, 0x3d,
-	0xbd, 0x2e, 0x65, 0xdf, 0x65, 0x4c, 0x2a, 0xfc, 0x11, 0xaa, 0x84, 0x3c, 0x3d, 0x88, 0xfb, 0xc4,
-	0x69, 0x3b, 0xdd, 0xfa, 0x1d, 0xb2, 0x53, 0xda, 0xc9, 0x0e, 0xb8, 0xee, 0x81, 0xdd, 0xbb, 0xf2,
-	0x32, 0x77, 0x1d, 0x6a, 0xbd, 0xf1, 0x6d, 0x54, 0x81, 0x65, 0x25, 0xb9, 0xdc, 0x9e, 0xeb, 0xd6,
-	0xef, 0xe0, 0x99, 0xb8, 0x5d, 0x6d, 0x82, 0x88, 0x4b, 0xd4, 0xfa, 0xe1, 0xbb, 0x68, 0x5e, 0xef,
-	0x4d, 0x92, 0x39, 0x08, 0xd8, 0x9c, 0x09, 0xd8, 0xe7, 0xbc, 0xbc, 0xce, 0x25, 0x6a, 0x7c, 0x71,
-	0x07, 0x55, 0x1e, 0x4a, 0x99, 0xb1, 0x88, 0x5c, 0x69, 0x3b, 0xdd, 0x39, 0x0f, 0x15, 0xb9, 0x5b,
-	0x89, 0x01, 0xa1, 0xd6, 0xd2, 0xf9, 0xd9, 0x41, 0x8d, 0xa7, 0x82, 0xbf, 0x38, 0xb1, 0x67, 0x92,
-	0xd8, 0x43, 0xab, 0x2c, 0x55, 0xb1, 0x3a, 0xf1, 0x03, 0xa5, 0x44, 0xdc, 0xcb, 0x14, 0x93, 0xc4,
-	0x69, 0xcf, 0x75, 0x6b, 0xde, 0x7a, 0x91, 0xbb, 0x67, 0x8d, 0x74, 0xc5, 0x40, 0xbb, 0x53, 0x04,
-	0xbb, 0x68, 0x5e, 0x8e, 0x92, 0xe0, 0x84, 0x5c, 0x6e, 0x3b, 0xdd, 0x05, 0xaf, 0x56, 0xe4, 0xae,
-	0x01, 0xa8, 0x79, 0xe0, 0x4f, 0xd0, 0x12, 0x08, 0x7e, 0xc8, 0x8f, 0x98, 0x08, 0xfa, 0x8c, 0xcc,
-	0xb5, 0x9d, 0x6e, 0xc3, 0xc3, 0x45, 0xee, 0xbe, 0x62, 0xa1, 0x0d, 0xd0, 0xf7, 0xac, 0xda, 0xf9,
-	0xb5, 0x86, 0xea, 0xa5, 0xd4, 0x62, 0x82, 0xaa, 0x21, 0x1f, 0x0e, 0x83, 0x34, 0x82, 0x2a, 0xd4,
-	0xe8, 0x44, 0xc5, 0x6d, 0x54, 0x67, 0xe9, 0x51, 0x2c, 0x78, 0x3a, 0x64, 0xa9, 0x82, 0xbd, 0xd4,
-	0x68, 0x19, 0xc2, 0x5d, 0xb4, 0x30, 0x08, 0xd2, 0x28, 0x61, 0xc2, 0x64, 0xb6, 0xe6, 0x2d, 0x16,
-	0xb9, 0x3b, 0xc5, 0xe8, 0x54, 0xc2, 0x9f, 0xa1, 0xab, 0x83, 0xb8, 0x3f, 0xf0, 0x0f, 0x92, 0x60,
-	0xe4, 0xab, 0x81, 0x60, 0x72, 0xc0, 0x13, 0x93, 0xd8, 0x86, 0xb7, 0x59, 0xe4, 0xee, 0x79, 0x66,
-	0xba, 0xaa, 0xc1, 0x07, 0x49, 0x30, 0x7a, 0x36, 0x81, 0xf4, 0x92, 0x71, 0xaa, 0x98, 0x38, 0x0a,
-	0x12, 0x32, 0x0f, 0xd1, 0xb0, 0xe4, 0x04, 0xa3, 0x53, 0x09, 0xdf, 0x43, 0x38, 0xe1, 0xc7, 0xaf,
-	0xae, 0x58, 0x81, 0x98, 0x8d, 0x22, 0x77, 0xcf, 0xb1, 0xd2, 0x95, 0x84, 0x1f, 0xcf, 0xae, 0x87,
-	0xd1, 0x95, 0x34, 0x18, 0x32, 0x52, 0x85, 0xd3, 0x83, 0x8c, 0x3b, 0x68, 0x91, 0x8b, 0x7e, 0x90,
-	0xc6, 0xdf, 0x07, 0x2a, 0xe6, 0x29, 0x59, 0x00, 0xdb, 0x0c, 0x86, 0x6f, 0xa2, 0xea, 0x28, 0xeb,
-	0x25, 0xb1, 0x1c, 0x90, 0x1a, 0x14, 0xb1, 0x5e, 0xe4, 0xee, 0x04, 0xa2, 0x13, 0x41, 0x17, 0x52,
-	0x64, 0x29, 0xcc, 0x8a, 0x6d, 0x69, 0x04, 0x79, 0x84, 0x42, 0xce, 0x5a, 0x68, 0xc3, 0xea, 0xbb,
-	0xa6, 0xa7, 0x3f, 0x46, 0x0d, 0x99, 0xf5, 0x64, 0x28, 0xe2, 0x91, 0x5e, 0x51, 0x92, 0x3a, 0x44,
-	0xae, 0x16, 0xb9, 0x3b, 0x6b, 0xa0, 0xb3, 0x2a, 0xfe, 0x10, 0xe1, 0xfb, 0x2f, 0x14, 0x4b, 0x23,
-	0x16, 0x9d, 0xf6, 0x1c, 0x59, 0x6c, 0x3b, 0xdd, 0x45, 0x6f, 0xbe, 0xc8, 0x5d, 0x67, 0x9b, 0x9e,
-	0xe3, 0x80, 0x1f, 0xa3, 0xe5, 0x91, 0xee, 0x74, 0xdf, 0x76, 0x70, 0x1c, 0x91, 0x86, 0x3e, 0xb8,
-	0x77, 0x63, 0x9c, 0xbb, 0x66, 0x08, 0xee, 0x83, 0xe5, 0xe1, 0xbd, 0x22, 0x77, 0x5f, 0xf5, 0xa5,
-	0x8d, 0x51, 0xc9, 0x23, 0xc2, 0x8f, 0x2c, 0x07, 0xf9, 0x66, 0x2e, 0x97, 0x60, 0x2e, 0xd7, 0xcf,
-	0xcc, 0xe5, 0xe3, 0x58, 0x2a, 0xef, 0xaa, 0x9e, 0xca, 0x22, 0x77, 0xcb, 0x11, 0x14, 0x81, 0xb2,
-	0x0f, 0x93, 0xaa, 0xe7, 0x45, 0x45, 0x71, 0x4a, 0x96, 0x4b, 0xf3, 0xa2, 0x01, 0x6a, 0x1e, 0xf8,
-	0x53, 0x54, 0x91, 0x59, 0x2f, 0xca, 0x18, 0x59, 0x01, 0xa6, 0xb9, 0x36, 0xb3, 0xd0, 0xb3, 0x78,
-	0xc8, 0x9e, 0x03, 0x53, 0x3d, 0x1f, 0xb0, 0xd4, 0xcc, 0xb9, 0x71, 0xa7, 0xf6, 0xa9, 0xdb, 0x20,
-	0x14, 0x3c, 0x25, 0xab, 0xa6, 0x0d, 0xb4, 0x8c, 0xb7, 0xd0, 0x9c, 0x52, 0x09, 0xc1, 0x40, 0x0e,
-	0xd5, 0x22, 0x77, 0xb5, 0x4a, 0xf5, 0x8f, 0xae, 0xbe, 0xae, 0x14, 0xcf, 0x14, 0xb9, 0x0a, 0x0d,
-	0x07, 0xd5, 0xb7, 0x10, 0x9d, 0x08, 0x78, 0x17, 0x2d, 0x99, 0x34, 0x09, 0xcb, 0x1e, 0x64, 0x0d,
-	0xb6, 0xd7, 0x9c, 0xd9, 0xde, 0x0c, 0xbf, 0xd8, 0x3c, 0x4e, 0xe9, 0xe6, 0x36, 0xaa, 0x0b, 0x9e,
-	0xa5, 0x91, 0x2f, 0x78, 0x2f, 0x4e, 0xc9, 0x3a, 0x24, 0x60, 0x59, 0x27, 0xab, 0x04, 0x53, 0x04,
-	0x0a, 0xd5, 0x32, 0xfe, 0x1c, 0xad, 0xf1, 0x4c, 0x8d, 0x32, 0xe5, 0x0f, 0x99, 0x12, 0x71, 0xe8,
-	0x1f, 0x70, 0x31, 0x0c, 0x14, 0xd9, 0x80, 0x62, 0x92, 0x22, 0x77, 0xcf, 0xb5, 0x53, 0x6c, 0xd0,
-	0x2f, 0x00, 0x7c, 0x00, 0x18, 0x7e, 0x8a, 0x36, 0x66, 0x7d, 0xa7, 0x74, 0xb0, 0x09, 0xcd, 0xd8,
-	0x2c, 0x72, 0xf7, 0x02, 0x0f, 0xba, 0x56, 0x7e, 0xdf, 0xfe, 0x84, 0x28, 0xde, 0x47, 0x0b, 0x2c,
-	0x3d, 0xf2, 0x8f, 0x02, 0x21, 0x09, 0x39, 0xa5, 0x94, 0x09, 0x46, 0xab, 0x2c, 0x3d, 0xfa, 0x3a,
-	0x10, 0xb2, 0xf3, 0xc3, 0x12, 0x9a, 0x07, 0x1e, 0x7b, 0xcb, 0x60, 0xff, 0x3b, 0x06, 0x7b, 0x4b,
-	0x45, 0xff, 0x0d, 0x2a, 0x6a, 0xa2, 0x85, 0x28, 0x13, 0xa6, 0x05, 0x35, 0xfd, 0x38, 0x74, 0xaa,
-	0xeb, 0x31, 0x61, 0x2f, 0x58, 0x98, 0x29, 0x16, 0x91, 0x4d, 0x38, 0x97, 0x21, 0x02, 0x8b, 0xd1,
-	0xa9, 0x84, 0xef, 0xa1, 0xea, 0x20, 0x96, 0x8a, 0x8b, 0x13, 0x60, 0x8c, 0xfa, 0x9d, 0xad, 0xb3,
-	0xf7, 0xc8, 0x7d, 0xe3, 0xe0, 0x2d, 0xdb, 0xfa, 0x4d, 0x22, 0xe8, 0x44, 0xd0, 0xb7, 0x3d, 0x73,
-	0xb7, 0x23, 0x5b, 0x67, 0x6f, 0x7b, 0xe6, 0x89, 0x37, 0x50, 0xc5, 0x90, 0x16, 0x69, 0x42, 0xf2,
-	0xad, 0x86, 0xd7, 0x74, 0xd1, 0x03, 0xc5, 0xc8, 0x35, 0x80, 0x8d, 0xa2, 0xdf, 0xa8, 0x85, 0x4c,
-	0x92, 0x77, 0x20, 0xf1, 0xa6, 0x98, 0x80, 0x50, 0xfb, 0xd4, 0x23, 0xae, 0xb8, 0x0a, 0x12, 0x1f,
-	0x42, 0xfc, 0x70, 0x10, 0xa4, 0x7d, 0x46, 0xde, 0x3d, 0x1d, 0xf1, 0x92, 0x75, 0xdb, 0x58, 0xe9,
-	0x0a, 0x60, 0x5f, 0x69, 0x68, 0x0f, 0x10, 0xbc, 0x83, 0xaa, 0x49, 0x20, 0x95, 0xcf, 0x0f, 0x49,
-	0x0b, 0x36, 0xbf, 0x3e, 0xce, 0xdd, 0xca, 0xe3, 0x40, 0xaa, 0x27, 0x8f, 0xf4, 0x61, 0xad, 0x91,
-	0x56, 0xb4, 0xf0, 0xe4, 0x10, 0x7f, 0x80, 0xea, 0x3c, 0x0c, 0x33, 0x21, 0x58, 0x1a, 0x32, 0x49,
-	0x5c, 0x88, 0x81, 0x4a, 0x95, 0x60, 0x5a, 0x56, 0xf0, 0x97, 0x68, 0xbd, 0xa4, 0xfa, 0xc7, 0x81,
-	0x62, 0x62, 0x18, 0x88, 0x43, 0xd2, 0x86, 0xe0, 0xad, 0x22, 0x77, 0xcf, 0x77, 0xa0, 0x6b, 0x25,
-	0xf8, 0xf9, 0x04, 0xc5, 0x6d, 0xb4, 0x20, 0xe3, 0x44, 0x83, 0x11, 0x79, 0x0f, 0xc6, 0xde, 0xdc,
-	0xf1, 0xa7, 0x28, 0xde, 0x9e, 0xdc, 0xd9, 0x3b, 0x50, 0xd4, 0xd5, 0x33, 0x03, 0x69, 0x23, 0xec,
-	0x6d, 0xfd, 0xa2, 0xcf, 0xda, 0xf5, 0x37, 0xfa, 0x59, 0xbb, 0xf1, 0x06, 0x3e, 0x6b, 0x37, 0x5f,
-	0xf3, 0x59, 0xbb, 0xe0, 0x72, 0x16, 0xfe, 0xcb, 0xe5, 0xac, 0xf3, 0xad, 0xfd, 0x6b, 0xb5, 0x7f,
-	0xda, 0xcd, 0xb6, 0xf7, 0x9c, 0x0b, 0x7b, 0xaf, 0x3c, 0x61, 0x97, 0x5f, 0x37, 0x61, 0xde, 0xf5,
-	0xbf, 0xff, 0x6c, 0x39, 0x3f, 0x8d, 0x5b, 0xce, 0x2f, 0xe3, 0x96, 0xf3, 0x72, 0xdc, 0x72, 0x7e,
-	0x1b, 0xb7, 0x9c, 0x3f, 0xc6, 0x2d, 0xe7, 0xc7, 0xbf, 0x5a, 0x97, 0xbe, 0x99, 0x87, 0x92, 0xf4,
-	0x2a, 0xf0, 0x2f, 0xef, 0xee, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x93, 0xea, 0x3f, 0x1d, 0x5c,
-	0x0e, 0x00, 0x00,
+	// 1891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x1b, 0xb7,
+	0x15, 0xcf, 0x8a, 0x16, 0x25, 0x81, 0xa2, 0x25, 0x41, 0xff, 0x20, 0xd9, 0xd6, 0x32, 0xb4, 0x9d,
+	0x30, 0x6d, 0x24, 0x3b, 0x72, 0xa6, 0x6e, 0x33, 0x93, 0x69, 0x4d, 0xd9, 0x8e, 0x5d, 0x3b, 0x95,
+	0x0b, 0x3b, 0xf5, 0x4c, 0x27, 0x33, 0x3b, 0x20, 0x17, 0x22, 0xb7, 0x22, 0x77, 0xd9, 0x05, 0x56,
+	0x12, 0x7d, 0xec, 0x4c, 0xbf, 0x43, 0x3f, 0x42, 0x6f, 0xbd, 0xe6, 0x03, 0xf4, 0x90, 0x63, 0xce,
+	0x3d, 0xec, 0xb4, 0xea, 0x6d, 0x3f, 0x41, 0x8f, 0x1d, 0x3c, 0x60, 0x49, 0xac, 0x28, 0x67, 0xc6,
+	0x8e, 0x9a, 0xce, 0xb4, 0xb9, 0x68, 0xf1, 0x7e, 0xef, 0x3d, 0x00, 0x8b, 0xf7, 0x07, 0x3f, 0xae,
+	0x50, 0xa5, 0xdd, 0xe5, 0xed, 0xc3, 0x9d, 0x41, 0x1c, 0xc9, 0x08, 0x57, 0x04, 0x0f, 0x45, 0xb2,
+	0x23, 0x87, 0x03, 0x2e, 0x36, 0xb7, 0x3b, 0x81, 0xec, 0x26, 0xad, 0x9d, 0x76, 0xd4, 0xbf, 0xd5,
+	0x89, 0x3a, 0xd1, 0x2d, 0xb0, 0x69, 0x25, 0x07, 0x20, 0x81, 0x00, 0x23, 0xed, 0xbb, 0x59, 0x61,
+	0x42, 0x70, 0x69, 0x04, 0xd4, 0x8d, 0x22, 0x33, 0xe9, 0x66, 0xb5, 0xcf, 0x65, 0x1c, 0xb4, 0x85,
+	0x11, 0x97, 0x64, 0xd0, 0xe7, 0xde, 0x71, 0x10, 0xfa, 0xd1, 0x71, 0x6e, 0xdd, 0xe7, 0x92, 0x99,
+	0xf1, 0xbc, 0xe0, 0xed, 0x38, 0x9f, 0xa7, 0xfe, 0x87, 0x29, 0x34, 0xbf, 0xa7, 0x36, 0x48, 0xf9,
+	0xef, 0x13, 0x2e, 0x24, 0xfe, 0x09, 0x2a, 0xb7, 0xa3, 0xf0, 0x20, 0xe8, 0x10, 0xa7, 0xe6, 0x34,
+	0x2a, 0xbb, 0x64, 0xc7, 0xda, 0xf2, 0x0e, 0x98, 0xee, 0x81, 0xbe, 0x79, 0xe9, 0xeb, 0xd4, 0x75,
+	0xa8, 0xb1, 0xc6, 0xb7, 0x51, 0x19, 0xf6, 0x27, 0xc8, 0x54, 0xad, 0xd4, 0xa8, 0xec, 0xe2, 0x82,
+	0xdf, 0x3d, 0xa5, 0x02, 0x8f, 0x77, 0xa8, 0xb1, 0xc3, 0x77, 0xd0, 0xb4, 0x7a, 0x09, 0x41, 0x4a,
+	0xe0, 0xb0, 0x5e, 0x70, 0x78, 0x14, 0x45, 0xf6, 0x3a, 0xef, 0x50, 0x6d, 0x8b, 0xeb, 0xa8, 0xfc,
+	0x58, 0x88, 0x84, 0xfb, 0xe4, 0x52, 0xcd, 0x69, 0x94, 0x9a, 0x28, 0x4b, 0xdd, 0x72, 0x00, 0x08,
+	0x35, 0x1a, 0x7c, 0x0b, 0xcd, 0xe8, 0x77, 0x14, 0x64, 0xba, 0x56, 0x6a, 0xcc, 0x35, 0x57, 0xb3,
+	0xd4, 0x5d, 0x32, 0xd0, 0x87, 0x51, 0x3f, 0x90, 0xbc, 0x3f, 0x90, 0x43, 0x9a, 0x5b, 0xd5, 0xff,
+	0xe2, 0xa0, 0xea, 0xb3, 0x38, 0x3a, 0x19, 0x9a, 0x43, 0x10, 0xb8, 0x89, 0x96, 0x78, 0x28, 0x03,
+	0x39, 0xf4, 0x98, 0x94, 0x71, 0xd0, 0x4a, 0x24, 0x17, 0xc4, 0x19, 0x4f, 0x36, 0xa1, 0xa4, 0x8b,
+	0x1a, 0xba, 0x37, 0x42, 0xb0, 0x8b, 0xa6, 0xc5, 0xa0, 0xc7, 0x86, 0x64, 0xaa, 0xe6, 0x34, 0x66,
+	0x9b, 0x73, 0x59, 0xea, 0x6a, 0x80, 0xea, 0x07, 0xfe, 0x19, 0xba, 0x0c, 0x03, 0xaf, 0x1d, 0x1d,
+	0xf1, 0x98, 0x75, 0x38, 0x29, 0xd5, 0x9c, 0x46, 0xb5, 0x89, 0xb3, 0xd4, 0x3d, 0xa3, 0xa1, 0x55,
+	0x90, 0xf7, 0x8c, 0x58, 0xff, 0x2b, 0x46, 0x15, 0x2b, 0x16, 0x98, 0xa0, 0x99, 0x76, 0xd4, 0xef,
+	0xb3, 0xd0, 0x87, 0xb0, 0xcd, 0xd1, 0x5c, 0xc4, 0x35, 0x54, 0xe1, 0xe1, 0x51, 0x10, 0x47, 0x61,
+	0x9f, 0x87, 0x12, 0xf6, 0x32, 0x47, 0x6d, 0x08, 0x37, 0xd0, 0x6c, 0x97, 0x85, 0x7e, 0x8f, 0xc7,
+	0x3a, 0x14, 0x73, 0xcd, 0xf9, 0x2c, 0x75, 0x47, 0x18, 0x1d, 0x8d, 0xf0, 0x67, 0x68, 0xb9, 0x1b,
+	0x74, 0xba, 0xde, 0x41, 0x8f, 0x0d, 0x3c, 0xd9, 0x8d, 0xb9, 0xe8, 0x46, 0x3d, 0x1d, 0x89, 0x6a,
+	0x73, 0x3d, 0x4b, 0xdd, 0xf3, 0xd4, 0x74, 0x49, 0x81, 0x0f, 0x7b, 0x6c, 0xf0, 0x22, 0x87, 0xd4,
+	0x92, 0x41, 0x28, 0x79, 0x7c, 0xc4, 0x7a, 0x64, 0x1a, 0xbc, 0x61, 0xc9, 0x1c, 0xa3, 0xa3, 0x11,
+	0xbe, 0x8f, 0x70, 0x2f, 0x3a, 0x3e, 0xbb, 0x62, 0x19, 0x7c, 0xd6, 0xb2, 0xd4, 0x3d, 0x47, 0x4b,
+	0x17, 0x7b, 0xd1, 0x71, 0x71, 0x3d, 0x8c, 0x2e, 0x85, 0xac, 0xcf, 0xc9, 0x0c, 0xbc, 0x3d, 0x8c,
+	0x71, 0x1d, 0xcd, 0x47, 0x71, 0x87, 0x85, 0xc1, 0x2b, 0x26, 0x83, 0x28, 0x24, 0xb3, 0xa0, 0x2b,
+	0x60, 0xf8, 0x26, 0x9a, 0x19, 0x24, 0xad, 0x5e, 0x20, 0xba, 0x64, 0x0e, 0x82, 0x58, 0xc9, 0x52,
+	0x37, 0x87, 0x68, 0x3e, 0x50, 0x81, 0x8c, 0x93, 0x10, 0xca, 0xce, 0xd4, 0x00, 0x82, 0x73, 0x84,
+	0x40, 0x16, 0x35, 0xb4, 0x6a, 0x64, 0xa8, 0x08, 0x81, 0xef, 0xa2, 0xaa, 0x48, 0x5a, 0xa2, 0x1d,
+	0x07, 0x03, 0xb5, 0xa2, 0x20, 0x15, 0xf0, 0x5c, 0xca, 0x52, 0xb7, 0xa8, 0xa0, 0x45, 0x11, 0x7f,
+	0x86, 0xf0, 0x83, 0x13, 0xc9, 0x43, 0x9f, 0xfb, 0xe3, 0x9c, 0x23, 0xf3, 0x35, 0xa7, 0x31, 0xdf,
+	0x5c, 0x57, 0x15, 0xf3, 0xb7, 0xd4, 0x45, 0x63, 0x4d, 0x96, 0xba, 0xce, 0x36, 0x3d, 0xc7, 0x05,
+	0x3f, 0x45, 0x0b, 0x03, 0x95, 0xfb, 0x9e, 0xc9, 0xe9, 0xc0, 0x27, 0x55, 0x75, 0x14, 0xcd, 0x1b,
+	0xa7, 0xa9, 0xab, 0xcb, 0xe2, 0x01, 0x68, 0x1e, 0xdf, 0xcf, 0x52, 0xf7, 0xac, 0x2d, 0xad, 0x0e,
+	0x2c, 0x0b, 0x1f, 0x3f, 0x31, 0xfd, 0xce, 0xd3, 0xa5, 0x7d, 0x19, 0x4a, 0x7b, 0x75, 0xa2, 0xb4,
+	0x9f, 0x06, 0x42, 0x36, 0x97, 0xd5, 0x36, 0xb3, 0xd4, 0xb5, 0x3d, 0x28, 0x02, 0x41, 0xd9, 0xe8,
+	0x0a, 0x92, 0x7e, 0x10, 0x92, 0x05, 0xab, 0x82, 0x14, 0x40, 0xf5, 0x03, 0xff, 0x1c, 0x95, 0x45,
+	0xd2, 0xf2, 0x13, 0x4e, 0x16, 0xa1, 0x59, 0x5d, 0x29, 0x2c, 0xf4, 0x22, 0xe8, 0xf3, 0x97, 0xd0,
+	0x06, 0x5f, 0x76, 0x79, 0xa8, 0x5b, 0x85, 0x36, 0xa7, 0xe6, 0xa9, 0x12, 0xa3, 0x1d, 0x47, 0x21,
+	0x59, 0xd2, 0x89, 0xa1, 0xc6, 0x78, 0x03, 0x95, 0xa4, 0xec, 0x11, 0x0c, 0xfd, 0x65, 0x26, 0x4b,
+	0x5d, 0x25, 0x52, 0xf5, 0x47, 0xe5, 0x83, 0x8a, 0x5d, 0x94, 0x48, 0xb2, 0x0c, 0x29, 0x08, 0xf9,
+	0x60, 0x20, 0x9a, 0x0f, 0xf0, 0x3d, 0x74, 0x59, 0x1f, 0x53, 0x6c, 0xfa, 0x09, 0x59, 0x81, 0xed,
+	0x6d, 0x16, 0xb6, 0x57, 0xe8, 0x38, 0xe6, 0x1c, 0x73, 0x11, 0xdf, 0x46, 0x95, 0x38, 0x4a, 0x42,
+	0xdf, 0x8b, 0xa3, 0x56, 0x10, 0x92, 0x55, 0x38, 0x80, 0x05, 0x75, 0x58, 0x16, 0x4c, 0x11, 0x08,
+	0x54, 0x8d, 0xf1, 0x2f, 0xd1, 0x4a, 0x94, 0xc8, 0x41, 0x22, 0x3d, 0x7d, 0x1d, 0x78, 0x07, 0x51,
+	0xdc, 0x67, 0x92, 0xac, 0x41, 0x30, 0x49, 0x96, 0xba, 0xe7, 0xea, 0x29, 0xd6, 0xe8, 0xe7, 0x00,
+	0x3e, 0x04, 0x0c, 0x3f, 0x43, 0x6b, 0x45, 0xdb, 0x51, 0x83, 0x58, 0x87, 0xf4, 0xdc, 0xcc, 0x52,
+	0xf7, 0x35, 0x16, 0x74, 0xc5, 0x9e, 0xef, 0x91, 0x41, 0xf1, 0xfb, 0x68, 0x96, 0x87, 0x47, 0xde,
+	0x11, 0x8b, 0x05, 0x21, 0xe3, 0x26, 0x93, 0x63, 0x74, 0x86, 0x87, 0x47, 0xbf, 0x61, 0xb1, 0x98,
+	0x5c, 0x5a, 0x46, 0x3d, 0x1e, 0xb3, 0x50, 0x92, 0x0d, 0x38, 0x83, 0x73, 0x96, 0xce, 0x2d, 0x8a,
+	0x4b, 0xbf, 0x30, 0x28, 0x3e, 0x40, 0xf8, 0x8c, 0x3d, 0xeb, 0x08, 0xb2, 0x09, 0x99, 0xb9, 0x56,
+	0x88, 0x88, 0x71, 0x64, 0x9d, 0x66, 0x2d, 0x4b, 0xdd, 0xab, 0x93, 0x5e, 0xd6, 0xe5, 0xb1, 0x58,
+	0x58, 0x8b, 0x75, 0x04, 0x16, 0x68, 0xb5, 0xe8, 0xd1, 0x67, 0x83, 0x41, 0x10, 0x76, 0xc8, 0x95,
+	0x73, 0x82, 0xaf, 0xfd, 0x3e, 0xd7, 0x16, 0xcd, 0xeb, 0x59, 0xea, 0xba, 0xe7, 0x3a, 0x5b, 0x2b,
+	0x2e, 0xdb, 0x2b, 0x1a, 0x4f, 0xfc, 0x41, 0x7e, 0xc9, 0x5c, 0x85, 0x7c, 0x5c, 0x56, 0x25, 0x0a,
+	0x80, 0xe5, 0xa8, 0x2d, 0xf0, 0x5d, 0x84, 0x7c, 0x3e, 0xe0, 0xa1, 0x2f, 0xbc, 0x28, 0x24, 0xd7,
+	0x6a, 0xa5, 0x3c, 0x2d, 0xc6, 0xa8, 0xe5, 0x34, 0x67, 0xd0, 0xfd, 0x10, 0x7f, 0x8c, 0xe6, 0x7c,
+	0xee, 0x27, 0x03, 0xef, 0x90, 0x0f, 0xc9, 0x16, 0xa4, 0x13, 0x34, 0xfb, 0x11, 0x68, 0xb9, 0xcd,
+	0x02, 0xf8, 0x84, 0x0f, 0xf1, 0x0b, 0x28, 0x82, 0x3e, 0x97, 0x5d, 0x9e, 0x08, 0x2f, 0x89, 0x7b,
+	0xc4, 0x05, 0xd7, 0x6d, 0xd3, 0x56, 0x8c, 0xe6, 0x0b, 0xfa, 0x34, 0x4b, 0x5d, 0x52, 0x34, 0xb5,
+	0x26, 0xac, 0x8e, 0x35, 0x5f, 0xc4, 0x3d, 0xfc, 0x00, 0x2d, 0xf4, 0xd9, 0x89, 0x67, 0xce, 0x4a,
+	0x04, 0xaf, 0x38, 0xa9, 0x41, 0xa1, 0x5e, 0xcb, 0x52, 0x77, 0xe3, 0x8c, 0xca, 0x9e, 0xa6, 0xcf,
+	0x4e, 0xf6, 0x41, 0xf3, 0x3c, 0x78, 0xc5, 0xf1, 0x1e, 0xba, 0xec, 0x07, 0xa2, 0xcd, 0x62, 0xdf,
+	0xd8, 0x93, 0x77, 0x21, 0xbb, 0xae, 0xaa, 0xbd, 0x14, 0x35, 0xf6, 0x24, 0x46, 0xa3, 0x27, 0xc2,
+	0xbf, 0x1e, 0xf3, 0x8c, 0xeb, 0x90, 0x4d, 0x57, 0x0b, 0x21, 0x7e, 0x0e, 0x3a, 0xca, 0x0f, 0x78,
+	0xcc, 0xc3, 0x36, 0x6f, 0x6e, 0x98, 0x76, 0xf7, 0x2d, 0x4c, 0x04, 0xb7, 0x10, 0x12, 0xfc, 0x88,
+	0xc7, 0x81, 0x0c, 0xb8, 0x20, 0x37, 0x60, 0xd6, 0xc6, 0xeb, 0x18, 0xd8, 0xce, 0xf3, 0x91, 0xe9,
+	0x83, 0x50, 0xc6, 0x43, 0x1d, 0xcd, 0xb1, 0xbf, 0xb5, 0x80, 0x35, 0x2b, 0xfe, 0x14, 0xcd, 0x1b,
+	0x72, 0xe0, 0xb1, 0xb8, 0x23, 0xc8, 0xcd, 0x71, 0x49, 0xdb, 0xb8, 0xe5, 0x5d, 0x31, 0xf8, 0xbd,
+	0xb8, 0x23, 0xf0, 0x2f, 0x50, 0x15, 0x9a, 0xaf, 0x37, 0x60, 0xc3, 0x5e, 0xc4, 0x7c, 0xf2, 0x1e,
+	0x84, 0xf5, 0x4a, 0x96, 0xba, 0xeb, 0x05, 0x85, 0x35, 0xc1, 0x3c, 0x28, 0x9e, 0x69, 0x1c, 0x3f,
+	0x46, 0xb3, 0x8a, 0x8f, 0xfa, 0x4c, 0x32, 0x52, 0xaf, 0x39, 0x13, 0xdc, 0x6f, 0xbf, 0xf5, 0x3b,
+	0xde, 0x56, 0x79, 0xce, 0x9a, 0x2b, 0xea, 0xcc, 0xbe, 0x49, 0x5d, 0x47, 0x35, 0x8b, 0xdc, 0x89,
+	0x8e, 0x46, 0x9b, 0x9f, 0xa2, 0x85, 0x33, 0x87, 0x80, 0x17, 0x51, 0x49, 0xe5, 0xa9, 0xa6, 0x41,
+	0x6a, 0x88, 0x57, 0xd0, 0xf4, 0x11, 0xeb, 0x25, 0xdc, 0x90, 0x1f, 0x2d, 0x7c, 0x32, 0xf5, 0x53,
+	0xa7, 0xfe, 0xd5, 0x3a, 0x9a, 0x86, 0x03, 0xfd, 0x81, 0x40, 0xfd, 0xdf, 0x11, 0xa8, 0x1f, 0x78,
+	0xcf, 0xff, 0x06, 0xef, 0xd9, 0x44, 0xb3, 0x7e, 0x12, 0xeb, 0x14, 0x54, 0x5c, 0xc7, 0xa1, 0x23,
+	0x59, 0x95, 0x09, 0x3f, 0xe1, 0xed, 0x44, 0x72, 0x9f, 0xac, 0xc3, 0x7b, 0x69, 0xd6, 0x61, 0x30,
+	0x3a, 0x1a, 0xe1, 0xfb, 0x68, 0xa6, 0x1b, 0x08, 0x19, 0xc5, 0x43, 0xa0, 0x27, 0x95, 0xdd, 0x8d,
+	0xc9, 0xae, 0xfb, 0x48, 0x1b, 0x34, 0x17, 0x4c, 0xfc, 0x72, 0x0f, 0x9a, 0x0f, 0xd4, 0xaf, 0x53,
+	0xfd, 0x5b, 0x94, 0x6c, 0x4c, 0xfe, 0x3a, 0xd5, 0x4f, 0xbc, 0x86, 0xca, 0xe6, 0xca, 0xd9, 0x84,
+	0xc3, 0x37, 0x92, 0xea, 0x52, 0x42, 0x32, 0xc9, 0x81, 0x2e, 0xcc, 0x51, 0x2d, 0xa8, 0x19, 0xd5,
+	0x20, 0x11, 0xe6, 0x82, 0xd7, 0xc1, 0x04, 0x84, 0x9a, 0xa7, 0x2a, 0x71, 0x19, 0x49, 0xd6, 0xf3,
+	0xc0, 0xc5, 0x6b, 0x77, 0x59, 0xd8, 0xe1, 0xe4, 0xda, 0xb8, 0xc4, 0x2d, 0xed, 0xb6, 0xd6, 0xd2,
+	0x45, 0xc0, 0x9e, 0x2b, 0x68, 0x0f, 0x10, 0xbc, 0x83, 0x66, 0x7a, 0x4c, 0x48, 0x2f, 0x3a, 0x84,
+	0x3b, 0xbe, 0xd4, 0x5c, 0x3d, 0x4d, 0xdd, 0xf2, 0x53, 0x26, 0xe4, 0xfe, 0x13, 0xf5, 0xb2, 0x46,
+	0x49, 0xcb, 0x6a, 0xb0, 0x7f, 0x88, 0x3f, 0x42, 0x95, 0xa8, 0xdd, 0x4e, 0x62, 0xb8, 0xdc, 0x04,
+	0x5c, 0xee, 0x25, 0x1d, 0x29, 0x0b, 0xa6, 0xb6, 0x80, 0x7f, 0x85, 0x56, 0x2d, 0xd1, 0x3b, 0x66,
+	0x92, 0xc7, 0x7d, 0x16, 0x1f, 0x9a, 0x2b, 0x7c, 0x23, 0x4b, 0xdd, 0xf3, 0x0d, 0xe8, 0x8a, 0x05,
+	0xbf, 0xcc, 0x51, 0x5c, 0x43, 0xb3, 0x22, 0xe8, 0x29, 0xd0, 0x27, 0xef, 0x42, 0xd9, 0xeb, 0x6f,
+	0x12, 0x23, 0x14, 0x6f, 0xe7, 0xdf, 0x18, 0xea, 0x10, 0xd4, 0xa5, 0x89, 0x82, 0x34, 0x1e, 0xda,
+	0xea, 0xb5, 0x1c, 0xfa, 0xfa, 0x85, 0x72, 0xe8, 0x1b, 0x17, 0xc0, 0xa1, 0x6f, 0xbe, 0x1d, 0x87,
+	0x7e, 0xef, 0x42, 0x39, 0xf4, 0xfb, 0xdf, 0x1f, 0x87, 0x6e, 0x7c, 0x1f, 0x1c, 0xfa, 0x83, 0x37,
+	0xe4, 0xd0, 0x3f, 0x7a, 0x4b, 0x0e, 0xfd, 0xe3, 0xb7, 0xe7, 0xd0, 0x1f, 0xfe, 0x67, 0x38, 0xf4,
+	0xf6, 0x85, 0x70, 0xe8, 0x9d, 0xef, 0xc4, 0xa1, 0x6f, 0x5f, 0x10, 0x87, 0xfe, 0xb2, 0xc0, 0xa1,
+	0x3f, 0x82, 0x59, 0xeb, 0x93, 0xdd, 0xfc, 0x2d, 0xd9, 0xf3, 0x2e, 0x9a, 0x35, 0xd2, 0x90, 0xec,
+	0x42, 0x30, 0xa0, 0xc5, 0xe6, 0x98, 0x1d, 0xc6, 0x1c, 0x9b, 0x60, 0xdc, 0x77, 0xbe, 0x23, 0xe3,
+	0xfe, 0xf8, 0x4d, 0x19, 0xf7, 0xf9, 0x1f, 0x8b, 0xda, 0x6f, 0xfe, 0xb1, 0xc8, 0xa6, 0xee, 0xb7,
+	0xfe, 0xab, 0xd4, 0xfd, 0x4b, 0x34, 0x6f, 0x5f, 0xca, 0xd6, 0x45, 0xe9, 0xbc, 0xf6, 0xa2, 0xb4,
+	0xe9, 0xc0, 0xd4, 0xb7, 0xd1, 0x81, 0xfa, 0x1f, 0xa7, 0x50, 0xb5, 0xd8, 0x24, 0xee, 0x22, 0xa4,
+	0x58, 0xaf, 0x77, 0x10, 0xf0, 0x9e, 0xf9, 0x8d, 0xa0, 0x33, 0x66, 0x8c, 0xda, 0x95, 0xaf, 0xd0,
+	0x87, 0x0a, 0xc4, 0x9f, 0xa0, 0x0a, 0xec, 0xda, 0x78, 0xc2, 0x8b, 0xe8, 0xab, 0xce, 0x82, 0xed,
+	0x64, 0x03, 0x58, 0xfb, 0x3e, 0x44, 0x0b, 0x8a, 0x5b, 0x09, 0xc9, 0xfa, 0x03, 0xe3, 0x5f, 0x02,
+	0x7f, 0xa8, 0xd4, 0x33, 0x2a, 0x6b, 0x8e, 0xcb, 0x23, 0x95, 0x9e, 0xe7, 0x2e, 0x42, 0x92, 0x75,
+	0xb4, 0x99, 0x20, 0x97, 0xc6, 0x6d, 0x6b, 0x8c, 0xda, 0x9b, 0x97, 0xac, 0x03, 0x7e, 0xa2, 0x79,
+	0xfd, 0x5f, 0xff, 0xd8, 0x72, 0xfe, 0x7c, 0xba, 0xe5, 0x7c, 0x75, 0xba, 0xe5, 0x7c, 0x7d, 0xba,
+	0xe5, 0x7c, 0x73, 0xba, 0xe5, 0xfc, 0xfd, 0x74, 0xcb, 0xf9, 0xd3, 0x3f, 0xb7, 0xde, 0xf9, 0xed,
+	0x34, 0x04, 0xbd, 0x55, 0x86, 0x7f, 0x25, 0xdc, 0xf9, 0xf7, 0x00, 0x81, 0xcf, 0x42, 0x79, 0xea,
+	0x18, 0x00, 0x00,
 }
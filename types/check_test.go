@@ -55,6 +55,11 @@ func TestCheckConfig(t *testing.T) {
 	assert.Error(t, c.Validate())
 	c.OutputMetricFormat = ""
 
+	// Invalid output metric tags
+	c.OutputMetricTags = []*MetricTag{{Value: "{{ .system.hostname }}"}}
+	assert.Error(t, c.Validate())
+	c.OutputMetricTags = []*MetricTag{{Name: "host", Value: "{{ .system.hostname }}"}}
+
 	// Valid check
 	c.Ttl = 90
 	assert.NoError(t, c.Validate())
@@ -72,8 +77,119 @@ func TestScheduleValidation(t *testing.T) {
 	c.Interval = 0
 	assert.NoError(t, c.Validate())
 
+	c.Cron = "CRON_TZ=Europe/Paris 0 9 * * *"
+	assert.NoError(t, c.Validate())
+
 	c.Cron = "this is an invalid cron"
 	assert.Error(t, c.Validate())
+
+	c.Cron = "CRON_TZ=Mars/Olympus_Mons 0 9 * * *"
+	assert.Error(t, c.Validate())
+}
+
+func TestCheckSplayValidation(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.Interval = 60
+
+	c.Splay = 30
+	assert.NoError(t, c.Validate())
+
+	c.Splay = 60
+	assert.Error(t, c.Validate())
+
+	c.Interval = 0
+	c.Cron = "0 * * * *"
+	assert.NoError(t, c.Validate())
+}
+
+func TestCheckDedupKeyValidation(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.DedupKey = "{{ .Check.ProxyEntityID }}"
+	assert.NoError(t, c.Validate())
+
+	c.DedupKey = "{{ .Check.ProxyEntityID "
+	assert.Error(t, c.Validate())
+
+	check := NewCheck(c)
+	assert.Equal(t, c.DedupKey, check.DedupKey)
+	assert.Error(t, check.Validate())
+}
+
+func TestCheckPrometheusURLValidation(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.PrometheusURL = "http://localhost:9100/metrics"
+	assert.Error(t, c.Validate())
+
+	c.Command = ""
+	assert.NoError(t, c.Validate())
+
+	check := NewCheck(c)
+	assert.Equal(t, c.PrometheusURL, check.PrometheusURL)
+	assert.NoError(t, check.Validate())
+
+	c.PrometheusURL = "localhost:9100/metrics"
+	assert.Error(t, c.Validate())
+}
+
+func TestCheckMaxOutputSizeValidation(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.MaxOutputSize = 1024
+	c.DiscardOutput = true
+	assert.NoError(t, c.Validate())
+
+	check := NewCheck(c)
+	assert.Equal(t, c.MaxOutputSize, check.MaxOutputSize)
+	assert.Equal(t, c.DiscardOutput, check.DiscardOutput)
+	assert.NoError(t, check.Validate())
+
+	c.MaxOutputSize = -1
+	assert.Error(t, c.Validate())
+
+	check.MaxOutputSize = -1
+	assert.Error(t, check.Validate())
+}
+
+func TestCheckCommandArgsValidation(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.Command = ""
+	c.CommandArgs = []string{"check-http", "--header", "Authorization: Bearer {{ .token }}"}
+	c.StdinPayload = "{\"url\": \"https://example.com\"}"
+	assert.NoError(t, c.Validate())
+
+	check := NewCheck(c)
+	assert.Equal(t, c.CommandArgs, check.CommandArgs)
+	assert.Equal(t, c.StdinPayload, check.StdinPayload)
+	assert.NoError(t, check.Validate())
+
+	c.Command = "check-http"
+	assert.EqualError(t, c.Validate(), "command_args: cannot be combined with a command")
+	c.Command = ""
+
+	c.Stdin = true
+	assert.EqualError(t, c.Validate(), "stdin_payload: cannot be combined with stdin")
+	c.Stdin = false
+
+	c.PrometheusURL = "http://localhost:9100/metrics"
+	assert.EqualError(t, c.Validate(), "prometheus_url: cannot be combined with a command")
+
+	check.CommandArgs = []string{""}
+	assert.EqualError(t, check.Validate(), "command_args[0]: must be the executable to run")
+}
+
+func TestCheckSeveritiesValidation(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.Severities = map[string]string{"1": SeverityOK, "2": "disaster"}
+	assert.NoError(t, c.Validate())
+
+	check := NewCheck(c)
+	assert.Equal(t, c.Severities, check.Severities)
+	assert.NoError(t, check.Validate())
+
+	c.Severities = map[string]string{"warning": SeverityWarning}
+	assert.EqualError(t, c.Validate(), "severities.warning: is not an exit status")
+
+	check.Severities = c.Severities
+	assert.EqualError(t, check.Validate(), "severities.warning: is not an exit status")
 }
 
 func TestFixtureCheckIsValid(t *testing.T) {
@@ -143,6 +259,10 @@ func TestOutputMetricFormatValidate(t *testing.T) {
 	assert.NoError(t, ValidateOutputMetricFormat(GraphiteOutputMetricFormat))
 	assert.NoError(t, ValidateOutputMetricFormat(InfluxDBOutputMetricFormat))
 	assert.NoError(t, ValidateOutputMetricFormat(OpenTSDBOutputMetricFormat))
+	assert.NoError(t, ValidateOutputMetricFormat(StatsdOutputMetricFormat))
+	assert.NoError(t, ValidateOutputMetricFormat(JSONOutputMetricFormat))
+	assert.NoError(t, ValidateOutputMetricFormat(PrometheusOutputMetricFormat))
+	assert.NoError(t, ValidateOutputMetricFormat(AutoOutputMetricFormat))
 	assert.Error(t, ValidateOutputMetricFormat("anything_else"))
 	assert.Error(t, ValidateOutputMetricFormat("NAGIOS_PERFDATA"))
 }
@@ -348,3 +468,37 @@ func TestAliasedExtendedAttributeBehaviour_GH1732(t *testing.T) {
 	check := NewCheck(config)
 	require.Equal(t, uint32(0), check.Status)
 }
+
+func TestParseCheckDependency(t *testing.T) {
+	testCases := []struct {
+		dependency string
+		entity     string
+		check      string
+		wantErr    bool
+	}{
+		{"postgres", "", "postgres", false},
+		{"db01/postgres", "db01", "postgres", false},
+		{"", "", "", true},
+		{"db01/", "", "", true},
+		{"/postgres", "", "", true},
+		{"dc1/db01/postgres", "", "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.dependency, func(t *testing.T) {
+			entity, check, err := ParseCheckDependency(tc.dependency)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.entity, entity)
+			assert.Equal(t, tc.check, check)
+		})
+	}
+
+	c := FixtureCheckConfig("check")
+	c.DependsOn = []string{"postgres", "db01/postgres"}
+	assert.NoError(t, c.Validate())
+	c.DependsOn = []string{"dc1/db01/postgres"}
+	assert.Error(t, c.Validate())
+}
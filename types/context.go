@@ -26,6 +26,8 @@ const (
 	RefreshTokenString
 	// StoreKey contains the key name to retrieve the etcd store from within a context
 	StoreKey
+	// RequestIDKey contains the key name to retrieve the request ID from context
+	RequestIDKey
 )
 
 // ContextEnvironment returns the environment name injected in the context
@@ -43,3 +45,12 @@ func ContextOrganization(ctx context.Context) string {
 	}
 	return ""
 }
+
+// ContextRequestID returns the identifier of the request injected in the
+// context
+func ContextRequestID(ctx context.Context) string {
+	if value := ctx.Value(RequestIDKey); value != nil {
+		return value.(string)
+	}
+	return ""
+}
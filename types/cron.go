@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// cronTimezonePrefixes are the prefixes giving the time zone of a cron
+// expression, e.g. "CRON_TZ=Europe/Paris 0 9 * * *".
+var cronTimezonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+// ParseCron parses the given cron expression, in the standard five fields
+// format, optionally prefixed by the IANA time zone it is evaluated in with
+// CRON_TZ= or TZ=. Expressions without time zone are evaluated in the local
+// time zone of the scheduler.
+func ParseCron(spec string) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	var location *time.Location
+	for _, prefix := range cronTimezonePrefixes {
+		if !strings.HasPrefix(spec, prefix) {
+			continue
+		}
+		fields := strings.SplitN(strings.TrimPrefix(spec, prefix), " ", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("missing cron expression after time zone %q", fields[0])
+		}
+		var err error
+		if location, err = time.LoadLocation(fields[0]); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %s", fields[0], err)
+		}
+		spec = strings.TrimSpace(fields[1])
+		break
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return schedule, nil
+	}
+	return zonedSchedule{Schedule: schedule, location: location}, nil
+}
+
+// zonedSchedule evaluates a cron schedule in a given time zone.
+type zonedSchedule struct {
+	cron.Schedule
+	location *time.Location
+}
+
+// Next returns the next activation time, later than the given time.
+func (s zonedSchedule) Next(t time.Time) time.Time {
+	return s.Schedule.Next(t.In(s.location)).In(t.Location())
+}
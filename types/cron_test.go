@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	now := time.Date(2018, time.June, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		spec     string
+		expected time.Time
+		wantErr  bool
+	}{
+		{"0 13 * * *", time.Date(2018, time.June, 1, 13, 0, 0, 0, time.UTC), false},
+		{"CRON_TZ=America/New_York 0 9 * * *", time.Date(2018, time.June, 1, 13, 0, 0, 0, time.UTC), false},
+		{"TZ=America/New_York 0 8 * * *", time.Date(2018, time.June, 2, 12, 0, 0, 0, time.UTC), false},
+		{"CRON_TZ=America/New_York", time.Time{}, true},
+		{"CRON_TZ=Nowhere/Special 0 9 * * *", time.Time{}, true},
+		{"CRON_TZ=UTC 0 9 * *", time.Time{}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			schedule, err := ParseCron(tc.spec)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			next := schedule.Next(now)
+			assert.True(t, tc.expected.Equal(next), "expected %s, got %s (%s)", tc.expected, next, next.In(newYork))
+			assert.Equal(t, time.UTC, next.Location())
+		})
+	}
+}
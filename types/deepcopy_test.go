@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeepCopy(t *testing.T) {
+	event := FixtureEvent("entity", "check")
+	event.Check.Labels = map[string]string{"team": "ops"}
+	event.Check.Hooks = []*Hook{FixtureHook("hook")}
+	event.Check.History = []CheckHistory{{Status: 0, Executed: 1}}
+	event.Entity.System.Network.Interfaces = []NetworkInterface{{Name: "eth0"}}
+	event.Entity.ExtendedAttributes = []byte(`{"foo":"bar"}`)
+	event.Metrics = FixtureMetrics()
+
+	copied := event.DeepCopy()
+	require.True(t, event.Equal(copied))
+
+	copied.Check.Labels["team"] = "dev"
+	copied.Check.Subscriptions[0] = "windows"
+	copied.Check.History[0].Status = 2
+	copied.Check.Hooks[0].Output = "modified"
+	copied.Entity.System.Network.Interfaces[0].Name = "modified"
+	copied.Entity.ExtendedAttributes = append(copied.Entity.ExtendedAttributes[:0], '{', '}')
+	assert.False(t, event.Equal(copied))
+
+	assert.Equal(t, "ops", event.Check.Labels["team"])
+	assert.Equal(t, "linux", event.Check.Subscriptions[0])
+	assert.Equal(t, uint32(0), event.Check.History[0].Status)
+	assert.Empty(t, event.Check.Hooks[0].Output)
+	assert.Equal(t, "eth0", event.Entity.System.Network.Interfaces[0].Name)
+	assert.Equal(t, `{"foo":"bar"}`, string(event.Entity.ExtendedAttributes))
+
+	var nilEvent *Event
+	assert.Nil(t, nilEvent.DeepCopy())
+}
+
+func TestNamespaceDeepCopyEqual(t *testing.T) {
+	ns := FixtureNamespace("production")
+	copied := ns.DeepCopy()
+	assert.True(t, ns.Equal(copied))
+	assert.True(t, ns.Equal(*copied))
+
+	copied.Description = "Production"
+	assert.False(t, ns.Equal(copied))
+	assert.False(t, ns.Equal(FixtureEnvironment("production")))
+}
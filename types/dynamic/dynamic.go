@@ -149,7 +149,7 @@ func GetField(v AttrGetter, name string) (interface{}, error) {
 		field := strukt.FieldByName(name)
 		if field.IsValid() {
 			rval := reflect.Indirect(field).Interface()
-			if b, ok := rval.([]byte); ok && len(b) > 0 {
+			if b, ok := bytesOf(reflect.Indirect(field)); ok && len(b) > 0 {
 				// Make sure this field isn't the extended attributes
 				if extendedAttributesAddress == &b[0] {
 					goto EXTENDED
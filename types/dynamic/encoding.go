@@ -202,8 +202,8 @@ func getJSONFields(v reflect.Value, addressOfAttrs *byte, omitEmpty bool, result
 		if elem.IsValid() && isExtendedAttributes(addressOfAttrs, sf.Value) {
 			continue
 		}
-		// if the field is embedded, flatten it out
-		if sf.Field.Anonymous {
+		// if the field is embedded without a JSON name, flatten it out
+		if sf.Field.Anonymous && !hasJSONName(sf.Field) {
 			var attrAddr *byte
 			if x, ok := sf.Value.Interface().(AttrGetter); ok {
 				attrs := x.GetExtendedAttributes()
@@ -195,6 +195,43 @@ func TestMarshalUnmarshalEmbedded(t *testing.T) {
 	require.Equal(t, test, &result)
 }
 
+type Meta struct {
+	Name string `json:"name"`
+}
+
+type NamedEmbeddedTest struct {
+	Foo   string `json:"foo"`
+	Meta  `json:"meta"`
+	Attrs []byte `json:"-"`
+}
+
+func (n *NamedEmbeddedTest) GetExtendedAttributes() []byte {
+	return n.Attrs
+}
+
+func (n *NamedEmbeddedTest) SetExtendedAttributes(b []byte) {
+	n.Attrs = b
+}
+
+func TestMarshalUnmarshalNamedEmbedded(t *testing.T) {
+	test := &NamedEmbeddedTest{
+		Foo:  "foo",
+		Meta: Meta{Name: "bar"},
+	}
+
+	require.NoError(t, SetField(test, "extended", true))
+
+	b, err := Marshal(test)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"foo":"foo","meta":{"name":"bar"},"extended":true}`, string(b))
+
+	var result NamedEmbeddedTest
+	require.NoError(t, Unmarshal(b, &result))
+
+	require.Equal(t, test, &result)
+}
+
 func TestEncodeNoDuplicateFields(t *testing.T) {
 	p := &Problematic{
 		Foo:   "foo",
@@ -40,7 +40,7 @@ func isExtendedAttributes(address *byte, value reflect.Value) bool {
 	}
 
 	elem := reflect.Indirect(value)
-	if b, ok := elem.Interface().([]byte); ok {
+	if b, ok := bytesOf(elem); ok {
 		if len(b) > 0 && &b[0] == address {
 			return true
 		}
@@ -49,6 +49,15 @@ func isExtendedAttributes(address *byte, value reflect.Value) bool {
 	return false
 }
 
+// bytesOf returns the bytes of the given value if it is a byte slice, of any
+// named type such as the extended attributes of the resources.
+func bytesOf(value reflect.Value) ([]byte, bool) {
+	if value.Kind() != reflect.Slice || value.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+	return value.Bytes(), true
+}
+
 func isEmpty(value reflect.Value) bool {
 	if !value.IsValid() {
 		return true
@@ -155,6 +164,12 @@ type structField struct {
 	OmitEmpty bool
 }
 
+// hasJSONName returns whether the given field is named by its JSON tag
+func hasJSONName(field reflect.StructField) bool {
+	tag := field.Tag.Get("json")
+	return strings.Split(tag, ",")[0] != ""
+}
+
 func (s *structField) jsonFieldName() (string, bool) {
 	fieldName := s.Field.Name
 	tag, ok := s.Field.Tag.Lookup("json")
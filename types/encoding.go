@@ -0,0 +1,8 @@
+package types
+
+const (
+	// ContentTypeProtobuf is the media type of resources serialized with their
+	// protobuf definitions. Lists of resources are serialized as a sequence of
+	// messages, each prefixed by its length encoded as a varint.
+	ContentTypeProtobuf = "application/octet-stream+protobuf"
+)
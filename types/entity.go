@@ -1,12 +1,13 @@
 package types
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 
 	"github.com/sensu/sensu-go/types/dynamic"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
 )
 
 const (
@@ -15,27 +16,47 @@ const (
 
 	// EntityProxyClass is the name of the class given to proxy entities.
 	EntityProxyClass = "proxy"
+
+	// EntityServiceClass is the name of the class given to the entities of
+	// services, which send keepalives like agents without running one.
+	EntityServiceClass = "service"
 )
 
+// EntityClasses contains the valid entity classes
+var EntityClasses = []string{EntityAgentClass, EntityProxyClass, EntityServiceClass}
+
 // Validate returns an error if the entity is invalid.
 func (e *Entity) Validate() error {
-	if err := ValidateName(e.ID); err != nil {
-		return errors.New("entity id " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("id", ValidateName(e.ID))
 
-	if err := ValidateName(e.Class); err != nil {
-		return errors.New("entity class " + err.Error())
+	if !utilstrings.InArray(e.Class, EntityClasses) {
+		errs.Add("class", "must be one of %s", strings.Join(EntityClasses, ", "))
 	}
 
 	if e.Environment == "" {
-		return errors.New("environment must be set")
+		errs.Add("environment", "must be set")
 	}
 
 	if e.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", "must be set")
 	}
 
-	return nil
+	warning := e.KeepaliveWarningTimeout
+	if warning == 0 {
+		warning = e.KeepaliveTimeout
+	}
+	if e.KeepaliveCriticalTimeout > 0 && e.KeepaliveCriticalTimeout <= warning {
+		errs.Add("keepalive_critical_timeout", "must be greater than the keepalive warning timeout")
+	}
+
+	return errs.Err()
+}
+
+// HasKeepalives returns true if the keepalives of the entity are monitored.
+// Proxy entities never send keepalives, so they are not monitored.
+func (e *Entity) HasKeepalives() bool {
+	return e.Class != EntityProxyClass
 }
 
 // Get implements govaluate.Parameters
@@ -43,6 +64,14 @@ func (e *Entity) Get(name string) (interface{}, error) {
 	return dynamic.GetField(e, name)
 }
 
+// GetExtendedAttributes gets the serialized ExtendedAttributes of the entity.
+func (e *Entity) GetExtendedAttributes() []byte {
+	if e != nil {
+		return e.ExtendedAttributes
+	}
+	return nil
+}
+
 // SetExtendedAttributes sets the serialized ExtendedAttributes of the entity.
 func (e *Entity) SetExtendedAttributes(b []byte) {
 	e.ExtendedAttributes = b
@@ -75,7 +104,7 @@ func GetEntitySubscription(entityID string) string {
 func FixtureEntity(id string) *Entity {
 	return &Entity{
 		ID:               id,
-		Class:            "host",
+		Class:            EntityAgentClass,
 		Subscriptions:    []string{"linux"},
 		Environment:      "default",
 		Organization:     "default",
@@ -88,6 +117,17 @@ func (e *Entity) URIPath() string {
 	return fmt.Sprintf("/entities/%s", url.PathEscape(e.ID))
 }
 
+// GetObjectMeta implements MetaResource.
+func (e *Entity) GetObjectMeta() ObjectMeta {
+	return objectMeta(e.ObjectMeta, e.ID, e.Organization, e.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (e *Entity) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&e.ID, &e.Organization, &e.Environment)
+	e.ObjectMeta = meta
+}
+
 //
 // Sorting
 
@@ -8,8 +8,6 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
-import bytes "bytes"
-
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -32,9 +30,20 @@ type Entity struct {
 	Organization     string         `protobuf:"bytes,10,opt,name=organization,proto3" json:"organization,omitempty"`
 	User             string         `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
-	ExtendedAttributes []byte `protobuf:"bytes,12,opt,name=extended_attributes,json=extendedAttributes,proto3" json:"-"`
+	ExtendedAttributes Attributes `protobuf:"bytes,12,opt,name=extended_attributes,json=extendedAttributes,proto3,customtype=Attributes" json:"-"`
 	// Redact contains the fields to redact on the agent
 	Redact []string `protobuf:"bytes,13,rep,name=redact" json:"redact,omitempty"`
+	// KeepaliveWarningTimeout is the time in seconds after which a warning
+	// keepalive event is created, defaults to KeepaliveTimeout
+	KeepaliveWarningTimeout uint32 `protobuf:"varint,14,opt,name=keepalive_warning_timeout,json=keepaliveWarningTimeout,proto3" json:"keepalive_warning_timeout,omitempty"`
+	// KeepaliveCriticalTimeout is the time in seconds after which a critical
+	// keepalive event is created, no critical keepalive event is created if 0
+	KeepaliveCriticalTimeout uint32 `protobuf:"varint,15,opt,name=keepalive_critical_timeout,json=keepaliveCriticalTimeout,proto3" json:"keepalive_critical_timeout,omitempty"`
+	// KeepaliveHandlers are the handlers of the keepalive events of the entity,
+	// defaults to the keepalive handler
+	KeepaliveHandlers []string `protobuf:"bytes,16,rep,name=keepalive_handlers,json=keepaliveHandlers" json:"keepalive_handlers,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the entity
+	ObjectMeta `protobuf:"bytes,17,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Entity) Reset()                    { *m = Entity{} }
@@ -119,16 +128,30 @@ func (m *Entity) GetUser() string {
 	return ""
 }
 
-func (m *Entity) GetExtendedAttributes() []byte {
+func (m *Entity) GetRedact() []string {
 	if m != nil {
-		return m.ExtendedAttributes
+		return m.Redact
 	}
 	return nil
 }
 
-func (m *Entity) GetRedact() []string {
+func (m *Entity) GetKeepaliveWarningTimeout() uint32 {
 	if m != nil {
-		return m.Redact
+		return m.KeepaliveWarningTimeout
+	}
+	return 0
+}
+
+func (m *Entity) GetKeepaliveCriticalTimeout() uint32 {
+	if m != nil {
+		return m.KeepaliveCriticalTimeout
+	}
+	return 0
+}
+
+func (m *Entity) GetKeepaliveHandlers() []string {
+	if m != nil {
+		return m.KeepaliveHandlers
 	}
 	return nil
 }
@@ -143,6 +166,13 @@ type System struct {
 	PlatformVersion string  `protobuf:"bytes,5,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"`
 	Network         Network `protobuf:"bytes,6,opt,name=network" json:"network"`
 	Arch            string  `protobuf:"bytes,7,opt,name=arch,proto3" json:"arch,omitempty"`
+	// Cloud contains the metadata of the cloud instance the Agent process is
+	// running on, if any
+	Cloud *Cloud `protobuf:"bytes,8,opt,name=cloud" json:"cloud,omitempty"`
+	// OSEdition, ServicePack and Domain are only discovered on Windows
+	OSEdition   string `protobuf:"bytes,9,opt,name=os_edition,json=osEdition,proto3" json:"os_edition,omitempty"`
+	ServicePack string `protobuf:"bytes,10,opt,name=service_pack,json=servicePack,proto3" json:"service_pack,omitempty"`
+	Domain      string `protobuf:"bytes,11,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
 func (m *System) Reset()                    { *m = System{} }
@@ -199,6 +229,34 @@ func (m *System) GetArch() string {
 	return ""
 }
 
+func (m *System) GetCloud() *Cloud {
+	if m != nil {
+		return m.Cloud
+	}
+	return nil
+}
+
+func (m *System) GetOSEdition() string {
+	if m != nil {
+		return m.OSEdition
+	}
+	return ""
+}
+
+func (m *System) GetServicePack() string {
+	if m != nil {
+		return m.ServicePack
+	}
+	return ""
+}
+
+func (m *System) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
 // Network contains information about the system network interfaces
 // that the Agent process is running on, used for additional Entity
 // context.
@@ -269,12 +327,73 @@ func (m *Deregistration) GetHandler() string {
 	return ""
 }
 
+// Cloud contains the metadata of a cloud instance, discovered by the Agent
+// from the metadata service of its cloud provider.
+type Cloud struct {
+	// Provider is the cloud provider of the instance, either ec2, gce or azure
+	Provider         string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	InstanceID       string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id"`
+	InstanceType     string `protobuf:"bytes,3,opt,name=instance_type,json=instanceType,proto3" json:"instance_type"`
+	Region           string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	AvailabilityZone string `protobuf:"bytes,5,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone"`
+	// Tags are the tags, or labels, of the instance
+	Tags map[string]string `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Cloud) Reset()                    { *m = Cloud{} }
+func (m *Cloud) String() string            { return proto.CompactTextString(m) }
+func (*Cloud) ProtoMessage()               {}
+func (*Cloud) Descriptor() ([]byte, []int) { return fileDescriptorEntity, []int{5} }
+
+func (m *Cloud) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *Cloud) GetInstanceID() string {
+	if m != nil {
+		return m.InstanceID
+	}
+	return ""
+}
+
+func (m *Cloud) GetInstanceType() string {
+	if m != nil {
+		return m.InstanceType
+	}
+	return ""
+}
+
+func (m *Cloud) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Cloud) GetAvailabilityZone() string {
+	if m != nil {
+		return m.AvailabilityZone
+	}
+	return ""
+}
+
+func (m *Cloud) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Entity)(nil), "sensu.types.Entity")
 	proto.RegisterType((*System)(nil), "sensu.types.System")
 	proto.RegisterType((*Network)(nil), "sensu.types.Network")
 	proto.RegisterType((*NetworkInterface)(nil), "sensu.types.NetworkInterface")
 	proto.RegisterType((*Deregistration)(nil), "sensu.types.Deregistration")
+	proto.RegisterType((*Cloud)(nil), "sensu.types.Cloud")
 }
 func (this *Entity) Equal(that interface{}) bool {
 	if that == nil {
@@ -333,7 +452,7 @@ func (this *Entity) Equal(that interface{}) bool {
 	if this.User != that1.User {
 		return false
 	}
-	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
+	if !this.ExtendedAttributes.Equal(that1.ExtendedAttributes) {
 		return false
 	}
 	if len(this.Redact) != len(that1.Redact) {
@@ -344,6 +463,23 @@ func (this *Entity) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.KeepaliveWarningTimeout != that1.KeepaliveWarningTimeout {
+		return false
+	}
+	if this.KeepaliveCriticalTimeout != that1.KeepaliveCriticalTimeout {
+		return false
+	}
+	if len(this.KeepaliveHandlers) != len(that1.KeepaliveHandlers) {
+		return false
+	}
+	for i := range this.KeepaliveHandlers {
+		if this.KeepaliveHandlers[i] != that1.KeepaliveHandlers[i] {
+			return false
+		}
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (this *System) Equal(that interface{}) bool {
@@ -386,6 +522,18 @@ func (this *System) Equal(that interface{}) bool {
 	if this.Arch != that1.Arch {
 		return false
 	}
+	if !this.Cloud.Equal(that1.Cloud) {
+		return false
+	}
+	if this.OSEdition != that1.OSEdition {
+		return false
+	}
+	if this.ServicePack != that1.ServicePack {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
 	return true
 }
 func (this *Network) Equal(that interface{}) bool {
@@ -476,6 +624,50 @@ func (this *Deregistration) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Cloud) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Cloud)
+	if !ok {
+		that2, ok := that.(Cloud)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Provider != that1.Provider {
+		return false
+	}
+	if this.InstanceID != that1.InstanceID {
+		return false
+	}
+	if this.InstanceType != that1.InstanceType {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.AvailabilityZone != that1.AvailabilityZone {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	return true
+}
 func (m *Entity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -572,12 +764,14 @@ func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEntity(dAtA, i, uint64(len(m.User)))
 		i += copy(dAtA[i:], m.User)
 	}
-	if len(m.ExtendedAttributes) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintEntity(dAtA, i, uint64(len(m.ExtendedAttributes)))
-		i += copy(dAtA[i:], m.ExtendedAttributes)
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintEntity(dAtA, i, uint64(m.ExtendedAttributes.Size()))
+	n6, err := m.ExtendedAttributes.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n6
 	if len(m.Redact) > 0 {
 		for _, s := range m.Redact {
 			dAtA[i] = 0x6a
@@ -593,6 +787,43 @@ func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.KeepaliveWarningTimeout != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.KeepaliveWarningTimeout))
+	}
+	if m.KeepaliveCriticalTimeout != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.KeepaliveCriticalTimeout))
+	}
+	if len(m.KeepaliveHandlers) > 0 {
+		for _, s := range m.KeepaliveHandlers {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x8a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintEntity(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n5, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
 	return i, nil
 }
 
@@ -655,6 +886,34 @@ func (m *System) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEntity(dAtA, i, uint64(len(m.Arch)))
 		i += copy(dAtA[i:], m.Arch)
 	}
+	if m.Cloud != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.Cloud.Size()))
+		n4, err := m.Cloud.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.OSEdition) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.OSEdition)))
+		i += copy(dAtA[i:], m.OSEdition)
+	}
+	if len(m.ServicePack) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.ServicePack)))
+		i += copy(dAtA[i:], m.ServicePack)
+	}
+	if len(m.Domain) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Domain)))
+		i += copy(dAtA[i:], m.Domain)
+	}
 	return i, nil
 }
 
@@ -757,6 +1016,71 @@ func (m *Deregistration) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Cloud) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cloud) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Provider)))
+		i += copy(dAtA[i:], m.Provider)
+	}
+	if len(m.InstanceID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.InstanceID)))
+		i += copy(dAtA[i:], m.InstanceID)
+	}
+	if len(m.InstanceType) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.InstanceType)))
+		i += copy(dAtA[i:], m.InstanceType)
+	}
+	if len(m.Region) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Region)))
+		i += copy(dAtA[i:], m.Region)
+	}
+	if len(m.AvailabilityZone) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.AvailabilityZone)))
+		i += copy(dAtA[i:], m.AvailabilityZone)
+	}
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			dAtA[i] = 0x32
+			i++
+			v := m.Tags[k]
+			mapSize := 1 + len(k) + sovEntity(uint64(len(k))) + 1 + len(v) + sovEntity(uint64(len(v)))
+			i = encodeVarintEntity(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEntity(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEntity(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintEntity(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -788,16 +1112,22 @@ func NewPopulatedEntity(r randyEntity, easy bool) *Entity {
 	this.Environment = string(randStringEntity(r))
 	this.Organization = string(randStringEntity(r))
 	this.User = string(randStringEntity(r))
-	v4 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v4)
-	for i := 0; i < v4; i++ {
-		this.ExtendedAttributes[i] = byte(r.Intn(256))
-	}
+	v4 := NewPopulatedAttributes(r)
+	this.ExtendedAttributes = *v4
 	v5 := r.Intn(10)
 	this.Redact = make([]string, v5)
 	for i := 0; i < v5; i++ {
 		this.Redact[i] = string(randStringEntity(r))
 	}
+	this.KeepaliveWarningTimeout = uint32(r.Uint32())
+	this.KeepaliveCriticalTimeout = uint32(r.Uint32())
+	v12 := r.Intn(10)
+	this.KeepaliveHandlers = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.KeepaliveHandlers[i] = string(randStringEntity(r))
+	}
+	v14 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -813,6 +1143,12 @@ func NewPopulatedSystem(r randyEntity, easy bool) *System {
 	v6 := NewPopulatedNetwork(r, easy)
 	this.Network = *v6
 	this.Arch = string(randStringEntity(r))
+	if r.Intn(10) != 0 {
+		this.Cloud = NewPopulatedCloud(r, easy)
+	}
+	this.OSEdition = string(randStringEntity(r))
+	this.ServicePack = string(randStringEntity(r))
+	this.Domain = string(randStringEntity(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -855,6 +1191,25 @@ func NewPopulatedDeregistration(r randyEntity, easy bool) *Deregistration {
 	return this
 }
 
+func NewPopulatedCloud(r randyEntity, easy bool) *Cloud {
+	this := &Cloud{}
+	this.Provider = string(randStringEntity(r))
+	this.InstanceID = string(randStringEntity(r))
+	this.InstanceType = string(randStringEntity(r))
+	this.Region = string(randStringEntity(r))
+	this.AvailabilityZone = string(randStringEntity(r))
+	if r.Intn(10) != 0 {
+		v13 := r.Intn(10)
+		this.Tags = make(map[string]string)
+		for i := 0; i < v13; i++ {
+			this.Tags[randStringEntity(r)] = randStringEntity(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyEntity interface {
 	Float32() float32
 	Float64() float64
@@ -969,16 +1324,28 @@ func (m *Entity) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntity(uint64(l))
 	}
-	l = len(m.ExtendedAttributes)
-	if l > 0 {
-		n += 1 + l + sovEntity(uint64(l))
-	}
+	l = m.ExtendedAttributes.Size()
+	n += 1 + l + sovEntity(uint64(l))
 	if len(m.Redact) > 0 {
 		for _, s := range m.Redact {
 			l = len(s)
 			n += 1 + l + sovEntity(uint64(l))
 		}
 	}
+	if m.KeepaliveWarningTimeout != 0 {
+		n += 1 + sovEntity(uint64(m.KeepaliveWarningTimeout))
+	}
+	if m.KeepaliveCriticalTimeout != 0 {
+		n += 1 + sovEntity(uint64(m.KeepaliveCriticalTimeout))
+	}
+	if len(m.KeepaliveHandlers) > 0 {
+		for _, s := range m.KeepaliveHandlers {
+			l = len(s)
+			n += 2 + l + sovEntity(uint64(l))
+		}
+	}
+	l = m.ObjectMeta.Size()
+	n += 2 + l + sovEntity(uint64(l))
 	return n
 }
 
@@ -1011,6 +1378,22 @@ func (m *System) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntity(uint64(l))
 	}
+	if m.Cloud != nil {
+		l = m.Cloud.Size()
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.OSEdition)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.ServicePack)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
 	return n
 }
 
@@ -1056,6 +1439,40 @@ func (m *Deregistration) Size() (n int) {
 	return n
 }
 
+func (m *Cloud) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.InstanceID)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.InstanceType)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.AvailabilityZone)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEntity(uint64(len(k))) + 1 + len(v) + sovEntity(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEntity(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func sovEntity(x uint64) (n int) {
 	for {
 		n++
@@ -1416,9 +1833,8 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedAttributes = append(m.ExtendedAttributes[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExtendedAttributes == nil {
-				m.ExtendedAttributes = []byte{}
+			if err := m.ExtendedAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 13:
@@ -1450,11 +1866,108 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			}
 			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEntity(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepaliveWarningTimeout", wireType)
+			}
+			m.KeepaliveWarningTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepaliveWarningTimeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepaliveCriticalTimeout", wireType)
+			}
+			m.KeepaliveCriticalTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepaliveCriticalTimeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepaliveHandlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeepaliveHandlers = append(m.KeepaliveHandlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthEntity
@@ -1704,6 +2217,126 @@ func (m *System) Unmarshal(dAtA []byte) error {
 			}
 			m.Arch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cloud", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cloud == nil {
+				m.Cloud = &Cloud{}
+			}
+			if err := m.Cloud.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSEdition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OSEdition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicePack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServicePack = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntity(dAtA[iNdEx:])
@@ -2022,6 +2655,319 @@ func (m *Deregistration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Cloud) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cloud: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cloud: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailabilityZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEntity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEntity
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEntity
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEntity(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEntity
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEntity(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2130,47 +3076,74 @@ var (
 func init() { proto.RegisterFile("entity.proto", fileDescriptorEntity) }
 
 var fileDescriptorEntity = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xed, 0xe4, 0x3f, 0x37, 0x3f, 0x5f, 0x3b, 0xed, 0x57, 0x0d, 0x45, 0xc4, 0x56, 0x58, 0x60,
-	0x8a, 0x9a, 0x8a, 0x82, 0x60, 0x5d, 0x53, 0x90, 0xba, 0x00, 0xc4, 0x14, 0xb1, 0x40, 0x48, 0x91,
-	0x13, 0xdf, 0x24, 0x56, 0x93, 0x71, 0x34, 0x33, 0x29, 0x84, 0x27, 0xe1, 0x11, 0x78, 0x03, 0x78,
-	0x84, 0x2e, 0x79, 0x02, 0x0b, 0xc2, 0x2e, 0x0f, 0x80, 0x58, 0x22, 0x8f, 0x9d, 0x34, 0x29, 0xec,
-	0xce, 0x39, 0xf7, 0xdc, 0xf9, 0xb9, 0x73, 0x6c, 0xa8, 0xa2, 0xd0, 0x81, 0x9e, 0xb6, 0xc6, 0x32,
-	0xd4, 0x21, 0xad, 0x28, 0x14, 0x6a, 0xd2, 0xd2, 0xd3, 0x31, 0xaa, 0xbd, 0x83, 0x7e, 0xa0, 0x07,
-	0x93, 0x4e, 0xab, 0x1b, 0x8e, 0x0e, 0xfb, 0x61, 0x3f, 0x3c, 0x34, 0x9e, 0xce, 0xa4, 0x67, 0x98,
-	0x21, 0x06, 0x25, 0xbd, 0xcd, 0x2f, 0x39, 0x28, 0x3c, 0x35, 0x8b, 0xd1, 0x5d, 0xc8, 0x04, 0x3e,
-	0x23, 0x36, 0x71, 0xca, 0x6e, 0x61, 0x16, 0x59, 0x99, 0xd3, 0x13, 0x9e, 0x09, 0x7c, 0xba, 0x03,
-	0xf9, 0xee, 0xd0, 0x53, 0x8a, 0x65, 0xe2, 0x12, 0x4f, 0x08, 0xbd, 0x0f, 0x05, 0x35, 0x55, 0x1a,
-	0x47, 0x2c, 0x6b, 0x13, 0xa7, 0x72, 0xb4, 0xdd, 0x5a, 0x39, 0x45, 0xeb, 0xcc, 0x94, 0xdc, 0xdc,
-	0x65, 0x64, 0x6d, 0xf0, 0xd4, 0x48, 0x1f, 0x43, 0x4d, 0x4d, 0x3a, 0xaa, 0x2b, 0x83, 0xb1, 0x0e,
-	0x42, 0xa1, 0x58, 0xce, 0xce, 0x3a, 0x65, 0x77, 0x6b, 0x1e, 0x59, 0xeb, 0x05, 0xbe, 0x4e, 0xe9,
-	0x3e, 0x94, 0x87, 0x9e, 0xd2, 0x6d, 0x85, 0x28, 0x58, 0xde, 0x26, 0x4e, 0xd6, 0xad, 0xcd, 0x23,
-	0xeb, 0x4a, 0xe4, 0xa5, 0x18, 0x9e, 0x21, 0x0a, 0xda, 0x02, 0xf0, 0x51, 0x62, 0x3f, 0x50, 0x1a,
-	0x25, 0x2b, 0xd8, 0xc4, 0x29, 0xb9, 0xf5, 0x79, 0x64, 0xad, 0xa8, 0x7c, 0x05, 0xd3, 0x53, 0xa8,
-	0x2f, 0x98, 0xf4, 0xe2, 0xed, 0x58, 0xd1, 0xdc, 0xe7, 0xe6, 0xda, 0x7d, 0x4e, 0xd6, 0x2c, 0xe9,
-	0xbd, 0xae, 0x35, 0x52, 0x17, 0xb6, 0xce, 0x11, 0xc7, 0xde, 0x30, 0xb8, 0xc0, 0xb6, 0x0e, 0x46,
-	0x18, 0x4e, 0x34, 0x2b, 0xd9, 0xc4, 0xa9, 0xb9, 0xff, 0xcf, 0x23, 0xeb, 0xef, 0x22, 0xdf, 0x5c,
-	0x4a, 0xaf, 0x13, 0x85, 0xda, 0x50, 0x41, 0x71, 0x11, 0xc8, 0x50, 0x8c, 0x50, 0x68, 0x56, 0x36,
-	0x23, 0x5f, 0x95, 0x68, 0x13, 0xaa, 0xa1, 0xec, 0x7b, 0x22, 0xf8, 0x98, 0x1c, 0x17, 0x8c, 0x65,
-	0x4d, 0xa3, 0x14, 0x72, 0x13, 0x85, 0x92, 0x55, 0x4c, 0xcd, 0x60, 0xfa, 0x08, 0xb6, 0xf1, 0x83,
-	0x46, 0xe1, 0xa3, 0xdf, 0xf6, 0xb4, 0x96, 0x41, 0x67, 0xa2, 0x51, 0xb1, 0xaa, 0x4d, 0x9c, 0xaa,
-	0x9b, 0x9f, 0x47, 0x16, 0x39, 0xe0, 0x74, 0xe1, 0x38, 0x5e, 0x1a, 0xe8, 0x2e, 0x14, 0x24, 0xfa,
-	0x5e, 0x57, 0xb3, 0x5a, 0xfc, 0x5c, 0x3c, 0x65, 0xcd, 0x5f, 0x04, 0x0a, 0xc9, 0x33, 0xd3, 0x3d,
-	0x28, 0x0d, 0x42, 0xa5, 0x85, 0x37, 0xc2, 0x24, 0x3f, 0x7c, 0xc9, 0xe3, 0x54, 0x85, 0x69, 0x74,
-	0x92, 0x54, 0xbd, 0x3c, 0xe3, 0x99, 0x50, 0xc5, 0x3d, 0xe3, 0xa1, 0xa7, 0x7b, 0xa1, 0x4c, 0x12,
-	0x54, 0xe6, 0x4b, 0x4e, 0xef, 0xc0, 0x7f, 0x0b, 0xdc, 0xee, 0x79, 0xa3, 0x60, 0x38, 0x65, 0x39,
-	0x63, 0xa9, 0x2f, 0xe4, 0x67, 0x46, 0xa5, 0x77, 0x61, 0x73, 0x69, 0xbc, 0x40, 0xa9, 0xe2, 0x79,
-	0xe4, 0x8d, 0x73, 0xb9, 0xc0, 0x9b, 0x44, 0xa6, 0x0f, 0xa1, 0x28, 0x50, 0xbf, 0x0f, 0xe5, 0xb9,
-	0x09, 0x45, 0xe5, 0x68, 0x67, 0xed, 0x81, 0x5f, 0x24, 0xb5, 0xf4, 0x65, 0x17, 0xd6, 0x78, 0x90,
-	0x9e, 0xec, 0x0e, 0x4c, 0x26, 0xca, 0xdc, 0xe0, 0xe6, 0x3b, 0x28, 0xa6, 0x6e, 0xfa, 0x0a, 0x20,
-	0x10, 0x1a, 0x65, 0xcf, 0xeb, 0xa2, 0x62, 0xc4, 0xce, 0x3a, 0x95, 0xa3, 0x5b, 0xff, 0x5a, 0xf7,
-	0x74, 0xe1, 0x72, 0x69, 0xbc, 0x41, 0x9c, 0xc7, 0xab, 0x46, 0xbe, 0x82, 0x9b, 0x02, 0x36, 0xaf,
-	0xf7, 0xc4, 0xa7, 0x58, 0x99, 0xad, 0xc1, 0xf4, 0x06, 0x64, 0x47, 0x5e, 0x37, 0x1d, 0x6c, 0x71,
-	0x16, 0x59, 0xd9, 0xe7, 0xc7, 0x4f, 0x78, 0xac, 0xd1, 0x7b, 0x50, 0xf6, 0x7c, 0x5f, 0xa2, 0x52,
-	0xa8, 0x58, 0xd6, 0x7c, 0x63, 0xe6, 0x73, 0x59, 0x8a, 0xfc, 0x0a, 0x36, 0xf7, 0xa1, 0xbe, 0x1e,
-	0x6e, 0xca, 0xa0, 0x38, 0xf0, 0x84, 0x3f, 0x44, 0x99, 0x6e, 0xb8, 0xa0, 0xee, 0xed, 0xdf, 0x3f,
-	0x1a, 0xe4, 0xf3, 0xac, 0x41, 0xbe, 0xce, 0x1a, 0xe4, 0x72, 0xd6, 0x20, 0xdf, 0x66, 0x0d, 0xf2,
-	0x7d, 0xd6, 0x20, 0x9f, 0x7e, 0x36, 0x36, 0xde, 0xe6, 0xcd, 0x8d, 0x3b, 0x05, 0xf3, 0x63, 0x79,
-	0xf0, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xe2, 0x1b, 0x4f, 0xa4, 0x04, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xef, 0xda, 0x89, 0x1d, 0x3f, 0x3b, 0xae, 0x33, 0xc9, 0x3f, 0xd9, 0xfa, 0xdf, 0x7a, 0x2d,
+	0x17, 0x54, 0x53, 0x5a, 0x57, 0x04, 0x44, 0x11, 0x52, 0x91, 0xba, 0x49, 0x50, 0x7d, 0x28, 0x81,
+	0x4d, 0x04, 0x52, 0x85, 0x64, 0x8d, 0x77, 0x27, 0xce, 0x10, 0x7b, 0xc6, 0xda, 0x19, 0xbb, 0xb8,
+	0x9f, 0x84, 0x8f, 0xc0, 0x99, 0x13, 0x1f, 0xa1, 0xc7, 0x9e, 0x39, 0xac, 0xc0, 0x5c, 0xd0, 0x7e,
+	0x00, 0xc4, 0x11, 0xcd, 0xcc, 0xee, 0x7a, 0xdd, 0xd0, 0xdb, 0x7b, 0xbf, 0xf7, 0x7b, 0x33, 0x3b,
+	0xef, 0xbd, 0xdf, 0xcc, 0x42, 0x8d, 0x30, 0x49, 0xe5, 0xa2, 0x37, 0x0d, 0xb9, 0xe4, 0xa8, 0x2a,
+	0x08, 0x13, 0xb3, 0x9e, 0x5c, 0x4c, 0x89, 0x68, 0x3e, 0x1c, 0x51, 0x79, 0x39, 0x1b, 0xf6, 0x7c,
+	0x3e, 0x79, 0x34, 0xe2, 0x23, 0xfe, 0x48, 0x73, 0x86, 0xb3, 0x0b, 0xed, 0x69, 0x47, 0x5b, 0x26,
+	0xb7, 0x09, 0x13, 0x22, 0xb1, 0xb1, 0x3b, 0xbf, 0x94, 0xa1, 0x74, 0xa2, 0x17, 0x46, 0xfb, 0x50,
+	0xa0, 0x81, 0x6d, 0xb5, 0xad, 0x6e, 0xc5, 0x2d, 0x2d, 0x23, 0xa7, 0xd0, 0x3f, 0xf6, 0x0a, 0x34,
+	0x40, 0x7b, 0xb0, 0xe9, 0x8f, 0xb1, 0x10, 0x76, 0x41, 0x85, 0x3c, 0xe3, 0xa0, 0x8f, 0xa0, 0x24,
+	0x16, 0x42, 0x92, 0x89, 0x5d, 0x6c, 0x5b, 0xdd, 0xea, 0xe1, 0x6e, 0x2f, 0xf7, 0x45, 0xbd, 0x33,
+	0x1d, 0x72, 0x37, 0x5e, 0x47, 0xce, 0x0d, 0x2f, 0x21, 0xa2, 0xc7, 0xb0, 0x2d, 0x66, 0x43, 0xe1,
+	0x87, 0x74, 0x2a, 0x29, 0x67, 0xc2, 0xde, 0x68, 0x17, 0xbb, 0x15, 0x77, 0x27, 0x8e, 0x9c, 0xf5,
+	0x80, 0xb7, 0xee, 0xa2, 0xfb, 0x50, 0x19, 0x63, 0x21, 0x07, 0x82, 0x10, 0x66, 0x6f, 0xb6, 0xad,
+	0x6e, 0xd1, 0xdd, 0x8e, 0x23, 0x67, 0x05, 0x7a, 0x5b, 0xca, 0x3c, 0x23, 0x84, 0xa1, 0x1e, 0x40,
+	0x40, 0x42, 0x32, 0xa2, 0x42, 0x92, 0xd0, 0x2e, 0xb5, 0xad, 0xee, 0x96, 0x5b, 0x8f, 0x23, 0x27,
+	0x87, 0x7a, 0x39, 0x1b, 0xf5, 0xa1, 0x9e, 0x7a, 0x21, 0x56, 0xdb, 0xd9, 0x65, 0x7d, 0x9e, 0xff,
+	0xaf, 0x9d, 0xe7, 0x78, 0x8d, 0x92, 0x9c, 0xeb, 0xad, 0x44, 0xe4, 0xc2, 0xce, 0x15, 0x21, 0x53,
+	0x3c, 0xa6, 0x73, 0x32, 0x90, 0x74, 0x42, 0xf8, 0x4c, 0xda, 0x5b, 0x6d, 0xab, 0xbb, 0xed, 0xfe,
+	0x2f, 0x8e, 0x9c, 0xeb, 0x41, 0xaf, 0x91, 0x41, 0xe7, 0x06, 0x41, 0x6d, 0xa8, 0x12, 0x36, 0xa7,
+	0x21, 0x67, 0x13, 0xc2, 0xa4, 0x5d, 0xd1, 0x25, 0xcf, 0x43, 0xa8, 0x03, 0x35, 0x1e, 0x8e, 0x30,
+	0xa3, 0xaf, 0xcc, 0xe7, 0x82, 0xa6, 0xac, 0x61, 0x08, 0xc1, 0xc6, 0x4c, 0x90, 0xd0, 0xae, 0xea,
+	0x98, 0xb6, 0xd1, 0x33, 0xd8, 0x25, 0x3f, 0x4a, 0xc2, 0x02, 0x12, 0x0c, 0xb0, 0x94, 0x21, 0x1d,
+	0xce, 0x24, 0x11, 0x76, 0xad, 0x6d, 0x75, 0x6b, 0xee, 0x81, 0x3a, 0xd0, 0x6f, 0x91, 0x03, 0x4f,
+	0xb3, 0x48, 0x1c, 0x39, 0xd6, 0x43, 0x0f, 0xa5, 0x39, 0xab, 0x00, 0xda, 0x87, 0x52, 0x48, 0x02,
+	0xec, 0x4b, 0x7b, 0x5b, 0x35, 0xd0, 0x4b, 0x3c, 0xe4, 0xc3, 0xad, 0xd5, 0x11, 0x5f, 0xe2, 0x90,
+	0x51, 0x36, 0xca, 0xea, 0x50, 0xd7, 0x75, 0xb8, 0x17, 0x47, 0xce, 0xdd, 0x77, 0x92, 0x1e, 0xf0,
+	0x09, 0x95, 0x64, 0x32, 0x95, 0x0b, 0xef, 0x20, 0x23, 0x7d, 0x67, 0x38, 0x69, 0x81, 0x2e, 0xa0,
+	0xb9, 0xca, 0xf7, 0x43, 0x2a, 0xa9, 0x8f, 0xc7, 0xd9, 0x2e, 0x37, 0xf5, 0x2e, 0xdd, 0x38, 0x72,
+	0xde, 0x7b, 0x37, 0x2b, 0xb7, 0x8d, 0x9d, 0xb1, 0x8e, 0x12, 0x52, 0xba, 0xcf, 0x29, 0xa0, 0xd5,
+	0x0a, 0x97, 0x98, 0x05, 0x63, 0x12, 0x0a, 0xbb, 0xa1, 0x27, 0xb6, 0x1d, 0x47, 0xce, 0xed, 0xeb,
+	0xd1, 0xdc, 0xba, 0xab, 0x5e, 0x3f, 0x4b, 0x82, 0xa8, 0x0f, 0x5b, 0x4a, 0x77, 0x01, 0x96, 0xd8,
+	0xde, 0xd1, 0x23, 0x76, 0xb0, 0x36, 0x62, 0xa7, 0xc3, 0x1f, 0x88, 0x2f, 0x9f, 0x13, 0x89, 0xdd,
+	0x3d, 0xd5, 0x8d, 0x37, 0x91, 0x63, 0xc5, 0x91, 0x93, 0x25, 0x79, 0x99, 0xd5, 0xf9, 0xab, 0x08,
+	0x25, 0xa3, 0x30, 0xd4, 0x84, 0xad, 0x4b, 0x2e, 0x24, 0xc3, 0x13, 0x62, 0xa4, 0xeb, 0x65, 0xbe,
+	0x12, 0x34, 0x4f, 0x54, 0x6b, 0x04, 0x7d, 0x7a, 0xe6, 0x15, 0xb8, 0x50, 0x39, 0xd3, 0x31, 0x96,
+	0x17, 0x3c, 0x34, 0xe2, 0xad, 0x78, 0x99, 0x8f, 0xee, 0xc1, 0xcd, 0xd4, 0x1e, 0x5c, 0xe0, 0x09,
+	0x1d, 0x2f, 0xec, 0x0d, 0x4d, 0xa9, 0xa7, 0xf0, 0x97, 0x1a, 0x45, 0x1f, 0x40, 0x23, 0x23, 0xce,
+	0x49, 0x28, 0x28, 0x37, 0xd2, 0xac, 0x78, 0xd9, 0x02, 0xdf, 0x1a, 0x18, 0x7d, 0x02, 0x65, 0x46,
+	0xe4, 0x4b, 0x1e, 0x5e, 0x69, 0x3d, 0x56, 0x0f, 0xf7, 0xd6, 0x0e, 0xfe, 0x95, 0x89, 0x25, 0xa2,
+	0x4a, 0xa9, 0x6a, 0x86, 0x71, 0xe8, 0x5f, 0x6a, 0x39, 0x56, 0x3c, 0x6d, 0xa3, 0x27, 0xea, 0x2a,
+	0xe2, 0xb3, 0x40, 0xab, 0xaa, 0x7a, 0x88, 0xd6, 0xd6, 0x39, 0x52, 0x11, 0x77, 0x37, 0x8e, 0x9c,
+	0x9b, 0x9a, 0x94, 0x6b, 0x87, 0xc9, 0x42, 0xc7, 0x00, 0x5c, 0x0c, 0x48, 0x40, 0xb5, 0x70, 0xb4,
+	0xb6, 0xdc, 0xf7, 0x97, 0x91, 0x53, 0x39, 0x3d, 0x3b, 0x31, 0x60, 0x1c, 0x39, 0x7b, 0x2b, 0x4a,
+	0x6e, 0x85, 0x0a, 0x17, 0x09, 0x05, 0x3d, 0x81, 0x9a, 0x20, 0xe1, 0x9c, 0xfa, 0x64, 0x30, 0xc5,
+	0xfe, 0x95, 0x11, 0xa0, 0xdb, 0x8c, 0x23, 0x67, 0x3f, 0x8f, 0xe7, 0x92, 0xab, 0x09, 0xfe, 0x35,
+	0xf6, 0xaf, 0xd0, 0x03, 0x28, 0x05, 0x7c, 0x82, 0x29, 0x33, 0xea, 0x74, 0xf7, 0xe2, 0xc8, 0x69,
+	0x18, 0x24, 0x97, 0x92, 0x70, 0x3a, 0xdf, 0x43, 0x39, 0xa9, 0x0f, 0xfa, 0x06, 0x80, 0x32, 0x49,
+	0xc2, 0x0b, 0xec, 0x13, 0x61, 0x5b, 0xed, 0x62, 0xb7, 0x7a, 0x78, 0xe7, 0xbf, 0x2a, 0xd9, 0x4f,
+	0x59, 0x2e, 0x52, 0x25, 0x55, 0x97, 0xdf, 0x2a, 0xd1, 0xcb, 0xd9, 0x1d, 0x06, 0x8d, 0xb7, 0x73,
+	0x54, 0xdd, 0x73, 0xd3, 0xa4, 0x6d, 0x74, 0x0b, 0x8a, 0x13, 0xec, 0x27, 0xa3, 0x54, 0x5e, 0x46,
+	0x4e, 0xf1, 0xf9, 0xd3, 0x23, 0x4f, 0x61, 0xe8, 0x43, 0xa8, 0xe0, 0x20, 0x08, 0x89, 0x10, 0x44,
+	0xd8, 0x45, 0x2d, 0x0f, 0x7d, 0x37, 0x67, 0xa0, 0xb7, 0x32, 0x3b, 0xf7, 0xa1, 0xbe, 0x7e, 0x93,
+	0x22, 0x1b, 0xca, 0x89, 0x7c, 0x92, 0x0d, 0x53, 0xb7, 0xf3, 0x77, 0x01, 0x36, 0x75, 0x4b, 0xf5,
+	0xbc, 0x86, 0x7c, 0x4e, 0x83, 0x8c, 0x94, 0xf9, 0xe8, 0x0b, 0xa8, 0x52, 0x26, 0x24, 0x66, 0x3e,
+	0x19, 0xd0, 0x20, 0xf9, 0xc2, 0x3b, 0xcb, 0xc8, 0x81, 0x7e, 0x02, 0xf7, 0x8f, 0xe3, 0xc8, 0xc9,
+	0x93, 0x3c, 0x48, 0x9d, 0x7e, 0x80, 0x3e, 0x85, 0xed, 0x2c, 0xa4, 0x8a, 0x68, 0x04, 0x61, 0xde,
+	0xa4, 0xb5, 0x80, 0x57, 0x4b, 0xdd, 0xf3, 0xc5, 0x94, 0x98, 0x3b, 0x70, 0xa4, 0xc6, 0xc8, 0xc8,
+	0x23, 0xf1, 0xd4, 0x1b, 0x80, 0xe7, 0x98, 0x8e, 0xf1, 0x90, 0x8e, 0xa9, 0x5c, 0x0c, 0x5e, 0x71,
+	0x46, 0x8c, 0x2e, 0xcc, 0x1b, 0x70, 0x2d, 0xe8, 0x35, 0xf2, 0xd0, 0x0b, 0xce, 0x08, 0x3a, 0x86,
+	0x0d, 0x89, 0x47, 0xc2, 0x2e, 0xe9, 0x16, 0xdf, 0xbe, 0x3e, 0xe4, 0xbd, 0x73, 0x3c, 0x12, 0x27,
+	0x4c, 0x86, 0x0b, 0x17, 0xc5, 0x91, 0x53, 0x57, 0xec, 0xdc, 0xec, 0xe8, 0xec, 0xe6, 0x63, 0xa8,
+	0x64, 0x34, 0xd4, 0x80, 0xe2, 0x15, 0x59, 0x24, 0xd5, 0x53, 0xa6, 0x7a, 0xd5, 0xe7, 0x78, 0x3c,
+	0x23, 0xe9, 0xab, 0xae, 0x9d, 0xcf, 0x0b, 0x9f, 0x59, 0xee, 0xdd, 0x7f, 0xfe, 0x68, 0x59, 0x3f,
+	0x2f, 0x5b, 0xd6, 0xaf, 0xcb, 0x96, 0xf5, 0x7a, 0xd9, 0xb2, 0xde, 0x2c, 0x5b, 0xd6, 0xef, 0xcb,
+	0x96, 0xf5, 0xd3, 0x9f, 0xad, 0x1b, 0x2f, 0x36, 0xf5, 0x77, 0x0c, 0x4b, 0xfa, 0xf7, 0xe1, 0xe3,
+	0x7f, 0x07, 0x00, 0x8a, 0x47, 0x8a, 0xa5, 0x96, 0x08, 0x00, 0x00,
 }
@@ -32,6 +32,46 @@ func TestEntityValidate(t *testing.T) {
 	assert.NoError(t, e.Validate())
 }
 
+func TestEntityValidateKeepaliveTimeouts(t *testing.T) {
+	e := FixtureEntity("entity")
+
+	// Critical timeout before the keepalive timeout
+	e.KeepaliveCriticalTimeout = 120
+	assert.Error(t, e.Validate())
+
+	e.KeepaliveCriticalTimeout = 180
+	assert.NoError(t, e.Validate())
+
+	// Critical timeout before the warning timeout
+	e.KeepaliveWarningTimeout = 180
+	assert.Error(t, e.Validate())
+
+	e.KeepaliveWarningTimeout = 60
+	assert.NoError(t, e.Validate())
+}
+
+func TestEntityValidateClass(t *testing.T) {
+	e := FixtureEntity("entity")
+	for _, class := range EntityClasses {
+		e.Class = class
+		assert.NoError(t, e.Validate())
+	}
+
+	e.Class = "router"
+	assert.Error(t, e.Validate())
+}
+
+func TestEntityHasKeepalives(t *testing.T) {
+	e := FixtureEntity("entity")
+	assert.True(t, e.HasKeepalives())
+
+	e.Class = EntityServiceClass
+	assert.True(t, e.HasKeepalives())
+
+	e.Class = EntityProxyClass
+	assert.False(t, e.HasKeepalives())
+}
+
 func TestFixtureEntityIsValid(t *testing.T) {
 	e := FixtureEntity("entity")
 	assert.Equal(t, "entity", e.ID)
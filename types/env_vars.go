@@ -6,32 +6,31 @@ import (
 )
 
 func validateVar(v string) error {
-	parts := strings.Split(v, "=")
+	parts := strings.SplitN(v, "=", 2)
 	if len(parts) != 2 {
-		return errors.New("environment variables must be of the form FOO=BAR")
+		return errors.New("must be of the form FOO=BAR")
 	}
 	if len(parts[0]) == 0 || len(parts[1]) == 0 {
-		return errors.New("environment variables must be of the form FOO=BAR")
+		return errors.New("must be of the form FOO=BAR")
 	}
 	return nil
 }
 
 // ValidateEnvVars ensures that all the environment variables are well-formed.
-// Vars should be of the form FOO=BAR.
+// Vars should be of the form FOO=BAR, where BAR may contain equal signs.
 func ValidateEnvVars(vars []string) error {
-	for _, v := range vars {
-		if err := validateVar(v); err != nil {
-			return err
-		}
+	var errs FieldErrors
+	for i, v := range vars {
+		errs.AddError(FieldPath(i), validateVar(v))
 	}
-	return nil
+	return errs.Err()
 }
 
 // EnvVarsToMap converts a list of FOO=BAR key-value pairs into a map.
 func EnvVarsToMap(vars []string) map[string]string {
 	result := make(map[string]string, len(vars))
 	for _, v := range vars {
-		parts := strings.Split(v, "=")
+		parts := strings.SplitN(v, "=", 2)
 		if len(parts) == 1 {
 			continue
 		}
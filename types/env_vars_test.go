@@ -18,6 +18,10 @@ func TestValidateEnvVars(t *testing.T) {
 			Name:    "it should work",
 			EnvVars: []string{"FOO=BAR", "BAZ=FOOBAR"},
 		},
+		{
+			Name:    "values with equal signs",
+			EnvVars: []string{"TOKEN=dG9rZW4=", "QUERY=a=b&c=d"},
+		},
 		{
 			Name:     "it should not work",
 			EnvVars:  []string{"FOO=BAR", "foo:bar"},
@@ -53,6 +57,11 @@ func TestEnvVarsToMap(t *testing.T) {
 			EnvVars: []string{"FOO=BAR", "BAZ=FOOBAR"},
 			Exp:     map[string]string{"FOO": "BAR", "BAZ": "FOOBAR"},
 		},
+		{
+			Name:    "values with equal signs",
+			EnvVars: []string{"TOKEN=dG9rZW4="},
+			Exp:     map[string]string{"TOKEN": "dG9rZW4="},
+		},
 		{
 			Name:    "some invalid",
 			EnvVars: []string{"FOO=BAR", "foo:bar"},
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"errors"
 	fmt "fmt"
 	"net/url"
 )
@@ -13,15 +12,14 @@ const (
 
 // Validate returns an error if the environment does not pass validation tests.
 func (e *Environment) Validate() error {
-	if err := ValidateName(e.Name); err != nil {
-		return errors.New("environment name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(e.Name))
 
 	if len(e.Organization) == 0 {
-		return errors.New("environment organization must be set")
+		errs.Add("organization", "must be set")
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // FixtureEnvironment returns a mocked environment.
@@ -43,6 +41,10 @@ func (e *Environment) Update(from *Environment, fields ...string) error {
 		switch f {
 		case "Description":
 			e.Description = from.Description
+		case "EventRetention":
+			e.EventRetention = from.EventRetention
+		case "Redact":
+			e.Redact = append([]string(nil), from.Redact...)
 		default:
 			return fmt.Errorf("unsupported update field: %q", f)
 		}
@@ -54,3 +56,14 @@ func (e *Environment) Update(from *Environment, fields ...string) error {
 func (e *Environment) URIPath() string {
 	return fmt.Sprintf("/rbac/organizations/%s/environments/%s", url.PathEscape(e.Organization), url.PathEscape(e.Name))
 }
+
+// GetObjectMeta implements MetaResource.
+func (e *Environment) GetObjectMeta() ObjectMeta {
+	return objectMeta(e.ObjectMeta, e.Name, e.Organization, "")
+}
+
+// SetObjectMeta implements MetaResource.
+func (e *Environment) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&e.Name, &e.Organization, nil)
+	e.ObjectMeta = meta
+}
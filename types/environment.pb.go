@@ -20,6 +20,16 @@ type Environment struct {
 	Description  string `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
 	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Organization string `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	// EventRetention is the number of days resolved events of the environment,
+	// including the keepalive events of entities which stopped sending them, are
+	// kept in the store before being purged. They are kept indefinitely if zero.
+	EventRetention uint32 `protobuf:"varint,4,opt,name=event_retention,json=eventRetention,proto3" json:"event_retention"`
+	// Metadata contains the name, namespace, labels and annotations of the
+	// environment
+	ObjectMeta `protobuf:"bytes,5,opt,name=metadata,embedded=metadata" json:"metadata"`
+	// Redact contains the fields redacted from the events of the environment, in
+	// addition to those of their entity
+	Redact []string `protobuf:"bytes,6,rep,name=redact" json:"redact,omitempty"`
 }
 
 func (m *Environment) Reset()                    { *m = Environment{} }
@@ -48,6 +58,20 @@ func (m *Environment) GetOrganization() string {
 	return ""
 }
 
+func (m *Environment) GetEventRetention() uint32 {
+	if m != nil {
+		return m.EventRetention
+	}
+	return 0
+}
+
+func (m *Environment) GetRedact() []string {
+	if m != nil {
+		return m.Redact
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Environment)(nil), "sensu.types.Environment")
 }
@@ -79,6 +103,20 @@ func (this *Environment) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if this.EventRetention != that1.EventRetention {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if len(this.Redact) != len(that1.Redact) {
+		return false
+	}
+	for i := range this.Redact {
+		if this.Redact[i] != that1.Redact[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Environment) Marshal() (dAtA []byte, err error) {
@@ -114,6 +152,34 @@ func (m *Environment) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEnvironment(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if m.EventRetention != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEnvironment(dAtA, i, uint64(m.EventRetention))
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintEnvironment(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -131,6 +197,14 @@ func NewPopulatedEnvironment(r randyEnvironment, easy bool) *Environment {
 	this.Description = string(randStringEnvironment(r))
 	this.Name = string(randStringEnvironment(r))
 	this.Organization = string(randStringEnvironment(r))
+	this.EventRetention = uint32(r.Uint32())
+	v3 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v3
+	v4 := r.Intn(10)
+	this.Redact = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Redact[i] = string(randStringEnvironment(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -223,6 +297,17 @@ func (m *Environment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEnvironment(uint64(l))
 	}
+	if m.EventRetention != 0 {
+		n += 1 + sovEnvironment(uint64(m.EventRetention))
+	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovEnvironment(uint64(l))
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			l = len(s)
+			n += 1 + l + sovEnvironment(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -355,6 +440,84 @@ func (m *Environment) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRetention", wireType)
+			}
+			m.EventRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventRetention |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnvironment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnvironment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnvironment(dAtA[iNdEx:])
@@ -484,18 +647,26 @@ var (
 func init() { proto.RegisterFile("environment.proto", fileDescriptorEnvironment) }
 
 var fileDescriptorEnvironment = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0xcd, 0x2b, 0xcb,
-	0x2c, 0xca, 0xcf, 0xcb, 0x4d, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e,
-	0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a,
-	0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0xa9, 0x82, 0x8b, 0xdb, 0x15, 0x61, 0xa0,
-	0x90, 0x21, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x13, 0xff, 0xab, 0x7b, 0xf2, 0xc8, 0xc2, 0x41, 0xc8, 0x1c, 0x21,
-	0x21, 0x2e, 0x96, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x26, 0x90, 0xda, 0x20, 0x30, 0x5b, 0x48, 0x89,
-	0x8b, 0x27, 0xbf, 0x28, 0x3d, 0x31, 0x2f, 0xb3, 0x2a, 0x11, 0x6c, 0x0e, 0x33, 0x58, 0x0e, 0x45,
-	0xcc, 0x49, 0xf9, 0xc7, 0x43, 0x39, 0xc6, 0x15, 0x8f, 0xe4, 0x18, 0x77, 0x3c, 0x92, 0x63, 0x3c,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x67, 0x3c, 0x96, 0x63,
-	0x88, 0x62, 0x05, 0xfb, 0x26, 0x89, 0x0d, 0xec, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xfa, 0x34, 0x46, 0xc0, 0xf6, 0x00, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0xeb, 0xfe, 0x89, 0x3a, 0x40, 0xc1, 0x54, 0x22, 0xea, 0xe0, 0x44, 0x65, 0xc9, 0x50,
+	0x52, 0x01, 0x2b, 0x53, 0x24, 0x06, 0x06, 0x84, 0x94, 0x91, 0x05, 0x39, 0xe9, 0x25, 0x04, 0x29,
+	0x76, 0x94, 0xdc, 0x56, 0x2a, 0x4f, 0xc2, 0x23, 0xb0, 0xb1, 0xf2, 0x08, 0x1d, 0xfb, 0x04, 0x11,
+	0x84, 0x2d, 0x4f, 0xc0, 0x88, 0xea, 0x42, 0x29, 0x6c, 0xe7, 0x9c, 0xfb, 0xdd, 0x6b, 0xf9, 0xd0,
+	0x7d, 0x90, 0xd3, 0x38, 0x53, 0x32, 0x01, 0x89, 0x6e, 0x9a, 0x29, 0x54, 0xcc, 0xc8, 0x41, 0xe6,
+	0x13, 0x17, 0x67, 0x29, 0xe4, 0xfd, 0xe3, 0x28, 0xc6, 0xfb, 0x49, 0xe0, 0x86, 0x2a, 0x19, 0x45,
+	0x2a, 0x52, 0x23, 0xcd, 0x04, 0x93, 0x3b, 0xed, 0xb4, 0xd1, 0x6a, 0xb5, 0xdb, 0xa7, 0x09, 0xa0,
+	0x58, 0xe9, 0xc1, 0x4b, 0x9d, 0x1a, 0x17, 0xbf, 0xd7, 0xd9, 0x09, 0x35, 0xc6, 0x90, 0x87, 0x59,
+	0x9c, 0x62, 0xac, 0xa4, 0x49, 0x6c, 0xe2, 0x74, 0xbc, 0x6e, 0x55, 0x58, 0x9b, 0xb1, 0xbf, 0x69,
+	0x18, 0xa3, 0x4d, 0x29, 0x12, 0x30, 0xeb, 0x4b, 0xd6, 0xd7, 0x9a, 0x0d, 0xe8, 0xb6, 0xca, 0x22,
+	0x21, 0xe3, 0x47, 0xa1, 0xef, 0x34, 0xf4, 0xec, 0x4f, 0xc6, 0xce, 0x69, 0x17, 0xa6, 0x20, 0xf1,
+	0x36, 0x03, 0x04, 0xa9, 0xb1, 0xa6, 0x4d, 0x9c, 0x1d, 0xef, 0xa0, 0x2a, 0xac, 0xff, 0x23, 0x7f,
+	0x57, 0x07, 0xfe, 0x8f, 0x67, 0x97, 0x74, 0x6b, 0xf9, 0x8d, 0xb1, 0x40, 0x61, 0xb6, 0x6c, 0xe2,
+	0x18, 0xa7, 0x87, 0xee, 0x46, 0x27, 0xee, 0x75, 0xf0, 0x00, 0x21, 0x5e, 0x01, 0x0a, 0xaf, 0x37,
+	0x2f, 0xac, 0xda, 0xa2, 0xb0, 0x48, 0x55, 0x58, 0xeb, 0x25, 0x7f, 0xad, 0xd8, 0x90, 0xb6, 0x33,
+	0x18, 0x8b, 0x10, 0xcd, 0xb6, 0xdd, 0x70, 0x3a, 0x5e, 0xaf, 0x2a, 0xac, 0xbd, 0x55, 0x32, 0x54,
+	0x49, 0x8c, 0x90, 0xa4, 0x38, 0xf3, 0xbf, 0x19, 0xef, 0xe8, 0xf3, 0x9d, 0x93, 0xe7, 0x92, 0x93,
+	0xd7, 0x92, 0x93, 0x79, 0xc9, 0xc9, 0xa2, 0xe4, 0xe4, 0xad, 0xe4, 0xe4, 0xe9, 0x83, 0xd7, 0x6e,
+	0x5a, 0xfa, 0xf5, 0xa0, 0xad, 0xdb, 0x3d, 0xfb, 0x1a, 0x00, 0x4d, 0x70, 0xf9, 0x58, 0xba, 0x01,
+	0x00, 0x00,
 }
@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"sort"
 	"time"
+
+	"github.com/sensu/sensu-go/types/dynamic"
 )
 
 // EventFailingState indicates failing check result status
@@ -73,37 +75,30 @@ func (e *Event) UnmarshalJSON(b []byte) error {
 
 // Validate returns an error if the event does not pass validation tests.
 func (e *Event) Validate() error {
+	var errs FieldErrors
 	if e.Entity == nil {
-		return errors.New("event must contain an entity")
+		errs.Add("entity", "must be set")
+	} else {
+		errs.AddError("entity", e.Entity.Validate())
 	}
 
 	if !e.HasCheck() && !e.HasMetrics() {
-		return errors.New("event must contain a check or metrics")
-	}
-
-	if err := e.Entity.Validate(); err != nil {
-		return errors.New("entity " + err.Error())
+		errs.Add("", "must contain a check or metrics")
 	}
 
 	if e.HasCheck() {
-		if err := e.Check.Validate(); err != nil {
-			return errors.New("check " + err.Error())
-		}
+		errs.AddError("check", e.Check.Validate())
 	}
 
 	if e.HasMetrics() {
-		if err := e.Metrics.Validate(); err != nil {
-			return errors.New("metrics " + err.Error())
-		}
+		errs.AddError("metrics", e.Metrics.Validate())
 	}
 
-	for _, hook := range e.Hooks {
-		if err := hook.Validate(); err != nil {
-			return errors.New("hook " + err.Error())
-		}
+	for i, hook := range e.Hooks {
+		errs.AddError(FieldPath("hooks", i), hook.Validate())
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // HasCheck determines if an event has check data.
@@ -116,9 +111,10 @@ func (e *Event) HasMetrics() bool {
 	return e.Metrics != nil
 }
 
-// IsIncident determines if an event indicates an incident.
+// IsIncident determines if an event indicates an incident, the status of its
+// check having another severity than ok.
 func (e *Event) IsIncident() bool {
-	return e.HasCheck() && e.Check.Status != 0
+	return e.HasCheck() && Severity(e.Check.Status, e.Check.Severities) != SeverityOK
 }
 
 // IsResolution returns true if an event has just transitionned from an incident
@@ -128,12 +124,18 @@ func (e *Event) IsResolution() bool {
 	}
 
 	// Try to retrieve the previous status in the check history and verify if it
-	// was a non-zero status, therefore indicating a resolution
-	isResolution := (len(e.Check.History) > 0 &&
-		e.Check.History[len(e.Check.History)-1].Status != 0 &&
-		!e.IsIncident())
+	// had another severity than ok, therefore indicating a resolution
+	if len(e.Check.History) == 0 || e.IsIncident() {
+		return false
+	}
+	previous := e.Check.History[len(e.Check.History)-1].Status
+	return Severity(previous, e.Check.Severities) != SeverityOK
+}
 
-	return isResolution
+// IsFlapping determines if the check of an event is flapping, its status
+// changing too often according to the flap thresholds of the check.
+func (e *Event) IsFlapping() bool {
+	return e.HasCheck() && e.Check.State == EventFlappingState
 }
 
 // IsSilenced determines if an event has any silenced entries
@@ -145,6 +147,51 @@ func (e *Event) IsSilenced() bool {
 	return len(e.Check.Silenced) > 0
 }
 
+// Redact redacts the sensitive fields of the event: those of its entity, or
+// the default ones if it has none, along with the given ones. They are
+// redacted from the attributes of the entity, and from the command, hooks and
+// environment variables of the check.
+func (e *Event) Redact(fields []string) error {
+	if e.Entity == nil {
+		return nil
+	}
+
+	entityFields := e.Entity.Redact
+	if len(entityFields) == 0 {
+		entityFields = dynamic.DefaultRedactFields
+	}
+	fields = append(append([]string{}, entityFields...), fields...)
+
+	redacted, err := dynamic.Redact(e.Entity, fields...)
+	if err != nil {
+		return err
+	}
+	e.Entity = redacted.(*Entity)
+
+	if !e.HasCheck() {
+		return nil
+	}
+
+	redactor := getCommandRedactor(fields)
+	e.Check.Command = redactor.command(e.Check.Command)
+	e.Check.CommandArgs = redactor.commandArgs(e.Check.CommandArgs)
+	if len(e.Check.EnvVars) > 0 {
+		envVars := make([]string, len(e.Check.EnvVars))
+		for i, envVar := range e.Check.EnvVars {
+			envVars[i] = redactor.command(envVar)
+		}
+		e.Check.EnvVars = envVars
+	}
+	for _, hook := range e.Check.Hooks {
+		if hook != nil {
+			hook.Command = redactor.command(hook.Command)
+			hook.CommandArgs = redactor.commandArgs(hook.CommandArgs)
+		}
+	}
+
+	return nil
+}
+
 // Get implements govaluate.Parameters
 func (e *Event) Get(name string) (interface{}, error) {
 	switch name {
@@ -313,5 +360,28 @@ func (e *Event) URIPath() string {
 	if !e.HasCheck() {
 		return ""
 	}
-	return fmt.Sprintf("/events/%s/%s", url.PathEscape(e.Entity.ID), url.PathEscape(e.Check.Name))
+	return fmt.Sprintf("/events/%s/%s", url.PathEscape(e.StreamKey()), url.PathEscape(e.Check.Name))
+}
+
+// GetObjectMeta implements MetaResource.
+func (e *Event) GetObjectMeta() ObjectMeta {
+	if e.Entity == nil {
+		return e.ObjectMeta
+	}
+	return objectMeta(e.ObjectMeta, "", e.Entity.Organization, e.Entity.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (e *Event) SetObjectMeta(meta ObjectMeta) {
+	e.ObjectMeta = meta
+}
+
+// StreamKey returns the key identifying the event stream of the event, along
+// with the name of its check: its deduplication key, if any, or else the ID of
+// its entity.
+func (e *Event) StreamKey() string {
+	if e.DedupKey != "" {
+		return e.DedupKey
+	}
+	return e.Entity.ID
 }
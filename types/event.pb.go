@@ -29,6 +29,14 @@ type Event struct {
 	Silenced []string `protobuf:"bytes,5,rep,name=silenced" json:"silenced,omitempty"`
 	// Hooks describes the results of multiple hooks; if event is associated to hook execution.
 	Hooks []*Hook `protobuf:"bytes,6,rep,name=hooks" json:"hooks,omitempty"`
+	// RequestID identifies the API request or the agent message the event
+	// originates from, to trace it through the pipeline.
+	RequestID string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// DedupKey is the rendered deduplication key of the check of the event,
+	// which identifies its event stream in place of its entity.
+	DedupKey string `protobuf:"bytes,8,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the event
+	ObjectMeta `protobuf:"bytes,9,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Event) Reset()                    { *m = Event{} }
@@ -78,6 +86,20 @@ func (m *Event) GetHooks() []*Hook {
 	return nil
 }
 
+func (m *Event) GetRequestID() string {
+	if m != nil {
+		return m.RequestID
+	}
+	return ""
+}
+
+func (m *Event) GetDedupKey() string {
+	if m != nil {
+		return m.DedupKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Event)(nil), "sensu.types.Event")
 }
@@ -128,6 +150,15 @@ func (this *Event) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.DedupKey != that1.DedupKey {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -207,6 +238,26 @@ func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.RequestID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RequestID)))
+		i += copy(dAtA[i:], m.RequestID)
+	}
+	if len(m.DedupKey) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DedupKey)))
+		i += copy(dAtA[i:], m.DedupKey)
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintEvent(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n4, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
 	return i, nil
 }
 
@@ -246,6 +297,10 @@ func NewPopulatedEvent(r randyEvent, easy bool) *Event {
 			this.Hooks[i] = NewPopulatedHook(r, easy)
 		}
 	}
+	this.RequestID = string(randStringEvent(r))
+	this.DedupKey = string(randStringEvent(r))
+	v5 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v5
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -353,6 +408,16 @@ func (m *Event) Size() (n int) {
 			n += 1 + l + sovEvent(uint64(l))
 		}
 	}
+	l = len(m.RequestID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DedupKey)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
@@ -576,6 +641,94 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DedupKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -705,24 +858,32 @@ var (
 func init() { proto.RegisterFile("event.proto", fileDescriptorEvent) }
 
 var fileDescriptorEvent = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x79, 0x4d, 0x13, 0xa8, 0x03, 0x03, 0x86, 0xc1, 0xaa, 0x90, 0x89, 0xca, 0x92, 0x05,
-	0x57, 0x14, 0x4e, 0x10, 0x54, 0x89, 0x85, 0x25, 0x23, 0x1b, 0x09, 0x26, 0x89, 0x4a, 0xe2, 0xa8,
-	0x76, 0x90, 0x7a, 0x0e, 0x16, 0x8e, 0xc0, 0x11, 0x38, 0x42, 0x47, 0x4e, 0x80, 0x20, 0x5c, 0x82,
-	0x11, 0xc5, 0x36, 0x85, 0x6c, 0xfe, 0xdf, 0xff, 0x7f, 0x4f, 0xbf, 0x1f, 0xf2, 0xf9, 0x23, 0xaf,
-	0x14, 0xab, 0x97, 0x42, 0x09, 0xec, 0x4b, 0x5e, 0xc9, 0x86, 0xa9, 0x55, 0xcd, 0xe5, 0xf8, 0x34,
-	0x2b, 0x54, 0xde, 0x24, 0x2c, 0x15, 0xe5, 0x34, 0x13, 0x99, 0x98, 0xea, 0x4c, 0xd2, 0xdc, 0x6b,
-	0xa5, 0x85, 0x7e, 0x19, 0x76, 0xbc, 0xcb, 0x2b, 0x55, 0xa8, 0x95, 0x55, 0x7e, 0x9a, 0xf3, 0x74,
-	0x61, 0xc5, 0x5e, 0xc9, 0xd5, 0xb2, 0x48, 0xa5, 0x95, 0x28, 0x17, 0xc2, 0x5a, 0x93, 0xa7, 0x01,
-	0x72, 0xe7, 0x5d, 0x03, 0x7c, 0x84, 0x46, 0xaa, 0x28, 0xb9, 0x54, 0xb7, 0x65, 0x4d, 0x20, 0x80,
-	0xd0, 0x89, 0xff, 0x06, 0xf8, 0x0c, 0x79, 0x66, 0x3f, 0x19, 0x04, 0x10, 0xfa, 0xb3, 0x03, 0xf6,
-	0xaf, 0x2a, 0x9b, 0x6b, 0x2b, 0x1a, 0xae, 0xdf, 0x8f, 0x21, 0xb6, 0x41, 0xcc, 0x90, 0xab, 0x4b,
-	0x10, 0x47, 0x13, 0xb8, 0x47, 0x5c, 0x76, 0x8e, 0x05, 0x4c, 0x0c, 0x5f, 0xa0, 0x6d, 0xdb, 0x93,
-	0x0c, 0x35, 0x71, 0xd8, 0x23, 0xae, 0x8d, 0x67, 0x99, 0xdf, 0x28, 0x9e, 0xa0, 0x1d, 0x59, 0x3c,
-	0xf0, 0x2a, 0xe5, 0x77, 0xc4, 0x0d, 0x9c, 0x70, 0x14, 0x79, 0x5d, 0x80, 0x40, 0xbc, 0x99, 0xe3,
-	0x29, 0x72, 0xbb, 0x2f, 0x4b, 0xe2, 0x05, 0x4e, 0xe8, 0xcf, 0xf6, 0x7b, 0x7b, 0xaf, 0x84, 0x58,
-	0x6c, 0x18, 0x93, 0x8b, 0x4e, 0xbe, 0x3f, 0x29, 0xbc, 0xb4, 0x14, 0x5e, 0x5b, 0x0a, 0xeb, 0x96,
-	0xc2, 0x5b, 0x4b, 0xe1, 0xa3, 0xa5, 0xf0, 0xfc, 0x45, 0xb7, 0x6e, 0x5c, 0x0d, 0x26, 0x9e, 0xbe,
-	0xe0, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x59, 0x95, 0xa1, 0xc2, 0x01, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xc1, 0x6e, 0x94, 0x50,
+	0x14, 0x86, 0x7b, 0xa5, 0x4c, 0x87, 0x8b, 0x2e, 0xbc, 0x9d, 0xa4, 0x37, 0x8d, 0x01, 0x52, 0x63,
+	0xc2, 0x42, 0x99, 0x58, 0xfb, 0x04, 0xd8, 0x26, 0x4e, 0x4c, 0x63, 0xc2, 0xd2, 0x4d, 0xc3, 0xc0,
+	0x71, 0x06, 0x47, 0xb8, 0x38, 0xf7, 0x60, 0xc2, 0x9b, 0xf8, 0x08, 0x3e, 0x82, 0x8f, 0x30, 0xcb,
+	0xae, 0x5d, 0x10, 0xc5, 0xdd, 0x3c, 0x81, 0x4b, 0xc3, 0xe5, 0x96, 0x96, 0xdd, 0xf9, 0xff, 0xf3,
+	0x7f, 0x67, 0xfe, 0x01, 0xa8, 0x0d, 0xdf, 0xa0, 0xc0, 0xa0, 0xdc, 0x0a, 0x14, 0xcc, 0x96, 0x50,
+	0xc8, 0x2a, 0xc0, 0xba, 0x04, 0x79, 0xfa, 0x6a, 0x95, 0xe1, 0xba, 0x5a, 0x06, 0x89, 0xc8, 0xe7,
+	0x2b, 0xb1, 0x12, 0x73, 0x95, 0x59, 0x56, 0x9f, 0x94, 0x52, 0x42, 0x4d, 0x3d, 0x7b, 0xfa, 0x18,
+	0x0a, 0xcc, 0xb0, 0xd6, 0xca, 0x4e, 0xd6, 0x90, 0x6c, 0xb4, 0x78, 0x92, 0x03, 0x6e, 0xb3, 0x44,
+	0x6a, 0x49, 0xd7, 0x42, 0xdc, 0xad, 0x68, 0x0e, 0x18, 0xf7, 0xf3, 0xd9, 0x2f, 0x83, 0x9a, 0x57,
+	0x5d, 0x1b, 0xf6, 0x8c, 0x5a, 0x98, 0xe5, 0x20, 0x31, 0xce, 0x4b, 0x4e, 0x3c, 0xe2, 0x1b, 0xd1,
+	0xbd, 0xc1, 0x5e, 0xd3, 0x49, 0xff, 0x5b, 0xfc, 0x91, 0x47, 0x7c, 0xfb, 0xfc, 0x38, 0x78, 0x50,
+	0x3b, 0xb8, 0x52, 0xab, 0xf0, 0x70, 0xd7, 0xb8, 0x24, 0xd2, 0x41, 0x16, 0x50, 0x53, 0x15, 0xe2,
+	0x86, 0x22, 0xd8, 0x88, 0x78, 0xdb, 0x6d, 0x34, 0xd0, 0xc7, 0xd8, 0x05, 0x3d, 0xd2, 0x9d, 0xf9,
+	0xa1, 0x22, 0x66, 0x23, 0xe2, 0xba, 0xdf, 0x69, 0xe6, 0x2e, 0xca, 0xce, 0xe8, 0x54, 0x66, 0x5f,
+	0xa0, 0x48, 0x20, 0xe5, 0xa6, 0x67, 0xf8, 0x56, 0x38, 0xe9, 0x02, 0x9c, 0x44, 0x83, 0xcf, 0xe6,
+	0xd4, 0xec, 0xfe, 0xbe, 0xe4, 0x13, 0xcf, 0xf0, 0xed, 0xf3, 0xa7, 0xa3, 0xbb, 0xef, 0x84, 0xd8,
+	0x0c, 0x4c, 0x9f, 0x63, 0x97, 0x94, 0x6e, 0xe1, 0x6b, 0x05, 0x12, 0x6f, 0xb2, 0x94, 0x1f, 0x79,
+	0xc4, 0xb7, 0xc2, 0x17, 0x6d, 0xe3, 0x5a, 0x51, 0xef, 0x2e, 0x2e, 0xf7, 0x8d, 0x3b, 0xbb, 0x8f,
+	0xbc, 0x14, 0x79, 0x86, 0x90, 0x97, 0x58, 0x47, 0x96, 0x76, 0x17, 0x29, 0xbb, 0xa0, 0x56, 0x0a,
+	0x69, 0x55, 0xde, 0x6c, 0xa0, 0xe6, 0x53, 0x75, 0xe4, 0x64, 0xdf, 0xb8, 0xc7, 0x83, 0xf9, 0x00,
+	0x9b, 0x2a, 0xf3, 0x3d, 0xd4, 0x6c, 0x41, 0xa7, 0xdd, 0xfb, 0x49, 0x63, 0x8c, 0xb9, 0xa5, 0x9e,
+	0xc3, 0xc9, 0xa8, 0xef, 0x87, 0xe5, 0x67, 0x48, 0xf0, 0x1a, 0x30, 0x0e, 0x67, 0xbb, 0xc6, 0x3d,
+	0xb8, 0x6d, 0x5c, 0xb2, 0x6f, 0xdc, 0x01, 0x8a, 0x86, 0x29, 0x7c, 0xfe, 0xef, 0x8f, 0x43, 0x7e,
+	0xb4, 0x0e, 0xf9, 0xd9, 0x3a, 0x64, 0xd7, 0x3a, 0xe4, 0xb6, 0x75, 0xc8, 0xef, 0xd6, 0x21, 0xdf,
+	0xff, 0x3a, 0x07, 0x1f, 0x4d, 0x75, 0x6f, 0x39, 0x51, 0x1f, 0xc2, 0x9b, 0xff, 0x03, 0x00, 0x96,
+	0x92, 0xe6, 0x9a, 0x95, 0x02, 0x00, 0x00,
 }
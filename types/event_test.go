@@ -5,6 +5,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,9 +74,10 @@ func TestEventHasMetrics(t *testing.T) {
 
 func TestEventIsIncident(t *testing.T) {
 	testCases := []struct {
-		name     string
-		status   uint32
-		expected bool
+		name       string
+		status     uint32
+		severities map[string]string
+		expected   bool
 	}{
 		{
 			name:     "OK Status",
@@ -87,13 +89,26 @@ func TestEventIsIncident(t *testing.T) {
 			status:   1,
 			expected: true,
 		},
+		{
+			name:       "Non-zero Status With OK Severity",
+			status:     1,
+			severities: map[string]string{"1": SeverityOK, "0": SeverityCritical},
+			expected:   false,
+		},
+		{
+			name:       "Zero Status With Critical Severity",
+			status:     0,
+			severities: map[string]string{"1": SeverityOK, "0": SeverityCritical},
+			expected:   true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			event := &Event{
 				Check: &Check{
-					Status: tc.status,
+					Status:     tc.status,
+					Severities: tc.severities,
 				},
 			}
 			incident := event.IsIncident()
@@ -104,10 +119,11 @@ func TestEventIsIncident(t *testing.T) {
 
 func TestEventIsResolution(t *testing.T) {
 	testCases := []struct {
-		name     string
-		history  []CheckHistory
-		status   uint32
-		expected bool
+		name       string
+		history    []CheckHistory
+		status     uint32
+		severities map[string]string
+		expected   bool
 	}{
 		{
 			name:     "check has no history",
@@ -142,14 +158,25 @@ func TestEventIsResolution(t *testing.T) {
 			status:   1,
 			expected: false,
 		},
+		{
+			name: "check has just transitioned to an ok severity",
+			history: []CheckHistory{
+				CheckHistory{Status: 0},
+				CheckHistory{Status: 2},
+			},
+			status:     1,
+			severities: map[string]string{"1": SeverityOK},
+			expected:   true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			event := &Event{
 				Check: &Check{
-					History: tc.history,
-					Status:  tc.status,
+					History:    tc.history,
+					Status:     tc.status,
+					Severities: tc.severities,
 				},
 			}
 			resolution := event.IsResolution()
@@ -196,6 +223,42 @@ func TestEventIsSilenced(t *testing.T) {
 	}
 }
 
+func TestEventIsFlapping(t *testing.T) {
+	testCases := []struct {
+		name     string
+		event    *Event
+		state    string
+		expected bool
+	}{
+		{
+			name:     "Passing check",
+			event:    FixtureEvent("entity1", "check1"),
+			state:    EventPassingState,
+			expected: false,
+		},
+		{
+			name:     "Flapping check",
+			event:    FixtureEvent("entity1", "check1"),
+			state:    EventFlappingState,
+			expected: true,
+		},
+		{
+			name:     "Metric without a check",
+			event:    &Event{},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.event.Check != nil {
+				tc.event.Check.State = tc.state
+			}
+			assert.Equal(t, tc.expected, tc.event.IsFlapping())
+		})
+	}
+}
+
 func TestEventsBySeverity(t *testing.T) {
 	critical := FixtureEvent("entity", "check")
 	critical.Check.Status = 2 // crit
@@ -308,3 +371,42 @@ func TestEventGet(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, event.Hooks, r)
 }
+
+func TestEventStreamKey(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	assert.Equal(t, "entity1", event.StreamKey())
+	assert.Equal(t, "/events/entity1/check1", event.URIPath())
+
+	event.DedupKey = "service1"
+	assert.Equal(t, "service1", event.StreamKey())
+	assert.Equal(t, "/events/service1/check1", event.URIPath())
+}
+
+func TestEventRedact(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	require.NoError(t, event.Entity.ExtendedAttributes.Set("password", "s3cr3t"))
+	require.NoError(t, event.Entity.ExtendedAttributes.Set("db_user", "sensu"))
+	event.Check.Command = "check-mysql --password s3cr3t --db-user sensu"
+	event.Check.EnvVars = []string{"TOKEN=abc", "PATH=/bin"}
+	event.Check.Hooks = []*Hook{FixtureHook("hook1")}
+	event.Check.Hooks[0].Command = "mysql --password=s3cr3t -e 'SHOW PROCESSLIST'"
+	event.Check.Hooks = append(event.Check.Hooks, FixtureHook("hook2"))
+	event.Check.Hooks[1].Command = ""
+	event.Check.Hooks[1].CommandArgs = []string{"mysql", "--password", "s3cr3t"}
+
+	require.NoError(t, event.Redact([]string{"db_user", "token"}))
+
+	assert.Equal(t, dynamic.Redacted, event.Entity.ExtendedAttributes.GetString("password"))
+	assert.Equal(t, dynamic.Redacted, event.Entity.ExtendedAttributes.GetString("db_user"))
+	assert.Equal(t, "check-mysql --password REDACTED --db-user REDACTED", event.Check.Command)
+	assert.Equal(t, []string{"TOKEN=REDACTED", "PATH=/bin"}, event.Check.EnvVars)
+	assert.Equal(t, "mysql --password=REDACTED -e 'SHOW PROCESSLIST'", event.Check.Hooks[0].Command)
+	assert.Equal(t, []string{"mysql", "--password", "REDACTED"}, event.Check.Hooks[1].CommandArgs)
+
+	// The redaction of an event without check is limited to its entity
+	event = &Event{Entity: FixtureEntity("entity1")}
+	event.Entity.Redact = []string{"rack"}
+	require.NoError(t, event.Entity.ExtendedAttributes.Set("rack", "r12"))
+	require.NoError(t, event.Redact(nil))
+	assert.Equal(t, dynamic.Redacted, event.Entity.ExtendedAttributes.GetString("rack"))
+}
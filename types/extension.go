@@ -1,23 +1,21 @@
 package types
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 )
 
 // Validate validates the extension.
 func (e *Extension) Validate() error {
-	if err := ValidateName(e.Name); err != nil {
-		return err
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(e.Name))
 	if e.URL == "" {
-		return errors.New("empty URL")
+		errs.Add("url", "must not be empty")
 	}
 	if e.Organization == "" {
-		return errors.New("empty Organization")
+		errs.Add("organization", "must not be empty")
 	}
-	return nil
+	return errs.Err()
 }
 
 // URIPath returns the path component of an Extension URI.
@@ -25,6 +23,17 @@ func (e *Extension) URIPath() string {
 	return fmt.Sprintf("/extensions/%s", url.PathEscape(e.Name))
 }
 
+// GetObjectMeta implements MetaResource.
+func (e *Extension) GetObjectMeta() ObjectMeta {
+	return objectMeta(e.ObjectMeta, e.Name, e.Organization, "")
+}
+
+// SetObjectMeta implements MetaResource.
+func (e *Extension) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&e.Name, &e.Organization, nil)
+	e.ObjectMeta = meta
+}
+
 // FixtureExtension given a name returns a valid extension for use in tests
 func FixtureExtension(name string) *Extension {
 	return &Extension{
@@ -23,6 +23,9 @@ type Extension struct {
 	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	// Organization indicates which organization an extension belongs to.
 	Organization string `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the
+	// extension
+	ObjectMeta `protobuf:"bytes,4,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Extension) Reset()                    { *m = Extension{} }
@@ -82,6 +85,9 @@ func (this *Extension) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Extension) Marshal() (dAtA []byte, err error) {
@@ -117,6 +123,14 @@ func (m *Extension) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintExtension(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintExtension(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -134,6 +148,8 @@ func NewPopulatedExtension(r randyExtension, easy bool) *Extension {
 	this.Name = string(randStringExtension(r))
 	this.URL = string(randStringExtension(r))
 	this.Organization = string(randStringExtension(r))
+	v3 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v3
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -226,6 +242,8 @@ func (m *Extension) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovExtension(uint64(l))
 	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovExtension(uint64(l))
 	return n
 }
 
@@ -358,6 +376,36 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExtension(dAtA[iNdEx:])
@@ -487,18 +535,21 @@ var (
 func init() { proto.RegisterFile("extension.proto", fileDescriptorExtension) }
 
 var fileDescriptorExtension = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
+	// 251 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0xad, 0x28, 0x49,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd,
 	0x2b, 0x2e, 0xd5, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
 	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03,
-	0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0x29, 0x8e, 0x8b, 0xd3, 0x15, 0x66, 0x9c, 0x90, 0x10,
-	0x17, 0x4b, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x98, 0x2d, 0x24,
-	0xc9, 0xc5, 0x5c, 0x5a, 0x94, 0x23, 0xc1, 0x04, 0x12, 0x72, 0x62, 0x7f, 0x74, 0x4f, 0x9e, 0x39,
-	0x34, 0xc8, 0x27, 0x08, 0x24, 0x26, 0xa4, 0xc4, 0xc5, 0x93, 0x5f, 0x94, 0x9e, 0x98, 0x97, 0x59,
-	0x95, 0x58, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0x0c, 0xd6, 0x86, 0x22, 0xe6, 0xa4, 0xfc, 0xe3, 0xa1,
-	0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x3b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x44, 0xb1, 0x82, 0xdd, 0x9c,
-	0xc4, 0x06, 0x76, 0x8b, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x59, 0x77, 0x6f, 0xda, 0x00,
-	0x00, 0x00,
+	0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0x8a, 0x2b, 0x37, 0xb5, 0x24, 0x11, 0xc2, 0x56, 0x5a,
+	0xc9, 0xc8, 0xc5, 0xe9, 0x0a, 0x33, 0x5b, 0x48, 0x88, 0x8b, 0x25, 0x2f, 0x31, 0x37, 0x55, 0x82,
+	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xcc, 0x16, 0x92, 0xe4, 0x62, 0x2e, 0x2d, 0xca, 0x91, 0x60,
+	0x02, 0x09, 0x39, 0xb1, 0x3f, 0xba, 0x27, 0xcf, 0x1c, 0x1a, 0xe4, 0x13, 0x04, 0x12, 0x13, 0x52,
+	0xe2, 0xe2, 0xc9, 0x2f, 0x4a, 0x4f, 0xcc, 0xcb, 0xac, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0x93, 0x60,
+	0x06, 0x6b, 0x43, 0x11, 0x13, 0xf2, 0xe4, 0xe2, 0x00, 0x59, 0x97, 0x92, 0x58, 0x92, 0x28, 0xc1,
+	0xa2, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xae, 0x87, 0xe4, 0x76, 0x3d, 0xff, 0xa4, 0xac, 0xd4, 0xe4,
+	0x12, 0xdf, 0xd4, 0x92, 0x44, 0x27, 0x91, 0x13, 0xf7, 0xe4, 0x19, 0x2e, 0xdc, 0x93, 0x67, 0x7c,
+	0x75, 0x4f, 0x1e, 0xae, 0x29, 0x08, 0xce, 0x72, 0x52, 0xfe, 0xf1, 0x50, 0x8e, 0x71, 0xc5, 0x23,
+	0x39, 0xc6, 0x1d, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
+	0x23, 0x39, 0xc6, 0x19, 0x8f, 0xe5, 0x18, 0xa2, 0x58, 0xc1, 0xe6, 0x25, 0xb1, 0x81, 0xfd, 0x65,
+	0x0c, 0x18, 0x00, 0xef, 0xc8, 0x04, 0x7d, 0x32, 0x01, 0x00, 0x00,
 }
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 
@@ -18,6 +17,14 @@ const (
 
 	// DefaultEventFilterAction is the default action for filters
 	DefaultEventFilterAction = EventFilterActionAllow
+
+	// EventFilterTypeGovaluate is the type of filters whose statements are
+	// govaluate expressions, the default
+	EventFilterTypeGovaluate = "govaluate"
+
+	// EventFilterTypeJavaScript is the type of filters whose statements are
+	// JavaScript expressions
+	EventFilterTypeJavaScript = "javascript"
 )
 
 var (
@@ -26,35 +33,48 @@ var (
 		EventFilterActionAllow,
 		EventFilterActionDeny,
 	}
+
+	// EventFilterAllTypes is a list of types of statements filters can use
+	EventFilterAllTypes = []string{
+		EventFilterTypeGovaluate,
+		EventFilterTypeJavaScript,
+	}
 )
 
 // Validate returns an error if the filter does not pass validation tests.
 func (f *EventFilter) Validate() error {
-	if err := ValidateName(f.Name); err != nil {
-		return errors.New("filter name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(f.Name))
 
 	if found := utilstrings.InArray(f.Action, EventFilterAllActions); !found {
-		return fmt.Errorf("action '%s' is not valid", f.Action)
+		errs.Add("action", "'%s' is not valid", f.Action)
 	}
 
 	if len(f.Statements) == 0 {
-		return errors.New("filter must have one or more statements")
+		errs.Add("statements", "must have one or more statements")
 	}
 
-	if err := eval.ValidateStatements(f.Statements, false); err != nil {
-		return err
+	switch f.Type {
+	case "", EventFilterTypeGovaluate:
+		if len(f.RuntimeAssets) > 0 {
+			errs.Add("runtime_assets", "only javascript filters can have runtime assets")
+		}
+		errs.AddError("statements", eval.ValidateStatements(f.Statements, false))
+	case EventFilterTypeJavaScript:
+		errs.AddError("statements", eval.ValidateJavaScriptStatements(f.Statements))
+	default:
+		errs.Add("type", "'%s' is not valid", f.Type)
 	}
 
 	if f.Environment == "" {
-		return errors.New("environment must be set")
+		errs.Add("environment", "must be set")
 	}
 
 	if f.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", "must be set")
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // Update updates e with selected fields. Returns non-nil error if any of the
@@ -68,6 +88,10 @@ func (f *EventFilter) Update(from *EventFilter, fields ...string) error {
 			f.When = from.When
 		case "Statements":
 			f.Statements = append(f.Statements[0:0], from.Statements...)
+		case "Type":
+			f.Type = from.Type
+		case "RuntimeAssets":
+			f.RuntimeAssets = append(f.RuntimeAssets[0:0], from.RuntimeAssets...)
 		default:
 			return fmt.Errorf("unsupported field: %q", f)
 		}
@@ -101,3 +125,14 @@ func FixtureDenyEventFilter(name string) *EventFilter {
 func (f *EventFilter) URIPath() string {
 	return fmt.Sprintf("/filters/%s", url.PathEscape(f.Name))
 }
+
+// GetObjectMeta implements MetaResource.
+func (f *EventFilter) GetObjectMeta() ObjectMeta {
+	return objectMeta(f.ObjectMeta, f.Name, f.Organization, f.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (f *EventFilter) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&f.Name, &f.Organization, &f.Environment)
+	f.ObjectMeta = meta
+}
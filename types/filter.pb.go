@@ -30,6 +30,14 @@ type EventFilter struct {
 	Organization string `protobuf:"bytes,5,opt,name=organization,proto3" json:"organization,omitempty"`
 	// When indicates a TimeWindowWhen that a filter uses to filter by days & times
 	When *TimeWindowWhen `protobuf:"bytes,6,opt,name=when" json:"when,omitempty"`
+	// Type indicates the language of the statements of the filter, either
+	// govaluate expressions, by default, or JavaScript expressions
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// RuntimeAssets are the assets providing JavaScript libraries to the
+	// statements of a JavaScript filter
+	RuntimeAssets []string `protobuf:"bytes,8,rep,name=runtime_assets,json=runtimeAssets" json:"runtime_assets,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the filter
+	ObjectMeta `protobuf:"bytes,9,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *EventFilter) Reset()                    { *m = EventFilter{} }
@@ -79,6 +87,20 @@ func (m *EventFilter) GetWhen() *TimeWindowWhen {
 	return nil
 }
 
+func (m *EventFilter) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventFilter) GetRuntimeAssets() []string {
+	if m != nil {
+		return m.RuntimeAssets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventFilter)(nil), "sensu.types.EventFilter")
 }
@@ -124,6 +146,20 @@ func (this *EventFilter) Equal(that interface{}) bool {
 	if !this.When.Equal(that1.When) {
 		return false
 	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *EventFilter) Marshal() (dAtA []byte, err error) {
@@ -190,6 +226,35 @@ func (m *EventFilter) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintFilter(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintFilter(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n2, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
 	return i, nil
 }
 
@@ -216,6 +281,14 @@ func NewPopulatedEventFilter(r randyFilter, easy bool) *EventFilter {
 	if r.Intn(10) != 0 {
 		this.When = NewPopulatedTimeWindowWhen(r, easy)
 	}
+	this.Type = string(randStringFilter(r))
+	v4 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.RuntimeAssets[i] = string(randStringFilter(r))
+	}
+	v5 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v5
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -322,6 +395,18 @@ func (m *EventFilter) Size() (n int) {
 		l = m.When.Size()
 		n += 1 + l + sovFilter(uint64(l))
 	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovFilter(uint64(l))
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			l = len(s)
+			n += 1 + l + sovFilter(uint64(l))
+		}
+	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovFilter(uint64(l))
 	return n
 }
 
@@ -545,6 +630,94 @@ func (m *EventFilter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFilter(dAtA[iNdEx:])
@@ -674,23 +847,29 @@ var (
 func init() { proto.RegisterFile("filter.proto", fileDescriptorFilter) }
 
 var fileDescriptorFilter = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4a, 0xc3, 0x40,
-	0x14, 0x86, 0x1d, 0xdb, 0x06, 0x3a, 0x29, 0x82, 0xb3, 0x90, 0x50, 0x61, 0x0c, 0x75, 0x93, 0x8d,
-	0x13, 0xd0, 0x1b, 0x14, 0xf4, 0x00, 0x41, 0x28, 0xb8, 0x91, 0xa4, 0xbe, 0x26, 0x03, 0xe6, 0x4d,
-	0x49, 0x5e, 0x1a, 0xf4, 0x24, 0x1e, 0xc1, 0x23, 0x78, 0x04, 0x97, 0x9e, 0x40, 0x6a, 0xdc, 0x79,
-	0x02, 0x97, 0xe2, 0xab, 0x8b, 0xb8, 0xfb, 0xbf, 0x8f, 0xff, 0xcd, 0x63, 0x9e, 0x9c, 0xac, 0xec,
-	0x3d, 0x41, 0x65, 0xd6, 0x95, 0x23, 0xa7, 0xfc, 0x1a, 0xb0, 0x6e, 0x0c, 0x3d, 0xac, 0xa1, 0x9e,
-	0x9e, 0xe5, 0x96, 0x8a, 0x26, 0x33, 0x4b, 0x57, 0xc6, 0xb9, 0xcb, 0x5d, 0xcc, 0x9d, 0xac, 0x59,
-	0x31, 0x31, 0x70, 0xda, 0xcd, 0x4e, 0x0f, 0xc9, 0x96, 0x70, 0xdb, 0x5a, 0xbc, 0x73, 0xed, 0x4e,
-	0xcd, 0xb6, 0x42, 0xfa, 0x97, 0x1b, 0x40, 0xba, 0xe2, 0x25, 0x4a, 0xc9, 0x21, 0xa6, 0x25, 0x04,
-	0x22, 0x14, 0xd1, 0x38, 0xe1, 0xac, 0x8e, 0xa4, 0x97, 0x2e, 0xc9, 0x3a, 0x0c, 0xf6, 0xd9, 0xfe,
-	0x91, 0x32, 0x52, 0xd6, 0x94, 0x12, 0x94, 0x80, 0x54, 0x07, 0x83, 0x70, 0x10, 0x8d, 0xe7, 0x07,
-	0x5f, 0xef, 0x27, 0x3d, 0x9b, 0xf4, 0xb2, 0x0a, 0xa5, 0x0f, 0xb8, 0xb1, 0x95, 0xc3, 0x5f, 0x0e,
-	0x86, 0xfc, 0x58, 0x5f, 0xa9, 0x99, 0x9c, 0xb8, 0x2a, 0x4f, 0xd1, 0x3e, 0xa6, 0xbc, 0x6f, 0xc4,
-	0x95, 0x7f, 0x4e, 0xc5, 0x72, 0xd8, 0x16, 0x80, 0x81, 0x17, 0x8a, 0xc8, 0x3f, 0x3f, 0x36, 0xbd,
-	0x7b, 0x98, 0x6b, 0x5b, 0xc2, 0x82, 0xbf, 0xb7, 0x28, 0x00, 0x13, 0x2e, 0xce, 0x4f, 0xbf, 0x3f,
-	0xb4, 0x78, 0xee, 0xb4, 0x78, 0xe9, 0xb4, 0x78, 0xed, 0xb4, 0x78, 0xeb, 0xb4, 0xd8, 0x76, 0x5a,
-	0x3c, 0x7d, 0xea, 0xbd, 0x9b, 0x11, 0x4f, 0x66, 0x1e, 0x9f, 0xe3, 0xe2, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0x91, 0x6e, 0x00, 0xe7, 0x6d, 0x01, 0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xdd, 0x6a, 0xd4, 0x40,
+	0x14, 0xee, 0xb8, 0xe9, 0xda, 0x9d, 0xd4, 0x05, 0x07, 0xd1, 0xa1, 0x4a, 0x12, 0x2a, 0xc8, 0x5e,
+	0x68, 0x16, 0xf4, 0x09, 0x8c, 0x28, 0x78, 0x21, 0x42, 0x10, 0x0a, 0xde, 0xc8, 0x64, 0x7b, 0x9a,
+	0x1d, 0x71, 0x66, 0x96, 0xcc, 0x49, 0x97, 0xfa, 0x24, 0x7d, 0x04, 0x1f, 0xc1, 0x47, 0xe8, 0x65,
+	0x9f, 0x20, 0x68, 0xbc, 0xcb, 0x13, 0x78, 0x59, 0x72, 0x76, 0x59, 0xb2, 0x77, 0xdf, 0xcf, 0x39,
+	0x67, 0xce, 0xf9, 0x86, 0x1f, 0x5f, 0xe8, 0x1f, 0x08, 0x55, 0xba, 0xaa, 0x1c, 0x3a, 0x11, 0x7a,
+	0xb0, 0xbe, 0x4e, 0xf1, 0x6a, 0x05, 0xfe, 0xe4, 0x55, 0xa9, 0x71, 0x59, 0x17, 0xe9, 0xc2, 0x99,
+	0x79, 0xe9, 0x4a, 0x37, 0xa7, 0x9a, 0xa2, 0xbe, 0x20, 0x46, 0x84, 0xd0, 0xa6, 0xf7, 0xe4, 0x21,
+	0x6a, 0x03, 0xdf, 0xd6, 0xda, 0x9e, 0xbb, 0xf5, 0x56, 0xe2, 0x06, 0x50, 0x6d, 0xf0, 0xe9, 0xf5,
+	0x88, 0x87, 0xef, 0x2f, 0xc1, 0xe2, 0x07, 0x7a, 0x50, 0x08, 0x1e, 0x58, 0x65, 0x40, 0xb2, 0x84,
+	0xcd, 0x26, 0x39, 0x61, 0xf1, 0x98, 0x8f, 0xd5, 0x02, 0xb5, 0xb3, 0xf2, 0x1e, 0xa9, 0x5b, 0x26,
+	0x52, 0xce, 0x3d, 0x2a, 0x04, 0x03, 0x16, 0xbd, 0x1c, 0x25, 0xa3, 0xd9, 0x24, 0x9b, 0x76, 0x4d,
+	0x3c, 0x50, 0xf3, 0x01, 0x16, 0x09, 0x0f, 0xc1, 0x5e, 0xea, 0xca, 0xd9, 0x9e, 0xcb, 0x80, 0x86,
+	0x0d, 0x25, 0x71, 0xca, 0x8f, 0x5d, 0x55, 0x2a, 0xab, 0x7f, 0x2a, 0x7a, 0xef, 0x90, 0x4a, 0xf6,
+	0x34, 0x31, 0xe7, 0xc1, 0x7a, 0x09, 0x56, 0x8e, 0x13, 0x36, 0x0b, 0x5f, 0x3f, 0x4d, 0x07, 0xd9,
+	0xa4, 0x5f, 0xb4, 0x81, 0x33, 0x3a, 0xf5, 0x6c, 0x09, 0x36, 0xa7, 0x42, 0xf1, 0x82, 0x07, 0xbd,
+	0x2b, 0xef, 0xf7, 0xc3, 0x32, 0xd1, 0x35, 0xf1, 0xb4, 0xe7, 0x2f, 0x9d, 0xd1, 0x08, 0x66, 0x85,
+	0x57, 0x39, 0xf9, 0xe2, 0x1d, 0x9f, 0x56, 0xb5, 0xa5, 0xb8, 0x94, 0xf7, 0x80, 0x5e, 0x1e, 0xd1,
+	0x49, 0xcf, 0xba, 0x26, 0x96, 0xfb, 0xce, 0xa0, 0xf7, 0xc1, 0xd6, 0x79, 0x4b, 0x86, 0xf8, 0xc8,
+	0x8f, 0xfa, 0x74, 0xcf, 0x15, 0x2a, 0x39, 0xa1, 0x0d, 0x9f, 0xec, 0x6d, 0xf8, 0xb9, 0xf8, 0x0e,
+	0x0b, 0xfc, 0x04, 0xa8, 0xb2, 0x47, 0x37, 0x4d, 0x7c, 0x70, 0xdb, 0xc4, 0xac, 0x6b, 0xe2, 0x5d,
+	0x53, 0xbe, 0x43, 0xd9, 0xf3, 0xff, 0x7f, 0x23, 0xf6, 0xab, 0x8d, 0xd8, 0xef, 0x36, 0x62, 0x37,
+	0x6d, 0xc4, 0x6e, 0xdb, 0x88, 0xfd, 0x69, 0x23, 0x76, 0xfd, 0x2f, 0x3a, 0xf8, 0x7a, 0x48, 0xf3,
+	0x8a, 0x31, 0x7d, 0xe3, 0x9b, 0xbb, 0x01, 0x00, 0x38, 0xf3, 0xdb, 0x23, 0x31, 0x02, 0x00, 0x00,
 }
@@ -48,3 +48,25 @@ func TestEventFilterValidate(t *testing.T) {
 	// Valid filter
 	assert.NoError(t, f.Validate())
 }
+
+func TestEventFilterValidateType(t *testing.T) {
+	f := FixtureEventFilter("filter")
+
+	// Invalid type
+	f.Type = "lua"
+	assert.Error(t, f.Validate())
+
+	// Runtime assets of a govaluate filter
+	f.Type = EventFilterTypeGovaluate
+	f.RuntimeAssets = []string{"lodash"}
+	assert.Error(t, f.Validate())
+
+	// Invalid javascript statement
+	f.Type = EventFilterTypeJavaScript
+	f.Statements = []string{"event.check.occurrences %"}
+	assert.Error(t, f.Validate())
+
+	// Valid javascript filter
+	f.Statements = []string{"event.check.occurrences % 10 == 0"}
+	assert.NoError(t, f.Validate())
+}
@@ -1,9 +1,12 @@
 package types
 
 import (
-	"errors"
 	fmt "fmt"
+	"net/mail"
 	"net/url"
+	"reflect"
+	"text/template"
+	"time"
 )
 
 const (
@@ -26,55 +29,176 @@ const (
 
 	// HandlerGRPCType is a special kind of handler that represents an extension
 	HandlerGRPCType = "grpc"
+
+	// HandlerSlackType represents handlers that post event messages to a Slack
+	// incoming webhook
+	HandlerSlackType = "slack"
+
+	// HandlerPagerDutyType represents handlers that trigger, acknowledge and
+	// resolve PagerDuty incidents with the Events API v2
+	HandlerPagerDutyType = "pagerduty"
+
+	// HandlerEmailType represents handlers that send event emails through an
+	// SMTP server
+	HandlerEmailType = "email"
+
+	// HandlerSetMaxDepth is the maximum depth at which handlers are executed,
+	// the handlers of an event being at depth 1 and the handlers of the
+	// handler sets they include one level deeper.
+	HandlerSetMaxDepth = 3
+
+	// HandlerMaxAttempts is the maximum number of times a handler can be
+	// executed for an event, so that failed deliveries are not retried for
+	// hours during an outage.
+	HandlerMaxAttempts = 10
 )
 
 // Validate returns an error if the handler does not pass validation tests.
 func (h *Handler) Validate() error {
-	if err := ValidateName(h.Name); err != nil {
-		return errors.New("handler name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(h.Name))
 
-	if err := h.validateType(); err != nil {
-		return err
-	}
+	h.validateType(&errs)
 
 	if h.Environment == "" {
-		return errors.New("environment must be set")
+		errs.Add("environment", "must be set")
 	}
 
 	if h.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", "must be set")
+	}
+
+	errs.AddError("env_vars", ValidateEnvVars(h.EnvVars))
+	errs.AddError("secrets", ValidateSecretReferences(h.Secrets))
+
+	if len(h.RuntimeAssets) > 0 && h.Type != HandlerPipeType {
+		errs.Add("runtime_assets", "only pipe handlers can have runtime assets")
+	}
+	for i, assetName := range h.RuntimeAssets {
+		errs.AddError(FieldPath("runtime_assets", i), ValidateAssetName(assetName))
+	}
+
+	errs.AddError("subdue", h.Subdue.Validate())
+
+	if h.MaxAttempts > HandlerMaxAttempts {
+		errs.Add("max_attempts", "must not exceed %d", HandlerMaxAttempts)
 	}
 
-	return nil
+	return errs.Err()
 }
 
-func (h *Handler) validateType() error {
+// IsSubdued returns true if the handler is subdued at the current time.
+// It returns false otherwise.
+func (h *Handler) IsSubdued() bool {
+	subdue := h.GetSubdue()
+	if subdue == nil {
+		return false
+	}
+	subdued, err := subdue.InWindows(time.Now())
+	if err != nil {
+		return false
+	}
+	return subdued
+}
+
+func (h *Handler) validateType(errs *FieldErrors) {
 	if h.Type == "" {
-		return errors.New("empty handler type")
+		errs.Add("type", "must not be empty")
+		return
 	}
 
 	switch h.Type {
-	case "pipe", "set", "grpc":
-		return nil
+	case "pipe", "grpc":
+	case "set":
+		for _, name := range h.Handlers {
+			if name == h.Name {
+				errs.Add("handlers", "cannot include the handler set itself")
+			}
+		}
 	case "tcp", "udp":
-		return h.Socket.Validate()
+		errs.AddError("socket", h.Socket.Validate())
+	case "slack":
+		errs.AddError("slack", h.Slack.Validate())
+	case "pagerduty":
+		errs.AddError("pagerduty", h.PagerDuty.Validate())
+	case "email":
+		errs.AddError("email", h.Email.Validate())
+	default:
+		errs.Add("type", "%q is not a valid handler type", h.Type)
 	}
-
-	return fmt.Errorf("unknown handler type: %s", h.Type)
 }
 
 func (s *HandlerSocket) Validate() error {
+	var errs FieldErrors
 	if s == nil {
-		return errors.New("tcp and udp handlers need a valid socket")
+		errs.Add("", "must be set for tcp and udp handlers")
+		return errs
 	}
 	if len(s.Host) == 0 {
-		return errors.New("socket host undefined")
+		errs.Add("host", "must be set")
 	}
 	if s.Port == 0 {
-		return errors.New("socket port undefined")
+		errs.Add("port", "must be set")
+	}
+	return errs.Err()
+}
+
+// Validate returns an error if the Slack handler configuration is invalid.
+func (s *HandlerSlack) Validate() error {
+	var errs FieldErrors
+	if s == nil || s.WebhookURL == "" {
+		errs.Add("webhook_url", "must be set for slack handlers")
+		return errs
+	}
+	if u, err := url.Parse(s.WebhookURL); err != nil {
+		errs.Add("webhook_url", "is invalid: %s", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		errs.Add("webhook_url", "has invalid scheme %q", u.Scheme)
+	}
+	if _, err := template.New("slack").Parse(s.Template); err != nil {
+		errs.Add("template", "is invalid: %s", err)
+	}
+	return errs.Err()
+}
+
+// Validate returns an error if the PagerDuty handler configuration is invalid.
+func (p *HandlerPagerDuty) Validate() error {
+	var errs FieldErrors
+	if p == nil || p.RoutingKey == "" {
+		errs.Add("routing_key", "must be set for pagerduty handlers")
+		return errs
+	}
+	if _, err := template.New("pagerduty").Parse(p.SummaryTemplate); err != nil {
+		errs.Add("summary_template", "is invalid: %s", err)
 	}
-	return nil
+	return errs.Err()
+}
+
+// Validate returns an error if the email handler configuration is invalid.
+func (e *HandlerEmail) Validate() error {
+	var errs FieldErrors
+	if e == nil || e.Host == "" {
+		errs.Add("host", "must be set for email handlers")
+		return errs
+	}
+	if _, err := mail.ParseAddress(e.From); err != nil {
+		errs.Add("from", "%q is invalid: %s", e.From, err)
+	}
+	if len(e.To) == 0 {
+		errs.Add("to", "must contain at least one recipient")
+	}
+	for i, to := range e.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			errs.Add(FieldPath("to", i), "%q is invalid: %s", to, err)
+		}
+	}
+	if _, err := template.New("subject").Parse(e.SubjectTemplate); err != nil {
+		errs.Add("subject_template", "is invalid: %s", err)
+	}
+	if _, err := template.New("body").Parse(e.BodyTemplate); err != nil {
+		errs.Add("body_template", "is invalid: %s", err)
+	}
+	return errs.Err()
 }
 
 // FixtureHandler returns a Handler fixture for testing.
@@ -99,9 +223,43 @@ func FixtureSocketHandler(name string, proto string) *Handler {
 	return handler
 }
 
+// FixtureSlackHandler returns a Handler fixture for testing.
+func FixtureSlackHandler(name string, webhookURL string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerSlackType
+	handler.Command = ""
+	handler.Slack = &HandlerSlack{WebhookURL: webhookURL}
+	return handler
+}
+
+// FixturePagerDutyHandler returns a Handler fixture for testing.
+func FixturePagerDutyHandler(name string, routingKey string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerPagerDutyType
+	handler.Command = ""
+	handler.PagerDuty = &HandlerPagerDuty{RoutingKey: routingKey}
+	return handler
+}
+
+// FixtureEmailHandler returns a Handler fixture for testing.
+func FixtureEmailHandler(name string, host string, port uint32) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerEmailType
+	handler.Command = ""
+	handler.Email = &HandlerEmail{
+		Host: host,
+		Port: port,
+		From: "sensu@example.com",
+		To:   []string{"ops@example.com"},
+	}
+	return handler
+}
+
 // FixtureSetHandler returns a Handler fixture for testing.
 func FixtureSetHandler(name string, handlers ...string) *Handler {
 	handler := FixtureHandler(name)
+	handler.Type = HandlerSetType
+	handler.Command = ""
 	handler.Handlers = handlers
 	return handler
 }
@@ -110,3 +268,24 @@ func FixtureSetHandler(name string, handlers ...string) *Handler {
 func (h *Handler) URIPath() string {
 	return fmt.Sprintf("/handlers/%s", url.PathEscape(h.Name))
 }
+
+// GetObjectMeta implements MetaResource.
+func (h *Handler) GetObjectMeta() ObjectMeta {
+	return objectMeta(h.ObjectMeta, h.Name, h.Organization, h.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (h *Handler) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&h.Name, &h.Organization, &h.Environment)
+	h.ObjectMeta = meta
+}
+
+// Get implements govaluate.Parameters
+func (h *Handler) Get(name string) (interface{}, error) {
+	strukt := reflect.Indirect(reflect.ValueOf(h))
+	field := strukt.FieldByName(name)
+	if field.IsValid() {
+		return reflect.Indirect(field).Interface(), nil
+	}
+	return nil, nil
+}
@@ -39,6 +39,30 @@ type Handler struct {
 	Environment string `protobuf:"bytes,10,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Organization indicates to which org a handler belongs to
 	Organization string `protobuf:"bytes,11,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Subdue represents one or more time windows when the handler should not
+	// be executed, e.g. maintenance windows.
+	Subdue *TimeWindowWhen `protobuf:"bytes,12,opt,name=subdue" json:"subdue,omitempty"`
+	// MaxAttempts is the maximum number of times the handler is executed for an
+	// event until it succeeds, including the first execution.
+	MaxAttempts uint32 `protobuf:"varint,13,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// RetryBackoff is the time, in seconds, waited before executing the handler
+	// again after its first failure, doubled after each failure.
+	RetryBackoff uint32 `protobuf:"varint,14,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	// Slack contains configuration for a Slack handler.
+	Slack *HandlerSlack `protobuf:"bytes,15,opt,name=slack" json:"slack,omitempty"`
+	// PagerDuty contains configuration for a PagerDuty handler.
+	PagerDuty *HandlerPagerDuty `protobuf:"bytes,16,opt,name=pagerduty" json:"pagerduty,omitempty"`
+	// Email contains configuration for an email handler.
+	Email *HandlerEmail `protobuf:"bytes,17,opt,name=email" json:"email,omitempty"`
+	// RuntimeAssets are a list of assets required to execute the handler
+	// command.
+	RuntimeAssets []string `protobuf:"bytes,18,rep,name=runtime_assets,json=runtimeAssets" json:"runtime_assets,omitempty"`
+	// Secrets are the secrets exposed to the handler command, as environment
+	// variables.
+	Secrets []SecretReference `protobuf:"bytes,20,rep,name=secrets" json:"secrets,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the
+	// handler
+	ObjectMeta `protobuf:"bytes,19,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Handler) Reset()                    { *m = Handler{} }
@@ -123,12 +147,74 @@ func (m *Handler) GetOrganization() string {
 	return ""
 }
 
+func (m *Handler) GetSubdue() *TimeWindowWhen {
+	if m != nil {
+		return m.Subdue
+	}
+	return nil
+}
+
+func (m *Handler) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *Handler) GetRetryBackoff() uint32 {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return 0
+}
+
+func (m *Handler) GetSlack() *HandlerSlack {
+	if m != nil {
+		return m.Slack
+	}
+	return nil
+}
+
+func (m *Handler) GetPagerDuty() *HandlerPagerDuty {
+	if m != nil {
+		return m.PagerDuty
+	}
+	return nil
+}
+
+func (m *Handler) GetEmail() *HandlerEmail {
+	if m != nil {
+		return m.Email
+	}
+	return nil
+}
+
+func (m *Handler) GetRuntimeAssets() []string {
+	if m != nil {
+		return m.RuntimeAssets
+	}
+	return nil
+}
+
+func (m *Handler) GetSecrets() []SecretReference {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
 // HandlerSocket contains configuration for a TCP or UDP handler.
 type HandlerSocket struct {
 	// Host is the socket peer address.
 	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	// Port is the socket peer port.
 	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port"`
+	// ReconnectAttempts is the number of times the handler reconnects to the
+	// socket to send the event again when sending it fails.
+	ReconnectAttempts uint32 `protobuf:"varint,3,opt,name=reconnect_attempts,json=reconnectAttempts,proto3" json:"reconnect_attempts,omitempty"`
+	// ReconnectInterval is the time, in seconds, waited before reconnecting to
+	// the socket.
+	ReconnectInterval uint32 `protobuf:"varint,4,opt,name=reconnect_interval,json=reconnectInterval,proto3" json:"reconnect_interval,omitempty"`
 }
 
 func (m *HandlerSocket) Reset()                    { *m = HandlerSocket{} }
@@ -150,9 +236,203 @@ func (m *HandlerSocket) GetPort() uint32 {
 	return 0
 }
 
+func (m *HandlerSocket) GetReconnectAttempts() uint32 {
+	if m != nil {
+		return m.ReconnectAttempts
+	}
+	return 0
+}
+
+func (m *HandlerSocket) GetReconnectInterval() uint32 {
+	if m != nil {
+		return m.ReconnectInterval
+	}
+	return 0
+}
+
+// HandlerSlack contains configuration for a Slack handler.
+type HandlerSlack struct {
+	// WebhookURL is the URL of the Slack incoming webhook events are posted to.
+	WebhookURL string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Channel overrides the channel of the webhook, e.g. #alerts.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Username overrides the username of the webhook.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Template is the Go template of the message, executed with the event.
+	Template string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (m *HandlerSlack) Reset()                    { *m = HandlerSlack{} }
+func (m *HandlerSlack) String() string            { return proto.CompactTextString(m) }
+func (*HandlerSlack) ProtoMessage()               {}
+func (*HandlerSlack) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{2} }
+
+func (m *HandlerSlack) GetWebhookURL() string {
+	if m != nil {
+		return m.WebhookURL
+	}
+	return ""
+}
+
+func (m *HandlerSlack) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *HandlerSlack) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *HandlerSlack) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+// HandlerPagerDuty contains configuration for a PagerDuty handler.
+type HandlerPagerDuty struct {
+	// RoutingKey is the integration key of the PagerDuty service incidents are
+	// triggered for.
+	RoutingKey string `protobuf:"bytes,1,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// SummaryTemplate is the Go template of the summary of incidents, executed
+	// with the event.
+	SummaryTemplate string `protobuf:"bytes,2,opt,name=summary_template,json=summaryTemplate,proto3" json:"summary_template,omitempty"`
+}
+
+func (m *HandlerPagerDuty) Reset()                    { *m = HandlerPagerDuty{} }
+func (m *HandlerPagerDuty) String() string            { return proto.CompactTextString(m) }
+func (*HandlerPagerDuty) ProtoMessage()               {}
+func (*HandlerPagerDuty) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{3} }
+
+func (m *HandlerPagerDuty) GetRoutingKey() string {
+	if m != nil {
+		return m.RoutingKey
+	}
+	return ""
+}
+
+func (m *HandlerPagerDuty) GetSummaryTemplate() string {
+	if m != nil {
+		return m.SummaryTemplate
+	}
+	return ""
+}
+
+// HandlerEmail contains configuration for an email handler.
+type HandlerEmail struct {
+	// Host is the address of the SMTP server.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Port is the port of the SMTP server, 25 by default.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Username is the name used to authenticate with the SMTP server, if any.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Password is the password used to authenticate with the SMTP server.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// From is the sender address of the emails.
+	From string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	// To is the list of the recipient addresses of the emails.
+	To []string `protobuf:"bytes,6,rep,name=to" json:"to"`
+	// SubjectTemplate is the Go template of the subject of the emails, executed
+	// with the event.
+	SubjectTemplate string `protobuf:"bytes,7,opt,name=subject_template,json=subjectTemplate,proto3" json:"subject_template,omitempty"`
+	// BodyTemplate is the Go template of the body of the emails, executed with
+	// the event.
+	BodyTemplate string `protobuf:"bytes,8,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`
+	// TLS connects to the SMTP server over TLS, instead of upgrading the
+	// connection with STARTTLS when the server supports it.
+	TLS bool `protobuf:"varint,9,opt,name=tls,proto3" json:"tls,omitempty"`
+	// InsecureSkipVerify disables the verification of the certificate of the
+	// SMTP server.
+	InsecureSkipVerify bool `protobuf:"varint,10,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+}
+
+func (m *HandlerEmail) Reset()                    { *m = HandlerEmail{} }
+func (m *HandlerEmail) String() string            { return proto.CompactTextString(m) }
+func (*HandlerEmail) ProtoMessage()               {}
+func (*HandlerEmail) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{4} }
+
+func (m *HandlerEmail) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *HandlerEmail) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *HandlerEmail) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *HandlerEmail) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *HandlerEmail) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *HandlerEmail) GetTo() []string {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *HandlerEmail) GetSubjectTemplate() string {
+	if m != nil {
+		return m.SubjectTemplate
+	}
+	return ""
+}
+
+func (m *HandlerEmail) GetBodyTemplate() string {
+	if m != nil {
+		return m.BodyTemplate
+	}
+	return ""
+}
+
+func (m *HandlerEmail) GetTLS() bool {
+	if m != nil {
+		return m.TLS
+	}
+	return false
+}
+
+func (m *HandlerEmail) GetInsecureSkipVerify() bool {
+	if m != nil {
+		return m.InsecureSkipVerify
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Handler)(nil), "sensu.types.Handler")
 	proto.RegisterType((*HandlerSocket)(nil), "sensu.types.HandlerSocket")
+	proto.RegisterType((*HandlerSlack)(nil), "sensu.types.HandlerSlack")
+	proto.RegisterType((*HandlerPagerDuty)(nil), "sensu.types.HandlerPagerDuty")
+	proto.RegisterType((*HandlerEmail)(nil), "sensu.types.HandlerEmail")
 }
 func (this *Handler) Equal(that interface{}) bool {
 	if that == nil {
@@ -221,6 +501,43 @@ func (this *Handler) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if !this.Subdue.Equal(that1.Subdue) {
+		return false
+	}
+	if this.MaxAttempts != that1.MaxAttempts {
+		return false
+	}
+	if this.RetryBackoff != that1.RetryBackoff {
+		return false
+	}
+	if !this.Slack.Equal(that1.Slack) {
+		return false
+	}
+	if !this.PagerDuty.Equal(that1.PagerDuty) {
+		return false
+	}
+	if !this.Email.Equal(that1.Email) {
+		return false
+	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
+	}
+	if len(this.Secrets) != len(that1.Secrets) {
+		return false
+	}
+	for i := range this.Secrets {
+		if !this.Secrets[i].Equal(&that1.Secrets[i]) {
+			return false
+		}
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (this *HandlerSocket) Equal(that interface{}) bool {
@@ -248,79 +565,201 @@ func (this *HandlerSocket) Equal(that interface{}) bool {
 	if this.Port != that1.Port {
 		return false
 	}
+	if this.ReconnectAttempts != that1.ReconnectAttempts {
+		return false
+	}
+	if this.ReconnectInterval != that1.ReconnectInterval {
+		return false
+	}
 	return true
 }
-func (m *Handler) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *HandlerSlack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Handler) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	that1, ok := that.(*HandlerSlack)
+	if !ok {
+		that2, ok := that.(HandlerSlack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Mutator) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Mutator)))
-		i += copy(dAtA[i:], m.Mutator)
+	if this.WebhookURL != that1.WebhookURL {
+		return false
 	}
-	if len(m.Command) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Command)))
-		i += copy(dAtA[i:], m.Command)
+	if this.Channel != that1.Channel {
+		return false
 	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Timeout))
+	if this.Username != that1.Username {
+		return false
 	}
-	if m.Socket != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Socket.Size()))
-		n1, err := m.Socket.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	if this.Template != that1.Template {
+		return false
 	}
-	if len(m.Handlers) > 0 {
-		for _, s := range m.Handlers {
-			dAtA[i] = 0x3a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return true
+}
+func (this *HandlerPagerDuty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandlerPagerDuty)
+	if !ok {
+		that2, ok := that.(HandlerPagerDuty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Filters) > 0 {
-		for _, s := range m.Filters {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RoutingKey != that1.RoutingKey {
+		return false
+	}
+	if this.SummaryTemplate != that1.SummaryTemplate {
+		return false
+	}
+	return true
+}
+func (this *HandlerEmail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandlerEmail)
+	if !ok {
+		that2, ok := that.(HandlerEmail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Host != that1.Host {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if len(this.To) != len(that1.To) {
+		return false
+	}
+	for i := range this.To {
+		if this.To[i] != that1.To[i] {
+			return false
+		}
+	}
+	if this.SubjectTemplate != that1.SubjectTemplate {
+		return false
+	}
+	if this.BodyTemplate != that1.BodyTemplate {
+		return false
+	}
+	if this.TLS != that1.TLS {
+		return false
+	}
+	if this.InsecureSkipVerify != that1.InsecureSkipVerify {
+		return false
+	}
+	return true
+}
+func (m *Handler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Handler) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Mutator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Mutator)))
+		i += copy(dAtA[i:], m.Mutator)
+	}
+	if len(m.Command) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Command)))
+		i += copy(dAtA[i:], m.Command)
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Timeout))
+	}
+	if m.Socket != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Socket.Size()))
+		n1, err := m.Socket.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Handlers) > 0 {
+		for _, s := range m.Handlers {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
 			dAtA[i] = 0x42
 			i++
 			l = len(s)
@@ -361,6 +800,101 @@ func (m *Handler) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintHandler(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if m.Subdue != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Subdue.Size()))
+		n2, err := m.Subdue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.MaxAttempts != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxAttempts))
+	}
+	if m.RetryBackoff != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.RetryBackoff))
+	}
+	if m.Slack != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Slack.Size()))
+		n3, err := m.Slack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.PagerDuty != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.PagerDuty.Size()))
+		n4, err := m.PagerDuty.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Email != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Email.Size()))
+		n5, err := m.Email.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, msg := range m.Secrets {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintHandler(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n6, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
 	return i, nil
 }
 
@@ -390,63 +924,311 @@ func (m *HandlerSocket) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
 	}
+	if m.ReconnectAttempts != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ReconnectAttempts))
+	}
+	if m.ReconnectInterval != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ReconnectInterval))
+	}
 	return i, nil
 }
 
-func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HandlerSlack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
-	this := &Handler{}
-	this.Name = string(randStringHandler(r))
-	this.Type = string(randStringHandler(r))
-	this.Mutator = string(randStringHandler(r))
-	this.Command = string(randStringHandler(r))
-	this.Timeout = uint32(r.Uint32())
-	if r.Intn(10) != 0 {
-		this.Socket = NewPopulatedHandlerSocket(r, easy)
+
+func (m *HandlerSlack) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.WebhookURL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.WebhookURL)))
+		i += copy(dAtA[i:], m.WebhookURL)
 	}
-	v1 := r.Intn(10)
-	this.Handlers = make([]string, v1)
-	for i := 0; i < v1; i++ {
-		this.Handlers[i] = string(randStringHandler(r))
+	if len(m.Channel) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Channel)))
+		i += copy(dAtA[i:], m.Channel)
 	}
-	v2 := r.Intn(10)
-	this.Filters = make([]string, v2)
-	for i := 0; i < v2; i++ {
-		this.Filters[i] = string(randStringHandler(r))
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
 	}
-	v3 := r.Intn(10)
-	this.EnvVars = make([]string, v3)
-	for i := 0; i < v3; i++ {
-		this.EnvVars[i] = string(randStringHandler(r))
+	if len(m.Template) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Template)))
+		i += copy(dAtA[i:], m.Template)
 	}
-	this.Environment = string(randStringHandler(r))
-	this.Organization = string(randStringHandler(r))
-	if !easy && r.Intn(10) != 0 {
+	return i, nil
+}
+
+func (m *HandlerPagerDuty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedHandlerSocket(r randyHandler, easy bool) *HandlerSocket {
-	this := &HandlerSocket{}
-	this.Host = string(randStringHandler(r))
-	this.Port = uint32(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
+func (m *HandlerPagerDuty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoutingKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.RoutingKey)))
+		i += copy(dAtA[i:], m.RoutingKey)
 	}
-	return this
+	if len(m.SummaryTemplate) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.SummaryTemplate)))
+		i += copy(dAtA[i:], m.SummaryTemplate)
+	}
+	return i, nil
 }
 
-type randyHandler interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
+func (m *HandlerEmail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerEmail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Host) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if len(m.Password) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Password)))
+		i += copy(dAtA[i:], m.Password)
+	}
+	if len(m.From) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.From)))
+		i += copy(dAtA[i:], m.From)
+	}
+	if len(m.To) > 0 {
+		for _, s := range m.To {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.SubjectTemplate) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.SubjectTemplate)))
+		i += copy(dAtA[i:], m.SubjectTemplate)
+	}
+	if len(m.BodyTemplate) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.BodyTemplate)))
+		i += copy(dAtA[i:], m.BodyTemplate)
+	}
+	if m.TLS {
+		dAtA[i] = 0x48
+		i++
+		if m.TLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.InsecureSkipVerify {
+		dAtA[i] = 0x50
+		i++
+		if m.InsecureSkipVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
+	this := &Handler{}
+	this.Name = string(randStringHandler(r))
+	this.Type = string(randStringHandler(r))
+	this.Mutator = string(randStringHandler(r))
+	this.Command = string(randStringHandler(r))
+	this.Timeout = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		this.Socket = NewPopulatedHandlerSocket(r, easy)
+	}
+	v1 := r.Intn(10)
+	this.Handlers = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.Handlers[i] = string(randStringHandler(r))
+	}
+	v2 := r.Intn(10)
+	this.Filters = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Filters[i] = string(randStringHandler(r))
+	}
+	v3 := r.Intn(10)
+	this.EnvVars = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.EnvVars[i] = string(randStringHandler(r))
+	}
+	this.Environment = string(randStringHandler(r))
+	this.Organization = string(randStringHandler(r))
+	if r.Intn(10) != 0 {
+		this.Subdue = NewPopulatedTimeWindowWhen(r, easy)
+	}
+	this.MaxAttempts = uint32(r.Uint32())
+	this.RetryBackoff = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		this.Slack = NewPopulatedHandlerSlack(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.PagerDuty = NewPopulatedHandlerPagerDuty(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Email = NewPopulatedHandlerEmail(r, easy)
+	}
+	v7 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.RuntimeAssets[i] = string(randStringHandler(r))
+	}
+	if r.Intn(10) != 0 {
+		v9 := r.Intn(5)
+		this.Secrets = make([]SecretReference, v9)
+		for i := 0; i < v9; i++ {
+			v10 := NewPopulatedSecretReference(r, easy)
+			this.Secrets[i] = *v10
+		}
+	}
+	v8 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v8
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHandlerSocket(r randyHandler, easy bool) *HandlerSocket {
+	this := &HandlerSocket{}
+	this.Host = string(randStringHandler(r))
+	this.Port = uint32(r.Uint32())
+	this.ReconnectAttempts = uint32(r.Uint32())
+	this.ReconnectInterval = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHandlerSlack(r randyHandler, easy bool) *HandlerSlack {
+	this := &HandlerSlack{}
+	this.WebhookURL = string(randStringHandler(r))
+	this.Channel = string(randStringHandler(r))
+	this.Username = string(randStringHandler(r))
+	this.Template = string(randStringHandler(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHandlerPagerDuty(r randyHandler, easy bool) *HandlerPagerDuty {
+	this := &HandlerPagerDuty{}
+	this.RoutingKey = string(randStringHandler(r))
+	this.SummaryTemplate = string(randStringHandler(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHandlerEmail(r randyHandler, easy bool) *HandlerEmail {
+	this := &HandlerEmail{}
+	this.Host = string(randStringHandler(r))
+	this.Port = uint32(r.Uint32())
+	this.Username = string(randStringHandler(r))
+	this.Password = string(randStringHandler(r))
+	this.From = string(randStringHandler(r))
+	v6 := r.Intn(10)
+	this.To = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.To[i] = string(randStringHandler(r))
+	}
+	this.SubjectTemplate = string(randStringHandler(r))
+	this.BodyTemplate = string(randStringHandler(r))
+	this.TLS = bool(bool(r.Intn(2) == 0))
+	this.InsecureSkipVerify = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyHandler interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
 	Int31() int32
 	Uint32() uint32
 	Intn(n int) int
@@ -559,44 +1341,1092 @@ func (m *Handler) Size() (n int) {
 			n += 1 + l + sovHandler(uint64(l))
 		}
 	}
-	l = len(m.Environment)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	l = len(m.Organization)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	return n
-}
-
-func (m *HandlerSocket) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Host)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	if m.Port != 0 {
-		n += 1 + sovHandler(uint64(m.Port))
-	}
-	return n
-}
+	l = len(m.Environment)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Subdue != nil {
+		l = m.Subdue.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovHandler(uint64(m.MaxAttempts))
+	}
+	if m.RetryBackoff != 0 {
+		n += 1 + sovHandler(uint64(m.RetryBackoff))
+	}
+	if m.Slack != nil {
+		l = m.Slack.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.PagerDuty != nil {
+		l = m.PagerDuty.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.Email != nil {
+		l = m.Email.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			l = len(s)
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	l = m.ObjectMeta.Size()
+	n += 2 + l + sovHandler(uint64(l))
+	return n
+}
+
+func (m *HandlerSocket) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	if m.ReconnectAttempts != 0 {
+		n += 1 + sovHandler(uint64(m.ReconnectAttempts))
+	}
+	if m.ReconnectInterval != 0 {
+		n += 1 + sovHandler(uint64(m.ReconnectInterval))
+	}
+	return n
+}
+
+func (m *HandlerSlack) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.WebhookURL)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *HandlerPagerDuty) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RoutingKey)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.SummaryTemplate)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *HandlerEmail) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.To) > 0 {
+		for _, s := range m.To {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.SubjectTemplate)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.BodyTemplate)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.TLS {
+		n += 2
+	}
+	if m.InsecureSkipVerify {
+		n += 2
+	}
+	return n
+}
+
+func sovHandler(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozHandler(x uint64) (n int) {
+	return sovHandler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Handler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Handler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Handler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Socket == nil {
+				m.Socket = &HandlerSocket{}
+			}
+			if err := m.Socket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subdue == nil {
+				m.Subdue = &TimeWindowWhen{}
+			}
+			if err := m.Subdue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			m.RetryBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoff |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slack == nil {
+				m.Slack = &HandlerSlack{}
+			}
+			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PagerDuty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PagerDuty == nil {
+				m.PagerDuty = &HandlerPagerDuty{}
+			}
+			if err := m.PagerDuty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Email == nil {
+				m.Email = &HandlerEmail{}
+			}
+			if err := m.Email.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, SecretReference{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerSocket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerSocket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconnectAttempts", wireType)
+			}
+			m.ReconnectAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReconnectAttempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconnectInterval", wireType)
+			}
+			m.ReconnectInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReconnectInterval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerSlack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerSlack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerSlack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovHandler(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozHandler(x uint64) (n int) {
-	return sovHandler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Handler) Unmarshal(dAtA []byte) error {
+func (m *HandlerPagerDuty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -619,15 +2449,15 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Handler: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandlerPagerDuty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Handler: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandlerPagerDuty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -652,11 +2482,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SummaryTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -681,40 +2511,61 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.SummaryTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerEmail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerEmail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerEmail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -739,13 +2590,13 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			m.Timeout = 0
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -755,16 +2606,16 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (uint32(b) & 0x7F) << shift
+				m.Port |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -774,28 +2625,24 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Socket == nil {
-				m.Socket = &HandlerSocket{}
-			}
-			if err := m.Socket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -820,11 +2667,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -849,11 +2696,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,11 +2725,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			m.To = append(m.To, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -907,11 +2754,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Environment = string(dAtA[iNdEx:postIndex])
+			m.SubjectTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -936,63 +2783,13 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.BodyTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHandler(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthHandler
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HandlerSocket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandlerSocket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -1002,26 +2799,17 @@ func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Host = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.TLS = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
 			}
-			m.Port = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -1031,11 +2819,12 @@ func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.InsecureSkipVerify = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -1165,29 +2954,72 @@ var (
 func init() { proto.RegisterFile("handler.proto", fileDescriptorHandler) }
 
 var fileDescriptorHandler = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0xce, 0xd3, 0x30,
-	0x18, 0xc6, 0x31, 0x4d, 0x9b, 0xd4, 0x69, 0x16, 0x4f, 0x56, 0x85, 0x92, 0xa8, 0x08, 0x91, 0x85,
-	0x54, 0x82, 0x85, 0x95, 0x4c, 0xcc, 0x41, 0x62, 0x60, 0x41, 0x6e, 0xeb, 0xa6, 0x11, 0x8d, 0x5d,
-	0xd9, 0x4e, 0x24, 0x38, 0x00, 0x67, 0xe0, 0x08, 0x1c, 0x81, 0x23, 0x74, 0xe4, 0x04, 0x11, 0x84,
-	0xad, 0x27, 0x60, 0xfc, 0xe4, 0x37, 0x49, 0xbf, 0x76, 0x89, 0x9e, 0xe7, 0xe7, 0x27, 0xaf, 0xde,
-	0x3f, 0x38, 0x38, 0x30, 0xb1, 0x3b, 0x72, 0x95, 0x9e, 0x94, 0x34, 0x92, 0xf8, 0x9a, 0x0b, 0x5d,
-	0xa7, 0xe6, 0xeb, 0x89, 0xeb, 0xe5, 0xab, 0xa2, 0x34, 0x87, 0x7a, 0x93, 0x6e, 0x65, 0xb5, 0x2e,
-	0x64, 0x21, 0xd7, 0x90, 0xd9, 0xd4, 0x7b, 0x70, 0x60, 0x40, 0xf5, 0xff, 0xae, 0xbe, 0x4f, 0xb0,
-	0xfb, 0xbe, 0xaf, 0x46, 0x08, 0x76, 0x04, 0xab, 0x38, 0x45, 0x31, 0x4a, 0xe6, 0x39, 0x68, 0xcb,
-	0x6c, 0x5d, 0xfa, 0xb4, 0x67, 0x56, 0x13, 0x8a, 0xdd, 0xaa, 0x36, 0xcc, 0x48, 0x45, 0x27, 0x80,
-	0x47, 0x6b, 0x5f, 0xb6, 0xb2, 0xaa, 0x98, 0xd8, 0x51, 0xa7, 0x7f, 0x19, 0x2c, 0x79, 0x81, 0x5d,
-	0x53, 0x56, 0x5c, 0xd6, 0x86, 0x4e, 0x63, 0x94, 0x04, 0x99, 0x7f, 0x69, 0xa3, 0x11, 0xe5, 0xa3,
-	0x20, 0x6f, 0xf1, 0x4c, 0xcb, 0xed, 0x17, 0x6e, 0xe8, 0x2c, 0x46, 0x89, 0xff, 0x7a, 0x99, 0xde,
-	0xcc, 0x96, 0x0e, 0x8d, 0x7e, 0x80, 0x44, 0xe6, 0x9c, 0xdb, 0x08, 0xe5, 0x43, 0x9e, 0x24, 0xd8,
-	0x1b, 0xb6, 0xa2, 0xa9, 0x1b, 0x4f, 0x92, 0x79, 0xb6, 0xb8, 0xb4, 0xd1, 0x95, 0xe5, 0x57, 0x65,
-	0x5b, 0xd9, 0x97, 0x47, 0x63, 0x83, 0x1e, 0x04, 0xa1, 0x95, 0x01, 0xe5, 0xa3, 0x20, 0x2f, 0xb1,
-	0xc7, 0x45, 0xf3, 0xb9, 0x61, 0x4a, 0xd3, 0xf9, 0x63, 0xc1, 0x91, 0xe5, 0x2e, 0x17, 0xcd, 0x47,
-	0xa6, 0x34, 0x89, 0xb1, 0xcf, 0x45, 0x53, 0x2a, 0x29, 0x2a, 0x2e, 0x0c, 0xc5, 0x30, 0xf8, 0x2d,
-	0x22, 0x2b, 0xbc, 0x90, 0xaa, 0x60, 0xa2, 0xfc, 0xc6, 0x4c, 0x29, 0x05, 0xf5, 0x21, 0x72, 0xc7,
-	0x56, 0xef, 0x70, 0x70, 0x37, 0x9e, 0xdd, 0xfc, 0x41, 0x6a, 0x33, 0x5e, 0xc3, 0x6a, 0xf2, 0x0c,
-	0x3b, 0x27, 0xa9, 0x0c, 0x5c, 0x23, 0xc8, 0xbc, 0x4b, 0x1b, 0x81, 0xcf, 0xe1, 0x9b, 0x3d, 0xff,
-	0xff, 0x37, 0x44, 0x3f, 0xbb, 0x10, 0xfd, 0xea, 0x42, 0x74, 0xee, 0x42, 0xf4, 0xbb, 0x0b, 0xd1,
-	0x9f, 0x2e, 0x44, 0x3f, 0xfe, 0x85, 0x4f, 0x3e, 0x4d, 0x61, 0x87, 0x9b, 0x19, 0xdc, 0xfd, 0xcd,
-	0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x8c, 0x7e, 0xaa, 0x44, 0x02, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x45,
+	0x13, 0xde, 0x89, 0x1d, 0x7f, 0xb4, 0xed, 0x7c, 0xf4, 0xe6, 0x4d, 0x7a, 0xb3, 0x79, 0xdd, 0x96,
+	0x11, 0x60, 0x21, 0x48, 0xa4, 0x70, 0xe1, 0x82, 0xc4, 0x0e, 0x20, 0x6d, 0xc4, 0xa2, 0x85, 0x49,
+	0x76, 0x23, 0x71, 0xb1, 0xda, 0xe3, 0xb6, 0x3d, 0xd8, 0x33, 0x6d, 0xf5, 0xf4, 0x38, 0x6b, 0x0e,
+	0xfc, 0x0e, 0x7e, 0x02, 0x47, 0x8e, 0xfc, 0x84, 0x1c, 0xf7, 0xc8, 0x69, 0x04, 0xde, 0xdb, 0x48,
+	0xdc, 0x39, 0xa2, 0xae, 0x99, 0xb1, 0x7b, 0x16, 0x73, 0xe1, 0x62, 0x55, 0x3f, 0x4f, 0xd5, 0xd3,
+	0x55, 0xd5, 0xdd, 0x35, 0x46, 0xad, 0x09, 0x0b, 0x86, 0x33, 0x2e, 0xcf, 0xe7, 0x52, 0x28, 0x81,
+	0x1b, 0x21, 0x0f, 0xc2, 0xe8, 0x5c, 0x2d, 0xe7, 0x3c, 0x3c, 0xfd, 0x68, 0xec, 0xa9, 0x49, 0x34,
+	0x38, 0x77, 0x85, 0x7f, 0x31, 0x16, 0x63, 0x71, 0x01, 0x3e, 0x83, 0x68, 0x04, 0x2b, 0x58, 0x80,
+	0x95, 0xc6, 0x9e, 0x1e, 0x2a, 0xcf, 0xe7, 0xfd, 0x3b, 0x2f, 0x18, 0x8a, 0xbb, 0x0c, 0x42, 0x3e,
+	0x57, 0x2c, 0xb3, 0x9b, 0x21, 0x77, 0x25, 0x57, 0xe9, 0xaa, 0xfb, 0x4b, 0x0d, 0x55, 0x9f, 0xa6,
+	0x5b, 0x63, 0x8c, 0xca, 0x01, 0xf3, 0x39, 0xb1, 0x3a, 0x56, 0xaf, 0xee, 0x80, 0xad, 0x31, 0x9d,
+	0x04, 0xd9, 0x49, 0x31, 0x6d, 0x63, 0x82, 0xaa, 0x7e, 0xa4, 0x98, 0x12, 0x92, 0x94, 0x00, 0xce,
+	0x97, 0x9a, 0x71, 0x85, 0xef, 0xb3, 0x60, 0x48, 0xca, 0x29, 0x93, 0x2d, 0xf1, 0xbb, 0xa8, 0xaa,
+	0xd3, 0x12, 0x91, 0x22, 0xbb, 0x1d, 0xab, 0xd7, 0xb2, 0x1b, 0x49, 0x4c, 0x73, 0xc8, 0xc9, 0x0d,
+	0xfc, 0x09, 0xaa, 0x84, 0xc2, 0x9d, 0x72, 0x45, 0x2a, 0x1d, 0xab, 0xd7, 0xb8, 0x3c, 0x3d, 0x37,
+	0x1a, 0x71, 0x9e, 0x25, 0x7a, 0x0d, 0x1e, 0x76, 0xf9, 0x3e, 0xa6, 0x96, 0x93, 0xf9, 0xe3, 0x1e,
+	0xaa, 0x65, 0x2d, 0x0c, 0x49, 0xb5, 0x53, 0xea, 0xd5, 0xed, 0x66, 0x12, 0xd3, 0x35, 0xe6, 0xac,
+	0x2d, 0x9d, 0xca, 0xc8, 0x9b, 0x29, 0xed, 0x58, 0x03, 0x47, 0x48, 0x25, 0x83, 0x9c, 0xdc, 0xc0,
+	0xef, 0xa3, 0x1a, 0x0f, 0x16, 0xfd, 0x05, 0x93, 0x21, 0xa9, 0x6f, 0x04, 0x73, 0xcc, 0xa9, 0xf2,
+	0x60, 0xf1, 0x92, 0xc9, 0x10, 0x77, 0x50, 0x83, 0x07, 0x0b, 0x4f, 0x8a, 0xc0, 0xe7, 0x81, 0x22,
+	0x08, 0x0a, 0x37, 0x21, 0xdc, 0x45, 0x4d, 0x21, 0xc7, 0x2c, 0xf0, 0x7e, 0x60, 0xca, 0x13, 0x01,
+	0x69, 0x80, 0x4b, 0x01, 0xc3, 0x57, 0xa8, 0x12, 0x46, 0x83, 0x61, 0xc4, 0x49, 0x13, 0x2a, 0x7f,
+	0x5c, 0xa8, 0xfc, 0xc6, 0xf3, 0xf9, 0x2d, 0x9c, 0xe8, 0xed, 0x84, 0x07, 0xf6, 0x51, 0x12, 0xd3,
+	0x83, 0xd4, 0xfd, 0x43, 0xe1, 0x7b, 0x8a, 0xfb, 0x73, 0xb5, 0x74, 0x32, 0x01, 0xfc, 0x29, 0x6a,
+	0xfa, 0xec, 0x55, 0x9f, 0x29, 0xc0, 0x43, 0xd2, 0x82, 0x86, 0x9f, 0x26, 0x31, 0x3d, 0x36, 0x71,
+	0x23, 0xb2, 0xe1, 0xb3, 0x57, 0x4f, 0x32, 0x18, 0x7f, 0x86, 0x5a, 0x92, 0x2b, 0xb9, 0xec, 0x0f,
+	0x98, 0x3b, 0x15, 0xa3, 0x11, 0xd9, 0x83, 0xf8, 0xc7, 0x49, 0x4c, 0x4f, 0x0a, 0x84, 0x21, 0xd0,
+	0x04, 0xc2, 0x4e, 0x71, 0xfc, 0x14, 0xed, 0x86, 0x33, 0xe6, 0x4e, 0xc9, 0x3e, 0x94, 0xf2, 0x68,
+	0xeb, 0x21, 0x6a, 0x07, 0xfb, 0x44, 0x9f, 0x61, 0x12, 0xd3, 0x7d, 0xf0, 0x37, 0x04, 0x53, 0x01,
+	0xcc, 0x51, 0x7d, 0xce, 0xc6, 0x5c, 0x0e, 0x23, 0xb5, 0x24, 0x07, 0xa0, 0xf6, 0xff, 0x6d, 0x6a,
+	0xdf, 0x68, 0xa7, 0x2f, 0x22, 0xb5, 0xb4, 0x7b, 0x5a, 0x71, 0x15, 0xd3, 0xfa, 0x1a, 0x4a, 0x62,
+	0xfa, 0x70, 0x2d, 0x62, 0x6c, 0xb1, 0x51, 0xd6, 0x09, 0x73, 0x9f, 0x79, 0x33, 0x72, 0xf8, 0xef,
+	0x09, 0x7f, 0xa9, 0x1d, 0x36, 0x09, 0x83, 0xbf, 0x99, 0x30, 0x00, 0xf8, 0x73, 0xb4, 0x27, 0xa3,
+	0x00, 0x5e, 0x20, 0x0b, 0x43, 0xae, 0x42, 0x82, 0xe1, 0xee, 0x9c, 0x25, 0x31, 0x25, 0x45, 0xc6,
+	0x08, 0x6e, 0x65, 0xcc, 0x13, 0x20, 0xf0, 0xb7, 0xa8, 0x9a, 0x3e, 0xd2, 0x90, 0x1c, 0x75, 0x4a,
+	0xbd, 0xc6, 0xe5, 0x59, 0x21, 0xa1, 0x6b, 0xe0, 0x1c, 0x3e, 0xe2, 0x92, 0x07, 0x2e, 0xb7, 0x1f,
+	0xdd, 0xc7, 0xf4, 0x41, 0x12, 0xd3, 0xc3, 0x2c, 0xc8, 0x10, 0xce, 0x75, 0xf0, 0x15, 0xaa, 0xe9,
+	0x19, 0x30, 0x64, 0x8a, 0x91, 0x87, 0x50, 0xe4, 0x49, 0x41, 0xf3, 0xf9, 0xe0, 0x7b, 0xee, 0xaa,
+	0xaf, 0xb9, 0x62, 0xf6, 0x91, 0x96, 0x7b, 0x9d, 0x96, 0xb9, 0x0e, 0x72, 0xd6, 0x56, 0xf7, 0x8d,
+	0x85, 0x5a, 0x85, 0x97, 0xa8, 0x87, 0xc4, 0x44, 0x84, 0x2a, 0x1f, 0x1c, 0xda, 0xc6, 0x67, 0xa8,
+	0x3c, 0x17, 0x52, 0xc1, 0xe0, 0x68, 0xd9, 0xb5, 0x24, 0xa6, 0xb0, 0x76, 0xe0, 0x17, 0x3f, 0x47,
+	0x58, 0x72, 0x57, 0x04, 0x01, 0x77, 0xd5, 0xe6, 0xa2, 0x96, 0xc0, 0xb7, 0x93, 0xc4, 0xf4, 0xec,
+	0x9f, 0xac, 0x51, 0xd5, 0xe1, 0x9a, 0x5d, 0x5f, 0xda, 0x82, 0xa0, 0x17, 0x28, 0x2e, 0x17, 0x6c,
+	0x46, 0xca, 0xdb, 0x04, 0x73, 0x76, 0xab, 0xe0, 0x55, 0x46, 0x76, 0x7f, 0xb3, 0x50, 0xd3, 0xbc,
+	0xaa, 0xf8, 0x02, 0x35, 0xee, 0xf8, 0x60, 0x22, 0xc4, 0xb4, 0x1f, 0xc9, 0x59, 0x5a, 0xab, 0xbd,
+	0xb7, 0x8a, 0x29, 0xba, 0x4d, 0xe1, 0x17, 0xce, 0x33, 0x07, 0x65, 0x2e, 0x2f, 0xe4, 0x0c, 0x5f,
+	0xa0, 0xaa, 0x3b, 0x61, 0x41, 0xc0, 0x67, 0xe9, 0xf4, 0xb4, 0xff, 0xa7, 0xcf, 0x28, 0x83, 0xcc,
+	0x33, 0xca, 0x20, 0x7c, 0x89, 0x6a, 0x51, 0xc8, 0x25, 0xcc, 0x60, 0x18, 0xac, 0xf6, 0x71, 0x12,
+	0x53, 0x9c, 0x63, 0x46, 0xc8, 0xda, 0x4f, 0xc7, 0x68, 0x6c, 0xc6, 0x14, 0x27, 0xe5, 0x4d, 0x4c,
+	0x8e, 0x99, 0x31, 0x39, 0xd6, 0xfd, 0x11, 0x1d, 0xbc, 0xfd, 0x6c, 0x30, 0x45, 0x0d, 0x29, 0x22,
+	0xe5, 0x05, 0xe3, 0xfe, 0x94, 0x2f, 0xb3, 0x93, 0x44, 0x19, 0xf4, 0x15, 0x5f, 0xe2, 0x2b, 0x74,
+	0x10, 0x46, 0xbe, 0xcf, 0xe4, 0xb2, 0xbf, 0xde, 0x30, 0x2d, 0xab, 0x9d, 0xc4, 0xf4, 0xf4, 0x6d,
+	0xce, 0xd8, 0x78, 0x3f, 0xe3, 0x6e, 0xf2, 0xfd, 0xff, 0x2c, 0xa1, 0xa6, 0xf9, 0xa8, 0xb6, 0xde,
+	0x9f, 0xf7, 0x0a, 0xf7, 0x07, 0x27, 0x31, 0xdd, 0xd3, 0x6b, 0x43, 0x37, 0xbd, 0x49, 0xff, 0xb1,
+	0x69, 0x73, 0x16, 0x86, 0x77, 0x42, 0x0e, 0xcd, 0xa6, 0xe5, 0x98, 0x19, 0x93, 0x63, 0x3a, 0xc7,
+	0x91, 0x14, 0x3e, 0x7c, 0xbd, 0xea, 0x0e, 0xd8, 0xf8, 0x18, 0xed, 0x28, 0x41, 0x2a, 0xf0, 0xc0,
+	0x2b, 0x49, 0x4c, 0x77, 0x94, 0x70, 0x76, 0x94, 0x48, 0x7b, 0x05, 0x0f, 0x6a, 0xd3, 0xab, 0xaa,
+	0xd9, 0xab, 0x22, 0x57, 0xec, 0x15, 0x70, 0x79, 0xaf, 0xf4, 0x30, 0x1e, 0x88, 0xa1, 0xd1, 0xf3,
+	0x1a, 0xe8, 0xc0, 0x30, 0x2e, 0x10, 0xe6, 0x30, 0xd6, 0xc4, 0x5a, 0xe1, 0x03, 0x54, 0x52, 0x33,
+	0xfd, 0x09, 0xb3, 0x7a, 0x35, 0x9b, 0xac, 0x62, 0x5a, 0xba, 0x79, 0x76, 0x9d, 0xc4, 0xb4, 0xa5,
+	0x66, 0xe6, 0x9b, 0xd2, 0x4e, 0xf8, 0x06, 0x1d, 0x79, 0x41, 0xc8, 0xdd, 0x48, 0xf2, 0x7e, 0x38,
+	0xf5, 0xe6, 0xfd, 0x05, 0x97, 0xde, 0x68, 0x09, 0xdf, 0xb4, 0x9a, 0xdd, 0x4d, 0x62, 0xda, 0xde,
+	0xc6, 0x1b, 0x32, 0x38, 0xe7, 0xaf, 0xa7, 0xde, 0xfc, 0x25, 0xb0, 0xf6, 0x3b, 0x7f, 0xfd, 0xd1,
+	0xb6, 0x7e, 0x5e, 0xb5, 0xad, 0x5f, 0x57, 0x6d, 0xeb, 0x7e, 0xd5, 0xb6, 0x5e, 0xaf, 0xda, 0xd6,
+	0xef, 0xab, 0xb6, 0xf5, 0xd3, 0x9b, 0xf6, 0x83, 0xef, 0x76, 0x61, 0x00, 0x0d, 0x2a, 0xf0, 0x7f,
+	0xe4, 0xe3, 0xbf, 0x07, 0x00, 0xdc, 0x05, 0x13, 0x77, 0x09, 0x09, 0x00, 0x00,
 }
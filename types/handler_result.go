@@ -0,0 +1,31 @@
+package types
+
+// HandlerResult describes the execution of a handler for an event by the
+// pipeline.
+type HandlerResult struct {
+	// Handler is the name of the handler.
+	Handler string `json:"handler"`
+	// Type is the type of the handler, e.g. pipe or tcp.
+	Type string `json:"type"`
+	// Organization the handled event belongs to.
+	Organization string `json:"organization"`
+	// Environment the handled event belongs to.
+	Environment string `json:"environment"`
+	// Entity is the ID of the entity of the handled event.
+	Entity string `json:"entity"`
+	// Check is the name of the check of the handled event, if any.
+	Check string `json:"check,omitempty"`
+	// Success is true if the handler executed successfully.
+	Success bool `json:"success"`
+	// Status is the exit status of pipe handlers.
+	Status int `json:"status"`
+	// Error is the reason the handler failed, if any.
+	Error string `json:"error,omitempty"`
+	// Duration of the execution, in seconds.
+	Duration float64 `json:"duration"`
+	// RequestID identifies the API request or the agent message the handled
+	// event originates from, if any.
+	RequestID string `json:"request_id,omitempty"`
+	// Executed is the time the handler was executed at, as a Unix timestamp.
+	Executed int64 `json:"executed"`
+}
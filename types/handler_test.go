@@ -17,6 +17,7 @@ func TestFixtureHandler(t *testing.T) {
 func TestFixtureSetHandler(t *testing.T) {
 	handler := FixtureSetHandler("handler")
 	assert.Equal(t, "handler", handler.Name)
+	assert.Equal(t, "set", handler.Type)
 	assert.NoError(t, handler.Validate())
 }
 
@@ -36,20 +37,20 @@ func TestHandlerValidate(t *testing.T) {
 	}{
 		{
 			Handler: Handler{},
-			Error:   "handler name must not be empty",
+			Error:   "name: must not be empty; type: must not be empty; environment: must be set; organization: must be set",
 		},
 		{
 			Handler: Handler{
 				Name: "foo",
 			},
-			Error: "empty handler type",
+			Error: "type: must not be empty; environment: must be set; organization: must be set",
 		},
 		{
 			Handler: Handler{
 				Name: "foo",
 				Type: "pipe",
 			},
-			Error: "environment must be set",
+			Error: "environment: must be set; organization: must be set",
 		},
 		{
 			Handler: Handler{
@@ -57,7 +58,7 @@ func TestHandlerValidate(t *testing.T) {
 				Type:        "pipe",
 				Environment: "default",
 			},
-			Error: "organization must be set",
+			Error: "organization: must be set",
 		},
 		{
 			Handler: Handler{
@@ -67,6 +68,36 @@ func TestHandlerValidate(t *testing.T) {
 				Environment:  "default",
 			},
 		},
+		{
+			Handler: Handler{
+				Name:          "foo",
+				Type:          "pipe",
+				Organization:  "default",
+				Environment:   "default",
+				RuntimeAssets: []string{"sensu-plugins"},
+			},
+		},
+		{
+			Handler: Handler{
+				Name:          "foo",
+				Type:          "pipe",
+				Organization:  "default",
+				Environment:   "default",
+				RuntimeAssets: []string{"BAD--a!!!---ASDFASDF$$$$"},
+			},
+			Error: "runtime_assets[0]: must be lowercase and may only contain forward slashes, underscores, dashes and numbers",
+		},
+		{
+			Handler: Handler{
+				Name:          "foo",
+				Type:          "tcp",
+				Organization:  "default",
+				Environment:   "default",
+				Socket:        &HandlerSocket{Host: "127.0.0.1", Port: 3000},
+				RuntimeAssets: []string{"sensu-plugins"},
+			},
+			Error: "runtime_assets: only pipe handlers can have runtime assets",
+		},
 		{
 			Handler: Handler{
 				Name:         "foo",
@@ -83,13 +114,58 @@ func TestHandlerValidate(t *testing.T) {
 				Environment:  "default",
 			},
 		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "pipe",
+				Organization: "default",
+				Environment:  "default",
+				EnvVars:      []string{"API_KEY"},
+			},
+			Error: "env_vars[0]: must be of the form FOO=BAR",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "pipe",
+				Organization: "default",
+				Environment:  "default",
+				MaxAttempts:  HandlerMaxAttempts + 1,
+			},
+			Error: "max_attempts: must not exceed 10",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "pipe",
+				Organization: "default",
+				Environment:  "default",
+				Subdue: &TimeWindowWhen{
+					Days: TimeWindowDays{
+						All: []*TimeWindowTimeRange{{Begin: "3:00PM", End: "4:00PM"}},
+					},
+					Timezone: "Nowhere/Special",
+				},
+			},
+			Error: "subdue.timezone: unknown time zone Nowhere/Special",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "set",
+				Organization: "default",
+				Environment:  "default",
+			},
+		},
 		{
 			Handler: Handler{
 				Name:         "foo",
 				Type:         "set",
+				Handlers:     []string{"bar", "foo"},
 				Organization: "default",
 				Environment:  "default",
 			},
+			Error: "handlers: cannot include the handler set itself",
 		},
 		{
 			Handler: Handler{
@@ -98,7 +174,7 @@ func TestHandlerValidate(t *testing.T) {
 				Organization: "default",
 				Environment:  "default",
 			},
-			Error: "tcp and udp handlers need a valid socket",
+			Error: "socket: must be set for tcp and udp handlers",
 		},
 		{
 			Handler: Handler{
@@ -110,7 +186,7 @@ func TestHandlerValidate(t *testing.T) {
 					Host: "localhost",
 				},
 			},
-			Error: "socket port undefined",
+			Error: "socket.port: must be set",
 		},
 		{
 			Handler: Handler{
@@ -122,7 +198,7 @@ func TestHandlerValidate(t *testing.T) {
 					Port: 1234,
 				},
 			},
-			Error: "socket host undefined",
+			Error: "socket.host: must be set",
 		},
 		{
 			Handler: Handler{
@@ -146,7 +222,7 @@ func TestHandlerValidate(t *testing.T) {
 					Host: "localhost",
 				},
 			},
-			Error: "socket port undefined",
+			Error: "socket.port: must be set",
 		},
 		{
 			Handler: Handler{
@@ -158,7 +234,7 @@ func TestHandlerValidate(t *testing.T) {
 					Port: 1234,
 				},
 			},
-			Error: "socket host undefined",
+			Error: "socket.host: must be set",
 		},
 		{
 			Handler: Handler{
@@ -179,7 +255,113 @@ func TestHandlerValidate(t *testing.T) {
 				Organization: "default",
 				Environment:  "default",
 			},
-			Error: "unknown handler type: magic",
+			Error: `type: "magic" is not a valid handler type`,
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "slack",
+				Organization: "default",
+				Environment:  "default",
+			},
+			Error: "slack.webhook_url: must be set for slack handlers",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "slack",
+				Organization: "default",
+				Environment:  "default",
+				Slack: &HandlerSlack{
+					WebhookURL: "ftp://hooks.slack.com/services/T000/B000/XXX",
+				},
+			},
+			Error: `slack.webhook_url: has invalid scheme "ftp"`,
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "slack",
+				Organization: "default",
+				Environment:  "default",
+				Slack: &HandlerSlack{
+					WebhookURL: "https://hooks.slack.com/services/T000/B000/XXX",
+					Template:   "{{ .Check.Output",
+				},
+			},
+			Error: "slack.template: is invalid: template: slack:1: unclosed action",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "pagerduty",
+				Organization: "default",
+				Environment:  "default",
+				PagerDuty:    &HandlerPagerDuty{},
+			},
+			Error: "pagerduty.routing_key: must be set for pagerduty handlers",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "email",
+				Organization: "default",
+				Environment:  "default",
+				Email: &HandlerEmail{
+					Host: "smtp.example.com",
+					From: "sensu@example.com",
+				},
+			},
+			Error: "email.to: must contain at least one recipient",
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "email",
+				Organization: "default",
+				Environment:  "default",
+				Email: &HandlerEmail{
+					Host: "smtp.example.com",
+					From: "sensu@example.com",
+					To:   []string{"nope"},
+				},
+			},
+			Error: `email.to[0]: "nope" is invalid: mail: missing '@' or angle-addr`,
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "email",
+				Organization: "default",
+				Environment:  "default",
+				Email: &HandlerEmail{
+					Host:            "smtp.example.com",
+					From:            "Sensu <sensu@example.com>",
+					To:              []string{"ops@example.com"},
+					SubjectTemplate: "{{ .Check.Name }}",
+				},
+			},
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "pagerduty",
+				Organization: "default",
+				Environment:  "default",
+				PagerDuty:    &HandlerPagerDuty{RoutingKey: "abc", SummaryTemplate: "{{ .Check.Output }}"},
+			},
+		},
+		{
+			Handler: Handler{
+				Name:         "foo",
+				Type:         "slack",
+				Organization: "default",
+				Environment:  "default",
+				Slack: &HandlerSlack{
+					WebhookURL: "https://hooks.slack.com/services/T000/B000/XXX",
+					Template:   "{{ .Check.Output }}",
+				},
+			},
 		},
 	}
 
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"errors"
 	fmt "fmt"
 	"net/url"
 	"regexp"
@@ -24,40 +23,42 @@ const HookRequestType = "hook_request"
 
 // Validate returns an error if the hook does not pass validation tests.
 func (h *Hook) Validate() error {
-	if err := h.HookConfig.Validate(); err != nil {
-		return err
-	}
+	var errs FieldErrors
+	errs.AddError("", h.HookConfig.Validate())
 
 	if h.Status < 0 {
-		return errors.New("hook status must be greater than or equal to 0")
+		errs.Add("status", "must be greater than or equal to 0")
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // Validate returns an error if the hook does not pass validation tests.
 func (c *HookConfig) Validate() error {
-	if err := ValidateName(c.Name); err != nil {
-		return errors.New("hook name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(c.Name))
 
-	if c.Command == "" {
-		return errors.New("command cannot be empty")
+	if c.Command == "" && len(c.CommandArgs) == 0 {
+		errs.Add("command", "cannot be empty")
+	}
+	errs.AddError("command_args", validateCommandArgs(c.CommandArgs, c.Command))
+	if c.StdinPayload != "" && c.Stdin {
+		errs.Add("stdin_payload", "cannot be combined with stdin")
 	}
 
 	if c.Timeout <= 0 {
-		return errors.New("hook timeout must be greater than 0")
+		errs.Add("timeout", "must be greater than 0")
 	}
 
 	if c.Environment == "" {
-		return errors.New("environment cannot be empty")
+		errs.Add("environment", "cannot be empty")
 	}
 
 	if c.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", "must be set")
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // URIPath returns the path component of a HookConfig URI.
@@ -65,23 +66,33 @@ func (c *HookConfig) URIPath() string {
 	return fmt.Sprintf("/hooks/%s", url.PathEscape(c.Name))
 }
 
+// GetObjectMeta implements MetaResource.
+func (c *HookConfig) GetObjectMeta() ObjectMeta {
+	return objectMeta(c.ObjectMeta, c.Name, c.Organization, c.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (c *HookConfig) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&c.Name, &c.Organization, &c.Environment)
+	c.ObjectMeta = meta
+}
+
 // Validate returns an error if the check hook does not pass validation tests.
 func (h *HookList) Validate() error {
+	var errs FieldErrors
 	if h.Type == "" {
-		return errors.New("type cannot be empty")
-	}
-
-	if h.Hooks == nil || len(h.Hooks) == 0 {
-		return errors.New("hooks cannot be empty")
-	}
-
-	if !(CheckHookRegex.MatchString(h.Type) || isSeverity(h.Type)) {
-		return errors.New(
+		errs.Add("type", "cannot be empty")
+	} else if !(CheckHookRegex.MatchString(h.Type) || isSeverity(h.Type)) {
+		errs.Add("type",
 			"valid check hook types are \"0\"-\"255\", \"ok\", \"warning\", \"critical\", \"unknown\", and \"non-zero\"",
 		)
 	}
 
-	return nil
+	if len(h.Hooks) == 0 {
+		errs.Add("hooks", "cannot be empty")
+	}
+
+	return errs.Err()
 }
 
 func isSeverity(name string) bool {
@@ -27,10 +27,19 @@ type HookConfig struct {
 	Timeout uint32 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout"`
 	// Stdin indicates if hook requests have stdin enabled
 	Stdin bool `protobuf:"varint,4,opt,name=stdin,proto3" json:"stdin"`
+	// CommandArgs are the executable to run and its arguments, executed without
+	// a shell instead of Command. Arguments may contain spaces, quotes or
+	// newlines without being escaped.
+	CommandArgs []string `protobuf:"bytes,8,rep,name=command_args,json=commandArgs" json:"command_args,omitempty"`
+	// StdinPayload is written to the standard input of the command. It cannot be
+	// combined with Stdin, which writes the event to it.
+	StdinPayload string `protobuf:"bytes,9,opt,name=stdin_payload,json=stdinPayload,proto3" json:"stdin_payload,omitempty"`
 	// Environment indicates to which env a hook belongs to
 	Environment string `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Organization indicates to which org a hook belongs to
 	Organization string `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the hook
+	ObjectMeta `protobuf:"bytes,7,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *HookConfig) Reset()                    { *m = HookConfig{} }
@@ -66,6 +75,20 @@ func (m *HookConfig) GetStdin() bool {
 	return false
 }
 
+func (m *HookConfig) GetCommandArgs() []string {
+	if m != nil {
+		return m.CommandArgs
+	}
+	return nil
+}
+
+func (m *HookConfig) GetStdinPayload() string {
+	if m != nil {
+		return m.StdinPayload
+	}
+	return ""
+}
+
 func (m *HookConfig) GetEnvironment() string {
 	if m != nil {
 		return m.Environment
@@ -199,12 +222,26 @@ func (this *HookConfig) Equal(that interface{}) bool {
 	if this.Stdin != that1.Stdin {
 		return false
 	}
+	if len(this.CommandArgs) != len(that1.CommandArgs) {
+		return false
+	}
+	for i := range this.CommandArgs {
+		if this.CommandArgs[i] != that1.CommandArgs[i] {
+			return false
+		}
+	}
+	if this.StdinPayload != that1.StdinPayload {
+		return false
+	}
 	if this.Environment != that1.Environment {
 		return false
 	}
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (this *Hook) Equal(that interface{}) bool {
@@ -320,6 +357,27 @@ func (m *HookConfig) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.CommandArgs) > 0 {
+		for _, s := range m.CommandArgs {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.StdinPayload) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintHook(dAtA, i, uint64(len(m.StdinPayload)))
+		i += copy(dAtA[i:], m.StdinPayload)
+	}
 	if len(m.Environment) > 0 {
 		dAtA[i] = 0x2a
 		i++
@@ -332,6 +390,14 @@ func (m *HookConfig) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintHook(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintHook(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n2, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
 	return i, nil
 }
 
@@ -442,8 +508,16 @@ func NewPopulatedHookConfig(r randyHook, easy bool) *HookConfig {
 	this.Command = string(randStringHook(r))
 	this.Timeout = uint32(r.Uint32())
 	this.Stdin = bool(bool(r.Intn(2) == 0))
+	v6 := r.Intn(10)
+	this.CommandArgs = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.CommandArgs[i] = string(randStringHook(r))
+	}
+	this.StdinPayload = string(randStringHook(r))
 	this.Environment = string(randStringHook(r))
 	this.Organization = string(randStringHook(r))
+	v5 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v5
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -577,6 +651,16 @@ func (m *HookConfig) Size() (n int) {
 	if m.Stdin {
 		n += 2
 	}
+	if len(m.CommandArgs) > 0 {
+		for _, s := range m.CommandArgs {
+			l = len(s)
+			n += 1 + l + sovHook(uint64(l))
+		}
+	}
+	l = len(m.StdinPayload)
+	if l > 0 {
+		n += 1 + l + sovHook(uint64(l))
+	}
 	l = len(m.Environment)
 	if l > 0 {
 		n += 1 + l + sovHook(uint64(l))
@@ -585,6 +669,8 @@ func (m *HookConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHook(uint64(l))
 	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovHook(uint64(l))
 	return n
 }
 
@@ -767,6 +853,64 @@ func (m *HookConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Stdin = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandArgs = append(m.CommandArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdinPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
@@ -825,6 +969,36 @@ func (m *HookConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHook(dAtA[iNdEx:])
@@ -1239,31 +1413,37 @@ var (
 func init() { proto.RegisterFile("hook.proto", fileDescriptorHook) }
 
 var fileDescriptorHook = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x6b, 0xda, 0xe6, 0xb6, 0xa7, 0x65, 0xf1, 0x00, 0xd1, 0x1d, 0x92, 0x28, 0x08, 0x29,
-	0x0b, 0xb9, 0x12, 0xcc, 0x08, 0x29, 0x2c, 0x0c, 0x4c, 0x1e, 0xd9, 0xd2, 0xc6, 0x37, 0xd7, 0xba,
-	0x8a, 0x4f, 0x55, 0xdb, 0x08, 0x78, 0x12, 0x1e, 0x81, 0x47, 0xe0, 0x11, 0x3a, 0xde, 0x27, 0x88,
-	0x20, 0x6c, 0xe1, 0x05, 0x18, 0x51, 0x8e, 0x93, 0x52, 0xa6, 0x73, 0xfe, 0x4f, 0x3e, 0xb2, 0xbe,
-	0x63, 0x03, 0xdc, 0x21, 0xde, 0xe7, 0x87, 0x23, 0x5a, 0xe4, 0x1b, 0x23, 0xb5, 0x71, 0xb9, 0xfd,
-	0x7c, 0x90, 0xe6, 0xfa, 0x45, 0xad, 0xec, 0x9d, 0xdb, 0xe5, 0x7b, 0x6c, 0x6e, 0x6a, 0xac, 0xf1,
-	0x86, 0xce, 0xec, 0xdc, 0x2d, 0x25, 0x0a, 0xd4, 0xf9, 0xd9, 0xf4, 0xc4, 0x00, 0xde, 0x21, 0xde,
-	0xbf, 0x45, 0x7d, 0xab, 0x6a, 0xce, 0x61, 0xa1, 0xcb, 0x46, 0x86, 0x2c, 0x61, 0xd9, 0x5a, 0x50,
-	0xcf, 0x43, 0xb8, 0xda, 0x63, 0xd3, 0x94, 0xba, 0x0a, 0x1f, 0x11, 0x9e, 0x22, 0x7f, 0x0e, 0x57,
-	0x56, 0x35, 0x12, 0x9d, 0x0d, 0xe7, 0x09, 0xcb, 0x1e, 0x17, 0x9b, 0xbe, 0x8d, 0x27, 0x24, 0xa6,
-	0x86, 0xc7, 0xb0, 0x34, 0xb6, 0x52, 0x3a, 0x5c, 0x24, 0x2c, 0x5b, 0x15, 0xeb, 0xbe, 0x8d, 0x3d,
-	0x10, 0xbe, 0xf0, 0x04, 0x36, 0x52, 0x7f, 0x54, 0x47, 0xd4, 0x8d, 0xd4, 0x36, 0x5c, 0xd2, 0x2d,
-	0x97, 0x88, 0xa7, 0xb0, 0xc5, 0x63, 0x5d, 0x6a, 0xf5, 0xa5, 0xb4, 0x0a, 0x75, 0x18, 0xd0, 0x91,
-	0xff, 0x58, 0xfa, 0x9b, 0xc1, 0x62, 0x50, 0xe1, 0xaf, 0x21, 0xd8, 0x93, 0x0e, 0x69, 0x6c, 0x5e,
-	0x3e, 0xcd, 0x2f, 0x16, 0x94, 0xff, 0xb3, 0x2d, 0xb6, 0xa7, 0x36, 0x9e, 0x3d, 0xb4, 0x31, 0xeb,
-	0xdb, 0x78, 0x26, 0xc6, 0x21, 0x7e, 0x0d, 0xab, 0xca, 0x1d, 0xfd, 0x3d, 0x83, 0x30, 0x13, 0xe7,
-	0xcc, 0x33, 0x58, 0xc9, 0x4f, 0x72, 0xef, 0xac, 0xac, 0x48, 0x79, 0x5e, 0x6c, 0xfb, 0x36, 0x3e,
-	0x33, 0x71, 0xee, 0x78, 0x0a, 0x81, 0x32, 0xc6, 0xc9, 0x8a, 0xac, 0xe7, 0x05, 0xf4, 0x6d, 0x3c,
-	0x12, 0x31, 0x56, 0xfe, 0x04, 0x02, 0x74, 0xf6, 0xe0, 0x26, 0xe5, 0x31, 0x0d, 0xb3, 0xc6, 0x96,
-	0xd6, 0x19, 0xf2, 0x5c, 0xfa, 0x59, 0x4f, 0xc4, 0x58, 0xd3, 0x37, 0xb0, 0x1a, 0x4c, 0xde, 0x2b,
-	0x43, 0x0b, 0x1e, 0xbe, 0x83, 0x09, 0x59, 0x32, 0xcf, 0xd6, 0x7e, 0xc1, 0x04, 0x84, 0x2f, 0xc3,
-	0xb3, 0x0e, 0xf2, 0xe3, 0xfb, 0x51, 0x5f, 0x3c, 0xfb, 0xf3, 0x33, 0x62, 0xdf, 0xba, 0x88, 0x7d,
-	0xef, 0x22, 0x76, 0xea, 0x22, 0xf6, 0xd0, 0x45, 0xec, 0x47, 0x17, 0xb1, 0xaf, 0xbf, 0xa2, 0xd9,
-	0x87, 0x25, 0x2d, 0x6b, 0x17, 0xd0, 0x2f, 0x79, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0x56, 0x2f,
-	0x75, 0xdd, 0x6f, 0x02, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8e, 0xd3, 0x3e,
+	0x10, 0xae, 0x7f, 0xfd, 0x97, 0x4e, 0xbb, 0x17, 0xeb, 0xa7, 0x5d, 0xab, 0x48, 0x49, 0x54, 0x84,
+	0x94, 0x03, 0x74, 0x25, 0x38, 0xaf, 0x80, 0x70, 0x01, 0x09, 0x04, 0xf2, 0x91, 0xcb, 0xca, 0x6d,
+	0xbc, 0xd9, 0xb0, 0x24, 0xae, 0x62, 0x1b, 0x51, 0x9e, 0x83, 0x03, 0x8f, 0xc0, 0x23, 0xf0, 0x08,
+	0x7b, 0xdc, 0x27, 0x88, 0xa0, 0xdc, 0xc2, 0x0b, 0x70, 0x44, 0x9e, 0xa4, 0x25, 0x2b, 0x71, 0x9a,
+	0x6f, 0x3e, 0x7b, 0x46, 0x33, 0xf3, 0x7d, 0x00, 0x97, 0x4a, 0x5d, 0x2d, 0x37, 0xa5, 0x32, 0x8a,
+	0x4e, 0xb5, 0x2c, 0xb4, 0x5d, 0x9a, 0xed, 0x46, 0xea, 0xf9, 0x83, 0x34, 0x33, 0x97, 0x76, 0xb5,
+	0x5c, 0xab, 0xfc, 0x34, 0x55, 0xa9, 0x3a, 0xc5, 0x3f, 0x2b, 0x7b, 0x81, 0x19, 0x26, 0x88, 0x9a,
+	0xda, 0x39, 0xe4, 0xd2, 0x88, 0x06, 0x2f, 0x3e, 0xf7, 0x01, 0x9e, 0x2b, 0x75, 0xf5, 0x4c, 0x15,
+	0x17, 0x59, 0x4a, 0x29, 0x0c, 0x0a, 0x91, 0x4b, 0x46, 0x42, 0x12, 0x4d, 0x38, 0x62, 0xca, 0x60,
+	0xbc, 0x56, 0x79, 0x2e, 0x8a, 0x84, 0xfd, 0x87, 0xf4, 0x3e, 0xa5, 0xf7, 0x60, 0x6c, 0xb2, 0x5c,
+	0x2a, 0x6b, 0x58, 0x3f, 0x24, 0xd1, 0x51, 0x3c, 0xad, 0xab, 0x60, 0x4f, 0xf1, 0x3d, 0xa0, 0x01,
+	0x0c, 0xb5, 0x49, 0xb2, 0x82, 0x0d, 0x42, 0x12, 0x79, 0xf1, 0xa4, 0xae, 0x82, 0x86, 0xe0, 0x4d,
+	0xa0, 0x67, 0x30, 0x6b, 0x5b, 0x9e, 0x8b, 0x32, 0xd5, 0xcc, 0x0b, 0xfb, 0xd1, 0x24, 0x9e, 0xd7,
+	0x55, 0x70, 0xdc, 0xe5, 0xef, 0xab, 0x3c, 0x33, 0x32, 0xdf, 0x98, 0x2d, 0x9f, 0xb6, 0xfc, 0xd3,
+	0x32, 0xd5, 0xf4, 0x09, 0x1c, 0x61, 0x9f, 0xf3, 0x8d, 0xd8, 0xbe, 0x57, 0x22, 0x61, 0x13, 0x37,
+	0x66, 0x7c, 0xa7, 0xae, 0x82, 0x93, 0x5b, 0x0f, 0x9d, 0x06, 0x33, 0x7c, 0x78, 0xd3, 0xf0, 0x34,
+	0x84, 0xa9, 0x2c, 0x3e, 0x64, 0xa5, 0x2a, 0x72, 0x59, 0x18, 0x36, 0xc4, 0x35, 0xbb, 0x14, 0x5d,
+	0xc0, 0x4c, 0x95, 0xa9, 0x28, 0xb2, 0x4f, 0xc2, 0x64, 0xaa, 0x60, 0x23, 0xfc, 0x72, 0x8b, 0xa3,
+	0x2f, 0xc0, 0x73, 0x97, 0x4d, 0x84, 0x11, 0x6c, 0x1c, 0x92, 0x68, 0xfa, 0xf0, 0x64, 0xd9, 0x91,
+	0x69, 0xf9, 0x7a, 0xf5, 0x4e, 0xae, 0xcd, 0x2b, 0x69, 0x44, 0xfc, 0xff, 0x75, 0x15, 0xf4, 0x6e,
+	0xaa, 0x80, 0xd4, 0x55, 0x70, 0x28, 0xe2, 0x07, 0xb4, 0xf8, 0x45, 0x60, 0xe0, 0x64, 0xa1, 0x67,
+	0x30, 0x5a, 0xa3, 0x34, 0x8c, 0xfc, 0xa3, 0xe3, 0x5f, 0xe5, 0xe2, 0x59, 0xa7, 0x63, 0x8f, 0xb7,
+	0x45, 0x74, 0x0e, 0x5e, 0x62, 0xcb, 0x66, 0x64, 0x27, 0x1e, 0xe1, 0x87, 0x9c, 0x46, 0xe0, 0xc9,
+	0x8f, 0x72, 0x6d, 0x8d, 0x4c, 0x50, 0xbe, 0x7e, 0x3c, 0x73, 0xd3, 0xec, 0x39, 0x7e, 0x40, 0x74,
+	0x01, 0xa3, 0x4c, 0x6b, 0x2b, 0x13, 0x54, 0xb0, 0x1f, 0x43, 0x5d, 0x05, 0x2d, 0xc3, 0xdb, 0x48,
+	0x8f, 0x61, 0xa4, 0xac, 0xd9, 0xd8, 0xfd, 0xf5, 0xda, 0xcc, 0xd5, 0x6a, 0x23, 0x8c, 0xd5, 0x78,
+	0xb2, 0x61, 0x53, 0xdb, 0x30, 0xbc, 0x8d, 0x8b, 0xc7, 0xe0, 0xb9, 0x4d, 0x5e, 0x66, 0x1a, 0xcd,
+	0xe2, 0x6c, 0xae, 0x19, 0x41, 0x13, 0xa0, 0x59, 0x90, 0xe0, 0x4d, 0x70, 0x16, 0x75, 0xcb, 0xb7,
+	0x5e, 0x44, 0x1c, 0xdf, 0xfd, 0xfd, 0xc3, 0x27, 0x5f, 0x77, 0x3e, 0xf9, 0xb6, 0xf3, 0xc9, 0xf5,
+	0xce, 0x27, 0x37, 0x3b, 0x9f, 0x7c, 0xdf, 0xf9, 0xe4, 0xcb, 0x4f, 0xbf, 0xf7, 0x76, 0x88, 0xc7,
+	0x5a, 0x8d, 0xd0, 0xf1, 0x8f, 0xfe, 0x0c, 0x00, 0xfe, 0x5d, 0xf9, 0x28, 0x47, 0x03, 0x00, 0x00,
 }
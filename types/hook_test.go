@@ -80,6 +80,26 @@ func TestHookConfig(t *testing.T) {
 	assert.NoError(t, h.Validate())
 }
 
+func TestHookConfigCommandArgs(t *testing.T) {
+	h := FixtureHookConfig("hook")
+	h.Command = ""
+	h.CommandArgs = []string{"psql", "-c", "SELECT 1;\nSELECT 2;"}
+	assert.NoError(t, h.Validate())
+
+	h.Command = "true"
+	assert.EqualError(t, h.Validate(), "command_args: cannot be combined with a command")
+	h.Command = ""
+
+	h.CommandArgs = []string{" ", "-c"}
+	assert.EqualError(t, h.Validate(), "command_args[0]: must be the executable to run")
+	h.CommandArgs = []string{"psql"}
+
+	h.StdinPayload = "SELECT 1;"
+	assert.NoError(t, h.Validate())
+	h.Stdin = true
+	assert.EqualError(t, h.Validate(), "stdin_payload: cannot be combined with stdin")
+}
+
 func TestFixtureHookIsValid(t *testing.T) {
 	c := FixtureHook("hook")
 	config := c.HookConfig
@@ -0,0 +1,73 @@
+package types
+
+// objectMetaFields are the JSON names of the fields of ObjectMeta
+var objectMetaFields = map[string]bool{
+	"name":        true,
+	"namespace":   true,
+	"labels":      true,
+	"annotations": true,
+	"created_by":  true,
+}
+
+// MetaResource is a resource with standard object metadata. The name and
+// namespace of its metadata are those of the resource, which keeps its own
+// name, organization and environment for compatibility.
+type MetaResource interface {
+	Resource
+
+	// GetObjectMeta returns the object metadata of the resource, named and
+	// namespaced after the resource.
+	GetObjectMeta() ObjectMeta
+
+	// SetObjectMeta sets the object metadata of the resource, renaming the
+	// resource and moving it to the namespace given by the metadata, if any.
+	SetObjectMeta(ObjectMeta)
+}
+
+// SyncObjectMeta reconciles the object metadata of the given resource with its
+// name, organization and environment: those missing from the resource are
+// given by the metadata, which is then named and namespaced after the
+// resource.
+func SyncObjectMeta(r MetaResource) {
+	r.SetObjectMeta(r.GetObjectMeta())
+}
+
+// FixtureObjectMeta returns a mocked object metadata in the default namespace.
+func FixtureObjectMeta(name string) ObjectMeta {
+	return ObjectMeta{
+		Name:        name,
+		Namespace:   FixtureNamespace("default").Name,
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+	}
+}
+
+// objectMeta returns the given metadata named and namespaced after the given
+// name, organization and environment, when not empty.
+func objectMeta(meta ObjectMeta, name, org, env string) ObjectMeta {
+	if name != "" {
+		meta.Name = name
+	}
+	if org != "" && env != "" {
+		meta.Namespace = NewNamespace(org, env).Name
+	}
+	return meta
+}
+
+// apply sets the name, organization and environment given by the metadata to
+// the given ones, which may be nil for the resources without them.
+func (m ObjectMeta) apply(name, org, env *string) {
+	if name != nil && m.Name != "" {
+		*name = m.Name
+	}
+	ns := Namespace{Name: m.Namespace}
+	if ns.Validate() != nil {
+		return
+	}
+	if org != nil {
+		*org = ns.GetOrganization()
+	}
+	if env != nil {
+		*env = ns.GetEnvironment()
+	}
+}
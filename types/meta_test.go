@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectMeta(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.Labels = map[string]string{"team": "ops"}
+
+	meta := check.GetObjectMeta()
+	assert.Equal(t, "check", meta.Name)
+	assert.Equal(t, "default/default", meta.Namespace)
+	assert.Equal(t, map[string]string{"team": "ops"}, meta.Labels)
+
+	// Resources outside of an environment have no namespace
+	org := FixtureOrganization("acme")
+	assert.Equal(t, ObjectMeta{Name: "acme"}, org.GetObjectMeta())
+}
+
+func TestSetObjectMeta(t *testing.T) {
+	handler := &Handler{}
+	handler.SetObjectMeta(ObjectMeta{Name: "slack", Namespace: "acme/production"})
+	assert.Equal(t, "slack", handler.Name)
+	assert.Equal(t, "acme", handler.Organization)
+	assert.Equal(t, "production", handler.Environment)
+
+	// An invalid namespace leaves the organization and environment untouched
+	handler.SetObjectMeta(ObjectMeta{Namespace: "acme"})
+	assert.Equal(t, "slack", handler.Name)
+	assert.Equal(t, "acme", handler.Organization)
+	assert.Equal(t, "production", handler.Environment)
+}
+
+func TestSyncObjectMeta(t *testing.T) {
+	entity := FixtureEntity("entity")
+	entity.ObjectMeta = ObjectMeta{Name: "other", Annotations: map[string]string{"foo": "bar"}}
+	SyncObjectMeta(entity)
+
+	// The name of the resource takes precedence over its metadata
+	assert.Equal(t, "entity", entity.ID)
+	assert.Equal(t, "entity", entity.ObjectMeta.Name)
+	assert.Equal(t, "default/default", entity.ObjectMeta.Namespace)
+	assert.Equal(t, map[string]string{"foo": "bar"}, entity.Annotations)
+
+	var _ MetaResource = &User{}
+	user := FixtureUser("alice")
+	SyncObjectMeta(user)
+	assert.Equal(t, "alice", user.ObjectMeta.Name)
+	assert.Equal(t, "/rbac/users/alice", user.URIPath())
+}
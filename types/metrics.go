@@ -9,6 +9,25 @@ func (m *Metrics) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the metric tag does not pass validation tests.
+func (t *MetricTag) Validate() error {
+	var errs FieldErrors
+	if t == nil || t.Name == "" {
+		errs.Add("name", "cannot be empty")
+	}
+	return errs.Err()
+}
+
+// ValidateMetricTags returns an error if one of the metric tags does not pass
+// validation tests.
+func ValidateMetricTags(tags []*MetricTag) error {
+	var errs FieldErrors
+	for i, tag := range tags {
+		errs.AddError(FieldPath(i), tag.Validate())
+	}
+	return errs.Err()
+}
+
 // FixtureMetrics returns a testing fixture for a Metrics object.
 func FixtureMetrics() *Metrics {
 	return &Metrics{
@@ -19,11 +38,13 @@ func FixtureMetrics() *Metrics {
 
 // FixtureMetricPoint returns a testing fixture for a Metric Point object.
 func FixtureMetricPoint() *MetricPoint {
+	now := time.Now()
 	return &MetricPoint{
-		Name:      "answer",
-		Value:     42.0,
-		Timestamp: time.Now().UnixNano(),
-		Tags:      []*MetricTag{FixtureMetricTag()},
+		Name:           "answer",
+		Value:          42.0,
+		Timestamp:      now.Unix(),
+		TimestampNanos: now.UnixNano(),
+		Tags:           []*MetricTag{FixtureMetricTag()},
 	}
 }
 
@@ -23,6 +23,9 @@ type Metrics struct {
 	Handlers []string `protobuf:"bytes,1,rep,name=handlers" json:"handlers"`
 	// Points is a list of metric points (measurements).
 	Points []*MetricPoint `protobuf:"bytes,2,rep,name=points" json:"points"`
+	// ParseErrors is the number of metrics dropped because they could not be
+	// parsed from the check output.
+	ParseErrors uint32 `protobuf:"varint,3,opt,name=parse_errors,json=parseErrors,proto3" json:"parse_errors,omitempty"`
 }
 
 func (m *Metrics) Reset()                    { *m = Metrics{} }
@@ -44,16 +47,26 @@ func (m *Metrics) GetPoints() []*MetricPoint {
 	return nil
 }
 
+func (m *Metrics) GetParseErrors() uint32 {
+	if m != nil {
+		return m.ParseErrors
+	}
+	return 0
+}
+
 // A MetricPoint represents a single measurement.
 type MetricPoint struct {
 	// The metric point name.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The metric point value.
 	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value"`
-	// The metric point timestamp, time in nanoseconds since the Epoch.
+	// The metric point timestamp, time in seconds since the Epoch.
 	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp"`
 	// Tags is a list of metric tags (dimensions).
 	Tags []*MetricTag `protobuf:"bytes,4,rep,name=tags" json:"tags"`
+	// The metric point timestamp with nanosecond precision, time in nanoseconds
+	// since the Epoch.
+	TimestampNanos int64 `protobuf:"varint,5,opt,name=timestamp_nanos,json=timestampNanos,proto3" json:"timestamp_nanos,omitempty"`
 }
 
 func (m *MetricPoint) Reset()                    { *m = MetricPoint{} }
@@ -89,6 +102,13 @@ func (m *MetricPoint) GetTags() []*MetricTag {
 	return nil
 }
 
+func (m *MetricPoint) GetTimestampNanos() int64 {
+	if m != nil {
+		return m.TimestampNanos
+	}
+	return 0
+}
+
 // A MetricTag adds a dimension to a metric point.
 type MetricTag struct {
 	// The metric tag name.
@@ -156,6 +176,9 @@ func (this *Metrics) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ParseErrors != that1.ParseErrors {
+		return false
+	}
 	return true
 }
 func (this *MetricPoint) Equal(that interface{}) bool {
@@ -194,6 +217,9 @@ func (this *MetricPoint) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.TimestampNanos != that1.TimestampNanos {
+		return false
+	}
 	return true
 }
 func (this *MetricTag) Equal(that interface{}) bool {
@@ -265,6 +291,11 @@ func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.ParseErrors != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.ParseErrors))
+	}
 	return i, nil
 }
 
@@ -312,6 +343,11 @@ func (m *MetricPoint) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.TimestampNanos != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.TimestampNanos))
+	}
 	return i, nil
 }
 
@@ -368,6 +404,7 @@ func NewPopulatedMetrics(r randyMetrics, easy bool) *Metrics {
 			this.Points[i] = NewPopulatedMetricPoint(r, easy)
 		}
 	}
+	this.ParseErrors = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -391,6 +428,10 @@ func NewPopulatedMetricPoint(r randyMetrics, easy bool) *MetricPoint {
 			this.Tags[i] = NewPopulatedMetricTag(r, easy)
 		}
 	}
+	this.TimestampNanos = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.TimestampNanos *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -492,6 +533,9 @@ func (m *Metrics) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if m.ParseErrors != 0 {
+		n += 1 + sovMetrics(uint64(m.ParseErrors))
+	}
 	return n
 }
 
@@ -514,6 +558,9 @@ func (m *MetricPoint) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if m.TimestampNanos != 0 {
+		n += 1 + sovMetrics(uint64(m.TimestampNanos))
+	}
 	return n
 }
 
@@ -633,6 +680,25 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParseErrors", wireType)
+			}
+			m.ParseErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParseErrors |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -773,6 +839,25 @@ func (m *MetricPoint) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampNanos", wireType)
+			}
+			m.TimestampNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -1010,25 +1095,29 @@ var (
 func init() { proto.RegisterFile("metrics.proto", fileDescriptorMetrics) }
 
 var fileDescriptorMetrics = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xbf, 0xdb, 0xb4, 0xfd, 0x1a, 0x97, 0x2e, 0x16, 0x42, 0x16, 0x83, 0x1d, 0x95, 0xc5,
-	0x12, 0x22, 0x95, 0xf8, 0xb3, 0x31, 0x79, 0x47, 0x42, 0x16, 0x13, 0x9b, 0x5b, 0x4c, 0x1a, 0xa9,
-	0x89, 0x43, 0xed, 0x20, 0xf1, 0x26, 0xbc, 0x00, 0x12, 0x8f, 0xc0, 0x23, 0x30, 0xf2, 0x04, 0x11,
-	0x84, 0x2d, 0x4f, 0xc0, 0x88, 0x70, 0xaa, 0xb6, 0x43, 0x17, 0xdf, 0x73, 0x8f, 0x8f, 0xaf, 0x7f,
-	0xba, 0x68, 0x94, 0x69, 0xb7, 0x4c, 0x67, 0x36, 0x2e, 0x96, 0xc6, 0x19, 0x3c, 0xb4, 0x3a, 0xb7,
-	0x65, 0xec, 0x9e, 0x0a, 0x6d, 0x0f, 0x4f, 0x92, 0xd4, 0xcd, 0xcb, 0x69, 0x3c, 0x33, 0xd9, 0x24,
-	0x31, 0x89, 0x99, 0xf8, 0xcc, 0xb4, 0xbc, 0xf7, 0x9d, 0x6f, 0xbc, 0x6a, 0xdf, 0x8e, 0x1f, 0xd0,
-	0xff, 0xab, 0x76, 0x18, 0xe6, 0x68, 0x30, 0x57, 0xf9, 0xdd, 0x42, 0x2f, 0x2d, 0x81, 0x28, 0xe0,
-	0xa1, 0xd8, 0x6b, 0x2a, 0xb6, 0xf6, 0xe4, 0x5a, 0xe1, 0x4b, 0xd4, 0x2f, 0x4c, 0x9a, 0x3b, 0x4b,
-	0x3a, 0x51, 0xc0, 0x87, 0xa7, 0x24, 0xde, 0x22, 0x88, 0xdb, 0x79, 0xd7, 0x7f, 0x01, 0x81, 0x9a,
-	0x8a, 0xad, 0xb2, 0x72, 0x55, 0xc7, 0x2f, 0x80, 0x86, 0x5b, 0x19, 0x8c, 0x51, 0x37, 0x57, 0x99,
-	0x26, 0x10, 0x01, 0x0f, 0xa5, 0xd7, 0x98, 0xa1, 0xde, 0xa3, 0x5a, 0x94, 0x9a, 0x74, 0x22, 0xe0,
-	0x20, 0xc2, 0xa6, 0x62, 0xad, 0x21, 0xdb, 0x82, 0x8f, 0x51, 0xe8, 0xd2, 0x4c, 0x5b, 0xa7, 0xb2,
-	0x82, 0x04, 0x11, 0xf0, 0x40, 0x8c, 0x9a, 0x8a, 0x6d, 0x4c, 0xb9, 0x91, 0xf8, 0x1c, 0x75, 0x9d,
-	0x4a, 0x2c, 0xe9, 0x7a, 0xda, 0x83, 0x1d, 0xb4, 0x37, 0x2a, 0x11, 0x83, 0xa6, 0x62, 0x3e, 0x27,
-	0xfd, 0x39, 0xbe, 0x40, 0xe1, 0xfa, 0x72, 0x27, 0xe4, 0xfe, 0x36, 0x64, 0xb8, 0x22, 0x13, 0x47,
-	0x3f, 0x5f, 0x14, 0x5e, 0x6b, 0x0a, 0x6f, 0x35, 0x85, 0xf7, 0x9a, 0xc2, 0x47, 0x4d, 0xe1, 0xb3,
-	0xa6, 0xf0, 0xfc, 0x4d, 0xff, 0xdd, 0xf6, 0xfc, 0xaf, 0xd3, 0xbe, 0xdf, 0xfe, 0xd9, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x13, 0x3c, 0xce, 0x9c, 0xca, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0xfd, 0xa6, 0x69, 0xfb, 0x35, 0x93, 0xf6, 0xfb, 0x60, 0x90, 0x32, 0x16, 0xcc, 0x84, 0xba,
+	0x09, 0xa8, 0x29, 0xf8, 0xb3, 0xd3, 0x4d, 0x40, 0x77, 0x8a, 0x04, 0x57, 0x6e, 0xca, 0xb4, 0x8e,
+	0x69, 0xa0, 0xc9, 0x84, 0xcc, 0x44, 0xe8, 0x9b, 0xf8, 0x08, 0x2e, 0x5d, 0xfa, 0x08, 0x2e, 0x7d,
+	0x82, 0xa0, 0x71, 0x17, 0x70, 0xef, 0x52, 0x3a, 0x29, 0x69, 0x90, 0x6e, 0x72, 0xcf, 0x3d, 0xf7,
+	0xe4, 0xde, 0x73, 0x60, 0x60, 0x2f, 0x64, 0x32, 0x09, 0xa6, 0xc2, 0x89, 0x13, 0x2e, 0x39, 0x32,
+	0x04, 0x8b, 0x44, 0xea, 0xc8, 0x45, 0xcc, 0xc4, 0xe0, 0xc0, 0x0f, 0xe4, 0x2c, 0x9d, 0x38, 0x53,
+	0x1e, 0x8e, 0x7c, 0xee, 0xf3, 0x91, 0xd2, 0x4c, 0xd2, 0x7b, 0xd5, 0xa9, 0x46, 0xa1, 0xf2, 0xdf,
+	0xe1, 0x33, 0x80, 0x7f, 0x2f, 0xcb, 0x6d, 0xc8, 0x86, 0x9d, 0x19, 0x8d, 0xee, 0xe6, 0x2c, 0x11,
+	0x18, 0x58, 0x9a, 0xad, 0xbb, 0xdd, 0x22, 0x23, 0x15, 0xe7, 0x55, 0x08, 0x9d, 0xc2, 0x76, 0xcc,
+	0x83, 0x48, 0x0a, 0xdc, 0xb0, 0x34, 0xdb, 0x38, 0xc4, 0x4e, 0xcd, 0x82, 0x53, 0xee, 0xbb, 0x5e,
+	0x0a, 0x5c, 0x58, 0x64, 0x64, 0xa5, 0xf5, 0x56, 0x15, 0x9d, 0xc1, 0x6e, 0x4c, 0x13, 0xc1, 0xc6,
+	0x2c, 0x49, 0x78, 0x22, 0xb0, 0x66, 0x01, 0xbb, 0xe7, 0x0e, 0x8a, 0x8c, 0xf4, 0xeb, 0xfc, 0x3e,
+	0x0f, 0x03, 0xc9, 0xc2, 0x58, 0x2e, 0x3c, 0x43, 0xf1, 0xe7, 0x8a, 0x1e, 0x7e, 0x01, 0x68, 0xd4,
+	0x4e, 0x20, 0x04, 0x9b, 0x11, 0x0d, 0x19, 0x06, 0x16, 0xb0, 0x75, 0x4f, 0x61, 0x44, 0x60, 0xeb,
+	0x81, 0xce, 0x53, 0x86, 0x1b, 0x16, 0xb0, 0x81, 0xab, 0x17, 0x19, 0x29, 0x09, 0xaf, 0x2c, 0x68,
+	0x0f, 0xea, 0x32, 0x08, 0x99, 0x90, 0x34, 0x8c, 0x95, 0x01, 0xcd, 0xed, 0x15, 0x19, 0x59, 0x93,
+	0xde, 0x1a, 0xa2, 0x63, 0xd8, 0x94, 0xd4, 0x17, 0xb8, 0xa9, 0xc2, 0xf6, 0x37, 0x84, 0xbd, 0xa1,
+	0xbe, 0xdb, 0x29, 0x32, 0xa2, 0x74, 0x9e, 0xfa, 0xa2, 0x0b, 0xf8, 0xbf, 0x5a, 0x31, 0x8e, 0x68,
+	0xc4, 0x05, 0x6e, 0xa9, 0x43, 0x3b, 0x45, 0x46, 0xb6, 0x7f, 0x8d, 0x6a, 0x61, 0xff, 0x55, 0xa3,
+	0xab, 0xe5, 0x64, 0x78, 0x02, 0xf5, 0xea, 0xc8, 0xc6, 0xb0, 0x5b, 0xf5, 0xb0, 0xfa, 0x2a, 0xa1,
+	0xbb, 0xfb, 0xfd, 0x61, 0x82, 0xa7, 0xdc, 0x04, 0x2f, 0xb9, 0x09, 0x5e, 0x73, 0x13, 0xbc, 0xe5,
+	0x26, 0x78, 0xcf, 0x4d, 0xf0, 0xf8, 0x69, 0xfe, 0xb9, 0x6d, 0x29, 0xf7, 0x93, 0xb6, 0x7a, 0x05,
+	0x47, 0x3f, 0x03, 0x00, 0x85, 0x53, 0x28, 0x59, 0x52, 0x02, 0x00, 0x00,
 }
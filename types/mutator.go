@@ -1,29 +1,35 @@
 package types
 
 import (
-	"errors"
 	fmt "fmt"
 	"net/url"
 )
 
 // Validate returns an error if the mutator does not pass validation tests.
 func (m *Mutator) Validate() error {
-	if err := ValidateName(m.Name); err != nil {
-		return errors.New("mutator name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(m.Name))
+
 	if m.Command == "" {
-		return errors.New("mutator command must be set")
+		errs.Add("command", "must be set")
 	}
 
 	if m.Environment == "" {
-		return errors.New("mutator environment must be set")
+		errs.Add("environment", "must be set")
 	}
 
 	if m.Organization == "" {
-		return errors.New("mutator organization must be set")
+		errs.Add("organization", "must be set")
 	}
 
-	return nil
+	for i, assetName := range m.RuntimeAssets {
+		errs.AddError(FieldPath("runtime_assets", i), ValidateAssetName(assetName))
+	}
+
+	errs.AddError("env_vars", ValidateEnvVars(m.EnvVars))
+	errs.AddError("secrets", ValidateSecretReferences(m.Secrets))
+
+	return errs.Err()
 }
 
 // Update updates m with selected fields. Returns non-nil error if any of the
@@ -37,6 +43,10 @@ func (m *Mutator) Update(from *Mutator, fields ...string) error {
 			m.Timeout = from.Timeout
 		case "EnvVars":
 			m.EnvVars = append(m.EnvVars[0:0], from.EnvVars...)
+		case "RuntimeAssets":
+			m.RuntimeAssets = append(m.RuntimeAssets[0:0], from.RuntimeAssets...)
+		case "Secrets":
+			m.Secrets = append(m.Secrets[0:0], from.Secrets...)
 		default:
 			return fmt.Errorf("unsupported field: %q", f)
 		}
@@ -58,3 +68,14 @@ func FixtureMutator(name string) *Mutator {
 func (m *Mutator) URIPath() string {
 	return fmt.Sprintf("/mutators/%s", url.PathEscape(m.Name))
 }
+
+// GetObjectMeta implements MetaResource.
+func (m *Mutator) GetObjectMeta() ObjectMeta {
+	return objectMeta(m.ObjectMeta, m.Name, m.Organization, m.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (m *Mutator) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&m.Name, &m.Organization, &m.Environment)
+	m.ObjectMeta = meta
+}
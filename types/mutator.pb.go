@@ -29,6 +29,15 @@ type Mutator struct {
 	Environment string `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Organization specifies the organization to which the mutator belongs.
 	Organization string `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization,omitempty"`
+	// RuntimeAssets are a list of assets required to execute the mutator
+	// command.
+	RuntimeAssets []string `protobuf:"bytes,7,rep,name=runtime_assets,json=runtimeAssets" json:"runtime_assets,omitempty"`
+	// Secrets are the secrets exposed to the mutator command, as environment
+	// variables.
+	Secrets []SecretReference `protobuf:"bytes,9,rep,name=secrets" json:"secrets,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the
+	// mutator
+	ObjectMeta `protobuf:"bytes,8,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Mutator) Reset()                    { *m = Mutator{} }
@@ -78,6 +87,20 @@ func (m *Mutator) GetOrganization() string {
 	return ""
 }
 
+func (m *Mutator) GetRuntimeAssets() []string {
+	if m != nil {
+		return m.RuntimeAssets
+	}
+	return nil
+}
+
+func (m *Mutator) GetSecrets() []SecretReference {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Mutator)(nil), "sensu.types.Mutator")
 }
@@ -123,6 +146,25 @@ func (this *Mutator) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
+	}
+	if len(this.Secrets) != len(that1.Secrets) {
+		return false
+	}
+	for i := range this.Secrets {
+		if !this.Secrets[i].Equal(&that1.Secrets[i]) {
+			return false
+		}
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Mutator) Marshal() (dAtA []byte, err error) {
@@ -184,6 +226,41 @@ func (m *Mutator) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMutator(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, msg := range m.Secrets {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMutator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMutator(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -208,6 +285,21 @@ func NewPopulatedMutator(r randyMutator, easy bool) *Mutator {
 	}
 	this.Environment = string(randStringMutator(r))
 	this.Organization = string(randStringMutator(r))
+	v4 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.RuntimeAssets[i] = string(randStringMutator(r))
+	}
+	if r.Intn(10) != 0 {
+		v6 := r.Intn(5)
+		this.Secrets = make([]SecretReference, v6)
+		for i := 0; i < v6; i++ {
+			v7 := NewPopulatedSecretReference(r, easy)
+			this.Secrets[i] = *v7
+		}
+	}
+	v5 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v5
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -313,6 +405,20 @@ func (m *Mutator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMutator(uint64(l))
 	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			l = len(s)
+			n += 1 + l + sovMutator(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovMutator(uint64(l))
+		}
+	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovMutator(uint64(l))
 	return n
 }
 
@@ -522,6 +628,96 @@ func (m *Mutator) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, SecretReference{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMutator(dAtA[iNdEx:])
@@ -651,22 +847,30 @@ var (
 func init() { proto.RegisterFile("mutator.proto", fileDescriptorMutator) }
 
 var fileDescriptorMutator = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x3d, 0x4e, 0xc3, 0x40,
-	0x10, 0x85, 0x19, 0xf2, 0x63, 0xb2, 0x49, 0x9a, 0xad, 0x56, 0x14, 0x1b, 0x2b, 0x08, 0xe1, 0x06,
-	0xa7, 0xe0, 0x06, 0xee, 0x69, 0x5c, 0x50, 0xd0, 0xa0, 0x75, 0x58, 0x8c, 0x8b, 0x9d, 0x89, 0xd6,
-	0x6b, 0x4b, 0x70, 0x12, 0x8e, 0xc0, 0x11, 0x38, 0x02, 0x0d, 0x12, 0x27, 0x88, 0xc0, 0x74, 0x39,
-	0x01, 0x25, 0xd2, 0x44, 0x46, 0xd0, 0xbd, 0xef, 0xd3, 0xdb, 0x1d, 0xcd, 0x88, 0xb9, 0x6b, 0x82,
-	0x09, 0xe4, 0xd3, 0x8d, 0xa7, 0x40, 0x72, 0x5a, 0x5b, 0xac, 0x9b, 0x34, 0x3c, 0x6c, 0x6c, 0x7d,
-	0x7c, 0x5e, 0x56, 0xe1, 0xbe, 0x29, 0xd2, 0x35, 0xb9, 0x55, 0x49, 0x25, 0xad, 0xb8, 0x53, 0x34,
-	0x77, 0x4c, 0x0c, 0x9c, 0xf6, 0x6f, 0x97, 0x6f, 0x20, 0xa2, 0xcb, 0xfd, 0x6f, 0x52, 0x8a, 0x21,
-	0x1a, 0x67, 0x15, 0xc4, 0x90, 0x4c, 0x72, 0xce, 0x52, 0x89, 0x68, 0x4d, 0xce, 0x19, 0xbc, 0x55,
-	0x87, 0xac, 0x7b, 0x94, 0xa7, 0x22, 0x0a, 0x95, 0xb3, 0xd4, 0x04, 0x35, 0x88, 0x21, 0x99, 0x67,
-	0xd3, 0xdd, 0x76, 0xd1, 0xab, 0xbc, 0x0f, 0xf2, 0x4c, 0x1c, 0x59, 0x6c, 0x6f, 0x5a, 0xe3, 0x6b,
-	0x35, 0x8c, 0x07, 0xc9, 0x24, 0x9b, 0xed, 0xb6, 0x8b, 0x5f, 0x97, 0x47, 0x16, 0xdb, 0x2b, 0xe3,
-	0x6b, 0x19, 0x8b, 0xa9, 0xc5, 0xb6, 0xf2, 0x84, 0xce, 0x62, 0x50, 0x23, 0x9e, 0xf6, 0x57, 0xc9,
-	0xa5, 0x98, 0x91, 0x2f, 0x0d, 0x56, 0x8f, 0x26, 0x54, 0x84, 0x6a, 0xcc, 0x95, 0x7f, 0x2e, 0x3b,
-	0xf9, 0xfe, 0xd4, 0xf0, 0xdc, 0x69, 0x78, 0xe9, 0x34, 0xbc, 0x76, 0x1a, 0xde, 0x3b, 0x0d, 0x1f,
-	0x9d, 0x86, 0xa7, 0x2f, 0x7d, 0x70, 0x3d, 0xe2, 0x1b, 0x15, 0x63, 0xde, 0xfd, 0xe2, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0xc4, 0x24, 0x93, 0x46, 0x48, 0x01, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xcf, 0x6a, 0xd4, 0x40,
+	0x18, 0xef, 0xb8, 0x6d, 0xb3, 0x3b, 0xd9, 0x15, 0x1c, 0x04, 0xc7, 0x52, 0x92, 0x50, 0x11, 0x73,
+	0xd0, 0x14, 0xea, 0x13, 0x18, 0x4f, 0x1e, 0x8a, 0x18, 0xc1, 0x83, 0x97, 0x32, 0x49, 0xbf, 0xc6,
+	0x08, 0x33, 0xb3, 0xcc, 0x7c, 0x09, 0xd4, 0x27, 0xf1, 0x11, 0x3c, 0x7a, 0xf4, 0x11, 0x7a, 0xec,
+	0x13, 0x04, 0x8d, 0xb7, 0x3c, 0x81, 0x47, 0xc9, 0x64, 0xb3, 0xec, 0xde, 0x7e, 0x7f, 0xbe, 0xef,
+	0xf7, 0x83, 0xef, 0xa3, 0x2b, 0x59, 0xa3, 0x40, 0x6d, 0x92, 0xb5, 0xd1, 0xa8, 0x99, 0x6f, 0x41,
+	0xd9, 0x3a, 0xc1, 0xdb, 0x35, 0xd8, 0x93, 0x57, 0x65, 0x85, 0x5f, 0xea, 0x3c, 0x29, 0xb4, 0x3c,
+	0x2f, 0x75, 0xa9, 0xcf, 0xdd, 0x4c, 0x5e, 0xdf, 0x38, 0xe6, 0x88, 0x43, 0xe3, 0xee, 0x09, 0x95,
+	0x80, 0x62, 0x83, 0x97, 0x16, 0x0a, 0x03, 0x38, 0xb2, 0xb3, 0x9f, 0x33, 0xea, 0x5d, 0x8e, 0x3d,
+	0x8c, 0xd1, 0x43, 0x25, 0x24, 0x70, 0x12, 0x91, 0x78, 0x91, 0x39, 0xcc, 0x38, 0xf5, 0x0a, 0x2d,
+	0xa5, 0x50, 0xd7, 0xfc, 0x81, 0x93, 0x27, 0xca, 0x9e, 0x53, 0x0f, 0x2b, 0x09, 0xba, 0x46, 0x3e,
+	0x8b, 0x48, 0xbc, 0x4a, 0xfd, 0xbe, 0x0d, 0x27, 0x29, 0x9b, 0x00, 0x7b, 0x41, 0xe7, 0xa0, 0x9a,
+	0xab, 0x46, 0x18, 0xcb, 0x0f, 0xa3, 0x59, 0xbc, 0x48, 0x97, 0x7d, 0x1b, 0x6e, 0xb5, 0xcc, 0x03,
+	0xd5, 0x7c, 0x12, 0xc6, 0xb2, 0x88, 0xfa, 0xa0, 0x9a, 0xca, 0x68, 0x25, 0x41, 0x21, 0x3f, 0x72,
+	0x6d, 0xbb, 0x12, 0x3b, 0xa3, 0x4b, 0x6d, 0x4a, 0xa1, 0xaa, 0x6f, 0x02, 0x2b, 0xad, 0xf8, 0xb1,
+	0x1b, 0xd9, 0xd3, 0xd8, 0x5b, 0xfa, 0xd0, 0xd4, 0x6a, 0x28, 0xbf, 0x12, 0xd6, 0x02, 0x5a, 0xee,
+	0xb9, 0xd2, 0xd3, 0xbe, 0x0d, 0xf9, 0xbe, 0xf3, 0x52, 0xcb, 0x0a, 0x41, 0xae, 0xf1, 0x36, 0x5b,
+	0x6d, 0x9c, 0x37, 0xce, 0x60, 0x1f, 0xa8, 0x37, 0x1e, 0xc9, 0xf2, 0x45, 0x34, 0x8b, 0xfd, 0x8b,
+	0xd3, 0x64, 0xe7, 0xf8, 0xc9, 0x47, 0xe7, 0x65, 0x70, 0x03, 0x06, 0x54, 0x01, 0xe9, 0xd3, 0xbb,
+	0x36, 0x3c, 0xe8, 0xdb, 0xf0, 0xd1, 0x66, 0x69, 0x27, 0x78, 0xca, 0x61, 0xef, 0xe8, 0x7c, 0xf8,
+	0xc1, 0xb5, 0x40, 0xc1, 0xe7, 0x11, 0x89, 0xfd, 0x8b, 0x27, 0x7b, 0x99, 0xef, 0xf3, 0xaf, 0x50,
+	0xe0, 0x25, 0xa0, 0x48, 0x1f, 0x0f, 0x71, 0xf7, 0x6d, 0x48, 0x86, 0x3b, 0x4d, 0x4b, 0xd9, 0x16,
+	0xa5, 0xcf, 0xfe, 0xfd, 0x09, 0xc8, 0x8f, 0x2e, 0x20, 0xbf, 0xba, 0x80, 0xdc, 0x75, 0x01, 0xb9,
+	0xef, 0x02, 0xf2, 0xbb, 0x0b, 0xc8, 0xf7, 0xbf, 0xc1, 0xc1, 0xe7, 0x23, 0x97, 0x97, 0x1f, 0xbb,
+	0xf7, 0xbe, 0xfe, 0x3f, 0x00, 0xa1, 0x27, 0x26, 0xc5, 0x45, 0x02, 0x00, 0x00,
 }
@@ -31,6 +31,16 @@ func TestMutatorValidate(t *testing.T) {
 	assert.Error(t, m.Validate())
 	m.Environment = "default"
 
+	// Invalid env vars
+	m.EnvVars = []string{"FOO"}
+	assert.Error(t, m.Validate())
+	m.EnvVars = []string{"FOO=BAR"}
+
+	// Invalid runtime assets
+	m.RuntimeAssets = []string{"BAD--a!!!---ASDFASDF$$$$"}
+	assert.Error(t, m.Validate())
+	m.RuntimeAssets = []string{"sensu-plugins"}
+
 	// Valid mutator
 	assert.NoError(t, m.Validate())
 }
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"context"
+	fmt "fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// NamespaceSeparator separates the organization and the environment in the
+	// name of a namespace
+	NamespaceSeparator = "/"
+)
+
+// Namespace addresses an environment of an organization as a single
+// hierarchical name, e.g. "acme/production". The resources of a namespace are
+// those of its organization and environment.
+type Namespace struct {
+	// Name is the name of the namespace, as organization/environment
+	Name string `json:"name"`
+
+	// Description is more information for the namespace
+	Description string `json:"description,omitempty"`
+
+	// Redact contains the fields redacted from the events of the namespace, in
+	// addition to those of their entity
+	Redact []string `json:"redact,omitempty"`
+}
+
+// NewNamespace returns the namespace of the given organization and environment
+func NewNamespace(org, env string) *Namespace {
+	return &Namespace{Name: org + NamespaceSeparator + env}
+}
+
+// FixtureNamespace returns a mocked namespace in the default organization.
+func FixtureNamespace(env string) *Namespace {
+	return NewNamespace("default", env)
+}
+
+// ResourceNamespace returns the name of the namespace of the given resource
+func ResourceNamespace(r MultitenantResource) string {
+	return NewNamespace(r.GetOrganization(), r.GetEnvironment()).Name
+}
+
+// ContextNamespace returns the name of the namespace of the organization and
+// environment injected in the context
+func ContextNamespace(ctx context.Context) string {
+	return NewNamespace(ContextOrganization(ctx), ContextEnvironment(ctx)).Name
+}
+
+// GetOrganization returns the organization of the namespace
+func (n *Namespace) GetOrganization() string {
+	org, _ := n.split()
+	return org
+}
+
+// GetEnvironment returns the environment of the namespace
+func (n *Namespace) GetEnvironment() string {
+	_, env := n.split()
+	return env
+}
+
+// split splits the name of the namespace in its organization and environment
+func (n *Namespace) split() (string, string) {
+	parts := strings.SplitN(n.Name, NamespaceSeparator, 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// Validate returns an error if the namespace does not pass validation tests.
+func (n *Namespace) Validate() error {
+	var errs FieldErrors
+	if !strings.Contains(n.Name, NamespaceSeparator) {
+		errs.Add("name", "must be of the form organization/environment")
+		return errs
+	}
+
+	org, env := n.split()
+	if err := ValidateName(org); err != nil {
+		errs.Add("name", "organization %s", err)
+	}
+	if err := ValidateName(env); err != nil {
+		errs.Add("name", "environment %s", err)
+	}
+
+	return errs.Err()
+}
+
+// URIPath returns the path component of a Namespace URI.
+func (n *Namespace) URIPath() string {
+	org, env := n.split()
+	return fmt.Sprintf("/namespaces/%s/%s", url.PathEscape(org), url.PathEscape(env))
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceValidate(t *testing.T) {
+	n := &Namespace{Name: "acme"}
+	assert.Error(t, n.Validate())
+
+	n.Name = "acme/"
+	assert.Error(t, n.Validate())
+
+	n.Name = "/production"
+	assert.Error(t, n.Validate())
+
+	n.Name = "acme/prod uction"
+	assert.Error(t, n.Validate())
+
+	n.Name = "acme/production"
+	assert.NoError(t, n.Validate())
+}
+
+func TestNamespaceOrganizationEnvironment(t *testing.T) {
+	n := NewNamespace("acme", "production")
+	assert.Equal(t, "acme/production", n.Name)
+	assert.Equal(t, "acme", n.GetOrganization())
+	assert.Equal(t, "production", n.GetEnvironment())
+	assert.Equal(t, "/namespaces/acme/production", n.URIPath())
+
+	ctx := SetContextFromResource(context.Background(), n)
+	assert.Equal(t, "acme", ContextOrganization(ctx))
+	assert.Equal(t, "production", ContextEnvironment(ctx))
+	assert.Equal(t, "acme/production", ContextNamespace(ctx))
+
+	assert.Equal(t, "default/default", ResourceNamespace(FixtureCheckConfig("check")))
+}
@@ -12,11 +12,9 @@ const (
 
 // Validate returns an error if the organization does not pass validation tests
 func (o *Organization) Validate() error {
-	if err := ValidateName(o.Name); err != nil {
-		return fmt.Errorf("organization name %s", err)
-	}
-
-	return nil
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(o.Name))
+	return errs.Err()
 }
 
 // FixtureOrganization returns a mocked organization
@@ -30,3 +28,14 @@ func FixtureOrganization(name string) *Organization {
 func (o *Organization) URIPath() string {
 	return fmt.Sprintf("/rbac/organizations/%s", url.PathEscape(o.Name))
 }
+
+// GetObjectMeta implements MetaResource.
+func (o *Organization) GetObjectMeta() ObjectMeta {
+	return objectMeta(o.ObjectMeta, o.Name, "", "")
+}
+
+// SetObjectMeta implements MetaResource.
+func (o *Organization) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&o.Name, nil, nil)
+	o.ObjectMeta = meta
+}
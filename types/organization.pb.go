@@ -21,6 +21,9 @@ type Organization struct {
 	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
 	// Name is the unique identifier for an organization.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// Metadata contains the name, namespace, labels and annotations of the
+	// organization
+	ObjectMeta `protobuf:"bytes,3,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Organization) Reset()                    { *m = Organization{} }
@@ -70,6 +73,9 @@ func (this *Organization) Equal(that interface{}) bool {
 	if this.Name != that1.Name {
 		return false
 	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Organization) Marshal() (dAtA []byte, err error) {
@@ -99,6 +105,14 @@ func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOrganization(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintOrganization(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -115,6 +129,8 @@ func NewPopulatedOrganization(r randyOrganization, easy bool) *Organization {
 	this := &Organization{}
 	this.Description = string(randStringOrganization(r))
 	this.Name = string(randStringOrganization(r))
+	v3 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v3
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -203,6 +219,8 @@ func (m *Organization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrganization(uint64(l))
 	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovOrganization(uint64(l))
 	return n
 }
 
@@ -306,6 +324,36 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -435,17 +483,21 @@ var (
 func init() { proto.RegisterFile("organization.proto", fileDescriptorOrganization) }
 
 var fileDescriptorOrganization = []byte{
-	// 185 bytes of a gzipped FileDescriptorProto
+	// 242 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0x2f, 0x4a, 0x4f,
 	0xcc, 0xcb, 0xac, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
 	0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c,
 	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49,
-	0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0x29, 0x9e, 0x8b, 0xc7, 0x1f, 0xc9,
-	0x44, 0x21, 0x43, 0x2e, 0xee, 0x94, 0xd4, 0xe2, 0xe4, 0xa2, 0xcc, 0x02, 0x10, 0x57, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xd3, 0x89, 0xff, 0xd5, 0x3d, 0x79, 0x64, 0xe1, 0x20, 0x64, 0x8e, 0x90, 0x0c,
-	0x17, 0x4b, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0x13, 0x58, 0x2d, 0xc7, 0xab, 0x7b, 0xf2, 0x60, 0x7e,
-	0x10, 0x98, 0x74, 0x52, 0xfe, 0xf1, 0x50, 0x8e, 0x71, 0xc5, 0x23, 0x39, 0xc6, 0x1d, 0x8f, 0xe4,
-	0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x19, 0x8f,
-	0xe5, 0x18, 0xa2, 0x58, 0xc1, 0x8e, 0x4e, 0x62, 0x03, 0x3b, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xd8, 0xe6, 0x7e, 0x1d, 0xde, 0x00, 0x00, 0x00,
+	0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0x8a, 0x2b, 0x37, 0xb5, 0x24, 0x11,
+	0xc2, 0x56, 0x5a, 0xc5, 0xc8, 0xc5, 0xe3, 0x8f, 0x64, 0xbc, 0x90, 0x21, 0x17, 0x77, 0x4a, 0x6a,
+	0x71, 0x72, 0x51, 0x66, 0x01, 0x88, 0x2b, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0xc4, 0xff, 0xea,
+	0x9e, 0x3c, 0xb2, 0x70, 0x10, 0x32, 0x47, 0x48, 0x86, 0x8b, 0x25, 0x2f, 0x31, 0x37, 0x55, 0x82,
+	0x09, 0xac, 0x96, 0xe3, 0xd5, 0x3d, 0x79, 0x30, 0x3f, 0x08, 0x4c, 0x0a, 0x79, 0x72, 0x71, 0x80,
+	0xec, 0x4b, 0x49, 0x2c, 0x49, 0x94, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd7, 0x43, 0x72,
+	0xbc, 0x9e, 0x7f, 0x52, 0x56, 0x6a, 0x72, 0x89, 0x6f, 0x6a, 0x49, 0xa2, 0x93, 0xc8, 0x89, 0x7b,
+	0xf2, 0x0c, 0x17, 0xee, 0xc9, 0x33, 0xbe, 0xba, 0x27, 0x0f, 0xd7, 0x14, 0x04, 0x67, 0x39, 0x29,
+	0xff, 0x78, 0x28, 0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x8e, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9,
+	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x51, 0xac,
+	0x60, 0xf3, 0x92, 0xd8, 0xc0, 0x1e, 0x33, 0x06, 0x0c, 0x00, 0x79, 0x4f, 0xf0, 0x0a, 0x36, 0x01,
+	0x00, 0x00,
 }
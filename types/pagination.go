@@ -0,0 +1,7 @@
+package types
+
+const (
+	// PaginationContinueHeader represents the HTTP header containing the token
+	// of the next page of a list of resources
+	PaginationContinueHeader = "Sensu-Continue"
+)
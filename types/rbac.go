@@ -1,7 +1,6 @@
 package types
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 )
@@ -25,6 +24,9 @@ const (
 	// RuleTypeAsset access control for asset objects
 	RuleTypeAsset = "assets"
 
+	// RuleTypeAudit access control for the audit log
+	RuleTypeAudit = "audit"
+
 	// RuleTypeCheck access control for check objects
 	RuleTypeCheck = "checks"
 
@@ -61,6 +63,13 @@ const (
 	// RuleTypeRole access control for role objects
 	RuleTypeRole = "roles"
 
+	// RuleTypeSecret access control for secret objects
+	RuleTypeSecret = "secrets"
+
+	// RuleTypeService access control for business services and their
+	// components
+	RuleTypeService = "services"
+
 	// RuleTypeSilenced access control for silenced objects
 	RuleTypeSilenced = "silenced"
 
@@ -81,6 +90,7 @@ var (
 	AllTypes = []string{
 		RuleTypeAll,
 		RuleTypeAsset,
+		RuleTypeAudit,
 		RuleTypeCheck,
 		RuleTypeEntity,
 		RuleTypeEnvironment,
@@ -92,6 +102,8 @@ var (
 		RuleTypeMutator,
 		RuleTypeOrganization,
 		RuleTypeRole,
+		RuleTypeSecret,
+		RuleTypeService,
 		RuleTypeSilenced,
 		RuleTypeUser,
 	}
@@ -102,32 +114,30 @@ var (
 
 // Validate returns an error if the rule is invalid.
 func (r *Rule) Validate() error {
+	var errs FieldErrors
 	if r.Type == "" {
-		return errors.New("type can't be empty")
+		errs.Add("type", "can't be empty")
 	}
 
 	if r.Environment != "*" {
-		if err := ValidateNameStrict(r.Environment); err != nil {
-			return errors.New("environment " + err.Error())
-		}
+		errs.AddError("environment", ValidateNameStrict(r.Environment))
 	}
 
 	if r.Organization != "*" {
-		if err := ValidateNameStrict(r.Organization); err != nil {
-			return errors.New("organization " + err.Error())
-		}
+		errs.AddError("organization", ValidateNameStrict(r.Organization))
 	}
 
 	if len(r.Permissions) == 0 {
-		return errors.New("permissions must have at least one permission")
+		errs.Add("permissions", "must have at least one permission")
 	}
 
-	for _, p := range r.Permissions {
+	for i, p := range r.Permissions {
 		switch p {
 		case RulePermCreate, RulePermRead, RulePermUpdate, RulePermDelete:
 		default:
-			return fmt.Errorf(
-				"permission '%s' is not valid - must be one of ['%s', '%s', '%s', '%s']",
+			errs.Add(
+				FieldPath("permissions", i),
+				"'%s' is not valid - must be one of ['%s', '%s', '%s', '%s']",
 				p,
 				RulePermCreate,
 				RulePermRead,
@@ -137,24 +147,21 @@ func (r *Rule) Validate() error {
 		}
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // Validate returns an error if the role is invalid.
 func (r *Role) Validate() error {
-	if err := ValidateNameStrict(r.Name); err != nil {
-		return errors.New("name " + err.Error())
-	}
+	var errs FieldErrors
+	errs.AddError("name", ValidateNameStrict(r.Name))
 
-	for _, rule := range r.Rules {
-		if err := rule.Validate(); err != nil {
-			return fmt.Errorf("rule %s", err)
-		}
+	for i, rule := range r.Rules {
+		errs.AddError(FieldPath("rules", i), rule.Validate())
 
 		// TODO: Check for duplicate rule definitions?
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // URIPath returns the path component of a Role URI.
@@ -162,6 +169,17 @@ func (r *Role) URIPath() string {
 	return fmt.Sprintf("/rbac/roles/%s", url.PathEscape(r.Name))
 }
 
+// GetObjectMeta implements MetaResource.
+func (r *Role) GetObjectMeta() ObjectMeta {
+	return objectMeta(r.ObjectMeta, r.Name, "", "")
+}
+
+// SetObjectMeta implements MetaResource.
+func (r *Role) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&r.Name, nil, nil)
+	r.ObjectMeta = meta
+}
+
 //
 // Fixtures
 
@@ -60,6 +60,8 @@ func (m *Rule) GetPermissions() []string {
 type Role struct {
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Rules []Rule `protobuf:"bytes,2,rep,name=rules" json:"rules"`
+	// Metadata contains the name, namespace, labels and annotations of the role
+	ObjectMeta `protobuf:"bytes,3,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Role) Reset()                    { *m = Role{} }
@@ -153,6 +155,9 @@ func (this *Role) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Rule) Marshal() (dAtA []byte, err error) {
@@ -239,6 +244,14 @@ func (m *Role) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintRbac(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -277,6 +290,8 @@ func NewPopulatedRole(r randyRbac, easy bool) *Role {
 			this.Rules[i] = *v3
 		}
 	}
+	v6 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v6
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -391,6 +406,8 @@ func (m *Role) Size() (n int) {
 			n += 1 + l + sovRbac(uint64(l))
 		}
 	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovRbac(uint64(l))
 	return n
 }
 
@@ -662,6 +679,36 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRbac(dAtA[iNdEx:])
@@ -791,22 +838,25 @@ var (
 func init() { proto.RegisterFile("rbac.proto", fileDescriptorRbac) }
 
 var fileDescriptorRbac = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
+	// 313 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0x4d, 0x4a, 0xc4, 0x30,
-	0x18, 0x9d, 0x4c, 0x3b, 0xc2, 0xa4, 0x8a, 0x98, 0x55, 0x71, 0x91, 0x96, 0xba, 0xe9, 0xc6, 0x0c,
-	0x2a, 0x78, 0x80, 0x1e, 0x21, 0x4b, 0x77, 0xed, 0x10, 0x6b, 0x60, 0x9a, 0xaf, 0xe4, 0x47, 0xd0,
-	0x6b, 0xb8, 0xf1, 0x08, 0x1e, 0xc1, 0x23, 0xcc, 0xd2, 0x13, 0x14, 0x8d, 0xbb, 0x39, 0x81, 0x4b,
-	0x69, 0x0a, 0x52, 0x57, 0x79, 0xef, 0xe5, 0xbd, 0xf0, 0x5e, 0x30, 0xd6, 0x4d, 0xbd, 0x65, 0xbd,
-	0x06, 0x0b, 0x24, 0x31, 0x42, 0x19, 0xc7, 0xec, 0x53, 0x2f, 0xcc, 0xf9, 0x65, 0x2b, 0xed, 0x83,
-	0x6b, 0xd8, 0x16, 0xba, 0x4d, 0x0b, 0x2d, 0x6c, 0x82, 0xa7, 0x71, 0xf7, 0x81, 0x05, 0x12, 0xd0,
-	0x94, 0x2d, 0x5e, 0x10, 0x8e, 0xb9, 0xdb, 0x09, 0x42, 0x70, 0x3c, 0x3e, 0x90, 0xa2, 0x1c, 0x95,
-	0x6b, 0x1e, 0x30, 0xc9, 0x71, 0x22, 0xd4, 0xa3, 0xd4, 0xa0, 0x3a, 0xa1, 0x6c, 0xba, 0x0c, 0x57,
-	0x73, 0x89, 0x14, 0xf8, 0x18, 0x74, 0x5b, 0x2b, 0xf9, 0x5c, 0x5b, 0x09, 0x2a, 0x8d, 0x82, 0xe5,
-	0x9f, 0x46, 0xae, 0x70, 0xd2, 0x0b, 0xdd, 0x49, 0x63, 0x24, 0x28, 0x93, 0xc6, 0x79, 0x54, 0xae,
-	0xab, 0xd3, 0xc3, 0x90, 0xcd, 0x65, 0x3e, 0x27, 0x05, 0xc7, 0x31, 0x87, 0xa9, 0x94, 0xaa, 0xbb,
-	0xbf, 0x52, 0x23, 0x26, 0xb7, 0x78, 0xa5, 0xdd, 0x4e, 0x98, 0x74, 0x99, 0x47, 0x65, 0x72, 0x7d,
-	0xc6, 0x66, 0xeb, 0xd9, 0x38, 0xa5, 0x3a, 0xd9, 0x0f, 0xd9, 0xe2, 0x30, 0x64, 0x93, 0x8f, 0x4f,
-	0x47, 0x75, 0xf1, 0xf3, 0x45, 0xd1, 0x9b, 0xa7, 0xe8, 0xdd, 0x53, 0xb4, 0xf7, 0x14, 0x7d, 0x78,
-	0x8a, 0x3e, 0x3d, 0x45, 0xaf, 0xdf, 0x74, 0x71, 0xb7, 0x0a, 0xf9, 0xe6, 0x28, 0xfc, 0xca, 0xcd,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0xcb, 0xf0, 0xfe, 0x5f, 0x01, 0x00, 0x00,
+	0x18, 0x9d, 0xcc, 0x8f, 0x38, 0xa9, 0x22, 0x06, 0xc1, 0x32, 0x8b, 0xb4, 0xd4, 0x4d, 0x37, 0x76,
+	0x70, 0x04, 0x0f, 0xd0, 0x9d, 0x0b, 0x11, 0xba, 0x74, 0x97, 0xd6, 0x58, 0x23, 0xd3, 0xa4, 0x24,
+	0xa9, 0xa0, 0xd7, 0x70, 0xe3, 0xc6, 0xbd, 0x47, 0xf0, 0x08, 0xb3, 0x9c, 0x13, 0x14, 0xad, 0xbb,
+	0x9e, 0xc0, 0xa5, 0x24, 0x85, 0xd2, 0x59, 0xe5, 0x7d, 0x2f, 0xef, 0x3d, 0xde, 0xf7, 0x41, 0x28,
+	0x53, 0x92, 0x45, 0xa5, 0x14, 0x5a, 0x20, 0x47, 0x51, 0xae, 0xaa, 0x48, 0xbf, 0x94, 0x54, 0x2d,
+	0xce, 0x73, 0xa6, 0x1f, 0xab, 0x34, 0xca, 0x44, 0xb1, 0xcc, 0x45, 0x2e, 0x96, 0x56, 0x93, 0x56,
+	0x0f, 0x76, 0xb2, 0x83, 0x45, 0x9d, 0x77, 0x01, 0x0b, 0xaa, 0x49, 0x87, 0x83, 0x37, 0x00, 0xa7,
+	0x49, 0xb5, 0xa6, 0x08, 0xc1, 0xa9, 0x09, 0x73, 0x81, 0x0f, 0xc2, 0x79, 0x62, 0x31, 0xf2, 0xa1,
+	0x43, 0xf9, 0x33, 0x93, 0x82, 0x17, 0x94, 0x6b, 0x77, 0x6c, 0xbf, 0x86, 0x14, 0x0a, 0xe0, 0x81,
+	0x90, 0x39, 0xe1, 0xec, 0x95, 0x68, 0x26, 0xb8, 0x3b, 0xb1, 0x92, 0x1d, 0x0e, 0x5d, 0x40, 0xa7,
+	0xa4, 0xb2, 0x60, 0x4a, 0x31, 0xc1, 0x95, 0x3b, 0xf5, 0x27, 0xe1, 0x3c, 0x3e, 0x6a, 0x6b, 0x6f,
+	0x48, 0x27, 0xc3, 0x21, 0xf8, 0x30, 0xad, 0x44, 0xd7, 0x8a, 0x93, 0xa2, 0x6f, 0x65, 0x30, 0xba,
+	0x82, 0x33, 0x59, 0xad, 0xa9, 0x72, 0xc7, 0xfe, 0x24, 0x74, 0x56, 0xc7, 0xd1, 0xe0, 0x14, 0x91,
+	0xd9, 0x25, 0x3e, 0xdc, 0xd4, 0xde, 0xa8, 0xad, 0xbd, 0x4e, 0x97, 0x74, 0x0f, 0xba, 0x86, 0xfb,
+	0x66, 0xf1, 0x7b, 0xa2, 0x89, 0xed, 0xe9, 0xac, 0x4e, 0x77, 0xac, 0xb7, 0xe9, 0x13, 0xcd, 0xf4,
+	0x0d, 0xd5, 0x24, 0x3e, 0x31, 0x01, 0xdb, 0xda, 0x03, 0x6d, 0xed, 0xf5, 0xa6, 0xa4, 0x47, 0xf1,
+	0xd9, 0xdf, 0x0f, 0x06, 0x9f, 0x0d, 0x06, 0x5f, 0x0d, 0x06, 0x9b, 0x06, 0x83, 0x6d, 0x83, 0xc1,
+	0x77, 0x83, 0xc1, 0xfb, 0x2f, 0x1e, 0xdd, 0xcd, 0x6c, 0x5e, 0xba, 0x67, 0x2f, 0x7c, 0xf9, 0x3f,
+	0x00, 0xb8, 0x0f, 0xb5, 0xf9, 0xb7, 0x01, 0x00, 0x00,
 }
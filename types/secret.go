@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// SecretProviderEnv is the provider of the secrets held by the environment
+	// variables of the backend
+	SecretProviderEnv = "env"
+
+	// SecretProviderVault is the provider of the secrets held by HashiCorp
+	// Vault
+	SecretProviderVault = "vault"
+
+	// RedactedSecret replaces the values of the secrets in the outputs of
+	// commands
+	RedactedSecret = "REDACTED"
+)
+
+// envVarNameRegex matches the valid names of environment variables
+var envVarNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Validate returns an error if the secret does not pass validation tests.
+func (s *Secret) Validate() error {
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(s.Name))
+
+	switch s.Provider {
+	case SecretProviderEnv, SecretProviderVault:
+	default:
+		errs.Add("provider", "must be %q or %q", SecretProviderEnv, SecretProviderVault)
+	}
+
+	if s.ID == "" {
+		errs.Add("id", "must be set")
+	}
+
+	if s.Environment == "" {
+		errs.Add("environment", "must be set")
+	}
+
+	if s.Organization == "" {
+		errs.Add("organization", "must be set")
+	}
+
+	return errs.Err()
+}
+
+// Update updates s with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (s *Secret) Update(from *Secret, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Provider":
+			s.Provider = from.Provider
+		case "ID":
+			s.ID = from.ID
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// FixtureSecret returns a Secret fixture for testing, held by the env
+// provider.
+func FixtureSecret(name string) *Secret {
+	return &Secret{
+		Name:         name,
+		Provider:     SecretProviderEnv,
+		ID:           "SENSU_SECRET",
+		Environment:  "default",
+		Organization: "default",
+	}
+}
+
+// URIPath returns the path component of a Secret URI.
+func (s *Secret) URIPath() string {
+	return fmt.Sprintf("/secrets/%s", url.PathEscape(s.Name))
+}
+
+// GetObjectMeta implements MetaResource.
+func (s *Secret) GetObjectMeta() ObjectMeta {
+	return objectMeta(s.ObjectMeta, s.Name, s.Organization, s.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (s *Secret) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&s.Name, &s.Organization, &s.Environment)
+	s.ObjectMeta = meta
+}
+
+// Validate returns an error if the secret reference does not pass validation
+// tests.
+func (r *SecretReference) Validate() error {
+	var errs FieldErrors
+	if !envVarNameRegex.MatchString(r.Name) {
+		errs.Add("name", "%q is not a valid environment variable name", r.Name)
+	}
+	errs.AddError("secret", ValidateName(r.Secret))
+	return errs.Err()
+}
+
+// ValidateSecretReferences ensures that all the secret references are valid,
+// and that no environment variable is given more than one secret.
+func ValidateSecretReferences(refs []SecretReference) error {
+	var errs FieldErrors
+	names := make(map[string]bool, len(refs))
+	for i := range refs {
+		errs.AddError(FieldPath(i), refs[i].Validate())
+		if names[refs[i].Name] {
+			errs.Add(FieldPath(i, "name"), "%q is used more than once", refs[i].Name)
+		}
+		names[refs[i].Name] = true
+	}
+	return errs.Err()
+}
+
+// RedactSecrets replaces the values of the given secrets, as NAME=value
+// environment variables, by RedactedSecret in s.
+func RedactSecrets(s string, secrets []string) string {
+	values := make([]string, 0, len(secrets))
+	for _, value := range EnvVarsToMap(secrets) {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return s
+	}
+
+	// The longest values are replaced first, so the secrets containing others
+	// are entirely redacted
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, RedactedSecret)
+	}
+	return strings.NewReplacer(oldnew...).Replace(s)
+}
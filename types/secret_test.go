@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureSecret(t *testing.T) {
+	fixture := FixtureSecret("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+	assert.Equal(t, "/secrets/fixture", fixture.URIPath())
+}
+
+func TestSecretValidate(t *testing.T) {
+	var s Secret
+
+	// Invalid name
+	assert.Error(t, s.Validate())
+	s.Name = "foo"
+
+	// Invalid provider
+	assert.Error(t, s.Validate())
+	s.Provider = "keychain"
+	assert.Error(t, s.Validate())
+	s.Provider = SecretProviderVault
+
+	// Invalid ID
+	assert.Error(t, s.Validate())
+	s.ID = "secret/sensu#password"
+
+	// Invalid organization
+	assert.Error(t, s.Validate())
+	s.Organization = "default"
+
+	// Invalid environment
+	assert.Error(t, s.Validate())
+	s.Environment = "default"
+
+	// Valid secret
+	assert.NoError(t, s.Validate())
+}
+
+func TestValidateSecretReferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		refs    []SecretReference
+		wantErr bool
+	}{
+		{
+			name: "no references",
+		},
+		{
+			name: "valid references",
+			refs: []SecretReference{
+				{Name: "API_TOKEN", Secret: "api-token"},
+				{Name: "password", Secret: "db-password"},
+			},
+		},
+		{
+			name:    "invalid variable name",
+			refs:    []SecretReference{{Name: "API-TOKEN", Secret: "api-token"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid secret name",
+			refs:    []SecretReference{{Name: "API_TOKEN", Secret: ""}},
+			wantErr: true,
+		},
+		{
+			name: "duplicated variable name",
+			refs: []SecretReference{
+				{Name: "API_TOKEN", Secret: "api-token"},
+				{Name: "API_TOKEN", Secret: "other-token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSecretReferences(tc.refs)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	secrets := []string{"PASSWORD=hunter2", "TOKEN=hunter2-token", "EMPTY="}
+
+	assert.Equal(t, "no secret", RedactSecrets("no secret", secrets))
+	assert.Equal(t, "password: REDACTED", RedactSecrets("password: hunter2", secrets))
+	assert.Equal(t, "token: REDACTED, password: REDACTED", RedactSecrets("token: hunter2-token, password: hunter2", secrets))
+	assert.Equal(t, "password: hunter2", RedactSecrets("password: hunter2", nil))
+}
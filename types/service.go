@@ -0,0 +1,229 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// ServiceHealthCheckName is the name of the check of the health events of
+	// services, whose status is the most severe of their components.
+	ServiceHealthCheckName = "service_health"
+
+	// DefaultServiceComponentInterval is the default interval, in seconds, at
+	// which the status of service components is derived.
+	DefaultServiceComponentInterval = 60
+)
+
+// Validate returns an error if the service does not pass validation tests.
+func (s *Service) Validate() error {
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(s.Name))
+
+	for i, handler := range s.Handlers {
+		errs.AddError(FieldPath("handlers", i), ValidateName(handler))
+	}
+
+	if s.Environment == "" {
+		errs.Add("environment", "must be set")
+	}
+
+	if s.Organization == "" {
+		errs.Add("organization", "must be set")
+	}
+
+	return errs.Err()
+}
+
+// Update updates s with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (s *Service) Update(from *Service, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Description":
+			s.Description = from.Description
+		case "Handlers":
+			s.Handlers = append(s.Handlers[0:0], from.Handlers...)
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// FixtureService returns a Service fixture for testing.
+func FixtureService(name string) *Service {
+	return &Service{
+		Name:         name,
+		Handlers:     []string{},
+		Environment:  "default",
+		Organization: "default",
+	}
+}
+
+// URIPath returns the path component of a Service URI.
+func (s *Service) URIPath() string {
+	return fmt.Sprintf("/services/%s", url.PathEscape(s.Name))
+}
+
+// GetObjectMeta implements MetaResource.
+func (s *Service) GetObjectMeta() ObjectMeta {
+	return objectMeta(s.ObjectMeta, s.Name, s.Organization, s.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (s *Service) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&s.Name, &s.Organization, &s.Environment)
+	s.ObjectMeta = meta
+}
+
+// Validate returns an error if the service component does not pass validation
+// tests.
+func (c *ServiceComponent) Validate() error {
+	var errs FieldErrors
+	if err := ValidateName(c.Name); err != nil {
+		errs.AddError("name", err)
+	} else if c.Name == ServiceHealthCheckName {
+		errs.Add("name", "%q is reserved to the health events of services", c.Name)
+	}
+
+	if len(c.Services) == 0 {
+		errs.Add("services", "must not be empty")
+	}
+	for i, service := range c.Services {
+		errs.AddError(FieldPath("services", i), ValidateName(service))
+	}
+
+	if len(c.Rules) == 0 {
+		errs.Add("rules", "must not be empty")
+	}
+	for i := range c.Rules {
+		errs.AddError(FieldPath("rules", i), c.Rules[i].Validate())
+	}
+
+	if c.Interval == 0 {
+		errs.Add("interval", "must be greater than 0")
+	}
+
+	for i, handler := range c.Handlers {
+		errs.AddError(FieldPath("handlers", i), ValidateName(handler))
+	}
+
+	if c.Environment == "" {
+		errs.Add("environment", "must be set")
+	}
+
+	if c.Organization == "" {
+		errs.Add("organization", "must be set")
+	}
+
+	return errs.Err()
+}
+
+// Update updates c with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (c *ServiceComponent) Update(from *ServiceComponent, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Services":
+			c.Services = append(c.Services[0:0], from.Services...)
+		case "LabelSelector":
+			c.LabelSelector = from.LabelSelector
+		case "FieldSelector":
+			c.FieldSelector = from.FieldSelector
+		case "Rules":
+			c.Rules = append(c.Rules[0:0], from.Rules...)
+		case "Interval":
+			c.Interval = from.Interval
+		case "Handlers":
+			c.Handlers = append(c.Handlers[0:0], from.Handlers...)
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// Status returns the status given to the component by its rules, given the
+// number of failing events among those it selects, along with the exceeded
+// rule giving it, if any. When several rules are exceeded, the most severe
+// prevails. The status is unknown (3) when the component selects no events.
+func (c *ServiceComponent) Status(failing, total int) (uint32, *ServiceRule) {
+	if total == 0 {
+		return 3, nil
+	}
+
+	var status uint32
+	var exceeded *ServiceRule
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Status > status && rule.Exceeded(failing, total) {
+			status = rule.Status
+			exceeded = rule
+		}
+	}
+	return status, exceeded
+}
+
+// FixtureServiceComponent returns a ServiceComponent fixture for testing,
+// member of the given service and critical when any of its events are failing.
+func FixtureServiceComponent(name, service string) *ServiceComponent {
+	return &ServiceComponent{
+		Name:     name,
+		Services: []string{service},
+		Rules: []ServiceRule{
+			{Name: "failing", Status: 2},
+		},
+		Interval:     DefaultServiceComponentInterval,
+		Handlers:     []string{},
+		Environment:  "default",
+		Organization: "default",
+	}
+}
+
+// URIPath returns the path component of a ServiceComponent URI.
+func (c *ServiceComponent) URIPath() string {
+	return fmt.Sprintf("/service-components/%s", url.PathEscape(c.Name))
+}
+
+// GetObjectMeta implements MetaResource.
+func (c *ServiceComponent) GetObjectMeta() ObjectMeta {
+	return objectMeta(c.ObjectMeta, c.Name, c.Organization, c.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (c *ServiceComponent) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&c.Name, &c.Organization, &c.Environment)
+	c.ObjectMeta = meta
+}
+
+// Validate returns an error if the service rule does not pass validation
+// tests.
+func (r *ServiceRule) Validate() error {
+	var errs FieldErrors
+	errs.AddError("name", ValidateName(r.Name))
+
+	if r.Status != 1 && r.Status != 2 {
+		errs.Add("status", "must be 1 (warning) or 2 (critical)")
+	}
+
+	if r.FailingCount > 0 && r.FailingPercentage > 0 {
+		errs.Add("failing_percentage", "cannot be combined with a failing count")
+	}
+	if r.FailingPercentage > 100 {
+		errs.Add("failing_percentage", "must be between 0 and 100")
+	}
+
+	return errs.Err()
+}
+
+// Exceeded returns whether more events than tolerated by the rule are
+// failing, given the number of failing events among the total. A rule
+// tolerating neither a count nor a percentage of failing events is exceeded
+// as soon as one is failing.
+func (r *ServiceRule) Exceeded(failing, total int) bool {
+	if r.FailingPercentage > 0 {
+		return failing*100 > int(r.FailingPercentage)*total
+	}
+	return failing > int(r.FailingCount)
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureService(t *testing.T) {
+	fixture := FixtureService("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+	assert.Equal(t, "/services/fixture", fixture.URIPath())
+}
+
+func TestServiceValidate(t *testing.T) {
+	var s Service
+
+	// Invalid name
+	assert.Error(t, s.Validate())
+	s.Name = "webstore"
+
+	// Invalid handlers
+	s.Handlers = []string{"slack", "!@#"}
+	assert.EqualError(t, s.Validate(), "handlers[1]: cannot contain spaces or special characters; environment: must be set; organization: must be set")
+	s.Handlers = []string{"slack"}
+
+	// Invalid organization
+	assert.Error(t, s.Validate())
+	s.Organization = "default"
+
+	// Invalid environment
+	assert.Error(t, s.Validate())
+	s.Environment = "default"
+
+	// Valid service
+	assert.NoError(t, s.Validate())
+}
+
+func TestFixtureServiceComponent(t *testing.T) {
+	fixture := FixtureServiceComponent("web", "webstore")
+	assert.Equal(t, "web", fixture.Name)
+	assert.Equal(t, []string{"webstore"}, fixture.Services)
+	assert.NoError(t, fixture.Validate())
+	assert.Equal(t, "/service-components/web", fixture.URIPath())
+}
+
+func TestServiceComponentValidate(t *testing.T) {
+	c := FixtureServiceComponent("web", "webstore")
+
+	c.Name = ServiceHealthCheckName
+	assert.EqualError(t, c.Validate(), `name: "service_health" is reserved to the health events of services`)
+	c.Name = "web"
+
+	c.Services = nil
+	assert.EqualError(t, c.Validate(), "services: must not be empty")
+	c.Services = []string{"webstore"}
+
+	c.Rules = nil
+	assert.EqualError(t, c.Validate(), "rules: must not be empty")
+	c.Rules = []ServiceRule{{Name: "failing", Status: 3}}
+	assert.EqualError(t, c.Validate(), "rules[0].status: must be 1 (warning) or 2 (critical)")
+	c.Rules = []ServiceRule{{Name: "failing", Status: 2}}
+
+	c.Interval = 0
+	assert.EqualError(t, c.Validate(), "interval: must be greater than 0")
+	c.Interval = 30
+
+	assert.NoError(t, c.Validate())
+}
+
+func TestServiceRuleValidate(t *testing.T) {
+	r := ServiceRule{Name: "degraded", Status: 1, FailingCount: 2}
+	assert.NoError(t, r.Validate())
+
+	r.FailingPercentage = 30
+	assert.EqualError(t, r.Validate(), "failing_percentage: cannot be combined with a failing count")
+
+	r.FailingCount = 0
+	assert.NoError(t, r.Validate())
+
+	r.FailingPercentage = 101
+	assert.EqualError(t, r.Validate(), "failing_percentage: must be between 0 and 100")
+}
+
+func TestServiceRuleExceeded(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     ServiceRule
+		failing  int
+		total    int
+		expected bool
+	}{
+		{
+			name:     "any failing",
+			rule:     ServiceRule{},
+			failing:  1,
+			total:    10,
+			expected: true,
+		},
+		{
+			name:    "none failing",
+			rule:    ServiceRule{},
+			failing: 0,
+			total:   10,
+		},
+		{
+			name:    "count tolerated",
+			rule:    ServiceRule{FailingCount: 2},
+			failing: 2,
+			total:   10,
+		},
+		{
+			name:     "count exceeded",
+			rule:     ServiceRule{FailingCount: 2},
+			failing:  3,
+			total:    10,
+			expected: true,
+		},
+		{
+			name:    "percentage tolerated",
+			rule:    ServiceRule{FailingPercentage: 30},
+			failing: 3,
+			total:   10,
+		},
+		{
+			name:     "percentage exceeded",
+			rule:     ServiceRule{FailingPercentage: 30},
+			failing:  4,
+			total:    10,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.rule.Exceeded(tc.failing, tc.total))
+		})
+	}
+}
+
+func TestServiceComponentStatus(t *testing.T) {
+	c := FixtureServiceComponent("web", "webstore")
+	c.Rules = []ServiceRule{
+		{Name: "degraded", Status: 1},
+		{Name: "down", Status: 2, FailingPercentage: 30},
+	}
+
+	status, rule := c.Status(0, 0)
+	assert.Equal(t, uint32(3), status)
+	assert.Nil(t, rule)
+
+	status, rule = c.Status(0, 10)
+	assert.Equal(t, uint32(0), status)
+	assert.Nil(t, rule)
+
+	status, rule = c.Status(3, 10)
+	assert.Equal(t, uint32(1), status)
+	assert.Equal(t, "degraded", rule.Name)
+
+	status, rule = c.Status(4, 10)
+	assert.Equal(t, uint32(2), status)
+	assert.Equal(t, "down", rule.Name)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"sort"
+	"strconv"
+)
+
+// The default severities of check statuses, following the Nagios plugin
+// conventions.
+const (
+	// SeverityOK is the default severity of the 0 status
+	SeverityOK = "ok"
+
+	// SeverityWarning is the default severity of the 1 status
+	SeverityWarning = "warning"
+
+	// SeverityCritical is the default severity of the 2 status
+	SeverityCritical = "critical"
+
+	// SeverityUnknown is the default severity of any other status
+	SeverityUnknown = "unknown"
+)
+
+// DefaultSeverity returns the default severity of the given status.
+func DefaultSeverity(status uint32) string {
+	switch status {
+	case 0:
+		return SeverityOK
+	case 1:
+		return SeverityWarning
+	case 2:
+		return SeverityCritical
+	}
+	return SeverityUnknown
+}
+
+// Severity returns the severity the given severities map the given status to,
+// or its default severity if they do not map it.
+func Severity(status uint32, severities map[string]string) string {
+	if severity, ok := severities[strconv.FormatUint(uint64(status), 10)]; ok {
+		return severity
+	}
+	return DefaultSeverity(status)
+}
+
+// ValidateSeverities returns an error if the given severities do not map exit
+// statuses to valid severity names.
+func ValidateSeverities(severities map[string]string) error {
+	statuses := make([]string, 0, len(severities))
+	for status := range severities {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	var errs FieldErrors
+	for _, status := range statuses {
+		if _, err := strconv.ParseUint(status, 10, 32); err != nil {
+			errs.Add(status, "is not an exit status")
+			continue
+		}
+		errs.AddError(status, ValidateNameStrict(severities[status]))
+	}
+	return errs.Err()
+}
+
+// SetSeverity sets the severity of the check from its status.
+func (c *Check) SetSeverity() {
+	c.Severity = Severity(c.Status, c.Severities)
+}
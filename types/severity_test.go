@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverity(t *testing.T) {
+	assert.Equal(t, SeverityOK, Severity(0, nil))
+	assert.Equal(t, SeverityWarning, Severity(1, nil))
+	assert.Equal(t, SeverityCritical, Severity(2, nil))
+	assert.Equal(t, SeverityUnknown, Severity(3, nil))
+	assert.Equal(t, SeverityUnknown, Severity(127, nil))
+
+	severities := map[string]string{"1": SeverityOK, "3": "disaster"}
+	assert.Equal(t, SeverityOK, Severity(0, severities))
+	assert.Equal(t, SeverityOK, Severity(1, severities))
+	assert.Equal(t, SeverityCritical, Severity(2, severities))
+	assert.Equal(t, "disaster", Severity(3, severities))
+}
+
+func TestValidateSeverities(t *testing.T) {
+	assert.NoError(t, ValidateSeverities(nil))
+	assert.NoError(t, ValidateSeverities(map[string]string{"1": "ok", "3": "disaster"}))
+	assert.EqualError(t, ValidateSeverities(map[string]string{"one": "ok"}), "one: is not an exit status")
+	assert.EqualError(t, ValidateSeverities(map[string]string{"-1": "ok"}), "-1: is not an exit status")
+	assert.Error(t, ValidateSeverities(map[string]string{"1": "Disaster!"}))
+}
+
+func TestCheckSetSeverity(t *testing.T) {
+	check := FixtureCheck("check")
+	check.Status = 2
+	check.SetSeverity()
+	assert.Equal(t, SeverityCritical, check.Severity)
+
+	check.Severities = map[string]string{"2": SeverityWarning}
+	check.SetSeverity()
+	assert.Equal(t, SeverityWarning, check.Severity)
+}
@@ -10,32 +10,31 @@ import (
 )
 
 // Validate returns an error if the CheckName and Subscription fields are not
-// provided.
+// provided, unless the entry selects events by their labels.
 func (s *Silenced) Validate() error {
-	if (s.Subscription == "" && s.Check == "") || (s.Subscription == "*" && s.Check == "*") {
-		return errors.New("must provide check or subscription")
+	var errs FieldErrors
+	wildcard := (s.Subscription == "" || s.Subscription == "*") && (s.Check == "" || s.Check == "*")
+	if wildcard && s.LabelSelector == "" {
+		errs.Add("", "must provide check, subscription or label selector")
 	}
 	if s.Subscription != "" && s.Subscription != "*" {
-		if err := ValidateSubscriptionName(s.Subscription); err != nil {
-			return fmt.Errorf("Subscription %s", err)
-		}
+		errs.AddError("subscription", ValidateSubscriptionName(s.Subscription))
 	}
 	if s.Check != "" && s.Check != "*" {
-		if err := ValidateName(s.Check); err != nil {
-			return fmt.Errorf("Check %s", err)
-		}
+		errs.AddError("check", ValidateName(s.Check))
 	}
-	return nil
+	return errs.Err()
 }
 
-// StartSilence returns true if the current unix timestamp is less than the begin
-// timestamp.
+// StartSilence returns true if the given unix timestamp is not before the begin
+// timestamp, which means the silenced entry is in effect. Entries are deleted
+// from the store once expired.
 func (s *Silenced) StartSilence(currentTime int64) bool {
 	// if begin time is zero, it has not been set, so silencing can start.
 	if s.Begin == 0 {
 		return true
 	}
-	return currentTime > s.Begin
+	return currentTime >= s.Begin
 }
 
 // FixtureSilenced returns a testing fixutre for a Silenced event struct.
@@ -78,14 +77,42 @@ func SilencedID(subscription, check string) (string, error) {
 	return fmt.Sprintf("%s:%s", subscription, check), nil
 }
 
+// SilencedIDWithSelector returns the canonical ID for a silenced entry which
+// may also select events by their labels: the canonical ID of its subscription
+// and check, followed by its label selector if any. It returns non-nil error if
+// the subscription, check and label selector are all empty strings.
+func SilencedIDWithSelector(subscription, check, labelSelector string) (string, error) {
+	if labelSelector == "" {
+		return SilencedID(subscription, check)
+	}
+	if subscription == "" {
+		subscription = "*"
+	}
+	if check == "" {
+		check = "*"
+	}
+	return fmt.Sprintf("%s:%s:%s", subscription, check, labelSelector), nil
+}
+
 // URIPath returns the path component of a Silenced URI.
 func (s *Silenced) URIPath() string {
 	if s.ID == "" {
-		s.ID, _ = SilencedID(s.Subscription, s.Check)
+		s.ID, _ = SilencedIDWithSelector(s.Subscription, s.Check, s.LabelSelector)
 	}
 	return fmt.Sprintf("/silenced/%s", url.PathEscape(s.ID))
 }
 
+// GetObjectMeta implements MetaResource.
+func (s *Silenced) GetObjectMeta() ObjectMeta {
+	return objectMeta(s.ObjectMeta, s.ID, s.Organization, s.Environment)
+}
+
+// SetObjectMeta implements MetaResource.
+func (s *Silenced) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(nil, &s.Organization, &s.Environment)
+	s.ObjectMeta = meta
+}
+
 // Get implements govaluate.Parameters
 func (s *Silenced) Get(fname string) (interface{}, error) {
 	strukt := reflect.Indirect(reflect.ValueOf(s))
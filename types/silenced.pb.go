@@ -38,6 +38,12 @@ type Silenced struct {
 	Environment string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Begin is a timestamp at which the silenced entry takes effect.
 	Begin int64 `protobuf:"varint,10,opt,name=begin,proto3" json:"begin"`
+	// LabelSelector restricts the entry to the events whose entity and check
+	// labels match the given label selector, e.g. "team=payments".
+	LabelSelector string `protobuf:"bytes,11,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// Metadata contains the name, namespace, labels and annotations of the
+	// silenced entry
+	ObjectMeta `protobuf:"bytes,12,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *Silenced) Reset()                    { *m = Silenced{} }
@@ -115,6 +121,13 @@ func (m *Silenced) GetBegin() int64 {
 	return 0
 }
 
+func (m *Silenced) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Silenced)(nil), "sensu.types.Silenced")
 }
@@ -167,6 +180,12 @@ func (this *Silenced) Equal(that interface{}) bool {
 	if this.Begin != that1.Begin {
 		return false
 	}
+	if this.LabelSelector != that1.LabelSelector {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *Silenced) Marshal() (dAtA []byte, err error) {
@@ -246,6 +265,20 @@ func (m *Silenced) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintSilenced(dAtA, i, uint64(m.Begin))
 	}
+	if len(m.LabelSelector) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintSilenced(dAtA, i, uint64(len(m.LabelSelector)))
+		i += copy(dAtA[i:], m.LabelSelector)
+	}
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintSilenced(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -276,6 +309,9 @@ func NewPopulatedSilenced(r randySilenced, easy bool) *Silenced {
 	if r.Intn(2) == 0 {
 		this.Begin *= -1
 	}
+	this.LabelSelector = string(randStringSilenced(r))
+	v3 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v3
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -393,6 +429,12 @@ func (m *Silenced) Size() (n int) {
 	if m.Begin != 0 {
 		n += 1 + sovSilenced(uint64(m.Begin))
 	}
+	l = len(m.LabelSelector)
+	if l > 0 {
+		n += 1 + l + sovSilenced(uint64(l))
+	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovSilenced(uint64(l))
 	return n
 }
 
@@ -699,6 +741,65 @@ func (m *Silenced) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilenced
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilenced
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilenced
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilenced
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSilenced(dAtA[iNdEx:])
@@ -828,27 +929,33 @@ var (
 func init() { proto.RegisterFile("silenced.proto", fileDescriptorSilenced) }
 
 var fileDescriptorSilenced = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x41, 0x4e, 0xe3, 0x30,
-	0x14, 0x86, 0xc7, 0x69, 0x93, 0xb6, 0x6e, 0xd5, 0xd1, 0x58, 0xa3, 0x91, 0x07, 0x21, 0x27, 0x2a,
-	0x12, 0xca, 0x86, 0x76, 0xc1, 0x0d, 0x22, 0x58, 0xb0, 0x42, 0x0a, 0x3b, 0x36, 0x55, 0x92, 0x3e,
-	0x52, 0x8b, 0xd6, 0x8e, 0xec, 0xa4, 0x02, 0x4e, 0xc2, 0x11, 0x38, 0x02, 0x47, 0xe8, 0x92, 0x13,
-	0x44, 0x10, 0x76, 0x3d, 0x00, 0x62, 0x89, 0xea, 0x04, 0xa9, 0x15, 0xab, 0xf7, 0xfe, 0xef, 0xfd,
-	0xbf, 0x6d, 0xf9, 0xe1, 0xa1, 0xe6, 0x0b, 0x10, 0x09, 0xcc, 0xc6, 0x99, 0x92, 0xb9, 0x24, 0x7d,
-	0x0d, 0x42, 0x17, 0xe3, 0xfc, 0x3e, 0x03, 0x7d, 0x70, 0x92, 0xf2, 0x7c, 0x5e, 0xc4, 0xe3, 0x44,
-	0x2e, 0x27, 0xa9, 0x4c, 0xe5, 0xc4, 0x78, 0xe2, 0xe2, 0xc6, 0x28, 0x23, 0x4c, 0x57, 0x67, 0x47,
-	0x1f, 0x16, 0xee, 0x5e, 0x35, 0xc7, 0x91, 0x7f, 0xd8, 0xe2, 0x33, 0x8a, 0x3c, 0xe4, 0xf7, 0x02,
-	0xa7, 0x2a, 0x5d, 0xeb, 0xe2, 0x2c, 0xb4, 0xf8, 0x8c, 0x1c, 0x63, 0x07, 0xee, 0x32, 0xae, 0x80,
-	0x5a, 0x1e, 0xf2, 0x5b, 0xc1, 0x70, 0x5d, 0xba, 0x68, 0x53, 0xba, 0x0d, 0x0d, 0x9b, 0x4a, 0xce,
-	0xf1, 0x9f, 0xba, 0x9b, 0x4a, 0x31, 0x55, 0xa0, 0xe5, 0x62, 0x05, 0xb4, 0xe5, 0x21, 0xbf, 0x1b,
-	0xfc, 0x6f, 0x22, 0x3f, 0x0d, 0xe1, 0xef, 0x1a, 0x5d, 0x8a, 0xb0, 0x06, 0x84, 0xe1, 0x4e, 0xa2,
-	0x20, 0xca, 0xa5, 0xa2, 0x6d, 0xf3, 0x96, 0xf6, 0x36, 0x1c, 0x7e, 0x43, 0xf2, 0x17, 0xdb, 0xc9,
-	0x1c, 0x92, 0x5b, 0x6a, 0x6f, 0xa7, 0x61, 0x2d, 0xc8, 0x21, 0x76, 0x14, 0x44, 0x5a, 0x0a, 0xea,
-	0xec, 0x84, 0x1a, 0x46, 0x7c, 0x3c, 0xd0, 0x45, 0xac, 0x13, 0xc5, 0xb3, 0x9c, 0x4b, 0x41, 0x3b,
-	0x3b, 0x9e, 0xbd, 0x09, 0x19, 0xe1, 0x81, 0x54, 0x69, 0x24, 0xf8, 0x43, 0x64, 0x9c, 0x5d, 0x73,
-	0xc9, 0x1e, 0x23, 0x1e, 0xee, 0x83, 0x58, 0x71, 0x25, 0xc5, 0x12, 0x44, 0x4e, 0x7b, 0xc6, 0xb2,
-	0x8b, 0x88, 0x8b, 0xed, 0x18, 0x52, 0x2e, 0x28, 0x36, 0x3f, 0xd6, 0xdb, 0x94, 0x6e, 0x0d, 0xc2,
-	0xba, 0x04, 0x47, 0x9f, 0x6f, 0x0c, 0x3d, 0x55, 0x0c, 0x3d, 0x57, 0x0c, 0xad, 0x2b, 0x86, 0x5e,
-	0x2a, 0x86, 0x5e, 0x2b, 0x86, 0x1e, 0xdf, 0xd9, 0xaf, 0x6b, 0xdb, 0x2c, 0x33, 0x76, 0xcc, 0x92,
-	0x4e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xef, 0xfd, 0xb3, 0xf2, 0x01, 0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x6e, 0xd4, 0x3e,
+	0x14, 0xc6, 0xeb, 0xe9, 0x4c, 0x3a, 0xe3, 0x99, 0xff, 0xfc, 0x85, 0x55, 0x81, 0xa9, 0xaa, 0x24,
+	0x2a, 0x12, 0xca, 0x02, 0x52, 0x09, 0x6e, 0x10, 0x60, 0xd1, 0x05, 0xaa, 0xe4, 0xee, 0xd8, 0x8c,
+	0x9c, 0xcc, 0x23, 0x35, 0x24, 0x76, 0x64, 0x7b, 0x2a, 0xca, 0x01, 0x38, 0x03, 0x47, 0xe0, 0x08,
+	0x1c, 0x61, 0x96, 0x3d, 0x41, 0x04, 0x61, 0x37, 0x27, 0x60, 0x89, 0xc6, 0x0e, 0xd5, 0x8c, 0x58,
+	0xf9, 0xbd, 0xdf, 0xf7, 0x7d, 0x8e, 0x63, 0x3f, 0x3c, 0x37, 0xa2, 0x02, 0x59, 0xc0, 0x32, 0x6d,
+	0xb4, 0xb2, 0x8a, 0x4c, 0x0d, 0x48, 0xb3, 0x4a, 0xed, 0x6d, 0x03, 0xe6, 0xe4, 0x79, 0x29, 0xec,
+	0xf5, 0x2a, 0x4f, 0x0b, 0x55, 0x9f, 0x97, 0xaa, 0x54, 0xe7, 0xce, 0x93, 0xaf, 0xde, 0xbb, 0xce,
+	0x35, 0xae, 0xf2, 0xd9, 0x13, 0x5c, 0x83, 0xe5, 0xbe, 0x3e, 0xfb, 0x32, 0xc4, 0xe3, 0xab, 0x7e,
+	0x6b, 0xf2, 0x10, 0x0f, 0xc4, 0x92, 0xa2, 0x18, 0x25, 0x93, 0x2c, 0xe8, 0xda, 0x68, 0x70, 0xf1,
+	0x9a, 0x0d, 0xc4, 0x92, 0x3c, 0xc5, 0x01, 0x7c, 0x6a, 0x84, 0x06, 0x3a, 0x88, 0x51, 0x72, 0x98,
+	0xcd, 0xd7, 0x6d, 0x84, 0x36, 0x6d, 0xd4, 0x53, 0xd6, 0xaf, 0xe4, 0x0d, 0x7e, 0xe0, 0xab, 0x85,
+	0x92, 0x0b, 0x0d, 0x46, 0x55, 0x37, 0x40, 0x0f, 0x63, 0x94, 0x8c, 0xb3, 0xc7, 0x7d, 0xe4, 0x5f,
+	0x03, 0xfb, 0xdf, 0xa3, 0x4b, 0xc9, 0x3c, 0x20, 0x21, 0x3e, 0x2a, 0x34, 0x70, 0xab, 0x34, 0x1d,
+	0xba, 0xb3, 0x0c, 0xb7, 0x61, 0xf6, 0x17, 0x92, 0x63, 0x3c, 0x2a, 0xae, 0xa1, 0xf8, 0x48, 0x47,
+	0x5b, 0x95, 0xf9, 0x86, 0x9c, 0xe2, 0x40, 0x03, 0x37, 0x4a, 0xd2, 0x60, 0x27, 0xd4, 0x33, 0x92,
+	0xe0, 0x99, 0x59, 0xe5, 0xa6, 0xd0, 0xa2, 0xb1, 0x42, 0x49, 0x7a, 0xb4, 0xe3, 0xd9, 0x53, 0xc8,
+	0x19, 0x9e, 0x29, 0x5d, 0x72, 0x29, 0x3e, 0x73, 0xe7, 0x1c, 0xbb, 0x8f, 0xec, 0x31, 0x12, 0xe3,
+	0x29, 0xc8, 0x1b, 0xa1, 0x95, 0xac, 0x41, 0x5a, 0x3a, 0x71, 0x96, 0x5d, 0x44, 0x22, 0x3c, 0xca,
+	0xa1, 0x14, 0x92, 0x62, 0x77, 0x63, 0x93, 0x4d, 0x1b, 0x79, 0xc0, 0xfc, 0x42, 0x5e, 0xe1, 0x79,
+	0xc5, 0x73, 0xa8, 0x16, 0x06, 0x2a, 0x28, 0xb6, 0xff, 0x3a, 0x75, 0x47, 0x3a, 0xdd, 0xb4, 0x11,
+	0xdd, 0x57, 0x9e, 0xa9, 0x5a, 0x58, 0xa8, 0x1b, 0x7b, 0xcb, 0xfe, 0x73, 0xca, 0x55, 0x2f, 0x90,
+	0x0b, 0x3c, 0xde, 0xbe, 0xe5, 0x92, 0x5b, 0x4e, 0x67, 0x31, 0x4a, 0xa6, 0x2f, 0x1e, 0xa5, 0x3b,
+	0x83, 0x91, 0x5e, 0xe6, 0x1f, 0xa0, 0xb0, 0x6f, 0xc1, 0xf2, 0xec, 0x78, 0xdd, 0x46, 0x07, 0x77,
+	0xfe, 0x11, 0xee, 0x43, 0xec, 0xbe, 0xca, 0x9e, 0xfc, 0xfe, 0x19, 0xa2, 0x6f, 0x5d, 0x88, 0xbe,
+	0x77, 0x21, 0x5a, 0x77, 0x21, 0xba, 0xeb, 0x42, 0xf4, 0xa3, 0x0b, 0xd1, 0xd7, 0x5f, 0xe1, 0xc1,
+	0xbb, 0x91, 0xdb, 0x2f, 0x0f, 0xdc, 0xd0, 0xbc, 0xfc, 0x33, 0x00, 0xa7, 0xf7, 0xd9, 0x7d, 0x8e,
+	0x02, 0x00, 0x00,
 }
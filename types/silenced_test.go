@@ -37,6 +37,27 @@ func TestFixtureSilenced(t *testing.T) {
 func TestSilencedValidate(t *testing.T) {
 	var s Silenced
 	assert.Error(t, s.Validate())
+
+	s.Subscription = "*"
+	s.Check = "*"
+	assert.Error(t, s.Validate())
+
+	// Entries may silence all the events with the given labels
+	s.LabelSelector = "team=payments"
+	assert.NoError(t, s.Validate())
+}
+
+func TestSilencedIDWithSelector(t *testing.T) {
+	id, err := SilencedIDWithSelector("linux", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "linux:*", id)
+
+	id, err = SilencedIDWithSelector("", "", "team=payments")
+	assert.NoError(t, err)
+	assert.Equal(t, "*:*:team=payments", id)
+
+	_, err = SilencedIDWithSelector("", "", "")
+	assert.Error(t, err)
 }
 
 func TestSortSilencedByID(t *testing.T) {
@@ -82,3 +103,14 @@ func TestSortSilencedByBegin(t *testing.T) {
 	sort.Sort(SortSilencedByBegin(in))
 	assert.EqualValues(t, []*Silenced{a, b, c}, in)
 }
+
+func TestSilencedStartSilence(t *testing.T) {
+	s := FixtureSilenced("*:check")
+	assert.True(t, s.StartSilence(100))
+
+	// Scheduled entries take effect at their begin timestamp
+	s.Begin = 100
+	assert.False(t, s.StartSilence(99))
+	assert.True(t, s.StartSilence(100))
+	assert.True(t, s.StartSilence(101))
+}
@@ -1,29 +1,59 @@
 package types
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 )
 
 // Validate ensures that all the time windows in t can be parsed.
 func (t *TimeWindowWhen) Validate() error {
+	var errs FieldErrors
 	if t == nil {
 		return nil
 	}
-	for _, windows := range t.MapTimeWindows() {
-		for _, window := range windows {
-			if err := window.Validate(); err != nil {
-				return err
-			}
+	if t.Timezone != "" {
+		if _, err := time.LoadLocation(t.Timezone); err != nil {
+			errs.Add("timezone", "%s", err)
 		}
 	}
-	return nil
+	windowsByDay := t.MapTimeWindows()
+	days := make([]string, 0, len(windowsByDay))
+	for day := range windowsByDay {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+	for _, day := range days {
+		for i, window := range windowsByDay[day] {
+			errs.AddError(FieldPath("days", strings.ToLower(day), i), window.Validate())
+		}
+	}
+	return errs.Err()
 }
 
 // Validate ensures the TimeWindowTimeRange is valid.
 func (t *TimeWindowTimeRange) Validate() error {
-	_, err := t.InWindow(time.Now())
-	return err
+	var errs FieldErrors
+	if _, err := time.Parse(time.Kitchen, strings.Replace(t.Begin, " ", "", -1)); err != nil {
+		errs.Add("begin", "%s", err)
+	}
+	if _, err := time.Parse(time.Kitchen, strings.Replace(t.End, " ", "", -1)); err != nil {
+		errs.Add("end", "%s", err)
+	}
+	return errs.Err()
+}
+
+// location returns the time zone of the time windows, UTC by default.
+func (t *TimeWindowWhen) location() (*time.Location, error) {
+	if t.Timezone == "" {
+		return time.UTC, nil
+	}
+	location, err := time.LoadLocation(t.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time window timezone %q: %s", t.Timezone, err)
+	}
+	return location, nil
 }
 
 // MapTimeWindows returns a map of all the time windows in t.
@@ -44,7 +74,8 @@ func (t *TimeWindowWhen) MapTimeWindows() map[string][]*TimeWindowTimeRange {
 // InWindow determines if the current time falls between the provided time
 // window. Current should typically be time.Now() but to allow easier tests, it
 // must be provided as a parameter. Begin and end parameters must be strings
-// representing an hour of the day in the time.Kitchen format (e.g. "3:04PM")
+// representing an hour of the day in the time.Kitchen format (e.g. "3:04PM"),
+// in the time zone of the current time.
 func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 	// Get the year, month and day of the provided current time (e.g. 2016, 01 &
 	// 02)
@@ -64,7 +95,7 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 		return false, err
 	}
 	beginHour, beginMin, _ := beginTime.Clock()
-	beginTime = time.Date(year, month, day, beginHour, beginMin, 0, 0, time.UTC)
+	beginTime = time.Date(year, month, day, beginHour, beginMin, 0, 0, current.Location())
 
 	// Parse the ending of the provided time window in order to retrieve the
 	// hour and minute and apply it to current year, month and day so we end up
@@ -74,7 +105,7 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 		return false, err
 	}
 	endHour, endMin, _ := endTime.Clock()
-	endTime = time.Date(year, month, day, endHour, endMin, 0, 0, time.UTC)
+	endTime = time.Date(year, month, day, endHour, endMin, 0, 0, current.Location())
 
 	// Verify if the end of the time window is actually before the beginning of
 	// it, which means that the window ends the next day (e.g. 3:00PM to 8:00AM)
@@ -85,12 +116,12 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 		// of this second day (e.g. 3:00PM to 8:00AM, it's currently 5:00AM so let's
 		// move the beginning to 0:00AM)
 		if current.Before(endTime) {
-			beginTime = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+			beginTime = time.Date(year, month, day, 0, 0, 0, 0, current.Location())
 		} else {
 			// We are currently on the first day of the window so we just need to move
 			// the end of this window to the end of the first day (e.g. 3:00PM to
 			// 8:00AM, it's currently 5:00PM so let's move the ending to 11:59PM)
-			endTime = time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+			endTime = time.Date(year, month, day, 23, 59, 59, 999999999, current.Location())
 		}
 	}
 
@@ -101,8 +132,15 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 // InWindows determines if the current time falls between the provided time
 // windows. Current should typically be time.Now() but to allow easier tests, it
 // must be provided as a parameter. The function returns a positive value as
-// soon the current time falls within a time window
+// soon the current time falls within a time window. The time windows are
+// evaluated in their time zone.
 func (t *TimeWindowWhen) InWindows(current time.Time) (bool, error) {
+	location, err := t.location()
+	if err != nil {
+		return false, err
+	}
+	current = current.In(location)
+
 	windowsByDay := t.MapTimeWindows()
 
 	var windows []*TimeWindowTimeRange
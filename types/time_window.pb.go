@@ -19,6 +19,9 @@ var _ = math.Inf
 type TimeWindowWhen struct {
 	// Days is a hash of days
 	Days TimeWindowDays `protobuf:"bytes,1,opt,name=days" json:"days"`
+	// Timezone is the IANA time zone the time windows are in, e.g.
+	// "America/Montreal"; time windows are in UTC by default.
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
 }
 
 func (m *TimeWindowWhen) Reset()                    { *m = TimeWindowWhen{} }
@@ -33,6 +36,13 @@ func (m *TimeWindowWhen) GetDays() TimeWindowDays {
 	return TimeWindowDays{}
 }
 
+func (m *TimeWindowWhen) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
 // TimeWindowDays defines the days of a time window
 type TimeWindowDays struct {
 	All       []*TimeWindowTimeRange `protobuf:"bytes,1,rep,name=all" json:"all,omitempty"`
@@ -162,6 +172,9 @@ func (this *TimeWindowWhen) Equal(that interface{}) bool {
 	if !this.Days.Equal(&that1.Days) {
 		return false
 	}
+	if this.Timezone != that1.Timezone {
+		return false
+	}
 	return true
 }
 func (this *TimeWindowDays) Equal(that interface{}) bool {
@@ -299,6 +312,12 @@ func (m *TimeWindowWhen) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n1
+	if len(m.Timezone) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTimeWindow(dAtA, i, uint64(len(m.Timezone)))
+		i += copy(dAtA[i:], m.Timezone)
+	}
 	return i, nil
 }
 
@@ -459,6 +478,7 @@ func NewPopulatedTimeWindowWhen(r randyTimeWindow, easy bool) *TimeWindowWhen {
 	this := &TimeWindowWhen{}
 	v1 := NewPopulatedTimeWindowDays(r, easy)
 	this.Days = *v1
+	this.Timezone = string(randStringTimeWindow(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -613,6 +633,10 @@ func (m *TimeWindowWhen) Size() (n int) {
 	_ = l
 	l = m.Days.Size()
 	n += 1 + l + sovTimeWindow(uint64(l))
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovTimeWindow(uint64(l))
+	}
 	return n
 }
 
@@ -756,6 +780,35 @@ func (m *TimeWindowWhen) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeWindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTimeWindow(dAtA[iNdEx:])
@@ -1291,28 +1344,30 @@ var (
 func init() { proto.RegisterFile("time_window.proto", fileDescriptorTimeWindow) }
 
 var fileDescriptorTimeWindow = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x4a, 0xc3, 0x40,
-	0x14, 0x87, 0x3b, 0x4d, 0xfa, 0x6f, 0x2a, 0x82, 0xe3, 0x26, 0x2a, 0x24, 0xa5, 0x6e, 0xba, 0x31,
-	0x85, 0xba, 0x71, 0xa3, 0x48, 0xe8, 0x5e, 0x0c, 0x42, 0xc1, 0x8d, 0x24, 0x66, 0x9a, 0x06, 0x9a,
-	0x99, 0x92, 0x99, 0xa1, 0xe4, 0x26, 0xe2, 0x09, 0x3c, 0x82, 0x47, 0xe8, 0xd2, 0x13, 0x04, 0x8d,
-	0xbb, 0x9e, 0xc0, 0xa5, 0xcc, 0xc4, 0xda, 0x2e, 0x74, 0x31, 0x9b, 0xc9, 0xbc, 0xcc, 0xef, 0xfb,
-	0x78, 0x3c, 0x1e, 0x3c, 0xe0, 0x49, 0x8a, 0x1f, 0x96, 0x09, 0x89, 0xe8, 0xd2, 0x5d, 0x64, 0x94,
-	0x53, 0xd4, 0x65, 0x98, 0x30, 0xe1, 0xf2, 0x7c, 0x81, 0xd9, 0xf1, 0x59, 0x9c, 0xf0, 0x99, 0x08,
-	0xdd, 0x47, 0x9a, 0x0e, 0x63, 0x1a, 0xd3, 0xa1, 0xca, 0x84, 0x62, 0xaa, 0x2a, 0x55, 0xa8, 0x5b,
-	0xc5, 0xf6, 0x6f, 0xe0, 0xfe, 0x5d, 0x92, 0xe2, 0x89, 0xf2, 0x4d, 0x66, 0x98, 0xa0, 0x4b, 0x68,
-	0x46, 0x41, 0xce, 0x2c, 0xd0, 0x03, 0x83, 0xee, 0xe8, 0xc4, 0xdd, 0x91, 0xbb, 0xdb, 0xe8, 0x38,
-	0xc8, 0x99, 0xb7, 0xb7, 0x2a, 0x9c, 0xda, 0xba, 0x70, 0x14, 0xe0, 0xab, 0xb3, 0xff, 0x6c, 0xee,
-	0x1a, 0x65, 0x0c, 0x5d, 0x40, 0x23, 0x98, 0xcf, 0x2d, 0xd0, 0x33, 0x06, 0xdd, 0x51, 0xef, 0x1f,
-	0xa1, 0xbc, 0xf9, 0x01, 0x89, 0xb1, 0x67, 0xae, 0x0a, 0x07, 0xf8, 0x12, 0x41, 0x57, 0xb0, 0xc9,
-	0x04, 0x89, 0x82, 0xdc, 0xaa, 0x6b, 0xc1, 0x3f, 0x94, 0xe4, 0x53, 0xaa, 0x78, 0x43, 0x8f, 0xaf,
-	0x28, 0x74, 0x0d, 0x5b, 0x5c, 0x60, 0x26, 0x05, 0xa6, 0x96, 0x60, 0x83, 0xa1, 0x31, 0xec, 0x2c,
-	0x71, 0x44, 0x2a, 0x47, 0x43, 0xcb, 0xb1, 0x05, 0x91, 0x07, 0xdb, 0x7c, 0x26, 0x32, 0x25, 0x69,
-	0x6a, 0x49, 0x7e, 0x39, 0x39, 0x8b, 0x69, 0x96, 0x48, 0x43, 0x4b, 0x6f, 0x16, 0x15, 0x25, 0x7b,
-	0x60, 0x01, 0x17, 0x99, 0x34, 0xb4, 0xf5, 0x7a, 0xd8, 0x70, 0xfd, 0x5b, 0x78, 0xf8, 0x47, 0x0c,
-	0x39, 0xb0, 0x11, 0xe2, 0x38, 0x21, 0x6a, 0xe7, 0x3a, 0x5e, 0x67, 0x5d, 0x38, 0xd5, 0x0f, 0xbf,
-	0xfa, 0xa0, 0x23, 0x68, 0x60, 0x12, 0x59, 0x75, 0xf5, 0xdc, 0x5a, 0x17, 0x8e, 0x2c, 0x7d, 0x79,
-	0x78, 0xa7, 0x5f, 0x1f, 0x36, 0x78, 0x29, 0x6d, 0xf0, 0x5a, 0xda, 0x60, 0x55, 0xda, 0xe0, 0xad,
-	0xb4, 0xc1, 0x7b, 0x69, 0x83, 0xa7, 0x4f, 0xbb, 0x76, 0xdf, 0x50, 0xbd, 0x85, 0x4d, 0xb5, 0xec,
-	0xe7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0xff, 0x53, 0x21, 0x3d, 0x03, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6a, 0xe3, 0x30,
+	0x18, 0x86, 0xa3, 0xd8, 0xf9, 0xb1, 0x32, 0x04, 0x46, 0x03, 0x83, 0x67, 0x06, 0x6c, 0x93, 0xd9,
+	0x64, 0xd1, 0x3a, 0x90, 0x6e, 0xba, 0x69, 0x29, 0x26, 0x17, 0xa8, 0x29, 0x04, 0xba, 0x29, 0x76,
+	0xad, 0x38, 0x86, 0x58, 0x0a, 0x96, 0x4c, 0x70, 0xb7, 0xbd, 0x44, 0xe9, 0x09, 0x7a, 0x84, 0x1e,
+	0x21, 0xcb, 0x9e, 0xc0, 0xb4, 0xee, 0xce, 0x27, 0xe8, 0xb2, 0x48, 0xce, 0x4f, 0x0b, 0xed, 0xc2,
+	0x1b, 0x59, 0x9f, 0x78, 0x9f, 0x87, 0x17, 0x21, 0xc3, 0x9f, 0x3c, 0x8a, 0xf1, 0xd5, 0x2a, 0x22,
+	0x01, 0x5d, 0xd9, 0xcb, 0x84, 0x72, 0x8a, 0x7a, 0x0c, 0x13, 0x96, 0xda, 0x3c, 0x5b, 0x62, 0xf6,
+	0xf7, 0x30, 0x8c, 0xf8, 0x3c, 0xf5, 0xed, 0x6b, 0x1a, 0x8f, 0x42, 0x1a, 0xd2, 0x91, 0xcc, 0xf8,
+	0xe9, 0x4c, 0x4e, 0x72, 0x90, 0xbb, 0x8a, 0x1d, 0xdc, 0x02, 0xd8, 0xbf, 0x88, 0x62, 0x3c, 0x95,
+	0xc2, 0xe9, 0x1c, 0x13, 0x74, 0x02, 0xd5, 0xc0, 0xcb, 0x98, 0x0e, 0x2c, 0x30, 0xec, 0x8d, 0xff,
+	0xd9, 0x1f, 0xec, 0xf6, 0x3e, 0x3a, 0xf1, 0x32, 0xe6, 0xfc, 0x58, 0xe7, 0x66, 0xa3, 0xcc, 0x4d,
+	0x09, 0xb8, 0x72, 0x45, 0x63, 0xd8, 0x15, 0x15, 0x6f, 0x28, 0xc1, 0x7a, 0xd3, 0x02, 0x43, 0xcd,
+	0xf9, 0x5d, 0xe6, 0x26, 0xda, 0x9e, 0x1d, 0xd0, 0x38, 0xe2, 0x38, 0x5e, 0xf2, 0xcc, 0xdd, 0xe5,
+	0x06, 0xf7, 0x2a, 0xec, 0x7f, 0x56, 0xa3, 0x63, 0xa8, 0x78, 0x8b, 0x85, 0x0e, 0x2c, 0x65, 0xd8,
+	0x1b, 0x5b, 0xdf, 0x94, 0x10, 0x3b, 0xd7, 0x23, 0x21, 0x76, 0xd4, 0x75, 0x6e, 0x02, 0x57, 0x20,
+	0xe8, 0x14, 0xb6, 0x59, 0x4a, 0x02, 0x2f, 0xd3, 0x9b, 0xb5, 0xe0, 0x0d, 0x25, 0xf8, 0x98, 0x4a,
+	0x5e, 0xa9, 0xc7, 0x57, 0x14, 0x3a, 0x83, 0x1d, 0x9e, 0x62, 0x26, 0x04, 0x6a, 0x2d, 0xc1, 0x16,
+	0x43, 0x13, 0xa8, 0xad, 0x70, 0x40, 0x2a, 0x47, 0xab, 0x96, 0x63, 0x0f, 0x22, 0x07, 0x76, 0xf9,
+	0x3c, 0x4d, 0xa4, 0xa4, 0x5d, 0x4b, 0xb2, 0xe3, 0xc4, 0x5d, 0xcc, 0x92, 0x48, 0x18, 0x3a, 0xf5,
+	0xee, 0xa2, 0xa2, 0x44, 0x07, 0xe6, 0xf1, 0x34, 0x11, 0x86, 0x6e, 0xbd, 0x0e, 0x5b, 0x6e, 0x70,
+	0x0e, 0x7f, 0x7d, 0x11, 0x43, 0x26, 0x6c, 0xf9, 0x38, 0x8c, 0x88, 0x7c, 0xa7, 0x9a, 0xa3, 0x95,
+	0xb9, 0x59, 0x1d, 0xb8, 0xd5, 0x07, 0xfd, 0x81, 0x0a, 0x26, 0xc1, 0xe6, 0x0d, 0x76, 0xca, 0xdc,
+	0x14, 0xa3, 0x2b, 0x16, 0xe7, 0xff, 0xdb, 0x8b, 0x01, 0x1e, 0x0a, 0x03, 0x3c, 0x16, 0x06, 0x58,
+	0x17, 0x06, 0x78, 0x2a, 0x0c, 0xf0, 0x5c, 0x18, 0xe0, 0xee, 0xd5, 0x68, 0x5c, 0xb6, 0x64, 0x37,
+	0xbf, 0x2d, 0xff, 0x90, 0xa3, 0xf7, 0x01, 0x00, 0x78, 0x43, 0xa0, 0x46, 0x72, 0x03, 0x00, 0x00,
 }
@@ -233,6 +233,50 @@ func TestInWindows(t *testing.T) {
 			expected:      false,
 			expectedError: false,
 		},
+		{
+			name: "is within the time window of Monday in its time zone",
+			now:  mustParse(t, "2006-01-03T02:30:00Z"), // Monday 9:30PM in Montreal
+			windows: TimeWindowWhen{
+				Days: TimeWindowDays{
+					Monday: []*TimeWindowTimeRange{
+						&TimeWindowTimeRange{
+							Begin: "9:00PM",
+							End:   "10:00PM",
+						},
+					},
+				},
+				Timezone: "America/Montreal",
+			},
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			name: "is outside the time window in its time zone",
+			now:  mustParse(t, "2006-01-02T21:30:00Z"), // Monday 4:30PM in Montreal
+			windows: TimeWindowWhen{
+				Days: TimeWindowDays{
+					All: []*TimeWindowTimeRange{
+						&TimeWindowTimeRange{
+							Begin: "9:00PM",
+							End:   "10:00PM",
+						},
+					},
+				},
+				Timezone: "America/Montreal",
+			},
+			expected:      false,
+			expectedError: false,
+		},
+		{
+			name: "invalid time zone",
+			now:  mustParse(t, "2006-01-02T17:04:05Z"),
+			windows: TimeWindowWhen{
+				Days:     TimeWindowDays{},
+				Timezone: "Nowhere/Special",
+			},
+			expected:      false,
+			expectedError: true,
+		},
 		{
 			name: "invalid time format",
 			now:  mustParse(t, "2006-01-02T17:04:05Z"),
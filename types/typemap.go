@@ -59,10 +59,14 @@ var typeMap = map[string]interface{}{
 	"metrics":                &Metrics{},
 	"Mutator":                &Mutator{},
 	"mutator":                &Mutator{},
+	"Namespace":              &Namespace{},
+	"namespace":              &Namespace{},
 	"Network":                &Network{},
 	"network":                &Network{},
 	"NetworkInterface":       &NetworkInterface{},
 	"network_interface":      &NetworkInterface{},
+	"ObjectMeta":             &ObjectMeta{},
+	"object_meta":            &ObjectMeta{},
 	"Organization":           &Organization{},
 	"organization":           &Organization{},
 	"ProxyRequests":          &ProxyRequests{},
@@ -71,6 +75,16 @@ var typeMap = map[string]interface{}{
 	"role":                   &Role{},
 	"Rule":                   &Rule{},
 	"rule":                   &Rule{},
+	"Secret":                 &Secret{},
+	"secret":                 &Secret{},
+	"SecretReference":        &SecretReference{},
+	"secret_reference":       &SecretReference{},
+	"Service":                &Service{},
+	"service":                &Service{},
+	"ServiceComponent":       &ServiceComponent{},
+	"service_component":      &ServiceComponent{},
+	"ServiceRule":            &ServiceRule{},
+	"service_rule":           &ServiceRule{},
 	"Silenced":               &Silenced{},
 	"silenced":               &Silenced{},
 	"System":                 &System{},
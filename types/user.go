@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"net/url"
 )
 
 // FixtureUser returns a testing fixture for an Entity object.
@@ -16,11 +17,9 @@ func FixtureUser(username string) *User {
 
 // Validate returns an error if the entity is invalid.
 func (u *User) Validate() error {
-	if err := ValidateNameStrict(u.Username); err != nil {
-		return fmt.Errorf("username %s", err)
-	}
-
-	return nil
+	var errs FieldErrors
+	errs.AddError("username", ValidateNameStrict(u.Username))
+	return errs.Err()
 }
 
 // ValidatePassword returns an error if the entity is invalid.
@@ -35,3 +34,19 @@ func (u *User) ValidatePassword() error {
 
 	return nil
 }
+
+// URIPath returns the path component of a User URI.
+func (u *User) URIPath() string {
+	return fmt.Sprintf("/rbac/users/%s", url.PathEscape(u.Username))
+}
+
+// GetObjectMeta implements MetaResource.
+func (u *User) GetObjectMeta() ObjectMeta {
+	return objectMeta(u.ObjectMeta, u.Username, "", "")
+}
+
+// SetObjectMeta implements MetaResource.
+func (u *User) SetObjectMeta(meta ObjectMeta) {
+	meta.apply(&u.Username, nil, nil)
+	u.ObjectMeta = meta
+}
@@ -21,6 +21,8 @@ type User struct {
 	Password string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Roles    []string `protobuf:"bytes,3,rep,name=roles" json:"roles,omitempty"`
 	Disabled bool     `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled"`
+	// Metadata contains the name, namespace, labels and annotations of the user
+	ObjectMeta `protobuf:"bytes,5,opt,name=metadata,embedded=metadata" json:"metadata"`
 }
 
 func (m *User) Reset()                    { *m = User{} }
@@ -95,6 +97,9 @@ func (this *User) Equal(that interface{}) bool {
 	if this.Disabled != that1.Disabled {
 		return false
 	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
 	return true
 }
 func (m *User) Marshal() (dAtA []byte, err error) {
@@ -149,6 +154,14 @@ func (m *User) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintUser(dAtA, i, uint64(m.ObjectMeta.Size()))
+	n1, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
 	return i, nil
 }
 
@@ -171,6 +184,8 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 		this.Roles[i] = string(randStringUser(r))
 	}
 	this.Disabled = bool(bool(r.Intn(2) == 0))
+	v4 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v4
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -268,6 +283,8 @@ func (m *User) Size() (n int) {
 	if m.Disabled {
 		n += 2
 	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovUser(uint64(l))
 	return n
 }
 
@@ -420,6 +437,36 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Disabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -549,19 +596,22 @@ var (
 func init() { proto.RegisterFile("user.proto", fileDescriptorUser) }
 
 var fileDescriptorUser = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
+	// 264 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2a, 0x2d, 0x4e, 0x2d,
 	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9,
 	0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
 	0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b,
-	0xa2, 0x57, 0xa9, 0x8e, 0x8b, 0x25, 0xb4, 0x38, 0xb5, 0x48, 0x48, 0x8a, 0x8b, 0x03, 0x64, 0x62,
-	0x5e, 0x62, 0x6e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x9c, 0x0f, 0x92, 0x2b, 0x48,
-	0x2c, 0x2e, 0x2e, 0xcf, 0x2f, 0x4a, 0x91, 0x60, 0x82, 0xc8, 0xc1, 0xf8, 0x42, 0x22, 0x5c, 0xac,
-	0x45, 0xf9, 0x39, 0xa9, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x06,
-	0x17, 0x47, 0x4a, 0x66, 0x71, 0x62, 0x52, 0x4e, 0x6a, 0x8a, 0x04, 0x8b, 0x02, 0xa3, 0x06, 0x87,
-	0x13, 0xcf, 0xab, 0x7b, 0xf2, 0x70, 0xb1, 0x20, 0x38, 0xcb, 0x49, 0xf9, 0xc7, 0x43, 0x39, 0xc6,
-	0x15, 0x8f, 0xe4, 0x18, 0x77, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x67, 0x3c, 0x96, 0x63, 0x88, 0x62, 0x05, 0xfb, 0x29, 0x89, 0x0d,
-	0xec, 0x56, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0x80, 0xad, 0xd0, 0xf5, 0x00, 0x00,
-	0x00,
+	0xa2, 0x57, 0x8a, 0x2b, 0x37, 0xb5, 0x24, 0x11, 0xc2, 0x56, 0x3a, 0xc9, 0xc8, 0xc5, 0x12, 0x5a,
+	0x9c, 0x5a, 0x24, 0x24, 0xc5, 0xc5, 0x01, 0x32, 0x3e, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x51, 0x81,
+	0x51, 0x83, 0x33, 0x08, 0xce, 0x07, 0xc9, 0x15, 0x24, 0x16, 0x17, 0x97, 0xe7, 0x17, 0xa5, 0x48,
+	0x30, 0x41, 0xe4, 0x60, 0x7c, 0x21, 0x11, 0x2e, 0xd6, 0xa2, 0xfc, 0x9c, 0xd4, 0x62, 0x09, 0x66,
+	0x05, 0x66, 0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x83, 0x8b, 0x23, 0x25, 0xb3, 0x38, 0x31, 0x29,
+	0x27, 0x35, 0x45, 0x82, 0x45, 0x81, 0x51, 0x83, 0xc3, 0x89, 0xe7, 0xd5, 0x3d, 0x79, 0xb8, 0x58,
+	0x10, 0x9c, 0x25, 0xe4, 0xc9, 0xc5, 0x01, 0x72, 0x4e, 0x4a, 0x62, 0x49, 0xa2, 0x04, 0xab, 0x02,
+	0xa3, 0x06, 0xb7, 0x91, 0xb8, 0x1e, 0x92, 0xdf, 0xf4, 0xfc, 0x93, 0xb2, 0x52, 0x93, 0x4b, 0x7c,
+	0x53, 0x4b, 0x12, 0x9d, 0x44, 0x4e, 0xdc, 0x93, 0x67, 0xb8, 0x70, 0x4f, 0x9e, 0x11, 0x64, 0x14,
+	0x4c, 0x53, 0x10, 0x9c, 0xe5, 0xa4, 0xfc, 0xe3, 0xa1, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x3b,
+	0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
+	0x33, 0x1e, 0xcb, 0x31, 0x44, 0xb1, 0x82, 0xcd, 0x4b, 0x62, 0x03, 0xfb, 0xdb, 0x18, 0x30, 0x00,
+	0x22, 0xa7, 0x93, 0x9f, 0x4d, 0x01, 0x00, 0x00,
 }
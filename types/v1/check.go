@@ -7,6 +7,7 @@ import (
 // CheckResult contains the 1.x compatible check result payload
 type CheckResult struct {
 	Client             string   `json:"client"`
+	Source             string   `json:"source"`
 	Status             uint32   `json:"status"`
 	Command            string   `json:"command"`
 	Subscribers        []string `json:"subscribers"`
@@ -16,6 +17,10 @@ type CheckResult struct {
 	Executed           int64    `json:"executed"`
 	Duration           float64  `json:"duration"`
 	Output             string   `json:"output"`
+	Handler            string   `json:"handler"`
+	Handlers           []string `json:"handlers"`
+	TTL                int64    `json:"ttl"`
+	Timeout            uint32   `json:"timeout"`
 	ExtendedAttributes []byte   `json:"-"`
 }
 
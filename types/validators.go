@@ -2,7 +2,9 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 )
 
 // ConstrainedResource defines a resources that has contraints on it's attributes
@@ -10,6 +12,93 @@ type ConstrainedResource interface {
 	Validate() error
 }
 
+// FieldError is the validation error of a field of a resource, addressed by
+// its path, e.g. "proxy_requests.splay_coverage" or "secrets[0].name".
+type FieldError struct {
+	// Field is the path of the invalid field, made of the JSON names of the
+	// fields leading to it and of the indexes of the lists. It is empty when the
+	// error concerns the resource as a whole.
+	Field string `json:"field"`
+
+	// Message describes why the field is invalid
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// FieldErrors are the validation errors of the fields of a resource. The
+// Validate methods of the resources return them, the zero value being ready
+// to use.
+type FieldErrors []*FieldError
+
+// Error implements the error interface.
+func (e FieldErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add adds an error of the field at the given path, whose message is
+// formatted according to the given format.
+func (e *FieldErrors) Add(field string, format string, args ...interface{}) {
+	*e = append(*e, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// AddError adds the given error, if not nil, to the field at the given path.
+// The errors of the fields of a nested resource, given as FieldErrors, are
+// added under the path.
+func (e *FieldErrors) AddError(field string, err error) {
+	switch err := err.(type) {
+	case nil:
+	case FieldErrors:
+		for _, fieldErr := range err {
+			e.Add(FieldPath(field, fieldErr.Field), "%s", fieldErr.Message)
+		}
+	case *FieldError:
+		e.Add(FieldPath(field, err.Field), "%s", err.Message)
+	default:
+		e.Add(field, "%s", err)
+	}
+}
+
+// Err returns the errors, or nil if there are none.
+func (e FieldErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// FieldPath returns the path of a field from the given elements: the names of
+// the fields leading to it, joined by dots, and the indexes of the lists,
+// given as integers, e.g. FieldPath("secrets", 0, "name") is "secrets[0].name".
+func FieldPath(elems ...interface{}) string {
+	var path string
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case int:
+			path += fmt.Sprintf("[%d]", elem)
+		case string:
+			if elem == "" {
+				continue
+			}
+			if path != "" && !strings.HasPrefix(elem, "[") {
+				path += "."
+			}
+			path += elem
+		}
+	}
+	return path
+}
+
 // NameRegex is used to validate the name of a resource
 var NameRegex = regexp.MustCompile(`\A[\w\.\-]+\z`)
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateName(t *testing.T) {
@@ -29,3 +30,56 @@ func TestValidateSubscriptionName(t *testing.T) {
 	assert.NoError(t, ValidateSubscriptionName("entity:foo"))
 	assert.NoError(t, ValidateSubscriptionName("foo-bar_2"))
 }
+
+func TestFieldPath(t *testing.T) {
+	assert.Equal(t, "", FieldPath())
+	assert.Equal(t, "name", FieldPath("name"))
+	assert.Equal(t, "secrets[0].name", FieldPath("secrets", 0, "name"))
+	assert.Equal(t, "[1]", FieldPath("", 1))
+	assert.Equal(t, "env_vars[1]", FieldPath("env_vars", "[1]"))
+	assert.Equal(t, "subdue.days.monday[0]", FieldPath("subdue", "", "days.monday", 0))
+}
+
+func TestFieldErrors(t *testing.T) {
+	var errs FieldErrors
+	assert.NoError(t, errs.Err())
+
+	errs.AddError("name", nil)
+	assert.NoError(t, errs.Err())
+
+	errs.AddError("name", ValidateName(""))
+	errs.Add("interval", "must be greater than or equal to %d", 1)
+	errs.AddError("proxy_requests", FieldErrors{{Field: "splay_coverage", Message: "must be between 0 and 100"}})
+	errs.AddError("env_vars", ValidateEnvVars([]string{"FOO=BAR", "BAZ"}))
+	errs.Add("", "must contain a check or metrics")
+
+	err := errs.Err()
+	require.Error(t, err)
+	fieldErrs, ok := err.(FieldErrors)
+	require.True(t, ok)
+	assert.Equal(t, FieldErrors{
+		{Field: "name", Message: "must not be empty"},
+		{Field: "interval", Message: "must be greater than or equal to 1"},
+		{Field: "proxy_requests.splay_coverage", Message: "must be between 0 and 100"},
+		{Field: "env_vars[1]", Message: "must be of the form FOO=BAR"},
+		{Field: "", Message: "must contain a check or metrics"},
+	}, fieldErrs)
+	assert.Equal(t, "name: must not be empty; interval: must be greater than or equal to 1; "+
+		"proxy_requests.splay_coverage: must be between 0 and 100; env_vars[1]: must be of the form FOO=BAR; "+
+		"must contain a check or metrics", err.Error())
+}
+
+func TestValidateNestedFieldErrors(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.Interval = 0
+	check.ProxyRequests = &ProxyRequests{Splay: true}
+	check.Secrets = []SecretReference{{Name: "PASSWORD", Secret: "db"}, {Name: "PASSWORD", Secret: "db"}}
+
+	err := check.Validate()
+	require.Error(t, err)
+	assert.Equal(t, FieldErrors{
+		{Field: "interval", Message: "must be greater than 0 or a valid cron schedule must be provided"},
+		{Field: "proxy_requests.splay_coverage", Message: "must be greater than 0 if splay is enabled"},
+		{Field: "secrets[1].name", Message: `"PASSWORD" is used more than once`},
+	}, err)
+}
@@ -0,0 +1,93 @@
+package eval
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/parser"
+)
+
+// JavaScriptTimeout is how long a JavaScript statement may run before its
+// evaluation is interrupted.
+var JavaScriptTimeout = time.Second
+
+var errJavaScriptTimeout = errors.New("evaluation timed out")
+
+// ValidateJavaScriptStatements ensures that the given JavaScript statements can
+// be parsed successfully.
+func ValidateJavaScriptStatements(statements []string) error {
+	for _, statement := range statements {
+		if _, err := parser.ParseFile(nil, "", statement, 0); err != nil {
+			return SyntaxError(fmt.Sprintf("syntax error: %s (%s)", err, statement))
+		}
+	}
+	return nil
+}
+
+// EvaluateJavaScriptPredicate evaluates the given JavaScript statement, which
+// must result in a boolean value, after running the given library scripts.
+// The parameters are exposed to the statement as their JSON representation,
+// so that an event is seen as `event.check.status`, like in handlers.
+func EvaluateJavaScriptPredicate(statement string, parameters map[string]interface{}, libraries ...string) (result bool, err error) {
+	vm := otto.New()
+	for name, value := range parameters {
+		object, err := toJavaScriptObject(value)
+		if err != nil {
+			return false, err
+		}
+		if err := vm.Set(name, object); err != nil {
+			return false, err
+		}
+	}
+
+	// Interrupt the evaluation, which panics in the VM, once timed out
+	vm.Interrupt = make(chan func(), 1)
+	timer := time.AfterFunc(JavaScriptTimeout, func() {
+		vm.Interrupt <- func() {
+			panic(errJavaScriptTimeout)
+		}
+	})
+	defer timer.Stop()
+	defer func() {
+		if r := recover(); r != nil {
+			if r != errJavaScriptTimeout {
+				panic(r)
+			}
+			err = errJavaScriptTimeout
+		}
+	}()
+
+	for _, library := range libraries {
+		if _, err := vm.Run(library); err != nil {
+			return false, fmt.Errorf("failed to load library: %s", err)
+		}
+	}
+
+	script, err := vm.Compile("", statement)
+	if err != nil {
+		return false, SyntaxError(err.Error())
+	}
+	value, err := vm.Run(script)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate the statement: %s", err)
+	}
+	if !value.IsBoolean() {
+		return false, TypeError(fmt.Sprintf("javascript statement: want bool, got %v", value))
+	}
+	return value.ToBoolean()
+}
+
+// toJavaScriptObject returns the given value as a generic object, following
+// its JSON representation.
+func toJavaScriptObject(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var object interface{}
+	err = json.Unmarshal(b, &object)
+	return object, err
+}
@@ -0,0 +1,106 @@
+package eval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type javaScriptCheck struct {
+	Status      int   `json:"status"`
+	Occurrences int64 `json:"occurrences"`
+}
+
+func TestEvaluateJavaScriptPredicate(t *testing.T) {
+	parameters := map[string]interface{}{
+		"event": map[string]interface{}{
+			"check": javaScriptCheck{Status: 2, Occurrences: 20},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		statement string
+		libraries []string
+		want      bool
+		wantErr   bool
+		errType   string
+	}{
+		{
+			name:      "unparsable statement",
+			statement: "1 &&",
+			wantErr:   true,
+			errType:   "SyntaxError",
+		},
+		{
+			name:      "unevaluable statement",
+			statement: "event.entity.id == 'foo'",
+			wantErr:   true,
+		},
+		{
+			name:      "non-boolean statement value",
+			statement: "42",
+			wantErr:   true,
+			errType:   "TypeError",
+		},
+		{
+			name:      "positive result",
+			statement: "event.check.occurrences % 10 == 0 && event.check.status == 2",
+			want:      true,
+		},
+		{
+			name:      "negative result",
+			statement: "event.check.occurrences % 3 == 0",
+			want:      false,
+		},
+		{
+			name:      "library",
+			statement: "isCritical(event)",
+			libraries: []string{"function isCritical(event) { return event.check.status == 2; }"},
+			want:      true,
+		},
+		{
+			name:      "invalid library",
+			statement: "true",
+			libraries: []string{"function {"},
+			wantErr:   true,
+		},
+		{
+			name:      "timeout",
+			statement: "while (true) {}",
+			wantErr:   true,
+		},
+	}
+
+	defer func(timeout time.Duration) { JavaScriptTimeout = timeout }(JavaScriptTimeout)
+	JavaScriptTimeout = 100 * time.Millisecond
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvaluateJavaScriptPredicate(tt.statement, parameters, tt.libraries...)
+			if tt.wantErr {
+				assert.Error(t, err)
+				switch tt.errType {
+				case "SyntaxError":
+					assert.IsType(t, SyntaxError(""), err)
+				case "TypeError":
+					assert.IsType(t, TypeError(""), err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateJavaScriptStatements(t *testing.T) {
+	// Valid statement
+	statements := []string{"event.check.occurrences % 10 == 0"}
+	assert.NoError(t, ValidateJavaScriptStatements(statements))
+
+	// Invalid statement
+	statements = []string{"event.check.status =="}
+	assert.Error(t, ValidateJavaScriptStatements(statements))
+}
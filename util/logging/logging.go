@@ -19,6 +19,11 @@ func EventFields(event *types.Event, debug bool) map[string]interface{} {
 		"organization": event.Entity.Organization,
 	}
 
+	// Trace the event back to the request or message it originates from
+	if event.RequestID != "" {
+		fields["request_id"] = event.RequestID
+	}
+
 	if debug {
 		fields["timestamp"] = event.Timestamp
 		if event.HasCheck() {
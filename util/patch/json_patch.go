@@ -0,0 +1,261 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// operation is an operation of a JSON patch.
+type operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// JSONPatch applies the given JSON patch, a list of operations among "add",
+// "remove", "replace", "move", "copy" and "test", to the given document.
+// Patches are atomic: no document is returned when any operation fails.
+func JSONPatch(doc, patch []byte) ([]byte, error) {
+	var value interface{}
+	if err := json.Unmarshal(doc, &value); err != nil {
+		return nil, fmt.Errorf("invalid document: %s", err)
+	}
+	var operations []operation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %s", err)
+	}
+
+	for i, op := range operations {
+		var err error
+		if value, err = op.apply(value); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %q): %s", i, op.Op, op.Path, err)
+		}
+	}
+	return json.Marshal(value)
+}
+
+func (op operation) apply(doc interface{}) (interface{}, error) {
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add", "replace", "test":
+		var value interface{}
+		if len(op.Value) == 0 {
+			return nil, errors.New("missing value")
+		}
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return nil, err
+		}
+		switch op.Op {
+		case "add":
+			return add(doc, path, value)
+		case "replace":
+			return replace(doc, path, value)
+		}
+		current, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(current, value) {
+			return nil, errors.New("test failed")
+		}
+		return doc, nil
+	case "remove":
+		return remove(doc, path)
+	case "move", "copy":
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == "copy" {
+			return add(doc, path, deepCopy(value))
+		}
+		if len(path) > len(from) && isPrefix(from, path) {
+			return nil, errors.New("can not move a value into one of its children")
+		}
+		if doc, err = remove(doc, from); err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	}
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// parsePointer returns the reference tokens of the given JSON pointer
+// (RFC 6901).
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid path %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+func isPrefix(prefix, path []string) bool {
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// index returns the array index referenced by the given token, which is
+// valid when lower than max.
+func index(token string, max int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i >= max || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid index %q", token)
+	}
+	return i, nil
+}
+
+// get returns the value at the given path of the document.
+func get(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch v := doc.(type) {
+		case map[string]interface{}:
+			value, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			doc = value
+		case []interface{}:
+			i, err := index(token, len(v))
+			if err != nil {
+				return nil, err
+			}
+			doc = v[i]
+		default:
+			return nil, fmt.Errorf("can not reference %q in a scalar value", token)
+		}
+	}
+	return doc, nil
+}
+
+// set replaces the existing value at the given path of the document,
+// returning the document.
+func set(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	token := path[len(path)-1]
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		v[token] = value
+	case []interface{}:
+		i, err := index(token, len(v))
+		if err != nil {
+			return nil, err
+		}
+		v[i] = value
+	default:
+		return nil, fmt.Errorf("can not reference %q in a scalar value", token)
+	}
+	return doc, nil
+}
+
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parentPath, token := path[:len(path)-1], path[len(path)-1]
+	parent, err := get(doc, parentPath)
+	if err != nil {
+		return nil, err
+	}
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		v[token] = value
+		return doc, nil
+	case []interface{}:
+		i := len(v)
+		if token != "-" {
+			if i, err = index(token, len(v)+1); err != nil {
+				return nil, err
+			}
+		}
+		array := make([]interface{}, 0, len(v)+1)
+		array = append(array, v[:i]...)
+		array = append(array, value)
+		array = append(array, v[i:]...)
+		return set(doc, parentPath, array)
+	}
+	return nil, fmt.Errorf("can not add %q to a scalar value", token)
+}
+
+func remove(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, errors.New("can not remove the document")
+	}
+	parentPath, token := path[:len(path)-1], path[len(path)-1]
+	parent, err := get(doc, parentPath)
+	if err != nil {
+		return nil, err
+	}
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := v[token]; !ok {
+			return nil, fmt.Errorf("member %q not found", token)
+		}
+		delete(v, token)
+		return doc, nil
+	case []interface{}:
+		i, err := index(token, len(v))
+		if err != nil {
+			return nil, err
+		}
+		array := make([]interface{}, 0, len(v)-1)
+		array = append(array, v[:i]...)
+		array = append(array, v[i+1:]...)
+		return set(doc, parentPath, array)
+	}
+	return nil, fmt.Errorf("can not remove %q from a scalar value", token)
+}
+
+func replace(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if _, err := get(doc, path); err != nil {
+		return nil, err
+	}
+	return set(doc, path, value)
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			object[key] = deepCopy(value)
+		}
+		return object
+	case []interface{}:
+		array := make([]interface{}, len(v))
+		for i, value := range v {
+			array[i] = deepCopy(value)
+		}
+		return array
+	}
+	return value
+}
@@ -0,0 +1,125 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Examples of RFC 6902, appendix A
+func TestJSONPatch(t *testing.T) {
+	testCases := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{
+			"add object member",
+			`{"foo":"bar"}`,
+			`[{"op":"add","path":"/baz","value":"qux"}]`,
+			`{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			"add array element",
+			`{"foo":["bar","baz"]}`,
+			`[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			`{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			"append array element",
+			`{"foo":["bar"]}`,
+			`[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			`{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			"remove object member",
+			`{"baz":"qux","foo":"bar"}`,
+			`[{"op":"remove","path":"/baz"}]`,
+			`{"foo":"bar"}`,
+		},
+		{
+			"remove array element",
+			`{"foo":["bar","qux","baz"]}`,
+			`[{"op":"remove","path":"/foo/1"}]`,
+			`{"foo":["bar","baz"]}`,
+		},
+		{
+			"replace value",
+			`{"baz":"qux","foo":"bar"}`,
+			`[{"op":"replace","path":"/baz","value":"boo"}]`,
+			`{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			"move value",
+			`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			"move array element",
+			`{"foo":["all","grass","cows","eat"]}`,
+			`[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			`{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			"copy value",
+			`{"foo":{"bar":"baz"}}`,
+			`[{"op":"copy","from":"/foo","path":"/qux"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"bar":"baz"}}`,
+		},
+		{
+			"test value",
+			`{"baz":"qux","foo":["a",2,"c"]}`,
+			`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			`{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			"escaped pointer",
+			`{"/":9,"~1":10}`,
+			`[{"op":"test","path":"/~01","value":10},{"op":"remove","path":"/~1"}]`,
+			`{"~1":10}`,
+		},
+		{
+			"replace document",
+			`{"foo":"bar"}`,
+			`[{"op":"replace","path":"","value":["baz"]}]`,
+			`["baz"]`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := JSONPatch([]byte(tc.doc), []byte(tc.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestJSONPatchErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		doc   string
+		patch string
+	}{
+		{"invalid patch", `{}`, `{"op":"add"}`},
+		{"unknown operation", `{}`, `[{"op":"merge","path":"/a"}]`},
+		{"missing value", `{}`, `[{"op":"add","path":"/a"}]`},
+		{"invalid path", `{}`, `[{"op":"add","path":"a","value":1}]`},
+		{"missing parent", `{}`, `[{"op":"add","path":"/a/b","value":1}]`},
+		{"index out of bounds", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/2","value":1}]`},
+		{"leading zero index", `{"foo":["bar","baz"]}`, `[{"op":"remove","path":"/foo/01"}]`},
+		{"remove missing member", `{"foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`},
+		{"replace missing member", `{"foo":"bar"}`, `[{"op":"replace","path":"/baz","value":1}]`},
+		{"failed test", `{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`},
+		{"move into child", `{"foo":{"bar":1}}`, `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`},
+		{"scalar parent", `{"foo":1}`, `[{"op":"add","path":"/foo/bar","value":1}]`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := JSONPatch([]byte(tc.doc), []byte(tc.patch))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Package patch applies JSON merge patches (RFC 7386) and JSON patches
+// (RFC 6902) to JSON documents.
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+)
+
+const (
+	// MergePatchType is the media type of JSON merge patches
+	MergePatchType = "application/merge-patch+json"
+
+	// JSONPatchType is the media type of JSON patches
+	JSONPatchType = "application/json-patch+json"
+)
+
+// Apply applies the given patch, of the given media type, to the given
+// document. Patches of type "application/json", or of no type, are applied as
+// merge patches.
+func Apply(contentType string, doc, patch []byte) ([]byte, error) {
+	mediaType := MergePatchType
+	if contentType != "" {
+		var err error
+		if mediaType, _, err = mime.ParseMediaType(contentType); err != nil {
+			return nil, err
+		}
+	}
+
+	switch mediaType {
+	case MergePatchType, "application/json":
+		return MergePatch(doc, patch)
+	case JSONPatchType:
+		return JSONPatch(doc, patch)
+	}
+	return nil, fmt.Errorf("unsupported patch type %q", mediaType)
+}
+
+// MergePatch applies the given JSON merge patch to the given document: the
+// members of patch objects replace the members of the document, recursively,
+// and null members remove them.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	var docValue, patchValue interface{}
+	if err := json.Unmarshal(doc, &docValue); err != nil {
+		return nil, fmt.Errorf("invalid document: %s", err)
+	}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %s", err)
+	}
+	return json.Marshal(mergeValue(docValue, patchValue))
+}
+
+func mergeValue(doc, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	docObject, ok := doc.(map[string]interface{})
+	if !ok {
+		docObject = map[string]interface{}{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(docObject, key)
+			continue
+		}
+		docObject[key] = mergeValue(docObject[key], value)
+	}
+	return docObject
+}
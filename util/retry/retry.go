@@ -11,7 +11,7 @@ type ExponentialBackoff struct {
 	// InitialDelayInterval represents the initial amount of time of sleep
 	InitialDelayInterval time.Duration
 	// MaxDelayInterval represents the maximal amount of time of sleep between
-	// retries
+	// retries. A value of zero signifies no limit
 	MaxDelayInterval time.Duration
 	// MaxElapsedTime represents the maximal amount of time allowed to retry. A
 	// value of zero signifies no limit
@@ -50,15 +50,15 @@ func (b *ExponentialBackoff) Retry(fn Func) error {
 				return ErrMaxElapsedTime
 			}
 
-			// Sleep for the determined duration
-			time.Sleep(wait)
+			// Sleep for the determined duration, with a jitter (randomized delay)
+			// of up to half of it, to prevent potential collisions
+			time.Sleep(wait - time.Duration(rand.Float64()*float64(wait)/2))
 
-			// Exponentially increase that sleep duration
+			// Exponentially increase that sleep duration, up to MaxDelayInterval
 			wait = time.Duration(float64(wait) * b.Multiplier)
-
-			// Add a jitter (randomized delay) for the next attempt, to prevent
-			// potential collisions
-			wait = wait + time.Duration(rand.Float64()*float64(wait))
+			if b.MaxDelayInterval != 0 && wait > b.MaxDelayInterval {
+				wait = b.MaxDelayInterval
+			}
 		} else {
 			// Save the current time, in order to measure the total execution time
 			b.start = time.Now()
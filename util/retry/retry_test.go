@@ -60,3 +60,32 @@ func TestExponentialBackoff(t *testing.T) {
 	sleepFn := mockBackoffFuncSleep()
 	assert.Equal(t, ErrMaxElapsedTime, b.Retry(sleepFn))
 }
+
+func TestExponentialBackoffMaxDelayInterval(t *testing.T) {
+	var last time.Time
+	var delays []time.Duration
+	fn := func(retry int) (bool, error) {
+		if retry != 0 {
+			delays = append(delays, time.Since(last))
+		}
+		last = time.Now()
+		return false, nil
+	}
+
+	b := ExponentialBackoff{
+		InitialDelayInterval: 5 * time.Millisecond,
+		MaxDelayInterval:     20 * time.Millisecond,
+		MaxRetryAttempts:     5,
+		Multiplier:           10,
+	}
+	assert.Equal(t, ErrMaxRetryAttempts, b.Retry(fn))
+
+	// The delays are randomized between half of the interval and the interval,
+	// which never exceeds MaxDelayInterval
+	assert.Len(t, delays, 4)
+	assert.True(t, delays[0] >= 2500*time.Microsecond, delays[0].String())
+	for _, delay := range delays[1:] {
+		assert.True(t, delay >= 10*time.Millisecond, delay.String())
+		assert.True(t, delay < 100*time.Millisecond, delay.String())
+	}
+}